@@ -4,6 +4,7 @@ import (
 	"gb-cms/common"
 	"gb-cms/dao"
 	"gb-cms/log"
+	"gb-cms/stack"
 	"github.com/gorilla/mux"
 	"github.com/gorilla/websocket"
 	"net"
@@ -28,6 +29,7 @@ type InviteParams struct {
 	Speed     string `json:"speed"`
 	Token     string `json:"token"`
 	Download  bool   `json:"download"`
+	Stream    string `json:"streamnumber"` // 码流, 0/main-主码流 1/sub-子码流, 为空使用设备默认码流
 	streamId  common.StreamID
 }
 
@@ -50,6 +52,8 @@ type QueryRecordParams struct {
 	EndTime   string `json:"endtime"`
 	//Type_     string `json:"type"`
 	Command string `json:"command"` // 云台控制命令 left/up/right/down/zoomin/zoomout
+	Refresh bool   `json:"refresh"` // 忽略缓存, 重新查询设备录像
+	Enable  bool   `json:"enable"`  // 是否预取录像
 }
 
 type DeviceChannelID struct {
@@ -71,13 +75,18 @@ type BroadcastParams struct {
 
 type RecordParams struct {
 	StreamParams
-	Path string `json:"path"`
+	Path      string `json:"path"`
+	StartTime int64  `json:"start_time"` // 录像开始时间, unix毫秒
+	EndTime   int64  `json:"end_time"`   // 录像结束时间, unix毫秒
+	Duration  int64  `json:"duration"`   // 录像时长, 单位毫秒, 未携带开始时间时用于计算开始时间
+	Size      int64  `json:"size"`       // 文件大小
 }
 
 type StreamIDParams struct {
 	StreamID common.StreamID `json:"streamid"`
 	Command  string          `json:"command"`
 	Scale    float64         `json:"scale"`
+	Range    string          `json:"range"` // 回放跳转的位置, 相对开始时间的秒数
 }
 
 type PageQuery struct {
@@ -114,6 +123,8 @@ type QueryDeviceChannel struct {
 	Method    string `json:"method"`
 	StartTime string `json:"starttime"`
 	EndTime   string `json:"endtime"`
+	Status    string `json:"status"` // 报警处理状态
+	Assignee  string `json:"assignee"`
 }
 
 type DeleteDevice struct {
@@ -177,6 +188,9 @@ type DeviceInfo struct {
 	DropChannelType    string  `json:"drop_channel_type"`
 	Longitude          float64 `json:"longitude"`
 	Latitude           float64 `json:"latitude"`
+	InviteTimeout      int     `json:"invite_timeout"`
+	AckTimeout         int     `json:"ack_timeout"`
+	FirstPacketTimeout int     `json:"first_packet_timeout"`
 }
 
 type responseRecorder struct {
@@ -275,14 +289,17 @@ func StartApiServer(addr string) {
 	apiServer.registerStatisticsHandler("删除设备", "/api/v1/device/remove", withVerify(common.WithFormDataParams(apiServer.OnDeviceRemove, DeleteDevice{})))                                     // 删除设备
 	apiServer.registerStatisticsHandler("设置设备媒体传输模式", "/api/v1/device/setmediatransport", withVerify(common.WithFormDataParams(apiServer.OnDeviceMediaTransportSet, SetMediaTransportReq{}))) // 设置设备媒体传输模式
 
-	apiServer.registerStatisticsHandler("查询录像列表", "/api/v1/playback/recordlist", withVerify(common.WithQueryStringParams(apiServer.OnRecordList, QueryRecordParams{}))) // 查询录像列表
+	apiServer.registerStatisticsHandler("查询录像列表", "/api/v1/playback/recordlist", withVerify(common.WithQueryStringParams(apiServer.OnRecordList, QueryRecordParams{})))       // 查询录像列表
+	apiServer.router.HandleFunc("/api/v1/playback/timeline", withVerify(common.WithQueryStringParams(apiServer.OnRecordTimeline, QueryCloudRecord{})))                        // 合并设备和云端录像的时间轴
+	apiServer.router.HandleFunc("/api/v1/playback/prefetch/list", withVerify(common.WithQueryStringParams(apiServer.OnRecordPrefetchList, Empty{})))                          // 夜间预取录像的通道
+	apiServer.registerStatisticsHandler("设置录像预取", "/api/v1/playback/prefetch/set", withVerify(common.WithFormDataParams(apiServer.OnRecordPrefetchSet, QueryRecordParams{}))) // 设置通道是否在夜间预取录像
 	apiServer.router.HandleFunc("/api/v1/stream/info", withVerify(apiServer.OnStreamInfo))
-	apiServer.router.HandleFunc("/api/v1/playback/streaminfo", withVerify(apiServer.OnStreamInfo))
+	apiServer.router.HandleFunc("/api/v1/playback/streaminfo", withVerify(apiServer.OnPlaybackStreamInfo))
 	apiServer.router.HandleFunc("/api/v1/device/session/list", withVerify(common.WithQueryStringParams(apiServer.OnSessionList, QueryDeviceChannel{}))) // 推流列表
 	apiServer.router.HandleFunc("/api/v1/device/session/stop", withVerify(common.WithFormDataParams(apiServer.OnSessionStop, StreamIDParams{})))        // 关闭流
 	apiServer.router.HandleFunc("/api/v1/device/setchannelid", withVerify(common.WithFormDataParams(apiServer.OnCustomChannelSet, CustomChannel{})))    // 自定义通道ID
 
-	apiServer.router.HandleFunc("/api/v1/playback/seek", common.WithJsonResponse(apiServer.OnSeekPlayback, &SeekParams{}))                                 // 回放seek
+	apiServer.router.HandleFunc("/api/v1/playback/seek", withVerify(common.WithJsonResponse(apiServer.OnSeekPlayback, &SeekParams{})))                     // 回放seek
 	apiServer.registerStatisticsHandler("云台控制", "/api/v1/control/ptz", withVerify(common.WithFormDataParams(apiServer.OnPTZControl, QueryRecordParams{}))) // 云台控制
 
 	apiServer.router.HandleFunc("/api/v1/cascade/list", withVerify(common.WithQueryStringParams(apiServer.OnPlatformList, QueryDeviceChannel{})))                    // 级联设备列表
@@ -302,12 +319,72 @@ func StartApiServer(addr string) {
 	apiServer.router.HandleFunc("/api/v1/log/list", withVerify(common.WithQueryStringParams(apiServer.OnLogList, QueryDeviceChannel{})))                    // 操作日志
 	apiServer.router.HandleFunc("/api/v1/log/clear", withVerify(common.WithQueryStringParams(apiServer.OnLogClear, Empty{})))                               // 操作日志
 
+	apiServer.router.HandleFunc("/api/v1/alarm/plan/list", withVerify(common.WithQueryStringParams(apiServer.OnAlarmPlanList, QueryDeviceChannel{})))                    // 报警预案列表
+	apiServer.registerStatisticsHandler("保存报警预案", "/api/v1/alarm/plan/save", withVerify(common.WithFormDataParams(apiServer.OnAlarmPlanSave, AlarmPlan{})))              // 添加或编辑报警预案
+	apiServer.registerStatisticsHandler("删除报警预案", "/api/v1/alarm/plan/remove", withVerify(common.WithFormDataParams(apiServer.OnAlarmPlanRemove, AlarmPlan{})))          // 删除报警预案
+	apiServer.registerStatisticsHandler("设置报警预案状态", "/api/v1/alarm/plan/setenable", withVerify(common.WithFormDataParams(apiServer.OnAlarmPlanEnableSet, AlarmPlan{})))  // 使能报警预案
+	apiServer.router.HandleFunc("/api/v1/alarm/plan/channellist", withVerify(common.WithQueryStringParams(apiServer.OnAlarmPlanChannelList, QueryCascadeChannelList{}))) // 报警预案通道列表
+	apiServer.router.HandleFunc("/api/v1/alarm/plan/savechannels", withVerify(apiServer.OnAlarmPlanChannelBind))                                                         // 报警预案关联通道
+	apiServer.router.HandleFunc("/api/v1/alarm/plan/removechannels", withVerify(apiServer.OnAlarmPlanChannelUnbind))                                                     // 报警预案取消关联通道
+	apiServer.router.HandleFunc("/api/v1/alarm/plan/loglist", withVerify(common.WithQueryStringParams(apiServer.OnAlarmPlanLogList, QueryAlarmPlanLog{})))               // 报警预案执行记录
+	apiServer.router.HandleFunc("/api/v1/alarm/snap/upload/{session}", apiServer.OnAlarmSnapUpload)                                                                      // 设备上传报警联动抓拍图片
+
+	apiServer.registerStatisticsHandler("处理报警", "/api/v1/alarm/handle", withVerify(common.WithFormDataParams(apiServer.OnAlarmHandle, AlarmHandle{}))) // 修改报警处理状态
+	apiServer.registerStatisticsHandler("指派报警", "/api/v1/alarm/assign", withVerify(common.WithFormDataParams(apiServer.OnAlarmAssign, AlarmHandle{}))) // 指派报警处理人
+	apiServer.router.HandleFunc("/api/v1/alarm/handlelog", withVerify(common.WithQueryStringParams(apiServer.OnAlarmHandleLog, AlarmHandle{})))        // 报警处理记录
+	apiServer.router.HandleFunc("/api/v1/alarm/stats", withVerify(common.WithQueryStringParams(apiServer.OnAlarmStats, QueryAlarmStats{})))            // 报警处理统计
+
 	apiServer.router.HandleFunc("/api/v1/device/statuslog", withVerify(common.WithQueryStringParams(apiServer.OnStatusLogList, QueryDeviceChannel{}))) // 设备上下线统计
 
+	apiServer.router.HandleFunc("/api/v1/cloudrecord/plan/list", withVerify(common.WithQueryStringParams(apiServer.OnRecordPlanList, QueryDeviceChannel{})))                    // 录像计划列表
+	apiServer.registerStatisticsHandler("保存录像计划", "/api/v1/cloudrecord/plan/save", withVerify(common.WithFormDataParams(apiServer.OnRecordPlanSave, RecordPlan{})))             // 添加或编辑录像计划
+	apiServer.registerStatisticsHandler("删除录像计划", "/api/v1/cloudrecord/plan/remove", withVerify(common.WithFormDataParams(apiServer.OnRecordPlanRemove, RecordPlan{})))         // 删除录像计划
+	apiServer.registerStatisticsHandler("设置录像计划状态", "/api/v1/cloudrecord/plan/setenable", withVerify(common.WithFormDataParams(apiServer.OnRecordPlanEnableSet, RecordPlan{}))) // 使能录像计划
+	apiServer.router.HandleFunc("/api/v1/cloudrecord/plan/channellist", withVerify(common.WithQueryStringParams(apiServer.OnRecordPlanChannelList, QueryCascadeChannelList{}))) // 录像计划通道列表
+	apiServer.router.HandleFunc("/api/v1/cloudrecord/plan/savechannels", withVerify(apiServer.OnRecordPlanChannelBind))                                                         // 录像计划关联通道
+	apiServer.router.HandleFunc("/api/v1/cloudrecord/plan/removechannels", withVerify(apiServer.OnRecordPlanChannelUnbind))                                                     // 录像计划取消关联通道
+
+	apiServer.router.HandleFunc("/api/v1/cloudrecord/querychannels", withVerify(common.WithQueryStringParams(apiServer.OnCloudRecordChannelList, QueryDeviceChannel{})))                    // 有云端录像的通道
+	apiServer.router.HandleFunc("/api/v1/cloudrecord/querydaily", withVerify(common.WithQueryStringParams(apiServer.OnCloudRecordList, QueryCloudRecord{})))                                // 按天查询录像片段
+	apiServer.router.HandleFunc("/api/v1/cloudrecord/querylist", withVerify(common.WithQueryStringParams(apiServer.OnCloudRecordList, QueryCloudRecord{})))                                 // 按时间范围查询录像片段
+	apiServer.router.HandleFunc("/api/v1/cloudrecord/timeline", withVerify(common.WithQueryStringParams(apiServer.OnCloudRecordTimeline, QueryCloudRecord{})))                              // 录像时间轴
+	apiServer.router.HandleFunc("/api/v1/cloudrecord/queryflags", withVerify(common.WithQueryStringParams(apiServer.OnCloudRecordFlags, QueryCloudRecord{})))                               // 按月查询有录像的日期
+	apiServer.router.HandleFunc("/api/v1/cloudrecord/download/{device}/{channel}/{start}", withVerify(apiServer.OnCloudRecordDownload))                                                     // 录像片段下载地址
+	apiServer.router.HandleFunc("/api/v1/cloudrecord/file/{id}/video.mp4", withVerify(apiServer.OnCloudRecordFile))                                                                         // 录像文件
+	apiServer.registerStatisticsHandler("标记云端录像", "/api/v1/cloudrecord/setimportant", withVerify(common.WithFormDataParams(apiServer.OnCloudRecordImportantSet, QueryCloudRecord{})))       // 锁定/解锁录像
+	apiServer.registerStatisticsHandler("删除云端录像", "/api/v1/cloudrecord/remove", withVerify(common.WithFormDataParams(apiServer.OnCloudRecordRemove, QueryCloudRecord{})))                   // 删除录像片段
+	apiServer.registerStatisticsHandler("删除云端录像", "/api/v1/cloudrecord/removedaily", withVerify(common.WithFormDataParams(apiServer.OnCloudRecordDailyRemove, QueryCloudRecord{})))         // 删除某天的录像
+	apiServer.registerStatisticsHandler("删除云端录像", "/api/v1/cloudrecord/removechannel", withVerify(common.WithFormDataParams(apiServer.OnCloudRecordChannelRemove, QueryCloudRecord{})))     // 删除通道的录像
+	apiServer.router.HandleFunc("/api/v1/cloudrecord/getreservedays", withVerify(common.WithQueryStringParams(apiServer.OnCloudRecordReserveDaysGet, QueryCloudRecord{})))                  // 查询通道录像保留天数
+	apiServer.registerStatisticsHandler("设置录像保留天数", "/api/v1/cloudrecord/setreservedays", withVerify(common.WithFormDataParams(apiServer.OnCloudRecordReserveDaysSet, QueryCloudRecord{}))) // 设置通道录像保留天数
+
+	apiServer.registerStatisticsHandler("创建下载任务", "/api/v1/download/create", withVerify(common.WithFormDataParams(apiServer.OnDownloadJobCreate, InviteParams{})))      // 创建录像下载任务
+	apiServer.router.HandleFunc("/api/v1/download/list", withVerify(common.WithQueryStringParams(apiServer.OnDownloadJobList, DownloadJobParams{})))                    // 下载任务列表
+	apiServer.router.HandleFunc("/api/v1/download/info", withVerify(common.WithQueryStringParams(apiServer.OnDownloadJobInfo, DownloadJobParams{})))                    // 下载任务详情
+	apiServer.registerStatisticsHandler("取消下载任务", "/api/v1/download/cancel", withVerify(common.WithFormDataParams(apiServer.OnDownloadJobCancel, DownloadJobParams{}))) // 取消下载任务
+	apiServer.registerStatisticsHandler("重试下载任务", "/api/v1/download/retry", withVerify(common.WithFormDataParams(apiServer.OnDownloadJobRetry, DownloadJobParams{})))   // 重试失败的任务
+	apiServer.registerStatisticsHandler("删除下载任务", "/api/v1/download/remove", withVerify(common.WithFormDataParams(apiServer.OnDownloadJobRemove, DownloadJobParams{}))) // 删除下载任务
+	apiServer.router.HandleFunc("/api/v1/download/file/{id}/video.mp4", withVerify(apiServer.OnDownloadJobFile))                                                        // 下载完成的文件
+
+	apiServer.registerStatisticsHandler("导出证据包", "/api/v1/export/create", withVerify(common.WithFormDataParams(apiServer.OnEvidenceExportCreate, EvidenceExportParams{}))) // 导出多通道证据包
+	apiServer.router.HandleFunc("/api/v1/export/list", withVerify(common.WithQueryStringParams(apiServer.OnEvidenceExportList, EvidenceExportParams{})))                   // 证据包导出任务列表
+	apiServer.router.HandleFunc("/api/v1/export/info", withVerify(common.WithQueryStringParams(apiServer.OnEvidenceExportInfo, EvidenceExportParams{})))                   // 导出任务详情和各通道下载进度
+	apiServer.registerStatisticsHandler("删除证据包", "/api/v1/export/remove", withVerify(common.WithFormDataParams(apiServer.OnEvidenceExportRemove, EvidenceExportParams{}))) // 删除导出任务和证据包
+	apiServer.router.HandleFunc("/api/v1/export/file/{id}/evidence.zip", withVerify(apiServer.OnEvidenceExportFile))                                                       // 下载证据包
+
+	apiServer.registerStatisticsHandler("添加拉流代理", "/api/v1/proxy/add", withVerify(common.WithFormDataParams(apiServer.OnStreamProxyAdd, StreamProxyParams{})))       // 添加拉流代理
+	apiServer.registerStatisticsHandler("编辑拉流代理", "/api/v1/proxy/edit", withVerify(common.WithFormDataParams(apiServer.OnStreamProxyEdit, StreamProxyParams{})))     // 编辑拉流代理
+	apiServer.registerStatisticsHandler("删除拉流代理", "/api/v1/proxy/remove", withVerify(common.WithFormDataParams(apiServer.OnStreamProxyRemove, StreamProxyParams{}))) // 删除拉流代理和通道
+	apiServer.router.HandleFunc("/api/v1/proxy/list", withVerify(common.WithQueryStringParams(apiServer.OnStreamProxyList, StreamProxyParams{})))                    // 拉流代理列表
+
+	apiServer.router.HandleFunc("/api/v1/onvif/discover", withVerify(common.WithQueryStringParams(apiServer.OnOnvifDiscover, OnvifParams{})))               // 发现本地网段的ONVIF设备
+	apiServer.registerStatisticsHandler("接入ONVIF设备", "/api/v1/onvif/add", withVerify(common.WithFormDataParams(apiServer.OnOnvifAdd, OnvifParams{})))       // 接入ONVIF设备
+	apiServer.router.HandleFunc("/api/v1/onvif/list", withVerify(common.WithQueryStringParams(apiServer.OnOnvifList, Empty{})))                             // ONVIF设备列表
+	apiServer.registerStatisticsHandler("删除ONVIF设备", "/api/v1/onvif/remove", withVerify(common.WithFormDataParams(apiServer.OnOnvifRemove, OnvifParams{}))) // 删除ONVIF设备和通道
+
 	// 暂未开发
-	apiServer.router.HandleFunc("/api/v1/sms/list", withVerify(func(w http.ResponseWriter, req *http.Request) {}))                  // 流媒体服务器列表
-	apiServer.router.HandleFunc("/api/v1/cloudrecord/querychannels", withVerify(func(w http.ResponseWriter, req *http.Request) {})) // 云端录像
-	apiServer.router.HandleFunc("/api/v1/user/list", withVerify(func(w http.ResponseWriter, req *http.Request) {}))                 // 用户管理
+	apiServer.router.HandleFunc("/api/v1/sms/list", withVerify(func(w http.ResponseWriter, req *http.Request) {}))  // 流媒体服务器列表
+	apiServer.router.HandleFunc("/api/v1/user/list", withVerify(func(w http.ResponseWriter, req *http.Request) {})) // 用户管理
 	apiServer.router.HandleFunc("/api/v1/getbaseconfig", withVerify(common.WithFormDataParams(apiServer.OnGetBaseConfig, Empty{})))
 	apiServer.router.HandleFunc("/api/v1/setbaseconfig", withVerify(common.WithFormDataParams(apiServer.OnSetBaseConfig, Empty{})))
 	apiServer.router.HandleFunc("/api/v1/gm/cert/list", withVerify(func(w http.ResponseWriter, req *http.Request) {}))
@@ -331,6 +408,10 @@ func StartApiServer(addr string) {
 	apiServer.router.HandleFunc("/api/v1/jt/channel/add", common.WithJsonResponse(apiServer.OnVirtualChannelAdd, &dao.ChannelModel{}))
 	apiServer.router.HandleFunc("/api/v1/jt/channel/edit", common.WithJsonResponse(apiServer.OnVirtualChannelEdit, &dao.ChannelModel{}))
 	apiServer.router.HandleFunc("/api/v1/jt/channel/remove", common.WithJsonResponse(apiServer.OnVirtualChannelRemove, &dao.ChannelModel{}))
+	apiServer.router.HandleFunc("/api/v1/event/ws", withVerify(apiServer.OnEventWebSocket)) // websocket订阅实时事件
+	apiServer.router.HandleFunc("/api/v1/event/sse", withVerify(apiServer.OnEventSSE))      // sse订阅实时事件
+
+	apiServer.router.HandleFunc("/api/v1/jt/position", withVerify(common.WithJsonResponse(apiServer.OnJTPosition, &stack.JTPosition{}))) // 1078信令服务器上报终端位置
 	apiServer.registerStatisticsHandler("退出登录", "/api/v1/logout", func(writer http.ResponseWriter, req *http.Request) {
 		cookie, err := req.Cookie("token")
 		if err == nil {
@@ -347,12 +428,15 @@ func StartApiServer(addr string) {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			now := time.Now()
 			name, ok := apiServer.actionNames[r.URL.Path]
-			recorder := &responseRecorder{ResponseWriter: w}
-			handler.ServeHTTP(recorder, r)
 			if !ok {
+				// 非统计接口直接处理, 避免包装后websocket无法hijack, sse无法flush
+				handler.ServeHTTP(w, r)
 				return
 			}
 
+			recorder := &responseRecorder{ResponseWriter: w}
+			handler.ServeHTTP(recorder, r)
+
 			end := time.Now()
 			var address string
 			if common.Config.IP2RegionEnable {
@@ -369,6 +453,8 @@ func StartApiServer(addr string) {
 			var username = "admin"
 			if r.URL.Path == "/api/v1/login" {
 				username = r.FormValue("username")
+			} else if name := requestUsername(r); name != "" {
+				username = name
 			}
 
 			var status = "OK"
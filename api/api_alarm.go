@@ -1,11 +1,71 @@
 package api
 
 import (
+	"fmt"
 	"gb-cms/common"
 	"gb-cms/dao"
+	"gb-cms/log"
+	"gb-cms/stack"
+	"github.com/gorilla/mux"
+	"io"
 	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
 )
 
+const (
+	// 抓拍图片的最大字节数
+	maxSnapSize = 10 * 1024 * 1024
+)
+
+type AlarmPlan struct {
+	ID                int    `json:"ID"`
+	Name              string `json:"Name"`
+	Enable            bool   `json:"Enable"`
+	Priority          string `json:"Priority"` // 多个用逗号分隔, 下同
+	Method            string `json:"Method"`
+	Type              string `json:"Type"`
+	EventType         string `json:"EventType"`
+	StartTime         string `json:"StartTime"` // 生效时间段, 例如08:00
+	EndTime           string `json:"EndTime"`
+	RecordDuration    int    `json:"RecordDuration"`
+	SnapInterval      int    `json:"SnapInterval"`
+	PresetDeviceID    string `json:"PresetDeviceID"`
+	PresetChannelID   string `json:"PresetChannelID"`
+	PresetIndex       int    `json:"PresetIndex"`
+	WebhookURL        string `json:"WebhookURL"`
+	BroadcastURL      string `json:"BroadcastURL"`
+	BroadcastDuration int    `json:"BroadcastDuration"`
+	ChannelCount      int    `json:"ChannelCount"`
+	CreatedAt         string `json:"CreatedAt"`
+	UpdatedAt         string `json:"UpdatedAt"`
+}
+
+type AlarmPlanChannel struct {
+	PlanID string
+	*LiveGBSChannel
+}
+
+type AlarmHandle struct {
+	ID       int    `json:"id"`
+	Status   string `json:"status"`   // acknowledged/processing/resolved/false_positive
+	Assignee string `json:"assignee"` // 指派的处理人
+	Note     string `json:"note"`     // 处理说明
+}
+
+type QueryAlarmStats struct {
+	StartTime string `json:"starttime"`
+	EndTime   string `json:"endtime"`
+}
+
+type QueryAlarmPlanLog struct {
+	ID    int `json:"id"` // 预案ID, 为空查询所有
+	Start int `json:"start"`
+	Limit int `json:"limit"`
+}
+
 func (api *ApiServer) OnAlarmList(q *QueryDeviceChannel, _ http.ResponseWriter, _ *http.Request) (interface{}, error) {
 	if q.Limit < 1 {
 		q.Limit = 10
@@ -34,6 +94,14 @@ func (api *ApiServer) OnAlarmList(q *QueryDeviceChannel, _ http.ResponseWriter,
 		conditions["alarm_method"] = q.Method
 	}
 
+	if q.Status != "" {
+		conditions["status"] = q.Status
+	}
+
+	if q.Assignee != "" {
+		conditions["assignee"] = q.Assignee
+	}
+
 	alarms, count, err := dao.Alarm.QueryAlarmList((q.Start/q.Limit)+1, q.Limit, conditions)
 
 	if err != nil {
@@ -72,3 +140,319 @@ func (api *ApiServer) OnAlarmClear(_ *Empty, _ http.ResponseWriter, req *http.Re
 
 	return "OK", nil
 }
+
+// OnAlarmHandle 修改报警的处理状态
+func (api *ApiServer) OnAlarmHandle(v *AlarmHandle, _ http.ResponseWriter, r *http.Request) (interface{}, error) {
+	alarm, err := dao.Alarm.Transition(v.ID, v.Status, requestUsername(r), v.Note)
+	if err != nil {
+		return nil, err
+	}
+
+	// 设备报警处理完成后, 通知设备复位, 例如一键报警按钮
+	if alarm.Closed() && 2 == alarm.AlarmMethod {
+		device, err := dao.Device.QueryDevice(alarm.DeviceID)
+		if device == nil || !device.Online() {
+			log.Sugar.Warnf("设备离线, 无法复位报警 err: %v device: %s", err, alarm.DeviceID)
+		} else {
+			(&stack.Device{DeviceModel: device}).ResetAlarm(alarm.ChannelID, alarm.AlarmMethod, alarm.AlarmType)
+		}
+	}
+
+	return "OK", nil
+}
+
+// OnAlarmAssign 指派报警的处理人, 处理人可以是系统外的值班人员, 只校验非空
+func (api *ApiServer) OnAlarmAssign(v *AlarmHandle, _ http.ResponseWriter, r *http.Request) (interface{}, error) {
+	if v.Assignee = strings.TrimSpace(v.Assignee); v.Assignee == "" {
+		return nil, fmt.Errorf("处理人不能为空")
+	} else if err := dao.Alarm.Assign(v.ID, v.Assignee, requestUsername(r), v.Note); err != nil {
+		return nil, err
+	}
+
+	return "OK", nil
+}
+
+// OnAlarmHandleLog 查询报警的处理记录
+func (api *ApiServer) OnAlarmHandleLog(v *AlarmHandle, _ http.ResponseWriter, _ *http.Request) (interface{}, error) {
+	alarm, err := dao.Alarm.QueryAlarm(v.ID)
+	if err != nil {
+		return nil, fmt.Errorf("报警不存在")
+	}
+
+	logs, err := dao.Alarm.QueryHandleLogs(v.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{
+		"Alarm":    alarm,
+		"LogCount": len(logs),
+		"LogList":  logs,
+	}, nil
+}
+
+// OnAlarmStats 统计报警的响应时长和处理情况, 默认统计最近7天
+func (api *ApiServer) OnAlarmStats(v *QueryAlarmStats, _ http.ResponseWriter, _ *http.Request) (interface{}, error) {
+	end := time.Now()
+	start := end.AddDate(0, 0, -7)
+	if v.StartTime != "" {
+		start = common.ParseGBTime(v.StartTime)
+	}
+
+	if v.EndTime != "" {
+		end = common.ParseGBTime(v.EndTime)
+	}
+
+	return dao.Alarm.QueryStats(start, end)
+}
+
+func (api *ApiServer) OnAlarmPlanList(q *QueryDeviceChannel, _ http.ResponseWriter, _ *http.Request) (interface{}, error) {
+	if q.Limit < 1 {
+		q.Limit = 10
+	}
+
+	plans, total, err := dao.AlarmPlan.QueryPlans((q.Start/q.Limit)+1, q.Limit, q.Keyword)
+	if err != nil {
+		return nil, err
+	}
+
+	response := struct {
+		PlanCount int
+		PlanList  []*AlarmPlan
+	}{
+		PlanCount: total,
+		PlanList:  []*AlarmPlan{},
+	}
+
+	for _, plan := range plans {
+		count, _ := dao.AlarmPlan.QueryPlanChannelCount(int(plan.ID))
+		response.PlanList = append(response.PlanList, &AlarmPlan{
+			ID:                int(plan.ID),
+			Name:              plan.Name,
+			Enable:            plan.Enable,
+			Priority:          plan.Priority,
+			Method:            plan.Method,
+			Type:              plan.Type,
+			EventType:         plan.EventType,
+			StartTime:         plan.StartTime,
+			EndTime:           plan.EndTime,
+			RecordDuration:    plan.RecordDuration,
+			SnapInterval:      plan.SnapInterval,
+			PresetDeviceID:    plan.PresetDeviceID,
+			PresetChannelID:   plan.PresetChannelID,
+			PresetIndex:       plan.PresetIndex,
+			WebhookURL:        plan.WebhookURL,
+			BroadcastURL:      plan.BroadcastURL,
+			BroadcastDuration: plan.BroadcastDuration,
+			ChannelCount:      count,
+			CreatedAt:         plan.CreatedAt.Format("2006-01-02 15:04:05"),
+			UpdatedAt:         plan.UpdatedAt.Format("2006-01-02 15:04:05"),
+		})
+	}
+
+	return &response, nil
+}
+
+func (api *ApiServer) OnAlarmPlanSave(v *AlarmPlan, _ http.ResponseWriter, _ *http.Request) (interface{}, error) {
+	log.Sugar.Debugf("保存报警预案 %v", *v)
+
+	for _, t := range []string{v.StartTime, v.EndTime} {
+		if t == "" {
+			continue
+		} else if _, err := planTime2Minutes(t); err != nil || len(t) != 5 {
+			return nil, fmt.Errorf("时间格式错误 %s", t)
+		}
+	}
+
+	var err error
+	model := &dao.AlarmPlanModel{}
+	if v.ID > 0 {
+		if model, err = dao.AlarmPlan.QueryPlan(v.ID); err != nil {
+			return nil, fmt.Errorf("报警预案不存在")
+		}
+	}
+
+	model.Name = v.Name
+	model.Enable = v.Enable
+	model.Priority = v.Priority
+	model.Method = v.Method
+	model.Type = v.Type
+	model.EventType = v.EventType
+	model.StartTime = v.StartTime
+	model.EndTime = v.EndTime
+	model.RecordDuration = v.RecordDuration
+	model.SnapInterval = v.SnapInterval
+	model.PresetDeviceID = v.PresetDeviceID
+	model.PresetChannelID = v.PresetChannelID
+	model.PresetIndex = v.PresetIndex
+	model.WebhookURL = v.WebhookURL
+	model.BroadcastURL = v.BroadcastURL
+	model.BroadcastDuration = v.BroadcastDuration
+	if err = dao.AlarmPlan.SavePlan(model); err != nil {
+		return nil, err
+	}
+
+	return "OK", nil
+}
+
+func (api *ApiServer) OnAlarmPlanRemove(v *AlarmPlan, _ http.ResponseWriter, _ *http.Request) (interface{}, error) {
+	if err := dao.AlarmPlan.DeletePlan(v.ID); err != nil {
+		return nil, err
+	}
+
+	return "OK", nil
+}
+
+func (api *ApiServer) OnAlarmPlanEnableSet(v *AlarmPlan, _ http.ResponseWriter, _ *http.Request) (interface{}, error) {
+	if _, err := dao.AlarmPlan.QueryPlan(v.ID); err != nil {
+		return nil, fmt.Errorf("报警预案不存在")
+	} else if err = dao.AlarmPlan.UpdateEnable(v.ID, v.Enable); err != nil {
+		return nil, err
+	}
+
+	return "OK", nil
+}
+
+func (api *ApiServer) OnAlarmPlanChannelList(q *QueryCascadeChannelList, w http.ResponseWriter, req *http.Request) (interface{}, error) {
+	response := struct {
+		ChannelCount       int                 `json:"ChannelCount"`
+		ChannelRelateCount int                 `json:"ChannelRelateCount"`
+		ChannelList        []*AlarmPlanChannel `json:"ChannelList"`
+	}{}
+
+	id, err := strconv.Atoi(q.ID)
+	if err != nil {
+		return nil, err
+	} else if _, err = dao.AlarmPlan.QueryPlan(id); err != nil {
+		return nil, fmt.Errorf("报警预案不存在")
+	}
+
+	if response.ChannelRelateCount, err = dao.AlarmPlan.QueryPlanChannelCount(id); err != nil {
+		return nil, err
+	}
+
+	// 只看已选择
+	if q.Related {
+		relations, err := dao.AlarmPlan.QueryPlanChannels(id)
+		if err != nil {
+			return nil, err
+		}
+
+		var list []*dao.ChannelModel
+		for _, relation := range relations {
+			if channel, err := dao.Channel.QueryChannel(relation.DeviceID, relation.ChannelID); err == nil {
+				list = append(list, channel)
+			}
+		}
+
+		response.ChannelCount = len(list)
+		for _, channel := range ChannelModels2LiveGBSChannels(q.Start+1, list, "") {
+			response.ChannelList = append(response.ChannelList, &AlarmPlanChannel{q.ID, channel})
+		}
+
+		return &response, nil
+	}
+
+	list, err := api.OnChannelList(&q.QueryDeviceChannel, w, req)
+	if err != nil {
+		return nil, err
+	}
+
+	result := list.(*ChannelListResult)
+	response.ChannelCount = result.ChannelCount
+	for _, channel := range result.ChannelList {
+		var planId string
+		if exist, _ := dao.AlarmPlan.QueryPlanChannelExist(id, channel.DeviceID, channel.ID); exist {
+			planId = q.ID
+		}
+
+		response.ChannelList = append(response.ChannelList, &AlarmPlanChannel{planId, channel})
+	}
+
+	return &response, nil
+}
+
+func (api *ApiServer) OnAlarmPlanChannelBind(w http.ResponseWriter, r *http.Request) {
+	api.doAlarmPlanChannels(w, r, dao.AlarmPlan.BindChannels)
+}
+
+func (api *ApiServer) OnAlarmPlanChannelUnbind(w http.ResponseWriter, r *http.Request) {
+	api.doAlarmPlanChannels(w, r, dao.AlarmPlan.UnbindChannels)
+}
+
+func (api *ApiServer) doAlarmPlanChannels(w http.ResponseWriter, r *http.Request, cb func(int, []string) error) {
+	idStr := r.FormValue("id")
+	channels := r.Form["channels[]"]
+
+	var err error
+	id, _ := strconv.Atoi(idStr)
+	_, err = dao.AlarmPlan.QueryPlan(id)
+	if err == nil {
+		err = cb(id, channels)
+	}
+
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_ = common.HttpResponseJson(w, err.Error())
+	} else {
+		_ = common.HttpResponseJson(w, "OK")
+	}
+}
+
+func (api *ApiServer) OnAlarmPlanLogList(q *QueryAlarmPlanLog, _ http.ResponseWriter, _ *http.Request) (interface{}, error) {
+	if q.Limit < 1 {
+		q.Limit = 10
+	}
+
+	logs, total, err := dao.AlarmPlan.QueryLogs((q.Start/q.Limit)+1, q.Limit, q.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := struct {
+		LogCount int
+		LogList  []*dao.AlarmPlanLogModel
+	}{
+		LogCount: total,
+		LogList:  logs,
+	}
+
+	return &response, nil
+}
+
+// OnAlarmSnapUpload 设备上传报警联动的抓拍图片, 支持multipart和直接上传图片
+func (api *ApiServer) OnAlarmSnapUpload(w http.ResponseWriter, r *http.Request) {
+	session := mux.Vars(r)["session"]
+	path, ok := stack.AlarmPlanManager.SnapPath(session)
+	if !ok {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, maxSnapSize)
+	var reader io.Reader = r.Body
+	if file, _, err := r.FormFile("file"); err == nil {
+		defer file.Close()
+		reader = file
+	}
+
+	err := os.MkdirAll(stack.AlarmSnapDir, 0755)
+	var file *os.File
+	if err == nil {
+		file, err = os.Create(path)
+	}
+
+	if err == nil {
+		_, err = io.Copy(file, reader)
+		_ = file.Close()
+	}
+
+	if err != nil {
+		log.Sugar.Errorf("保存抓拍图片失败 err: %s session: %s", err.Error(), session)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	log.Sugar.Infof("保存抓拍图片 path: %s", path)
+	w.WriteHeader(http.StatusOK)
+}
@@ -0,0 +1,661 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"gb-cms/common"
+	"gb-cms/dao"
+	"gb-cms/log"
+	"gb-cms/stack"
+	"github.com/gorilla/mux"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type RecordPlan struct {
+	ID           int    `json:"ID"`
+	Name         string `json:"Name"`
+	Enable       bool   `json:"Enable"`
+	Plan         string `json:"Plan"` // 前端的时间段, [[{"s1":"08:00","e1":"12:00"}],...], 按星期一至星期日排列
+	ChannelCount int    `json:"ChannelCount"`
+	CreatedAt    string `json:"CreatedAt"`
+	UpdatedAt    string `json:"UpdatedAt"`
+}
+
+type RecordPlanChannel struct {
+	PlanID string
+	*LiveGBSChannel
+}
+
+// 前端时间转分钟, 例如"08:30"
+func planTime2Minutes(str string) (int, error) {
+	split := strings.Split(str, ":")
+	if len(split) != 2 {
+		return 0, fmt.Errorf("时间格式错误 %s", str)
+	}
+
+	hour, err := strconv.Atoi(split[0])
+	if err != nil {
+		return 0, err
+	}
+
+	minute, err := strconv.Atoi(split[1])
+	if err != nil {
+		return 0, err
+	}
+
+	return hour*60 + minute, nil
+}
+
+// Plan2Schedule 前端的时间段转为每半小时1bit的录像计划, 开始时间向前取整, 结束时间向后取整
+func Plan2Schedule(plan string) ([7]uint64, error) {
+	var schedule [7]uint64
+	var days [][]map[string]string
+	if plan == "" {
+		return schedule, nil
+	} else if err := json.Unmarshal([]byte(plan), &days); err != nil {
+		return schedule, err
+	}
+
+	for day := 0; day < len(days) && day < 7; day++ {
+		for _, duration := range days[day] {
+			start, err := planTime2Minutes(duration[fmt.Sprintf("s%d", day+1)])
+			if err != nil {
+				return schedule, err
+			}
+
+			end, err := planTime2Minutes(duration[fmt.Sprintf("e%d", day+1)])
+			if err != nil {
+				return schedule, err
+			}
+
+			for slot := start / 30; slot < (end+29)/30 && slot < dao.RecordPlanSlots; slot++ {
+				schedule[day] |= 1 << (dao.RecordPlanSlots - 1 - slot)
+			}
+		}
+	}
+
+	return schedule, nil
+}
+
+// Schedule2Plan 录像计划转为前端的时间段, 连续的刻度合并为一个时间段
+func Schedule2Plan(schedule [7]uint64) string {
+	format := func(slot int) string {
+		return fmt.Sprintf("%02d:%02d", slot/2, slot%2*30)
+	}
+
+	days := make([][]map[string]string, 7)
+	for day := 0; day < 7; day++ {
+		days[day] = []map[string]string{}
+		for slot := 0; slot < dao.RecordPlanSlots; slot++ {
+			if schedule[day]&(1<<(dao.RecordPlanSlots-1-slot)) == 0 {
+				continue
+			}
+
+			start := slot
+			for slot+1 < dao.RecordPlanSlots && schedule[day]&(1<<(dao.RecordPlanSlots-2-slot)) != 0 {
+				slot++
+			}
+
+			days[day] = append(days[day], map[string]string{
+				fmt.Sprintf("s%d", day+1): format(start),
+				fmt.Sprintf("e%d", day+1): format(slot + 1),
+			})
+		}
+	}
+
+	bytes, _ := json.Marshal(days)
+	return string(bytes)
+}
+
+func (api *ApiServer) OnRecordPlanList(q *QueryDeviceChannel, _ http.ResponseWriter, _ *http.Request) (interface{}, error) {
+	if q.Limit < 1 {
+		q.Limit = 10
+	}
+
+	plans, total, err := dao.RecordPlan.QueryPlans((q.Start/q.Limit)+1, q.Limit, q.Keyword)
+	if err != nil {
+		return nil, err
+	}
+
+	response := struct {
+		PlanCount int
+		PlanList  []*RecordPlan
+	}{
+		PlanCount: total,
+		PlanList:  []*RecordPlan{},
+	}
+
+	for _, plan := range plans {
+		count, _ := dao.RecordPlan.QueryPlanChannelCount(int(plan.ID))
+		response.PlanList = append(response.PlanList, &RecordPlan{
+			ID:           int(plan.ID),
+			Name:         plan.Name,
+			Enable:       plan.Enable,
+			Plan:         Schedule2Plan(plan.Schedule),
+			ChannelCount: count,
+			CreatedAt:    plan.CreatedAt.Format("2006-01-02 15:04:05"),
+			UpdatedAt:    plan.UpdatedAt.Format("2006-01-02 15:04:05"),
+		})
+	}
+
+	return &response, nil
+}
+
+func (api *ApiServer) OnRecordPlanSave(v *RecordPlan, _ http.ResponseWriter, _ *http.Request) (interface{}, error) {
+	log.Sugar.Debugf("保存录像计划 %v", *v)
+
+	schedule, err := Plan2Schedule(v.Plan)
+	if err != nil {
+		return nil, err
+	}
+
+	model := &dao.RecordPlanModel{}
+	if v.ID > 0 {
+		if model, err = dao.RecordPlan.QueryPlan(v.ID); err != nil {
+			return nil, fmt.Errorf("录像计划不存在")
+		}
+	}
+
+	model.Name = v.Name
+	model.Enable = v.Enable
+	model.Schedule = schedule
+	if err = dao.RecordPlan.SavePlan(model); err != nil {
+		return nil, err
+	}
+
+	return "OK", nil
+}
+
+func (api *ApiServer) OnRecordPlanRemove(v *RecordPlan, _ http.ResponseWriter, _ *http.Request) (interface{}, error) {
+	if err := dao.RecordPlan.DeletePlan(v.ID); err != nil {
+		return nil, err
+	}
+
+	return "OK", nil
+}
+
+func (api *ApiServer) OnRecordPlanEnableSet(v *RecordPlan, _ http.ResponseWriter, _ *http.Request) (interface{}, error) {
+	if _, err := dao.RecordPlan.QueryPlan(v.ID); err != nil {
+		return nil, fmt.Errorf("录像计划不存在")
+	} else if err = dao.RecordPlan.UpdateEnable(v.ID, v.Enable); err != nil {
+		return nil, err
+	}
+
+	return "OK", nil
+}
+
+func (api *ApiServer) OnRecordPlanChannelList(q *QueryCascadeChannelList, w http.ResponseWriter, req *http.Request) (interface{}, error) {
+	response := struct {
+		ChannelCount       int                  `json:"ChannelCount"`
+		ChannelRelateCount int                  `json:"ChannelRelateCount"`
+		ChannelList        []*RecordPlanChannel `json:"ChannelList"`
+	}{}
+
+	id, err := strconv.Atoi(q.ID)
+	if err != nil {
+		return nil, err
+	} else if _, err = dao.RecordPlan.QueryPlan(id); err != nil {
+		return nil, fmt.Errorf("录像计划不存在")
+	}
+
+	if response.ChannelRelateCount, err = dao.RecordPlan.QueryPlanChannelCount(id); err != nil {
+		return nil, err
+	}
+
+	// 只看已选择
+	if q.Related {
+		relations, err := dao.RecordPlan.QueryPlanChannels(id)
+		if err != nil {
+			return nil, err
+		}
+
+		var list []*dao.ChannelModel
+		for _, relation := range relations {
+			if channel, err := dao.Channel.QueryChannel(relation.DeviceID, relation.ChannelID); err == nil {
+				list = append(list, channel)
+			}
+		}
+
+		response.ChannelCount = len(list)
+		for _, channel := range ChannelModels2LiveGBSChannels(q.Start+1, list, "") {
+			response.ChannelList = append(response.ChannelList, &RecordPlanChannel{q.ID, channel})
+		}
+
+		return &response, nil
+	}
+
+	list, err := api.OnChannelList(&q.QueryDeviceChannel, w, req)
+	if err != nil {
+		return nil, err
+	}
+
+	result := list.(*ChannelListResult)
+	response.ChannelCount = result.ChannelCount
+	for _, channel := range result.ChannelList {
+		var planId string
+		if exist, _ := dao.RecordPlan.QueryPlanChannelExist(id, channel.DeviceID, channel.ID); exist {
+			planId = q.ID
+		}
+
+		response.ChannelList = append(response.ChannelList, &RecordPlanChannel{planId, channel})
+	}
+
+	return &response, nil
+}
+
+func (api *ApiServer) OnRecordPlanChannelBind(w http.ResponseWriter, r *http.Request) {
+	api.doRecordPlanChannels(w, r, dao.RecordPlan.BindChannels)
+}
+
+func (api *ApiServer) OnRecordPlanChannelUnbind(w http.ResponseWriter, r *http.Request) {
+	api.doRecordPlanChannels(w, r, dao.RecordPlan.UnbindChannels)
+}
+
+func (api *ApiServer) doRecordPlanChannels(w http.ResponseWriter, r *http.Request, cb func(int, []string) error) {
+	idStr := r.FormValue("id")
+	channels := r.Form["channels[]"]
+
+	var err error
+	id, _ := strconv.Atoi(idStr)
+	_, err = dao.RecordPlan.QueryPlan(id)
+	if err == nil {
+		err = cb(id, channels)
+	}
+
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_ = common.HttpResponseJson(w, err.Error())
+	} else {
+		_ = common.HttpResponseJson(w, "OK")
+	}
+}
+
+type QueryCloudRecord struct {
+	DeviceID  string `json:"serial"`
+	ChannelID string `json:"code"`
+	Period    string `json:"period"` // 按天查询为YYYYMMDD
+	StartTime string `json:"starttime"`
+	EndTime   string `json:"endtime"`
+	Sort      string `json:"sort"`
+	Order     string `json:"order"` // asc/desc, 兼容ascending/descending
+	Important bool   `json:"important"`
+	Days      int    `json:"days"`    // 录像保留天数
+	Refresh   bool   `json:"refresh"` // 忽略缓存, 重新查询设备录像
+}
+
+// CloudRecordChannel 有云端录像的通道
+type CloudRecordChannel struct {
+	DeviceID  string `json:"serial"`
+	ChannelID string `json:"code"`
+	Name      string `json:"name"`
+	SnapURL   string `json:"snap_url"`
+	Count     int    `json:"count"`
+	CreatedAt string `json:"created_at"` // 最早录像时间
+	UpdatedAt string `json:"updated_at"` // 最近录像时间
+}
+
+// CloudRecord 云端录像片段
+type CloudRecord struct {
+	DeviceID  string `json:"serial"`
+	ChannelID string `json:"code"`
+	Name      string `json:"name"`
+	StartAt   string `json:"startAt"`   // YYYYMMDDHHmmss
+	StartTime string `json:"StartTime"` // 与设备录像保持一致
+	EndTime   string `json:"EndTime"`
+	Duration  int64  `json:"duration"` // 单位秒
+	Size      int64  `json:"size"`
+	HLS       string `json:"hls"` // 播放地址, 兼容前端字段名
+	Download  string `json:"download"`
+	Important bool   `json:"important"`
+}
+
+// CloudRecordRange 合并后的连续录像时间段
+type CloudRecordRange struct {
+	StartTime string
+	EndTime   string
+}
+
+const (
+	cloudRecordStartAtFormat = "20060102150405"
+	cloudRecordTimeFormat    = "2006-01-02T15:04:05"
+)
+
+// CloudRecordFileUrl 返回录像文件的访问地址, 由本服务转发到流媒体服务
+func CloudRecordFileUrl(id uint) string {
+	return fmt.Sprintf("/api/v1/cloudrecord/file/%d/video.mp4", id)
+}
+
+func CloudRecordModel2CloudRecord(model *dao.CloudRecordModel, name string) *CloudRecord {
+	start := time.Unix(model.StartTime, 0)
+	fileUrl := CloudRecordFileUrl(model.ID)
+	return &CloudRecord{
+		DeviceID:  model.DeviceID,
+		ChannelID: model.ChannelID,
+		Name:      name,
+		StartAt:   start.Format(cloudRecordStartAtFormat),
+		StartTime: start.Format(cloudRecordTimeFormat),
+		EndTime:   time.Unix(model.EndTime, 0).Format(cloudRecordTimeFormat),
+		Duration:  model.Duration(),
+		Size:      model.Size,
+		HLS:       fileUrl,
+		Download:  fileUrl + "?download=1",
+		Important: model.Important,
+	}
+}
+
+// MergeCloudRecords 合并相邻的录像片段, 间隔不超过gap秒视为连续. records需按开始时间升序
+func MergeCloudRecords(records []*dao.CloudRecordModel, gap int64) [][2]int64 {
+	var ranges [][2]int64
+	for _, record := range records {
+		if n := len(ranges); n > 0 && record.StartTime-ranges[n-1][1] <= gap {
+			if record.EndTime > ranges[n-1][1] {
+				ranges[n-1][1] = record.EndTime
+			}
+			continue
+		}
+
+		ranges = append(ranges, [2]int64{record.StartTime, record.EndTime})
+	}
+
+	return ranges
+}
+
+func (q *QueryCloudRecord) desc() bool {
+	return strings.HasPrefix(q.Order, "desc")
+}
+
+// 解析查询的时间范围, period优先
+func (q *QueryCloudRecord) timeRange() (time.Time, time.Time, error) {
+	if q.Period != "" {
+		day, err := time.ParseInLocation("20060102", q.Period, time.Local)
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("日期格式错误 %s", q.Period)
+		}
+
+		return day, day.AddDate(0, 0, 1), nil
+	}
+
+	start, err := time.ParseInLocation(cloudRecordTimeFormat, q.StartTime, time.Local)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("开始时间格式错误 %s", q.StartTime)
+	}
+
+	end, err := time.ParseInLocation(cloudRecordTimeFormat, q.EndTime, time.Local)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("结束时间格式错误 %s", q.EndTime)
+	} else if !end.After(start) {
+		return time.Time{}, time.Time{}, fmt.Errorf("结束时间必须大于开始时间")
+	}
+
+	return start, end, nil
+}
+
+func (api *ApiServer) OnCloudRecordChannelList(q *QueryDeviceChannel, _ http.ResponseWriter, _ *http.Request) (interface{}, error) {
+	if q.Limit < 1 {
+		q.Limit = 10
+	}
+
+	channels, total, err := dao.CloudRecord.QueryChannels((q.Start/q.Limit)+1, q.Limit, q.Keyword, q.Order != "asc")
+	if err != nil {
+		return nil, err
+	}
+
+	response := struct {
+		Rows  []*CloudRecordChannel `json:"rows"`
+		Total int                   `json:"total"`
+	}{
+		Rows:  []*CloudRecordChannel{},
+		Total: total,
+	}
+
+	for _, channel := range channels {
+		row := &CloudRecordChannel{
+			DeviceID:  channel.DeviceID,
+			ChannelID: channel.ChannelID,
+			Count:     channel.Count,
+			CreatedAt: time.Unix(channel.StartTime, 0).Format("2006-01-02 15:04:05"),
+			UpdatedAt: time.Unix(channel.EndTime, 0).Format("2006-01-02 15:04:05"),
+		}
+
+		if model, _ := dao.Channel.QueryChannel(channel.DeviceID, channel.ChannelID); model != nil {
+			row.Name = model.Name
+		}
+
+		response.Rows = append(response.Rows, row)
+	}
+
+	return &response, nil
+}
+
+// OnCloudRecordList 查询时间范围内的录像片段
+func (api *ApiServer) OnCloudRecordList(q *QueryCloudRecord, _ http.ResponseWriter, _ *http.Request) (interface{}, error) {
+	start, end, err := q.timeRange()
+	if err != nil {
+		return nil, err
+	}
+
+	records, err := dao.CloudRecord.QueryRecords(q.DeviceID, q.ChannelID, start, end, q.desc())
+	if err != nil {
+		return nil, err
+	}
+
+	name, _ := dao.Channel.QueryChannelName(q.DeviceID, q.ChannelID)
+	response := struct {
+		Name   string         `json:"name"`
+		OSD    string         `json:"osd"`
+		Shared bool           `json:"shared"`
+		List   []*CloudRecord `json:"list"`
+	}{
+		Name: name,
+		List: []*CloudRecord{},
+	}
+
+	for _, record := range records {
+		response.List = append(response.List, CloudRecordModel2CloudRecord(record, name))
+	}
+
+	return &response, nil
+}
+
+// OnCloudRecordTimeline 查询时间范围内合并后的录像时间轴
+func (api *ApiServer) OnCloudRecordTimeline(q *QueryCloudRecord, _ http.ResponseWriter, _ *http.Request) (interface{}, error) {
+	start, end, err := q.timeRange()
+	if err != nil {
+		return nil, err
+	}
+
+	records, err := dao.CloudRecord.QueryRecords(q.DeviceID, q.ChannelID, start, end, false)
+	if err != nil {
+		return nil, err
+	}
+
+	timeline := []*CloudRecordRange{}
+	for _, r := range MergeCloudRecords(records, 1) {
+		timeline = append(timeline, &CloudRecordRange{
+			StartTime: time.Unix(r[0], 0).Format(cloudRecordTimeFormat),
+			EndTime:   time.Unix(r[1], 0).Format(cloudRecordTimeFormat),
+		})
+	}
+
+	return timeline, nil
+}
+
+// OnCloudRecordFlags 按月返回每天是否有录像, 例如{"202410": "0110..."}
+func (api *ApiServer) OnCloudRecordFlags(q *QueryCloudRecord, _ http.ResponseWriter, _ *http.Request) (interface{}, error) {
+	times, err := dao.CloudRecord.QueryStartTimes(q.DeviceID, q.ChannelID)
+	if err != nil {
+		return nil, err
+	}
+
+	months := make(map[string][]byte, 12)
+	for _, t := range times {
+		day := time.Unix(t, 0)
+		month := day.Format("200601")
+		flags, ok := months[month]
+		if !ok {
+			flags = []byte(strings.Repeat("0", 31))
+			months[month] = flags
+		}
+
+		flags[day.Day()-1] = '1'
+	}
+
+	response := make(map[string]string, len(months))
+	for month, flags := range months {
+		response[month] = string(flags)
+	}
+
+	return response, nil
+}
+
+// OnCloudRecordDownload 返回录像片段的下载地址, 路径参数为通道和录像开始时间
+func (api *ApiServer) OnCloudRecordDownload(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	start, err := time.ParseInLocation(cloudRecordStartAtFormat, vars["start"], time.Local)
+	var record *dao.CloudRecordModel
+	if err == nil {
+		record, err = dao.CloudRecord.QueryRecordByStartTime(vars["device"], vars["channel"], start.Unix())
+	}
+
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_ = common.HttpResponseJson(w, "录像不存在")
+		return
+	}
+
+	_ = common.HttpResponseJson(w, CloudRecordFileUrl(record.ID)+"?download=1")
+}
+
+// OnCloudRecordFile 转发到流媒体服务读取录像文件
+func (api *ApiServer) OnCloudRecordFile(w http.ResponseWriter, r *http.Request) {
+	id, _ := strconv.Atoi(mux.Vars(r)["id"])
+	record, err := dao.CloudRecord.QueryRecord(id)
+	if err != nil {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	values := url.Values{}
+	values.Set("path", record.Path)
+	if r.URL.Query().Get("download") != "" {
+		values.Set("download", "1")
+	}
+
+	r.URL.RawQuery = values.Encode()
+	common.HttpForwardTo("/api/v1/record/file", w, r)
+}
+
+// 根据通道和录像开始时间(YYYYMMDDHHmmss)查询录像片段
+func (q *QueryCloudRecord) record() (*dao.CloudRecordModel, error) {
+	start, err := time.ParseInLocation(cloudRecordStartAtFormat, q.Period, time.Local)
+	if err != nil {
+		return nil, fmt.Errorf("录像时间格式错误 %s", q.Period)
+	}
+
+	record, err := dao.CloudRecord.QueryRecordByStartTime(q.DeviceID, q.ChannelID, start.Unix())
+	if err != nil {
+		return nil, fmt.Errorf("录像不存在")
+	}
+
+	return record, nil
+}
+
+// OnCloudRecordImportantSet 锁定/解锁录像片段, 锁定的录像不会被自动清理
+func (api *ApiServer) OnCloudRecordImportantSet(q *QueryCloudRecord, _ http.ResponseWriter, _ *http.Request) (interface{}, error) {
+	record, err := q.record()
+	if err != nil {
+		return nil, err
+	} else if err = dao.CloudRecord.UpdateImportant(record.ID, q.Important); err != nil {
+		return nil, err
+	}
+
+	return "OK", nil
+}
+
+// 删除录像片段, 跳过锁定的录像
+func removeCloudRecords(records []*dao.CloudRecordModel) error {
+	var list []*dao.CloudRecordModel
+	for _, record := range records {
+		if !record.Important {
+			list = append(list, record)
+		}
+	}
+
+	_, err := stack.DeleteCloudRecords(list)
+	return err
+}
+
+func (api *ApiServer) OnCloudRecordRemove(q *QueryCloudRecord, _ http.ResponseWriter, _ *http.Request) (interface{}, error) {
+	record, err := q.record()
+	if err != nil {
+		return nil, err
+	} else if record.Important {
+		return nil, fmt.Errorf("录像已锁定, 请先取消标记")
+	} else if err = removeCloudRecords([]*dao.CloudRecordModel{record}); err != nil {
+		return nil, err
+	}
+
+	return "OK", nil
+}
+
+// OnCloudRecordDailyRemove 删除通道某天的录像
+func (api *ApiServer) OnCloudRecordDailyRemove(q *QueryCloudRecord, _ http.ResponseWriter, _ *http.Request) (interface{}, error) {
+	start, end, err := q.timeRange()
+	if err != nil {
+		return nil, err
+	}
+
+	records, err := dao.CloudRecord.QueryRecords(q.DeviceID, q.ChannelID, start, end, false)
+	if err != nil {
+		return nil, err
+	} else if err = removeCloudRecords(records); err != nil {
+		return nil, err
+	}
+
+	return "OK", nil
+}
+
+// OnCloudRecordChannelRemove 删除通道的所有录像
+func (api *ApiServer) OnCloudRecordChannelRemove(q *QueryCloudRecord, _ http.ResponseWriter, _ *http.Request) (interface{}, error) {
+	records, err := dao.CloudRecord.QueryRecords(q.DeviceID, q.ChannelID, time.Unix(0, 0), time.Now().AddDate(1, 0, 0), false)
+	if err != nil {
+		return nil, err
+	} else if err = removeCloudRecords(records); err != nil {
+		return nil, err
+	}
+
+	return "OK", nil
+}
+
+// OnCloudRecordReserveDaysGet 查询通道的录像保留天数, 未单独设置返回全局配置
+func (api *ApiServer) OnCloudRecordReserveDaysGet(q *QueryCloudRecord, _ http.ResponseWriter, _ *http.Request) (interface{}, error) {
+	response := struct {
+		Days   int  `json:"days"`
+		Global bool `json:"global"` // 是否使用全局配置
+	}{
+		Days:   common.Config.RecordReserveDays,
+		Global: true,
+	}
+
+	if retention, _ := dao.CloudRecord.QueryRetention(q.DeviceID, q.ChannelID); retention != nil {
+		response.Days = retention.ReserveDays
+		response.Global = false
+	}
+
+	return &response, nil
+}
+
+// OnCloudRecordReserveDaysSet 设置通道的录像保留天数, 小于1恢复使用全局配置
+func (api *ApiServer) OnCloudRecordReserveDaysSet(q *QueryCloudRecord, _ http.ResponseWriter, _ *http.Request) (interface{}, error) {
+	if _, err := dao.Channel.QueryChannel(q.DeviceID, q.ChannelID); err != nil {
+		return nil, fmt.Errorf("通道不存在")
+	} else if err = dao.CloudRecord.SaveRetention(q.DeviceID, q.ChannelID, q.Days); err != nil {
+		return nil, err
+	}
+
+	return "OK", nil
+}
@@ -0,0 +1,102 @@
+package api
+
+import (
+	"testing"
+)
+
+func TestPlan2Schedule(t *testing.T) {
+	tests := []struct {
+		name    string
+		plan    string
+		want    [7]uint64
+		wantErr bool
+	}{
+		{name: "empty", plan: ""},
+		{name: "no duration", plan: `[[],[],[],[],[],[],[]]`},
+		{
+			name: "monday all day",
+			plan: `[[{"s1":"00:00","e1":"24:00"}],[],[],[],[],[],[]]`,
+			want: [7]uint64{1<<48 - 1},
+		},
+		{
+			name: "tuesday first slot",
+			plan: `[[],[{"s2":"00:00","e2":"00:30"}],[],[],[],[],[]]`,
+			want: [7]uint64{0, 1 << 47},
+		},
+		{
+			name: "sunday last slot",
+			plan: `[[],[],[],[],[],[],[{"s7":"23:30","e7":"24:00"}]]`,
+			want: [7]uint64{6: 1},
+		},
+		{
+			name: "exact half hour",
+			plan: `[[],[],[{"s3":"08:00","e3":"08:30"}],[],[],[],[]]`,
+			want: [7]uint64{2: 1 << 31},
+		},
+		{
+			// 开始时间向前取整, 结束时间向后取整
+			name: "round to half hour",
+			plan: `[[],[],[{"s3":"08:10","e3":"09:10"}],[],[],[],[]]`,
+			want: [7]uint64{2: 1<<31 | 1<<30 | 1<<29},
+		},
+		{
+			name: "multiple durations",
+			plan: `[[{"s1":"00:00","e1":"01:00"},{"s1":"23:00","e1":"24:00"}],[],[],[],[],[],[]]`,
+			want: [7]uint64{1<<47 | 1<<46 | 1<<1 | 1},
+		},
+		{
+			name: "extra days ignored",
+			plan: `[[],[],[],[],[],[],[],[{"s8":"00:00","e8":"24:00"}]]`,
+		},
+		{name: "invalid time", plan: `[[{"s1":"0800","e1":"09:00"}]]`, wantErr: true},
+		{name: "key of other day", plan: `[[{"s2":"08:00","e2":"09:00"}]]`, wantErr: true},
+		{name: "invalid json", plan: `{}`, wantErr: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := Plan2Schedule(test.plan)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("Plan2Schedule(%s) err = %v, wantErr %v", test.plan, err, test.wantErr)
+			} else if !test.wantErr && got != test.want {
+				t.Errorf("Plan2Schedule(%s) = %b, want %b", test.plan, got, test.want)
+			}
+		})
+	}
+}
+
+func TestSchedule2Plan(t *testing.T) {
+	tests := []struct {
+		name     string
+		schedule [7]uint64
+		want     string
+	}{
+		{"empty", [7]uint64{}, `[[],[],[],[],[],[],[]]`},
+		{"monday all day", [7]uint64{1<<48 - 1}, `[[{"e1":"24:00","s1":"00:00"}],[],[],[],[],[],[]]`},
+		{"tuesday first slot", [7]uint64{0, 1 << 47}, `[[],[{"e2":"00:30","s2":"00:00"}],[],[],[],[],[]]`},
+		{"sunday last slot", [7]uint64{6: 1}, `[[],[],[],[],[],[],[{"e7":"24:00","s7":"23:30"}]]`},
+		{
+			"split durations",
+			[7]uint64{2: 1<<31 | 1<<30 | 1<<27},
+			`[[],[],[{"e3":"09:00","s3":"08:00"},{"e3":"10:30","s3":"10:00"}],[],[],[],[]]`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := Schedule2Plan(test.schedule); got != test.want {
+				t.Errorf("Schedule2Plan(%b) = %s, want %s", test.schedule, got, test.want)
+			}
+		})
+	}
+}
+
+func TestScheduleRoundTrip(t *testing.T) {
+	plan := `[[{"e1":"12:00","s1":"08:00"},{"e1":"18:30","s1":"13:30"}],[],[{"e3":"24:00","s3":"00:00"}],[],[],[{"e6":"00:30","s6":"00:00"}],[{"e7":"24:00","s7":"23:30"}]]`
+	schedule, err := Plan2Schedule(plan)
+	if err != nil {
+		t.Fatal(err)
+	} else if got := Schedule2Plan(schedule); got != plan {
+		t.Errorf("round trip = %s, want %s", got, plan)
+	}
+}
@@ -1,7 +1,6 @@
 package api
 
 import (
-	"context"
 	"fmt"
 	"gb-cms/common"
 	"gb-cms/dao"
@@ -110,7 +109,7 @@ func (api *ApiServer) OnDeviceList(q *QueryDeviceChannel, _ http.ResponseWriter,
 			RemoteRegion:       device.RemoteRegion,
 			SMSGroupID:         "",
 			SMSID:              "",
-			StreamMode:         "",
+			StreamMode:         string(device.StreamMode),
 			SubscribeInterval:  0,
 			Type:               "GB",
 			UpdatedAt:          device.UpdatedAt.Format("2006-01-02 15:04:05"),
@@ -173,35 +172,15 @@ func (api *ApiServer) OnRecordList(v *QueryRecordParams, _ http.ResponseWriter,
 		return nil, fmt.Errorf("设备离线")
 	}
 
+	// 设置查询超时时长
+	timeout := time.Duration(math.Max(5, math.Min(float64(v.Timeout), 60))) * time.Second
 	device := &stack.Device{DeviceModel: model}
-	sn := stack.GetSN()
-	err := device.QueryRecord(v.ChannelID, v.StartTime, v.EndTime, sn, "all")
+	recordList, complete, err := device.QueryRecordListWithCache(v.ChannelID, v.StartTime, v.EndTime, v.Refresh, timeout, time.Minute)
 	if err != nil {
-		log.Sugar.Errorf("发送查询录像请求失败 err: %s", err.Error())
+		log.Sugar.Errorf("查询录像失败 err: %s device: %s channel: %s", err.Error(), v.DeviceID, v.ChannelID)
 		return nil, err
-	}
-
-	// 设置查询超时时长
-	timeout := int(math.Max(math.Min(5, float64(v.Timeout)), 60))
-	withTimeout, cancelFunc := context.WithTimeout(context.Background(), time.Duration(timeout)*time.Second)
-
-	var recordList []stack.RecordInfo
-	stack.SNManager.AddEvent(sn, func(data interface{}) {
-		response := data.(*stack.QueryRecordInfoResponse)
-
-		if len(response.DeviceList.Devices) > 0 {
-			recordList = append(recordList, response.DeviceList.Devices...)
-		}
-
-		// 所有记录响应完毕
-		if len(recordList) >= response.SumNum {
-			cancelFunc()
-		}
-	})
-
-	select {
-	case _ = <-withTimeout.Done():
-		break
+	} else if !complete {
+		log.Sugar.Warnf("查询录像未完整响应 device: %s channel: %s count: %d", v.DeviceID, v.ChannelID, len(recordList))
 	}
 
 	response := struct {
@@ -247,6 +226,101 @@ func (api *ApiServer) OnRecordList(v *QueryRecordParams, _ http.ResponseWriter,
 	return &response, nil
 }
 
+// RecordTimelineSpan 时间轴上的一段录像或空白
+type RecordTimelineSpan struct {
+	StartTime string
+	EndTime   string
+	Sources   []string `json:",omitempty"` // device/nvr/center/cloud
+}
+
+// OnRecordTimeline 查询通道某天的录像时间轴, 合并设备录像和云端录像
+func (api *ApiServer) OnRecordTimeline(q *QueryCloudRecord, _ http.ResponseWriter, _ *http.Request) (interface{}, error) {
+	start, end, err := q.timeRange()
+	if err != nil {
+		return nil, err
+	}
+
+	// 当天只统计到当前时间
+	if now := time.Now(); end.After(now) {
+		end = now
+	}
+
+	name, _ := dao.Channel.QueryChannelName(q.DeviceID, q.ChannelID)
+	response := struct {
+		DeviceID    string
+		ChannelID   string
+		Name        string
+		Complete    bool   // 设备录像是否查询完整
+		DeviceError string // 设备离线或查询失败的原因, 此时只返回云端录像
+		Spans       []*RecordTimelineSpan
+		Gaps        []*RecordTimelineSpan
+	}{
+		DeviceID:  q.DeviceID,
+		ChannelID: q.ChannelID,
+		Name:      name,
+		Spans:     []*RecordTimelineSpan{},
+		Gaps:      []*RecordTimelineSpan{},
+	}
+
+	var spans []stack.RecordSpan
+	// 设备录像
+	if model, _ := dao.Device.QueryDevice(q.DeviceID); model == nil || !model.Online() {
+		response.DeviceError = "设备离线"
+	} else {
+		device := &stack.Device{DeviceModel: model}
+		records, complete, err := device.QueryRecordListWithCache(q.ChannelID, start.Format(cloudRecordTimeFormat), end.Format(cloudRecordTimeFormat), q.Refresh, 5*time.Second, time.Minute)
+		if err != nil {
+			response.DeviceError = err.Error()
+		}
+
+		response.Complete = complete
+		for i := range records {
+			recordStart, err := stack.ParseRecordTime(records[i].StartTime)
+			if err != nil {
+				continue
+			}
+
+			recordEnd, err := stack.ParseRecordTime(records[i].EndTime)
+			if err != nil {
+				continue
+			}
+
+			spans = append(spans, stack.RecordSpan{Start: recordStart.Unix(), End: recordEnd.Unix(), Sources: []string{stack.RecordSource(&records[i])}})
+		}
+	}
+
+	// 云端录像
+	cloudRecords, err := dao.CloudRecord.QueryRecords(q.DeviceID, q.ChannelID, start, end, false)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, record := range cloudRecords {
+		spans = append(spans, stack.RecordSpan{Start: record.StartTime, End: record.EndTime, Sources: []string{stack.RecordSourceCloud}})
+	}
+
+	// 截取到查询范围内
+	for i := range spans {
+		spans[i].Start = max(spans[i].Start, start.Unix())
+		spans[i].End = min(spans[i].End, end.Unix())
+	}
+
+	format := func(t int64) string {
+		return time.Unix(t, 0).Format(cloudRecordTimeFormat)
+	}
+
+	merged := stack.MergeRecordSpans(spans, 1)
+	for _, span := range merged {
+		response.Spans = append(response.Spans, &RecordTimelineSpan{StartTime: format(span.Start), EndTime: format(span.End), Sources: span.Sources})
+	}
+
+	for _, gap := range stack.RecordGaps(merged, start.Unix(), end.Unix()) {
+		response.Gaps = append(response.Gaps, &RecordTimelineSpan{StartTime: format(gap[0]), EndTime: format(gap[1])})
+	}
+
+	return &response, nil
+}
+
 func (api *ApiServer) OnDeviceMediaTransportSet(req *SetMediaTransportReq, _ http.ResponseWriter, _ *http.Request) (interface{}, error) {
 	var setupType common.SetupType
 	if "udp" == strings.ToLower(req.MediaTransport) {
@@ -335,7 +409,14 @@ func (api *ApiServer) OnDeviceTree(q *QueryDeviceChannel, _ http.ResponseWriter,
 
 func (api *ApiServer) OnDeviceRemove(q *DeleteDevice, _ http.ResponseWriter, _ *http.Request) (interface{}, error) {
 	var err error
-	if q.IP != "" {
+	if q.IP == "" && q.UA == "" && stack.IsProxyDevice(q.DeviceID) {
+		return nil, fmt.Errorf("拉流代理的虚拟设备不能删除")
+	} else if q.UA == stack.OnvifUserAgent {
+		return nil, fmt.Errorf("请逐个删除ONVIF设备")
+	} else if onvif, _ := dao.Onvif.QueryOnvifDeviceByDeviceID(q.DeviceID); q.IP == "" && q.UA == "" && onvif != nil {
+		// 删除ONVIF设备和通道
+		err = stack.RemoveOnvifDevice(onvif)
+	} else if q.IP != "" {
 		// 删除IP下的所有设备
 		err = dao.Device.DeleteDevicesByIP(q.IP)
 	} else if q.UA != "" {
@@ -377,6 +458,24 @@ func (api *ApiServer) OnDeviceInfoSet(params *DeviceInfo, w http.ResponseWriter,
 		conditions["catalog_interval"] = params.CatalogInterval
 	}
 
+	// 取流超时时间, 0-使用全局配置
+	if params.InviteTimeout != model.InviteTimeout && params.InviteTimeout >= 0 {
+		conditions["invite_timeout"] = params.InviteTimeout
+	}
+
+	if params.AckTimeout != model.AckTimeout && params.AckTimeout >= 0 {
+		conditions["ack_timeout"] = params.AckTimeout
+	}
+
+	if params.FirstPacketTimeout != model.FirstPacketTimeout && params.FirstPacketTimeout >= 0 {
+		conditions["first_packet_timeout"] = params.FirstPacketTimeout
+	}
+
+	// 默认码流, 为空由设备决定
+	if params.StreamMode != string(model.StreamMode) {
+		conditions["stream_mode"] = params.StreamMode
+	}
+
 	if model.CatalogSubscribe != params.CatalogSubscribe {
 		conditions["catalog_subscribe"] = params.CatalogSubscribe
 		// 开启目录订阅
@@ -439,6 +538,16 @@ func (api *ApiServer) OnPTZControl(v *QueryRecordParams, _ http.ResponseWriter,
 		return nil, fmt.Errorf("设备离线")
 	}
 
+	// ONVIF设备使用ONVIF云台服务控制
+	if stack.IsOnvifDevice(model) {
+		if err := stack.OnvifControlPTZ(v.DeviceID, v.ChannelID, v.Command); err != nil {
+			log.Sugar.Errorf("ONVIF云台控制失败 err: %s device: %s channel: %s", err.Error(), v.DeviceID, v.ChannelID)
+			return nil, err
+		}
+
+		return "OK", nil
+	}
+
 	device := &stack.Device{DeviceModel: model}
 	device.ControlPTZ(v.Command, v.ChannelID)
 
@@ -467,3 +576,35 @@ func (api *ApiServer) OnStatusLogList(q *QueryDeviceChannel, _ http.ResponseWrit
 	v.LogList = logList
 	return &v, nil
 }
+
+func (api *ApiServer) OnRecordPrefetchList(_ *Empty, _ http.ResponseWriter, _ *http.Request) (interface{}, error) {
+	prefetches, err := dao.RecordCache.QueryPrefetches()
+	if err != nil {
+		return nil, err
+	}
+
+	type prefetch struct {
+		DeviceID  string
+		ChannelID string
+		Name      string
+	}
+
+	list := []*prefetch{}
+	for _, model := range prefetches {
+		name, _ := dao.Channel.QueryChannelName(model.DeviceID, model.ChannelID)
+		list = append(list, &prefetch{model.DeviceID, model.ChannelID, name})
+	}
+
+	return list, nil
+}
+
+// OnRecordPrefetchSet 设置通道是否在夜间预取前一天的录像
+func (api *ApiServer) OnRecordPrefetchSet(v *QueryRecordParams, _ http.ResponseWriter, _ *http.Request) (interface{}, error) {
+	if _, err := dao.Channel.QueryChannel(v.DeviceID, v.ChannelID); err != nil {
+		return nil, fmt.Errorf("通道不存在")
+	} else if err = dao.RecordCache.SavePrefetch(v.DeviceID, v.ChannelID, v.Enable); err != nil {
+		return nil, err
+	}
+
+	return "OK", nil
+}
@@ -0,0 +1,199 @@
+package api
+
+import (
+	"fmt"
+	"gb-cms/common"
+	"gb-cms/dao"
+	"gb-cms/log"
+	"gb-cms/stack"
+	"github.com/gorilla/mux"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+type DownloadJobParams struct {
+	ID     int    `json:"id"`
+	Start  int    `json:"start"`
+	Limit  int    `json:"limit"`
+	Q      string `json:"q"`
+	Status string `json:"status"` // waiting/downloading/merging/completed/failed/canceled
+}
+
+// DownloadJob 下载任务
+type DownloadJob struct {
+	*dao.DownloadJobModel
+	ID        uint   `json:"id"`
+	Url       string `json:"url"` // 下载完成后的文件地址
+	CreatedAt string `json:"created_at"`
+}
+
+func DownloadJobModel2DownloadJob(model *dao.DownloadJobModel) *DownloadJob {
+	job := &DownloadJob{
+		DownloadJobModel: model,
+		ID:               model.ID,
+		CreatedAt:        model.CreatedAt.Format("2006-01-02 15:04:05"),
+	}
+
+	if model.Status == dao.DownloadStatusCompleted {
+		job.Url = fmt.Sprintf("/api/v1/download/file/%d/video.mp4", model.ID)
+	}
+
+	return job
+}
+
+// OnDownloadJobCreate 创建下载任务, 由后台调度下载
+func (api *ApiServer) OnDownloadJobCreate(v *InviteParams, _ http.ResponseWriter, _ *http.Request) (interface{}, error) {
+	log.Sugar.Infof("创建下载任务 %v", *v)
+
+	startTime, err := time.ParseInLocation(stack.RecordTimeFormat, v.StartTime, time.Local)
+	if err != nil {
+		return nil, fmt.Errorf("开始时间格式错误 %s", v.StartTime)
+	}
+
+	endTime, err := time.ParseInLocation(stack.RecordTimeFormat, v.EndTime, time.Local)
+	if err != nil {
+		return nil, fmt.Errorf("结束时间格式错误 %s", v.EndTime)
+	} else if !endTime.After(startTime) {
+		return nil, fmt.Errorf("结束时间必须大于开始时间")
+	}
+
+	device, _ := dao.Device.QueryDevice(v.DeviceID)
+	if device == nil {
+		return nil, fmt.Errorf("设备不存在")
+	}
+
+	channel, err := dao.Channel.QueryChannel(v.DeviceID, v.ChannelID)
+	if err != nil {
+		return nil, fmt.Errorf("通道不存在")
+	}
+
+	streamNumber := v.StreamNumber(device)
+	streamId := common.GenerateStreamIDWithNumber(common.InviteTypeDownload, v.DeviceID, v.ChannelID, v.StartTime, v.EndTime, streamNumber)
+	if job, _ := dao.DownloadJob.QueryJobByStreamID(string(streamId)); job != nil {
+		return nil, fmt.Errorf("相同时间段的下载任务已存在 id: %d", job.ID)
+	}
+
+	speed, _ := strconv.Atoi(v.Speed)
+	if speed < 1 {
+		speed = 4
+	}
+
+	job := &dao.DownloadJobModel{
+		DeviceID:     v.DeviceID,
+		ChannelID:    v.ChannelID,
+		Name:         channel.Name,
+		StartTime:    v.StartTime,
+		EndTime:      v.EndTime,
+		Speed:        speed,
+		StreamNumber: streamNumber,
+		StreamID:     string(streamId),
+		Status:       dao.DownloadStatusWaiting,
+	}
+
+	if err = dao.DownloadJob.Save(job); err != nil {
+		return nil, err
+	}
+
+	return DownloadJobModel2DownloadJob(job), nil
+}
+
+func (api *ApiServer) OnDownloadJobList(v *DownloadJobParams, _ http.ResponseWriter, _ *http.Request) (interface{}, error) {
+	if v.Limit < 1 {
+		v.Limit = 10
+	}
+
+	jobs, total, err := dao.DownloadJob.QueryJobs((v.Start/v.Limit)+1, v.Limit, v.Q, v.Status)
+	if err != nil {
+		return nil, err
+	}
+
+	response := struct {
+		Total int            `json:"total"`
+		Rows  []*DownloadJob `json:"rows"`
+	}{
+		Total: total,
+		Rows:  []*DownloadJob{},
+	}
+
+	for _, job := range jobs {
+		response.Rows = append(response.Rows, DownloadJobModel2DownloadJob(job))
+	}
+
+	return &response, nil
+}
+
+func (api *ApiServer) OnDownloadJobInfo(v *DownloadJobParams, _ http.ResponseWriter, _ *http.Request) (interface{}, error) {
+	job, err := dao.DownloadJob.QueryJob(v.ID)
+	if err != nil {
+		return nil, fmt.Errorf("下载任务不存在")
+	}
+
+	return DownloadJobModel2DownloadJob(job), nil
+}
+
+func (api *ApiServer) OnDownloadJobCancel(v *DownloadJobParams, _ http.ResponseWriter, _ *http.Request) (interface{}, error) {
+	job, err := dao.DownloadJob.QueryJob(v.ID)
+	if err != nil {
+		return nil, fmt.Errorf("下载任务不存在")
+	} else if !job.Active() {
+		return nil, fmt.Errorf("下载任务已结束")
+	} else if err = stack.DownloadJobManager.Cancel(job); err != nil {
+		return nil, err
+	}
+
+	return "OK", nil
+}
+
+// OnDownloadJobRetry 重新下载失败或已取消的任务
+func (api *ApiServer) OnDownloadJobRetry(v *DownloadJobParams, _ http.ResponseWriter, _ *http.Request) (interface{}, error) {
+	job, err := dao.DownloadJob.QueryJob(v.ID)
+	if err != nil {
+		return nil, fmt.Errorf("下载任务不存在")
+	} else if job.Status != dao.DownloadStatusFailed && job.Status != dao.DownloadStatusCanceled {
+		return nil, fmt.Errorf("只能重试失败或已取消的任务")
+	} else if old, _ := dao.DownloadJob.QueryJobByStreamID(job.StreamID); old != nil {
+		return nil, fmt.Errorf("相同时间段的下载任务已存在 id: %d", old.ID)
+	}
+
+	job.Status = dao.DownloadStatusWaiting
+	job.Retries = 0
+	job.NextRetryAt = time.Time{}
+	job.Error = ""
+	if err = dao.DownloadJob.Save(job); err != nil {
+		return nil, err
+	}
+
+	return "OK", nil
+}
+
+// OnDownloadJobRemove 删除已结束的任务
+func (api *ApiServer) OnDownloadJobRemove(v *DownloadJobParams, _ http.ResponseWriter, _ *http.Request) (interface{}, error) {
+	job, err := dao.DownloadJob.QueryJob(v.ID)
+	if err != nil {
+		return nil, fmt.Errorf("下载任务不存在")
+	} else if job.Active() {
+		return nil, fmt.Errorf("请先取消下载任务")
+	} else if err = dao.DownloadJob.DeleteJob(v.ID); err != nil {
+		return nil, err
+	}
+
+	return "OK", nil
+}
+
+// OnDownloadJobFile 转发到流媒体服务读取下载完成的文件
+func (api *ApiServer) OnDownloadJobFile(w http.ResponseWriter, r *http.Request) {
+	id, _ := strconv.Atoi(mux.Vars(r)["id"])
+	job, err := dao.DownloadJob.QueryJob(id)
+	if err != nil || job.Status != dao.DownloadStatusCompleted {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	values := url.Values{}
+	values.Set("path", job.Path)
+	values.Set("download", "1")
+	r.URL.RawQuery = values.Encode()
+	common.HttpForwardTo("/api/v1/record/file", w, r)
+}
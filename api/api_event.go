@@ -0,0 +1,144 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"gb-cms/log"
+	"gb-cms/stack"
+	"github.com/gorilla/websocket"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	// 实时事件连接的心跳间隔, 小于http服务的读写超时
+	eventHeartbeatInterval = 15 * time.Second
+	eventWriteTimeout      = 10 * time.Second
+	// websocket未收到客户端消息或pong的超时时间
+	eventReadTimeout = 2 * eventHeartbeatInterval
+)
+
+// 解析客户端订阅的主题, 多个主题用逗号分隔, 为空订阅所有
+func parseEventTopics(r *http.Request) []string {
+	var topics []string
+	for _, topic := range strings.Split(r.URL.Query().Get("topics"), ",") {
+		if topic = strings.TrimSpace(topic); topic != "" {
+			topics = append(topics, topic)
+		}
+	}
+
+	return topics
+}
+
+// OnEventWebSocket 通过websocket推送实时事件
+func (api *ApiServer) OnEventWebSocket(w http.ResponseWriter, r *http.Request) {
+	conn, err := api.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		log.Sugar.Errorf("升级websocket失败 err: %s", err.Error())
+		return
+	}
+
+	defer conn.Close()
+
+	topics := parseEventTopics(r)
+	subscriber := stack.EventBus.Subscribe(topics)
+	defer stack.EventBus.Unsubscribe(subscriber)
+	log.Sugar.Infof("websocket订阅实时事件 addr: %s topics: %v", r.RemoteAddr, topics)
+
+	// 劫持后的连接保留了http服务的读超时, 收到pong或消息时延长
+	_ = conn.SetReadDeadline(time.Now().Add(eventReadTimeout))
+	conn.SetPongHandler(func(string) error {
+		return conn.SetReadDeadline(time.Now().Add(eventReadTimeout))
+	})
+
+	// 丢弃客户端的消息, 读取失败认为连接断开
+	closed := make(chan struct{})
+	go func() {
+		defer close(closed)
+		for {
+			_ = conn.SetReadDeadline(time.Now().Add(eventReadTimeout))
+			if _, _, err := conn.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}()
+
+	heartbeat := time.NewTicker(eventHeartbeatInterval)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case event := <-subscriber.C:
+			_ = conn.SetWriteDeadline(time.Now().Add(eventWriteTimeout))
+			if err = conn.WriteJSON(event); err != nil {
+				log.Sugar.Infof("websocket推送实时事件失败 err: %s addr: %s", err.Error(), r.RemoteAddr)
+				return
+			}
+		case <-heartbeat.C:
+			if err = conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(eventWriteTimeout)); err != nil {
+				return
+			}
+		case <-closed:
+			log.Sugar.Infof("websocket取消订阅实时事件 addr: %s", r.RemoteAddr)
+			return
+		}
+	}
+}
+
+// OnEventSSE 通过sse推送实时事件, 事件名为主题
+func (api *ApiServer) OnEventSSE(w http.ResponseWriter, r *http.Request) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	// 长连接不受http服务的写超时限制, 每次推送单独设置写超时
+	controller := http.NewResponseController(w)
+	if err := controller.SetWriteDeadline(time.Time{}); err != nil {
+		log.Sugar.Warnf("sse清除写超时失败 err: %s addr: %s", err.Error(), r.RemoteAddr)
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	topics := parseEventTopics(r)
+	subscriber := stack.EventBus.Subscribe(topics)
+	defer stack.EventBus.Unsubscribe(subscriber)
+	log.Sugar.Infof("sse订阅实时事件 addr: %s topics: %v", r.RemoteAddr, topics)
+
+	heartbeat := time.NewTicker(eventHeartbeatInterval)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case event := <-subscriber.C:
+			data, err := json.Marshal(event)
+			if err != nil {
+				continue
+			}
+
+			_ = controller.SetWriteDeadline(time.Now().Add(eventWriteTimeout))
+			if _, err = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event.Topic, data); err != nil {
+				return
+			}
+
+			flusher.Flush()
+		case <-heartbeat.C:
+			// 注释行保持连接
+			_ = controller.SetWriteDeadline(time.Now().Add(eventWriteTimeout))
+			if _, err := fmt.Fprint(w, ": ping\n\n"); err != nil {
+				return
+			}
+
+			flusher.Flush()
+		case <-r.Context().Done():
+			log.Sugar.Infof("sse取消订阅实时事件 addr: %s", r.RemoteAddr)
+			return
+		}
+	}
+}
@@ -0,0 +1,56 @@
+package api
+
+import (
+	"bufio"
+	"gb-cms/log"
+	"gb-cms/stack"
+	"go.uber.org/zap"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestEventSSEWriteTimeout(t *testing.T) {
+	log.Sugar = zap.NewNop().Sugar()
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc((&ApiServer{}).OnEventSSE))
+	server.Config.WriteTimeout = 100 * time.Millisecond
+	server.Start()
+	defer server.Close()
+
+	response, err := http.Get(server.URL + "?topics=test")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer response.Body.Close()
+
+	// 超过http服务的写超时后推送
+	time.Sleep(300 * time.Millisecond)
+	stack.EventBus.Publish("test", "data")
+
+	lines := make(chan string)
+	go func() {
+		scanner := bufio.NewScanner(response.Body)
+		for scanner.Scan() {
+			lines <- scanner.Text()
+		}
+		close(lines)
+	}()
+
+	timeout := time.After(3 * time.Second)
+	for {
+		select {
+		case line, ok := <-lines:
+			if !ok {
+				t.Fatal("connection closed")
+			} else if strings.HasPrefix(line, "event: test") {
+				return
+			}
+		case <-timeout:
+			t.Fatal("event not received")
+		}
+	}
+}
@@ -0,0 +1,200 @@
+package api
+
+import (
+	"fmt"
+	"gb-cms/common"
+	"gb-cms/dao"
+	"gb-cms/log"
+	"gb-cms/stack"
+	"github.com/gorilla/mux"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type EvidenceExportParams struct {
+	ID        int    `json:"id"`
+	Name      string `json:"name"`
+	StartTime string `json:"starttime"`
+	EndTime   string `json:"endtime"`
+	Speed     string `json:"speed"`
+	Stream    string `json:"streamnumber"` // 码流, 为空使用设备默认码流
+	Start     int    `json:"start"`
+	Limit     int    `json:"limit"`
+	Q         string `json:"q"`
+}
+
+// EvidenceExport 证据包导出任务
+type EvidenceExport struct {
+	*dao.EvidenceExportModel
+	ID        uint           `json:"id"`
+	Url       string         `json:"url"` // 打包完成后的证据包地址
+	CreatedAt string         `json:"created_at"`
+	Jobs      []*DownloadJob `json:"jobs,omitempty"` // 各通道的下载任务
+}
+
+func EvidenceExportModel2EvidenceExport(model *dao.EvidenceExportModel) *EvidenceExport {
+	export := &EvidenceExport{
+		EvidenceExportModel: model,
+		ID:                  model.ID,
+		CreatedAt:           model.CreatedAt.Format("2006-01-02 15:04:05"),
+	}
+
+	if model.Status == dao.ExportStatusCompleted {
+		export.Url = fmt.Sprintf("/api/v1/export/file/%d/evidence.zip", model.ID)
+	}
+
+	return export
+}
+
+// OnEvidenceExportCreate 导出多个通道同一时间段的录像, channels[]格式为deviceId:channelId
+func (api *ApiServer) OnEvidenceExportCreate(v *EvidenceExportParams, _ http.ResponseWriter, r *http.Request) (interface{}, error) {
+	channels := r.Form["channels[]"]
+	log.Sugar.Infof("创建证据包导出任务 %v channels: %v", *v, channels)
+
+	startTime, err := time.ParseInLocation(stack.RecordTimeFormat, v.StartTime, time.Local)
+	if err != nil {
+		return nil, fmt.Errorf("开始时间格式错误 %s", v.StartTime)
+	}
+
+	endTime, err := time.ParseInLocation(stack.RecordTimeFormat, v.EndTime, time.Local)
+	if err != nil {
+		return nil, fmt.Errorf("结束时间格式错误 %s", v.EndTime)
+	} else if !endTime.After(startTime) {
+		return nil, fmt.Errorf("结束时间必须大于开始时间")
+	} else if len(channels) == 0 {
+		return nil, fmt.Errorf("请选择通道")
+	}
+
+	speed, _ := strconv.Atoi(v.Speed)
+	if speed < 1 {
+		speed = 4
+	}
+
+	var jobs []*dao.DownloadJobModel
+	exists := make(map[string]bool, len(channels))
+	for _, item := range channels {
+		ids := strings.Split(item, ":")
+		if len(ids) != 2 {
+			return nil, fmt.Errorf("通道格式错误 %s", item)
+		} else if exists[item] {
+			continue
+		}
+
+		exists[item] = true
+		device, _ := dao.Device.QueryDevice(ids[0])
+		if device == nil {
+			return nil, fmt.Errorf("设备不存在 %s", ids[0])
+		}
+
+		channel, err := dao.Channel.QueryChannel(ids[0], ids[1])
+		if err != nil {
+			return nil, fmt.Errorf("通道不存在 %s", item)
+		}
+
+		streamNumber := (&InviteParams{Stream: v.Stream}).StreamNumber(device)
+		streamId := common.GenerateStreamIDWithNumber(common.InviteTypeDownload, ids[0], ids[1], v.StartTime, v.EndTime, streamNumber)
+		if job, _ := dao.DownloadJob.QueryJobByStreamID(string(streamId)); job != nil {
+			return nil, fmt.Errorf("相同时间段的下载任务已存在 id: %d channel: %s", job.ID, item)
+		}
+
+		jobs = append(jobs, &dao.DownloadJobModel{
+			DeviceID:     ids[0],
+			ChannelID:    ids[1],
+			Name:         channel.Name,
+			StartTime:    v.StartTime,
+			EndTime:      v.EndTime,
+			Speed:        speed,
+			StreamNumber: streamNumber,
+			StreamID:     string(streamId),
+			Status:       dao.DownloadStatusWaiting,
+		})
+	}
+
+	// 导出人从登录的cookie读取, 记录到证据包清单
+	username := requestUsername(r)
+	if v.Name == "" {
+		v.Name = fmt.Sprintf("证据包_%s", startTime.Format("20060102150405"))
+	}
+
+	export := &dao.EvidenceExportModel{
+		Name:      v.Name,
+		StartTime: v.StartTime,
+		EndTime:   v.EndTime,
+		Username:  username,
+		Status:    dao.ExportStatusDownloading,
+	}
+
+	if err = dao.Export.Create(export, jobs); err != nil {
+		return nil, err
+	}
+
+	return EvidenceExportModel2EvidenceExport(export), nil
+}
+
+func (api *ApiServer) OnEvidenceExportList(v *EvidenceExportParams, _ http.ResponseWriter, _ *http.Request) (interface{}, error) {
+	if v.Limit < 1 {
+		v.Limit = 10
+	}
+
+	exports, total, err := dao.Export.QueryExports((v.Start/v.Limit)+1, v.Limit, v.Q)
+	if err != nil {
+		return nil, err
+	}
+
+	response := struct {
+		Total int               `json:"total"`
+		Rows  []*EvidenceExport `json:"rows"`
+	}{
+		Total: total,
+		Rows:  []*EvidenceExport{},
+	}
+
+	for _, export := range exports {
+		response.Rows = append(response.Rows, EvidenceExportModel2EvidenceExport(export))
+	}
+
+	return &response, nil
+}
+
+// OnEvidenceExportInfo 查询导出任务和各通道的下载进度
+func (api *ApiServer) OnEvidenceExportInfo(v *EvidenceExportParams, _ http.ResponseWriter, _ *http.Request) (interface{}, error) {
+	model, err := dao.Export.QueryExport(v.ID)
+	if err != nil {
+		return nil, fmt.Errorf("导出任务不存在")
+	}
+
+	export := EvidenceExportModel2EvidenceExport(model)
+	jobs, _ := dao.DownloadJob.QueryJobsByExportID(model.ID)
+	for _, job := range jobs {
+		export.Jobs = append(export.Jobs, DownloadJobModel2DownloadJob(job))
+	}
+
+	return export, nil
+}
+
+func (api *ApiServer) OnEvidenceExportRemove(v *EvidenceExportParams, _ http.ResponseWriter, _ *http.Request) (interface{}, error) {
+	export, err := dao.Export.QueryExport(v.ID)
+	if err != nil {
+		return nil, fmt.Errorf("导出任务不存在")
+	} else if err = stack.EvidenceExportManager.Remove(export); err != nil {
+		return nil, err
+	}
+
+	return "OK", nil
+}
+
+// OnEvidenceExportFile 下载证据包
+func (api *ApiServer) OnEvidenceExportFile(w http.ResponseWriter, r *http.Request) {
+	id, _ := strconv.Atoi(mux.Vars(r)["id"])
+	export, err := dao.Export.QueryExport(id)
+	if err != nil || export.Status != dao.ExportStatusCompleted {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=evidence_%d.zip", export.ID))
+	w.Header().Set("X-Content-SHA256", export.SHA256)
+	http.ServeFile(w, r, export.Path)
+}
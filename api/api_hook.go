@@ -8,7 +8,10 @@ import (
 	"gb-cms/stack"
 	"github.com/lkmio/avformat/utils"
 	"net/http"
+	"os"
+	"strconv"
 	"strings"
+	"time"
 )
 
 func (api *ApiServer) OnPlay(params *PlayDoneParams, w http.ResponseWriter, r *http.Request) {
@@ -51,14 +54,22 @@ func (api *ApiServer) OnPlay(params *PlayDoneParams, w http.ResponseWriter, r *h
 			return
 		}
 
-		simNumber := sourceStream[0]
-		channelNumber := sourceStream[1]
-		response, err := hook.PostOnInviteEvent(simNumber, channelNumber)
-		if err != nil {
+		simNumber := params.Stream.DeviceID()
+		channelNumber := params.Stream.ChannelID()
+		if common.InviteTypePlay != params.Stream.InviteType() {
+			// 回放/下载, 下发0x9201
+			speed, _ := strconv.Atoi(query.Get("speed"))
+			if err := stack.StartJTPlayback(params.Stream, speed); err != nil {
+				code = http.StatusInternalServerError
+				log.Sugar.Errorf("通知1078信令服务器回放失败 err: %s stream: %s", err.Error(), params.Stream)
+			} else {
+				code = http.StatusOK
+			}
+		} else if err := stack.StartJTLive(params.Stream); err != nil {
 			code = http.StatusInternalServerError
 			log.Sugar.Errorf("通知1078信令服务器失败 err: %s sim number: %s channel number: %s", err.Error(), simNumber, channelNumber)
-		} else if code = response.StatusCode; code != http.StatusOK {
-			log.Sugar.Errorf("通知1078信令服务器失败. 响应状态码: %d sim number: %s channel number: %s", response.StatusCode, simNumber, channelNumber)
+		} else {
+			code = http.StatusOK
 		}
 	} else {
 		// livegbs前端即使退出的播放，还是会拉流. 如果在hook中发起invite, 会造成不必要的请求.
@@ -159,6 +170,8 @@ func (api *ApiServer) OnPublish(params *StreamParams, w http.ResponseWriter, _ *
 		log.Sugar.Infof("推流事件. 未找到stream. stream: %s", params.Stream)
 	}
 
+	stack.PublishEvent(hook.EventTypeStreamStart, map[string]interface{}{"stream": params.Stream, "protocol": params.Protocol})
+
 	// 创建stream
 	if params.Protocol == stack.SourceTypeGBTalk || params.Protocol == stack.SourceType1078 {
 		s := &dao.StreamModel{
@@ -182,6 +195,12 @@ func (api *ApiServer) OnPublish(params *StreamParams, w http.ResponseWriter, _ *
 
 func (api *ApiServer) OnPublishDone(params *StreamParams, _ http.ResponseWriter, _ *http.Request) {
 	log.Sugar.Debugf("推流结束事件. protocol: %s stream: %s", params.Protocol, params.Stream)
+	stack.PublishEvent(hook.EventTypeStreamStop, map[string]interface{}{"stream": params.Stream, "protocol": params.Protocol})
+
+	// 拉流代理的源断开后删除流记录, 否则常驻拉流无法重连
+	if stack.SourceTypePull == params.Protocol {
+		stack.CloseStream(params.Stream, false)
+	}
 
 	//stack.CloseStream(params.Stream, false)
 	//// 对讲websocket断开连接
@@ -193,6 +212,11 @@ func (api *ApiServer) OnPublishDone(params *StreamParams, _ http.ResponseWriter,
 func (api *ApiServer) OnIdleTimeout(params *StreamParams, w http.ResponseWriter, _ *http.Request) {
 	log.Sugar.Debugf("推流空闲超时事件. protocol: %s stream: %s", params.Protocol, params.Stream)
 
+	// 常驻拉流的代理无人观看时不关闭
+	if stack.IsAlwaysOnProxyStream(params.Stream) {
+		return
+	}
+
 	// 非rtmp空闲超时, 返回非200应答, 删除会话
 	if stack.SourceTypeRtmp != params.Protocol {
 		w.WriteHeader(http.StatusForbidden)
@@ -212,6 +236,80 @@ func (api *ApiServer) OnReceiveTimeout(params *StreamParams, w http.ResponseWrit
 
 func (api *ApiServer) OnRecord(params *RecordParams, _ http.ResponseWriter, _ *http.Request) {
 	log.Sugar.Infof("录制事件. protocol: %s stream: %s path:%s ", params.Protocol, params.Stream, params.Path)
+
+	// 只索引国标和1078通道的录像
+	if !strings.Contains(string(params.Stream), "/") || params.Path == "" {
+		return
+	}
+
+	// 流媒体服务未携带的字段, 从录像文件补全
+	info, err := os.Stat(params.Path)
+	if params.Size < 1 && err == nil {
+		params.Size = info.Size()
+	}
+
+	// 下载任务生成的文件, 不作为云端录像
+	if stack.DownloadJobManager.OnRecord(params.Stream, params.Path, params.Size) {
+		return
+	} else if common.InviteTypePlay != params.Stream.InviteType() {
+		// 回放和下载流录制的是设备录像, 不索引
+		return
+	}
+
+	end := time.Now()
+	if params.EndTime > 0 {
+		end = time.UnixMilli(params.EndTime)
+	} else if err == nil {
+		end = info.ModTime()
+	}
+
+	var start time.Time
+	if params.StartTime > 0 {
+		start = time.UnixMilli(params.StartTime)
+	} else if params.Duration > 0 {
+		start = end.Add(-time.Duration(params.Duration) * time.Millisecond)
+	} else {
+		start = recordStartTime(params.Stream, params.Path, end)
+	}
+
+	// 时长为0的录像影响回放和断档计算, 不索引
+	if start.IsZero() || start.Unix() >= end.Unix() {
+		log.Sugar.Errorf("录像时间无效, 不索引 stream: %s path: %s start_time: %d end_time: %d duration: %d", params.Stream, params.Path, params.StartTime, params.EndTime, params.Duration)
+		return
+	}
+
+	record := &dao.CloudRecordModel{
+		DeviceID:  params.Stream.DeviceID(),
+		ChannelID: params.Stream.ChannelID(),
+		StreamID:  string(params.Stream),
+		StartTime: start.Unix(),
+		EndTime:   end.Unix(),
+		Size:      params.Size,
+		Path:      params.Path,
+	}
+
+	if err := dao.CloudRecord.Save(record); err != nil {
+		log.Sugar.Errorf("保存录像索引失败 err: %s stream: %s path: %s", err.Error(), params.Stream, params.Path)
+	}
+}
+
+// 流媒体服务只携带录像路径时, 使用mp4时长推算开始时间, 读取失败则使用上一段录像的结束时间或拉流时间
+func recordStartTime(streamId common.StreamID, path string, end time.Time) time.Time {
+	if duration, err := common.MP4Duration(path); err == nil && duration > 0 {
+		return end.Add(-duration)
+	}
+
+	stream, _ := dao.Stream.QueryStream(streamId)
+	if stream == nil || stream.CreatedAt.IsZero() {
+		return time.Time{}
+	}
+
+	start := stream.CreatedAt
+	if last, _ := dao.CloudRecord.QueryLastRecord(string(streamId)); last != nil && last.EndTime > start.Unix() {
+		start = time.Unix(last.EndTime, 0)
+	}
+
+	return start
 }
 
 func (api *ApiServer) OnStarted(_ http.ResponseWriter, _ *http.Request) {
@@ -249,3 +249,15 @@ func (api *ApiServer) OnVirtualDeviceList(v *PageQuery, w http.ResponseWriter, r
 
 	return query, nil
 }
+
+// OnJTPosition 1078信令服务器上报终端的位置汇报(0x0200)
+func (api *ApiServer) OnJTPosition(position *stack.JTPosition, _ http.ResponseWriter, _ *http.Request) (interface{}, error) {
+	device, err := dao.JTDevice.QueryDeviceBySimNumber(position.SimNumber)
+	if err != nil {
+		return nil, fmt.Errorf("部标设备不存在 %s", position.SimNumber)
+	} else if err = stack.OnJTPosition(device, position); err != nil {
+		return nil, err
+	}
+
+	return "OK", nil
+}
@@ -0,0 +1,138 @@
+package api
+
+import (
+	"fmt"
+	"gb-cms/dao"
+	"gb-cms/log"
+	"gb-cms/onvif"
+	"gb-cms/stack"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+type OnvifParams struct {
+	ID       int    `json:"id"`
+	XAddr    string `json:"xaddr"`    // 设备服务地址, 例如http://192.168.1.64/onvif/device_service
+	Endpoint string `json:"endpoint"` // 发现的设备标识, 可为空
+	Username string `json:"username"`
+	Password string `json:"password"`
+	Name     string `json:"name"`
+	AlwaysOn bool   `json:"always_on"`
+	Timeout  int    `json:"timeout"` // 发现设备的等待时间, 单位秒
+}
+
+// OnvifDevice ONVIF设备和接入的通道
+type OnvifDevice struct {
+	*dao.OnvifDeviceModel
+	ID        uint                    `json:"id"`
+	Status    string                  `json:"status"`
+	CreatedAt string                  `json:"created_at"`
+	Channels  []*dao.StreamProxyModel `json:"channels"`
+}
+
+// OnvifDiscovered 发现的设备, 标记是否已接入
+type OnvifDiscovered struct {
+	*onvif.Discovered
+	XAddr    string `json:"xaddr"`
+	DeviceID string `json:"device_id"` // 已接入的虚拟设备ID
+}
+
+func OnvifDeviceModel2OnvifDevice(model *dao.OnvifDeviceModel) *OnvifDevice {
+	device := &OnvifDevice{
+		OnvifDeviceModel: model,
+		ID:               model.ID,
+		Status:           "OFF",
+		CreatedAt:        model.CreatedAt.Format("2006-01-02 15:04:05"),
+		Channels:         []*dao.StreamProxyModel{},
+	}
+
+	if gb, _ := dao.Device.QueryDevice(model.DeviceID); gb != nil {
+		device.Status = gb.Status.String()
+	}
+
+	// 取流地址携带认证信息, 隐藏密码
+	proxies, _ := dao.StreamProxy.QueryProxiesByDeviceID(model.DeviceID)
+	for _, proxy := range proxies {
+		if u, err := url.Parse(proxy.Url); err == nil {
+			proxy.Url = u.Redacted()
+		}
+
+		device.Channels = append(device.Channels, proxy)
+	}
+
+	return device
+}
+
+// OnOnvifDiscover 在本地网段发送WS-Discovery探测
+func (api *ApiServer) OnOnvifDiscover(v *OnvifParams, _ http.ResponseWriter, _ *http.Request) (interface{}, error) {
+	if v.Timeout < 1 || v.Timeout > 10 {
+		v.Timeout = 3
+	}
+
+	discovered, err := onvif.Probe("", time.Duration(v.Timeout)*time.Second)
+	if err != nil {
+		log.Sugar.Errorf("ONVIF设备发现失败 err: %s", err.Error())
+		return nil, err
+	}
+
+	devices, _ := dao.Onvif.LoadOnvifDevices()
+	result := make([]*OnvifDiscovered, 0, len(discovered))
+	for _, item := range discovered {
+		d := &OnvifDiscovered{Discovered: item, XAddr: item.XAddr()}
+		for _, device := range devices {
+			if (item.Endpoint != "" && device.Endpoint == item.Endpoint) || stack.IsOnvifXAddrHost(device.XAddr, d.XAddr) {
+				d.DeviceID = device.DeviceID
+				break
+			}
+		}
+
+		result = append(result, d)
+	}
+
+	return result, nil
+}
+
+// OnOnvifAdd 接入ONVIF设备, 查询媒体配置生成通道
+func (api *ApiServer) OnOnvifAdd(v *OnvifParams, _ http.ResponseWriter, _ *http.Request) (interface{}, error) {
+	log.Sugar.Infof("接入ONVIF设备 xaddr: %s endpoint: %s username: %s name: %s", v.XAddr, v.Endpoint, v.Username, v.Name)
+
+	v.XAddr = strings.TrimSpace(v.XAddr)
+	if !strings.HasPrefix(v.XAddr, "http://") && !strings.HasPrefix(v.XAddr, "https://") {
+		return nil, fmt.Errorf("设备服务地址格式错误 %s", v.XAddr)
+	}
+
+	device, err := stack.AddOnvifDevice(v.XAddr, v.Endpoint, v.Username, v.Password, strings.TrimSpace(v.Name), v.AlwaysOn)
+	if err != nil {
+		log.Sugar.Errorf("接入ONVIF设备失败 err: %s xaddr: %s", err.Error(), v.XAddr)
+		return nil, err
+	}
+
+	return OnvifDeviceModel2OnvifDevice(device), nil
+}
+
+func (api *ApiServer) OnOnvifList(_ *Empty, _ http.ResponseWriter, _ *http.Request) (interface{}, error) {
+	devices, err := dao.Onvif.LoadOnvifDevices()
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*OnvifDevice, 0, len(devices))
+	for _, device := range devices {
+		result = append(result, OnvifDeviceModel2OnvifDevice(device))
+	}
+
+	return result, nil
+}
+
+func (api *ApiServer) OnOnvifRemove(v *OnvifParams, _ http.ResponseWriter, _ *http.Request) (interface{}, error) {
+	device, err := dao.Onvif.QueryOnvifDevice(v.ID)
+	if err != nil {
+		return nil, fmt.Errorf("设备不存在")
+	} else if err = stack.RemoveOnvifDevice(device); err != nil {
+		return nil, err
+	}
+
+	return "OK", nil
+}
@@ -0,0 +1,147 @@
+package api
+
+import (
+	"fmt"
+	"gb-cms/dao"
+	"gb-cms/log"
+	"gb-cms/stack"
+	"net/http"
+	"strings"
+)
+
+type StreamProxyParams struct {
+	ID        int    `json:"id"`
+	Name      string `json:"name"`
+	Url       string `json:"url"`
+	Transport string `json:"transport"`
+	AlwaysOn  bool   `json:"always_on"`
+	Enable    bool   `json:"enable"`
+	Start     int    `json:"start"`
+	Limit     int    `json:"limit"`
+	Q         string `json:"q"`
+}
+
+// StreamProxy 拉流代理
+type StreamProxy struct {
+	*dao.StreamProxyModel
+	ID        uint   `json:"id"`
+	Online    bool   `json:"online"` // 是否正在拉流
+	CreatedAt string `json:"created_at"`
+}
+
+func StreamProxyModel2StreamProxy(model *dao.StreamProxyModel) *StreamProxy {
+	stream, _ := dao.Stream.QueryStream(stack.ProxyStreamID(model))
+	return &StreamProxy{
+		StreamProxyModel: model,
+		ID:               model.ID,
+		Online:           stream != nil,
+		CreatedAt:        model.CreatedAt.Format("2006-01-02 15:04:05"),
+	}
+}
+
+func (v *StreamProxyParams) verify() error {
+	v.Name = strings.TrimSpace(v.Name)
+	v.Url = strings.TrimSpace(v.Url)
+	v.Transport = strings.ToLower(v.Transport)
+
+	if v.Name == "" {
+		return fmt.Errorf("名称不能为空")
+	} else if !strings.HasPrefix(v.Url, "rtsp://") && !strings.HasPrefix(v.Url, "rtmp://") &&
+		!strings.HasPrefix(v.Url, "http://") && !strings.HasPrefix(v.Url, "https://") {
+		return fmt.Errorf("只支持rtsp/rtmp/http-flv地址")
+	} else if v.Transport != "" && v.Transport != "tcp" && v.Transport != "udp" {
+		return fmt.Errorf("传输方式错误 %s", v.Transport)
+	}
+
+	return nil
+}
+
+// OnStreamProxyAdd 添加拉流代理, 在虚拟设备下生成国标通道
+func (api *ApiServer) OnStreamProxyAdd(v *StreamProxyParams, _ http.ResponseWriter, _ *http.Request) (interface{}, error) {
+	log.Sugar.Infof("添加拉流代理 %v", *v)
+
+	if err := v.verify(); err != nil {
+		return nil, err
+	}
+
+	proxy := &dao.StreamProxyModel{
+		DeviceID:  stack.ProxyDeviceID(),
+		Name:      v.Name,
+		Url:       v.Url,
+		Transport: v.Transport,
+		AlwaysOn:  v.AlwaysOn,
+		Enable:    v.Enable,
+	}
+
+	if err := dao.StreamProxy.Create(proxy, stack.GenerateProxyChannelID); err != nil {
+		return nil, err
+	}
+
+	// 常驻拉流由保活任务启动
+	return StreamProxyModel2StreamProxy(proxy), nil
+}
+
+// OnStreamProxyEdit 编辑拉流代理, 地址变更或停用后关闭正在拉的流
+func (api *ApiServer) OnStreamProxyEdit(v *StreamProxyParams, _ http.ResponseWriter, _ *http.Request) (interface{}, error) {
+	log.Sugar.Infof("编辑拉流代理 %v", *v)
+
+	proxy, err := dao.StreamProxy.QueryProxy(v.ID)
+	if err != nil {
+		return nil, fmt.Errorf("拉流代理不存在")
+	} else if err = v.verify(); err != nil {
+		return nil, err
+	}
+
+	restart := proxy.Url != v.Url || proxy.Transport != v.Transport || !v.Enable
+	proxy.Name = v.Name
+	proxy.Url = v.Url
+	proxy.Transport = v.Transport
+	proxy.AlwaysOn = v.AlwaysOn
+	proxy.Enable = v.Enable
+	if err = dao.StreamProxy.Update(proxy); err != nil {
+		return nil, err
+	} else if restart {
+		stack.StopProxyStream(proxy)
+	}
+
+	return StreamProxyModel2StreamProxy(proxy), nil
+}
+
+func (api *ApiServer) OnStreamProxyRemove(v *StreamProxyParams, _ http.ResponseWriter, _ *http.Request) (interface{}, error) {
+	log.Sugar.Infof("删除拉流代理 %v", *v)
+
+	proxy, err := dao.StreamProxy.QueryProxy(v.ID)
+	if err != nil {
+		return nil, fmt.Errorf("拉流代理不存在")
+	} else if err = dao.StreamProxy.Delete(proxy); err != nil {
+		return nil, err
+	}
+
+	stack.StopProxyStream(proxy)
+	return "OK", nil
+}
+
+func (api *ApiServer) OnStreamProxyList(v *StreamProxyParams, _ http.ResponseWriter, _ *http.Request) (interface{}, error) {
+	if v.Limit < 1 {
+		v.Limit = 10
+	}
+
+	proxies, total, err := dao.StreamProxy.QueryProxies(stack.ProxyDeviceID(), (v.Start/v.Limit)+1, v.Limit, v.Q)
+	if err != nil {
+		return nil, err
+	}
+
+	response := struct {
+		Total int            `json:"total"`
+		Rows  []*StreamProxy `json:"rows"`
+	}{
+		Total: total,
+		Rows:  []*StreamProxy{},
+	}
+
+	for _, proxy := range proxies {
+		response.Rows = append(response.Rows, StreamProxyModel2StreamProxy(proxy))
+	}
+
+	return &response, nil
+}
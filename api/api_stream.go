@@ -1,6 +1,7 @@
 package api
 
 import (
+	"bytes"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -8,7 +9,7 @@ import (
 	"gb-cms/dao"
 	"gb-cms/log"
 	"gb-cms/stack"
-	"github.com/ghettovoice/gosip/sip"
+	"io"
 	"net/http"
 	"net/url"
 	"strconv"
@@ -134,6 +135,17 @@ func (api *ApiServer) DoStartStream(v *InviteParams, w http.ResponseWriter, r *h
 	return response, err
 }
 
+// StreamNumber 返回请求的码流, 未指定使用设备默认码流
+func (v *InviteParams) StreamNumber(device *dao.DeviceModel) int {
+	if v.Stream != "" {
+		return common.String2StreamNumber(v.Stream)
+	} else if device == nil {
+		return common.StreamNumberDefault
+	}
+
+	return device.StreamMode.Number()
+}
+
 // DoInvite 发起Invite请求
 // @params sync 是否异步等待流媒体的publish事件(确认收到流), 目前请求流分两种方式，流媒体hook和http接口, hook方式同步等待确认收到流再应答, http接口直接应答成功。
 func (api *ApiServer) DoInvite(inviteType common.InviteType, params *InviteParams, sync bool) (int, *dao.StreamModel, error) {
@@ -161,7 +173,7 @@ func (api *ApiServer) DoInvite(inviteType common.InviteType, params *InviteParam
 	}
 
 	if params.streamId == "" {
-		params.streamId = common.GenerateStreamID(inviteType, device.GetID(), params.ChannelID, params.StartTime, params.EndTime)
+		params.streamId = common.GenerateStreamIDWithNumber(inviteType, device.GetID(), params.ChannelID, params.StartTime, params.EndTime, params.StreamNumber(device))
 	}
 
 	if params.Setup == "" {
@@ -188,7 +200,8 @@ func (api *ApiServer) OnCloseStream(v *StreamIDParams, _ http.ResponseWriter, _
 }
 
 func (api *ApiServer) OnCloseLiveStream(v *InviteParams, _ http.ResponseWriter, _ *http.Request) (interface{}, error) {
-	id := common.GenerateStreamID(common.InviteTypePlay, v.DeviceID, v.ChannelID, "", "")
+	device, _ := dao.Device.QueryDevice(v.DeviceID)
+	id := common.GenerateStreamIDWithNumber(common.InviteTypePlay, v.DeviceID, v.ChannelID, "", "", v.StreamNumber(device))
 	stack.CloseStream(id, true)
 	return "OK", nil
 }
@@ -197,6 +210,39 @@ func (api *ApiServer) OnStreamInfo(w http.ResponseWriter, r *http.Request) {
 	common.HttpForwardTo("/api/v1/stream/info", w, r)
 }
 
+// OnPlaybackStreamInfo 查询回放流信息, 在流媒体服务返回的信息中加入播放状态和位置
+func (api *ApiServer) OnPlaybackStreamInfo(w http.ResponseWriter, r *http.Request) {
+	response, err := stack.MSQueryStreamInfo(nil, r.URL.RawQuery)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		_ = common.HttpResponseJson(w, err.Error())
+		return
+	}
+
+	defer response.Body.Close()
+	info := make(map[string]interface{}, 16)
+	if http.StatusOK != response.StatusCode {
+		w.WriteHeader(response.StatusCode)
+		return
+	} else if err = json.NewDecoder(response.Body).Decode(&info); err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		_ = common.HttpResponseJson(w, err.Error())
+		return
+	}
+
+	stream, _ := dao.Stream.QueryStream(common.StreamID(r.URL.Query().Get("streamid")))
+	if stream != nil && stream.PlaybackState != "" {
+		position := stream.CurrentPosition()
+		info["PlaybackState"] = stream.PlaybackState
+		info["PlaybackSpeed"] = stream.Scale
+		info["PlaybackPosition"] = position // 相对开始时间的秒数
+		info["PlaybackDuration"] = stream.PlaybackEnd - stream.PlaybackStart
+		info["PlaybackTime"] = time.Unix(stream.PlaybackStart+position, 0).Format("2006-01-02T15:04:05")
+	}
+
+	_ = common.HttpResponseJson(w, info)
+}
+
 func (api *ApiServer) OnSessionList(q *QueryDeviceChannel, _ http.ResponseWriter, r *http.Request) (interface{}, error) {
 	// 分页参数
 	if q.Limit < 1 {
@@ -277,35 +323,64 @@ func (api *ApiServer) OnSessionStop(params *StreamIDParams, _ http.ResponseWrite
 }
 
 func (api *ApiServer) OnRecordStart(writer http.ResponseWriter, request *http.Request) {
-	common.HttpForwardTo("/api/v1/record/start", writer, request)
+	forwardRecord("/api/v1/record/start", writer, request, true)
 }
 
 func (api *ApiServer) OnRecordStop(writer http.ResponseWriter, request *http.Request) {
-	common.HttpForwardTo("/api/v1/record/stop", writer, request)
+	forwardRecord("/api/v1/record/stop", writer, request, false)
 }
 
-func (api *ApiServer) OnPlaybackControl(params *StreamIDParams, _ http.ResponseWriter, _ *http.Request) (interface{}, error) {
-	if "scale" != params.Command || params.Scale <= 0 || params.Scale > 4 {
-		return nil, errors.New("scale error")
+// 转发手动录制请求, 成功后通知录像计划, 避免计划结束时关闭手动开启的录制
+func forwardRecord(path string, writer http.ResponseWriter, request *http.Request, recording bool) {
+	streamId := request.URL.Query().Get("streamid")
+	if streamId == "" && request.Body != nil {
+		// 请求体需要保留给转发使用
+		body, _ := io.ReadAll(request.Body)
+		_ = request.Body.Close()
+		request.Body = io.NopCloser(bytes.NewReader(body))
+		values, _ := url.ParseQuery(string(body))
+		streamId = values.Get("streamid")
 	}
 
-	stream, err := dao.Stream.QueryStream(params.StreamID)
-	if err != nil {
-		return nil, err
-	} else if stream.Dialog == nil {
-		return nil, errors.New("stream not found")
+	recorder := &responseRecorder{ResponseWriter: writer}
+	common.HttpForwardTo(path, recorder, request)
+	if streamId != "" && (recorder.statusCode == 0 || recorder.statusCode == http.StatusOK) {
+		stack.RecordPlanManager.OnManualRecord(common.StreamID(streamId), recording)
 	}
+}
 
-	// 查找設備
-	device, err := dao.Device.QueryDevice(stream.DeviceID)
-	if err != nil {
-		return nil, err
+// OnPlaybackControl 回放控制, command: pause-暂停 play-继续播放, 携带range时跳转到相对开始时间的秒数 scale-倍速
+func (api *ApiServer) OnPlaybackControl(params *StreamIDParams, _ http.ResponseWriter, _ *http.Request) (interface{}, error) {
+	log.Sugar.Infof("回放控制 %v", *params)
+
+	var err error
+	switch params.Command {
+	case "pause":
+		err = stack.PausePlayback(params.StreamID)
+	case "play":
+		if params.Range == "" {
+			err = stack.ResumePlayback(params.StreamID)
+			break
+		}
+
+		seconds, parseErr := strconv.ParseInt(params.Range, 10, 64)
+		if parseErr != nil {
+			return nil, fmt.Errorf("range error")
+		}
+
+		err = stack.SeekPlayback(params.StreamID, seconds)
+	case "scale":
+		if params.Scale <= 0 || params.Scale > 4 {
+			return nil, errors.New("scale error")
+		}
+
+		err = stack.ScalePlayback(params.StreamID, params.Scale)
+	default:
+		return nil, fmt.Errorf("不支持的回放控制指令 %s", params.Command)
 	}
 
-	s := &stack.Device{DeviceModel: device}
-	s.ScalePlayback(stream.Dialog, params.Scale)
-	err = stack.MSSpeedSet(string(params.StreamID), params.Scale)
 	if err != nil {
+		log.Sugar.Errorf("回放控制失败 err: %s stream: %s", err.Error(), params.StreamID)
 		return nil, err
 	}
 
@@ -315,20 +390,10 @@ func (api *ApiServer) OnPlaybackControl(params *StreamIDParams, _ http.ResponseW
 func (api *ApiServer) OnSeekPlayback(v *SeekParams, _ http.ResponseWriter, _ *http.Request) (interface{}, error) {
 	log.Sugar.Debugf("快进回放 %v", *v)
 
-	model, _ := dao.Stream.QueryStream(v.StreamId)
-	if model == nil || model.Dialog == nil {
-		log.Sugar.Errorf("快进回放失败 stream不存在 %s", v.StreamId)
-		return nil, fmt.Errorf("stream不存在")
+	if err := stack.SeekPlayback(v.StreamId, int64(v.Seconds)); err != nil {
+		log.Sugar.Errorf("快进回放失败 err: %s stream: %s", err.Error(), v.StreamId)
+		return nil, err
 	}
 
-	stream := &stack.Stream{StreamModel: model}
-	seekRequest := stream.CreateRequestFromDialog(sip.INFO)
-	seq, _ := seekRequest.CSeq()
-	body := fmt.Sprintf(stack.SeekBodyFormat, seq.SeqNo, v.Seconds)
-	seekRequest.SetBody(body, true)
-	seekRequest.RemoveHeader(stack.RtspMessageType.Name())
-	seekRequest.AppendHeader(&stack.RtspMessageType)
-
-	common.SipStack.SendRequest(seekRequest)
-	return nil, nil
+	return "OK", nil
 }
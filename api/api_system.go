@@ -19,13 +19,14 @@ var (
 
 type BaseConfig struct {
 	APIAuth                               bool   `json:"APIAuth"`
-	AckTimeout                            int    `json:"AckTimeout"`
+	AckTimeout                            int    `json:"AckTimeout"` // 等待200应答的超时时间, 单位秒
 	AllowStreamStartByURL                 bool   `json:"AllowStreamStartByURL"`
 	BlackIPList                           string `json:"BlackIPList"`
 	BlackUAList                           string `json:"BlackUAList"`
 	Captcha                               bool   `json:"Captcha"`
 	DevicePassword                        string `json:"DevicePassword"`
 	DropChannelType                       string `json:"DropChannelType"`
+	FirstPacketTimeout                    int    `json:"FirstPacketTimeout"` // 等待首包的超时时间, 单位秒
 	GlobalChannelAudio                    bool   `json:"GlobalChannelAudio"`
 	GlobalChannelShared                   bool   `json:"GlobalChannelShared"`
 	GlobalDeviceAlarmSubscribeInterval    int    `json:"GlobalDeviceAlarmSubscribeInterval"`
@@ -36,6 +37,7 @@ type BaseConfig struct {
 	HTTPSKeyFile                          string `json:"HTTPSKeyFile"`
 	HTTPSPort                             int    `json:"HTTPSPort"`
 	Host                                  string `json:"Host"`
+	InviteTimeout                         int    `json:"InviteTimeout"` // 等待设备应答invite的超时时间, 单位秒
 	KeepaliveTimeout                      int    `json:"KeepaliveTimeout"`
 	LiveStreamAuth                        bool   `json:"LiveStreamAuth"`
 	MapCenter                             string `json:"MapCenter"`
@@ -55,13 +57,14 @@ func (api *ApiServer) OnGetBaseConfig(_ *Empty, _ http.ResponseWriter, _ *http.R
 	ip, ua := dao.BlacklistManager.ToStrings()
 	v := BaseConfig{
 		APIAuth:                               true,
-		AckTimeout:                            common.Config.InviteTimeout,
+		AckTimeout:                            common.Config.AckTimeout,
 		AllowStreamStartByURL:                 false,
 		BlackIPList:                           ip,
 		BlackUAList:                           ua,
 		Captcha:                               false,
 		DevicePassword:                        common.Config.Password,
 		DropChannelType:                       common.Config.GlobalDropChannelType,
+		FirstPacketTimeout:                    common.Config.FirstPacketTimeout,
 		GlobalChannelAudio:                    true,
 		GlobalChannelShared:                   false,
 		GlobalDeviceAlarmSubscribeInterval:    common.Config.SubAlarmGlobalInterval,
@@ -72,6 +75,7 @@ func (api *ApiServer) OnGetBaseConfig(_ *Empty, _ http.ResponseWriter, _ *http.R
 		HTTPSKeyFile:                          "",
 		HTTPSPort:                             0,
 		Host:                                  common.Config.PublicIP,
+		InviteTimeout:                         common.Config.InviteTimeout,
 		KeepaliveTimeout:                      common.Config.AliveExpires,
 		LiveStreamAuth:                        true,
 		MapCenter:                             "",
@@ -153,6 +157,22 @@ func (api *ApiServer) OnSetBaseConfig(baseConfig *BaseConfig, _ http.ResponseWri
 		changed = true
 	}
 
+	// 更新取流超时时间
+	if baseConfig.InviteTimeout > 0 && baseConfig.InviteTimeout != common.Config.InviteTimeout {
+		iniConfig.Section("sip").Key("invite_timeout").SetValue(strconv.Itoa(baseConfig.InviteTimeout))
+		changed = true
+	}
+
+	if baseConfig.AckTimeout > 0 && baseConfig.AckTimeout != common.Config.AckTimeout {
+		iniConfig.Section("sip").Key("ack_timeout").SetValue(strconv.Itoa(baseConfig.AckTimeout))
+		changed = true
+	}
+
+	if baseConfig.FirstPacketTimeout > 0 && baseConfig.FirstPacketTimeout != common.Config.FirstPacketTimeout {
+		iniConfig.Section("sip").Key("first_packet_timeout").SetValue(strconv.Itoa(baseConfig.FirstPacketTimeout))
+		changed = true
+	}
+
 	// 更新订阅间隔
 	if baseConfig.GlobalDeviceAlarmSubscribeInterval != common.Config.SubAlarmGlobalInterval {
 		iniConfig.Section("sip").Key("sub_alarm_global_interval").SetValue(strconv.Itoa(baseConfig.GlobalDeviceAlarmSubscribeInterval))
@@ -15,6 +15,7 @@ import (
 	"github.com/shirou/gopsutil/v3/net"
 	"math"
 	"net/http"
+	"strconv"
 	"strings"
 	"time"
 )
@@ -140,12 +141,6 @@ func stateDiskUsage() ([]struct {
 	Threshold string
 }, error) {
 
-	// 获取所有磁盘分区
-	partitions, err := disk.Partitions(false) // true表示获取所有分区，包括远程分区
-	if err != nil {
-		return nil, err
-	}
-
 	var result []struct {
 		Name      string
 		Unit      string
@@ -155,6 +150,46 @@ func stateDiskUsage() ([]struct {
 		Percent   string
 		Threshold string
 	}
+
+	// 配置了录像存储路径, 统计每个录像存储路径所在磁盘
+	if len(common.Config.RecordPaths) > 0 {
+		for _, path := range common.Config.RecordPaths {
+			var threshold string
+			if n := common.Config.GetRecordDiskThreshold(path); n > 0 {
+				threshold = strconv.Itoa(n)
+			}
+
+			usage, err := disk.Usage(path)
+			if err != nil {
+				log.Sugar.Errorf("获取录像存储路径磁盘信息失败 err: %s path: %s", err.Error(), path)
+				continue
+			}
+
+			size, unit := FormatDiskSize(usage.Total)
+			freeSpace, unit := FormatDiskSize(usage.Free)
+			used, unit := FormatDiskSize(usage.Used)
+			percent := fmt.Sprintf("%.2f", usage.UsedPercent)
+
+			result = append(result, struct {
+				Name      string
+				Unit      string
+				Size      string
+				FreeSpace string
+				Used      string
+				Percent   string
+				Threshold string
+			}{Name: path, Unit: unit, Size: size, FreeSpace: freeSpace, Used: used, Percent: percent, Threshold: threshold})
+		}
+
+		return result, nil
+	}
+
+	// 获取所有磁盘分区
+	partitions, err := disk.Partitions(false) // true表示获取所有分区，包括远程分区
+	if err != nil {
+		return nil, err
+	}
+
 	for _, partition := range partitions {
 		// 跳过某些特殊文件系统类型
 		if partition.Fstype == "tmpfs" || partition.Fstype == "devtmpfs" || partition.Fstype == "squashfs" {
@@ -388,6 +423,7 @@ func StartStats() {
 		if streamCount > 0 {
 			liveStreamCount, _ = dao.Stream.QueryStreamCountByType("play")
 			playbackStreamCount, _ = dao.Stream.QueryStreamCountByType("playback")
+			h265StreamCount, _ = dao.Stream.QueryStreamCountByCodec("H265")
 
 			if i, _ := dao.Sink.Count(); i > 0 {
 				// 查询级联
@@ -2,6 +2,7 @@ package api
 
 import (
 	"math/rand"
+	"net/http"
 	"sync"
 	"time"
 )
@@ -95,3 +96,15 @@ func GenerateToken() string {
 	}
 	return string(token)
 }
+
+// 返回请求cookie中的token对应的登录用户名, 未登录返回空
+func requestUsername(req *http.Request) string {
+	cookie, err := req.Cookie("token")
+	if err != nil {
+		return ""
+	} else if session := TokenManager.Find(cookie.Value); session != nil {
+		return session.Username
+	}
+
+	return ""
+}
@@ -27,9 +27,11 @@ type Config_ struct {
 	AlarmReserveDays       int `json:"alarm_reserve_days"`
 	LogReserveDays         int `json:"log_reserve_days"`
 
-	MediaServer     string `json:"media_server"`
-	PreferStreamFmt string `json:"prefer_stream_fmt"`
-	InviteTimeout   int
+	MediaServer        string `json:"media_server"`
+	PreferStreamFmt    string `json:"prefer_stream_fmt"`
+	InviteTimeout      int    `json:"invite_timeout"`       // 等待设备应答invite的超时时间, 单位秒
+	AckTimeout         int    `json:"ack_timeout"`          // 收到临时应答后, 等待200应答并回复ack的超时时间, 单位秒
+	FirstPacketTimeout int    `json:"first_packet_timeout"` // 等待流媒体服务收到首包的超时时间, 单位秒
 
 	SubCatalogGlobalInterval  int `json:"sub_catalog_global_interval"`
 	SubAlarmGlobalInterval    int `json:"sub_alarm_global_interval"`
@@ -38,13 +40,69 @@ type Config_ struct {
 
 	GlobalDropChannelType string `json:"global_drop_channel_type"`
 
-	DeviceDefaultMediaTransport string `json:"device_default_media_transport"`
+	DeviceDefaultMediaTransport string   `json:"device_default_media_transport"`
+	CascadeStreamNumber         int      `json:"cascade_stream_number"`    // 级联上级点播时使用的码流, -1-不指定/0-主码流/1-子码流
+	MediaTransportFallback      string   `json:"media_transport_fallback"` // 收流失败后依次尝试的取流方式, 逗号分隔, 为空不重试
+	VideoPayloads               []string `json:"video_payloads"`           // invite时提供的视频负载类型, 按优先级排序
+	AudioPayloads               []string `json:"audio_payloads"`           // 广播应答时支持的音频负载类型, 按优先级排序
+
+	RecordReserveDays    int            `json:"record_reserve_days"`    // 云端录像保留天数, 0-不按天数清理
+	RecordPaths          []string       `json:"record_paths"`           // 流媒体服务的录像存储路径, 每个路径单独统计磁盘使用率
+	RecordDiskThreshold  int            `json:"record_disk_threshold"`  // 存储路径所在磁盘使用率的清理阈值, 百分比, 0-不按磁盘清理
+	RecordDiskThresholds map[string]int `json:"record_disk_thresholds"` // 单独设置阈值的存储路径, 例如paths = /data/rec=85
+	RecordCacheTTL       int            `json:"record_cache_ttl"`       // 设备录像查询结果的缓存时长, 单位秒, 0-不缓存
+	RecordCacheTodayTTL  int            `json:"record_cache_today_ttl"` // 查询范围包含今天时的缓存时长, 单位秒, 今天的录像仍在增加
+	DownloadConcurrency  int            `json:"download_concurrency"`   // 同时进行的下载任务数
+	DownloadRetries      int            `json:"download_retries"`       // 下载失败的重试次数
+	ExportPath           string         `json:"export_path"`            // 证据包的存储目录
 
 	Hooks struct {
 		Online   string `json:"online"`
 		Offline  string `json:"offline"`
 		Position string `json:"position"`
 		OnInvite string `json:"on_invite"`
+
+		OnPlayback         string `json:"on_playback"`          // 1078录像回放/下载
+		OnPlaybackControl  string `json:"on_playback_control"`  // 1078录像回放控制
+		OnQueryRecord      string `json:"on_query_record"`      // 1078录像查询
+		QueryRecordTimeout int    `json:"query_record_timeout"` // 等待1078录像查询应答的超时时间, 单位秒
+
+		// 异步推送的事件
+		KeepaliveTimeout string `json:"keepalive_timeout"`
+		Catalog          string `json:"catalog"`
+		Alarm            string `json:"alarm"`
+		StreamStart      string `json:"stream_start"`
+		StreamStop       string `json:"stream_stop"`
+		CascadeOnline    string `json:"cascade_online"`
+		CascadeOffline   string `json:"cascade_offline"`
+		ChannelStatus    string `json:"channel_status"`
+
+		Secret        string `json:"-"`              // 推送的HMAC-SHA256签名密钥
+		Timeout       int    `json:"timeout"`        // 请求超时时间, 单位秒
+		Retries       int    `json:"retries"`        // 推送失败的重试次数
+		RetryInterval int    `json:"retry_interval"` // 首次重试的间隔, 单位秒, 之后每次翻倍
+		QueueSize     int    `json:"queue_size"`
+		DeadLetter    string `json:"dead_letter"` // 重试失败的事件写入该文件
+	}
+
+	// 内置部标信令服务器
+	JT808 struct {
+		Port         int    `json:"port"`           // 监听端口, 0-不启用
+		MediaIP      string `json:"media_ip"`       // 终端推流的1078收流地址, 为空使用PublicIP
+		MediaTCPPort int    `json:"media_tcp_port"` // 1078收流TCP端口
+		MediaUDPPort int    `json:"media_udp_port"` // 1078收流UDP端口, 0-不使用UDP
+		AliveExpires int    `json:"alive_expires"`  // 终端心跳超时时间, 单位秒
+	}
+
+	// 发布事件到MQTT, 并接收控制指令
+	MQTT struct {
+		Broker      string `json:"broker"` // broker地址, 为空不启用
+		ClientID    string `json:"client_id"`
+		Username    string `json:"username"`
+		Password    string `json:"-"`
+		TopicPrefix string `json:"topic_prefix"`
+		QoS         byte   `json:"qos"`
+		Retain      bool   `json:"retain"` // 状态类主题是否保留消息
 	}
 
 	IP2RegionDBPath string
@@ -82,25 +140,84 @@ func ParseConfig(path string) (*Config_, error) {
 		LogReserveDays:              load.Section("sip").Key("log_reserve_days").MustInt(),
 		MediaServer:                 load.Section("sip").Key("media_server").String(),
 		PreferStreamFmt:             load.Section("sip").Key("prefer_stream_fmt").String(),
-		InviteTimeout:               load.Section("sip").Key("invite_timeout").MustInt(),
+		InviteTimeout:               load.Section("sip").Key("invite_timeout").MustInt(10),
+		AckTimeout:                  load.Section("sip").Key("ack_timeout").MustInt(10),
+		FirstPacketTimeout:          load.Section("sip").Key("first_packet_timeout").MustInt(10),
 		SubCatalogGlobalInterval:    load.Section("sip").Key("sub_catalog_global_interval").MustInt(),
 		SubAlarmGlobalInterval:      load.Section("sip").Key("sub_alarm_global_interval").MustInt(),
 		SubPositionGlobalInterval:   load.Section("sip").Key("sub_position_global_interval").MustInt(),
 		SubPTZGlobalInterval:        load.Section("sip").Key("sub_ptz_global_interval").MustInt(),
 		DeviceDefaultMediaTransport: load.Section("sip").Key("device_default_media_transport").String(),
+		MediaTransportFallback:      load.Section("sip").Key("media_transport_fallback").String(),
+		VideoPayloads:               String2Payloads(load.Section("sip").Key("video_payloads").String(), VideoPayloads, DefaultVideoPayload),
+		AudioPayloads:               String2Payloads(load.Section("sip").Key("audio_payloads").String(), AudioPayloads, DefaultAudioPayload),
+		CascadeStreamNumber:         String2StreamNumber(load.Section("sip").Key("cascade_stream_number").String()),
 		GlobalDropChannelType:       load.Section("sip").Key("global_drop_channel_type").String(),
 		IP2RegionDBPath:             load.Section("ip2region").Key("db_path").String(),
 		IP2RegionEnable:             load.Section("ip2region").Key("enable").MustBool(),
 	}
 
+	config_.RecordReserveDays = load.Section("record").Key("reserve_days").MustInt()
+	config_.RecordPaths, config_.RecordDiskThresholds = String2RecordPaths(load.Section("record").Key("paths").String())
+	config_.RecordDiskThreshold = load.Section("record").Key("disk_threshold").MustInt()
+	config_.RecordCacheTTL = load.Section("record").Key("cache_ttl").MustInt()
+	config_.RecordCacheTodayTTL = load.Section("record").Key("cache_today_ttl").MustInt()
+	config_.DownloadConcurrency = load.Section("record").Key("download_concurrency").MustInt(4)
+	config_.DownloadRetries = load.Section("record").Key("download_retries").MustInt(3)
+	config_.ExportPath = load.Section("record").Key("export_path").MustString("./data/export")
+
 	config_.Hooks.Online = load.Section("hooks").Key("online").String()
 	config_.Hooks.Offline = load.Section("hooks").Key("offline").String()
 	config_.Hooks.Position = load.Section("hooks").Key("position").String()
 	config_.Hooks.OnInvite = load.Section("hooks").Key("on_invite").String()
+	config_.Hooks.OnPlayback = load.Section("hooks").Key("on_playback").String()
+	config_.Hooks.OnPlaybackControl = load.Section("hooks").Key("on_playback_control").String()
+	config_.Hooks.OnQueryRecord = load.Section("hooks").Key("on_query_record").String()
+	config_.Hooks.QueryRecordTimeout = load.Section("hooks").Key("query_record_timeout").MustInt(15)
+	config_.Hooks.KeepaliveTimeout = load.Section("hooks").Key("keepalive_timeout").String()
+	config_.Hooks.Catalog = load.Section("hooks").Key("catalog").String()
+	config_.Hooks.Alarm = load.Section("hooks").Key("alarm").String()
+	config_.Hooks.StreamStart = load.Section("hooks").Key("stream_start").String()
+	config_.Hooks.StreamStop = load.Section("hooks").Key("stream_stop").String()
+	config_.Hooks.CascadeOnline = load.Section("hooks").Key("cascade_online").String()
+	config_.Hooks.CascadeOffline = load.Section("hooks").Key("cascade_offline").String()
+	config_.Hooks.ChannelStatus = load.Section("hooks").Key("channel_status").String()
+	config_.Hooks.Secret = load.Section("hooks").Key("secret").String()
+	config_.Hooks.Timeout = load.Section("hooks").Key("timeout").MustInt(5)
+	config_.Hooks.Retries = load.Section("hooks").Key("retries").MustInt(3)
+	config_.Hooks.RetryInterval = load.Section("hooks").Key("retry_interval").MustInt(2)
+	config_.Hooks.QueueSize = load.Section("hooks").Key("queue_size").MustInt(1024)
+	config_.Hooks.DeadLetter = load.Section("hooks").Key("dead_letter").MustString("./data/hook_dead_letter.log")
+
+	config_.JT808.Port = load.Section("jt808").Key("port").MustInt()
+	config_.JT808.MediaIP = load.Section("jt808").Key("media_ip").String()
+	config_.JT808.MediaTCPPort = load.Section("jt808").Key("media_tcp_port").MustInt(1078)
+	config_.JT808.MediaUDPPort = load.Section("jt808").Key("media_udp_port").MustInt()
+	config_.JT808.AliveExpires = load.Section("jt808").Key("alive_expires").MustInt(180)
+	if config_.JT808.MediaIP == "" {
+		config_.JT808.MediaIP = config_.PublicIP
+	}
+
+	config_.MQTT.Broker = load.Section("mqtt").Key("broker").String()
+	config_.MQTT.ClientID = load.Section("mqtt").Key("client_id").MustString("gb-cms")
+	config_.MQTT.Username = load.Section("mqtt").Key("username").String()
+	config_.MQTT.Password = load.Section("mqtt").Key("password").String()
+	config_.MQTT.TopicPrefix = load.Section("mqtt").Key("topic_prefix").MustString("gb-cms")
+	config_.MQTT.QoS = byte(load.Section("mqtt").Key("qos").MustUint(1))
+	config_.MQTT.Retain = load.Section("mqtt").Key("retain").MustBool(true)
 
 	return &config_, err
 }
 
+// GetRecordDiskThreshold 返回存储路径的磁盘使用率清理阈值, 未单独设置使用disk_threshold
+func (c *Config_) GetRecordDiskThreshold(path string) int {
+	if threshold, ok := c.RecordDiskThresholds[path]; ok {
+		return threshold
+	}
+
+	return c.RecordDiskThreshold
+}
+
 func ParseGBTime(gbTime string) time.Time {
 	// 2023-08-10 15:04:05
 	if gbTime == "" {
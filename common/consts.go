@@ -1,6 +1,9 @@
 package common
 
-import "strings"
+import (
+	"strconv"
+	"strings"
+)
 
 type OnlineStatus string
 
@@ -47,6 +50,30 @@ func String2SetupType(str string) SetupType {
 	}
 }
 
+// String2SetupTypes 解析逗号分隔的取流方式列表, 忽略重复项
+func String2SetupTypes(str string) []SetupType {
+	var setups []SetupType
+	for _, item := range strings.Split(str, ",") {
+		if item = strings.TrimSpace(item); item == "" {
+			continue
+		}
+
+		setup := String2SetupType(item)
+		var exist bool
+		for _, s := range setups {
+			if exist = s == setup; exist {
+				break
+			}
+		}
+
+		if !exist {
+			setups = append(setups, setup)
+		}
+	}
+
+	return setups
+}
+
 func (s SetupType) MediaProtocol() string {
 	switch s {
 	case SetupTypePassive, SetupTypeActive:
@@ -89,3 +116,87 @@ func (i *InviteType) SessionName2Type(name string) {
 		break
 	}
 }
+
+// StreamMode 码流选择方式, 格式同SDP属性"名称:值", 例如streamnumber:1. 为空表示不指定, 由设备决定
+type StreamMode string
+
+const (
+	StreamNumberDefault = -1 // 不指定码流
+	StreamNumberMain    = 0  // 主码流
+	StreamNumberSub     = 1  // 子码流
+)
+
+// Name 返回SDP属性名称, stream/streamnumber/streamprofile/streamMode
+func (m StreamMode) Name() string {
+	return strings.Split(string(m), ":")[0]
+}
+
+// Number 返回码流编号, 未指定返回StreamNumberDefault
+func (m StreamMode) Number() int {
+	split := strings.Split(string(m), ":")
+	if len(split) < 2 {
+		return StreamNumberDefault
+	}
+
+	return String2StreamNumber(split[1])
+}
+
+// WithNumber 保持属性名称不变, 替换码流编号. 未指定属性名称时, 使用2022标准的streamnumber
+func (m StreamMode) WithNumber(number int) StreamMode {
+	if number < 0 {
+		return ""
+	}
+
+	name := m.Name()
+	if name == "" {
+		name = "streamnumber"
+	}
+
+	if "streamMode" == name {
+		if number == StreamNumberMain {
+			return StreamMode(name + ":MAIN")
+		}
+		return StreamMode(name + ":SUB")
+	}
+
+	return StreamMode(name + ":" + strconv.Itoa(number))
+}
+
+// String2StreamNumber 解析码流编号, 支持0/1/2和main/sub
+func String2StreamNumber(str string) int {
+	switch strings.ToLower(strings.TrimSpace(str)) {
+	case "0", "main":
+		return StreamNumberMain
+	case "sub":
+		return StreamNumberSub
+	default:
+		number, err := strconv.Atoi(str)
+		if err != nil || number < 0 {
+			return StreamNumberDefault
+		}
+		return number
+	}
+}
+
+// String2RecordPaths 解析逗号分隔的录像存储路径, 路径后可以跟"=百分比"单独设置磁盘使用率阈值
+func String2RecordPaths(str string) ([]string, map[string]int) {
+	var paths []string
+	thresholds := make(map[string]int)
+	for _, item := range strings.Split(str, ",") {
+		if item = strings.TrimSpace(item); item == "" {
+			continue
+		}
+
+		// 路径本身可能包含"=", 只解析最后一个"="后的数字
+		if i := strings.LastIndex(item, "="); i > 0 {
+			if threshold, err := strconv.Atoi(strings.TrimSpace(item[i+1:])); err == nil {
+				item = strings.TrimSpace(item[:i])
+				thresholds[item] = threshold
+			}
+		}
+
+		paths = append(paths, item)
+	}
+
+	return paths, thresholds
+}
@@ -0,0 +1,56 @@
+package common
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestString2SetupTypes(t *testing.T) {
+	tests := []struct {
+		name string
+		str  string
+		want []SetupType
+	}{
+		{"empty", "", nil},
+		{"blank items", " , ,", nil},
+		{"single", "active", []SetupType{SetupTypeActive}},
+		{"keep order", "udp,passive,active", []SetupType{SetupTypeUDP, SetupTypePassive, SetupTypeActive}},
+		{"trim and case", " Passive , ACTIVE ", []SetupType{SetupTypePassive, SetupTypeActive}},
+		{"ignore duplicates", "active,passive,active", []SetupType{SetupTypeActive, SetupTypePassive}},
+		// 无法识别的取流方式按udp处理, 与String2SetupType一致
+		{"unknown as udp", "tcp,udp", []SetupType{SetupTypeUDP}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := String2SetupTypes(test.str); !reflect.DeepEqual(got, test.want) {
+				t.Errorf("String2SetupTypes(%q) = %v, want %v", test.str, got, test.want)
+			}
+		})
+	}
+}
+
+func TestString2RecordPaths(t *testing.T) {
+	tests := []struct {
+		name       string
+		str        string
+		paths      []string
+		thresholds map[string]int
+	}{
+		{"empty", "", nil, map[string]int{}},
+		{"no threshold", "/data/rec, /data/rec2", []string{"/data/rec", "/data/rec2"}, map[string]int{}},
+		{"threshold", "/data/rec=85,/data/rec2", []string{"/data/rec", "/data/rec2"}, map[string]int{"/data/rec": 85}},
+		{"spaces", " /data/rec = 85 ", []string{"/data/rec"}, map[string]int{"/data/rec": 85}},
+		{"equal sign in path", "/data/a=b", []string{"/data/a=b"}, map[string]int{}},
+		{"equal sign in path with threshold", "/data/a=b=90", []string{"/data/a=b"}, map[string]int{"/data/a=b": 90}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			paths, thresholds := String2RecordPaths(test.str)
+			if !reflect.DeepEqual(paths, test.paths) || !reflect.DeepEqual(thresholds, test.thresholds) {
+				t.Errorf("String2RecordPaths(%q) = %v %v, want %v %v", test.str, paths, thresholds, test.paths, test.thresholds)
+			}
+		})
+	}
+}
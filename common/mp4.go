@@ -0,0 +1,88 @@
+package common
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"os"
+	"time"
+)
+
+// 查找[offset, end)范围内的mp4 box, 返回box内容的起止位置
+func findMP4Box(r io.ReaderAt, offset, end int64, boxType string) (int64, int64, error) {
+	header := make([]byte, 16)
+	for offset+8 <= end {
+		if _, err := r.ReadAt(header[:8], offset); err != nil {
+			return 0, 0, err
+		}
+
+		size := int64(binary.BigEndian.Uint32(header))
+		headerSize := int64(8)
+		if size == 1 {
+			if _, err := r.ReadAt(header[8:16], offset+8); err != nil {
+				return 0, 0, err
+			}
+
+			size = int64(binary.BigEndian.Uint64(header[8:16]))
+			headerSize = 16
+		} else if size == 0 {
+			// box延伸到文件末尾
+			size = end - offset
+		}
+
+		if size < headerSize || offset+size > end {
+			return 0, 0, fmt.Errorf("box长度错误 type: %s size: %d", header[4:8], size)
+		} else if string(header[4:8]) == boxType {
+			return offset + headerSize, offset + size, nil
+		}
+
+		offset += size
+	}
+
+	return 0, 0, fmt.Errorf("未找到box %s", boxType)
+}
+
+// MP4Duration 读取mp4文件moov/mvhd中的时长
+func MP4Duration(path string) (time.Duration, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return 0, err
+	}
+
+	defer file.Close()
+	info, err := file.Stat()
+	if err != nil {
+		return 0, err
+	}
+
+	start, end, err := findMP4Box(file, 0, info.Size(), "moov")
+	if err != nil {
+		return 0, err
+	}
+
+	start, end, err = findMP4Box(file, start, end, "mvhd")
+	if err != nil {
+		return 0, err
+	}
+
+	// version(1) flags(3), version 1的创建/修改时间和时长为64位
+	data := make([]byte, 32)
+	n, _ := io.ReadFull(io.NewSectionReader(file, start, end-start), data)
+
+	var timescale, duration uint64
+	if n >= 32 && data[0] == 1 {
+		timescale = uint64(binary.BigEndian.Uint32(data[20:24]))
+		duration = binary.BigEndian.Uint64(data[24:32])
+	} else if n >= 20 && data[0] == 0 {
+		timescale = uint64(binary.BigEndian.Uint32(data[12:16]))
+		duration = uint64(binary.BigEndian.Uint32(data[16:20]))
+	} else {
+		return 0, fmt.Errorf("mvhd长度错误")
+	}
+
+	if timescale == 0 {
+		return 0, fmt.Errorf("mvhd timescale为0")
+	}
+
+	return time.Duration(float64(duration) / float64(timescale) * float64(time.Second)), nil
+}
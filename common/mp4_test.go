@@ -0,0 +1,73 @@
+package common
+
+import (
+	"encoding/binary"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func mp4Box(boxType string, payload ...[]byte) []byte {
+	var data []byte
+	for _, p := range payload {
+		data = append(data, p...)
+	}
+
+	box := binary.BigEndian.AppendUint32(nil, uint32(8+len(data)))
+	return append(append(box, boxType...), data...)
+}
+
+func mvhdPayload(version byte, timescale uint32, duration uint64) []byte {
+	payload := []byte{version, 0, 0, 0}
+	if version == 1 {
+		payload = append(payload, make([]byte, 16)...)
+		payload = binary.BigEndian.AppendUint32(payload, timescale)
+		return binary.BigEndian.AppendUint64(payload, duration)
+	}
+
+	payload = append(payload, make([]byte, 8)...)
+	payload = binary.BigEndian.AppendUint32(payload, timescale)
+	return binary.BigEndian.AppendUint32(payload, uint32(duration))
+}
+
+func TestMP4Duration(t *testing.T) {
+	ftyp := mp4Box("ftyp", []byte("isom"), make([]byte, 4))
+	file := func(boxes ...[]byte) []byte {
+		data := append([]byte{}, ftyp...)
+		for _, box := range boxes {
+			data = append(data, box...)
+		}
+		return data
+	}
+
+	tests := []struct {
+		name    string
+		data    []byte
+		want    time.Duration
+		wantErr bool
+	}{
+		{"version 0", file(mp4Box("moov", mp4Box("mvhd", mvhdPayload(0, 1000, 60500)))), 60500 * time.Millisecond, false},
+		{"version 1", file(mp4Box("moov", mp4Box("trak"), mp4Box("mvhd", mvhdPayload(1, 90000, 90000*30)))), 30 * time.Second, false},
+		{"no moov", file(), 0, true},
+		{"no mvhd", file(mp4Box("moov", mp4Box("trak"))), 0, true},
+		{"zero timescale", file(mp4Box("moov", mp4Box("mvhd", mvhdPayload(0, 0, 100)))), 0, true},
+		{"truncated mvhd", file(mp4Box("moov", mp4Box("mvhd", []byte{0, 0, 0, 0}))), 0, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "test.mp4")
+			if err := os.WriteFile(path, test.data, 0644); err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := MP4Duration(path)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("MP4Duration() err = %v, wantErr %v", err, test.wantErr)
+			} else if got != test.want {
+				t.Errorf("MP4Duration() = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package common
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Payload RTP负载类型, 格式同rtpmap, 例如"96 PS/90000"
+type Payload string
+
+var (
+	// VideoPayloads 国标视频负载类型
+	VideoPayloads = map[string]Payload{
+		"PS":    "96 PS/90000",
+		"MPEG4": "97 MPEG4/90000",
+		"H264":  "98 H264/90000",
+		"SVAC":  "99 SVAC/90000",
+		"H265":  "100 H265/90000",
+	}
+
+	// AudioPayloads 国标音频负载类型
+	AudioPayloads = map[string]Payload{
+		"PCMA": "8 PCMA/8000",
+		"PCMU": "0 PCMU/8000",
+		"AAC":  "102 AAC/8000",
+	}
+
+	DefaultVideoPayload = VideoPayloads["PS"]
+	DefaultAudioPayload = AudioPayloads["PCMA"]
+)
+
+// PT 返回负载类型编号
+func (p Payload) PT() int {
+	pt, _ := strconv.Atoi(strings.Split(string(p), " ")[0])
+	return pt
+}
+
+// Name 返回编码名称, 例如PS/H264/PCMA
+func (p Payload) Name() string {
+	split := strings.Split(string(p), " ")
+	if len(split) < 2 {
+		return ""
+	}
+
+	return strings.Split(split[1], "/")[0]
+}
+
+// String2Payloads 解析逗号分隔的编码名称列表, 例如"PS,H264,H265". 也支持直接填写rtpmap, 例如"96 PS/90000". 为空返回def
+func String2Payloads(str string, payloads map[string]Payload, def Payload) []string {
+	var result []string
+	for _, name := range strings.Split(str, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+
+		if payload, ok := payloads[strings.ToUpper(name)]; ok {
+			result = append(result, string(payload))
+		} else if Payload(name).Name() != "" {
+			result = append(result, name)
+		}
+	}
+
+	if len(result) == 0 {
+		result = append(result, string(def))
+	}
+
+	return result
+}
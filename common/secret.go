@@ -0,0 +1,111 @@
+package common
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+const (
+	// SecretKeyFile 加密数据库中设备密码的密钥, 首次使用时随机生成
+	SecretKeyFile = "./data/secret.key"
+	// 加密后的密文前缀, 用于兼容未加密的旧数据
+	secretPrefix = "enc:"
+)
+
+var (
+	secretKey     []byte
+	secretKeyErr  error
+	secretKeyOnce sync.Once
+)
+
+func loadSecretKey() ([]byte, error) {
+	secretKeyOnce.Do(func() {
+		if data, err := os.ReadFile(SecretKeyFile); err == nil && len(data) == 32 {
+			secretKey = data
+			return
+		} else if err == nil {
+			secretKeyErr = fmt.Errorf("密钥文件长度错误 %s", SecretKeyFile)
+			return
+		}
+
+		key := make([]byte, 32)
+		if _, secretKeyErr = rand.Read(key); secretKeyErr != nil {
+			return
+		} else if secretKeyErr = os.MkdirAll(filepath.Dir(SecretKeyFile), 0755); secretKeyErr != nil {
+			return
+		} else if secretKeyErr = os.WriteFile(SecretKeyFile, key, 0600); secretKeyErr != nil {
+			return
+		}
+
+		secretKey = key
+	})
+
+	return secretKey, secretKeyErr
+}
+
+func newSecretCipher() (cipher.AEAD, error) {
+	key, err := loadSecretKey()
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
+
+// EncryptSecret 使用AES-GCM加密保存到数据库的密码
+func EncryptSecret(plaintext string) (string, error) {
+	if plaintext == "" {
+		return "", nil
+	}
+
+	gcm, err := newSecretCipher()
+	if err != nil {
+		return "", err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err = rand.Read(nonce); err != nil {
+		return "", err
+	}
+
+	data := gcm.Seal(nonce, nonce, []byte(plaintext), nil)
+	return secretPrefix + base64.StdEncoding.EncodeToString(data), nil
+}
+
+// DecryptSecret 解密EncryptSecret加密的密码, 没有密文前缀的按明文返回
+func DecryptSecret(ciphertext string) (string, error) {
+	if !strings.HasPrefix(ciphertext, secretPrefix) {
+		return ciphertext, nil
+	}
+
+	data, err := base64.StdEncoding.DecodeString(ciphertext[len(secretPrefix):])
+	if err != nil {
+		return "", err
+	}
+
+	gcm, err := newSecretCipher()
+	if err != nil {
+		return "", err
+	} else if len(data) < gcm.NonceSize() {
+		return "", fmt.Errorf("密文长度错误")
+	}
+
+	plaintext, err := gcm.Open(nil, data[:gcm.NonceSize()], data[gcm.NonceSize():], nil)
+	if err != nil {
+		return "", err
+	}
+
+	return string(plaintext), nil
+}
@@ -17,7 +17,57 @@ func (s StreamID) ChannelID() string {
 	return strings.Split(strings.Split(string(s), "/")[1], ".")[0]
 }
 
+// InviteType 返回流ID中的流类型, 没有类型后缀的为实时流
+func (s StreamID) InviteType() InviteType {
+	split := strings.Split(string(s), ".")
+	if len(split) > 1 {
+		switch t := InviteType(split[1]); t {
+		case InviteTypePlayback, InviteTypeDownload, InviteTypeBroadcast, InviteTypeTalk:
+			return t
+		}
+	}
+
+	return InviteTypePlay
+}
+
+// TimeRange 返回回放/下载流ID中的开始和结束时间, 单位秒
+func (s StreamID) TimeRange() (int64, int64) {
+	split := strings.Split(string(s), ".")
+	if len(split) < 4 {
+		return 0, 0
+	}
+
+	start, _ := strconv.ParseInt(split[2], 10, 64)
+	end, _ := strconv.ParseInt(split[3], 10, 64)
+	return start, end
+}
+
+// StreamNumber 返回流ID中的码流编号, 没有码流后缀的为主码流或由设备决定
+func (s StreamID) StreamNumber() int {
+	split := strings.Split(string(s), ".")
+	last := split[len(split)-1]
+	if len(split) < 2 || !strings.HasPrefix(last, "stream") {
+		return StreamNumberDefault
+	}
+
+	return String2StreamNumber(strings.TrimPrefix(last, "stream"))
+}
+
 func GenerateStreamID(inviteType InviteType, deviceId, channelId string, startTime, endTime string) StreamID {
+	return GenerateStreamIDWithNumber(inviteType, deviceId, channelId, startTime, endTime, StreamNumberDefault)
+}
+
+// GenerateStreamIDWithNumber 生成指定码流的流ID, 非主码流追加.stream{N}后缀, 保证不同码流的流ID不同
+func GenerateStreamIDWithNumber(inviteType InviteType, deviceId, channelId string, startTime, endTime string, streamNumber int) StreamID {
+	streamId := generateStreamID(inviteType, deviceId, channelId, startTime, endTime)
+	if streamNumber > StreamNumberMain {
+		streamId += StreamID(".stream" + strconv.Itoa(streamNumber))
+	}
+
+	return streamId
+}
+
+func generateStreamID(inviteType InviteType, deviceId, channelId string, startTime, endTime string) StreamID {
 	utils.Assert(channelId != "")
 
 	var streamId []string
@@ -45,6 +95,8 @@ func GenerateStreamID(inviteType InviteType, deviceId, channelId string, startTi
 		return StreamID(strings.Join(streamId, "/") + ".download" + "." + startTime + "." + endTime)
 	} else if InviteTypeBroadcast == inviteType {
 		return StreamID(strings.Join(streamId, "/") + ".broadcast")
+	} else if InviteTypeTalk == inviteType {
+		return StreamID(strings.Join(streamId, "/") + ".talk")
 	}
 
 	return StreamID(strings.Join(streamId, "/"))
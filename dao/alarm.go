@@ -1,11 +1,28 @@
 package dao
 
 import (
+	"fmt"
 	"gb-cms/common"
 	"gorm.io/gorm"
 	"time"
 )
 
+// 报警处理状态
+const (
+	AlarmStatusNew           = "new"            // 未处理
+	AlarmStatusAcknowledged  = "acknowledged"   // 已确认
+	AlarmStatusProcessing    = "processing"     // 处理中
+	AlarmStatusResolved      = "resolved"       // 已处理
+	AlarmStatusFalsePositive = "false_positive" // 误报
+)
+
+// 允许的状态流转, 只能向后流转, 已处理和误报不能再修改
+var alarmTransitions = map[string][]string{
+	AlarmStatusNew:          {AlarmStatusAcknowledged, AlarmStatusProcessing, AlarmStatusResolved, AlarmStatusFalsePositive},
+	AlarmStatusAcknowledged: {AlarmStatusProcessing, AlarmStatusResolved, AlarmStatusFalsePositive},
+	AlarmStatusProcessing:   {AlarmStatusResolved, AlarmStatusFalsePositive},
+}
+
 type AlarmModel struct {
 	GBModel
 	DeviceID          string
@@ -23,12 +40,48 @@ type AlarmModel struct {
 	AlarmType         *int
 	AlarmTypeName     string
 	EventType         *int
+
+	Status         string     `gorm:"default:new"` // 处理状态
+	Assignee       string     // 处理人
+	AcknowledgedAt *time.Time // 首次处理的时间, 用于统计响应时长
+	ClosedAt       *time.Time // 已处理或误报的时间
 }
 
 func (a *AlarmModel) TableName() string {
 	return "lkm_alarm"
 }
 
+// Closed 返回是否已处理完成
+func (a *AlarmModel) Closed() bool {
+	return AlarmStatusResolved == a.Status || AlarmStatusFalsePositive == a.Status
+}
+
+// AlarmHandleLogModel 报警的处理记录
+type AlarmHandleLogModel struct {
+	GBModel
+	AlarmID    uint   `gorm:"index"`
+	FromStatus string // 状态未变化时为空, 例如指派处理人
+	ToStatus   string
+	Assignee   string
+	Username   string // 操作人
+	Note       string // 处理说明
+	Time       string // 操作时间
+}
+
+func (a *AlarmHandleLogModel) TableName() string {
+	return "lkm_alarm_handle_log"
+}
+
+// AlarmStats 报警处理统计, 时长单位秒
+type AlarmStats struct {
+	Total             int
+	StatusCount       map[string]int
+	AvgResponseTime   float64 // 收到报警到首次处理的平均时长
+	MaxResponseTime   float64
+	AvgHandleTime     float64 // 收到报警到处理完成的平均时长
+	UnacknowledgedNum int     // 未处理的报警数
+}
+
 type daoAlarm struct {
 }
 
@@ -111,6 +164,10 @@ func (d *daoAlarm) Save(alarm *AlarmModel) error {
 		}
 	}
 
+	if alarm.Status == "" {
+		alarm.Status = AlarmStatusNew
+	}
+
 	deviceName, _ := Device.QueryDeviceName(alarm.DeviceID)
 	channelName, _ := Channel.QueryChannelName(alarm.DeviceID, alarm.ChannelID)
 	alarm.DeviceName = deviceName
@@ -150,6 +207,14 @@ func (d *daoAlarm) QueryAlarmList(page int, size int, conditions map[string]inte
 		tx.Where("alarm_method = ?", v.(int))
 	}
 
+	if v, ok := conditions["status"]; ok && v != "" {
+		tx.Where("status = ?", v.(string))
+	}
+
+	if v, ok := conditions["assignee"]; ok && v != "" {
+		tx.Where("assignee = ?", v.(string))
+	}
+
 	var count int64
 	tx.Count(&count)
 
@@ -164,9 +229,13 @@ func (d *daoAlarm) QueryAlarmList(page int, size int, conditions map[string]inte
 	return alarms, int(count), nil
 }
 
-// Delete 删除报警
+// Delete 删除报警和处理记录
 func (d *daoAlarm) Delete(id int) error {
 	return DBTransaction(func(tx *gorm.DB) error {
+		if err := tx.Where("alarm_id = ?", id).Delete(&AlarmHandleLogModel{}).Unscoped().Error; err != nil {
+			return err
+		}
+
 		return tx.Delete(&AlarmModel{}, id).Unscoped().Error
 	})
 }
@@ -174,6 +243,10 @@ func (d *daoAlarm) Delete(id int) error {
 func (d *daoAlarm) Clear() error {
 	// 清空报警
 	return DBTransaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("DELETE FROM lkm_alarm_handle_log;").Error; err != nil {
+			return err
+		}
+
 		return tx.Exec("DELETE FROM lkm_alarm;").Error
 	})
 }
@@ -181,6 +254,147 @@ func (d *daoAlarm) Clear() error {
 func (d *daoAlarm) DeleteExpired(time time.Time) error {
 	// 删除过期的报警记录
 	return DBTransaction(func(tx *gorm.DB) error {
-		return tx.Where("created_at < ?", time).Delete(&AlarmModel{}).Unscoped().Error
+		if err := tx.Where("created_at < ?", time).Delete(&AlarmModel{}).Unscoped().Error; err != nil {
+			return err
+		}
+
+		return tx.Exec("DELETE FROM lkm_alarm_handle_log WHERE alarm_id NOT IN (SELECT id FROM lkm_alarm);").Error
+	})
+}
+
+func (d *daoAlarm) QueryAlarm(id int) (*AlarmModel, error) {
+	var alarm AlarmModel
+	tx := db.Where("id =?", id).Take(&alarm)
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	return &alarm, nil
+}
+
+// Transition 修改报警的处理状态, 并保存处理记录. 返回修改后的报警
+func (d *daoAlarm) Transition(id int, status, username, note string) (*AlarmModel, error) {
+	var alarm AlarmModel
+	err := DBTransaction(func(tx *gorm.DB) error {
+		if err := tx.Where("id =?", id).Take(&alarm).Error; err != nil {
+			return fmt.Errorf("报警不存在")
+		}
+
+		var allowed bool
+		for _, to := range alarmTransitions[alarm.Status] {
+			allowed = allowed || to == status
+		}
+
+		if !allowed {
+			return fmt.Errorf("报警状态不能从%s修改为%s", alarm.Status, status)
+		}
+
+		now := time.Now()
+		from := alarm.Status
+		alarm.Status = status
+		if alarm.AcknowledgedAt == nil {
+			alarm.AcknowledgedAt = &now
+		}
+
+		if alarm.Closed() {
+			alarm.ClosedAt = &now
+		}
+
+		if err := tx.Save(&alarm).Error; err != nil {
+			return err
+		}
+
+		return tx.Create(&AlarmHandleLogModel{
+			AlarmID:    alarm.ID,
+			FromStatus: from,
+			ToStatus:   status,
+			Assignee:   alarm.Assignee,
+			Username:   username,
+			Note:       note,
+			Time:       now.Format("2006-01-02 15:04:05"),
+		}).Error
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &alarm, nil
+}
+
+// Assign 指派处理人
+func (d *daoAlarm) Assign(id int, assignee, username, note string) error {
+	return DBTransaction(func(tx *gorm.DB) error {
+		var alarm AlarmModel
+		if err := tx.Where("id =?", id).Take(&alarm).Error; err != nil {
+			return fmt.Errorf("报警不存在")
+		} else if alarm.Closed() {
+			return fmt.Errorf("报警已处理完成")
+		}
+
+		if err := tx.Model(&alarm).Update("assignee", assignee).Error; err != nil {
+			return err
+		}
+
+		return tx.Create(&AlarmHandleLogModel{
+			AlarmID:  alarm.ID,
+			ToStatus: alarm.Status,
+			Assignee: assignee,
+			Username: username,
+			Note:     note,
+			Time:     time.Now().Format("2006-01-02 15:04:05"),
+		}).Error
 	})
 }
+
+func (d *daoAlarm) QueryHandleLogs(id int) ([]*AlarmHandleLogModel, error) {
+	var logs []*AlarmHandleLogModel
+	tx := db.Where("alarm_id =?", id).Order("id asc").Find(&logs)
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	return logs, nil
+}
+
+// QueryStats 统计时间范围内收到的报警的处理情况
+func (d *daoAlarm) QueryStats(start, end time.Time) (*AlarmStats, error) {
+	var alarms []*AlarmModel
+	tx := db.Select("created_at", "status", "acknowledged_at", "closed_at").Where("created_at >= ? and created_at <= ?", start, end).Find(&alarms)
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	stats := &AlarmStats{Total: len(alarms), StatusCount: make(map[string]int, 5)}
+	var acknowledged, closed int
+	var responseTime, handleTime float64
+	for _, alarm := range alarms {
+		stats.StatusCount[alarm.Status]++
+		if alarm.AcknowledgedAt == nil {
+			stats.UnacknowledgedNum++
+			continue
+		}
+
+		seconds := alarm.AcknowledgedAt.Sub(alarm.CreatedAt).Seconds()
+		acknowledged++
+		responseTime += seconds
+		if seconds > stats.MaxResponseTime {
+			stats.MaxResponseTime = seconds
+		}
+
+		if alarm.ClosedAt != nil {
+			closed++
+			handleTime += alarm.ClosedAt.Sub(alarm.CreatedAt).Seconds()
+		}
+	}
+
+	if acknowledged > 0 {
+		stats.AvgResponseTime = responseTime / float64(acknowledged)
+	}
+
+	if closed > 0 {
+		stats.AvgHandleTime = handleTime / float64(closed)
+	}
+
+	return stats, nil
+}
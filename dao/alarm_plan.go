@@ -0,0 +1,305 @@
+package dao
+
+import (
+	"errors"
+	"gorm.io/gorm"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// AlarmPlanModel 报警预案, 关联通道的报警满足条件时执行联动动作
+type AlarmPlanModel struct {
+	GBModel
+	Name      string `json:"name"`
+	Enable    bool   `json:"enable"`
+	Priority  string `json:"priority"`   // 匹配的报警级别, 多个用逗号分隔, 为空匹配所有. 下同
+	Method    string `json:"method"`     // 匹配的报警方式
+	Type      string `json:"type"`       // 匹配的报警类型
+	EventType string `json:"event_type"` // 匹配的报警类型参数
+	StartTime string `json:"start_time"` // 生效时间段, 例如08:00, 为空全天生效
+	EndTime   string `json:"end_time"`   // 结束时间小于开始时间表示跨天
+
+	RecordDuration    int    `json:"record_duration"`    // 报警通道录像时长, 单位秒, 0-不录像
+	SnapInterval      int    `json:"snap_interval"`      // 报警通道抓拍间隔, 单位秒, 0-不抓拍
+	PresetDeviceID    string `json:"preset_device_id"`   // 调用预置位的设备, 一般为附近的球机
+	PresetChannelID   string `json:"preset_channel_id"`  // 调用预置位的通道
+	PresetIndex       int    `json:"preset_index"`       // 预置位编号, 0-不调用
+	WebhookURL        string `json:"webhook_url"`        // 推送报警和预案到该地址, 为空不推送
+	BroadcastURL      string `json:"broadcast_url"`      // 向报警通道广播的音频地址, 由流媒体服务拉流, 为空不广播
+	BroadcastDuration int    `json:"broadcast_duration"` // 广播时长, 单位秒
+}
+
+func (a *AlarmPlanModel) TableName() string {
+	return "lkm_alarm_plan"
+}
+
+// 逗号分隔的条件为空, 或包含指定值
+func matchCondition(condition string, value *int) bool {
+	if condition == "" {
+		return true
+	} else if value == nil {
+		return false
+	}
+
+	for _, s := range strings.Split(condition, ",") {
+		if strings.TrimSpace(s) == strconv.Itoa(*value) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// InTime 返回指定时间是否在生效时间段内
+func (a *AlarmPlanModel) InTime(t time.Time) bool {
+	if a.StartTime == "" || a.EndTime == "" {
+		return true
+	}
+
+	now := t.Format("15:04")
+	if a.StartTime <= a.EndTime {
+		return now >= a.StartTime && now < a.EndTime
+	}
+
+	// 跨天
+	return now >= a.StartTime || now < a.EndTime
+}
+
+// Match 返回报警是否满足预案的条件
+func (a *AlarmPlanModel) Match(alarm *AlarmModel, t time.Time) bool {
+	return a.InTime(t) &&
+		matchCondition(a.Priority, &alarm.AlarmPriority) &&
+		matchCondition(a.Method, &alarm.AlarmMethod) &&
+		matchCondition(a.Type, alarm.AlarmType) &&
+		matchCondition(a.EventType, alarm.EventType)
+}
+
+// AlarmPlanChannelModel 报警预案关联的通道, 关联目录时对目录下的所有通道生效
+type AlarmPlanChannelModel struct {
+	GBModel
+	PlanID    uint   `json:"plan_id" gorm:"index"`
+	DeviceID  string `json:"device_id" gorm:"index"`
+	ChannelID string `json:"channel_id"`
+	IsDir     bool   `json:"is_dir"`
+}
+
+func (a *AlarmPlanChannelModel) TableName() string {
+	return "lkm_alarm_plan_channel"
+}
+
+// AlarmPlanLogModel 报警预案的执行记录
+type AlarmPlanLogModel struct {
+	GBModel
+	PlanID    uint   `json:"plan_id" gorm:"index"`
+	PlanName  string `json:"plan_name"`
+	DeviceID  string `json:"device_id"`
+	ChannelID string `json:"channel_id"`
+	Action    string `json:"action"` // record/preset/snap/webhook/broadcast
+	Result    string `json:"result"` // OK或失败原因
+}
+
+func (a *AlarmPlanLogModel) TableName() string {
+	return "lkm_alarm_plan_log"
+}
+
+type daoAlarmPlan struct {
+}
+
+func (d *daoAlarmPlan) SavePlan(plan *AlarmPlanModel) error {
+	return DBTransaction(func(tx *gorm.DB) error {
+		return tx.Save(plan).Error
+	})
+}
+
+func (d *daoAlarmPlan) QueryPlan(id int) (*AlarmPlanModel, error) {
+	var plan AlarmPlanModel
+	tx := db.Where("id =?", id).Take(&plan)
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	return &plan, nil
+}
+
+// QueryPlans 分页查询报警预案
+func (d *daoAlarmPlan) QueryPlans(page, size int, keyword string) ([]*AlarmPlanModel, int, error) {
+	cond := db.Model(&AlarmPlanModel{})
+	if keyword != "" {
+		cond = cond.Where("name like ?", "%"+keyword+"%")
+	}
+
+	var total int64
+	if tx := cond.Count(&total); tx.Error != nil {
+		return nil, 0, tx.Error
+	}
+
+	var plans []*AlarmPlanModel
+	tx := cond.Limit(size).Offset((page - 1) * size).Find(&plans)
+	if tx.Error != nil {
+		return nil, 0, tx.Error
+	}
+
+	return plans, int(total), nil
+}
+
+func (d *daoAlarmPlan) QueryEnabledPlans() ([]*AlarmPlanModel, error) {
+	var plans []*AlarmPlanModel
+	tx := db.Where("enable =?", true).Find(&plans)
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	return plans, nil
+}
+
+func (d *daoAlarmPlan) UpdateEnable(id int, enable bool) error {
+	return DBTransaction(func(tx *gorm.DB) error {
+		return tx.Model(&AlarmPlanModel{}).Where("id =?", id).Update("enable", enable).Error
+	})
+}
+
+// DeletePlan 删除报警预案和关联的通道, 保留执行记录
+func (d *daoAlarmPlan) DeletePlan(id int) error {
+	return DBTransaction(func(tx *gorm.DB) error {
+		if err := tx.Unscoped().Delete(&AlarmPlanChannelModel{}, "plan_id =?", id).Error; err != nil {
+			return err
+		}
+
+		return tx.Unscoped().Delete(&AlarmPlanModel{}, "id =?", id).Error
+	})
+}
+
+// BindChannels 关联通道, channels格式为deviceId:channelId
+func (d *daoAlarmPlan) BindChannels(planId int, channels []string) error {
+	return DBTransaction(func(tx *gorm.DB) error {
+		for _, channel := range channels {
+			ids := strings.Split(channel, ":")
+			if len(ids) != 2 {
+				continue
+			}
+
+			var count int64
+			if err := tx.Model(&AlarmPlanChannelModel{}).Where("device_id =? and channel_id =? and plan_id =?", ids[0], ids[1], planId).Count(&count).Error; err != nil {
+				return err
+			} else if count > 0 {
+				continue
+			}
+
+			// 忽略不存在的通道
+			var model ChannelModel
+			if err := tx.Where("root_id =? and device_id =?", ids[0], ids[1]).Take(&model).Error; errors.Is(err, gorm.ErrRecordNotFound) {
+				continue
+			} else if err != nil {
+				return err
+			} else if err = tx.Create(&AlarmPlanChannelModel{
+				PlanID:    uint(planId),
+				DeviceID:  ids[0],
+				ChannelID: ids[1],
+				IsDir:     model.IsDir,
+			}).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (d *daoAlarmPlan) UnbindChannels(planId int, channels []string) error {
+	return DBTransaction(func(tx *gorm.DB) error {
+		for _, channel := range channels {
+			ids := strings.Split(channel, ":")
+			if len(ids) != 2 {
+				continue
+			}
+
+			if err := tx.Unscoped().Delete(&AlarmPlanChannelModel{}, "device_id =? and channel_id =? and plan_id =?", ids[0], ids[1], planId).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (d *daoAlarmPlan) QueryPlanChannels(planId int) ([]*AlarmPlanChannelModel, error) {
+	var channels []*AlarmPlanChannelModel
+	tx := db.Where("plan_id =?", planId).Find(&channels)
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	return channels, nil
+}
+
+func (d *daoAlarmPlan) QueryPlanChannelCount(planId int) (int, error) {
+	var total int64
+	tx := db.Model(&AlarmPlanChannelModel{}).Where("plan_id =?", planId).Count(&total)
+	if tx.Error != nil {
+		return 0, tx.Error
+	}
+
+	return int(total), nil
+}
+
+func (d *daoAlarmPlan) QueryPlanChannelExist(planId int, deviceId, channelId string) (bool, error) {
+	var total int64
+	tx := db.Model(&AlarmPlanChannelModel{}).Where("plan_id =? and device_id =? and channel_id =?", planId, deviceId, channelId).Count(&total)
+	if tx.Error != nil {
+		return false, tx.Error
+	}
+
+	return total > 0, nil
+}
+
+// QueryPlanContainsChannel 返回通道是否关联了报警预案, 包括通过目录关联
+func (d *daoAlarmPlan) QueryPlanContainsChannel(planId int, deviceId, channelId string) (bool, error) {
+	relations, err := d.QueryPlanChannels(planId)
+	if err != nil {
+		return false, err
+	}
+
+	for _, relation := range relations {
+		if relation.DeviceID != deviceId {
+			continue
+		} else if relation.ChannelID == channelId {
+			return true, nil
+		} else if !relation.IsDir {
+			continue
+		}
+
+		for _, channel := range RecordPlan.queryDirChannels(deviceId, relation.ChannelID) {
+			if channel.DeviceID == channelId {
+				return true, nil
+			}
+		}
+	}
+
+	return false, nil
+}
+
+func (d *daoAlarmPlan) SaveLog(log *AlarmPlanLogModel) error {
+	return DBTransaction(func(tx *gorm.DB) error {
+		return tx.Create(log).Error
+	})
+}
+
+// QueryLogs 分页查询执行记录, planId为0查询所有
+func (d *daoAlarmPlan) QueryLogs(page, size int, planId int) ([]*AlarmPlanLogModel, int, error) {
+	cond := db.Model(&AlarmPlanLogModel{})
+	if planId > 0 {
+		cond = cond.Where("plan_id =?", planId)
+	}
+
+	var total int64
+	if tx := cond.Count(&total); tx.Error != nil {
+		return nil, 0, tx.Error
+	}
+
+	var logs []*AlarmPlanLogModel
+	tx := cond.Order("id desc").Limit(size).Offset((page - 1) * size).Find(&logs)
+	if tx.Error != nil {
+		return nil, 0, tx.Error
+	}
+
+	return logs, int(total), nil
+}
@@ -0,0 +1,84 @@
+package dao
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAlarmPlanInTime(t *testing.T) {
+	at := func(clock string) time.Time {
+		t, _ := time.ParseInLocation("2006-01-02 15:04", "2024-06-18 "+clock, time.Local)
+		return t
+	}
+
+	tests := []struct {
+		name       string
+		start, end string
+		clock      string
+		want       bool
+	}{
+		{"all day", "", "", "03:00", true},
+		{"no end time", "08:00", "", "03:00", true},
+		{"before window", "08:00", "18:00", "07:59", false},
+		{"window start", "08:00", "18:00", "08:00", true},
+		{"inside window", "08:00", "18:00", "12:30", true},
+		{"window end excluded", "08:00", "18:00", "18:00", false},
+		{"cross midnight evening", "22:00", "06:00", "23:30", true},
+		{"cross midnight start", "22:00", "06:00", "22:00", true},
+		{"cross midnight after midnight", "22:00", "06:00", "00:00", true},
+		{"cross midnight early morning", "22:00", "06:00", "05:59", true},
+		{"cross midnight end excluded", "22:00", "06:00", "06:00", false},
+		{"cross midnight daytime", "22:00", "06:00", "12:00", false},
+		{"same start and end", "08:00", "08:00", "08:00", false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			plan := &AlarmPlanModel{StartTime: test.start, EndTime: test.end}
+			if got := plan.InTime(at(test.clock)); got != test.want {
+				t.Errorf("InTime(%s) = %v, want %v", test.clock, got, test.want)
+			}
+		})
+	}
+}
+
+func TestAlarmPlanMatch(t *testing.T) {
+	intPtr := func(v int) *int {
+		return &v
+	}
+
+	now, _ := time.ParseInLocation("2006-01-02 15:04", "2024-06-18 12:00", time.Local)
+	alarm := &AlarmModel{AlarmPriority: 1, AlarmMethod: 5, AlarmType: intPtr(2), EventType: intPtr(7)}
+	noType := &AlarmModel{AlarmPriority: 1, AlarmMethod: 5}
+
+	tests := []struct {
+		name  string
+		plan  AlarmPlanModel
+		alarm *AlarmModel
+		want  bool
+	}{
+		{"no condition", AlarmPlanModel{}, alarm, true},
+		{"priority match", AlarmPlanModel{Priority: "1"}, alarm, true},
+		{"priority in list", AlarmPlanModel{Priority: "3, 1"}, alarm, true},
+		{"priority mismatch", AlarmPlanModel{Priority: "2,3"}, alarm, false},
+		{"method match", AlarmPlanModel{Method: "2,5"}, alarm, true},
+		{"method mismatch", AlarmPlanModel{Method: "2"}, alarm, false},
+		{"type match", AlarmPlanModel{Type: "2"}, alarm, true},
+		{"type mismatch", AlarmPlanModel{Type: "1"}, alarm, false},
+		{"type required", AlarmPlanModel{Type: "2"}, noType, false},
+		{"event type match", AlarmPlanModel{EventType: "7"}, alarm, true},
+		{"event type required", AlarmPlanModel{EventType: "7"}, noType, false},
+		{"all conditions", AlarmPlanModel{Priority: "1", Method: "5", Type: "2", EventType: "7"}, alarm, true},
+		{"one condition mismatch", AlarmPlanModel{Priority: "1", Method: "5", Type: "3"}, alarm, false},
+		{"out of time", AlarmPlanModel{StartTime: "22:00", EndTime: "06:00"}, alarm, false},
+		{"in time", AlarmPlanModel{StartTime: "08:00", EndTime: "18:00", Priority: "1"}, alarm, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := test.plan.Match(test.alarm, now); got != test.want {
+				t.Errorf("Match() = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,137 @@
+package dao
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func createTestAlarm(t *testing.T, alarm *AlarmModel) *AlarmModel {
+	if err := db.Create(alarm).Error; err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		_ = Alarm.Delete(int(alarm.ID))
+	})
+
+	return alarm
+}
+
+func TestAlarmTransition(t *testing.T) {
+	tests := []struct {
+		name    string
+		from    string
+		to      string
+		wantErr bool
+	}{
+		{"new to acknowledged", AlarmStatusNew, AlarmStatusAcknowledged, false},
+		{"new to resolved", AlarmStatusNew, AlarmStatusResolved, false},
+		{"acknowledged to processing", AlarmStatusAcknowledged, AlarmStatusProcessing, false},
+		{"processing to false positive", AlarmStatusProcessing, AlarmStatusFalsePositive, false},
+		{"unknown status", AlarmStatusNew, "closed", true},
+		{"same status", AlarmStatusAcknowledged, AlarmStatusAcknowledged, true},
+		{"backwards", AlarmStatusProcessing, AlarmStatusAcknowledged, true},
+		{"back to new", AlarmStatusAcknowledged, AlarmStatusNew, true},
+		{"reopen resolved", AlarmStatusResolved, AlarmStatusProcessing, true},
+		{"resolve again", AlarmStatusResolved, AlarmStatusResolved, true},
+		{"false positive to resolved", AlarmStatusFalsePositive, AlarmStatusResolved, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			alarm := createTestAlarm(t, &AlarmModel{DeviceID: "test", Status: test.from})
+			got, err := Alarm.Transition(int(alarm.ID), test.to, "admin", "")
+			if (err != nil) != test.wantErr {
+				t.Fatalf("Transition(%s -> %s) err = %v, wantErr %v", test.from, test.to, err, test.wantErr)
+			}
+
+			logs, _ := Alarm.QueryHandleLogs(int(alarm.ID))
+			if test.wantErr {
+				if old, _ := Alarm.QueryAlarm(int(alarm.ID)); old.Status != test.from {
+					t.Errorf("status = %s, want %s", old.Status, test.from)
+				} else if len(logs) != 0 {
+					t.Errorf("handle logs = %d, want 0", len(logs))
+				}
+				return
+			}
+
+			if got.Status != test.to || got.AcknowledgedAt == nil {
+				t.Errorf("status = %s acknowledged = %v, want %s", got.Status, got.AcknowledgedAt, test.to)
+			} else if got.Closed() != (got.ClosedAt != nil) {
+				t.Errorf("closed = %v closed at = %v", got.Closed(), got.ClosedAt)
+			} else if len(logs) != 1 || logs[0].FromStatus != test.from || logs[0].ToStatus != test.to || logs[0].Username != "admin" {
+				t.Errorf("handle logs = %+v", logs)
+			}
+		})
+	}
+
+	t.Run("keep first acknowledged time", func(t *testing.T) {
+		alarm := createTestAlarm(t, &AlarmModel{DeviceID: "test", Status: AlarmStatusNew})
+		first, err := Alarm.Transition(int(alarm.ID), AlarmStatusAcknowledged, "admin", "")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		time.Sleep(10 * time.Millisecond)
+		resolved, err := Alarm.Transition(int(alarm.ID), AlarmStatusResolved, "admin", "")
+		if err != nil {
+			t.Fatal(err)
+		} else if !resolved.AcknowledgedAt.Equal(*first.AcknowledgedAt) {
+			t.Errorf("acknowledged at = %v, want %v", resolved.AcknowledgedAt, first.AcknowledgedAt)
+		} else if _, err = Alarm.Transition(int(alarm.ID), AlarmStatusFalsePositive, "admin", ""); err == nil {
+			t.Errorf("closed alarm changed to %s", AlarmStatusFalsePositive)
+		}
+	})
+}
+
+func TestAlarmQueryStats(t *testing.T) {
+	// 使用不会与其他报警重叠的时间段
+	base := time.Date(2001, 1, 1, 0, 0, 0, 0, time.Local)
+	at := func(seconds int) *time.Time {
+		t := base.Add(time.Duration(seconds) * time.Second)
+		return &t
+	}
+
+	alarms := []*AlarmModel{
+		{Status: AlarmStatusNew},
+		{Status: AlarmStatusAcknowledged, AcknowledgedAt: at(10)},
+		{Status: AlarmStatusResolved, AcknowledgedAt: at(30), ClosedAt: at(100)},
+		{Status: AlarmStatusFalsePositive, AcknowledgedAt: at(20), ClosedAt: at(20)},
+	}
+
+	for _, alarm := range alarms {
+		alarm.DeviceID = "test"
+		alarm.CreatedAt = base
+		createTestAlarm(t, alarm)
+	}
+
+	// 时间段外的报警不统计
+	createTestAlarm(t, &AlarmModel{DeviceID: "test", Status: AlarmStatusNew, GBModel: GBModel{CreatedAt: base.Add(-time.Hour)}})
+
+	stats, err := Alarm.QueryStats(base, base.Add(time.Minute))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	equal := func(a, b float64) bool {
+		return math.Abs(a-b) < 1e-6
+	}
+
+	if stats.Total != 4 || stats.UnacknowledgedNum != 1 {
+		t.Errorf("total = %d unacknowledged = %d, want 4 1", stats.Total, stats.UnacknowledgedNum)
+	} else if stats.StatusCount[AlarmStatusNew] != 1 || stats.StatusCount[AlarmStatusResolved] != 1 {
+		t.Errorf("status count = %v", stats.StatusCount)
+	} else if !equal(stats.AvgResponseTime, 20) || !equal(stats.MaxResponseTime, 30) {
+		t.Errorf("response time avg = %f max = %f, want 20 30", stats.AvgResponseTime, stats.MaxResponseTime)
+	} else if !equal(stats.AvgHandleTime, 60) {
+		t.Errorf("handle time avg = %f, want 60", stats.AvgHandleTime)
+	}
+
+	empty, err := Alarm.QueryStats(base.Add(-48*time.Hour), base.Add(-24*time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	} else if empty.Total != 0 || empty.AvgResponseTime != 0 || empty.AvgHandleTime != 0 {
+		t.Errorf("empty stats = %+v", empty)
+	}
+}
@@ -0,0 +1,243 @@
+package dao
+
+import (
+	"gorm.io/gorm"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// CloudRecordModel 云端录像片段, 流媒体服务每录制完成一个文件, 通过on_record通知保存索引
+type CloudRecordModel struct {
+	GBModel
+	DeviceID  string `json:"device_id" gorm:"index:idx_cloud_record_channel"`
+	ChannelID string `json:"channel_id" gorm:"index:idx_cloud_record_channel"`
+	StreamID  string `json:"stream_id"`
+	StartTime int64  `json:"start_time" gorm:"index"` // 开始时间, unix秒
+	EndTime   int64  `json:"end_time"`                // 结束时间, unix秒
+	Size      int64  `json:"size"`                    // 文件大小, 字节
+	Path      string `json:"path"`                    // 流媒体服务的录像文件路径
+	Important bool   `json:"important"`               // 作为证据锁定, 不会被自动清理
+}
+
+func (c *CloudRecordModel) TableName() string {
+	return "lkm_cloud_record"
+}
+
+// Duration 录像时长, 单位秒
+func (c *CloudRecordModel) Duration() int64 {
+	return c.EndTime - c.StartTime
+}
+
+// CloudRecordRetentionModel 通道的录像保留策略, 未设置的通道使用全局配置
+type CloudRecordRetentionModel struct {
+	GBModel
+	DeviceID    string `json:"device_id" gorm:"uniqueIndex:idx_cloud_record_retention"`
+	ChannelID   string `json:"channel_id" gorm:"uniqueIndex:idx_cloud_record_retention"`
+	ReserveDays int    `json:"reserve_days"` // 录像保留天数
+}
+
+func (c *CloudRecordRetentionModel) TableName() string {
+	return "lkm_cloud_record_retention"
+}
+
+// CloudRecordChannel 有云端录像的通道
+type CloudRecordChannel struct {
+	DeviceID  string
+	ChannelID string
+	StartTime int64 // 最早录像时间
+	EndTime   int64 // 最近录像时间
+	Count     int   // 录像片段数
+}
+
+type daoCloudRecord struct {
+}
+
+func (d *daoCloudRecord) Save(record *CloudRecordModel) error {
+	return DBTransaction(func(tx *gorm.DB) error {
+		return tx.Save(record).Error
+	})
+}
+
+func (d *daoCloudRecord) QueryRecord(id int) (*CloudRecordModel, error) {
+	var record CloudRecordModel
+	tx := db.Where("id =?", id).Take(&record)
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	return &record, nil
+}
+
+// QueryLastRecord 查询流的最后一段录像
+func (d *daoCloudRecord) QueryLastRecord(streamId string) (*CloudRecordModel, error) {
+	var record CloudRecordModel
+	tx := db.Where("stream_id =?", streamId).Order("end_time desc").Take(&record)
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	return &record, nil
+}
+
+// QueryRecordByStartTime 根据通道和开始时间查询录像片段
+func (d *daoCloudRecord) QueryRecordByStartTime(deviceId, channelId string, startTime int64) (*CloudRecordModel, error) {
+	var record CloudRecordModel
+	tx := db.Where("device_id =? and channel_id =? and start_time =?", deviceId, channelId, startTime).Take(&record)
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	return &record, nil
+}
+
+// QueryRecords 查询通道在时间范围内的录像片段, 与时间范围有交集的都返回, 按开始时间排序
+func (d *daoCloudRecord) QueryRecords(deviceId, channelId string, start, end time.Time, desc bool) ([]*CloudRecordModel, error) {
+	order := "start_time asc"
+	if desc {
+		order = "start_time desc"
+	}
+
+	var records []*CloudRecordModel
+	tx := db.Where("device_id =? and channel_id =? and start_time <? and end_time >?", deviceId, channelId, end.Unix(), start.Unix()).Order(order).Find(&records)
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	return records, nil
+}
+
+// QueryChannels 分页查询有云端录像的通道, keyword匹配设备ID或通道ID
+func (d *daoCloudRecord) QueryChannels(page, size int, keyword string, desc bool) ([]*CloudRecordChannel, int, error) {
+	cond := db.Model(&CloudRecordModel{})
+	if keyword != "" {
+		cond = cond.Where("device_id like ? or channel_id like ?", "%"+keyword+"%", "%"+keyword+"%")
+	}
+
+	// 按通道分组后统计通道数
+	var total int64
+	group := cond.Session(&gorm.Session{}).Select("device_id, channel_id").Group("device_id, channel_id")
+	if tx := db.Table("(?) as t", group).Count(&total); tx.Error != nil {
+		return nil, 0, tx.Error
+	}
+
+	order := "end_time asc"
+	if desc {
+		order = "end_time desc"
+	}
+
+	var channels []*CloudRecordChannel
+	tx := cond.Select("device_id, channel_id, min(start_time) as start_time, max(end_time) as end_time, count(*) as count").
+		Group("device_id, channel_id").Order(order).Limit(size).Offset((page - 1) * size).Scan(&channels)
+	if tx.Error != nil {
+		return nil, 0, tx.Error
+	}
+
+	return channels, int(total), nil
+}
+
+// QueryStartTimes 查询通道所有录像片段的开始时间, 用于统计有录像的日期
+func (d *daoCloudRecord) QueryStartTimes(deviceId, channelId string) ([]int64, error) {
+	var times []int64
+	tx := db.Model(&CloudRecordModel{}).Where("device_id =? and channel_id =?", deviceId, channelId).Pluck("start_time", &times)
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	return times, nil
+}
+
+// QueryExpiredRecords 查询结束时间早于expired的未锁定录像, 按开始时间升序
+func (d *daoCloudRecord) QueryExpiredRecords(deviceId, channelId string, expired time.Time, limit int) ([]*CloudRecordModel, error) {
+	var records []*CloudRecordModel
+	tx := db.Where("device_id =? and channel_id =? and end_time <? and important =?", deviceId, channelId, expired.Unix(), false).
+		Order("start_time asc").Limit(limit).Find(&records)
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	return records, nil
+}
+
+// QueryGlobalExpiredRecords 查询结束时间早于expired, 且未单独设置保留策略的通道的未锁定录像
+func (d *daoCloudRecord) QueryGlobalExpiredRecords(expired time.Time, limit int) ([]*CloudRecordModel, error) {
+	var records []*CloudRecordModel
+	tx := db.Where("end_time <? and important =?", expired.Unix(), false).
+		Where("not exists (select 1 from lkm_cloud_record_retention r where r.device_id = lkm_cloud_record.device_id and r.channel_id = lkm_cloud_record.channel_id)").
+		Order("start_time asc").Limit(limit).Find(&records)
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	return records, nil
+}
+
+// 转义like的通配符, 配合escape '\'使用
+func escapeLike(str string) string {
+	return strings.NewReplacer("\\", "\\\\", "%", "\\%", "_", "\\_").Replace(str)
+}
+
+// QueryOldestRecords 查询存储路径下最早的未锁定录像
+func (d *daoCloudRecord) QueryOldestRecords(dir string, limit int) ([]*CloudRecordModel, error) {
+	// 以分隔符结尾, 避免匹配到前缀相同的其他目录, 例如/data/rec和/data/rec2
+	prefix := strings.TrimRight(dir, "/\\") + string(filepath.Separator)
+	var records []*CloudRecordModel
+	tx := db.Where("path like ? escape '\\' and important =?", escapeLike(prefix)+"%", false).Order("start_time asc").Limit(limit).Find(&records)
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	return records, nil
+}
+
+func (d *daoCloudRecord) DeleteRecords(ids []uint) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	return DBTransaction(func(tx *gorm.DB) error {
+		return tx.Unscoped().Delete(&CloudRecordModel{}, "id in ?", ids).Error
+	})
+}
+
+func (d *daoCloudRecord) UpdateImportant(id uint, important bool) error {
+	return DBTransaction(func(tx *gorm.DB) error {
+		return tx.Model(&CloudRecordModel{}).Where("id =?", id).Update("important", important).Error
+	})
+}
+
+// SaveRetention 设置通道的录像保留天数, 小于1表示使用全局配置
+func (d *daoCloudRecord) SaveRetention(deviceId, channelId string, days int) error {
+	return DBTransaction(func(tx *gorm.DB) error {
+		if days < 1 {
+			return tx.Unscoped().Delete(&CloudRecordRetentionModel{}, "device_id =? and channel_id =?", deviceId, channelId).Error
+		}
+
+		var retention CloudRecordRetentionModel
+		tx.Where("device_id =? and channel_id =?", deviceId, channelId).Take(&retention)
+		retention.DeviceID = deviceId
+		retention.ChannelID = channelId
+		retention.ReserveDays = days
+		return tx.Save(&retention).Error
+	})
+}
+
+func (d *daoCloudRecord) QueryRetention(deviceId, channelId string) (*CloudRecordRetentionModel, error) {
+	var retention CloudRecordRetentionModel
+	tx := db.Where("device_id =? and channel_id =?", deviceId, channelId).Take(&retention)
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	return &retention, nil
+}
+
+func (d *daoCloudRecord) QueryRetentions() ([]*CloudRecordRetentionModel, error) {
+	var retentions []*CloudRecordRetentionModel
+	tx := db.Find(&retentions)
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	return retentions, nil
+}
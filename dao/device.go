@@ -43,6 +43,12 @@ type DeviceModel struct {
 	Longitude         float64
 	Latitude          float64
 	DropChannelType   string
+
+	InviteTimeout      int `json:"invite_timeout"`       // invite超时时间, 单位秒, 0-使用全局配置
+	AckTimeout         int `json:"ack_timeout"`          // 等待200应答的超时时间, 单位秒, 0-使用全局配置
+	FirstPacketTimeout int `json:"first_packet_timeout"` // 等待首包的超时时间, 单位秒, 0-使用全局配置
+
+	StreamMode common.StreamMode `json:"stream_mode"` // 默认码流, 例如streamnumber:1, 为空由设备决定
 }
 
 func (d *DeviceModel) TableName() string {
@@ -64,6 +70,22 @@ func (d *DeviceModel) GetSetup() common.SetupType {
 	return d.Setup
 }
 
+// GetInviteTimeout 返回invite、200应答、首包的超时时间(秒), 设备未设置则使用全局配置
+func (d *DeviceModel) GetInviteTimeout() (int, int, int) {
+	invite, ack, firstPacket := common.Config.InviteTimeout, common.Config.AckTimeout, common.Config.FirstPacketTimeout
+	if d.InviteTimeout > 0 {
+		invite = d.InviteTimeout
+	}
+	if d.AckTimeout > 0 {
+		ack = d.AckTimeout
+	}
+	if d.FirstPacketTimeout > 0 {
+		firstPacket = d.FirstPacketTimeout
+	}
+
+	return invite, ack, firstPacket
+}
+
 type daoDevice struct {
 }
 
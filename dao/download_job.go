@@ -0,0 +1,142 @@
+package dao
+
+import (
+	"gorm.io/gorm"
+	"time"
+)
+
+// 下载任务状态
+const (
+	DownloadStatusWaiting     = "waiting"     // 等待下载或等待重试
+	DownloadStatusDownloading = "downloading" // 下载中
+	DownloadStatusMerging     = "merging"     // 设备已发送完毕, 等待流媒体服务生成文件
+	DownloadStatusCompleted   = "completed"
+	DownloadStatusFailed      = "failed"
+	DownloadStatusCanceled    = "canceled"
+)
+
+// DownloadJobModel 录像下载任务, 下载完成后由流媒体服务生成MP4文件
+type DownloadJobModel struct {
+	GBModel
+	DeviceID     string    `json:"device_id" gorm:"index"`
+	ChannelID    string    `json:"channel_id"`
+	Name         string    `json:"name"`       // 通道名
+	StartTime    string    `json:"start_time"` // 录像开始时间, 2006-01-02T15:04:05
+	EndTime      string    `json:"end_time"`
+	Speed        int       `json:"speed"` // 下载倍速
+	StreamNumber int       `json:"stream_number"`
+	StreamID     string    `json:"stream_id" gorm:"index"`
+	Status       string    `json:"status" gorm:"index"`
+	FileSize     int64     `json:"file_size"`     // 设备应答的文件大小, 未携带为0
+	Downloaded   int64     `json:"downloaded"`    // 已接收的字节数
+	Progress     float64   `json:"progress"`      // 下载进度, 0-100
+	Path         string    `json:"path"`          // 生成的MP4文件路径
+	Size         int64     `json:"size"`          // 生成的MP4文件大小
+	Retries      int       `json:"retries"`       // 已重试次数
+	NextRetryAt  time.Time `json:"next_retry_at"` // 下次重试时间
+	Error        string    `json:"error"`         // 最近一次失败原因
+	CompletedAt  time.Time `json:"completed_at"`
+	ExportID     uint      `json:"export_id" gorm:"index"` // 所属的证据包导出任务, 0-单独下载
+}
+
+func (d *DownloadJobModel) TableName() string {
+	return "lkm_download_job"
+}
+
+// Active 任务是否未结束
+func (d *DownloadJobModel) Active() bool {
+	return d.Status == DownloadStatusWaiting || d.Status == DownloadStatusDownloading || d.Status == DownloadStatusMerging
+}
+
+type daoDownloadJob struct {
+}
+
+func (d *daoDownloadJob) Save(job *DownloadJobModel) error {
+	return DBTransaction(func(tx *gorm.DB) error {
+		return tx.Save(job).Error
+	})
+}
+
+// UpdateActiveJob 只更新未结束任务的指定字段, 避免旧的任务副本覆盖并发修改的状态, 例如取消. 返回是否已更新
+func (d *daoDownloadJob) UpdateActiveJob(id uint, columns map[string]interface{}) (bool, error) {
+	var rows int64
+	err := DBTransaction(func(tx *gorm.DB) error {
+		result := tx.Model(&DownloadJobModel{}).Where("id =? and status in ?", id, []string{DownloadStatusWaiting, DownloadStatusDownloading, DownloadStatusMerging}).Updates(columns)
+		rows = result.RowsAffected
+		return result.Error
+	})
+
+	return rows > 0, err
+}
+
+func (d *daoDownloadJob) QueryJob(id int) (*DownloadJobModel, error) {
+	var job DownloadJobModel
+	tx := db.Where("id =?", id).Take(&job)
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	return &job, nil
+}
+
+// QueryJobByStreamID 查询流ID对应的未结束的任务
+func (d *daoDownloadJob) QueryJobByStreamID(streamId string) (*DownloadJobModel, error) {
+	var job DownloadJobModel
+	tx := db.Where("stream_id =? and status in ?", streamId, []string{DownloadStatusWaiting, DownloadStatusDownloading, DownloadStatusMerging}).Take(&job)
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	return &job, nil
+}
+
+// QueryJobs 分页查询下载任务, 按创建时间倒序
+func (d *daoDownloadJob) QueryJobs(page, size int, keyword, status string) ([]*DownloadJobModel, int, error) {
+	cond := db.Model(&DownloadJobModel{})
+	if keyword != "" {
+		cond = cond.Where("device_id like ? or channel_id like ? or name like ?", "%"+keyword+"%", "%"+keyword+"%", "%"+keyword+"%")
+	}
+
+	if status != "" {
+		cond = cond.Where("status =?", status)
+	}
+
+	var total int64
+	if tx := cond.Count(&total); tx.Error != nil {
+		return nil, 0, tx.Error
+	}
+
+	var jobs []*DownloadJobModel
+	tx := cond.Order("id desc").Limit(size).Offset((page - 1) * size).Find(&jobs)
+	if tx.Error != nil {
+		return nil, 0, tx.Error
+	}
+
+	return jobs, int(total), nil
+}
+
+func (d *daoDownloadJob) QueryJobsByStatus(status ...string) ([]*DownloadJobModel, error) {
+	var jobs []*DownloadJobModel
+	tx := db.Where("status in ?", status).Order("id asc").Find(&jobs)
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	return jobs, nil
+}
+
+func (d *daoDownloadJob) DeleteJob(id int) error {
+	return DBTransaction(func(tx *gorm.DB) error {
+		return tx.Unscoped().Delete(&DownloadJobModel{}, "id =?", id).Error
+	})
+}
+
+func (d *daoDownloadJob) QueryJobsByExportID(exportId uint) ([]*DownloadJobModel, error) {
+	var jobs []*DownloadJobModel
+	tx := db.Where("export_id =?", exportId).Order("id asc").Find(&jobs)
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	return jobs, nil
+}
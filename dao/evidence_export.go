@@ -0,0 +1,112 @@
+package dao
+
+import (
+	"gorm.io/gorm"
+	"time"
+)
+
+// 证据包导出状态
+const (
+	ExportStatusDownloading = "downloading" // 下载各通道录像
+	ExportStatusPacking     = "packing"     // 录像下载完毕, 打包中
+	ExportStatusCompleted   = "completed"
+	ExportStatusFailed      = "failed"
+)
+
+// EvidenceExportModel 多通道证据包导出任务. 各通道的录像由下载任务下载, 全部完成后打包成zip
+type EvidenceExportModel struct {
+	GBModel
+	Name        string    `json:"name"`
+	StartTime   string    `json:"start_time"` // 2006-01-02T15:04:05
+	EndTime     string    `json:"end_time"`
+	Username    string    `json:"username"` // 导出人
+	Status      string    `json:"status" gorm:"index"`
+	Path        string    `json:"-"`      // 证据包路径
+	Size        int64     `json:"size"`   // 证据包大小
+	SHA256      string    `json:"sha256"` // 证据包的SHA-256, 用于校验证据包是否被篡改
+	Error       string    `json:"error"`
+	CompletedAt time.Time `json:"completed_at"`
+}
+
+func (d *EvidenceExportModel) TableName() string {
+	return "lkm_evidence_export"
+}
+
+type daoEvidenceExport struct {
+}
+
+// Create 创建导出任务和各通道的下载任务
+func (d *daoEvidenceExport) Create(export *EvidenceExportModel, jobs []*DownloadJobModel) error {
+	return DBTransaction(func(tx *gorm.DB) error {
+		if err := tx.Create(export).Error; err != nil {
+			return err
+		}
+
+		for _, job := range jobs {
+			job.ExportID = export.ID
+			if err := tx.Create(job).Error; err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+func (d *daoEvidenceExport) Save(export *EvidenceExportModel) error {
+	return DBTransaction(func(tx *gorm.DB) error {
+		return tx.Save(export).Error
+	})
+}
+
+func (d *daoEvidenceExport) QueryExport(id int) (*EvidenceExportModel, error) {
+	var export EvidenceExportModel
+	tx := db.Where("id =?", id).Take(&export)
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	return &export, nil
+}
+
+// QueryExports 分页查询导出任务, 按创建时间倒序
+func (d *daoEvidenceExport) QueryExports(page, size int, keyword string) ([]*EvidenceExportModel, int, error) {
+	cond := db.Model(&EvidenceExportModel{})
+	if keyword != "" {
+		cond = cond.Where("name like ? or username like ?", "%"+keyword+"%", "%"+keyword+"%")
+	}
+
+	var total int64
+	if tx := cond.Count(&total); tx.Error != nil {
+		return nil, 0, tx.Error
+	}
+
+	var exports []*EvidenceExportModel
+	tx := cond.Order("id desc").Limit(size).Offset((page - 1) * size).Find(&exports)
+	if tx.Error != nil {
+		return nil, 0, tx.Error
+	}
+
+	return exports, int(total), nil
+}
+
+func (d *daoEvidenceExport) QueryExportsByStatus(status ...string) ([]*EvidenceExportModel, error) {
+	var exports []*EvidenceExportModel
+	tx := db.Where("status in ?", status).Order("id asc").Find(&exports)
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	return exports, nil
+}
+
+// DeleteExport 删除导出任务和关联的下载任务
+func (d *daoEvidenceExport) DeleteExport(id int) error {
+	return DBTransaction(func(tx *gorm.DB) error {
+		if err := tx.Unscoped().Delete(&DownloadJobModel{}, "export_id =?", id).Error; err != nil {
+			return err
+		}
+
+		return tx.Unscoped().Delete(&EvidenceExportModel{}, "id =?", id).Error
+	})
+}
@@ -24,6 +24,10 @@ type JTDeviceModel struct {
 	Model        string `json:"model"`
 	Firmware     string `json:"firmware"`
 	SimNumber    string `json:"sim_number" gorm:"uniqueIndex"`
+
+	// 内置部标信令服务器
+	AuthCode       string              `json:"-"`               // 终端注册时下发的鉴权码
+	TerminalStatus common.OnlineStatus `json:"terminal_status"` // 终端的在线状态
 }
 
 func (g *JTDeviceModel) TableName() string {
@@ -123,3 +127,42 @@ func (d *daoJTDevice) QueryDevices(page int, size int) ([]*JTDeviceModel, int, e
 
 	return devices, int(total), nil
 }
+
+// UpdateTerminalRegister 终端注册, 保存鉴权码和终端上报的制造商、型号
+func (d *daoJTDevice) UpdateTerminalRegister(username, manufacturer, model, authCode string) error {
+	return DBTransaction(func(tx *gorm.DB) error {
+		return tx.Model(&JTDeviceModel{}).Where("username =?", username).Updates(map[string]interface{}{
+			"manufacturer": manufacturer,
+			"model":        model,
+			"auth_code":    authCode,
+		}).Error
+	})
+}
+
+func (d *daoJTDevice) UpdateTerminalFirmware(username, firmware string) error {
+	return DBTransaction(func(tx *gorm.DB) error {
+		return tx.Model(&JTDeviceModel{}).Where("username =?", username).Update("firmware", firmware).Error
+	})
+}
+
+// UpdateTerminalStatus 更新终端和所有通道的在线状态
+func (d *daoJTDevice) UpdateTerminalStatus(username string, status common.OnlineStatus) error {
+	return DBTransaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&JTDeviceModel{}).Where("username =?", username).Update("terminal_status", status).Error; err != nil {
+			return err
+		}
+
+		return tx.Model(&ChannelModel{}).Where("root_id =?", username).Update("status", status).Error
+	})
+}
+
+// ResetTerminalStatus 启动时所有终端和通道置为离线, 等待终端重新鉴权
+func (d *daoJTDevice) ResetTerminalStatus() error {
+	return DBTransaction(func(tx *gorm.DB) error {
+		if err := tx.Session(&gorm.Session{AllowGlobalUpdate: true}).Model(&JTDeviceModel{}).Update("terminal_status", common.OFF).Error; err != nil {
+			return err
+		}
+
+		return tx.Model(&ChannelModel{}).Where("root_id in (?)", tx.Model(&JTDeviceModel{}).Select("username")).Update("status", common.OFF).Error
+	})
+}
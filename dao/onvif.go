@@ -0,0 +1,109 @@
+package dao
+
+import (
+	"fmt"
+	"gorm.io/gorm"
+)
+
+// OnvifDeviceModel ONVIF设备, 作为虚拟国标设备接入, 每个媒体配置对应一个拉流代理通道
+type OnvifDeviceModel struct {
+	GBModel
+	DeviceID   string `json:"device_id" gorm:"index"` // 虚拟国标设备ID
+	Name       string `json:"name"`
+	Endpoint   string `json:"endpoint"` // WS-Discovery的设备标识
+	XAddr      string `json:"xaddr" gorm:"uniqueIndex"`
+	Username   string `json:"username"`
+	Password   string `json:"-"`
+	MediaXAddr string `json:"media_xaddr"`
+	PTZXAddr   string `json:"ptz_xaddr"` // 不支持云台为空
+}
+
+func (o *OnvifDeviceModel) TableName() string {
+	return "lkm_onvif_device"
+}
+
+type daoOnvif struct {
+}
+
+// Create 保存ONVIF设备、虚拟国标设备和各媒体配置的通道. 设备ID和通道ID根据数据库ID生成
+func (d *daoOnvif) Create(onvif *OnvifDeviceModel, device *DeviceModel, proxies []*StreamProxyModel, generateDevice, generateChannel func(id uint) string) error {
+	return DBTransaction(func(tx *gorm.DB) error {
+		var old OnvifDeviceModel
+		if tx.Select("id").Where("x_addr =?", onvif.XAddr).Take(&old).Error == nil {
+			return fmt.Errorf("设备已接入 %s", onvif.XAddr)
+		} else if err := tx.Create(onvif).Error; err != nil {
+			return err
+		}
+
+		deviceId, err := generateUniqueID(tx, &DeviceModel{}, onvif.ID, generateDevice)
+		if err != nil {
+			return err
+		}
+
+		onvif.DeviceID = deviceId
+		device.DeviceID = deviceId
+		if err = tx.Save(onvif).Error; err != nil {
+			return err
+		} else if err = tx.Create(device).Error; err != nil {
+			return err
+		}
+
+		for _, proxy := range proxies {
+			proxy.DeviceID = onvif.DeviceID
+			if err := createProxy(tx, proxy, generateChannel); err != nil {
+				return err
+			}
+		}
+
+		// 通道的厂家和型号使用设备信息
+		return tx.Model(&ChannelModel{}).Where("root_id =?", onvif.DeviceID).Updates(map[string]interface{}{
+			"manufacturer": device.Manufacturer,
+			"model":        device.Model,
+		}).Error
+	})
+}
+
+// Delete 删除ONVIF设备、虚拟设备和所有通道
+func (d *daoOnvif) Delete(onvif *OnvifDeviceModel) error {
+	return DBTransaction(func(tx *gorm.DB) error {
+		if err := tx.Unscoped().Delete(&OnvifDeviceModel{}, "id =?", onvif.ID).Error; err != nil {
+			return err
+		} else if err = tx.Where("device_id =?", onvif.DeviceID).Unscoped().Delete(&StreamProxyModel{}).Error; err != nil {
+			return err
+		} else if err = tx.Where("root_id =?", onvif.DeviceID).Unscoped().Delete(&ChannelModel{}).Error; err != nil {
+			return err
+		}
+
+		return tx.Where("device_id =?", onvif.DeviceID).Unscoped().Delete(&DeviceModel{}).Error
+	})
+}
+
+func (d *daoOnvif) QueryOnvifDevice(id int) (*OnvifDeviceModel, error) {
+	var onvif OnvifDeviceModel
+	tx := db.Where("id =?", id).Take(&onvif)
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	return &onvif, nil
+}
+
+func (d *daoOnvif) QueryOnvifDeviceByDeviceID(deviceId string) (*OnvifDeviceModel, error) {
+	var onvif OnvifDeviceModel
+	tx := db.Where("device_id =?", deviceId).Take(&onvif)
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	return &onvif, nil
+}
+
+func (d *daoOnvif) LoadOnvifDevices() ([]*OnvifDeviceModel, error) {
+	var devices []*OnvifDeviceModel
+	tx := db.Order("id asc").Find(&devices)
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	return devices, nil
+}
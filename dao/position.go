@@ -9,6 +9,7 @@ const (
 	PositionSourceSubscribe = iota + 1
 	PositionSourceAlarm
 	PositionSourceChannel
+	PositionSourceJT // 部标终端位置汇报
 )
 
 type PositionModel struct {
@@ -0,0 +1,99 @@
+package dao
+
+import (
+	"gorm.io/gorm"
+	"time"
+)
+
+// RecordCacheModel 设备录像查询结果缓存, 按通道和查询时间范围保存
+type RecordCacheModel struct {
+	GBModel
+	DeviceID  string `json:"device_id" gorm:"uniqueIndex:idx_record_cache"`
+	ChannelID string `json:"channel_id" gorm:"uniqueIndex:idx_record_cache"`
+	StartTime string `json:"start_time" gorm:"uniqueIndex:idx_record_cache"` // 查询的开始时间
+	EndTime   string `json:"end_time" gorm:"uniqueIndex:idx_record_cache"`   // 查询的结束时间
+	Records   string `json:"records"`                                        // 录像列表, json格式
+}
+
+func (r *RecordCacheModel) TableName() string {
+	return "lkm_record_cache"
+}
+
+// RecordPrefetchModel 需要在夜间预取前一天录像的通道
+type RecordPrefetchModel struct {
+	GBModel
+	DeviceID  string `json:"device_id" gorm:"uniqueIndex:idx_record_prefetch"`
+	ChannelID string `json:"channel_id" gorm:"uniqueIndex:idx_record_prefetch"`
+}
+
+func (r *RecordPrefetchModel) TableName() string {
+	return "lkm_record_prefetch"
+}
+
+type daoRecordCache struct {
+}
+
+// QueryCoveringCaches 查询时间范围覆盖[startTime, endTime]的缓存, 按更新时间倒序. 时间格式需一致才能按字符串比较
+func (d *daoRecordCache) QueryCoveringCaches(deviceId, channelId, startTime, endTime string) ([]*RecordCacheModel, error) {
+	var caches []*RecordCacheModel
+	tx := db.Where("device_id =? and channel_id =? and start_time <=? and end_time >=?", deviceId, channelId, startTime, endTime).Order("updated_at desc").Find(&caches)
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	return caches, nil
+}
+
+// SaveCache 保存查询结果, 已存在则覆盖并刷新更新时间
+func (d *daoRecordCache) SaveCache(cache *RecordCacheModel) error {
+	return DBTransaction(func(tx *gorm.DB) error {
+		var old RecordCacheModel
+		if tx.Select("id").Where("device_id =? and channel_id =? and start_time =? and end_time =?", cache.DeviceID, cache.ChannelID, cache.StartTime, cache.EndTime).Take(&old).Error == nil {
+			cache.ID = old.ID
+		}
+
+		return tx.Save(cache).Error
+	})
+}
+
+// DeleteExpired 删除更新时间早于expired的缓存
+func (d *daoRecordCache) DeleteExpired(expired time.Time) error {
+	return DBTransaction(func(tx *gorm.DB) error {
+		return tx.Unscoped().Where("updated_at <?", expired).Delete(&RecordCacheModel{}).Error
+	})
+}
+
+func (d *daoRecordCache) SavePrefetch(deviceId, channelId string, enable bool) error {
+	return DBTransaction(func(tx *gorm.DB) error {
+		if !enable {
+			return tx.Unscoped().Delete(&RecordPrefetchModel{}, "device_id =? and channel_id =?", deviceId, channelId).Error
+		}
+
+		var old RecordPrefetchModel
+		if tx.Where("device_id =? and channel_id =?", deviceId, channelId).Take(&old).Error == nil {
+			return nil
+		}
+
+		return tx.Create(&RecordPrefetchModel{DeviceID: deviceId, ChannelID: channelId}).Error
+	})
+}
+
+func (d *daoRecordCache) QueryPrefetches() ([]*RecordPrefetchModel, error) {
+	var prefetches []*RecordPrefetchModel
+	tx := db.Find(&prefetches)
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	return prefetches, nil
+}
+
+func (d *daoRecordCache) QueryPrefetchExist(deviceId, channelId string) (bool, error) {
+	var total int64
+	tx := db.Model(&RecordPrefetchModel{}).Where("device_id =? and channel_id =?", deviceId, channelId).Count(&total)
+	if tx.Error != nil {
+		return false, tx.Error
+	}
+
+	return total > 0, nil
+}
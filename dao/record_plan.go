@@ -0,0 +1,237 @@
+package dao
+
+import (
+	"errors"
+	"gorm.io/gorm"
+	"strings"
+	"time"
+)
+
+const (
+	RecordPlanSlots = 48 // 每天的时间刻度数, 每个刻度半小时
+)
+
+// RecordPlanModel 云端录像计划
+type RecordPlanModel struct {
+	GBModel
+	Name     string    `json:"name"`
+	Enable   bool      `json:"enable"`
+	Schedule [7]uint64 `json:"schedule" gorm:"serializer:json"` // 0-6表示周一至周日，一天的时间刻度用一个uint64表示，从高位开始代表0点，每bit半小时，共占用48位, 1表示录像，0表示不录像
+}
+
+func (r *RecordPlanModel) TableName() string {
+	return "lkm_record_plan"
+}
+
+// Recording 返回指定时间是否在录像计划内
+func (r *RecordPlanModel) Recording(t time.Time) bool {
+	// time.Weekday从周日开始
+	day := (int(t.Weekday()) + 6) % 7
+	slot := t.Hour()*2 + t.Minute()/30
+	return r.Schedule[day]&(1<<(RecordPlanSlots-1-slot)) != 0
+}
+
+// RecordPlanChannelModel 录像计划关联的通道, 关联目录时对目录下的所有通道生效
+type RecordPlanChannelModel struct {
+	GBModel
+	PlanID    uint   `json:"plan_id" gorm:"index"`
+	DeviceID  string `json:"device_id" gorm:"index"`
+	ChannelID string `json:"channel_id"`
+	IsDir     bool   `json:"is_dir"` // 是否是目录
+}
+
+func (r *RecordPlanChannelModel) TableName() string {
+	return "lkm_record_plan_channel"
+}
+
+type daoRecordPlan struct {
+}
+
+func (d *daoRecordPlan) SavePlan(plan *RecordPlanModel) error {
+	return DBTransaction(func(tx *gorm.DB) error {
+		return tx.Save(plan).Error
+	})
+}
+
+func (d *daoRecordPlan) QueryPlan(id int) (*RecordPlanModel, error) {
+	var plan RecordPlanModel
+	tx := db.Where("id =?", id).Take(&plan)
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	return &plan, nil
+}
+
+// QueryPlans 分页查询录像计划
+func (d *daoRecordPlan) QueryPlans(page, size int, keyword string) ([]*RecordPlanModel, int, error) {
+	cond := db.Model(&RecordPlanModel{})
+	if keyword != "" {
+		cond = cond.Where("name like ?", "%"+keyword+"%")
+	}
+
+	var total int64
+	if tx := cond.Count(&total); tx.Error != nil {
+		return nil, 0, tx.Error
+	}
+
+	var plans []*RecordPlanModel
+	tx := cond.Limit(size).Offset((page - 1) * size).Find(&plans)
+	if tx.Error != nil {
+		return nil, 0, tx.Error
+	}
+
+	return plans, int(total), nil
+}
+
+func (d *daoRecordPlan) QueryEnabledPlans() ([]*RecordPlanModel, error) {
+	var plans []*RecordPlanModel
+	tx := db.Where("enable =?", true).Find(&plans)
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	return plans, nil
+}
+
+func (d *daoRecordPlan) UpdateEnable(id int, enable bool) error {
+	return DBTransaction(func(tx *gorm.DB) error {
+		return tx.Model(&RecordPlanModel{}).Where("id =?", id).Update("enable", enable).Error
+	})
+}
+
+// DeletePlan 删除录像计划和关联的通道
+func (d *daoRecordPlan) DeletePlan(id int) error {
+	return DBTransaction(func(tx *gorm.DB) error {
+		if err := tx.Unscoped().Delete(&RecordPlanChannelModel{}, "plan_id =?", id).Error; err != nil {
+			return err
+		}
+
+		return tx.Unscoped().Delete(&RecordPlanModel{}, "id =?", id).Error
+	})
+}
+
+// BindChannels 关联通道, channels格式为deviceId:channelId
+func (d *daoRecordPlan) BindChannels(planId int, channels []string) error {
+	return DBTransaction(func(tx *gorm.DB) error {
+		for _, channel := range channels {
+			ids := strings.Split(channel, ":")
+			if len(ids) != 2 {
+				continue
+			}
+
+			// 检查是否已经关联
+			var count int64
+			if err := tx.Model(&RecordPlanChannelModel{}).Where("device_id =? and channel_id =? and plan_id =?", ids[0], ids[1], planId).Count(&count).Error; err != nil {
+				return err
+			} else if count > 0 {
+				continue
+			}
+
+			// 检查通道是否存在, 忽略不存在的通道
+			var model ChannelModel
+			if err := tx.Where("root_id =? and device_id =?", ids[0], ids[1]).Take(&model).Error; errors.Is(err, gorm.ErrRecordNotFound) {
+				continue
+			} else if err != nil {
+				return err
+			} else if err = tx.Create(&RecordPlanChannelModel{
+				PlanID:    uint(planId),
+				DeviceID:  ids[0],
+				ChannelID: ids[1],
+				IsDir:     model.IsDir,
+			}).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (d *daoRecordPlan) UnbindChannels(planId int, channels []string) error {
+	return DBTransaction(func(tx *gorm.DB) error {
+		for _, channel := range channels {
+			ids := strings.Split(channel, ":")
+			if len(ids) != 2 {
+				continue
+			}
+
+			if err := tx.Unscoped().Delete(&RecordPlanChannelModel{}, "device_id =? and channel_id =? and plan_id =?", ids[0], ids[1], planId).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (d *daoRecordPlan) QueryPlanChannels(planId int) ([]*RecordPlanChannelModel, error) {
+	var channels []*RecordPlanChannelModel
+	tx := db.Where("plan_id =?", planId).Find(&channels)
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	return channels, nil
+}
+
+func (d *daoRecordPlan) QueryPlanChannelCount(planId int) (int, error) {
+	var total int64
+	tx := db.Model(&RecordPlanChannelModel{}).Where("plan_id =?", planId).Count(&total)
+	if tx.Error != nil {
+		return 0, tx.Error
+	}
+
+	return int(total), nil
+}
+
+func (d *daoRecordPlan) QueryPlanChannelExist(planId int, deviceId, channelId string) (bool, error) {
+	var total int64
+	tx := db.Model(&RecordPlanChannelModel{}).Where("plan_id =? and device_id =? and channel_id =?", planId, deviceId, channelId).Count(&total)
+	if tx.Error != nil {
+		return false, tx.Error
+	}
+
+	return total > 0, nil
+}
+
+// QueryPlanChannelModels 查询录像计划关联的所有通道, 目录展开为目录下的通道
+func (d *daoRecordPlan) QueryPlanChannelModels(planId int) ([]*ChannelModel, error) {
+	relations, err := d.QueryPlanChannels(planId)
+	if err != nil {
+		return nil, err
+	}
+
+	var channels []*ChannelModel
+	for _, relation := range relations {
+		if !relation.IsDir {
+			channel, err := Channel.QueryChannel(relation.DeviceID, relation.ChannelID)
+			if err == nil {
+				channels = append(channels, channel)
+			}
+			continue
+		}
+
+		channels = append(channels, d.queryDirChannels(relation.DeviceID, relation.ChannelID)...)
+	}
+
+	return channels, nil
+}
+
+// 递归查询目录下的所有通道
+func (d *daoRecordPlan) queryDirChannels(deviceId, groupId string) []*ChannelModel {
+	var subChannels []*ChannelModel
+	tx := db.Where("root_id =? and group_id =?", deviceId, groupId).Find(&subChannels)
+	if tx.Error != nil {
+		return nil
+	}
+
+	var channels []*ChannelModel
+	for _, channel := range subChannels {
+		if !channel.IsDir {
+			channels = append(channels, channel)
+		} else if channel.DeviceID != groupId {
+			channels = append(channels, d.queryDirChannels(deviceId, channel.DeviceID)...)
+		}
+	}
+
+	return channels
+}
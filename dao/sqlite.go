@@ -16,20 +16,28 @@ const (
 )
 
 var (
-	db        *gorm.DB
-	TaskQueue = make(chan *SaveTask, 1024)
-	Device    = &daoDevice{}
-	Channel   = &daoChannel{}
-	Platform  = &daoPlatform{}
-	Stream    = &daoStream{}
-	Sink      = &daoSink{}
-	JTDevice  = &daoJTDevice{}
-	Blacklist = &daoBlacklist{}
-	Dialog    = &daoDialog{}
-	Position  = &daoPosition{}
-	Alarm     = &daoAlarm{}
-	Log       = &daoLog{}
-	StatusLog = &daoStatusLog{}
+	db          *gorm.DB
+	TaskQueue   = make(chan *SaveTask, 1024)
+	Device      = &daoDevice{}
+	Channel     = &daoChannel{}
+	Platform    = &daoPlatform{}
+	Stream      = &daoStream{}
+	Sink        = &daoSink{}
+	JTDevice    = &daoJTDevice{}
+	Blacklist   = &daoBlacklist{}
+	Dialog      = &daoDialog{}
+	Position    = &daoPosition{}
+	Alarm       = &daoAlarm{}
+	Log         = &daoLog{}
+	StatusLog   = &daoStatusLog{}
+	RecordPlan  = &daoRecordPlan{}
+	CloudRecord = &daoCloudRecord{}
+	RecordCache = &daoRecordCache{}
+	DownloadJob = &daoDownloadJob{}
+	Export      = &daoEvidenceExport{}
+	StreamProxy = &daoStreamProxy{}
+	Onvif       = &daoOnvif{}
+	AlarmPlan   = &daoAlarmPlan{}
 )
 
 func init() {
@@ -89,10 +97,38 @@ func init() {
 		panic(err)
 	} else if err = db.AutoMigrate(&AlarmModel{}); err != nil {
 		panic(err)
+	} else if err = db.AutoMigrate(&AlarmHandleLogModel{}); err != nil {
+		panic(err)
 	} else if err = db.AutoMigrate(&LogModel{}); err != nil {
 		panic(err)
 	} else if err = db.AutoMigrate(&StatusLogModel{}); err != nil {
 		panic(err)
+	} else if err = db.AutoMigrate(&RecordPlanModel{}); err != nil {
+		panic(err)
+	} else if err = db.AutoMigrate(&RecordPlanChannelModel{}); err != nil {
+		panic(err)
+	} else if err = db.AutoMigrate(&CloudRecordModel{}); err != nil {
+		panic(err)
+	} else if err = db.AutoMigrate(&CloudRecordRetentionModel{}); err != nil {
+		panic(err)
+	} else if err = db.AutoMigrate(&RecordCacheModel{}); err != nil {
+		panic(err)
+	} else if err = db.AutoMigrate(&RecordPrefetchModel{}); err != nil {
+		panic(err)
+	} else if err = db.AutoMigrate(&DownloadJobModel{}); err != nil {
+		panic(err)
+	} else if err = db.AutoMigrate(&EvidenceExportModel{}); err != nil {
+		panic(err)
+	} else if err = db.AutoMigrate(&StreamProxyModel{}); err != nil {
+		panic(err)
+	} else if err = db.AutoMigrate(&OnvifDeviceModel{}); err != nil {
+		panic(err)
+	} else if err = db.AutoMigrate(&AlarmPlanModel{}); err != nil {
+		panic(err)
+	} else if err = db.AutoMigrate(&AlarmPlanChannelModel{}); err != nil {
+		panic(err)
+	} else if err = db.AutoMigrate(&AlarmPlanLogModel{}); err != nil {
+		panic(err)
 	}
 
 	StartSaveTask()
@@ -5,6 +5,13 @@ import (
 	"github.com/ghettovoice/gosip/sip"
 	"github.com/lkmio/avformat/utils"
 	"gorm.io/gorm"
+	"time"
+)
+
+// 回放状态
+const (
+	PlaybackStatePlaying = "playing"
+	PlaybackStatePaused  = "paused"
 )
 
 type StreamModel struct {
@@ -20,12 +27,51 @@ type StreamModel struct {
 	Urls       []string               `gorm:"serializer:json"` // 从流媒体服务器返回的拉流地址
 	Name       string                 `gorm:"index"`           // 视频通道名
 	RemoteAddr string
+	VideoCodec string `json:"video_codec" gorm:"index"` // 协商的视频编码, PS/H264/H265...
+	AudioCodec string `json:"audio_codec"`              // 协商的音频编码, PS流中的音频不在此列
+	FileSize   int64  `json:"file_size"`                // 下载时设备应答的文件大小
+
+	// 回放/下载的播放状态
+	PlaybackState string    `json:"playback_state,omitempty"`
+	PlaybackStart int64     `json:"playback_start,omitempty"` // 回放开始时间, unix秒
+	PlaybackEnd   int64     `json:"playback_end,omitempty"`
+	Scale         float64   `json:"scale,omitempty"`    // 当前倍速
+	Position      int64     `json:"position,omitempty"` // PositionAt时刻的播放位置, 相对开始时间的秒数
+	PositionAt    time.Time `json:"-"`
+	RTSPCSeq      int       `json:"-"` // MANSRTSP的CSeq, 每个流单独递增
 }
 
 func (s *StreamModel) TableName() string {
 	return "lkm_stream"
 }
 
+// InitPlayback 初始化回放状态, 从开始时间播放
+func (s *StreamModel) InitPlayback(start, end int64, scale float64) {
+	s.PlaybackState = PlaybackStatePlaying
+	s.PlaybackStart = start
+	s.PlaybackEnd = end
+	s.Scale = scale
+	s.Position = 0
+	s.PositionAt = time.Now()
+	s.RTSPCSeq = 0
+}
+
+// CurrentPosition 返回当前播放位置, 播放中按经过的时间和倍速推算
+func (s *StreamModel) CurrentPosition() int64 {
+	position := s.Position
+	if s.PlaybackState == PlaybackStatePlaying && !s.PositionAt.IsZero() {
+		position += int64(time.Since(s.PositionAt).Seconds() * s.Scale)
+	}
+
+	if duration := s.PlaybackEnd - s.PlaybackStart; duration > 0 && position > duration {
+		position = duration
+	} else if position < 0 {
+		position = 0
+	}
+
+	return position
+}
+
 func (s *StreamModel) SetDialog(dialog sip.Request) {
 	s.Dialog = &common.RequestWrapper{dialog}
 	id, _ := dialog.CallID()
@@ -211,6 +257,16 @@ func (d *daoStream) QueryStreamCountByType(streamType string) (int, error) {
 	return int(count), nil
 }
 
+// QueryStreamCountByCodec 根据视频编码计数stream
+func (d *daoStream) QueryStreamCountByCodec(codec string) (int, error) {
+	var count int64
+	tx := db.Model(&StreamModel{}).Where("video_codec = ?", codec).Count(&count)
+	if tx.Error != nil {
+		return 0, tx.Error
+	}
+	return int(count), nil
+}
+
 // Count 返回表记录数
 func (d *daoStream) Count() (int, error) {
 	var count int64
@@ -0,0 +1,186 @@
+package dao
+
+import (
+	"fmt"
+	"gb-cms/common"
+	"gorm.io/gorm"
+)
+
+// 生成ID时最多顺延的次数, 与6位序号的数量一致
+const maxGenerateIDAttempts = 1000000
+
+// StreamProxyModel 拉流代理, 由流媒体服务拉取RTSP/RTMP/HTTP-FLV地址, 作为虚拟设备下的国标通道使用
+type StreamProxyModel struct {
+	GBModel
+	DeviceID  string `json:"device_id"`                     // 所属的虚拟设备ID
+	ChannelID string `json:"channel_id" gorm:"uniqueIndex"` // 生成的20位国标通道ID
+	Name      string `json:"name"`
+	Url       string `json:"url"`       // 拉流地址, rtsp://, rtmp://, http(s)://...flv
+	Transport string `json:"transport"` // RTSP拉流的传输方式, tcp/udp, 为空由流媒体服务决定
+	AlwaysOn  bool   `json:"always_on"` // 常驻拉流, 否则按需拉流, 无人观看时断开
+	Enable    bool   `json:"enable"`
+
+	ProfileToken string `json:"profile_token"` // ONVIF设备的媒体配置, 用于云台控制
+	PTZ          bool   `json:"ptz"`           // ONVIF媒体配置是否支持云台
+}
+
+func (p *StreamProxyModel) TableName() string {
+	return "lkm_stream_proxy"
+}
+
+// Channel 代理对应的国标通道
+func (p *StreamProxyModel) Channel() *ChannelModel {
+	status := common.OFF
+	if p.Enable {
+		status = common.ON
+	}
+
+	return &ChannelModel{
+		RootID:       p.DeviceID,
+		TypeCode:     131,
+		GroupID:      p.DeviceID,
+		DeviceID:     p.ChannelID,
+		Name:         p.Name,
+		Manufacturer: "StreamProxy",
+		Parental:     "0",
+		ParentID:     p.DeviceID,
+		Status:       status,
+	}
+}
+
+type daoStreamProxy struct {
+}
+
+// Create 保存代理并创建对应的通道, 未指定通道ID时由generate根据代理ID生成
+func (d *daoStreamProxy) Create(proxy *StreamProxyModel, generate func(id uint) string) error {
+	return DBTransaction(func(tx *gorm.DB) error {
+		return createProxy(tx, proxy, generate)
+	})
+}
+
+func createProxy(tx *gorm.DB, proxy *StreamProxyModel, generate func(id uint) string) error {
+	if err := tx.Create(proxy).Error; err != nil {
+		return err
+	}
+
+	if proxy.ChannelID == "" {
+		channelId, err := generateUniqueID(tx, &ChannelModel{}, proxy.ID, generate)
+		if err != nil {
+			return err
+		}
+
+		proxy.ChannelID = channelId
+		if err = tx.Save(proxy).Error; err != nil {
+			return err
+		}
+	}
+
+	var old ChannelModel
+	if tx.Select("id").Where("device_id =?", proxy.ChannelID).Take(&old).Error == nil {
+		return fmt.Errorf("通道ID已存在 %s", proxy.ChannelID)
+	}
+
+	return tx.Create(proxy.Channel()).Error
+}
+
+// 生成的ID序号位数有限, 数据库ID超出后会重复, 顺延到第一个未使用的ID
+func generateUniqueID(tx *gorm.DB, model interface{}, id uint, generate func(id uint) string) (string, error) {
+	for i := uint(0); i < maxGenerateIDAttempts; i++ {
+		deviceId := generate(id + i)
+		var count int64
+		if err := tx.Model(model).Where("device_id =?", deviceId).Count(&count).Error; err != nil {
+			return "", err
+		} else if count == 0 {
+			return deviceId, nil
+		}
+	}
+
+	return "", fmt.Errorf("没有可用的ID")
+}
+
+// Update 更新代理和通道的名称、状态
+func (d *daoStreamProxy) Update(proxy *StreamProxyModel) error {
+	return DBTransaction(func(tx *gorm.DB) error {
+		if err := tx.Save(proxy).Error; err != nil {
+			return err
+		}
+
+		channel := proxy.Channel()
+		return tx.Model(&ChannelModel{}).Where("root_id =? and device_id =?", proxy.DeviceID, proxy.ChannelID).Updates(map[string]interface{}{
+			"name":   channel.Name,
+			"status": channel.Status,
+		}).Error
+	})
+}
+
+func (d *daoStreamProxy) Delete(proxy *StreamProxyModel) error {
+	return DBTransaction(func(tx *gorm.DB) error {
+		if err := tx.Unscoped().Delete(&StreamProxyModel{}, "id =?", proxy.ID).Error; err != nil {
+			return err
+		}
+
+		return tx.Where("root_id =? and device_id =?", proxy.DeviceID, proxy.ChannelID).Unscoped().Delete(&ChannelModel{}).Error
+	})
+}
+
+func (d *daoStreamProxy) QueryProxy(id int) (*StreamProxyModel, error) {
+	var proxy StreamProxyModel
+	tx := db.Where("id =?", id).Take(&proxy)
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	return &proxy, nil
+}
+
+func (d *daoStreamProxy) QueryProxyByChannelID(channelId string) (*StreamProxyModel, error) {
+	var proxy StreamProxyModel
+	tx := db.Where("channel_id =?", channelId).Take(&proxy)
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	return &proxy, nil
+}
+
+// QueryProxies 分页查询虚拟设备下的代理
+func (d *daoStreamProxy) QueryProxies(deviceId string, page, size int, keyword string) ([]*StreamProxyModel, int, error) {
+	cond := db.Model(&StreamProxyModel{}).Where("device_id =?", deviceId)
+	if keyword != "" {
+		cond = cond.Where("(name like ? or channel_id like ? or url like ?)", "%"+keyword+"%", "%"+keyword+"%", "%"+keyword+"%")
+	}
+
+	var total int64
+	if tx := cond.Count(&total); tx.Error != nil {
+		return nil, 0, tx.Error
+	}
+
+	var proxies []*StreamProxyModel
+	tx := cond.Order("id asc").Limit(size).Offset((page - 1) * size).Find(&proxies)
+	if tx.Error != nil {
+		return nil, 0, tx.Error
+	}
+
+	return proxies, int(total), nil
+}
+
+// QueryAlwaysOnProxies 查询启用的常驻拉流代理
+func (d *daoStreamProxy) QueryAlwaysOnProxies() ([]*StreamProxyModel, error) {
+	var proxies []*StreamProxyModel
+	tx := db.Where("always_on =? and enable =?", true, true).Find(&proxies)
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	return proxies, nil
+}
+
+func (d *daoStreamProxy) QueryProxiesByDeviceID(deviceId string) ([]*StreamProxyModel, error) {
+	var proxies []*StreamProxyModel
+	tx := db.Where("device_id =?", deviceId).Order("id asc").Find(&proxies)
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	return proxies, nil
+}
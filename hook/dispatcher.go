@@ -0,0 +1,218 @@
+package hook
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"gb-cms/log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// 异步推送的事件名称
+var eventNames = map[int]string{
+	EventTypeDeviceOnline:           "online",
+	EventTypeDeviceOffline:          "offline",
+	EventTypeDevicePosition:         "position",
+	EventTypeDeviceKeepaliveTimeout: "keepalive_timeout",
+	EventTypeDeviceCatalog:          "catalog",
+	EventTypeDeviceAlarm:            "alarm",
+	EventTypeStreamStart:            "stream_start",
+	EventTypeStreamStop:             "stream_stop",
+	EventTypeCascadeOnline:          "cascade_online",
+	EventTypeCascadeOffline:         "cascade_offline",
+	EventTypeChannelStatus:          "channel_status",
+}
+
+var (
+	// Timeout 同步钩子和异步推送的请求超时时间
+	Timeout = 5 * time.Second
+
+	dispatcher *Dispatcher
+)
+
+// Event 异步推送的请求体
+type Event struct {
+	Event     string      `json:"event"`
+	Timestamp int64       `json:"timestamp"` // 事件发生的时间, unix毫秒
+	Data      interface{} `json:"data"`
+}
+
+type DispatcherOptions struct {
+	Secret        string        // HMAC-SHA256签名密钥, 为空不签名
+	Retries       int           // 失败后的重试次数
+	RetryInterval time.Duration // 首次重试的间隔, 之后每次翻倍
+	QueueSize     int
+	Workers       int
+	DeadLetter    string // 重试失败的事件追加到该文件, 为空只打印日志
+}
+
+type task struct {
+	url      string
+	event    string
+	body     []byte
+	attempts int           // 已推送的次数
+	interval time.Duration // 下次重试的间隔
+}
+
+// Dispatcher 异步推送事件, 失败按指数退避重试, 最终失败写入死信文件
+type Dispatcher struct {
+	options DispatcherOptions
+	queue   chan *task
+	client  *http.Client
+	lock    sync.Mutex // 死信文件写锁
+}
+
+func NewDispatcher(options DispatcherOptions) *Dispatcher {
+	return &Dispatcher{
+		options: options,
+		queue:   make(chan *task, options.QueueSize),
+		client:  &http.Client{Timeout: Timeout},
+	}
+}
+
+func (d *Dispatcher) Start() {
+	for i := 0; i < d.options.Workers; i++ {
+		go func() {
+			for t := range d.queue {
+				d.deliver(t)
+			}
+		}()
+	}
+}
+
+// Dispatch 事件入队, 队列已满直接写入死信
+func (d *Dispatcher) Dispatch(url, event string, body []byte) {
+	d.enqueue(&task{url: url, event: event, body: body, interval: d.options.RetryInterval})
+}
+
+func (d *Dispatcher) enqueue(t *task) {
+	select {
+	case d.queue <- t:
+		break
+	default:
+		d.deadLetter(t, t.attempts, fmt.Errorf("推送队列已满"))
+	}
+}
+
+// Sign 对时间戳和请求体签名, 接收方使用相同的密钥校验
+func Sign(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func (d *Dispatcher) post(t *task) error {
+	request, err := http.NewRequest(http.MethodPost, t.url, bytes.NewReader(t.body))
+	if err != nil {
+		return err
+	}
+
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("X-Hook-Event", t.event)
+	if d.options.Secret != "" {
+		timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+		request.Header.Set("X-Hook-Timestamp", timestamp)
+		request.Header.Set("X-Hook-Signature", Sign(d.options.Secret, timestamp, t.body))
+	}
+
+	response, err := d.client.Do(request)
+	if err != nil {
+		return err
+	}
+
+	_ = response.Body.Close()
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		return fmt.Errorf("响应状态码: %d", response.StatusCode)
+	}
+
+	return nil
+}
+
+// 推送一次, 失败后定时重新入队, 等待重试期间不占用工作协程
+func (d *Dispatcher) deliver(t *task) {
+	err := d.post(t)
+	if t.attempts++; err == nil {
+		return
+	} else if t.attempts > d.options.Retries {
+		d.deadLetter(t, t.attempts, err)
+		return
+	}
+
+	interval := t.interval
+	t.interval *= 2
+	log.Sugar.Warnf("推送事件失败, %s后重试 err: %s event: %s url: %s", interval, err.Error(), t.event, t.url)
+	time.AfterFunc(interval, func() {
+		d.enqueue(t)
+	})
+}
+
+func (d *Dispatcher) deadLetter(t *task, attempts int, err error) {
+	log.Sugar.Errorf("推送事件失败 err: %s event: %s url: %s attempts: %d", err.Error(), t.event, t.url, attempts)
+	if d.options.DeadLetter == "" {
+		return
+	}
+
+	line, _ := json.Marshal(map[string]interface{}{
+		"time":     time.Now().Format(time.DateTime),
+		"url":      t.url,
+		"event":    t.event,
+		"attempts": attempts,
+		"error":    err.Error(),
+		"body":     json.RawMessage(t.body),
+	})
+
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	_ = os.MkdirAll(filepath.Dir(d.options.DeadLetter), 0755)
+	file, err := os.OpenFile(d.options.DeadLetter, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		log.Sugar.Errorf("写入死信文件失败 err: %s path: %s", err.Error(), d.options.DeadLetter)
+		return
+	}
+
+	defer file.Close()
+	_, _ = file.Write(append(line, '\n'))
+}
+
+// StartDispatcher 启动全局的异步推送
+func StartDispatcher(options DispatcherOptions) {
+	dispatcher = NewDispatcher(options)
+	dispatcher.Start()
+}
+
+// EventName 返回事件名称, 同时作为事件总线的主题
+func EventName(eventType int) string {
+	return eventNames[eventType]
+}
+
+// Notify 异步推送事件, 未配置该事件的地址或未启动推送时忽略
+func Notify(eventType int, data interface{}) {
+	if url := EventUrls[eventType]; url != "" {
+		NotifyURL(url, eventNames[eventType], data)
+	}
+}
+
+// NotifyURL 异步推送事件到指定地址, 同样签名和重试
+func NotifyURL(url, event string, data interface{}) {
+	if dispatcher == nil {
+		return
+	}
+
+	body, err := json.Marshal(&Event{Event: event, Timestamp: time.Now().UnixMilli(), Data: data})
+	if err != nil {
+		log.Sugar.Errorf("序列化推送事件失败 err: %s event: %s", err.Error(), event)
+		return
+	}
+
+	dispatcher.Dispatch(url, event, body)
+}
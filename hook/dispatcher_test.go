@@ -0,0 +1,115 @@
+package hook
+
+import (
+	"encoding/json"
+	"gb-cms/log"
+	"go.uber.org/zap"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func init() {
+	log.Sugar = zap.NewNop().Sugar()
+}
+
+func TestDispatcherRetry(t *testing.T) {
+	var attempts atomic.Int32
+	received := make(chan *Event, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if Sign("secret", r.Header.Get("X-Hook-Timestamp"), body) != r.Header.Get("X-Hook-Signature") {
+			t.Errorf("signature mismatch")
+		}
+
+		// 前两次失败
+		if attempts.Add(1) < 3 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		event := &Event{}
+		_ = json.Unmarshal(body, event)
+		received <- event
+	}))
+	defer server.Close()
+
+	d := NewDispatcher(DispatcherOptions{Secret: "secret", Retries: 3, RetryInterval: 10 * time.Millisecond, QueueSize: 1, Workers: 1})
+	d.Start()
+
+	body, _ := json.Marshal(&Event{Event: "online", Data: map[string]string{"device_id": "34020000001320000001"}})
+	d.Dispatch(server.URL, "online", body)
+
+	select {
+	case event := <-received:
+		if event.Event != "online" || attempts.Load() != 3 {
+			t.Fatalf("event: %+v attempts: %d", event, attempts.Load())
+		}
+	case <-time.After(3 * time.Second):
+		t.Fatal("event not delivered")
+	}
+}
+
+func TestDispatcherDeadLetter(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "dead_letter.log")
+	d := NewDispatcher(DispatcherOptions{Retries: 1, RetryInterval: 10 * time.Millisecond, QueueSize: 1, Workers: 1, DeadLetter: path})
+	d.Start()
+	d.Dispatch(server.URL, "offline", []byte(`{"event":"offline"}`))
+
+	deadline := time.Now().Add(3 * time.Second)
+	for time.Now().Before(deadline) {
+		data, _ := os.ReadFile(path)
+		if len(data) > 0 {
+			line := map[string]interface{}{}
+			if err := json.Unmarshal(data, &line); err != nil {
+				t.Fatal(err)
+			} else if line["event"] != "offline" || line["attempts"] != float64(2) {
+				t.Fatalf("dead letter: %s", data)
+			}
+			return
+		}
+
+		time.Sleep(20 * time.Millisecond)
+	}
+
+	t.Fatal("dead letter not written")
+}
+
+func TestDispatcherRetryNotBlocking(t *testing.T) {
+	received := make(chan string, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/fail" {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		received <- r.Header.Get("X-Hook-Event")
+	}))
+	defer server.Close()
+
+	// 只有一个工作协程, 重试等待期间仍能推送其他事件
+	d := NewDispatcher(DispatcherOptions{Retries: 3, RetryInterval: time.Second, QueueSize: 4, Workers: 1})
+	d.Start()
+	d.Dispatch(server.URL+"/fail", "offline", []byte(`{"event":"offline"}`))
+	time.Sleep(50 * time.Millisecond)
+	d.Dispatch(server.URL+"/ok", "online", []byte(`{"event":"online"}`))
+
+	select {
+	case event := <-received:
+		if event != "online" {
+			t.Fatalf("event: %s", event)
+		}
+	case <-time.After(500 * time.Millisecond):
+		t.Fatal("event blocked by retry")
+	}
+}
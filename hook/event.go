@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"net/http"
+	"time"
 )
 
 const (
@@ -11,6 +12,17 @@ const (
 	EventTypeDeviceOffline
 	EventTypeDevicePosition
 	EventTypeDeviceOnInvite
+	EventTypeDeviceOnPlayback
+	EventTypeDeviceOnPlaybackControl
+	EventTypeDeviceOnQueryRecord
+	EventTypeDeviceKeepaliveTimeout
+	EventTypeDeviceCatalog
+	EventTypeDeviceAlarm
+	EventTypeStreamStart
+	EventTypeStreamStop
+	EventTypeCascadeOnline
+	EventTypeCascadeOffline
+	EventTypeChannelStatus
 )
 
 var (
@@ -22,8 +34,13 @@ func RegisterEventUrl(event int, url string) {
 }
 
 func PostEvent(url string, body []byte) (*http.Response, error) {
+	return PostEventWithTimeout(url, body, Timeout)
+}
+
+// PostEventWithTimeout timeout包含读取响应体的时间, 0为不超时
+func PostEventWithTimeout(url string, body []byte, timeout time.Duration) (*http.Response, error) {
 	client := &http.Client{
-		//Timeout: time.Duration(AppConfig.Hooks.Timeout),
+		Timeout: timeout,
 	}
 
 	request, err := http.NewRequest("post", url, bytes.NewBuffer(body))
@@ -35,10 +52,12 @@ func PostEvent(url string, body []byte) (*http.Response, error) {
 	return client.Do(request)
 }
 
-func PostOnInviteEvent(simNumber, channelNumber string) (*http.Response, error) {
-	params := map[string]string{
+// PostOnInviteEvent 通知1078信令服务器, 向设备下发0x9101实时音视频传输请求. dataType 0-音视频 2-对讲 4-广播
+func PostOnInviteEvent(simNumber, channelNumber string, dataType int) (*http.Response, error) {
+	params := map[string]interface{}{
 		"sim_number":     simNumber,
 		"channel_number": channelNumber,
+		"data_type":      dataType,
 	}
 
 	body, err := json.Marshal(params)
@@ -48,3 +67,63 @@ func PostOnInviteEvent(simNumber, channelNumber string) (*http.Response, error)
 
 	return PostEvent(EventUrls[EventTypeDeviceOnInvite], body)
 }
+
+// PostOnPlaybackEvent 通知1078信令服务器, 向设备下发0x9201录像回放请求
+func PostOnPlaybackEvent(simNumber, channelNumber, stream string, startTime, endTime int64, mode, multiple int) (*http.Response, error) {
+	params := map[string]interface{}{
+		"sim_number":     simNumber,
+		"channel_number": channelNumber,
+		"stream":         stream,
+		"start_time":     startTime,
+		"end_time":       endTime,
+		"mode":           mode,
+		"multiple":       multiple,
+	}
+
+	body, err := json.Marshal(params)
+	if err != nil {
+		return nil, err
+	}
+
+	return PostEvent(EventUrls[EventTypeDeviceOnPlayback], body)
+}
+
+// PostOnPlaybackControlEvent 通知1078信令服务器, 向设备下发0x9202录像回放控制
+func PostOnPlaybackControlEvent(simNumber, channelNumber, stream string, control, multiple int, position int64) (*http.Response, error) {
+	params := map[string]interface{}{
+		"sim_number":     simNumber,
+		"channel_number": channelNumber,
+		"stream":         stream,
+		"control":        control,
+		"multiple":       multiple,
+		"position":       position,
+	}
+
+	body, err := json.Marshal(params)
+	if err != nil {
+		return nil, err
+	}
+
+	return PostEvent(EventUrls[EventTypeDeviceOnPlaybackControl], body)
+}
+
+// PostOnQueryRecordEvent 通知1078信令服务器, 向设备下发0x9205查询资源列表, 在响应体中返回设备应答的资源列表
+func PostOnQueryRecordEvent(simNumber, channelNumber string, startTime, endTime int64, timeout time.Duration) (*http.Response, error) {
+	params := map[string]interface{}{
+		"sim_number":     simNumber,
+		"channel_number": channelNumber,
+		"start_time":     startTime,
+		"end_time":       endTime,
+		"alarm_flag":     0, // 不限报警类型, 按上级的录像类型在应答中过滤
+		"media_type":     0, // 音视频
+		"stream_type":    0, // 所有码流
+		"storage_type":   0, // 所有存储器
+	}
+
+	body, err := json.Marshal(params)
+	if err != nil {
+		return nil, err
+	}
+
+	return PostEventWithTimeout(EventUrls[EventTypeDeviceOnQueryRecord], body, timeout)
+}
@@ -1 +1 @@
-webpackJsonp([0],{"+ABx":function(t,e,n){"use strict";function o(t){n("kayV")}Object.defineProperty(e,"__esModule",{value:!0});var r=n("Xv6o"),i=n.n(r);for(var a in r)["default","default"].indexOf(a)<0&&function(t){n.d(e,t,function(){return r[t]})}(a);var s=n("IrJl"),l=n("VU/8"),u=o,c=l(i.a,s.a,!1,u,"data-v-4b1fba7c",null);e.default=c.exports},"/4HN":function(t,e,n){var o=n("jThn");"string"==typeof o&&(o=[[t.i,o,""]]),o.locals&&(t.exports=o.locals);n("rjj0")("6eb059c4",o,!0,{})},"/aob":function(t,e,n){"use strict";Object.defineProperty(e,"__esModule",{value:!0});var o=n("RUmD"),r=n.n(o);for(var i in o)["default","default"].indexOf(i)<0&&function(t){n.d(e,t,function(){return o[t]})}(i);var a=n("cnUC"),s=n("VU/8"),l=s(r.a,a.a,!1,null,null,null);e.default=l.exports},"/ePw":function(t,e,n){"use strict";Object.defineProperty(e,"__esModule",{value:!0});var o=n("9/zo"),r=n.n(o);for(var i in o)["default","default"].indexOf(i)<0&&function(t){n.d(e,t,function(){return o[t]})}(i);var a=n("RAAF"),s=n("VU/8"),l=s(r.a,a.a,!1,null,null,null);e.default=l.exports},"12k0":function(t,e,n){var o=n("2My4");"string"==typeof o&&(o=[[t.i,o,""]]),o.locals&&(t.exports=o.locals);n("rjj0")("6aba167c",o,!0,{})},"1XML":function(t,e,n){"use strict";function o(t){return t&&t.__esModule?t:{default:t}}Object.defineProperty(e,"__esModule",{value:!0}),n("F8S1");var r=n("0iPh"),i=o(r),a=n("M4fF"),s=o(a),l=n("5U11"),u=o(l),c=n("TTNq"),d=o(c),f=n("JkFl"),v=o(f),p=n("PJh5"),h=o(p);e.default={props:{size:{type:String,default:""},fade:{type:Boolean,default:!1},serverInfo:{type:Object,default:function(){}},userInfo:{type:Object,default:function(){return null}}},data:function(){var t=this;return{tabName:"status",chartColors:["#337ab7","#7FFFD4"],streamLogSettings:{area:!0,labelMap:{OutBytes:"发送",InBytes:"接收"}},streamLogExtend:{legend:{formatter:function(e){switch(e){case"接收":return"接收("+(0,u.default)(t.streamLogs.sumInBytes)+")";case"发送":return"发送("+(0,u.default)(t.streamLogs.sumOutBytes)+")"}return e}},tooltip:{formatter:function(e){var n=e[0].name;n="today"==t.streamLogType||"yesterday"==t.streamLogType?(0,h.default)(n,"YYYY-MM-DD HH:mm:ss").format("HH"):(0,h.default)(n,"YYYY-MM-DD HH:mm:ss").format("MM-DD");for(var o=n+"<br>"+e[0].seriesName+" "+(0,u.default)(e[0].value[1]),r=1;r<e.length;r++)o+="<br>"+e[r].seriesName+" "+(0,u.default)(e[r].value[1]);return o}},yAxis:{axisLabel:{formatter:function(t,e){return""+(0,u.default)(t)}}},xAxis:{axisLabel:{formatter:function(e,n){return"today"==t.streamLogType||"yesterday"==t.streamLogType?""+(0,h.default)(e,"YYYY-MM-DD HH:mm:ss").format("HH"):""+(0,h.default)(e,"YYYY-MM-DD HH:mm:ss").format("MM-DD")}}}},title:"状态记录",serial:"",code:"",limit:20,statusLogs:[],statusDays:0,bStatusLogLoading:!1,positionLogs:[],positionDays:0,bPositionLogLoading:!1,streamLogType:"today",streamLogs:{columns:["Time","OutBytes","InBytes"],rows:[],sumInBytes:0,sumOutBytes:0},bStreamLogLoading:!1,sessions:[],sessionNumOutputs:0,sessionTotal:0,sessionPageSize:10,sessionCurrentPage:1,sessionQ:"",sessionFilter:"",sessionSort:"",sessionOrder:"",sessionLoading:!1,shown:!1}},watch:{sessionQ:function(t,e){this.delayGetSessions()},sessionFilter:function(t,e){this.getSessions()},sessionCurrentPage:function(t,e){this.getSessions()},sessionPageSize:function(t,e){this.getSessions()}},components:{ElTimeline:d.default,ElTimelineItem:v.default},mounted:function(){var t=this;(0,i.default)(this.$el).find(".modal-content").draggable({handle:".modal-header",cancel:".modal-title span",addClasses:!1,containment:"document",delay:100,opacity:.5}),(0,i.default)(this.$el).on("shown.bs.modal",function(){t.shown=!0,t.$emit("show")}).on("hidden.bs.modal",function(){t.shown=!1,t.reset(),t.$emit("hide")})},methods:{getStatusLogs:function(){var t=this;this.serial&&(this.bStatusLogLoading=!0,this.statusLogs=[],i.default.get("/api/v1/device/statuslog",{serial:this.serial,code:this.code,limit:this.limit}).then(function(e){e&&(t.statusLogs=e.LogList||[],t.statusDays=e.LogReserveDays||0)}).always(function(){t.$nextTick(function(){t.bStatusLogLoading=!1})}))},getPositionLogs:function(){var t=this;this.serial&&(this.bPositionLogLoading=!0,this.positionLogs=[],i.default.get("/api/v1/device/positionlog",{serial:this.serial,code:this.code,limit:this.limit}).then(function(e){e&&(t.positionLogs=e.LogList||[],t.positionDays=e.LogReserveDays||0)}).always(function(){t.$nextTick(function(){t.bPositionLogLoading=!1})}))},getStreamLogs:function(){var t=this;this.serial&&(this.bStreamLogLoading=!0,this.streamLogs.rows=[],this.streamLogs.sumInBytes=0,this.streamLogs.sumOutBytes=0,i.default.get("/api/v1/device/streamlog",{serial:this.serial,code:this.code,type:this.streamLogType}).then(function(e){if(e){var n=!0,o=!1,r=void 0;try{for(var i,a=e.List[Symbol.iterator]();!(n=(i=a.next()).done);n=!0){var s=i.value;t.streamLogs.sumInBytes+=s.InBytes,t.streamLogs.sumOutBytes+=s.OutBytes}}catch(t){o=!0,r=t}finally{try{!n&&a.return&&a.return()}finally{if(o)throw r}}t.streamLogs.rows=e.List||[]}}).always(function(){t.$nextTick(function(){t.bStreamLogLoading=!1})}))},delayGetSessions:s.default.debounce(function(){this.getSessions()},800),getSessions:function(t){var e=this;this.shown&&(this.sessionLoading=!0,i.default.get("/api/v1/device/session/list",{serial:this.serial,filter:this.sessionFilter,q:this.sessionQ,start:(this.sessionCurrentPage-1)*this.sessionPageSize,limit:this.sessionPageSize,sort:this.sessionSort,order:this.sessionOrder}).then(function(n){n&&(e.sessionNumOutputs=n.NumOutputs||0,e.sessionTotal=n.SessionCount||0,e.sessions=n.SessionList||[],t&&t.target&&e.$message({type:"success",message:"刷新成功！"}))}).always(function(){e.$nextTick(function(){e.sessionLoading=!1})}))},clearSessions:function(){var t=this;(0,i.default)(this.$el).hide(),this.$confirm("确认清空 设备("+this.serial+") 会话?","提示",{lockScroll:!1}).then(function(){(0,i.default)(t.$el).show().focus(),i.default.post("/api/v1/device/session/clear",{serial:t.serial}).always(function(){t.getSessions()})}).catch(function(){(0,i.default)(t.$el).show().focus()})},stopSession:function(t){var e=this;(0,i.default)(this.$el).hide(),this.$confirm("确认停止 "+(t.ChannelName||t.ChannelID)+" 会话?","提示",{lockScroll:!1}).then(function(){(0,i.default)(e.$el).show().focus(),i.default.post("/api/v1/device/session/stop",{streamid:t.StreamID}).always(function(){e.getSessions()})}).catch(function(){(0,i.default)(e.$el).show().focus()})},sessionSortChange:function(t){this.sessionSort=t.prop,this.sessionOrder="ascending"==t.order?"asc":"desc",this.getSessions()},formatSessionChannelName:function(t,e,n){return n||"-"},formatSessionType:function(t,e,n){var o=(t.StreamID||"").split(":");if(o.length>1)switch(o[0]){case"stream":return"直播";case"playback":return"回放";case"download":return t.CloudRecord?"补录":"下载";case"broadcast":return"对讲";default:return o[0]||"-"}return"-"},formatSessionTypeTitle:function(t,e,n){return t.PlaybackStartTime&&t.PlaybackEndTime?t.PlaybackStartTime+" ~ "+t.PlaybackEndTime:""},formatSessionDuration:function(t,e,n){return this.formatDuration(t.Duration||0)},formatInBytes:function(t,e,n){return n?(0,u.default)(n):"-"},formatInBitRate:function(t,e,n){return n?n+" Kbps":"-"},formatVideoCodec:function(t,e,n){return"hevc"==n?"H265":"h264"==n?"H264":n||"-"},formatCloudRecord:function(t,e,n){return n?"✓":"✗"},statusTimeLineType:function(t){var e="primary";if(!t)return e;switch(t.Status){case"ON":e="primary";break;case"OFF":e="warning";break;case"DEL":e="danger";break;default:e="info"}return e},positionTimeLineType:function(t){var e="primary";if(!t)return e;switch(t.Method){case"移动位置上报":case"通道通知":case"通道检索":e="primary";break;case"接口设置":e="warning";break;case"报警位置上报":e="danger";break;default:e="info"}return e},tabClick:function(t){var e=this;"stream"==t.name&&this.$nextTick(function(){e.$refs.streamChart.resize()})},changeStreamLogType:function(){this.getStreamLogs()},reset:function(){this.title="状态记录",this.serial="",this.code="",this.statusLogs=[],this.statusDays=0,this.positionLogs=[],this.positionDays=0,this.streamLogs.rows=[],this.streamLogs.sumInBytes=0,this.streamLogs.sumOutBytes=0,this.streamLogType="today",this.limit=20,this.sessionQ="",this.sessionFilter="",this.sessionSort="",this.sessionOrder="",this.sessions=[],this.sessionCurrentPage=1,this.sessionTotal=0,this.sessionNumOutputs=0,this.sessionPageSize=10},show:function(t,e,n,o){this.title=t||this.title,this.serial=e||"",this.code=n||"",this.limit=o||20,(0,i.default)(this.$el).modal("show"),this.getStatusLogs(),this.getPositionLogs(),this.getStreamLogs()},showStatusLog:function(t,e,n,o){this.tabName="status",this.title=t||this.title,this.serial=e||"",this.code=n||"",this.limit=o||20,(0,i.default)(this.$el).modal("show"),this.getStatusLogs(),this.getPositionLogs(),this.getStreamLogs()},showStreamLog:function(t,e,n){var o=this;this.tabName="stream",this.title=t||this.title,this.serial=e||"",this.code=n||"",(0,i.default)(this.$el).modal("show"),this.getStatusLogs(),this.getPositionLogs(),this.getStreamLogs(),this.$nextTick(function(){o.$refs.streamChart.resize()})},hide:function(){(0,i.default)(this.$el).modal("hide")}}}},"2My4":function(t,e,n){e=t.exports=n("FZ+f")(!1),e.push([t.i,".modal-content[data-v-6f9217a2]{overflow:hidden}@media screen and (min-width:992px){.modal-dialog.modal-lgg[data-v-6f9217a2]{width:90%}}@media screen and (min-width:1200px){.modal-dialog.modal-lgg[data-v-6f9217a2]{width:1200px}}",""])},"2xaA":function(t,e,n){"use strict";function o(t){return t&&t.__esModule?t:{default:t}}function r(t){return function(){var e=t.apply(this,arguments);return new Promise(function(t,n){function o(r,i){try{var a=e[r](i),s=a.value}catch(t){return void n(t)}if(!a.done)return Promise.resolve(s).then(function(t){o("next",t)},function(t){o("throw",t)});t(s)}return o("next")})}}Object.defineProperty(e,"__esModule",{value:!0});var i=n("A9K1"),a=o(i),s=n("0iPh"),l=o(s);e.default={data:function(){return{form:this.defForm()}},components:{FormDlg:a.default},methods:{defForm:function(){return{serial:"",code:"",name:"",custom:!1,longitude:"",latitude:"",customLongitude:"",customLatitude:""}},onHide:function(){this.form=this.defForm(),this.$emit("hide")},onShow:function(){this.errors.clear(),this.$emit("show")},onPasteLngLat:function(t){try{var e=t.clipboardData.getData("Text").trim().split(/\s*,\s*/);2==e.length&&(this.form.customLongitude=e[0],this.form.customLatitude=e[1],t.preventDefault())}catch(t){console.log(t)}},onSubmit:function(){var t=this;return r(regeneratorRuntime.mark(function e(){var n,o;return regeneratorRuntime.wrap(function(e){for(;;)switch(e.prev=e.next){case 0:return e.next=2,t.$validator.validateAll();case 2:if(n=e.sent){e.next=8;break}return o=t.errors.items[0],t.$message({type:"error",message:o.msg}),(0,l.default)("[name="+o.field+"]").focus(),e.abrupt("return");case 8:l.default.post("/api/v1/device/setchannelposition",{serial:t.form.serial,code:t.form.code,longitude:t.form.customLongitude,latitude:t.form.customLatitude}).then(function(e){t.$refs.dlg.hide(),t.$emit("submit")});case 9:case"end":return e.stop()}},e,t)}))()},show:function(t){var e=this;this.errors.clear(),t&&(this.form.serial=t.serial,this.form.code=t.code,this.form.name=t.name,this.form.longitude=t.longitude||"",this.form.latitude=t.latitude||"",this.form.customLongitude=t.customLongitude||"",this.form.customLatitude=t.customLatitude||""),this.$nextTick(function(){e.$refs.dlg.show()})}}}},"33FN":function(t,e,n){"use strict";var o=function(){var t=this,e=t.$createElement,n=t._self._c||e;return n("div",{staticClass:"box box-primary"},[t._m(0),t._v(" "),n("div",{staticClass:"box-body"},[n("div",{staticClass:"form-inline",attrs:{autocomplete:"off",spellcheck:"false"}},[n("div",{staticClass:"form-group form-group-sm"},[n("label",[t._v("搜索")]),t._v(" "),n("input",{directives:[{name:"model",rawName:"v-model.trim",value:t.q,expression:"q",modifiers:{trim:!0}}],ref:"q",staticClass:"form-control",attrs:{type:"text",placeholder:"关键字"},domProps:{value:t.q},on:{keydown:function(e){if(!("button"in e)&&t._k(e.keyCode,"enter",13,e.key))return null;e.preventDefault()},input:function(e){e.target.composing||(t.q=e.target.value.trim())},blur:function(e){t.$forceUpdate()}}})]),t._v(" "),n("span",{staticClass:"hidden-xs"},[t._v("  ")]),t._v(" "),n("div",{staticClass:"form-group pull-right"},[n("router-link",{staticClass:"btn btn-default btn-sm",attrs:{to:"/devices/1"}},[n("i",{staticClass:"fa fa-table"}),t._v(" 列表视图\n                ")])],1)]),t._v(" "),n("br"),t._v(" "),n("div",{staticClass:"clearfix"}),t._v(" "),n("div",{staticClass:"content"},[n("div",{class:[{"col-md-3":t.hasAnyRole(t.serverInfo,t.userInfo,"管理员","操作员"),"col-md-4":!t.hasAnyRole(t.serverInfo,t.userInfo,"管理员","操作员")}]},[t._m(1),t._v(" "),n("div",{staticClass:"tab-content",staticStyle:{margin:"10px 0"},attrs:{id:"tab-tree-wrapper"}},[n("div",{ref:"devTreeWrapper",staticClass:"tab-pane active",attrs:{id:"dev-tree-wrapper"}},[t.showTree?n("el-tree",{ref:"devTree",style:(t.isMobile()?"max-height:200px;":"")+";min-height:200px;overflow:auto;",attrs:{id:"dev-tree","node-key":"key",props:t.treeProps,load:t.treeLoad,"filter-node-method":t.treeFilter,lazy:""},on:{"node-click":t.treeNodeClick,"node-contextmenu":t.treeNodeRightClick},scopedSlots:t._u([{key:"default",fn:function(e){var o=e.node,r=e.data;return n("span",{staticClass:"custom-tree-node"},[n("span",{class:{"text-green":t.treeLeaf(r)&&"ON"===r.status}},[n("i",{class:["fa",{"fa-sitemap":!t.treeLeaf(r),"fa-camera":t.treeLeaf(r)}]}),t._v(" "),n("span",{staticClass:"ellipsis",attrs:{title:o.label}},[t._v(t._s(o.label))])])])}}])}):t._e()],1),t._v(" "),n("div",{ref:"groupTreeWrapper",staticClass:"tab-pane",attrs:{id:"group-tree-wrapper"}},[t.showGroupTree?n("el-tree",{ref:"groupTree",style:(t.isMobile()?"max-height:200px;":"")+";min-height:200px;overflow:auto;",attrs:{id:"group-tree","node-key":"key",props:t.treeProps,load:t.groupTreeLoad,"filter-node-method":t.treeFilter,lazy:""},on:{"node-click":t.treeNodeClick,"node-contextmenu":t.treeNodeRightClick},scopedSlots:t._u([{key:"default",fn:function(e){var o=e.node,r=e.data;return n("span",{staticClass:"custom-tree-node"},[n("span",{class:{"text-green":"ON"===r.status&&0===r.subCount&&r.code&&r.serial&&!r.custom}},[n("i",{class:["fa",{"fa-home":!r.code,"fa-sitemap":r.code&&!t.treeLeaf(r),"fa-camera":t.treeLeaf(r)}]}),t._v(" "),n("span",{staticClass:"ellipsis",attrs:{title:o.label}},[t._v(t._s(o.label))])])])}}])}):t._e()],1)])]),t._v(" "),n("VueContextMenu",{staticClass:"right-menu",attrs:{target:t.contextMenuTarget,show:t.contextMenuVisible},on:{"update:show":function(e){return t.contextMenuVisible=e}}},[n("a",{directives:[{name:"show",rawName:"v-show",value:t.contextMenuNodeData,expression:"contextMenuNodeData"}],attrs:{role:"button"},on:{click:t.treeRefresh}},[n("i",{staticClass:"fa fa-refresh"}),t._v(" 刷新节点\n                ")]),t._v(" "),n("a",{directives:[{name:"show",rawName:"v-show",value:t.contextMenuNodeData&&t.contextMenuNodeData.custom,expression:"contextMenuNodeData && contextMenuNodeData.custom"}],attrs:{role:"button"},on:{click:t.showNodeAddDlg}},[n("i",{staticClass:"fa fa-plus"}),t._v(" 新建节点\n                ")]),t._v(" "),n("a",{directives:[{name:"show",rawName:"v-show",value:t.contextMenuNodeData&&t.contextMenuNodeData.custom&&t.contextMenuNodeData.code,expression:"contextMenuNodeData && contextMenuNodeData.custom && contextMenuNodeData.code"}],attrs:{role:"button"},on:{click:t.showCustomListDlg}},[n("i",{staticClass:"fa fa-check"}),t._v(" 选择通道\n                ")]),t._v(" "),n("a",{directives:[{name:"show",rawName:"v-show",value:t.contextMenuNodeData&&!(t.contextMenuNodeData.custom&&!t.contextMenuNodeData.code),expression:"contextMenuNodeData && !(contextMenuNodeData.custom && !contextMenuNodeData.code)"}],attrs:{role:"button"},on:{click:t.showNodeEditDlg}},[n("i",{staticClass:"fa fa-edit"}),t._v(" 编辑节点\n                ")]),t._v(" "),n("a",{directives:[{name:"show",rawName:"v-show",value:t.contextMenuNodeData&&t.contextMenuNodeData.custom&&t.contextMenuNodeData.code,expression:"contextMenuNodeData && contextMenuNodeData.custom && contextMenuNodeData.code"}],attrs:{role:"button"},on:{click:t.removeCustomNode}},[n("i",{staticClass:"fa fa-remove"}),t._v(" 删除节点\n                ")])]),t._v(" "),n("div",{class:["row",{"col-md-9":t.hasAnyRole(t.serverInfo,t.userInfo,"管理员","操作员"),"col-md-8":!t.hasAnyRole(t.serverInfo,t.userInfo,"管理员","操作员")}],attrs:{id:"dev-tree-right","data-sticky-wrap":"","data-sticky-for":"1000","data-sticky-class":"sticky"}},[n("div",{staticClass:"col-md-9 col-lg-10",attrs:{id:"dev-tree-player"}},[n("div",{staticClass:"view-list row"},[n("div",{staticClass:"video-show"},[n("div",t._l(t.players,function(e,o){return n("div",{key:o,staticClass:"no-margin no-padding video col-sm-6",class:{active:o==t.playerIdx,"col-sm-12":1==t.playersLength,"col-sm-6":4==t.playersLength,"col-sm-4":9==t.playersLength,"col-sm-3":16==t.playersLength},on:{click:function(n){t.clickPlayer(e,o,n)},mousemove:function(n){t.resetCloseTimer(e)},touchstart:function(n){t.resetCloseTimer(e)}}},[n("LivePlayer",{directives:[{name:"loading",rawName:"v-loading",value:e.bLoading,expression:"player.bLoading"}],attrs:{videoUrl:e.url,poster:e.poster,live:"",muted:"",stretch:"",digitalZoom:"",hideBigPlayButton:!!t.serverInfo.HideBigPlayButton,loading:e.bLoading,"element-loading-text":"加载中...","element-loading-background":"#000"},on:{message:t.$message,"update:loading":function(n){t.$set(e,"bLoading",n)}}}),t._v(" "),n("div",{directives:[{name:"show",rawName:"v-show",value:e.url&&e.bCloseShow,expression:"player.url && player.bCloseShow"}],staticClass:"video-close",on:{click:function(n){t.closeVideo(e)}}},[t._v("关闭")])],1)}))])]),t._v(" "),n("br"),t._v(" "),n("br")]),t._v(" "),t.hasAnyRole(t.serverInfo,t.userInfo,"管理员","操作员")?n("div",{staticClass:"col-md-3 col-lg-2",attrs:{id:"dev-tree-ptz"}},[n("div",{staticClass:"ptz-block"},[n("div",{staticClass:"ptz-cell ptz-up",class:{readonly:!t.playing},attrs:{command:"up",title:"上"},on:{mousedown:function(e){e.preventDefault(),t.ptzControl(e)}}},[n("i",{staticClass:"fa fa-chevron-up"})]),t._v(" "),n("div",{staticClass:"ptz-cell ptz-left",class:{readonly:!t.playing},attrs:{command:"left",title:"左"},on:{mousedown:function(e){e.preventDefault(),t.ptzControl(e)}}},[n("i",{staticClass:"fa fa-chevron-left"})]),t._v(" "),n("div",{staticClass:"ptz-cell ptz-center",attrs:{title:"云台控制"}},[t.canTalk()&&"标准版"!=t.serverInfo.VersionType?n("i",{staticClass:"fa fa-microphone",class:{readonly:!t.playing},attrs:{title:"按住喊话"},on:{mousedown:function(e){e.preventDefault(),t.talkStart(e)}}}):t._e(),t._v(" "),t.canTalk()||"标准版"==t.serverInfo.VersionType?t._e():n("i",{staticClass:"fa fa-microphone-slash",class:{readonly:!t.playing},attrs:{title:"由于浏览器安全策略, 非 HTTPS 或 localhost 访问, 对讲不可用"}})]),t._v(" "),n("div",{staticClass:"ptz-cell ptz-right",class:{readonly:!t.playing},attrs:{command:"right",title:"右"},on:{mousedown:function(e){e.preventDefault(),t.ptzControl(e)}}},[n("i",{staticClass:"fa fa-chevron-right"})]),t._v(" "),n("div",{staticClass:"ptz-cell ptz-down",class:{readonly:!t.playing},attrs:{command:"down",title:"下"},on:{mousedown:function(e){e.preventDefault(),t.ptzControl(e)}}},[n("i",{staticClass:"fa fa-chevron-down"})]),t._v(" "),n("div",{staticClass:"ptz-cell ptz-zoomin",class:{readonly:!t.playing},attrs:{command:"zoomin",title:"放大"},on:{mousedown:function(e){e.preventDefault(),t.ptzControl(e)}}},[n("i",{staticClass:"fa fa-plus"})]),t._v(" "),n("div",{staticClass:"ptz-cell ptz-zoomout",class:{readonly:!t.playing},attrs:{command:"zoomout",title:"缩小"},on:{mousedown:function(e){e.preventDefault(),t.ptzControl(e)}}},[n("i",{staticClass:"fa fa-minus"})])])]):t._e()]),t._v(" "),n("div",{staticClass:"clearfix"}),t._v(" "),t.isDemoUser(t.serverInfo,t.userInfo)?n("div",{staticClass:"text-center text-gray"},[n("br"),t._v("\n                提示: 演示系统限制匿名登录播放时间, 若需测试长时间播放, 请"),n("a",{attrs:{target:"_blank",href:"//www.liveqing.com/docs/download/LiveGBS.html"}},[t._v("下载使用")])]):t._e(),t._v(" "),n("br")],1)]),t._v(" "),n("DeviceTreeNodeEditDlg",{ref:"nodeEditDlg",staticStyle:{"z-index":"2001"},attrs:{serverInfo:t.serverInfo,userInfo:t.userInfo},on:{submit:t.treeRefresh}}),t._v(" "),n("ChannelCustomListDlg",{ref:"customListDlg",staticStyle:{"z-index":"2001"},attrs:{serverInfo:t.serverInfo,userInfo:t.userInfo,size:"modal-lg",title:t.customListDlgTitle},on:{hide:t.treeRefresh}})],1)},r=[function(){var t=this,e=t.$createElement,n=t._self._c||e;return n("div",{staticClass:"box-header"},[n("h4",{staticClass:"text-primary text-center"},[t._v("设备树")])])},function(){var t=this,e=t.$createElement,n=t._self._c||e;return n("ul",{staticClass:"nav nav-tabs"},[n("li",{staticClass:"active"},[n("a",{attrs:{href:"#dev-tree-wrapper","data-toggle":"tab"}},[t._v("设备树")])]),t._v(" "),n("li",[n("a",{attrs:{href:"#group-tree-wrapper","data-toggle":"tab"}},[t._v("分 组")])])])}],i={render:o,staticRenderFns:r};e.a=i},"3f1d":function(t,e,n){"use strict";(function(t){Object.defineProperty(e,"__esModule",{value:!0}),n("F8S1");var o=n("eAMf"),r=function(t){return t&&t.__esModule?t:{default:t}}(o);e.default={data:function(){return{protocol:"",videoUrl:"",videoTitle:"",osd:"",snapUrl:"",serial:"",code:"",streamID:"",speed:1,inBitRate:0,timer:0,bShow:!1,bLoading:!1,mediaInfo:null,bOutHevcTip:!1,bAudioEnable:!1}},props:{fade:{type:Boolean,default:!1},digitalZoom:{type:Boolean,default:!0},serverInfo:{type:Object,default:function(){}},userInfo:{type:Object,default:function(){return null}}},components:{LivePlayer:r.default},mounted:function(){var e=this;t(this.$el).find(".modal-content").draggable({handle:".modal-header",cancel:".modal-title span",addClasses:!1,containment:"document",delay:100,opacity:.5}),t(this.$el).on("hidden.bs.modal",function(){e.bShow=!1,e.timer&&(clearInterval(e.timer),e.timer=0),e.stop(),e.reset()}).on("shown.bs.modal",function(){e.bShow=!0,e.streamID&&(e.timer=setInterval(function(){t.ajax({type:"GET",url:"/api/v1/playback/streaminfo",data:{streamid:e.streamID},global:!1}).then(function(t){e.speed=t.PlaybackSpeed||1,e.inBitRate=t.InBitRate||0}).fail(function(){e.inBitRate=0})},3e3))})},beforeDestroy:function(){this.timer&&(clearInterval(this.timer),this.timer=0)},methods:{reset:function(){this.protocol="",this.videoUrl="",this.osd="",this.snapUrl="",this.serial="",this.code="",this.streamID="",this.speed=1,this.inBitRate=0,this.timer=0,this.mediaInfo=null,this.bOutHevcTip=!1,this.bAudioEnable=!1},play:function(e,n,o,r,i){this.protocol=e||"",this.videoTitle=o||"",this.snapUrl=r||"",this.serial=i.DeviceID||"",this.code=i.ChannelID||"",this.streamID=i.StreamID||"",this.osd=i.ChannelOSD||"",this.speed=i.PlaybackSpeed||1,this.inBitRate=i.InBitRate||0,this.bAudioEnable=!!i.AudioEnable,this.videoUrl=n||"",t(this.$el).modal("show")},scale:function(){var e=this,n=arguments.length>0&&void 0!==arguments[0]?arguments[0]:1;this.streamID&&t.post("/api/v1/playback/control",{streamid:this.streamID,command:"scale",scale:n}).then(function(t){e.$message({type:"success",message:n+" 倍速设置成功"})})},stop:function(){var e=this;this.streamID&&(t.ajax({type:"POST",url:"/api/v1/playback/stop",data:{streamid:this.streamID},global:!1}).always(function(){e.$emit("close")}),this.streamID="")},onMediaInfo:function(t){this.mediaInfo=t},onEnded:function(t){this.mediaInfo=null},onError:function(t){"MediaError"==t&&(this.mediaInfo&&String(this.mediaInfo.videoCodec).startsWith("hvc")||"HLS"==this.protocol)&&flvjs.getFeatureList()&&!flvjs.getFeatureList().nativeMP4H265Playback&&(this.bOutHevcTip=!0,console.log("提示: 正在播放 H265 直出流, 确保浏览器版本较新, 并且开启硬件加速"))}}}}).call(e,n("0iPh"))},"49HP":function(t,e,n){"use strict";(function(t){Object.defineProperty(e,"__esModule",{value:!0});var o=n("PJh5"),r=function(t){return t&&t.__esModule?t:{default:t}}(o);e.default={data:function(){return{flags:{},defaultFlags:"0000000000000000000000000000000"}},props:{placeholder:{default:"选择日期"},clearBtn:{type:Boolean,default:!1},day:{default:""}},mounted:function(){var e=this;t(this.$el).datepicker({language:"zh-CN",autoclose:!0,clearBtn:this.clearBtn,format:"yyyy-mm-dd",todayHighlight:!0}).on("changeDate",function(t){t.date?e.$emit("update:day",(0,r.default)(t.date).format("YYYYMMDD")):e.$emit("update:day","")}).on("change",function(){e.$el.value||e.$emit("update:day","")}),this.day&&t(this.$el).datepicker("setDate",(0,r.default)(this.day,"YYYYMMDD").toDate()),this.update()},watch:{day:function(e){if(e){var n=t(this.$el).datepicker("getDate");e!=(0,r.default)(n).format("YYYYMMDD")&&t(this.$el).datepicker("setDate",(0,r.default)(e,"YYYYMMDD").toDate())}}},computed:{},methods:{update:function(){t(this.$el).datepicker("update")}}}}).call(e,n("0iPh"))},"4Dib":function(t,e,n){e=t.exports=n("FZ+f")(!1),e.push([t.i,"#dev-tree-player .view-list .video-show .video{border:1px solid #fff}#dev-tree-player .view-list .video-show .video.active .video-inner{border:2px solid red}#dev-tree-player .view-list .video-show .col-sm-12:first-child{border:0}#dev-tree-player .view-list .video-show .col-sm-6:first-child,#dev-tree-player .view-list .video-show .col-sm-6:nth-child(2){border-top-color:transparent}#dev-tree-player .view-list .video-show .col-sm-6:nth-child(2),#dev-tree-player .view-list .video-show .col-sm-6:nth-child(4){border-right-color:transparent}#dev-tree-player .view-list .video-show .col-sm-6:first-child,#dev-tree-player .view-list .video-show .col-sm-6:nth-child(3){border-left-color:transparent}#dev-tree-player .view-list .video-show .col-sm-6:nth-child(3),#dev-tree-player .view-list .video-show .col-sm-6:nth-child(4){border-bottom-color:transparent}#dev-tree-player .view-list .video-show .col-sm-4:first-child,#dev-tree-player .view-list .video-show .col-sm-4:nth-child(2),#dev-tree-player .view-list .video-show .col-sm-4:nth-child(3){border-top-color:transparent}#dev-tree-player .view-list .video-show .col-sm-4:nth-child(3),#dev-tree-player .view-list .video-show .col-sm-4:nth-child(6),#dev-tree-player .view-list .video-show .col-sm-4:nth-child(9){border-right-color:transparent}#dev-tree-player .view-list .video-show .col-sm-4:nth-child(7),#dev-tree-player .view-list .video-show .col-sm-4:nth-child(8),#dev-tree-player .view-list .video-show .col-sm-4:nth-child(9){border-bottom-color:transparent}#dev-tree-player .view-list .video-show .col-sm-4:first-child,#dev-tree-player .view-list .video-show .col-sm-4:nth-child(4),#dev-tree-player .view-list .video-show .col-sm-4:nth-child(7){border-left-color:transparent}#dev-tree-player .view-list .video-show .col-sm-3:first-child,#dev-tree-player .view-list .video-show .col-sm-3:nth-child(2),#dev-tree-player .view-list .video-show .col-sm-3:nth-child(3),#dev-tree-player .view-list .video-show .col-sm-3:nth-child(4){border-top-color:transparent}#dev-tree-player .view-list .video-show .col-sm-3:nth-child(4),#dev-tree-player .view-list .video-show .col-sm-3:nth-child(8),#dev-tree-player .view-list .video-show .col-sm-3:nth-child(12),#dev-tree-player .view-list .video-show .col-sm-3:nth-child(16){border-right-color:transparent}#dev-tree-player .view-list .video-show .col-sm-3:nth-child(13),#dev-tree-player .view-list .video-show .col-sm-3:nth-child(14),#dev-tree-player .view-list .video-show .col-sm-3:nth-child(15),#dev-tree-player .view-list .video-show .col-sm-3:nth-child(16){border-bottom-color:transparent}#dev-tree-player .view-list .video-show .col-sm-3:first-child,#dev-tree-player .view-list .video-show .col-sm-3:nth-child(5),#dev-tree-player .view-list .video-show .col-sm-3:nth-child(9),#dev-tree-player .view-list .video-show .col-sm-3:nth-child(13){border-left-color:transparent}",""])},"5aEn":function(t,e,n){"use strict";function o(t){n("kbZy")}Object.defineProperty(e,"__esModule",{value:!0});var r=n("3f1d"),i=n.n(r);for(var a in r)["default","default"].indexOf(a)<0&&function(t){n.d(e,t,function(){return r[t]})}(a);var s=n("SHtI"),l=n("VU/8"),u=o,c=l(i.a,s.a,!1,u,"data-v-66201e86",null);e.default=c.exports},"5gxX":function(t,e,n){"use strict";function o(t){n("LbQQ")}Object.defineProperty(e,"__esModule",{value:!0});var r=n("qb8i"),i=n.n(r);for(var a in r)["default","default"].indexOf(a)<0&&function(t){n.d(e,t,function(){return r[t]})}(a);var s=n("lGU5"),l=n("VU/8"),u=o,c=l(i.a,s.a,!1,u,null,null);e.default=c.exports},"5w0Z":function(t,e,n){"use strict";var o=function(){var t=this,e=t.$createElement,n=t._self._c||e;return n("div",{class:["modal",{fade:t.fade}],attrs:{"data-backdrop":"static","data-disable":"false","data-keyboard":"true",tabindex:"-1"}},[n("div",{class:["modal-dialog",t.size]},[n("div",{staticClass:"modal-content"},[n("div",{staticClass:"modal-header"},[t._m(0),t._v(" "),n("h4",{staticClass:"modal-title text-center text-primary"},[n("span",[t._v(t._s(t.title))])])]),t._v(" "),n("div",{staticClass:"modal-body"},[n("div",{staticClass:"form-inline",attrs:{autocomplete:"off",spellcheck:"false"}},[n("div",{staticClass:"form-group form-group-sm"},[n("label",[t._v("搜索")]),t._v(" "),n("input",{directives:[{name:"model",rawName:"v-model.trim",value:t.q,expression:"q",modifiers:{trim:!0}}],ref:"q",staticClass:"form-control",attrs:{type:"text",placeholder:"关键字"},domProps:{value:t.q},on:{keydown:function(e){if(!("button"in e)&&t._k(e.keyCode,"enter",13,e.key))return null;e.preventDefault()},input:function(e){e.target.composing||(t.q=e.target.value.trim())},blur:function(e){t.$forceUpdate()}}})]),t._v(" "),n("span",{staticClass:"hidden-xs"},[t._v("  ")]),t._v(" "),n("div",{staticClass:"form-group form-group-sm"},[n("label",[t._v("通道类型")]),t._v(" "),n("select",{directives:[{name:"model",rawName:"v-model.trim",value:t.channel_type,expression:"channel_type",modifiers:{trim:!0}}],staticClass:"form-control",on:{change:function(e){var n=Array.prototype.filter.call(e.target.options,function(t){return t.selected}).map(function(t){return"_value"in t?t._value:t.value});t.channel_type=e.target.multiple?n:n[0]}}},[n("option",{attrs:{value:""}},[t._v("全部")]),t._v(" "),n("option",{attrs:{value:"device"}},[t._v("设备")]),t._v(" "),n("option",{attrs:{value:"dir"}},[t._v("子目录")])])]),t._v(" "),n("span",{staticClass:"hidden-xs"},[t._v("  ")]),t._v(" "),"device"==t.channel_type?n("div",{staticClass:"form-group form-group-sm"},[n("label",[t._v("在线状态")]),t._v(" "),n("select",{directives:[{name:"model",rawName:"v-model.trim",value:t.online,expression:"online",modifiers:{trim:!0}}],staticClass:"form-control",on:{change:function(e){var n=Array.prototype.filter.call(e.target.options,function(t){return t.selected}).map(function(t){return"_value"in t?t._value:t.value});t.online=e.target.multiple?n:n[0]}}},[n("option",{attrs:{value:""}},[t._v("全部")]),t._v(" "),n("option",{attrs:{value:"true"}},[t._v("在线")]),t._v(" "),n("option",{attrs:{value:"false"}},[t._v("离线")])])]):t._e(),t._v(" "),"device"==t.channel_type?n("span",{staticClass:"hidden-xs"},[t._v("  ")]):t._e(),t._v(" "),n("div",{staticClass:"form-group form-group-sm"},[n("div",{staticClass:"checkbox",staticStyle:{"user-select":"none"}},[n("el-checkbox",{staticStyle:{"margin-top":"-5px","padding-left":"0"},attrs:{size:"small",name:"Related"},model:{value:t.related,callback:function(e){t.related="string"==typeof e?e.trim():e},expression:"related"}},[t._v("\n                                只看"+t._s(t.reverse?"未选":"已选")+"("+t._s(t.relateCnt)+")\n                            ")]),t._v(" "),n("span",[n("a",{attrs:{role:"button"},on:{click:t.toggleReverse}},[n("i",{staticClass:"fa fa-sort"})])])],1)])]),t._v(" "),n("br"),t._v(" "),n("el-table",{directives:[{name:"loading",rawName:"v-loading",value:t.loading,expression:"loading"}],ref:"channelTable",attrs:{data:t.channels,stripe:"","max-height":500,"element-loading-text":"加载中..."},on:{"sort-change":t.sortChange,select:t.select,"select-all":t.selectAll}},[n("el-table-column",{attrs:{type:"selection",width:"55",fixed:"",selectable:t.selectable}}),t._v(" "),n("el-table-column",{attrs:{prop:"DeviceID",label:"设备国标编号","min-width":"200","show-overflow-tooltip":"",sortable:"custom"}}),t._v(" "),n("el-table-column",{attrs:{prop:"ID",label:"通道国标编号","min-width":"200","show-overflow-tooltip":"",sortable:"custom"}}),t._v(" "),"标准版"!=t.serverInfo.VersionType?n("el-table-column",{attrs:{prop:"CustomID",label:"自定义通道国标编号","min-width":"200","show-overflow-tooltip":"",sortable:"custom"},scopedSlots:t._u([{key:"default",fn:function(e){return[e.row.Editing?n("input",{directives:[{name:"focus",rawName:"v-focus"}],staticStyle:{width:"170px",padding:"2px","line-height":"100%"},attrs:{type:"text",oninput:"value=value.replace(/[^\\d]/g,'')"},domProps:{value:e.row.CustomID||e.row.ID},on:{keydown:[function(n){if(!("button"in n)&&t._k(n.keyCode,"esc",27,n.key))return null;n.stopPropagation(),n.preventDefault(),t.setChannelID(e.row,!1,n)},function(n){if(!("button"in n)&&t._k(n.keyCode,"enter",13,n.key))return null;n.stopPropagation(),n.preventDefault(),t.setChannelID(e.row,!1,n)}],blur:function(n){t.setChannelID(e.row,!1,n)}}}):n("a",{class:{"text-orange":!!e.row.CustomID},attrs:{role:"button"},on:{click:function(n){n.preventDefault(),t.setChannelID(e.row,!0,n)}}},[t._v(t._s(e.row.CustomID||e.row.ID))])]}}])}):t._e(),t._v(" "),n("el-table-column",{attrs:{prop:"Name",label:"通道名称","min-width":"160",formatter:t.formatName,"show-overflow-tooltip":""}}),t._v(" "),n("el-table-column",{attrs:{"min-width":"100",label:"快照"},scopedSlots:t._u([{key:"default",fn:function(e){return[t.isDir(e.row)?n("span",[n("i",{staticClass:"fa fa-sitemap"})]):n("el-popover",{attrs:{"open-delay":1e3,"close-delay":10,placement:"left",title:e.row.ID,width:"400",trigger:"hover"}},[n("img",{staticStyle:{width:"100%",height:"100%"},attrs:{onerror:"this.src='/images/default_snap.png';",src:e.row.SnapURL}}),t._v(" "),n("img",{staticStyle:{height:"30px",width:"50px"},attrs:{slot:"reference",onerror:"this.src='/images/default_snap.png';",src:e.row.SnapURL},slot:"reference"})])]}}])}),t._v(" "),n("el-table-column",{attrs:{prop:"Status",label:"在线状态","min-width":"100"},scopedSlots:t._u([{key:"default",fn:function(e){return[t.isDir(e.row)?n("span",[t._v("-")]):e.row.DeviceOnline&&"ON"==(e.row.CustomStatus||e.row.Status)?n("span",{class:{"text-success":"ON"==e.row.Status,"text-orange":!!e.row.CustomStatus}},[t._v("在线")]):n("span",{class:{"text-orange":!!e.row.CustomStatus}},[t._v("离线")])]}}])}),t._v(" "),n("el-table-column",{attrs:{prop:"Manufacturer",label:"厂家","min-width":"120",formatter:t.formatManufacturer,"show-overflow-tooltip":""}})],1),t._v(" "),t.total>0?n("el-pagination",{staticClass:"pull-right",attrs:{layout:"total,prev,pager,next,sizes","pager-count":t.isMobile()?3:5,total:t.total,"page-size":t.pageSize,"current-page":t.currentPage},on:{"update:pageSize":function(e){t.pageSize=e},"update:currentPage":function(e){t.currentPage=e}}}):t._e(),t._v(" "),n("div",{staticClass:"clearfix"})],1)])])])},r=[function(){var t=this,e=t.$createElement,n=t._self._c||e;return n("button",{staticClass:"close",attrs:{type:"button","data-dismiss":"modal","aria-label":"Close"}},[n("span",{attrs:{"aria-hidden":"true"}},[t._v("×")])])}],i={render:o,staticRenderFns:r};e.a=i},"6Exk":function(t,e,n){"use strict";var o=function(){var t=this,e=t.$createElement,n=t._self._c||e;return n("FormDlg",{ref:"dlg",attrs:{title:"编辑通道位置",disabled:t.errors.any()||t.form.custom},on:{hide:t.onHide,show:t.onShow,submit:t.onSubmit}},[n("div",{class:{"form-group":!0,"has-error":t.errors.has("serial")}},[n("label",{staticClass:"col-sm-4 control-label",attrs:{for:"input-position-serial"}},[t._v("设备编号\n            "),n("span",{staticClass:"text-red"},[t._v("*")])]),t._v(" "),n("div",{staticClass:"col-sm-7"},[n("input",{staticClass:"form-control",attrs:{type:"text",readonly:"readonly",id:"input-position-serial",name:"serial","data-vv-as":"设备编号"},domProps:{value:t.form.serial}})])]),t._v(" "),n("div",{class:{"form-group":!0,"has-error":t.errors.has("code")}},[n("label",{staticClass:"col-sm-4 control-label",attrs:{for:"input-position-code"}},[t._v("通道编号\n            "),n("span",{staticClass:"text-red"},[t._v("*")])]),t._v(" "),n("div",{staticClass:"col-sm-7"},[n("input",{staticClass:"form-control",attrs:{type:"text",readonly:"readonly",id:"input-position-code",name:"code","data-vv-as":"通道编号"},domProps:{value:t.form.code}})])]),t._v(" "),n("div",{class:{"form-group":!0,"has-error":t.errors.has("name")}},[n("label",{staticClass:"col-sm-4 control-label",attrs:{for:"input-position-name"}},[t._v("通道名称\n        ")]),t._v(" "),n("div",{staticClass:"col-sm-7"},[n("input",{staticClass:"form-control",attrs:{type:"text",readonly:"readonly",id:"input-position-name",name:"name","data-vv-as":"通道名称"},domProps:{value:t.form.name||"-"}})])]),t._v(" "),n("div",{class:{"form-group":!0,"has-error":t.errors.has("longitude")}},[n("label",{staticClass:"col-sm-4 control-label",attrs:{for:"input-position-longitude"}},[t._v("经度\n        ")]),t._v(" "),n("div",{staticClass:"col-sm-7"},[n("input",{directives:[{name:"validate",rawName:"v-validate",value:"decimal",expression:"'decimal'"},{name:"model",rawName:"v-model.trim",value:t.form.customLongitude,expression:"form.customLongitude",modifiers:{trim:!0}}],staticClass:"form-control",attrs:{type:"text",id:"input-position-longitude",name:"longitude",placeholder:t.form.longitude||"-","data-vv-as":"经度"},domProps:{value:t.form.customLongitude},on:{paste:t.onPasteLngLat,keydown:function(e){if(!("button"in e)&&t._k(e.keyCode,"enter",13,e.key))return null;t.$el.querySelector("#input-position-latitude").focus()},input:function(e){e.target.composing||t.$set(t.form,"customLongitude",e.target.value.trim())},blur:function(e){t.$forceUpdate()}}})])]),t._v(" "),n("div",{class:{"form-group":!0,"has-error":t.errors.has("latitude")}},[n("label",{staticClass:"col-sm-4 control-label",attrs:{for:"input-position-latitude"}},[t._v("纬度\n        ")]),t._v(" "),n("div",{staticClass:"col-sm-7"},[n("input",{directives:[{name:"validate",rawName:"v-validate",value:"decimal",expression:"'decimal'"},{name:"model",rawName:"v-model.trim",value:t.form.customLatitude,expression:"form.customLatitude",modifiers:{trim:!0}}],staticClass:"form-control",attrs:{type:"text",id:"input-position-latitude",name:"latitude",placeholder:t.form.latitude||"-","data-vv-as":"纬度"},domProps:{value:t.form.customLatitude},on:{paste:t.onPasteLngLat,keydown:function(e){if(!("button"in e)&&t._k(e.keyCode,"enter",13,e.key))return null;t.onSubmit(e)},input:function(e){e.target.composing||t.$set(t.form,"customLatitude",e.target.value.trim())},blur:function(e){t.$forceUpdate()}}})])])])},r=[],i={render:o,staticRenderFns:r};e.a=i},"6O2P":function(t,e,n){e=t.exports=n("FZ+f")(!1),e.push([t.i,".opt-group .cell{overflow:visible}",""])},"7KHA":function(t,e,n){e=t.exports=n("FZ+f")(!1),e.push([t.i,".modal-content[data-v-65020dbb]{overflow:hidden}@media screen and (min-width:992px){.modal-dialog.modal-lgg[data-v-65020dbb]{width:90%}}@media screen and (min-width:1200px){.modal-dialog.modal-lgg[data-v-65020dbb]{width:1200px}}@media screen and (max-width:767px){.el-date-editor--daterange.el-input[data-v-65020dbb],.el-date-editor--daterange.el-input__inner[data-v-65020dbb],.el-date-editor--timerange.el-input[data-v-65020dbb],.el-date-editor--timerange.el-input__inner[data-v-65020dbb]{width:250px}}",""])},"8+DQ":function(t,e,n){"use strict";function o(t){n("/4HN")}Object.defineProperty(e,"__esModule",{value:!0});var r=n("SLv4"),i=n.n(r);for(var a in r)["default","default"].indexOf(a)<0&&function(t){n.d(e,t,function(){return r[t]})}(a);var s=n("WGgy"),l=n("VU/8"),u=o,c=l(i.a,s.a,!1,u,"data-v-4494c1da",null);e.default=c.exports},"9/zo":function(t,e,n){"use strict";(function(t){function o(t){return t&&t.__esModule?t:{default:t}}Object.defineProperty(e,"__esModule",{value:!0});var r=Object.assign||function(t){for(var e=1;e<arguments.length;e++){var n=arguments[e];for(var o in n)Object.prototype.hasOwnProperty.call(n,o)&&(t[o]=n[o])}return t},i=n("M4fF"),a=o(i),s=n("5gxX"),l=o(s),u=n("KToh"),c=o(u),d=n("MR46"),f=o(d),v=n("NYxO");e.default={props:{},data:function(){return{q:"",online:"",total:0,pageSize:10,currentPage:1,sort:"ID",order:"asc",devices:[],loading:!1,bgLoading:!1,timer:0,statusLogs:[],bStatusLogLoading:!1,removeDlg:!1,removeUACheck:!1,removeIPCheck:!1,forbidUACheck:!1,forbidIPCheck:!1,removeID:"",removeName:"",removeUA:"",removeIP:"",debug:!1,checkCatalogProgress:!0,catalogMap:{},network:!1,region:!1}},components:{UploadDlg:l.default,DeviceEditDlg:c.default,DeviceLogDlg:f.default},computed:r({},(0,v.mapState)(["userInfo","serverInfo"])),mounted:function(){t(document).on("keydown",this.keyDown)},beforeDestroy:function(){this.timer&&(clearInterval(this.timer),this.timer=0),t(document).off("keydown",this.keyDown)},methods:{ready:function(){var t=this;this.$watch("q",function(t,e){this.doDelaySearch()}),this.$watch("online",function(t,e){this.doSearch()}),this.$watch("currentPage",function(t,e){this.doSearch(t)}),this.timer=setInterval(function(){t.getDeviceList(!1)},3e3)},doSearch:function(){var t=arguments.length>0&&void 0!==arguments[0]?arguments[0]:1,e={};this.q&&(e.q=this.q),this.online&&(e.online=this.online),this.$router.replace({path:"/devices/"+t,query:e})},doDelaySearch:a.default.debounce(function(){this.doSearch()},800),getDeviceList:function(){var e=this,n=!(arguments.length>0&&void 0!==arguments[0])||arguments[0];if(n)this.loading=!0;else{if(this.bgLoading||this.loading)return;this.bgLoading=!0}t.ajax({method:"GET",url:"/api/v1/device/list",global:n,data:{q:this.q,start:(this.currentPage-1)*this.pageSize,limit:this.pageSize,online:this.online,sort:this.sort,order:this.order,check_catalog_progress:this.checkCatalogProgress}}).then(function(t){e.total=t.DeviceCount,e.devices=t.DeviceList,e.network=!1!==t.DeviceNetwork,e.region=!!t.DeviceRegion}).always(function(){n?e.loading=!1:e.bgLoading=!1})},sortChange:function(t){this.sort=t.prop,this.order="ascending"==t.order?"asc":"desc",this.getDeviceList()},fetchCatalog:function(e){var n=this;if(this.serverInfo&&!this.serverInfo.RemainDays)return void this.$message({type:"error",message:"授权过期"});this.$set(this.catalogMap,e.ID,!0),t.ajax({method:"GET",url:"/api/v1/device/fetchcatalog",global:!1,data:{serial:e.ID}}).then(function(t){n.$message({type:"success",message:"更新通道信息成功"})}).fail(function(t){t&&console.log("fetch catalog ajax error: "+t.status+" "+t.responseText)}).always(function(){n.$delete(n.catalogMap,e.ID)})},download:function(){window.open("/api/v1/device/export")},uploaded:function(){this.$message({type:"success",message:"上传成功！"}),this.getDeviceList(),this.$refs.uploadDlg.hide()},removeBatch:function(){var e=this;this.removeDlg=!1;var n={serial:this.removeID};this.removeUACheck&&this.removeUA?(n.ua=this.removeUA,this.forbidUACheck&&(n.forbid=!0)):this.removeIPCheck&&this.removeIP&&(n.ip=this.removeIP,this.forbidIPCheck&&(n.forbid=!0)),t.post("/api/v1/device/remove",n).always(function(){e.getDeviceList()})},removeDevice:function(e){var n=this;if(!e.Online&&!e.ChannelCount&&"0001-01-01 00:00:00"===e.LastKeepaliveAt&&(e.Manufacturer||e.RemoteIP)&&e.ID&&e.ID.length<10)return this.removeID=e.ID,this.removeName=e.Name,this.removeUA=e.Manufacturer,this.removeIP=e.RemoteIP,this.removeUACheck=!1,this.removeIPCheck=!1,this.forbidUACheck=!1,this.forbidIPCheck=!1,void(this.removeDlg=!0);this.$confirm("确认删除 "+(e.Name||e.ID)+" ?","提示",{lockScroll:!1}).then(function(){t.post("/api/v1/device/remove",{serial:e.ID}).always(function(){n.getDeviceList()})}).catch(function(){})},editDevice:function(t){this.$refs.deviceEditDlg.show({serial:t.ID,name:t.Name,custom_name:t.CustomName,media_transport:t.MediaTransport,media_transport_mode:t.MediaTransportMode,stream_mode:t.StreamMode,recv_stream_ip:t.RecvStreamIP,contact_ip:t.ContactIP,sms_id:t.SMSID,sms_group_id:t.SMSGroupID,charset:t.Charset,catalog_interval:t.CatalogInterval,subscribe_interval:t.SubscribeInterval,catalog_subscribe:t.CatalogSubscribe,alarm_subscribe:t.AlarmSubscribe,position_subscribe:t.PositionSubscribe,ptz_subscribe:t.PTZSubscribe,password:t.Password,record_center:t.RecordCenter,record_indistinct:t.RecordIndistinct,civil_code_first:t.CivilCodeFirst,keep_original_tree:t.KeepOriginalTree,drop_channel_type:t.DropChannelType,longitude:t.Longitude,latitude:t.Latitude})},formatName:function(t,e,n){return n||"-"},formatRemoteIP:function(t,e,n){if(!t.RemoteIP)return"-";var o=t.RemoteIP;return t.RemotePort&&(o=t.RemoteIP+":"+t.RemotePort),t.CommandTransport&&(o=String(t.CommandTransport).toLowerCase()+"://"+o),o},formatTransport:function(t,e,n){var o=String(t.MediaTransport).toUpperCase();return"TCP"==o&&(o+="active"==t.MediaTransportMode?" 主动":" 被动"),o},formatCatalogTitle:function(t){return t.CatalogProgress?"更新中("+t.CatalogProgress+")":this.catalogMap[t.ID]?"更新中...":""},formatCatalogPercent:function(t){if(!t||!t.CatalogProgress)return 0;var e=t.CatalogProgress.split("/");if(2!=e.length)return 0;var n=parseInt(e[1],10);if(!n)return 0;var o=parseInt(e[0],10)||0,r=parseInt(100*o/n,10);return isNaN(r)||r<0?0:r>100?100:r},setMediaTransport:function(e){var n=this,o=e.MediaTransport||e.row.MediaTransport,r=e.MediaTransportMode||e.row.MediaTransportMode;t.post("/api/v1/device/setmediatransport",{serial:e.row.ID,media_transport:o,media_transport_mode:r}).then(function(){n.$set(e.row,"MediaTransport",o),n.$set(e.row,"MediaTransportMode",r)})},showDeviceLog:function(t){this.$refs.deviceLogDlg.show("设备("+t.ID+")",t.ID)},showStatusLog:function(t){this.$refs.deviceLogDlg.showStatusLog("设备("+t.ID+")",t.ID)},showStreamLog:function(t){this.$refs.deviceLogDlg.showStreamLog("设备("+t.ID+")",t.ID)},keyDown:function(t){if(t.altKey&&t.shiftKey)switch(t.key){case"D":t.preventDefault(),this.toggleDebug()}},toggleDebug:function(){this.debug=!this.debug}},beforeRouteEnter:function(t,e,n){n(function(e){e.q=t.query.q||"",e.online=t.query.online||"",e.checkCatalogProgress="yes"===(t.query.checkCatalogProgress||"yes"),e.currentPage=parseInt(t.params.page)||1,e.ready()})},beforeRouteLeave:function(t,e,n){this.timer&&(clearInterval(this.timer),this.timer=0),n()},beforeRouteUpdate:function(t,e,n){var o=this;n(),this.$nextTick(function(){o.q=t.query.q||"",o.online=t.query.online||"",o.checkCatalogProgress="yes"===(t.query.checkCatalogProgress||"yes"),o.currentPage=parseInt(t.params.page)||1,o.devices=[],o.total=0,o.getDeviceList()})}}}).call(e,n("0iPh"))},"9N7t":function(t,e,n){"use strict";var o=function(){var t=this,e=t.$createElement,n=t._self._c||e;return n("div",{},[n("div",{staticClass:"box box-primary"},[n("div",{staticClass:"box-header"},[n("h4",{staticClass:"text-primary text-center"},[t._v("设备录像时间轴("+t._s(t.devid)+"-"+t._s(t.channel)+")")])]),t._v(" "),n("div",{staticClass:"box-body"},[n("div",{staticClass:"form-inline",attrs:{autocomplete:"off",spellcheck:"false"}},[n("div",{staticClass:"form-group form-group-sm"},["no"!=t.$getQueryString("menu")?n("button",{staticClass:"btn btn-sm btn-primary",attrs:{type:"button"},on:{click:function(e){e.preventDefault(),t.$router.go(-1)}}},[n("i",{staticClass:"fa fa-chevron-left"}),t._v(" 返回\r\n                    ")]):t._e(),t._v(" "),t.shareURL?n("span",[t._v("  ")]):t._e(),t._v(" "),t.shareURL?n("a",{staticClass:"btn btn-default btn-sm",attrs:{role:"button",href:t.shareURL,target:"_blank"}},[n("i",{staticClass:"fa fa-share"}),t._v(" 分享页\r\n                    ")]):t._e(),t._v(" "),n("span",[t._v("  ")]),t._v(" "),n("div",{staticClass:"btn-group btn-group-sm",attrs:{role:"group"}},[n("button",{staticClass:"btn btn-default dropdown-toggle",attrs:{id:"input-speed-dropdown",type:"button","data-toggle":"dropdown"}},[t._v("\r\n                            "+t._s(t.speed)+" 倍速 "),n("span",{staticClass:"caret"})]),t._v(" "),n("ul",{staticClass:"dropdown-menu",attrs:{id:"input-speed-menu"}},[n("li",{class:{active:.5==t.speed}},[n("a",{attrs:{role:"button","data-speed":"0.5"}},[t._v("0.5x")])]),t._v(" "),n("li",{class:{active:1==t.speed}},[n("a",{attrs:{role:"button","data-speed":"1"}},[t._v("1x")])]),t._v(" "),n("li",{class:{active:2==t.speed}},[n("a",{attrs:{role:"button","data-speed":"2"}},[t._v("2x")])]),t._v(" "),n("li",{class:{active:4==t.speed}},[n("a",{attrs:{role:"button","data-speed":"4"}},[t._v("4x")])])])]),t._v(" "),!t.isDemoUser(t.serverInfo,t.userInfo)&&t.videos.length>0?n("span",[t._v("  ")]):t._e(),t._v(" "),!t.isDemoUser(t.serverInfo,t.userInfo)&&t.videos.length>0?n("button",{directives:[{name:"loading",rawName:"v-loading.fullscreen.lock",value:t.bDownloading,expression:"bDownloading",modifiers:{fullscreen:!0,lock:!0}}],staticClass:"btn btn-default btn-sm",attrs:{type:"button","element-loading-text":"加载中..."},on:{click:function(e){e.preventDefault(),t.showTimeRangeDlg(e)}}},[n("i",{staticClass:"fa fa-download"}),t._v(" 下载\r\n                    ")]):t._e()]),t._v(" "),n("div",{staticClass:"form-group pull-right"},[n("div",{staticClass:"input-group input-group-sm"},[n("DatePicker",{ref:"datePicker",staticClass:"form-control",attrs:{day:t.day},on:{"update:day":t.updateDay}}),t._v(" "),n("div",{staticClass:"input-group-btn"},[n("button",{staticClass:"btn btn-sm btn-default",attrs:{type:"button"},on:{click:function(e){e.preventDefault(),t.showDatePicker(e)}}},[n("i",{staticClass:"fa fa-calendar"})]),t._v(" "),n("button",{staticClass:"btn btn-default btn-sm",on:{click:function(e){e.preventDefault(),t.toListView(e)}}},[n("i",{staticClass:"fa fa-hand-o-right"}),t._v(" 列表视图\r\n                            ")])])],1)])]),t._v(" "),n("br"),t._v(" "),n("div",{staticClass:"clearfix"}),t._v(" "),n("LivePlayer",{directives:[{name:"loading",rawName:"v-loading",value:t.videoLoading,expression:"videoLoading"}],attrs:{videoUrl:t.videoUrl,muted:!t.bAudioEnable,waterMark:t.osd,live:"",hideLiveText:"",digitalZoom:"",currentTime:t.currentTime,hideBigPlayButton:!!t.serverInfo.HideBigPlayButton,"element-loading-text":"加载中...","element-loading-background":"#000"},on:{media_info:t.onMediaInfo,ended:t.onEnded,error:t.onVideoError,timeupdate:t.onVideoTimeUpdate}}),t._v(" "),t.isDemoUser(t.serverInfo,t.userInfo)&&!t.bOutHevcTip?n("div",{staticClass:"text-center"},[n("br"),t._v("\r\n                提示: 演示系统限制匿名登录播放时间, 若需测试长时间播放, 请"),n("a",{attrs:{target:"_blank",href:"//www.liveqing.com/docs/download/LiveGBS.html"}},[t._v("下载使用")])]):t._e(),t._v(" "),t.bOutHevcTip?n("div",{staticClass:"text-center text-red"},[n("br"),t._v("\r\n                提示: 正在播放 H265 直出流, 确保浏览器版本较新, 并且开启硬件加速\r\n            ")]):t._e(),t._v(" "),n("br"),t._v(" "),n("br"),t._v(" "),n("TimeRule",{directives:[{name:"loading",rawName:"v-loading",value:t.loading,expression:"loading"}],ref:"timeRule",attrs:{videos:t.videos,minutesPerUnit:t.serverInfo.MinutesPerUnit||5},on:{timeChange:t.onTimeChange}}),t._v(" "),n("div",{staticClass:"clearfix"}),t._v(" "),n("br")],1)]),t._v(" "),n("TimeRangeDlg",{ref:"timeRangeDlg",attrs:{format:"HHmmss"},on:{submit:t.onTimeRangeChange}}),t._v(" "),n("PlaybackDownloadDlg",{ref:"playbackDownloadDlg",on:{download:t.onDownload}})],1)},r=[],i={render:o,staticRenderFns:r};e.a=i},AU86:function(t,e,n){"use strict";function o(t){return t&&t.__esModule?t:{default:t}}function r(t){return function(){var e=t.apply(this,arguments);return new Promise(function(t,n){function o(r,i){try{var a=e[r](i),s=a.value}catch(t){return void n(t)}if(!a.done)return Promise.resolve(s).then(function(t){o("next",t)},function(t){o("throw",t)});t(s)}return o("next")})}}Object.defineProperty(e,"__esModule",{value:!0});var i=n("A9K1"),a=o(i),s=n("0iPh"),l=o(s);e.default={data:function(){return{debug:!1,devid:"",start:0,limit:1e4,downloadThisDev:!1}},components:{FormDlg:a.default},beforeDestroy:function(){(0,l.default)(this.$el).off("keydown",this.keyDown)},computed:{showPage:function(){return this.debug||this.start||1e4!=this.limit},downloadURL:function(){return this.downloadThisDev&&this.devid?"/api/v1/channel/export?serial="+this.devid+"&start="+this.start+"&limit="+this.limit:"/api/v1/channel/export?start="+this.start+"&limit="+this.limit}},methods:{onHide:function(){this.debug=!1,this.devid="",this.downloadThisDev=!1,this.start=0,this.limit=1e4,this.$emit("hide"),(0,l.default)(this.$el).off("keydown",this.keyDown)},onShow:function(){this.errors.clear(),this.$emit("show"),(0,l.default)(this.$el).on("keydown",this.keyDown)},keyDown:function(t){if(t.altKey&&t.shiftKey)switch(t.key){case"D":t.preventDefault(),this.toggleDebug()}},toggleDebug:function(){this.debug=!this.debug},onSubmit:function(){var t=this;return r(regeneratorRuntime.mark(function e(){var n,o;return regeneratorRuntime.wrap(function(e){for(;;)switch(e.prev=e.next){case 0:return e.next=2,t.$validator.validateAll();case 2:if(n=e.sent){e.next=8;break}return o=t.errors.items[0],t.$message({type:"error",message:o.msg}),(0,l.default)("[name="+o.field+"]").focus(),e.abrupt("return");case 8:window.open(t.downloadURL,"_blank"),t.$refs.dlg.hide(),t.$emit("submit");case 11:case"end":return e.stop()}},e,t)}))()},show:function(t){var e=this;this.errors.clear(),this.devid=t,this.$nextTick(function(){e.$refs.dlg.show()})}}}},BgxG:function(t,e,n){"use strict";Object.defineProperty(e,"__esModule",{value:!0}),e.default={name:"ElTimelineItem",inject:["timeline"],props:{timestamp:String,hideTimestamp:{type:Boolean,default:!1},placement:{type:String,default:"bottom"},type:String,color:String,size:{type:String,default:"normal"},icon:String}}},DJXc:function(t,e,n){e=t.exports=n("FZ+f")(!1),e.push([t.i,".modal-title[data-v-7fc8ec04]{overflow:hidden;white-space:nowrap;text-overflow:ellipsis}#speed-switcher-download[data-v-7fc8ec04]{margin-right:10px}#speed-switcher-download label[data-v-7fc8ec04]{margin-bottom:0}",""])},DKbk:function(t,e,n){var o=n("DJXc");"string"==typeof o&&(o=[[t.i,o,""]]),o.locals&&(t.exports=o.locals);n("rjj0")("1da98724",o,!0,{})},Dwyu:function(t,e,n){"use strict";function o(t){return t&&t.__esModule?t:{default:t}}function r(t){return function(){var e=t.apply(this,arguments);return new Promise(function(t,n){function o(r,i){try{var a=e[r](i),s=a.value}catch(t){return void n(t)}if(!a.done)return Promise.resolve(s).then(function(t){o("next",t)},function(t){o("throw",t)});t(s)}return o("next")})}}Object.defineProperty(e,"__esModule",{value:!0});var i=n("A9K1"),a=o(i),s=n("0iPh"),l=o(s);e.default={props:{serverInfo:{type:Object,default:function(){}},userInfo:{type:Object,default:function(){return null}}},data:function(){return{form:this.defForm()}},components:{FormDlg:a.default},methods:{defForm:function(){return{serial:"",code:"",name:"",custom:!1,customName:"",customID:"",description:""}},onHide:function(){this.form=this.defForm(),this.$emit("hide")},onShow:function(){this.errors.clear(),this.$emit("show"),this.$el.querySelector("#input-name").focus()},onSubmit:function(){var t=this;return r(regeneratorRuntime.mark(function e(){var n,o;return regeneratorRuntime.wrap(function(e){for(;;)switch(e.prev=e.next){case 0:return e.next=2,t.$validator.validateAll();case 2:if(n=e.sent){e.next=8;break}return o=t.errors.items[0],t.$message({type:"error",message:o.msg}),(0,l.default)("[name="+o.field+"]").focus(),e.abrupt("return");case 8:l.default.post("/api/v1/device/setchannelname",{serial:t.form.serial,code:t.form.code,name:t.form.customName,custom_id:t.form.customID,description:t.form.description}).then(function(e){t.$refs.dlg.hide(),t.$emit("submit")});case 9:case"end":return e.stop()}},e,t)}))()},show:function(t){var e=this;this.errors.clear(),t&&(this.form.serial=t.serial,this.form.code=t.code,this.form.name=t.name,this.form.custom=!!t.custom,this.form.customName=t.customName,this.form.customID=t.customID,this.form.description=t.description,t.custom&&(this.form.customName=t.name,this.form.name="")),this.$nextTick(function(){e.$refs.dlg.show()})}}}},E3p4:function(t,e,n){"use strict";var o=function(){var t=this,e=t.$createElement,n=t._self._c||e;return n("div",{class:["modal",{fade:t.fade}],attrs:{"data-backdrop":"static","data-disable":"false","data-keyboard":"true",tabindex:"-1"}},[n("div",{class:["modal-dialog",t.size]},[n("div",{staticClass:"modal-content"},[n("div",{staticClass:"modal-header"},[t._m(0),t._v(" "),n("h4",{staticClass:"modal-title text-center text-primary"},[n("span",[t._v(t._s(t.title))])])]),t._v(" "),n("div",{staticClass:"modal-body"},[n("el-tabs",{on:{"tab-click":t.tabClick},model:{value:t.tabName,callback:function(e){t.tabName=e},expression:"tabName"}},[n("el-tab-pane",{directives:[{name:"loading",rawName:"v-loading",value:t.bStatusLogLoading,expression:"bStatusLogLoading"}],attrs:{name:"status","element-loading-text":"加载中..."}},[n("span",{attrs:{slot:"label"},slot:"label"},[n("i",{staticClass:"fa fa-list-ul"}),t._v(" 状态记录")]),t._v(" "),t.statusLogs.length>0?n("el-timeline",{staticStyle:{"margin-top":"10px","margin-bottom":"10px","min-height":"200px","max-height":"450px","overflow-y":"auto"}},t._l(t.statusLogs,function(e,o){return n("el-timeline-item",{key:o,attrs:{type:t.statusTimeLineType(e),timestamp:e.CreatedAt}},[t._v("\n                                "+t._s(e.Description)+"\n                            ")])})):n("p",{staticClass:"text-center"},[n("br"),t._v(" "),t.bStatusLogLoading?n("span",[t._v("暂无数据")]):t.statusDays&&t.statusDays>0?n("span",[t._v("最近"+t._s(t.statusDays)+"天没有状态变更记录")]):n("span",[t._v("未找到最近的状态变更记录")])])],1),t._v(" "),n("el-tab-pane",{directives:[{name:"loading",rawName:"v-loading",value:t.bStreamLogLoading,expression:"bStreamLogLoading"}],attrs:{name:"stream","element-loading-text":"加载中..."}},[n("span",{attrs:{slot:"label"},slot:"label"},[n("i",{staticClass:"fa fa-bar-chart"}),t._v(" 流量统计")]),t._v(" "),n("br"),t._v(" "),n("el-radio-group",{attrs:{size:"mini"},on:{change:t.changeStreamLogType},model:{value:t.streamLogType,callback:function(e){t.streamLogType=e},expression:"streamLogType"}},[n("el-radio-button",{attrs:{label:"today"}},[t._v("今天")]),t._v(" "),n("el-radio-button",{attrs:{label:"yesterday"}},[t._v("昨天")]),t._v(" "),n("el-radio-button",{attrs:{label:"week"}},[t._v("最近7天")]),t._v(" "),n("el-radio-button",{attrs:{label:"month"}},[t._v("最近30天")])],1),t._v(" "),n("br"),t._v(" "),n("br"),t._v(" "),n("br"),t._v(" "),n("ve-line",{ref:"streamChart",attrs:{height:"300px",colors:t.chartColors,data:t.streamLogs,extend:t.streamLogExtend,settings:t.streamLogSettings,"legend-position":"bottom","legend-visible":!0,title:{text:"流量使用",left:"center"}}})],1),t._v(" "),n("el-tab-pane",{directives:[{name:"loading",rawName:"v-loading",value:t.bPositionLogLoading,expression:"bPositionLogLoading"}],attrs:{name:"position","element-loading-text":"加载中..."}},[n("span",{attrs:{slot:"label"},slot:"label"},[n("i",{staticClass:"fa fa-location-arrow"}),t._v(" 移动轨迹")]),t._v(" "),t.positionLogs.length>0?n("el-timeline",{staticStyle:{"margin-top":"10px","margin-bottom":"10px","min-height":"200px","max-height":"450px","overflow-y":"auto"}},t._l(t.positionLogs,function(e,o){return n("el-timeline-item",{key:o,attrs:{type:t.positionTimeLineType(e),timestamp:e.CreatedAt}},[t._v("\n                                "+t._s(e.Method)+" "+t._s(e.Longitude)+", "+t._s(e.Latitude)+"\n                            ")])})):n("p",{staticClass:"text-center"},[n("br"),t._v(" "),t.bPositionLogLoading?n("span",[t._v("暂无数据")]):t.positionDays&&t.positionDays>0?n("span",[t._v("最近"+t._s(t.positionDays)+"天没有位置变更记录")]):n("span",[t._v("未找到最近的位置变更记录")])])],1),t._v(" "),t.serial&&!t.code?n("el-tab-pane",{attrs:{name:"session"}},[n("span",{attrs:{slot:"label"},slot:"label"},[n("i",{staticClass:"fa fa-align-left"}),t._v(" 会话列表")]),t._v(" "),n("div",{staticClass:"form-inline"},[n("div",{staticClass:"form-group form-group-sm"},[n("label",[t._v("搜索")]),t._v(" "),n("input",{directives:[{name:"model",rawName:"v-model.trim",value:t.sessionQ,expression:"sessionQ",modifiers:{trim:!0}}],ref:"sessionQ",staticClass:"form-control",attrs:{type:"text",placeholder:"关键字"},domProps:{value:t.sessionQ},on:{keydown:function(e){if(!("button"in e)&&t._k(e.keyCode,"enter",13,e.key))return null;e.preventDefault()},input:function(e){e.target.composing||(t.sessionQ=e.target.value.trim())},blur:function(e){t.$forceUpdate()}}})]),t._v(" "),n("span",{staticClass:"hidden-xs"},[t._v("  ")]),t._v(" "),n("div",{staticClass:"form-group form-group-sm"},[n("label",[t._v("筛选")]),t._v(" "),n("select",{directives:[{name:"model",rawName:"v-model.trim",value:t.sessionFilter,expression:"sessionFilter",modifiers:{trim:!0}}],staticClass:"form-control",on:{change:function(e){var n=Array.prototype.filter.call(e.target.options,function(t){return t.selected}).map(function(t){return"_value"in t?t._value:t.value});t.sessionFilter=e.target.multiple?n:n[0]}}},[n("option",{attrs:{value:""}},[t._v("全部")]),t._v(" "),n("option",{attrs:{value:"stream"}},[t._v("直播")]),t._v(" "),n("option",{attrs:{value:"playback"}},[t._v("回放")]),t._v(" "),n("option",{attrs:{value:"broadcast"}},[t._v("对讲")]),t._v(" "),n("option",{attrs:{value:"playing"}},[t._v("播放")]),t._v(" "),n("option",{attrs:{value:"record"}},[t._v("录像")]),t._v(" "),n("option",{attrs:{value:"cascade"}},[t._v("级联")]),t._v(" "),n("option",{attrs:{value:"udp"}},[t._v("UDP")]),t._v(" "),n("option",{attrs:{value:"tcp"}},[t._v("TCP")]),t._v(" "),n("option",{attrs:{value:"h264"}},[t._v("H264")]),t._v(" "),n("option",{attrs:{value:"hevc"}},[t._v("H265")])])]),t._v(" "),t.sessionNumOutputs&&"playing"==t.sessionFilter?n("span",{staticClass:"hidden-xs"},[t._v("  ")]):t._e(),t._v(" "),t.sessionNumOutputs&&"playing"==t.sessionFilter?n("span",[t._v("在线人数(合计): "+t._s(t.sessionNumOutputs))]):t._e(),t._v(" "),n("div",{staticClass:"form-group form-group-sm pull-right"},[n("div",{staticClass:"btn-group btn-group-sm"},[t.sessions.length&&t.hasAnyRole(t.serverInfo,t.userInfo,"超级管理员")?n("button",{staticClass:"btn btn-danger",attrs:{type:"button"},on:{click:function(e){e.preventDefault(),t.clearSessions(e)}}},[n("i",{staticClass:"fa fa-remove"}),t._v(" 清空")]):t._e(),t._v(" "),n("button",{staticClass:"btn btn-primary",attrs:{type:"button"},on:{click:function(e){e.preventDefault(),t.getSessions(e)}}},[n("i",{staticClass:"fa fa-refresh"}),t._v(" 刷新")])])])]),t._v(" "),n("br"),t._v(" "),"session"==t.tabName?n("el-table",{directives:[{name:"loading",rawName:"v-loading",value:t.sessionLoading,expression:"sessionLoading"}],attrs:{data:t.sessions,stripe:"","default-sort":{prop:"StartAt",order:"descending"},"max-height":400,"element-loading-text":"加载中..."},on:{"sort-change":t.sessionSortChange}},[n("el-table-column",{attrs:{prop:"ChannelID",label:"通道国标编号","min-width":"200","show-overflow-tooltip":"",sortable:"custom"}}),t._v(" "),n("el-table-column",{attrs:{prop:"ChannelName",label:"通道名称","min-width":"120",formatter:t.formatSessionChannelName,"show-overflow-tooltip":""}}),t._v(" "),n("el-table-column",{attrs:{prop:"SessionType",label:"会话类型","min-width":"100"},scopedSlots:t._u([{key:"default",fn:function(e){return[n("span",{attrs:{title:t.formatSessionTypeTitle(e.row)}},[t._v(t._s(t.formatSessionType(e.row)))])]}}])}),t._v(" "),n("el-table-column",{attrs:{prop:"StartAt",label:"开始时间","min-width":"160",sortable:"custom"}}),t._v(" "),n("el-table-column",{attrs:{prop:"Duration",label:"持续时长","min-width":"120",formatter:t.formatSessionDuration,align:"right",sortable:"custom"}}),t._v(" "),n("el-table-column",{attrs:{prop:"InBytes",label:"收流大小","min-width":"120",formatter:t.formatInBytes,align:"right","show-overflow-tooltip":"",sortable:"custom"}}),t._v(" "),n("el-table-column",{attrs:{prop:"InBitRate",label:"收流码率","min-width":"120",formatter:t.formatInBitRate,align:"right","show-overflow-tooltip":"",sortable:"custom"}}),t._v(" "),n("el-table-column",{attrs:{prop:"Transport",label:"流传输","min-width":"100","show-overflow-tooltip":""}}),t._v(" "),n("el-table-column",{attrs:{prop:"SourceVideoCodecName",label:"视频编码","min-width":"100",formatter:t.formatVideoCodec}}),t._v(" "),n("el-table-column",{attrs:{prop:"CloudRecord",label:"云端录像","min-width":"100",formatter:t.formatCloudRecord,align:"center"}}),t._v(" "),n("el-table-column",{attrs:{prop:"NumOutputs",label:"在线人数","min-width":"120",sortable:"custom",fixed:"playing"==t.sessionFilter&&"right"}}),t._v(" "),n("el-table-column",{attrs:{prop:"CascadeSize",label:"级联数","min-width":"100",sortable:"custom"}}),t._v(" "),n("el-table-column",{attrs:{prop:"SMSID",label:"SMS","min-width":"200","show-overflow-tooltip":"",sortable:"custom"}}),t._v(" "),t.hasAnyRole(t.serverInfo,t.userInfo,"超级管理员")?n("el-table-column",{attrs:{label:"操作","min-width":"100","class-name":"opt-group"},scopedSlots:t._u([{key:"default",fn:function(e){return[n("div",{staticClass:"btn-group btn-group-xs"},[n("button",{staticClass:"btn btn-danger",attrs:{type:"button"},on:{click:function(n){n.preventDefault(),t.stopSession(e.row)}}},[n("i",{staticClass:"fa fa-stop"}),t._v(" 停止\n                                        ")])])]}}])}):t._e()],1):t._e(),t._v(" "),t.sessionTotal>0?n("el-pagination",{staticClass:"pull-right",attrs:{layout:"total,prev,pager,next,sizes","pager-count":t.isMobile()?3:5,total:t.sessionTotal,"page-size":t.sessionPageSize,"current-page":t.sessionCurrentPage},on:{"update:pageSize":function(e){t.sessionPageSize=e},"update:currentPage":function(e){t.sessionCurrentPage=e}}}):t._e(),t._v(" "),n("div",{staticClass:"clearfix"})],1):t._e()],1)],1),t._v(" "),"position"==t.tabName&&t.serial&&t.code&&t.positionLogs.length>0&&t.serverInfo.MapInfo&&"标准版"!=t.serverInfo.VersionType?n("div",{staticClass:"modal-footer"},[n("a",{staticClass:"btn btn-primary",attrs:{role:"button",href:"/map.html?serial="+t.serial+"&code="+t.code+"&trace=yes",target:"_blank"}},[n("i",{staticClass:"fa fa-paper-plane"}),t._v(" 地图展示\n                ")])]):t._e()])])])},r=[function(){var t=this,e=t.$createElement,n=t._self._c||e;return n("button",{staticClass:"close",attrs:{type:"button","data-dismiss":"modal","aria-label":"Close"}},[n("span",{attrs:{"aria-hidden":"true"}},[t._v("×")])])}],i={render:o,staticRenderFns:r};e.a=i},"ENv+":function(t,e,n){"use strict";function o(t){n("DKbk")}Object.defineProperty(e,"__esModule",{value:!0});var r=n("lgdx"),i=n.n(r);for(var a in r)["default","default"].indexOf(a)<0&&function(t){n.d(e,t,function(){return r[t]})}(a);var s=n("KsAq"),l=n("VU/8"),u=o,c=l(i.a,s.a,!1,u,"data-v-7fc8ec04",null);e.default=c.exports},G5yr:function(t,e,n){e=t.exports=n("FZ+f")(!1),e.push([t.i,".datepicker{z-index:9999!important}",""])},GB08:function(t,e,n){"use strict";Object.defineProperty(e,"__esModule",{value:!0}),n("F8S1");var o=n("0iPh"),r=function(t){return t&&t.__esModule?t:{default:t}}(o);e.default={props:{size:{type:String,default:""},title:{type:String,default:"选择时间段"},arrow:{type:Boolean,default:!1},format:{type:String,default:"HH:mm:ss"}},data:function(){return{visiable:!1,value:"",vdefault:void 0}},mounted:function(){var t=this;(0,r.default)(this.$el).find(".modal-content").draggable({handle:".modal-header",cancel:".modal-title span",addClasses:!1,containment:"document",delay:100,opacity:.5}),(0,r.default)(this.$el).on("shown.bs.modal",function(){t.visiable=!0,t.$emit("show")}).on("hidden.bs.modal",function(){t.visiable=!1,t.value="",t.$emit("hide")})},methods:{show:function(){(0,r.default)(this.$el).modal("show")},hide:function(){(0,r.default)(this.$el).modal("hide")},setValue:function(t){this.value=t},setDefault:function(t){this.vdefault=t},doSubmit:function(){this.$emit("submit",this.value)}}}},IEpb:function(t,e,n){var o=n("G5yr");"string"==typeof o&&(o=[[t.i,o,""]]),o.locals&&(t.exports=o.locals);n("rjj0")("47dd6f6e",o,!0,{})},"IaS+":function(t,e,n){"use strict";Object.defineProperty(e,"__esModule",{value:!0});var o=n("Dwyu"),r=n.n(o);for(var i in o)["default","default"].indexOf(i)<0&&function(t){n.d(e,t,function(){return o[t]})}(i);var a=n("tHoF"),s=n("VU/8"),l=s(r.a,a.a,!1,null,null,null);e.default=l.exports},IrJl:function(t,e,n){"use strict";var o=function(){var t=this,e=t.$createElement,n=t._self._c||e;return n("div",{staticClass:"time-rule"},[n("div",{ref:"day",staticClass:"time-day",style:{left:t.timeDayX+"px"}},[t._l(1440/t.minutesPerUnit,function(e){return n("div",{key:e,class:["time-minute",t.minuteActiveClass((e-1)*t.minutesPerUnit)],style:{width:t.minutesPerUnit+"px"},attrs:{title:t.minuteTitle((e-1)*t.minutesPerUnit)},on:{click:function(n){n.preventDefault(),t.clickMinute((e-1)*t.minutesPerUnit)}}})}),t._v(" "),t._l(24,function(e){return n("div",{key:e,class:[1==e?"time-text-first":"time-text"]},[t._v(t._s(t.hourText(e-1)))])})],2),t._v(" "),n("div",{ref:"cursor",staticClass:"time-cursor",style:{left:t.timeCursorX+"px"}},[n("div",{staticClass:"time-cursor-text"},[t._v(t._s(t.timeCursorText))])])])},r=[],i={render:o,staticRenderFns:r};e.a=i},JCFA:function(t,e,n){"use strict";var o=function(){var t=this,e=t.$createElement,n=t._self._c||e;return n("div",[n("el-table",{directives:[{name:"loading",rawName:"v-loading",value:t.loading,expression:"loading"}],ref:"presetsTable",attrs:{size:"mini",data:t.presets,stripe:"","max-height":235,"element-loading-text":"获取中..."}},[n("el-table-column",{attrs:{prop:"PresetID",label:"序号","min-width":"40","show-overflow-tooltip":""}}),t._v(" "),n("el-table-column",{attrs:{prop:"PresetName",label:"备注","min-width":"80","show-overflow-tooltip":""},scopedSlots:t._u([{key:"default",fn:function(e){return[e.row.Editing?n("input",{directives:[{name:"focus",rawName:"v-focus"}],staticStyle:{width:"80px",padding:"2px","line-height":"100%"},attrs:{type:"text"},domProps:{value:e.row.PresetName},on:{keydown:[function(n){if(!("button"in n)&&t._k(n.keyCode,"esc",27,n.key))return null;n.stopPropagation(),n.preventDefault(),t.setCustomName(e.row,!1,n)},function(n){if(!("button"in n)&&t._k(n.keyCode,"enter",13,n.key))return null;n.stopPropagation(),n.preventDefault(),t.setCustomName(e.row,!1,n)}],blur:function(n){t.setCustomName(e.row,!1,n)}}}):n("a",{attrs:{role:"button"},on:{click:function(n){n.preventDefault(),t.setCustomName(e.row,!0,n)}}},[t._v(t._s(e.row.PresetName||"-"))])]}}])}),t._v(" "),n("el-table-column",{attrs:{fixed:"right",label:"操作",width:"110"},scopedSlots:t._u([{key:"default",fn:function(e){return[e.row.PresetEnable&&!e.row.Going?n("span",{staticStyle:{cursor:"pointer",color:"#00acd6"},attrs:{title:"调用预置位"},on:{click:function(n){n.preventDefault(),t.gotoPreet(e.row)}}},[t._v("\r\n                    调用\r\n                ")]):e.row.Going?n("span",[n("i",{staticClass:"fa fa-spinner fa-spin"})]):t._e(),t._v(" "),e.row.Setting?n("span",[n("i",{staticClass:"fa fa-spinner fa-spin"})]):n("span",{staticStyle:{cursor:"pointer",color:"#00acd6"},attrs:{title:"设置预置位"},on:{click:function(n){n.preventDefault(),t.setPreset(e.row)}}},[t._v("\r\n                    设置\r\n                ")]),t._v(" "),e.row.PresetEnable?n("span",{style:e.row.Confirm?"cursor:pointer;color:#e08e0b;":"cursor:pointer;color:#dd4b39;",attrs:{title:"删除预置位"},on:{click:function(n){n.preventDefault(),t.removePreset(e.row)}}},[t._v("\r\n                    "+t._s(e.row.Confirm?"确认?":"删除")+"\r\n                ")]):t._e()]}}])})],1)],1)},r=[],i={render:o,staticRenderFns:r};e.a=i},JK3F:function(t,e,n){!function(e,n){t.exports=n()}(0,function(){return function(t){function e(o){if(n[o])return n[o].exports;var r=n[o]={i:o,l:!1,exports:{}};return t[o].call(r.exports,r,r.exports,e),r.l=!0,r.exports}var n={};return e.m=t,e.c=n,e.i=function(t){return t},e.d=function(t,n,o){e.o(t,n)||Object.defineProperty(t,n,{configurable:!1,enumerable:!0,get:o})},e.n=function(t){var n=t&&t.__esModule?function(){return t.default}:function(){return t};return e.d(n,"a",n),n},e.o=function(t,e){return Object.prototype.hasOwnProperty.call(t,e)},e.p="",e(e.s=4)}([function(t,e,n){var o=n(2)(n(1),n(3),null,null,null);o.options.__file="C:\\Users\\Administrator\\Documents\\vue-context-menu\\src\\VueContextMenu.vue",o.esModule&&Object.keys(o.esModule).some(function(t){return"default"!==t&&"__"!==t.substr(0,2)})&&console.error("named exports are not supported in *.vue files."),o.options.functional&&console.error("[vue-loader] VueContextMenu.vue: functional components are not supported with templates, they should use render functions."),t.exports=o.exports},function(t,e,n){"use strict";Object.defineProperty(e,"__esModule",{value:!0}),e.default={name:"context-menu",data:function(){return{triggerShowFn:function(){},triggerHideFn:function(){},x:null,y:null,style:{},binded:!1}},props:{target:null,show:Boolean},mounted:function(){this.bindEvents()},watch:{show:function(t){t?this.bindHideEvents():this.unbindHideEvents()},target:function(t){this.bindEvents()}},methods:{bindEvents:function(){var t=this;this.$nextTick(function(){t.target&&!t.binded&&(t.triggerShowFn=t.contextMenuHandler.bind(t),t.target.addEventListener("contextmenu",t.triggerShowFn),t.binded=!0)})},unbindEvents:function(){this.target&&this.target.removeEventListener("contextmenu",this.triggerShowFn)},bindHideEvents:function(){this.triggerHideFn=this.clickDocumentHandler.bind(this),document.addEventListener("mousedown",this.triggerHideFn),document.addEventListener("mousewheel",this.triggerHideFn)},unbindHideEvents:function(){document.removeEventListener("mousedown",this.triggerHideFn),document.removeEventListener("mousewheel",this.triggerHideFn)},clickDocumentHandler:function(t){this.$emit("update:show",!1)},contextMenuHandler:function(t){this.x=t.clientX,this.y=t.clientY,this.$emit("update:show",!0),t.preventDefault(),this.$nextTick(this.layout)},layout:function(){if(this.y+this.$el.offsetHeight>document.documentElement.clientHeight)return void(this.style={left:this.x+"px",bottom:0});this.style={left:this.x+"px",top:this.y+"px"}}}}},function(t,e){t.exports=function(t,e,n,o,r){var i,a=t=t||{},s=typeof t.default;"object"!==s&&"function"!==s||(i=t,a=t.default);var l="function"==typeof a?a.options:a;e&&(l.render=e.render,l.staticRenderFns=e.staticRenderFns),o&&(l._scopeId=o);var u;if(r?(u=function(t){t=t||this.$vnode&&this.$vnode.ssrContext||this.parent&&this.parent.$vnode&&this.parent.$vnode.ssrContext,t||"undefined"==typeof __VUE_SSR_CONTEXT__||(t=__VUE_SSR_CONTEXT__),n&&n.call(this,t),t&&t._registeredComponents&&t._registeredComponents.add(r)},l._ssrRegister=u):n&&(u=n),u){var c=l.functional,d=c?l.render:l.beforeCreate;c?l.render=function(t,e){return u.call(e),d(t,e)}:l.beforeCreate=d?[].concat(d,u):[u]}return{esModule:i,exports:a,options:l}}},function(t,e,n){t.exports={render:function(){var t=this,e=t.$createElement;return(t._self._c||e)("div",{directives:[{name:"show",rawName:"v-show",value:t.show,expression:"show"}],staticStyle:{display:"block"},style:t.style,on:{mousedown:function(t){t.stopPropagation()},contextmenu:function(t){t.preventDefault()}}},[t._t("default")],2)},staticRenderFns:[]},t.exports.render._withStripped=!0},function(t,e,n){const o=n(0),r={};r.install=function(t,e){t.component(o.name,o)},r.component=o,"undefined"!=typeof window&&window.Vue&&window.Vue.use(r),t.exports=r}])})},JRgq:function(t,e,n){"use strict";(function(t){Object.defineProperty(e,"__esModule",{value:!0}),e.default={props:{serial:{type:String,default:""},code:{type:String,default:""},activeName:{type:String,default:""}},data:function(){return{presets:[],loading:!1}},watch:{activeName:function(t,e){"preset-info"==t&&this.getPresets()}},mounted:function(){},directives:{focus:{inserted:function(t){t.focus(),t.select()}}},methods:{getPresets:function(){var e=this;this.loading=!0,t.ajax({method:"GET",url:"/api/v1/device/fetchpreset",global:!1,data:{serial:this.serial,code:this.code,timeout:5}}).then(function(t){e.presets=t.PresetItemList||[]}).fail(function(t){t&&(console.log("fetchpreset["+e.serial+":"+e.code+"] ajax error: "+t.status+" "+t.responseText),401==t.status&&e.$nextTick(function(){location.href="/login?r="+encodeURIComponent(window.location.href)}))}).always(function(){e.$nextTick(function(){e.loading=!1})})},setCustomName:function(e,n,o){var r=this,i=e.Editing;if(this.$set(e,"Editing",n),i&&!n){var a=o.target.value.trim();e.PresetName!=a&&t.post("/api/v1/device/setpresetname",{serial:this.serial,code:this.code,preset:e.PresetID,name:a}).always(function(){r.getPresets()})}},setPreset:function(e){var n=this;this.$set(e,"Setting",!0),t.post("/api/v1/control/preset",{serial:this.serial,code:this.code,command:"set",preset:e.PresetID,name:e.PresetName}).always(function(){n.$delete(e,"Setting"),n.getPresets()})},removePreset:function(e){var n=this;e.Confirm?t.post("/api/v1/control/preset",{serial:this.serial,code:this.code,command:"remove",preset:e.PresetID}).always(function(){n.$delete(e,"Confirm"),n.getPresets()}):(this.$set(e,"Confirm",!0),setTimeout(function(){n.$delete(e,"Confirm")},3e3))},gotoPreet:function(e){var n=this;this.$set(e,"Going",!0),t.post("/api/v1/control/preset",{serial:this.serial,code:this.code,command:"goto",preset:e.PresetID}).always(function(){n.$delete(e,"Going")})}}}}).call(e,n("0iPh"))},JT0P:function(t,e,n){"use strict";var o=function(){var t=this,e=t.$createElement,n=t._self._c||e;return n("div",[n("div",{staticClass:"box box-primary"},[n("div",{staticClass:"box-header"},[n("h4",{staticClass:"text-primary text-center"},[t._v("通道列表("+t._s(t.name||t.devid)),t.dir_serial?n("span",[t._v(":"+t._s(t.dir_serial))]):t._e(),t._v(")")])]),t._v(" "),n("div",{staticClass:"box-body"},[n("div",{staticClass:"form-inline",attrs:{autocomplete:"off",spellcheck:"false"}},["no"!=t.$getQueryString("menu")?n("div",{staticClass:"form-group form-group-sm"},[n("button",{staticClass:"btn btn-sm btn-primary",attrs:{type:"button"},on:{click:function(e){e.preventDefault(),t.$router.go(-1)}}},[n("i",{staticClass:"fa fa-chevron-left"}),t._v(" 返回\r\n                    ")])]):t._e(),t._v(" "),n("span",{staticClass:"hidden-xs"},[t._v("  ")]),t._v(" "),n("div",{staticClass:"form-group form-group-sm"},[n("label",[t._v("搜索")]),t._v(" "),!t.isMobile()&&t.hasAnyRole(t.serverInfo,t.userInfo,"超级管理员")?n("div",{staticClass:"input-group input-group-sm"},[n("input",{directives:[{name:"model",rawName:"v-model.trim",value:t.q,expression:"q",modifiers:{trim:!0}}],ref:"q",staticClass:"form-control",attrs:{type:"text",placeholder:"关键字"},domProps:{value:t.q},on:{keydown:function(e){if(!("button"in e)&&t._k(e.keyCode,"enter",13,e.key))return null;e.preventDefault()},input:function(e){e.target.composing||(t.q=e.target.value.trim())},blur:function(e){t.$forceUpdate()}}}),t._v(" "),n("div",{staticClass:"input-group-btn"},[n("button",{staticClass:"btn btn-sm btn-primary",attrs:{type:"button",title:"导出通道列表"},on:{click:function(e){e.preventDefault(),t.$refs.downloadDlg.show(t.devid)}}},[n("i",{staticClass:"fa fa-download"})]),t._v(" "),n("button",{staticClass:"btn btn-sm btn-primary",attrs:{type:"button",title:"导入通道列表"},on:{click:function(e){e.preventDefault(),t.$refs.uploadDlg.show()}}},[n("i",{staticClass:"fa fa-upload"})])])]):n("input",{directives:[{name:"model",rawName:"v-model.trim",value:t.q,expression:"q",modifiers:{trim:!0}}],ref:"q",staticClass:"form-control",attrs:{type:"text",placeholder:"关键字"},domProps:{value:t.q},on:{keydown:function(e){if(!("button"in e)&&t._k(e.keyCode,"enter",13,e.key))return null;e.preventDefault()},input:function(e){e.target.composing||(t.q=e.target.value.trim())},blur:function(e){t.$forceUpdate()}}})]),t._v(" "),n("span",{staticClass:"hidden-xs"},[t._v("  ")]),t._v(" "),n("div",{staticClass:"form-group form-group-sm"},[n("label",[t._v("通道类型")]),t._v(" "),n("select",{directives:[{name:"model",rawName:"v-model.trim",value:t.channel_type,expression:"channel_type",modifiers:{trim:!0}}],staticClass:"form-control",on:{change:function(e){var n=Array.prototype.filter.call(e.target.options,function(t){return t.selected}).map(function(t){return"_value"in t?t._value:t.value});t.channel_type=e.target.multiple?n:n[0]}}},[n("option",{attrs:{value:""}},[t._v("全部")]),t._v(" "),n("option",{attrs:{value:"device"}},[t._v("设备")]),t._v(" "),n("option",{attrs:{value:"dir"}},[t._v("子目录")])])]),t._v(" "),n("span",{staticClass:"hidden-xs"},[t._v("  ")]),t._v(" "),n("div",{staticClass:"form-group form-group-sm"},[n("label",[t._v("在线状态")]),t._v(" "),n("select",{directives:[{name:"model",rawName:"v-model.trim",value:t.online,expression:"online",modifiers:{trim:!0}}],staticClass:"form-control",on:{change:function(e){var n=Array.prototype.filter.call(e.target.options,function(t){return t.selected}).map(function(t){return"_value"in t?t._value:t.value});t.online=e.target.multiple?n:n[0]}}},[n("option",{attrs:{value:""}},[t._v("全部")]),t._v(" "),n("option",{attrs:{value:"true"}},[t._v("在线")]),t._v(" "),n("option",{attrs:{value:"false"}},[t._v("离线")])])])]),t._v(" "),n("br"),t._v(" "),n("el-table",{directives:[{name:"loading",rawName:"v-loading",value:t.loading,expression:"loading"}],attrs:{data:t.channels,stripe:"","default-sort":{prop:"Channel",order:"ascending"},"element-loading-text":"加载中..."},on:{"sort-change":t.sortChange}},[n("el-table-column",{attrs:{prop:"Channel",label:"通道号","min-width":"100","show-overflow-tooltip":"",sortable:"custom"}}),t._v(" "),n("el-table-column",{attrs:{label:"操作","min-width":"240",fixed:!t.isMobile()&&"right","class-name":"opt-group"},scopedSlots:t._u([{key:"default",fn:function(e){return[n("div",{staticClass:"btn-group btn-group-xs"},[t.canPlay(e.row)?n("button",{staticClass:"btn btn-primary",attrs:{type:"button",disabled:e.row.Locked},on:{click:function(n){n.preventDefault(),t.playStream(e.row)}}},[n("i",{staticClass:"fa fa-play-circle"}),t._v(" 播放\r\n                            ")]):t._e(),t._v(" "),t.canPlay(e.row)&&(e.row.Shared||!1===t.serverInfo.APIAuth||t.serverInfo.GlobalChannelShared||t.isDemoUser(t.serverInfo,t.userInfo))?n("el-dropdown",{staticClass:"btn btn-primary",staticStyle:{"padding-left":"1px"}},[n("i",{staticClass:"el-icon-arrow-down el-icon--right",staticStyle:{color:"white"}}),t._v(" "),n("el-dropdown-menu",{attrs:{slot:"dropdown"},slot:"dropdown"},[n("el-dropdown-item",[n("a",{attrs:{href:"/play.html?serial="+e.row.DeviceID+"&code="+e.row.ID,target:"_blank"}},[n("i",{staticClass:"fa fa-share"}),t._v(" 分享页\r\n                                        ")])])],1)],1):t._e(),t._v(" "),t.canDecode(e.row)?n("button",{staticClass:"btn btn-primary",attrs:{type:"button",disabled:e.row.Locked},on:{click:function(n){n.preventDefault(),t.decodeStart(e.row)}}},[n("i",{staticClass:"fa fa-play-circle"}),t._v(" 解码\r\n                            ")]):t._e(),t._v(" "),e.row.StreamID&&t.hasAnyRole(t.serverInfo,t.userInfo,"管理员")?n("button",{staticClass:"btn btn-danger",attrs:{type:"button"},on:{click:function(n){n.preventDefault(),t.stopStream(e.row)}}},[n("i",{staticClass:"fa fa-stop"}),t._v(" 停止\r\n                            ")]):t._e(),t._v(" "),t.canPlayback(e.row)?n("router-link",{staticClass:"btn btn-info",attrs:{to:"/devices/playback/timebox/"+t.devid+"/"+e.row.ID}},[n("i",{staticClass:"fa fa-file-video-o"}),t._v(" 设备录像\r\n                            ")]):t._e(),t._v(" "),t.canPlayback(e.row)&&t.canCloudRecord(e.row)?n("el-dropdown",{staticClass:"btn btn-info",staticStyle:{"padding-left":"1px"}},[n("i",{staticClass:"el-icon-arrow-down el-icon--right",staticStyle:{color:"white"}}),t._v(" "),n("el-dropdown-menu",{attrs:{slot:"dropdown"},slot:"dropdown"},[n("el-dropdown-item",[n("router-link",{attrs:{to:"/cloudrecord/timeview/"+t.devid+"/"+e.row.ID}},[n("i",{staticClass:"fa fa-file-video-o"}),t._v(" 云端录像\r\n                                        ")])],1)],1)],1):!e.row.DeviceOnline&&t.canCloudRecord(e.row)?n("router-link",{staticClass:"btn btn-info",attrs:{to:"/cloudrecord/timeview/"+t.devid+"/"+e.row.ID}},[n("i",{staticClass:"fa fa-file-video-o"}),t._v(" 云端录像\r\n                            ")]):t._e(),t._v(" "),t.isDir(e.row)?n("router-link",{staticClass:"btn btn-info",attrs:{to:"/devices/channels/"+t.devid+"/1?dir_serial="+e.row.ID,exact:""}},[n("i",{staticClass:"fa fa-info"}),t._v(" 查看子目录\r\n                            ")]):t._e(),t._v(" "),t.canFetchCatalog(e.row)&&t.hasAnyRole(t.serverInfo,t.userInfo,"管理员")?n("button",{staticClass:"btn btn-primary",attrs:{type:"button",disabled:t.catalogMap[t.devid+":"+e.row.ID],title:t.formatCatalogTitle(e.row)},on:{click:function(n){n.preventDefault(),t.fetchCatalog(e.row)}}},[n("i",{class:["fa","fa-refresh",{"fa-spin":t.catalogMap[t.devid+":"+e.row.ID]}]}),t._v(" 更新通道\r\n                            ")]):t._e(),t._v(" "),"virtual"==e.row.Manufacturer&&t.hasAnyRole(t.serverInfo,t.userInfo,"超级管理员")?n("button",{staticClass:"btn btn-danger",attrs:{type:"button"},on:{click:function(n){n.preventDefault(),t.removeVirtual(e.row)}}},[n("i",{staticClass:"fa fa-remove"}),t._v(" 删除\r\n                            ")]):t._e()],1)]}}])}),t._v(" "),n("el-table-column",{attrs:{prop:"ID",label:"通道国标编号","min-width":"200","show-overflow-tooltip":"",sortable:"custom"}}),t._v(" "),n("el-table-column",{attrs:{prop:"Name",label:"通道名称","min-width":"120","show-overflow-tooltip":""},scopedSlots:t._u([{key:"default",fn:function(e){return[t.hasAnyRole(t.serverInfo,t.userInfo,"管理员")?n("a",{class:{"text-orange":!!e.row.CustomName},attrs:{role:"button",title:e.row.CustomName?e.row.Name||"-":""},on:{click:function(n){n.preventDefault(),t.editChannelName(e.row)}}},[t._v("\r\n                            "+t._s(e.row.CustomName||e.row.Name||"-")+"\r\n                        ")]):n("span",[t._v("\r\n                            "+t._s(e.row.CustomName||e.row.Name||"-")+"\r\n                        ")])]}}])}),t._v(" "),n("el-table-column",{attrs:{"min-width":"100",label:"快照"},scopedSlots:t._u([{key:"default",fn:function(e){return[t.isDir(e.row)?n("span",[n("i",{staticClass:"fa fa-sitemap"})]):n("el-popover",{attrs:{"open-delay":1e3,"close-delay":10,placement:"left",title:e.row.ID,width:"400",trigger:"hover"}},[n("img",{staticStyle:{width:"100%",height:"100%"},attrs:{onerror:"this.src='/images/default_snap.png';",src:e.row.SnapURL}}),t._v(" "),n("img",{staticStyle:{height:"30px",width:"50px"},attrs:{slot:"reference",onerror:"this.src='/images/default_snap.png';",src:e.row.SnapURL},slot:"reference"})])]}}])}),t._v(" "),n("el-table-column",{attrs:{prop:"Status",label:"在线状态","min-width":"100"},scopedSlots:t._u([{key:"default",fn:function(e){return[t.isDir(e.row)?n("span",[t._v("-")]):t.hasAnyRole(t.serverInfo,t.userInfo,"管理员")?n("span",[n("a",{class:[{"text-success":"ON"==e.row.Status,"text-orange":!!e.row.CustomStatus,"text-gray":"ON"!=(e.row.CustomStatus||e.row.Status)}],attrs:{role:"button",title:"点击查看通道状态记录"},on:{click:function(n){n.preventDefault(),t.showStatusLog(e.row)}}},[t._v("\r\n                                "+t._s("ON"==(e.row.CustomStatus||e.row.Status)?"在线":"离线")+"\r\n                            ")]),t._v(" "),n("a",{class:{"text-success":"ON"==e.row.Status,"text-orange":!!e.row.CustomStatus,"text-gray":"ON"!=(e.row.CustomStatus||e.row.Status)},attrs:{role:"button",title:"点击查看通道流量统计"},on:{click:function(n){n.preventDefault(),t.showStreamLog(e.row)}}},[n("i",{staticClass:"fa fa-bar-chart"})])]):"ON"==(e.row.CustomStatus||e.row.Status)?n("span",{class:[{"text-success":"ON"==e.row.Status,"text-orange":!!e.row.CustomStatus}]},[t._v("\r\n                            在线\r\n                        ")]):n("span",{staticClass:"text-gray"},[t._v("\r\n                            离线\r\n                        ")])]}}])}),t._v(" "),!t.serverInfo.GlobalChannelAudio&&t.hasAnyRole(t.serverInfo,t.userInfo,"管理员")?n("el-table-column",{attrs:{prop:"AudioEnable",label:"开启音频","min-width":"100"},scopedSlots:t._u([{key:"default",fn:function(e){return[n("el-switch",{attrs:{value:e.row.AudioEnable,disabled:t.isDir(e.row)||"Decode"==e.row.DeviceType},on:{change:function(n){t.toggleAudio(e.row)}}})]}}])}):t._e(),t._v(" "),t.hasAnyRole(t.serverInfo,t.userInfo,"管理员")?n("el-table-column",{attrs:{prop:"Ondemand",label:"按需直播","min-width":"100"},scopedSlots:t._u([{key:"default",fn:function(e){return[n("el-switch",{attrs:{value:e.row.Ondemand,disabled:t.isDir(e.row)||"Decode"==e.row.DeviceType},on:{change:function(n){t.toggleOndemand(e.row)}}})]}}])}):t._e(),t._v(" "),t.hasAnyRole(t.serverInfo,t.userInfo,"管理员")&&"标准版"!=t.serverInfo.VersionType?n("el-table-column",{attrs:{prop:"CloudRecord",label:"云端录像","min-width":"100"},scopedSlots:t._u([{key:"default",fn:function(e){return[n("el-switch",{attrs:{value:e.row.CloudRecord,disabled:t.isDir(e.row)||"Decode"==e.row.DeviceType},on:{change:function(n){t.toggleCloudRecord(e.row)}}})]}}])}):t._e(),t._v(" "),!t.serverInfo.GlobalChannelShared&&t.hasAnyRole(t.serverInfo,t.userInfo,"管理员")?n("el-table-column",{attrs:{prop:"Shared",label:"开启分享","min-width":"100"},scopedSlots:t._u([{key:"default",fn:function(e){return[n("el-switch",{attrs:{value:e.row.Shared,disabled:t.isDir(e.row)||"Decode"==e.row.DeviceType},on:{change:function(n){t.toggleShared(e.row)}}})]}}])}):t._e(),t._v(" "),t.hasAnyRole(t.serverInfo,t.userInfo,"管理员")?n("el-table-column",{attrs:{prop:"NumOutputs",label:"在线人数","min-width":"100"}}):t._e(),t._v(" "),t.hasAnyRole(t.serverInfo,t.userInfo,"管理员")&&t.hasAllChannel(t.serverInfo,t.userInfo)?n("el-table-column",{attrs:{prop:"SubCount",label:"子节点数","min-width":"100"}}):t._e(),t._v(" "),n("el-table-column",{attrs:{prop:"Manufacturer",label:"厂家","min-width":"120","show-overflow-tooltip":""},scopedSlots:t._u([{key:"default",fn:function(e){return[n("span",{class:{"text-orange":!!e.row.CustomManufacturer}},[t._v("\r\n                            "+t._s(e.row.CustomManufacturer||e.row.Manufacturer||"-")+"\r\n                        ")])]}}])}),t._v(" "),t.hasAnyRole(t.serverInfo,t.userInfo,"管理员","操作员")?n("el-table-column",{attrs:{prop:"PTZType",label:"云台类型","min-width":"140"},scopedSlots:t._u([{key:"default",fn:function(e){return[t.hasAnyRole(t.serverInfo,t.userInfo,"管理员")?n("el-dropdown",{attrs:{size:"small",trigger:"click"},on:{command:t.setPTZType}},[n("span",{class:["el-dropdown-link",{"text-orange":e.row.CustomPTZType}]},[t._v("\r\n                                "+t._s(t.formatPTZType(e.row))+" "),n("i",{staticClass:"el-icon-arrow-down el-icon--right"})]),t._v(" "),n("el-dropdown-menu",{attrs:{slot:"dropdown"},slot:"dropdown"},[n("el-dropdown-item",{attrs:{command:{row:e.row,PTZType:0}}},[t._v("0-未知")]),t._v(" "),n("el-dropdown-item",{attrs:{command:{row:e.row,PTZType:1}}},[t._v("1-球机")]),t._v(" "),n("el-dropdown-item",{attrs:{command:{row:e.row,PTZType:2}}},[t._v("2-半球机")]),t._v(" "),n("el-dropdown-item",{attrs:{command:{row:e.row,PTZType:3}}},[t._v("3-固定枪机")]),t._v(" "),n("el-dropdown-item",{attrs:{command:{row:e.row,PTZType:4}}},[t._v("4-遥控枪机")])],1)],1):n("span",{class:{"text-orange":e.row.CustumPTZType}},[t._v(t._s(t.formatPTZType(e.row)))])]}}])}):t._e(),t._v(" "),n("el-table-column",{attrs:{prop:"Longitude",label:"位置","min-width":"180","show-overflow-tooltip":""},scopedSlots:t._u([{key:"default",fn:function(e){return[t.hasAnyRole(t.serverInfo,t.userInfo,"管理员")?n("a",{class:{"text-orange":e.row.CustomLongitude||e.row.CustomLatitude},attrs:{role:"button"},on:{click:function(n){n.preventDefault(),t.editChannelPosition(e.row)}}},[t._v("\r\n                            "+t._s(t.formatPosition(e.row))+"\r\n                        ")]):n("span",{class:{"text-orange":e.row.CustomLongitude||e.row.CustomLatitude}},[t._v(t._s(t.formatPosition(e.row)))])]}}])}),t._v(" "),t.hasAnyRole(t.serverInfo,t.userInfo,"管理员")?n("el-table-column",{attrs:{prop:"UpdatedAt",label:"更新时间","min-width":"160",sortable:"custom"}}):t._e(),t._v(" "),t.hasAnyRole(t.serverInfo,t.userInfo,"管理员")?n("el-table-column",{attrs:{prop:"CreatedAt",label:"创建时间","min-width":"160",sortable:"custom"}}):t._e()],1)],1),t._v(" "),t.total>0?n("div",{staticClass:"box-footer"},[n("el-pagination",{staticClass:"pull-right",attrs:{layout:"total,prev,pager,next","pager-count":t.isMobile()?3:5,total:t.total,"page-size":t.pageSize,"current-page":t.currentPage},on:{"update:pageSize":function(e){t.pageSize=e},"update:currentPage":function(e){t.currentPage=e}}})],1):t._e()]),t._v(" "),n("ChannelExportDlg",{ref:"downloadDlg"}),t._v(" "),n("UploadDlg",{ref:"uploadDlg",attrs:{title:"上传通道列表",url:t.uploadURL},on:{uploaded:t.uploaded,hide:function(e){t.uploadThisDev=!1}}},[n("template",{attrs:{slot:"header"},slot:"header"},[t.devid?n("div",{staticClass:"checkbox text-center"},[n("el-checkbox",{staticStyle:{"margin-left":"-19px","margin-top":"-5px"},model:{value:t.uploadThisDev,callback:function(e){t.uploadThisDev="string"==typeof e?e.trim():e},expression:"uploadThisDev"}},[t._v("\r\n                    只导入当前设备("+t._s(t.devid)+")的通道\r\n                ")]),t._v(" "),n("br"),t._v(" "),n("br")],1):t._e()])],2),t._v(" "),n("ChannelNameEditDlg",{ref:"channelNameEditDlg",attrs:{serverInfo:t.serverInfo,userInfo:t.userInfo},on:{submit:t.getChannels}}),t._v(" "),n("ChannelPositionEditDlg",{ref:"channelPositionEditDlg",on:{submit:t.getChannels}}),t._v(" "),n("VideoDlg",{ref:"videoDlg",attrs:{serverInfo:t.serverInfo,userInfo:t.userInfo}}),t._v(" "),n("DeviceLogDlg",{ref:"deviceLogDlg",attrs:{serverInfo:t.serverInfo,userInfo:t.userInfo}}),t._v(" "),n("DecodeChannelListDlg",{ref:"decodeChannelListDlg",attrs:{serverInfo:t.serverInfo,userInfo:t.userInfo},on:{selected:t.decodeStream}})],1)},r=[],i={render:o,staticRenderFns:r};e.a=i},JkFl:function(t,e,n){"use strict";Object.defineProperty(e,"__esModule",{value:!0});var o=n("BgxG"),r=n.n(o);for(var i in o)["default","default"].indexOf(i)<0&&function(t){n.d(e,t,function(){return o[t]})}(i);var a=n("x5s2"),s=n("VU/8"),l=s(r.a,a.a,!1,null,null,null);e.default=l.exports},KBJn:function(t,e,n){var o=n("Ynuf");"string"==typeof o&&(o=[[t.i,o,""]]),o.locals&&(t.exports=o.locals);n("rjj0")("0dc1678c",o,!0,{})},KToh:function(t,e,n){"use strict";Object.defineProperty(e,"__esModule",{value:!0});var o=n("tviW"),r=n.n(o);for(var i in o)["default","default"].indexOf(i)<0&&function(t){n.d(e,t,function(){return o[t]})}(i);var a=n("wPWL"),s=n("VU/8"),l=s(r.a,a.a,!1,null,null,null);e.default=l.exports},KsAq:function(t,e,n){"use strict";var o=function(){var t=this,e=t.$createElement,n=t._self._c||e;return n("div",{class:["modal",{fade:t.fade}],attrs:{"data-keyboard":"true","data-backdrop":"static",tabindex:"-1"}},[n("div",{staticClass:"modal-dialog modal-md"},[n("div",{staticClass:"modal-content"},[n("div",{staticClass:"modal-header"},[t._m(0),t._v(" "),n("h4",{staticClass:"modal-title text-primary text-center"},[n("span",[t._v(t._s(t.title))])])]),t._v(" "),n("div",{staticClass:"modal-body"},[n("el-progress",{attrs:{"text-inside":!0,"stroke-width":18,percentage:t.progress}}),t._v(" "),t.fileError?n("p",{staticClass:"text-red text-center"},[n("br"),t._v(" "),n("span",{staticClass:"text-bold"},[t._v("异常终止提示：")]),t._v("\n                        "+t._s(t.fileError)+"\n                    ")]):t._e()],1),t._v(" "),n("div",{staticClass:"modal-footer"},[n("span",{directives:[{name:"show",rawName:"v-show",value:t.inBitRate,expression:"inBitRate"}]},[t._v(t._s(t.inBitRate)+"Kbps  ")]),t._v(" "),n("el-radio-group",{directives:[{name:"show",rawName:"v-show",value:t.fileUrl,expression:"fileUrl"}],attrs:{id:"speed-switcher-download",size:"small"},on:{change:t.scale},model:{value:t.speed,callback:function(e){t.speed=t._n(e)},expression:"speed"}},[n("el-radio-button",{attrs:{label:"0.5"}},[t._v("0.5x")]),t._v(" "),n("el-radio-button",{attrs:{label:"1"}},[t._v("1x")]),t._v(" "),n("el-radio-button",{attrs:{label:"2"}},[t._v("2x")]),t._v(" "),n("el-radio-button",{attrs:{label:"4"}},[t._v("4x")])],1),t._v(" "),n("button",{directives:[{name:"show",rawName:"v-show",value:t.fileUrl,expression:"fileUrl"}],staticClass:"btn btn-primary",attrs:{type:"button"},on:{click:function(e){e.preventDefault(),t.hide(e)}}},[t._v("下载")])],1)])])])},r=[function(){var t=this,e=t.$createElement,n=t._self._c||e;return n("button",{staticClass:"close",attrs:{type:"button","data-dismiss":"modal","aria-label":"Close"}},[n("span",{attrs:{"aria-hidden":"true"}},[t._v("×")])])}],i={render:o,staticRenderFns:r};e.a=i},LbQQ:function(t,e,n){var o=n("rF1v");"string"==typeof o&&(o=[[t.i,o,""]]),o.locals&&(t.exports=o.locals);n("rjj0")("080cae9a",o,!0,{})},MR46:function(t,e,n){"use strict";function o(t){n("12k0")}Object.defineProperty(e,"__esModule",{value:!0});var r=n("1XML"),i=n.n(r);for(var a in r)["default","default"].indexOf(a)<0&&function(t){n.d(e,t,function(){return r[t]})}(a);var s=n("E3p4"),l=n("VU/8"),u=o,c=l(i.a,s.a,!1,u,"data-v-6f9217a2",null);e.default=c.exports},OObi:function(t,e,n){"use strict";Object.defineProperty(e,"__esModule",{value:!0}),e.default={name:"ElTimeline",props:{reverse:{type:Boolean,default:!1}},provide:function(){return{timeline:this}},render:function(){var t=arguments[0],e=this.reverse,n={"el-timeline":!0,"is-reverse":e},o=this.$slots.default||[];return e&&(o=o.reverse()),t("ul",{class:n},[o])}}},RAAF:function(t,e,n){"use strict";var o=function(){var t=this,e=t.$createElement,n=t._self._c||e;return n("div",[n("div",{staticClass:"box box-primary"},[t._m(0),t._v(" "),n("div",{staticClass:"box-body"},[n("div",{staticClass:"form-inline",attrs:{autocomplete:"off",spellcheck:"false"}},[n("div",{staticClass:"form-group form-group-sm"},[n("label",[t._v("搜索")]),t._v(" "),!t.isMobile()&&t.hasAnyRole(t.serverInfo,t.userInfo,"超级管理员")?n("div",{staticClass:"input-group input-group-sm"},[n("input",{directives:[{name:"model",rawName:"v-model.trim",value:t.q,expression:"q",modifiers:{trim:!0}}],ref:"q",staticClass:"form-control",attrs:{type:"text",placeholder:"关键字"},domProps:{value:t.q},on:{keydown:function(e){if(!("button"in e)&&t._k(e.keyCode,"enter",13,e.key))return null;e.preventDefault()},input:function(e){e.target.composing||(t.q=e.target.value.trim())},blur:function(e){t.$forceUpdate()}}}),t._v(" "),n("div",{staticClass:"input-group-btn"},[n("button",{staticClass:"btn btn-sm btn-primary",attrs:{type:"button",title:"导出设备列表"},on:{click:function(e){e.preventDefault(),t.download(e)}}},[n("i",{staticClass:"fa fa-download"})]),t._v(" "),n("button",{staticClass:"btn btn-sm btn-primary",attrs:{type:"button",title:"导入设备列表"},on:{click:function(e){e.preventDefault(),t.$refs.uploadDlg.show()}}},[n("i",{staticClass:"fa fa-upload"})])])]):n("input",{directives:[{name:"model",rawName:"v-model.trim",value:t.q,expression:"q",modifiers:{trim:!0}}],ref:"q",staticClass:"form-control",attrs:{type:"text",placeholder:"关键字"},domProps:{value:t.q},on:{keydown:function(e){if(!("button"in e)&&t._k(e.keyCode,"enter",13,e.key))return null;e.preventDefault()},input:function(e){e.target.composing||(t.q=e.target.value.trim())},blur:function(e){t.$forceUpdate()}}})]),t._v(" "),n("span",{staticClass:"hidden-xs"},[t._v("  ")]),t._v(" "),n("div",{staticClass:"form-group form-group-sm"},[n("label",[t._v("在线状态")]),t._v(" "),n("select",{directives:[{name:"model",rawName:"v-model.trim",value:t.online,expression:"online",modifiers:{trim:!0}}],staticClass:"form-control",on:{change:function(e){var n=Array.prototype.filter.call(e.target.options,function(t){return t.selected}).map(function(t){return"_value"in t?t._value:t.value});t.online=e.target.multiple?n:n[0]}}},[n("option",{attrs:{value:""}},[t._v("全部")]),t._v(" "),n("option",{attrs:{value:"true"}},[t._v("在线")]),t._v(" "),n("option",{attrs:{value:"false"}},[t._v("离线")])])])]),t._v(" "),n("br"),t._v(" "),n("div",{staticClass:"clearfix"}),t._v(" "),n("el-table",{directives:[{name:"loading",rawName:"v-loading",value:t.loading,expression:"loading"}],attrs:{data:t.devices,stripe:"","default-sort":{prop:"ID",order:"ascending"},"element-loading-text":"加载中..."},on:{"sort-change":t.sortChange}},[n("el-table-column",{attrs:{prop:"ID",label:"设备国标编号","min-width":"200",sortable:"custom","show-overflow-tooltip":""}}),t._v(" "),n("el-table-column",{attrs:{label:"操作","min-width":t.hasAnyRole(t.serverInfo,t.userInfo,"管理员")?240:120,fixed:!t.isMobile()&&"right"},scopedSlots:t._u([{key:"default",fn:function(e){return[n("div",{staticClass:"btn-group btn-group-xs"},[n("router-link",{staticClass:"btn btn-info",attrs:{to:"/devices/channels/"+e.row.ID+"/1"}},[n("i",{staticClass:"fa fa-info"}),t._v(" 查看通道\n                                ")]),t._v(" "),e.row.Online&&t.hasAnyRole(t.serverInfo,t.userInfo,"管理员")?n("button",{staticClass:"btn btn-primary",attrs:{type:"button",disabled:(t.catalogMap[e.row.ID]||e.row.CatalogProgress)&&!t.debug,title:t.formatCatalogTitle(e.row)},on:{click:function(n){n.preventDefault(),t.fetchCatalog(e.row)}}},[n("i",{class:["fa","fa-refresh",{"fa-spin":t.catalogMap[e.row.ID]||e.row.CatalogProgress}]}),t._v(" 更新通道\n                                ")]):t._e(),t._v(" "),t.hasAnyRole(t.serverInfo,t.userInfo,"管理员")?n("button",{staticClass:"btn btn-warning",attrs:{type:"button"},on:{click:function(n){n.preventDefault(),t.editDevice(e.row)}}},[n("i",{staticClass:"fa fa-edit"}),t._v(" 编辑\n                                ")]):t._e(),t._v(" "),!e.row.Online&&t.hasAnyRole(t.serverInfo,t.userInfo,"管理员")?n("button",{staticClass:"btn btn-danger",attrs:{type:"button"},on:{click:function(n){n.preventDefault(),t.removeDevice(e.row)}}},[n("i",{staticClass:"fa fa-remove"}),t._v(" 删除\n                                ")]):t._e()],1)]}}])}),t._v(" "),n("el-table-column",{attrs:{prop:"Name",label:"名称","min-width":"140","show-overflow-tooltip":""},scopedSlots:t._u([{key:"default",fn:function(e){return[n("span",{class:{"text-orange":!!e.row.CustomName},attrs:{title:e.row.CustomName?e.row.Name||"-":""}},[t._v("\n\t\t\t\t\t\t\t\t"+t._s(e.row.CustomName||e.row.Name||"-")+"\n\t\t\t\t\t\t\t")])]}}])}),t._v(" "),t.hasAnyRole(t.serverInfo,t.userInfo,"管理员")?n("el-table-column",{attrs:{prop:"MediaTransport",label:"流传输模式","min-width":"140"},scopedSlots:t._u([{key:"default",fn:function(e){return[t.hasAnyRole(t.serverInfo,t.userInfo,"管理员")?n("el-dropdown",{attrs:{size:"small",trigger:"click"},on:{command:t.setMediaTransport}},[n("span",{staticClass:"el-dropdown-link"},[t._v("\n\t\t\t\t\t\t\t\t\t"+t._s(t.formatTransport(e.row))+" "),n("i",{staticClass:"el-icon-arrow-down el-icon--right"})]),t._v(" "),n("el-dropdown-menu",{attrs:{slot:"dropdown"},slot:"dropdown"},[n("el-dropdown-item",{attrs:{command:{row:e.row,MediaTransport:"UDP",MediaTransportMode:"passive"}}},[t._v("UDP")]),t._v(" "),n("el-dropdown-item",{attrs:{command:{row:e.row,MediaTransport:"TCP",MediaTransportMode:"passive"}}},[t._v("TCP 被动")]),t._v(" "),n("el-dropdown-item",{attrs:{command:{row:e.row,MediaTransport:"TCP",MediaTransportMode:"active"}}},[t._v("TCP 主动")])],1)],1):n("span",[t._v(t._s(t.formatTransport(e.row)))])]}}])}):t._e(),t._v(" "),t.hasAnyRole(t.serverInfo,t.userInfo,"管理员")&&t.hasAllChannel(t.serverInfo,t.userInfo)?n("el-table-column",{attrs:{prop:"ChannelCount",label:"通道数","min-width":"120",sortable:"custom","show-overflow-tooltip":""},scopedSlots:t._u([{key:"default",fn:function(e){return[e.row.ChannelOverLoad&&e.row.Online?n("span",{staticClass:"text-red"},[t._v("\n\t\t\t\t\t\t\t\t"+t._s(e.row.ChannelCount)+" (授权满)\n\t\t\t\t\t\t\t")]):e.row.CatalogProgress&&2==e.row.CatalogProgress.split("/").length?n("span",[t._v(t._s(e.row.ChannelCount)+"\n\t\t\t\t\t\t\t\t"),n("el-progress",{attrs:{percentage:t.formatCatalogPercent(e.row),"text-inside":"","stroke-width":14,title:t.formatCatalogTitle(e.row)}})],1):n("span",[t._v(t._s(e.row.ChannelCount))])]}}])}):t._e(),t._v(" "),n("el-table-column",{attrs:{prop:"Online",label:"在线状态","min-width":"100"},scopedSlots:t._u([{key:"default",fn:function(e){return[t.hasAnyRole(t.serverInfo,t.userInfo,"管理员")?n("span",[n("a",{class:[{"text-success":e.row.Online,"text-gray":!e.row.Online}],attrs:{role:"button",title:"点击查看设备状态记录"},on:{click:function(n){n.preventDefault(),t.showStatusLog(e.row)}}},[t._v("\n\t\t\t\t\t\t\t\t\t"+t._s(e.row.Online?"在线":"离线")+"\n\t\t\t\t\t\t\t\t")]),t._v(" "),n("a",{class:[{"text-success":e.row.Online,"text-gray":!e.row.Online}],attrs:{role:"button",title:"点击查看设备流量统计"},on:{click:function(n){n.preventDefault(),t.showStreamLog(e.row)}}},[n("i",{staticClass:"fa fa-bar-chart"})])]):e.row.Online?n("span",{staticClass:"text-success"},[t._v("\n\t\t\t\t\t\t\t\t在线\n\t\t\t\t\t\t\t")]):n("span",{staticClass:"text-gray"},[t._v("\n\t\t\t\t\t\t\t\t离线\n\t\t\t\t\t\t\t")])]}}])}),t._v(" "),t.hasAnyRole(t.serverInfo,t.userInfo,"管理员")&&t.network?n("el-table-column",{attrs:{prop:"RemoteIP",label:"出口 IP","min-width":"210",formatter:t.formatRemoteIP,"show-overflow-tooltip":""}}):t._e(),t._v(" "),t.hasAnyRole(t.serverInfo,t.userInfo,"管理员")&&t.region?n("el-table-column",{attrs:{prop:"RemoteRegion",label:"所在地区","min-width":"150","show-overflow-tooltip":""}}):t._e(),t._v(" "),n("el-table-column",{attrs:{prop:"Manufacturer",label:"厂家","min-width":"150","show-overflow-tooltip":""},scopedSlots:t._u([{key:"default",fn:function(e){return[n("span",[t._v(t._s(e.row.Manufacturer||"-"))]),e.row.GBVer?n("span",[t._v(", GB"+t._s(e.row.GBVer))]):t._e(),t._v(" "),"Decode"==e.row.Type?n("span",{staticClass:"badge"},[t._v("解码器")]):t._e()]}}])}),t._v(" "),t.hasAnyRole(t.serverInfo,t.userInfo,"管理员")?n("el-table-column",{attrs:{prop:"LastKeepaliveAt",label:"最近心跳","min-width":"160",sortable:"custom"}}):t._e(),t._v(" "),t.hasAnyRole(t.serverInfo,t.userInfo,"管理员")?n("el-table-column",{attrs:{prop:"LastRegisterAt",label:"最近注册","min-width":"160",sortable:"custom"}}):t._e(),t._v(" "),t.hasAnyRole(t.serverInfo,t.userInfo,"管理员")?n("el-table-column",{attrs:{prop:"UpdatedAt",label:"更新时间","min-width":"160",sortable:"custom"}}):t._e(),t._v(" "),t.hasAnyRole(t.serverInfo,t.userInfo,"管理员")?n("el-table-column",{attrs:{prop:"CreatedAt",label:"创建时间","min-width":"160",sortable:"custom"}}):t._e()],1)],1),t._v(" "),t.total>0?n("div",{staticClass:"box-footer"},[n("el-pagination",{staticClass:"pull-right",attrs:{layout:"total,prev,pager,next","pager-count":t.isMobile()?3:5,total:t.total,"page-size":t.pageSize,"current-page":t.currentPage},on:{"update:pageSize":function(e){t.pageSize=e},"update:currentPage":function(e){t.currentPage=e}}})],1):t._e()]),t._v(" "),t.serverInfo.DemoUser?n("div",{staticClass:"alert text-center"},[t._m(1)]):t._e(),t._v(" "),n("UploadDlg",{ref:"uploadDlg",attrs:{title:"上传设备列表",url:"/api/v1/device/import"},on:{uploaded:t.uploaded}}),t._v(" "),n("DeviceEditDlg",{ref:"deviceEditDlg",attrs:{useSeparateDevicePassword:!0===t.serverInfo.UseSeparateDevicePassword},on:{submit:t.getDeviceList}}),t._v(" "),n("DeviceLogDlg",{ref:"deviceLogDlg",attrs:{serverInfo:t.serverInfo,userInfo:t.userInfo}}),t._v(" "),n("el-dialog",{attrs:{title:"提示",visible:t.removeDlg,"lock-scroll":!1},on:{"update:visible":function(e){t.removeDlg=e}}},[n("p",[t._v("确认删除 "+t._s(t.removeName||t.removeID)+" ?")]),t._v(" "),n("br"),t._v(" "),t.removeUA?n("div",{staticClass:"checkbox"},[n("el-checkbox",{staticStyle:{"margin-left":"-19px","margin-top":"-5px"},model:{value:t.removeUACheck,callback:function(e){t.removeUACheck="string"==typeof e?e.trim():e},expression:"removeUACheck"}},[t._v("\n                    批量删除 "),n("strong",[t._v(t._s(t.removeUA))])]),t._v(" "),n("br"),n("br"),t._v(" "),n("el-checkbox",{directives:[{name:"show",rawName:"v-show",value:t.removeUACheck,expression:"removeUACheck"}],staticStyle:{"margin-left":"-19px","margin-top":"-5px"},model:{value:t.forbidUACheck,callback:function(e){t.forbidUACheck="string"==typeof e?e.trim():e},expression:"forbidUACheck"}},[t._v("\n                    禁止 "),n("strong",[t._v(t._s(t.removeUA))]),t._v(" 接入\n\t\t\t\t")])],1):t.removeIP?n("div",{staticClass:"checkbox"},[n("el-checkbox",{staticStyle:{"margin-left":"-19px","margin-top":"-5px"},model:{value:t.removeIPCheck,callback:function(e){t.removeIPCheck="string"==typeof e?e.trim():e},expression:"removeIPCheck"}},[t._v("\n                    批量删除 "),n("strong",[t._v(t._s(t.removeIP))])]),t._v(" "),n("br"),n("br"),t._v(" "),n("el-checkbox",{directives:[{name:"show",rawName:"v-show",value:t.removeIPCheck,expression:"removeIPCheck"}],staticStyle:{"margin-left":"-19px","margin-top":"-5px"},model:{value:t.forbidIPCheck,callback:function(e){t.forbidIPCheck="string"==typeof e?e.trim():e},expression:"forbidIPCheck"}},[t._v("\n                    禁止 "),n("strong",[t._v(t._s(t.removeIP))]),t._v(" 接入\n\t\t\t\t")])],1):t._e(),t._v(" "),n("span",{staticClass:"dialog-footer",attrs:{slot:"footer"},slot:"footer"},[n("el-button",{attrs:{size:"small"},on:{click:function(e){t.removeDlg=!1}}},[t._v("取 消")]),t._v(" "),n("el-button",{attrs:{type:"primary",size:"small"},on:{click:t.removeBatch}},[t._v("确 定")])],1)])],1)},r=[function(){var t=this,e=t.$createElement,n=t._self._c||e;return n("div",{staticClass:"box-header"},[n("h4",{staticClass:"text-primary text-center"},[t._v("设备列表")])])},function(){var t=this,e=t.$createElement,n=t._self._c||e;return n("small",[n("strong",[n("i",{staticClass:"fa fa-info-circle"}),t._v(" 提示 : ")]),t._v('\n                可向 Redis 订阅 device 消息以获取设备实时状态 > SUBSCRIBE device; 消息内容为 "设备国标编号 ON/OFF"\n\t\t\t')])}],i={render:o,staticRenderFns:r};e.a=i},"RE+l":function(t,e,n){var o=n("jPLH");"string"==typeof o&&(o=[[t.i,o,""]]),o.locals&&(t.exports=o.locals);n("rjj0")("56eb6553",o,!0,{})},RUmD:function(t,e,n){"use strict";function o(t){return t&&t.__esModule?t:{default:t}}function r(t){return function(){var e=t.apply(this,arguments);return new Promise(function(t,n){function o(r,i){try{var a=e[r](i),s=a.value}catch(t){return void n(t)}if(!a.done)return Promise.resolve(s).then(function(t){o("next",t)},function(t){o("throw",t)});t(s)}return o("next")})}}Object.defineProperty(e,"__esModule",{value:!0});var i=n("A9K1"),a=o(i),s=n("0iPh"),l=o(s);e.default={props:{serverInfo:{type:Object,default:function(){}},userInfo:{type:Object,default:function(){return null}}},data:function(){return{bImport:!1,bLoading:!1,devices:[],form:this.defForm()}},components:{FormDlg:a.default},computed:{title:function(){return this.form.add?this.bImport?"导入设备":this.form.custom?"新建分组":"新建节点":this.form.custom?"编辑分组":"编辑名称"},labelHeader:function(){return this.form.add?"分组":this.form.custom?"分组":this.form.serial&&!this.form.code?"设备":this.form.serial&&this.form.code?"通道":"节点"},codePlaceholder:function(){return this.form.add?!this.form.serial||this.form.serial.length<4?"1~20位数字":"1~20位数字, 推荐使用行政编号, 如: "+this.form.serial.substring(0,4):""}},methods:{defForm:function(){return{id:"",serial:"",code:"",name:"",custom_id:"",custom_name:"",parent_id:"",parent_name:"根节点",custom:!1,add:!1,virtual:!1,import_serial:"",new_code:""}},onHide:function(){this.form=this.defForm(),this.bLoading=!1,this.bImport=!1,this.devices=[],this.$emit("hide")},onShow:function(){this.errors.clear(),this.$emit("show"),"编辑名称"==this.title&&this.$el.querySelector("#input-name").focus()},onSubmit:function(){var t=this;return r(regeneratorRuntime.mark(function e(){var n,o;return regeneratorRuntime.wrap(function(e){for(;;)switch(e.prev=e.next){case 0:return e.next=2,t.$validator.validateAll();case 2:if(n=e.sent){e.next=8;break}return o=t.errors.items[0],t.$message({type:"error",message:o.msg}),(0,l.default)("[name="+o.field+"]").focus(),e.abrupt("return");case 8:t.form.virtual&&t.form.add?l.default.post("/api/v1/channel/virtual/add",{serial:t.form.serial,code:t.form.code,name:t.form.custom_name,parent_id:t.form.parent_id}).then(function(e){t.$refs.dlg.hide(),t.$emit("submit")}):l.default.post("/api/v1/channel/save",{serial:t.form.serial,code:t.form.code,name:t.form.custom_name,parent_id:t.form.parent_id,add:t.form.add,import_serial:t.form.import_serial,new_code:t.form.new_code,custom_id:t.form.custom_id}).then(function(e){t.$refs.dlg.hide(),t.$emit("submit")});case 9:case"end":return e.stop()}},e,t)}))()},show:function(t){var e=this;this.errors.clear(),t&&(this.bImport=!!t.import,this.form.add=!!t.add,this.form.id=t.id,this.form.serial=t.serial,this.form.code=t.code,this.form.name=t.name,this.form.custom_id=t.customID,this.form.custom_name=t.customName,this.form.custom=!!t.custom,t.custom&&(this.form.new_code=t.code,this.form.custom_name=t.name,this.form.custom_id="",this.form.name=""),t.parent&&(this.form.parent_id=t.parent.code,this.form.parent_name=t.parent.customName||t.parent.name||t.parent.id,this.form.virtual="virtual"===t.parent.manufacturer)),this.bImport&&this.loadDevice(),this.$nextTick(function(){e.$refs.dlg.show()})},loadDevice:function(t){var e=this;this.bLoading=!0,l.default.get("/api/v1/device/list",{q:t,limit:10}).then(function(t){e.devices=t.DeviceList||[]}).always(function(){e.$nextTick(function(){e.bLoading=!1})})},changeDevice:function(t){var e=this;t?(this.form.code=t,20==t.length&&"216"!=t.substring(10,13)&&(this.form.code=t.substring(0,10)+"216"+t.substring(13)),this.form.import_serial=t,this.$nextTick(function(){e.form.custom_name=e.$refs.selectDevice.selectedLabel})):(this.form.code="",this.form.custom_name="",this.form.import_serial="")},clearDevice:function(){this.form.code="",this.form.custom_name="",this.form.import_serial="",this.loadDevice()}}}},S4Bz:function(t,e,n){"use strict";function o(t){n("aIgG")}Object.defineProperty(e,"__esModule",{value:!0});var r=n("GB08"),i=n.n(r);for(var a in r)["default","default"].indexOf(a)<0&&function(t){n.d(e,t,function(){return r[t]})}(a);var s=n("znKA"),l=n("VU/8"),u=o,c=l(i.a,s.a,!1,u,"data-v-65020dbb",null);e.default=c.exports},SHtI:function(t,e,n){"use strict";var o=function(){var t=this,e=t.$createElement,n=t._self._c||e;return n("div",{class:["modal",{fade:t.fade}],attrs:{"data-keyboard":"true","data-backdrop":"static",tabindex:"-1"}},[n("div",{staticClass:"modal-dialog modal-lg"},[n("div",{staticClass:"modal-content"},[n("div",{staticClass:"modal-header"},[t._m(0),t._v(" "),n("h4",{staticClass:"modal-title text-primary text-center"},[n("span",[t._v(t._s(t.videoTitle))])])]),t._v(" "),n("div",{staticClass:"modal-body"},[t.bShow?n("LivePlayer",{directives:[{name:"loading",rawName:"v-loading",value:t.bLoading,expression:"bLoading"}],attrs:{videoUrl:t.videoUrl,muted:!t.bAudioEnable,waterMark:t.osd,digitalZoom:t.digitalZoom,hideLiveText:"",live:"",snapUrl:t.snapUrl,hideBigPlayButton:!!t.serverInfo.HideBigPlayButton,loading:t.bLoading,"element-loading-text":"加载中...","element-loading-background":"#000"},on:{media_info:t.onMediaInfo,ended:t.onEnded,error:t.onError,message:t.$message,"update:loading":function(e){t.bLoading=e}}}):t._e(),t._v(" "),t.isDemoUser(t.serverInfo,t.userInfo)&&!t.bOutHevcTip?n("div",{staticClass:"text-center"},[n("br"),t._v("\r\n                    提示: 演示系统限制匿名登录播放时间, 若需测试长时间播放, 请"),n("a",{attrs:{target:"_blank",href:"//www.liveqing.com/docs/download/LiveGBS.html"}},[t._v("下载使用")])]):t._e(),t._v(" "),t.bOutHevcTip?n("div",{staticClass:"text-center text-red"},[n("br"),t._v("\r\n                    提示: 正在播放 H265 直出流, 确保浏览器版本较新, 并且开启硬件加速\r\n                ")]):t._e()],1),t._v(" "),n("div",{staticClass:"modal-footer"},[n("span",{directives:[{name:"show",rawName:"v-show",value:t.inBitRate,expression:"inBitRate"}]},[t._v(t._s(t.inBitRate)+"Kbps  ")]),t._v(" "),n("el-radio-group",{directives:[{name:"show",rawName:"v-show",value:t.streamID,expression:"streamID"}],attrs:{id:"speed-switcher-playback",size:"small"},on:{change:t.scale},model:{value:t.speed,callback:function(e){t.speed=t._n(e)},expression:"speed"}},[n("el-radio-button",{attrs:{label:"0.5"}},[t._v("0.5x")]),t._v(" "),n("el-radio-button",{attrs:{label:"1"}},[t._v("1x")]),t._v(" "),n("el-radio-button",{attrs:{label:"2"}},[t._v("2x")]),t._v(" "),n("el-radio-button",{attrs:{label:"4"}},[t._v("4x")])],1),t._v(" "),n("button",{staticClass:"btn btn-default",attrs:{type:"button","data-dismiss":"modal"}},[t._v("关闭")])],1)])])])},r=[function(){var t=this,e=t.$createElement,n=t._self._c||e;return n("button",{staticClass:"close",attrs:{type:"button","data-dismiss":"modal","aria-label":"Close"}},[n("span",{attrs:{"aria-hidden":"true"}},[t._v("×")])])}],i={render:o,staticRenderFns:r};e.a=i},SLv4:function(t,e,n){"use strict";function o(t){return t&&t.__esModule?t:{default:t}}Object.defineProperty(e,"__esModule",{value:!0});var r=n("M4fF"),i=o(r),a=n("0iPh"),s=o(a);n("F8S1"),e.default={props:{title:{default:"选择解码视频源"},size:{type:String,default:"modal-lgg"},fade:{type:Boolean,default:!1},serverInfo:{type:Object,default:function(){}},userInfo:{type:Object,default:function(){return null}}},data:function(){return{q:"",online:"true",total:0,pageSize:10,currentPage:1,sort:"",order:"",loading:!1,channels:[],dstRow:null}},watch:{q:function(t,e){this.doDelaySearch()},online:function(t,e){this.doSearch()},currentPage:function(t,e){this.doSearch(t)},pageSize:function(t,e){this.doSearch()}},mounted:function(){var t=this;(0,s.default)(this.$el).find(".modal-content").draggable({handle:".modal-header",cancel:".modal-title span",addClasses:!1,containment:"document",delay:100,opacity:.5}),(0,s.default)(this.$el).on("shown.bs.modal",function(){t.$emit("show")}).on("hidden.bs.modal",function(){t.errors.clear(),t.$emit("hide")})},methods:{sortChange:function(t){this.sort=t.prop,this.order="ascending"==t.order?"asc":"desc",this.getChannels()},doSearch:function(){var t=arguments.length>0&&void 0!==arguments[0]?arguments[0]:1;this.currentPage=t,this.getChannels()},doDelaySearch:i.default.debounce(function(){this.doSearch()},800),formatName:function(t,e,n){var o=t.DeviceCustomName||t.DeviceName||"",r=t.CustomName||t.Name||"";return o&&o!=r&&(r=r?r+"@"+o:o),r||"-"},formatDeviceName:function(t,e,n){return t.DeviceCustomName||t.DeviceName||"-"},formatChannelName:function(t,e,n){return t.CustomName||t.Name||"-"},formatManufacturer:function(t,e,n){return n||"-"},getChannels:function(){var t=this;this.loading=!0,s.default.get("/api/v1/device/channellist",{q:this.q,start:(this.currentPage-1)*this.pageSize,limit:this.pageSize,channel_type:"device",online:this.online,sort:this.sort,order:this.order}).then(function(e){t.total=e.ChannelCount,t.channels=e.ChannelList||[]}).always(function(){t.$nextTick(function(){t.loading=!1})})},rowClick:function(t,e,n){"ON"===(t.CustomStatus||t.Status)&&(this.serverInfo.StrictChannelParental&&1==t.Parental||this.serverInfo.StrictChannelCode&&20!=t.ID.length||(this.$emit("selected",this.dstRow,t),this.hide()))},rowDblclick:function(t,e,n){this.serverInfo.StrictChannelParental&&1==t.Parental||this.serverInfo.StrictChannelCode&&20!=t.ID.length||(this.$emit("selected",this.dstRow,t),this.hide())},rowStyle:function(t){var e=t.row;t.rowIndex;return"ON"===(e.CustomStatus||e.Status)?this.serverInfo.StrictChannelParental&&1==e.Parental?"":this.serverInfo.StrictChannelCode&&20!=e.ID.length?"":"cursor:pointer":""},isDir:function(t){if(t){if(t.SubCount>0)return!0;if(this.serverInfo.StrictChannelParental&&1==t.Parental)return!0;if(this.serverInfo.StrictChannelCode&&t.ID.length<=10)return!0;if(1==t.Parental&&"LiveQing"==t.Manufacturer)return!0;if(20==t.ID.length&&("216"==t.ID.substring(10,13)||"215"==t.ID.substring(10,13)))return!0}return!1},reset:function(){this.dstRow=null,this.channels=[],this.q="",this.online="true",this.currentPage=1,this.pageSize=10},show:function(t){this.dstRow=t,(0,s.default)(this.$el).modal("show"),this.getChannels()},hide:function(){(0,s.default)(this.$el).modal("hide")}}}},TTNq:function(t,e,n){"use strict";Object.defineProperty(e,"__esModule",{value:!0});var o=n("OObi"),r=n.n(o);for(var i in o)["default","default"].indexOf(i)<0&&function(t){n.d(e,t,function(){return o[t]})}(i);var a=n("VU/8"),s=a(r.a,null,!1,null,null,null);e.default=s.exports},VMXz:function(t,e,n){"use strict";Object.defineProperty(e,"__esModule",{value:!0});var o=n("AU86"),r=n.n(o);for(var i in o)["default","default"].indexOf(i)<0&&function(t){n.d(e,t,function(){return o[t]})}(i);var a=n("hxIF"),s=n("VU/8"),l=s(r.a,a.a,!1,null,null,null);e.default=l.exports},WEd6:function(t,e,n){"use strict";function o(t){n("IEpb")}Object.defineProperty(e,"__esModule",{value:!0});var r=n("49HP"),i=n.n(r);for(var a in r)["default","default"].indexOf(a)<0&&function(t){n.d(e,t,function(){return r[t]})}(a);var s=n("u/lO"),l=n("VU/8"),u=o,c=l(i.a,s.a,!1,u,null,null);e.default=c.exports},WGgy:function(t,e,n){"use strict";var o=function(){var t=this,e=t.$createElement,n=t._self._c||e;return n("div",{class:["modal",{fade:t.fade}],attrs:{"data-backdrop":"static","data-disable":"false","data-keyboard":"true",tabindex:"-1"}},[n("div",{class:["modal-dialog",t.size]},[n("div",{staticClass:"modal-content"},[n("div",{staticClass:"modal-header"},[t._m(0),t._v(" "),n("h4",{staticClass:"modal-title text-center text-primary"},[n("span",[t._v(t._s(t.title))])])]),t._v(" "),n("div",{staticClass:"modal-body"},[n("div",{staticClass:"form-inline",attrs:{autocomplete:"off",spellcheck:"false"}},[n("div",{staticClass:"form-group form-group-sm"},[n("label",[t._v("搜索")]),t._v(" "),n("input",{directives:[{name:"model",rawName:"v-model.trim",value:t.q,expression:"q",modifiers:{trim:!0}}],ref:"q",staticClass:"form-control",attrs:{type:"text",placeholder:"关键字"},domProps:{value:t.q},on:{keydown:function(e){if(!("button"in e)&&t._k(e.keyCode,"enter",13,e.key))return null;e.preventDefault()},input:function(e){e.target.composing||(t.q=e.target.value.trim())},blur:function(e){t.$forceUpdate()}}})]),t._v(" "),n("span",{staticClass:"hidden-xs"},[t._v("  ")]),t._v(" "),n("div",{staticClass:"form-group form-group-sm"},[n("label",[t._v("在线状态")]),t._v(" "),n("select",{directives:[{name:"model",rawName:"v-model.trim",value:t.online,expression:"online",modifiers:{trim:!0}}],staticClass:"form-control",on:{change:function(e){var n=Array.prototype.filter.call(e.target.options,function(t){return t.selected}).map(function(t){return"_value"in t?t._value:t.value});t.online=e.target.multiple?n:n[0]}}},[n("option",{attrs:{value:""}},[t._v("全部")]),t._v(" "),n("option",{attrs:{value:"true"}},[t._v("在线")]),t._v(" "),n("option",{attrs:{value:"false"}},[t._v("离线")])])])]),t._v(" "),n("br"),t._v(" "),n("el-table",{directives:[{name:"loading",rawName:"v-loading",value:t.loading,expression:"loading"}],ref:"channelTable",attrs:{data:t.channels,stripe:"","max-height":500,"row-style":t.rowStyle,"element-loading-text":"加载中..."},on:{"sort-change":t.sortChange,"row-click":t.rowClick,"row-dblclick":t.rowDblclick}},[n("el-table-column",{attrs:{prop:"DeviceID",label:"设备国标编号","min-width":"200","show-overflow-tooltip":"",sortable:"custom"}}),t._v(" "),n("el-table-column",{attrs:{prop:"ID",label:"通道国标编号","min-width":"200","show-overflow-tooltip":"",sortable:"custom"}}),t._v(" "),n("el-table-column",{attrs:{prop:"Name",label:"通道名称","min-width":"160",formatter:t.formatName,"show-overflow-tooltip":""}}),t._v(" "),n("el-table-column",{attrs:{"min-width":"100",label:"快照"},scopedSlots:t._u([{key:"default",fn:function(e){return[t.isDir(e.row)?n("span",[n("i",{staticClass:"fa fa-sitemap"})]):n("el-popover",{attrs:{"open-delay":1e3,"close-delay":10,placement:"left",title:e.row.ID,width:"400",trigger:"hover"}},[n("img",{staticStyle:{width:"100%",height:"100%"},attrs:{onerror:"this.src='/images/default_snap.png';",src:e.row.SnapURL}}),t._v(" "),n("img",{staticStyle:{height:"30px",width:"50px"},attrs:{slot:"reference",onerror:"this.src='/images/default_snap.png';",src:e.row.SnapURL},slot:"reference"})])]}}])}),t._v(" "),n("el-table-column",{attrs:{prop:"Status",label:"在线状态","min-width":"100"},scopedSlots:t._u([{key:"default",fn:function(e){return[t.isDir(e.row)?n("span",[t._v("-")]):"ON"==(e.row.CustomStatus||e.row.Status)?n("span",{class:{"text-success":"ON"==e.row.Status,"text-orange":!!e.row.CustomStatus}},[t._v("在线")]):n("span",{class:{"text-orange":!!e.row.CustomStatus}},[t._v("离线")])]}}])}),t._v(" "),n("el-table-column",{attrs:{prop:"Manufacturer",label:"厂家","min-width":"120",formatter:t.formatManufacturer,"show-overflow-tooltip":""}})],1),t._v(" "),t.total>0?n("el-pagination",{staticClass:"pull-right",attrs:{layout:"total,prev,pager,next","pager-count":t.isMobile()?3:5,total:t.total,"page-size":t.pageSize,"current-page":t.currentPage},on:{"update:pageSize":function(e){t.pageSize=e},"update:currentPage":function(e){t.currentPage=e}}}):t._e(),t._v(" "),n("div",{staticClass:"clearfix"})],1)])])])},r=[function(){var t=this,e=t.$createElement,n=t._self._c||e;return n("button",{staticClass:"close",attrs:{type:"button","data-dismiss":"modal","aria-label":"Close"}},[n("span",{attrs:{"aria-hidden":"true"}},[t._v("×")])])}],i={render:o,staticRenderFns:r};e.a=i},"Xtk+":function(t,e,n){"use strict";function o(t){n("Y6/U")}Object.defineProperty(e,"__esModule",{value:!0});var r=n("toVl"),i=n.n(r);for(var a in r)["default","default"].indexOf(a)<0&&function(t){n.d(e,t,function(){return r[t]})}(a);var s=n("9N7t"),l=n("VU/8"),u=o,c=l(i.a,s.a,!1,u,"data-v-793c4316",null);e.default=c.exports},Xv6o:function(t,e,n){"use strict";(function(t){Object.defineProperty(e,"__esModule",{value:!0});var o=n("PJh5"),r=function(t){return t&&t.__esModule?t:{default:t}}(o);e.default={data:function(){return{timeCursorX:0,timeDayX:0,bMoving:!1}},props:{minutesPerUnit:{default:5,type:Number},videos:{default:function(){return[]}},start:{default:0,type:Number},end:{default:0,type:Number}},mounted:function(){function e(e){var n=t(i).data("originPageX"),o=e.pageX-n;s.timeCursorX=t(i).position().left+o,t(i).data("originPageX",e.pageX)}function n(e){var n=e.originalEvent.targetTouches[0],o=t(i).data("originPageX"),r=n.pageX-o;s.timeCursorX=t(i).position().left+r,t(i).data("originPageX",n.pageX)}function o(e){var n=t(a).data("originPageX"),o=e.pageX-n;s.timeDayX=t(a).position().left+o,t(a).data("originPageX",e.pageX)}function r(e){var n=e.originalEvent.targetTouches[0],o=t(a).data("originPageX"),r=n.pageX-o;s.timeDayX=t(a).position().left+r,t(a).data("originPageX",n.pageX)}var i=this.$refs.cursor,a=this.$refs.day,s=(this.$el,this);t(i).on("mousedown",function(n){t(i).data("originPageX",n.pageX),s.bMoving=!0,t(document).on("mousemove",e).one("mouseup",function(n){t(document).off("mousemove",e),t(i).removeData("originPageX"),s.triggerTimeChange(),s.bMoving=!1})}).on("touchstart",function(e){var o=e.originalEvent.targetTouches[0];t(i).data("originPageX",o.pageX),s.bMoving=!0,t(document).on("touchmove",n).one("touchend",function(e){t(document).off("touchmove",n),t(i).removeData("originPageX"),s.triggerTimeChange(),s.bMoving=!1})}),t(a).on("mousedown",function(e){if(t(e.target).hasClass("time-minute"))return!1;t(a).data("originPageX",e.pageX),s.bMoving=!0,t(document).on("mousemove",o).one("mouseup",function(e){t(document).off("mousemove",o),t(a).removeData("originPageX"),s.triggerTimeChange(),s.bMoving=!1})}).on("touchstart",function(e){if(t(e.target).hasClass("time-minute"))return!1;var n=e.originalEvent.targetTouches[0];t(a).data("originPageX",n.pageX),s.bMoving=!0,t(document).on("touchmove",r).one("touchend",function(e){t(document).off("touchmove",r),t(a).removeData("originPageX"),s.triggerTimeChange(),s.bMoving=!1})})},watch:{videos:function(t){this.triggerTimeChange()}},methods:{hourText:function(t){return(0,r.default)().hour(t).minute(0).second(0).format("HH:mm")},minuteActiveClass:function(t){var e=(0,r.default)().hour(0).minute(t),n=e.format("HH:mm");return Object.keys(this.activeMinutes).indexOf(n)>=0?"active":""},minuteTitle:function(t){var e=(0,r.default)().hour(0).minute(t),n=e.format("HH:mm");return Object.keys(this.activeMinutes).indexOf(n)>=0?n:""},clickMinute:function(t){var e=!(arguments.length>1&&void 0!==arguments[1])||arguments[1];this.bMoving||(this.timeCursorX=t+this.timeDayX,e&&this.triggerTimeChange())},triggerTimeChange:function(){this.$emit("timeChange",this.activeMinutes[this.timeCursorText])}},computed:{timeCursorText:function(){this.timeCursorX>=t(this.$el).innerWidth()&&(this.timeCursorX=t(this.$el).innerWidth()-1),this.timeCursorX<0&&(this.timeCursorX=0),this.timeDayX<t(this.$el).innerWidth()-t(this.$refs.day).outerWidth()&&(this.timeDayX=t(this.$el).innerWidth()-t(this.$refs.day).outerWidth()),this.timeDayX>0&&(this.timeDayX=0),this.timeCursorX-this.timeDayX>=1440&&(this.timeDayX=t(this.$el).innerWidth()-t(this.$refs.day).outerWidth(),this.timeCursorX=t(this.$el).innerWidth()-1);var e=parseInt((this.timeCursorX-this.timeDayX)/this.minutesPerUnit)*this.minutesPerUnit;return(0,r.default)().hour(0).minute(e).format("HH:mm")},activeMinutes:function(){var t={},e=0,n=!0,o=!1,i=void 0;try{for(var a,s=this.videos[Symbol.iterator]();!(n=(a=s.next()).done);n=!0){var l=a.value,u=(0,r.default)(l.StartTime,"YYYY-MM-DDTHH:mm:ss"),c=(0,r.default)(l.EndTime,"YYYY-MM-DDTHH:mm:ss");u.isSame(c,"day")||(0==e?u=(0,r.default)(c).startOf("day"):c=(0,r.default)(u).endOf("day"));var d,f,v=(0,r.default)(u).startOf("hour");this.start&&(d=(0,r.default)(u).startOf("day").add(this.start,"minute")),this.end&&(f=(0,r.default)(u).startOf("day").add(this.end,"minute"));for(var p=0;;p+=this.minutesPerUnit){var h=(0,r.default)(v).add(p,"minute");if(!h.isBefore(u,"minute")){if(h.isAfter(c,"minute"))break;if(!d||!h.isBefore(d,"minute")){if(f&&h.isAfter(f,"minute"))break;t[h.format("HH:mm")]=Object.assign({},l,{StartTime:h.format("YYYY-MM-DDTHH:mm:ss")})}}}e++}}catch(t){o=!0,i=t}finally{try{!n&&s.return&&s.return()}finally{if(o)throw i}}return t}}}}).call(e,n("0iPh"))},"Y6/U":function(t,e,n){var o=n("bCqn");"string"==typeof o&&(o=[[t.i,o,""]]),o.locals&&(t.exports=o.locals);n("rjj0")("6d28fafe",o,!0,{})},YgVS:function(t,e,n){var o=n("6O2P");"string"==typeof o&&(o=[[t.i,o,""]]),o.locals&&(t.exports=o.locals);n("rjj0")("5d4deb38",o,!0,{})},Ynuf:function(t,e,n){e=t.exports=n("FZ+f")(!1),e.push([t.i,".modal-content[data-v-6aa38924]{overflow:hidden}@media screen and (min-width:992px){.modal-dialog.modal-lgg[data-v-6aa38924]{width:90%}}@media screen and (min-width:1200px){.modal-dialog.modal-lgg[data-v-6aa38924]{width:1200px}}",""])},Zurf:function(t,e,n){"use strict";Object.defineProperty(e,"__esModule",{value:!0});var o=n("pCZB"),r=n.n(o);for(var i in o)["default","default"].indexOf(i)<0&&function(t){n.d(e,t,function(){return o[t]})}(i);var a=n("gJt0"),s=n("VU/8"),l=s(r.a,a.a,!1,null,null,null);e.default=l.exports},aIgG:function(t,e,n){var o=n("7KHA");"string"==typeof o&&(o=[[t.i,o,""]]),o.locals&&(t.exports=o.locals);n("rjj0")("48b908d3",o,!0,{})},aiu8:function(t,e,n){e=t.exports=n("FZ+f")(!1),e.push([t.i,".modal-title[data-v-66201e86]{overflow:hidden;white-space:nowrap;text-overflow:ellipsis}#speed-switcher-playback[data-v-66201e86]{margin-right:10px}#speed-switcher-playback label[data-v-66201e86]{margin-bottom:0}",""])},aonT:function(t,e,n){"use strict";(function(t){function o(t){return t&&t.__esModule?t:{default:t}}Object.defineProperty(e,"__esModule",{value:!0}),n("F8S1");var r=n("eAMf"),i=o(r),a=n("qDl4"),s=o(a);e.default={data:function(){return{videoUrl:"",videoTitle:"",snapUrl:"",serial:"",code:"",timer:0,active:!1,osd:"",streamid:"",protocol:"",streamInfo:null,bRecording:!1,bAudioEnable:!1,recordFileURL:"",bShow:!1,bLoading:!1,recorder:null,ws:null,level:0,statsTicker:0,SourceVideoWidth:0,SourceVideoHeight:0,SourceVideoCodecName:"",SourceAudioCodecName:"",StartAt:"",Transport:"",InBitRate:0,RTPLostRate:0,FrameRate:0,Duration:0,NumOutputs:0,activeName:"steam-info",mediaInfo:null,bOutHevcTip:!1,bRTPLostTip:!1}},props:{fade:{type:Boolean,default:!1},digitalZoom:{type:Boolean,default:!0},serverInfo:{type:Object,default:function(){}},userInfo:{type:Object,default:function(){return null}}},beforeDestroy:function(){this.timer&&(clearTimeout(this.timer),this.timer=0),this.statsTicker&&(clearInterval(this.statsTicker),this.statsTicker=0),this.ws&&(this.ws.close(),this.ws=null),this.ctrlStop(),t(document).off("mouseup touchend",this.mouseUp)},mounted:function(){var e=this;t(this.$el).find(".modal-content").draggable({handle:".modal-header",cancel:".modal-title span",addClasses:!1,containment:"document",delay:100,opacity:.5}),t(this.$el).on("hide.bs.modal",function(){e.streamid="",e.streamInfo=null,e.mediaInfo=null,e.bOutHevcTip=!1,e.bRTPLostTip=!1,e.protocol="",e.snapUrl="",e.videoUrl="",e.osd="",e.bRecording=!1,e.bAudioEnable=!1,e.recordFileURL="",e.activeName="steam-info",e.timer&&(clearTimeout(e.timer),e.timer=0),e.statsTicker&&(clearInterval(e.statsTicker),e.statsTicker=0),e.ctrlStop(),e.bShow=!1}).on("show.bs.modal",function(){e.bShow=!0}),t(document).on("mouseup touchend",this.mouseUp)},components:{LivePlayer:i.default,Preset:s.default},methods:{play:function(e,n,o,r){var i=this;r=r||{};var a=r.HLS,s="HLS";switch(this.flvSupported()&&(a=this.isIE()?r.WS_FLV:r.FLV,s=this.isIE()?"WS_FLV":"FLV"),String(this.serverInfo.PreferStreamFmt||"").toUpperCase()){case"WEBRTC":this.rtcSupported()&&(a=r.WEBRTC,s="WEBRTC");break;case"FLV":this.flvSupported()&&!this.isIE()&&(a=r.FLV,s="FLV");break;case"WS_FLV":case"WS-FLV":this.flvSupported()&&(a=r.WS_FLV,s="WS_FLV");break;case"HLS":a=r.HLS,s="HLS";break;case"RTMP":a=r.RTMP,s="RTMP"}this.protocol=s,this.serial=n||"",this.code=o||"",this.streamid=r.StreamID||"",this.streamInfo=r,this.readStreamInfo(r),this.videoTitle=e||"",this.osd=r.ChannelOSD||"",this.snapUrl="RTMP"==s?"":r.SnapURL||"",this.videoUrl=a||"",t(this.$el).modal("show"),this.doActive(),this.hasAnyRole(this.serverInfo,this.userInfo,"管理员","操作员")&&this.streamid&&(this.statsTicker=setInterval(function(){i.updateStreamInfo()},3e3))},updateStreamInfo:function(){var e=this;this.streamid&&t.ajax({method:"GET",url:"/api/v1/stream/info",global:!1,data:{streamid:this.streamid}}).then(function(t){t&&(e.streamInfo=t,e.readStreamInfo(t))}).fail(function(t){t&&console.log("update stream info ajax error: "+t.status+" "+t.responseText)})},readStreamInfo:function(t){t=t||{},this.bRecording||this.recordFileURL||!t.RecordStartAt||this.queryRecord(),this.bRecording=""!=t.RecordStartAt,this.bAudioEnable=!!t.AudioEnable,this.SourceVideoWidth=t.SourceVideoWidth||0,this.SourceVideoHeight=t.SourceVideoHeight||0,this.SourceVideoCodecName=t.SourceVideoCodecName||"","hevc"==this.SourceVideoCodecName&&(this.SourceVideoCodecName="h265"),this.SourceAudioCodecName=t.SourceAudioCodecName||"",this.StartAt=t.StartAt||"",this.Transport=t.Transport||"",this.InBitRate=t.InBitRate||0,this.FrameRate=t.SourceVideoFrameRate||0,this.RTPLostRate=t.RTPLostRate||0,this.Duration=t.Duration||0,this.NumOutputs=t.NumOutputs||0},setProtocol:function(t){if(this.streamInfo){switch(this.bOutHevcTip&&!String(t).endsWith("FLV")&&"HLS"!=t&&(this.bOutHevcTip=!1),t){case"WEBRTC":this.videoUrl=this.streamInfo.WEBRTC;break;case"FLV":this.videoUrl=this.streamInfo.FLV;break;case"WS_FLV":this.videoUrl=this.streamInfo.WS_FLV;break;case"RTMP":this.snapUrl="",this.videoUrl=this.streamInfo.RTMP;break;case"HLS":this.videoUrl=this.streamInfo.HLS}this.doActive()}},ptzControl:function(e,n){var o=this;t.ajax({method:"POST",url:"/api/v1/control/ptz",global:!1,data:{serial:this.serial,code:this.code,command:e}}).fail(function(t){t&&(console.log("ptz "+e+" ajax error: "+t.status+" "+t.responseText),401==t.status&&o.$nextTick(function(){location.href="/login?r="+encodeURIComponent(window.location.href)}))}),t(n.target).closest(".ptz-cell").addClass("active")},ptzStop:function(){t(this.$el).find(".ptz-cell.active").length>0&&(t.ajax({method:"POST",url:"/api/v1/control/ptz",global:!1,data:{serial:this.serial,code:this.code,command:"stop"}}).fail(function(t){t&&console.log("ptz stop ajax error: "+t.status+" "+t.responseText)}),t(this.$el).find(".ptz-cell.active").removeClass("active"))},talkStart:function(e){var n=this;if(this.ws){if(this.talkHold)return void this.talkStop()}else this.ws=new WebSocket(this.wsTalkURL()),this.ws.onopen=function(t){console.log("ws talk open")},this.ws.onclose=function(t){console.log("ws talk close")},this.ws.onerror=function(t){console.log("ws talk error",t)};var o=t(e.currentTarget);if(this.recorder)return o.addClass("active"),void this.recorder.start();LiveRecorder.get(function(t,e){if(e)return alert(e),void n.talkStop();o.addClass("active"),n.recorder=t,n.recorder.start()},{sampleBits:16,sampleRate:8e3,pcmCallback:function(t,e){var o=new window.FileReader;o.onloadend=function(){var t=o.result,t=t.split(",")[1];n.ws&&n.ws.readyState===WebSocket.OPEN&&(n.ws.send(t),n.level=e)},o.readAsDataURL(t)}})},talkStop:function(){this.recorder&&(this.recorder.destroy(),this.recorder=null,t(this.$el).find(".fa-microphone.active").removeClass("active")),this.ws&&(this.ws.close(),this.ws=null),this.level=0},ctrlStop:function(){this.talkStop(),this.ptzStop()},mouseUp:function(){this.talkHold||this.talkStop(),this.ptzStop()},toggleRecord:function(){var e=this;this.bRecording?t.ajax({method:"POST",url:"/api/v1/record/stop",global:!1,data:{streamid:this.streamid}}).then(function(t){var n="",o=!0,r=!1,i=void 0;try{for(var a,s=t.RecordList[Symbol.iterator]();!(o=(a=s.next()).done);o=!0){var l=a.value;if(l.DownloadURL){n=l.DownloadURL;break}}}catch(t){r=!0,i=t}finally{try{!o&&s.return&&s.return()}finally{if(r)throw i}}n&&window.open(n,"_blank"),e.bRecording=!1,e.recordFileURL=""}).fail(function(t){if(t&&console.log("record stop ajax error: "+t.status+" "+t.responseText),e.recordFileURL)window.open(e.recordFileURL,"_blank"),e.bRecording=!1,e.recordFileURL="";else if(t&&t.status){var n=t.responseText||"网络请求失败";try{n=JSON.parse(n)}catch(t){}e.$message({type:"error",message:n})}}):t.post("/api/v1/record/start",{streamid:this.streamid}).then(function(t){e.bRecording=!0,e.recordFileURL=t.DownloadURL||""})},queryRecord:function(){var e=this;t.ajax({method:"GET",url:"/api/v1/record/list",global:!1,data:{streamid:this.streamid}}).then(function(t){var n=!0,o=!1,r=void 0;try{for(var i,a=t.RecordList[Symbol.iterator]();!(n=(i=a.next()).done);n=!0){var s=i.value;if(s.DownloadURL){e.recordFileURL=s.DownloadURL;break}}}catch(t){o=!0,r=t}finally{try{!n&&a.return&&a.return()}finally{if(o)throw r}}}).fail(function(t){t&&console.log("record query ajax error: "+t.status+" "+t.responseText)})},wsTalkURL:function(){var t="ws:";return location.protocol.startsWith("https")&&(t="wss:"),t+"//"+location.host+"/api/v1/control/ws-talk/"+this.serial+"/"+this.code+"?format=pcm"},doActive:function(){var t=this;this.active=!0,this.timer&&(clearTimeout(this.timer),this.timer=0),this.timer=setTimeout(function(){t.active=!1},2e3)},onMediaInfo:function(t){this.mediaInfo=t},onEnded:function(t){this.mediaInfo=null},onError:function(t){"MediaError"==t&&(this.mediaInfo&&String(this.mediaInfo.videoCodec).startsWith("hvc")||"HLS"==this.protocol)&&flvjs.getFeatureList()&&!flvjs.getFeatureList().nativeMP4H265Playback&&(this.bOutHevcTip=!0,console.log("提示: 正在播放 H265 直出流, 确保浏览器版本较新, 并且开启硬件加速"))}}}}).call(e,n("0iPh"))},bCqn:function(t,e,n){e=t.exports=n("FZ+f")(!1),e.push([t.i,"#input-speed-dropdown[data-v-793c4316]{color:#444!important;background-color:#f4f4f4!important;border-color:#ddd!important}#input-speed-menu[data-v-793c4316]{min-width:unset}.player-wrapper[data-v-793c4316]{margin:0 auto;max-width:700px}@media screen and (min-width:1600px){.player-wrapper[data-v-793c4316]{max-width:50%}}",""])},"ci/Y":function(t,e,n){"use strict";var o=function(){var t=this,e=t.$createElement,n=t._self._c||e;return n("div",{class:["modal",{fade:t.fade}],attrs:{"data-keyboard":"true","data-backdrop":"static",tabindex:"-1"}},[n("div",{staticClass:"modal-dialog modal-lgg"},[n("div",{staticClass:"modal-content"},[n("div",{staticClass:"modal-header"},[t._m(0),t._v(" "),n("h4",{staticClass:"modal-title text-primary text-center"},[n("span",[t._v(t._s(t.videoTitle))])])]),t._v(" "),n("div",{staticClass:"modal-body"},[n("div",{staticClass:"row"},[n("div",{class:["play-area",{"user-active":t.active,"col-sm-9":t.hasAnyRole(t.serverInfo,t.userInfo,"管理员","操作员"),"col-sm-12":!t.hasAnyRole(t.serverInfo,t.userInfo,"管理员","操作员")}],on:{mousemove:t.doActive}},[t.bShow?n("LivePlayer",{directives:[{name:"loading",rawName:"v-loading",value:t.bLoading,expression:"bLoading"}],ref:"player",attrs:{videoUrl:t.videoUrl,waterMark:t.osd,digitalZoom:t.digitalZoom,poster:t.snapUrl,hideBigPlayButton:!!t.serverInfo.HideBigPlayButton,live:"",muted:!t.bAudioEnable,loading:t.bLoading,"element-loading-text":"加载中...","element-loading-background":"#000"},on:{media_info:t.onMediaInfo,ended:t.onEnded,error:t.onError,message:t.$message,"update:loading":function(e){t.bLoading=e}}}):t._e()],1),t._v(" "),t.hasAnyRole(t.serverInfo,t.userInfo,"管理员","操作员")?n("div",{staticClass:"ptz-area col-sm-3"},[n("div",{staticClass:"form-group hide"},[n("div",{staticClass:"input-group"},[n("input",{directives:[{name:"model",rawName:"v-model.trim",value:t.osd,expression:"osd",modifiers:{trim:!0}}],staticClass:"form-control",attrs:{type:"text"},domProps:{value:t.osd},on:{input:function(e){e.target.composing||(t.osd=e.target.value.trim())},blur:function(e){t.$forceUpdate()}}}),t._v(" "),n("span",{staticClass:"input-group-btn"},[n("button",{staticClass:"btn btn-default",attrs:{type:"button"},on:{click:function(e){e.preventDefault(),t.setOSD(e)}}},[t._v("设置OSD")])])])]),t._v(" "),n("div",{staticClass:"form-group",staticStyle:{margin:"0px"}},[n("div",{staticClass:"ptz-block"},[t.ws?n("el-progress",{staticClass:"mic-level-bar",attrs:{percentage:t.level,"text-inside":!0}}):t._e(),t._v(" "),n("div",{staticClass:"ptz-cell ptz-up",attrs:{command:"up",title:"上"},on:{mousedown:function(e){e.preventDefault(),t.ptzControl("up",e)},touchstart:function(e){e.preventDefault(),t.ptzControl("up",e)}}},[n("i",{staticClass:"fa fa-chevron-up"})]),t._v(" "),n("div",{staticClass:"ptz-cell ptz-left",attrs:{command:"left",title:"左"},on:{mousedown:function(e){e.preventDefault(),t.ptzControl("left",e)},touchstart:function(e){e.preventDefault(),t.ptzControl("left",e)}}},[n("i",{staticClass:"fa fa-chevron-left"})]),t._v(" "),n("div",{staticClass:"ptz-cell ptz-center",attrs:{title:"云台控制"}},[t.canTalk()&&"标准版"!==t.serverInfo.VersionType?n("i",{staticClass:"fa fa-microphone",attrs:{title:"按住喊话"},on:{mousedown:function(e){e.preventDefault(),t.talkStart(e)},touchstart:function(e){e.preventDefault(),t.talkStart(e)}}}):t._e(),t._v(" "),t.canTalk()||"标准版"===t.serverInfo.VersionType?t._e():n("i",{staticClass:"fa fa-microphone-slash",attrs:{title:"由于浏览器安全策略, 非 HTTPS 或 localhost 访问, 对讲不可用"}})]),t._v(" "),n("div",{staticClass:"ptz-cell ptz-right",attrs:{command:"right",title:"右"},on:{mousedown:function(e){e.preventDefault(),t.ptzControl("right",e)},touchstart:function(e){e.preventDefault(),t.ptzControl("right",e)}}},[n("i",{staticClass:"fa fa-chevron-right"})]),t._v(" "),n("div",{staticClass:"ptz-cell ptz-down",attrs:{command:"down",title:"下"},on:{mousedown:function(e){e.preventDefault(),t.ptzControl("down",e)},touchstart:function(e){e.preventDefault(),t.ptzControl("down",e)}}},[n("i",{staticClass:"fa fa-chevron-down"})]),t._v(" "),n("div",{staticClass:"ptz-cell ptz-zoomin",attrs:{command:"zoomin",title:"放大"},on:{mousedown:function(e){e.preventDefault(),t.ptzControl("zoomin",e)},touchstart:function(e){e.preventDefault(),t.ptzControl("zoomin",e)}}},[n("i",{staticClass:"fa fa-plus"})]),t._v(" "),n("div",{staticClass:"ptz-cell ptz-zoomout",attrs:{command:"zoomout",title:"缩小"},on:{mousedown:function(e){e.preventDefault(),t.ptzControl("zoomout",e)},touchstart:function(e){e.preventDefault(),t.ptzControl("zoomout",e)}}},[n("i",{staticClass:"fa fa-minus"})])],1),t._v(" "),n("div",{staticClass:"clearfix"}),t._v(" "),t.SourceVideoCodecName&&t.SourceVideoWidth&&t.SourceVideoHeight?n("el-tabs",{staticClass:"tab-info hidden-xs hidden-sm",model:{value:t.activeName,callback:function(e){t.activeName=e},expression:"activeName"}},[n("el-tab-pane",{attrs:{size:"mini",label:"流信息",name:"steam-info"}},[n("div",{staticClass:"row stream-info hidden-xs hidden-sm"},[n("div",{staticClass:"col-sm-3"},[t._v("\r\n                                            分辨率:\r\n                                        ")]),t._v(" "),n("div",{staticClass:"col-sm-3"},[t._v("\r\n                                            "+t._s(t.SourceVideoWidth)+"x"+t._s(t.SourceVideoHeight)+"\r\n                                        ")]),t._v(" "),n("div",{staticClass:"col-sm-3"},[t._v("\r\n                                            帧率:\r\n                                        ")]),t._v(" "),n("div",{staticClass:"col-sm-3"},[t._v("\r\n                                            "+t._s(t.FrameRate)+"fps\r\n                                        ")]),t._v(" "),n("div",{staticClass:"clearfix"},[n("div",{staticClass:"col-sm-3"},[t._v("\r\n\t\t\t\t\t\t\t\t\t\t\t\t码率:\r\n\t\t\t\t\t\t\t\t\t\t\t")]),t._v(" "),n("div",{staticClass:"col-sm-3"},[t._v("\r\n\t\t\t\t\t\t\t\t\t\t\t\t"+t._s(t.InBitRate)+"Kbps\r\n\t\t\t\t\t\t\t\t\t\t\t")]),t._v(" "),n("div",{staticClass:"col-sm-3"},[t._v("\r\n\t\t\t\t\t\t\t\t\t\t\t\t丢包率:\r\n\t\t\t\t\t\t\t\t\t\t\t")]),t._v(" "),n("div",{staticClass:"col-sm-3"},[t.RTPLostRate>0&&"UDP"==t.Transport&&!t.isDemoUser(t.serverInfo,t.userInfo)&&t.hasAnyRole(t.serverInfo,t.userInfo,"管理员")?n("a",{staticClass:"text-orange",attrs:{role:"button"},on:{click:function(e){t.bRTPLostTip=!t.bRTPLostTip}}},[n("u",[t._v(t._s(parseFloat(t.RTPLostRate).toFixed(2))+"% "),n("i",{staticClass:"glyphicon glyphicon-exclamation-sign"})])]):n("span",{class:{"text-orange":t.RTPLostRate>0}},[t._v("\r\n\t\t\t\t\t\t\t\t\t\t\t\t\t"+t._s(parseFloat(t.RTPLostRate).toFixed(2))+"%\r\n\t\t\t\t\t\t\t\t\t\t\t\t")])])]),t._v(" "),n("div",{staticClass:"clearfix"},[n("div",{staticClass:"col-sm-3"},[t._v("\r\n\t\t\t\t\t\t\t\t\t\t\t\t视频编码:\r\n\t\t\t\t\t\t\t\t\t\t\t")]),t._v(" "),n("div",{staticClass:"col-sm-3"},[t._v("\r\n\t\t\t\t\t\t\t\t\t\t\t\t"+t._s(t.SourceVideoCodecName)+"\r\n\t\t\t\t\t\t\t\t\t\t\t")]),t._v(" "),n("div",{staticClass:"col-sm-3"},[t._v("\r\n\t\t\t\t\t\t\t\t\t\t\t\t音频编码:\r\n\t\t\t\t\t\t\t\t\t\t\t")]),t._v(" "),n("div",{class:["col-sm-3",{"text-gray":!t.bAudioEnable}]},[t._v("\r\n\t\t\t\t\t\t\t\t\t\t\t\t"+t._s(t.SourceAudioCodecName)+"\r\n\t\t\t\t\t\t\t\t\t\t\t")])]),t._v(" "),n("div",{staticClass:"clearfix"},[n("div",{staticClass:"col-sm-3"},[t._v("\r\n\t\t\t\t\t\t\t\t\t\t\t\t传输模式:\r\n\t\t\t\t\t\t\t\t\t\t\t")]),t._v(" "),n("div",{staticClass:"col-sm-3"},[t._v("\r\n\t\t\t\t\t\t\t\t\t\t\t\t"+t._s(t.Transport)+"\r\n\t\t\t\t\t\t\t\t\t\t\t")]),t._v(" "),n("div",{staticClass:"col-sm-3"},[t._v("\r\n\t\t\t\t\t\t\t\t\t\t\t\t持续时长:\r\n\t\t\t\t\t\t\t\t\t\t\t")]),t._v(" "),n("div",{staticClass:"col-sm-3"},[t._v("\r\n\t\t\t\t\t\t\t\t\t\t\t\t"+t._s(t.formatDuration(t.Duration))+"\r\n\t\t\t\t\t\t\t\t\t\t\t")])]),t._v(" "),!t.isDemoUser(t.serverInfo,t.userInfo)&&t.hasAnyRole(t.serverInfo,t.userInfo,"超级管理员")?n("div",{staticClass:"clearfix"},[n("div",{staticClass:"col-sm-3"},[t._v("\r\n\t\t\t\t\t\t\t\t\t\t\t\t流地址:\r\n\t\t\t\t\t\t\t\t\t\t\t")]),t._v(" "),n("div",{staticClass:"col-sm-9"},[n("i",{directives:[{name:"clipboard",rawName:"v-clipboard",value:t.videoUrl,expression:"videoUrl"}],staticClass:"fa fa-copy",attrs:{title:"点击拷贝",role:"button"},on:{success:function(e){t.$message({type:"success",message:"成功拷贝到粘贴板"})}}}),t._v("\r\n\t\t\t\t\t\t\t\t\t\t\t\t"+t._s(t.videoUrl)+"\r\n\t\t\t\t\t\t\t\t\t\t\t")])]):t._e(),t._v(" "),t.isDemoUser(t.serverInfo,t.userInfo)?n("div",{staticClass:"clearfix"},[n("div",{staticClass:"col-sm-3 text-bold text-red"},[t._v("\r\n\t\t\t\t\t\t\t\t\t\t\t\t提示:\r\n\t\t\t\t\t\t\t\t\t\t\t")]),t._v(" "),n("div",{staticClass:"col-sm-9"},[t._v("\r\n\t\t\t\t\t\t\t\t\t\t\t\t演示系统限制匿名登录播放时间, 若需测试长时间播放, 请"),n("a",{attrs:{target:"_blank",href:"//www.liveqing.com/docs/download/LiveGBS.html"}},[t._v("下载使用")])])]):t._e()])]),t._v(" "),n("el-tab-pane",{attrs:{size:"mini",label:"预置位",name:"preset-info"}},[n("Preset",{ref:"presetList",attrs:{serial:t.serial,code:t.code,activeName:t.activeName}})],1)],1):t._e()],1)]):t._e()])]),t._v(" "),n("div",{staticClass:"modal-footer"},[n("el-radio-group",{staticClass:"hidden-xs pull-left",attrs:{size:"small",id:"protocol-switcher"},on:{change:t.setProtocol},model:{value:t.protocol,callback:function(e){t.protocol="string"==typeof e?e.trim():e},expression:"protocol"}},[t.flvSupported()&&!t.isIE()?n("el-radio-button",{attrs:{label:"FLV"}}):t._e(),t._v(" "),t.flvSupported()?n("el-radio-button",{attrs:{label:"WS_FLV"}}):t._e(),t._v(" "),t.rtcSupported()?n("el-radio-button",{attrs:{label:"WEBRTC"}}):t._e(),t._v(" "),n("el-radio-button",{attrs:{label:"HLS"}}),t._v(" "),n("el-radio-button",{attrs:{label:"RTMP"}})],1),t._v(" "),t.bOutHevcTip?n("span",{staticClass:"text-red small"},[t._v("\r\n                    正在播放 H265 直出流, 确保浏览器版本较新, 并开启硬件加速  \r\n                ")]):t.bRTPLostTip&&"UDP"==t.Transport?n("span",{staticClass:"text-orange small hidden-xs hidden-sm hidden-md"},[t._v("\r\n                    存在 UDP 丢包, 尝试返回设备列表将「流传输模式」设为「TCP 被动」并停止重新播放  \r\n                ")]):t._e(),t._v(" "),t.streamid&&t.hasAnyRole(t.serverInfo,t.userInfo,"管理员","操作员")&&"标准版"!==t.serverInfo.VersionType?n("button",{class:["btn",{"btn-primary":!t.bRecording,"btn-danger":t.bRecording}],attrs:{type:"button"},on:{click:function(e){e.preventDefault(),t.toggleRecord(e)}}},[n("i",{class:["fa",{"fa-save":!t.bRecording,"fa-stop":t.bRecording}]}),t._v("\r\n                    "+t._s(t.bRecording?"停止录像":"实时录像")+"\r\n                ")]):t._e(),t._v(" "),n("button",{staticClass:"btn btn-default",attrs:{type:"button","data-dismiss":"modal"}},[t._v("关闭")])],1)])])])},r=[function(){var t=this,e=t.$createElement,n=t._self._c||e;return n("button",{staticClass:"close",attrs:{type:"button","data-dismiss":"modal","aria-label":"Close"}},[n("span",{attrs:{"aria-hidden":"true"}},[t._v("×")])])}],i={render:o,staticRenderFns:r};e.a=i},cnUC:function(t,e,n){"use strict";var o=function(){var t=this,e=t.$createElement,n=t._self._c||e;return n("FormDlg",{ref:"dlg",attrs:{title:t.title,disabled:t.errors.any()},on:{hide:t.onHide,show:t.onShow,submit:t.onSubmit}},[n("div",{directives:[{name:"show",rawName:"v-show",value:t.form.parent_id||t.form.parent_name,expression:"form.parent_id || form.parent_name"}],class:{"form-group":!0,"has-error":t.errors.has("parentid")}},[n("label",{staticClass:"col-sm-4 control-label",attrs:{for:"input-parentid"}},[t._v("父节点\n            ")]),t._v(" "),n("div",{staticClass:"col-sm-7"},[n("select",{directives:[{name:"model",rawName:"v-model.trim",value:t.form.parent_id,expression:"form.parent_id",modifiers:{trim:!0}}],staticClass:"form-control",attrs:{id:"input-parentid","data-vv-as":"父节点",disabled:"disabled"},on:{change:function(e){var n=Array.prototype.filter.call(e.target.options,function(t){return t.selected}).map(function(t){return"_value"in t?t._value:t.value});t.$set(t.form,"parent_id",e.target.multiple?n:n[0])}}},[n("option",{domProps:{value:t.form.parent_id}},[t._v(t._s(t.form.parent_name))])])])]),t._v(" "),n("div",{class:{"form-group":!0,"has-error":t.errors.has("serial")}},[n("label",{staticClass:"col-sm-4 control-label",attrs:{for:"input-serial"}},[t._v("设备编号\n            "),n("span",{staticClass:"text-red"},[t._v("*")])]),t._v(" "),n("div",{staticClass:"col-sm-7"},[n("input",{staticClass:"form-control",attrs:{type:"text",readonly:"readonly",id:"input-serial",name:"serial","data-vv-as":"设备编号"},domProps:{value:t.form.serial}})])]),t._v(" "),t.bImport?n("div",{class:{"form-group":!0,"has-error":t.errors.has("import_serial")}},[n("label",{staticClass:"col-sm-4 control-label",attrs:{for:"input-serial"}},[t._v("导入设备\n            "),n("span",{staticClass:"text-red"},[t._v("*")])]),t._v(" "),n("div",{staticClass:"col-sm-7"},[n("el-select",{directives:[{name:"validate",rawName:"v-validate",value:"required",expression:"'required'"}],ref:"selectDevice",staticStyle:{width:"100%"},attrs:{filterable:"",clearable:"",remote:"","remote-method":t.loadDevice,loading:t.bLoading,id:"input-import-serial",name:"import_serial","data-vv-as":"导入设备",placeholder:"搜索",size:"small"},on:{change:t.changeDevice,clear:t.clearDevice},model:{value:t.form.import_serial,callback:function(e){t.$set(t.form,"import_serial",e)},expression:"form.import_serial"}},t._l(t.devices,function(t){return n("el-option",{key:t.ID,attrs:{label:t.CustomName||t.Name||t.ID,value:t.ID}})}))],1)]):t._e(),t._v(" "),n("div",{directives:[{name:"show",rawName:"v-show",value:t.form.add||t.form.code,expression:"form.add || form.code"}],class:{"form-group":!0,"has-error":t.errors.has("code")}},[n("label",{staticClass:"col-sm-4 control-label",attrs:{for:"input-code"}},[t._v(t._s(t.labelHeader)+"编号\n            "),t.form.add||t.form.custom?n("span",{staticClass:"text-red"},[t._v("*")]):t._e()]),t._v(" "),n("div",{staticClass:"col-sm-7"},[t.form.add?n("input",{directives:[{name:"model",rawName:"v-model.trim",value:t.form.code,expression:"form.code",modifiers:{trim:!0}},{name:"validate",rawName:"v-validate",value:"required|regex:^[0-9]+$",expression:"'required|regex:^[0-9]+$'"}],staticClass:"form-control",attrs:{type:"text",id:"input-code",name:"code",placeholder:t.codePlaceholder,"data-vv-as":"节点编号"},domProps:{value:t.form.code},on:{keydown:function(e){if(!("button"in e)&&t._k(e.keyCode,"enter",13,e.key))return null;t.$el.querySelector("#input-name").focus()},input:function(e){e.target.composing||t.$set(t.form,"code",e.target.value.trim())},blur:function(e){t.$forceUpdate()}}}):t.form.custom?n("input",{directives:[{name:"model",rawName:"v-model.trim",value:t.form.new_code,expression:"form.new_code",modifiers:{trim:!0}},{name:"validate",rawName:"v-validate",value:"required|regex:^[0-9]+$",expression:"'required|regex:^[0-9]+$'"}],staticClass:"form-control",attrs:{type:"text",id:"input-code",name:"code",placeholder:t.codePlaceholder,"data-vv-as":"节点编号"},domProps:{value:t.form.new_code},on:{keydown:function(e){if(!("button"in e)&&t._k(e.keyCode,"enter",13,e.key))return null;t.$el.querySelector("#input-name").focus()},input:function(e){e.target.composing||t.$set(t.form,"new_code",e.target.value.trim())},blur:function(e){t.$forceUpdate()}}}):n("input",{directives:[{name:"model",rawName:"v-model.trim",value:t.form.code,expression:"form.code",modifiers:{trim:!0}}],staticClass:"form-control",attrs:{type:"text",readonly:"readonly",id:"input-code",name:"code","data-vv-as":"节点编号"},domProps:{value:t.form.code},on:{input:function(e){e.target.composing||t.$set(t.form,"code",e.target.value.trim())},blur:function(e){t.$forceUpdate()}}})])]),t._v(" "),"标准版"!=t.serverInfo.VersionType&&!t.form.add&&!t.form.custom&&t.form.serial&&t.form.code?n("div",{class:{"form-group":!0,"has-error":t.errors.has("custom_id")}},[n("label",{staticClass:"col-sm-4 control-label",attrs:{for:"input-custom-id"}},[t._v("自定义通道编号\n        ")]),t._v(" "),n("div",{staticClass:"col-sm-7"},[n("input",{directives:[{name:"model",rawName:"v-model.trim",value:t.form.custom_id,expression:"form.custom_id",modifiers:{trim:!0}}],staticClass:"form-control",attrs:{type:"text",id:"input-custom-id",name:"custom_id",placeholder:"可选, 用于级联","data-vv-as":"自定义通道编号",oninput:"value=value.replace(/[^\\d]/g,'')"},domProps:{value:t.form.custom_id},on:{keydown:function(e){if(!("button"in e)&&t._k(e.keyCode,"enter",13,e.key))return null;e.preventDefault(),t.$el.querySelector("#input-name").focus()},input:function(e){e.target.composing||t.$set(t.form,"custom_id",e.target.value.trim())},blur:function(e){t.$forceUpdate()}}})])]):t._e(),t._v(" "),n("div",{class:{"form-group":!0,"has-error":t.errors.has("name")}},[n("label",{staticClass:"col-sm-4 control-label",attrs:{for:"input-name"}},[t._v(t._s(t.labelHeader)+"名称\n        ")]),t._v(" "),n("div",{staticClass:"col-sm-7"},[n("div",{staticClass:"input-group",staticStyle:{width:"100%"}},[n("input",{directives:[{name:"model",rawName:"v-model.trim",value:t.form.custom_name,expression:"form.custom_name",modifiers:{trim:!0}}],staticClass:"form-control",attrs:{type:"text",id:"input-name",name:"name",placeholder:t.form.name||t.form.code||t.form.serial,"data-vv-as":"节点名称"},domProps:{value:t.form.custom_name},on:{keydown:function(e){if(!("button"in e)&&t._k(e.keyCode,"enter",13,e.key))return null;t.onSubmit(e)},input:function(e){e.target.composing||t.$set(t.form,"custom_name",e.target.value.trim())},blur:function(e){t.$forceUpdate()}}}),t._v(" "),n("span",{directives:[{name:"show",rawName:"v-show",value:t.form.custom_name||t.form.name,expression:"form.custom_name || form.name"}],staticClass:"input-group-btn"},[n("button",{directives:[{name:"clipboard",rawName:"v-clipboard",value:t.form.custom_name||t.form.name,expression:"form.custom_name || form.name"}],staticClass:"btn btn-default",staticStyle:{"border-top-right-radius":"0","border-bottom-right-radius":"0"},attrs:{type:"button",title:t.form.custom?"拷贝名称":t.form.custom_name?"拷贝自定义名称":"拷贝原名称"},on:{success:function(e){t.$message({type:"success",message:"成功拷贝到粘贴板"})}}},[n("i",{staticClass:"fa fa-copy"})])])])])])])},r=[],i={render:o,staticRenderFns:r};e.a=i},d155:function(t,e,n){e=t.exports=n("FZ+f")(!1),e.push([t.i,".right-menu[data-v-1785224b]{position:fixed;background:#fff;border:1px solid rgba(0,0,0,.2);border-radius:3px;z-index:999;display:none}.right-menu a[data-v-1785224b]{width:100px;height:28px;line-height:28px;text-align:center;display:block;color:#1a1a1a}.right-menu a[data-v-1785224b]:hover{background:#eee;color:#fff}.right-menu[data-v-1785224b]{border:1px solid #eee;box-shadow:0 .5em 1em 0 rgba(0,0,0,.1);border-radius:1px}a[data-v-1785224b]{text-decoration:none}.right-menu a[data-v-1785224b]{padding:2px}.right-menu a[data-v-1785224b]:hover{background:#42b983}.video[data-v-1785224b]{position:relative}.video .video-close[data-v-1785224b]{position:absolute;top:5px;right:5px;color:#fff;font-size:12px;background-color:hsla(0,0%,50%,.5);padding:2px 5px;cursor:pointer;border-radius:2px;max-width:120px;overflow:hidden;white-space:nowrap;text-overflow:ellipsis}.ptz-block[data-v-1785224b]{width:150px;height:180px;margin:0 auto;text-align:center;position:relative;font-size:24px}.ptz-cell[data-v-1785224b]{width:50px;height:50px;line-height:50px;position:absolute}.ptz-cell.active[data-v-1785224b]{color:#ccc;font-size:26px}.ptz-cell.readonly[data-v-1785224b]{color:#ddd}.fa-microphone.active[data-v-1785224b]{color:#fff}.fa-microphone-slash.readonly[data-v-1785224b],.fa-microphone.readonly[data-v-1785224b]{color:#ddd}.ptz-center[data-v-1785224b]{top:50px;left:50px;border-radius:25px;background-color:#ccc}.ptz-up[data-v-1785224b]{top:0;left:50px}.ptz-left[data-v-1785224b]{top:50px;left:0}.ptz-right[data-v-1785224b]{top:50px;left:100px}.ptz-down[data-v-1785224b]{top:100px;left:50px}.ptz-zoomin[data-v-1785224b]{top:150px;left:20px}.ptz-zoomout[data-v-1785224b]{top:150px;left:80px}.ptz-center>.fa-microphone[data-v-1785224b],.ptz-down[data-v-1785224b],.ptz-left[data-v-1785224b],.ptz-right[data-v-1785224b],.ptz-up[data-v-1785224b],.ptz-zoomin[data-v-1785224b],.ptz-zoomout[data-v-1785224b]{cursor:pointer}.ptz-center>.fa-microphone.readonly[data-v-1785224b],.ptz-down.readonly[data-v-1785224b],.ptz-left.readonly[data-v-1785224b],.ptz-right.readonly[data-v-1785224b],.ptz-up.readonly[data-v-1785224b],.ptz-zoomin.readonly[data-v-1785224b],.ptz-zoomout.readonly[data-v-1785224b]{cursor:auto}",""])},eAMf:function(t,e,n){!function(e,n){t.exports=n()}(window,function(){return function(t){function e(o){if(n[o])return n[o].exports;var r=n[o]={i:o,l:!1,exports:{}};return t[o].call(r.exports,r,r.exports,e),r.l=!0,r.exports}var n={};return e.m=t,e.c=n,e.d=function(t,n,o){e.o(t,n)||Object.defineProperty(t,n,{enumerable:!0,get:o})},e.r=function(t){"undefined"!=typeof Symbol&&Symbol.toStringTag&&Object.defineProperty(t,Symbol.toStringTag,{value:"Module"}),Object.defineProperty(t,"__esModule",{value:!0})},e.t=function(t,n){if(1&n&&(t=e(t)),8&n)return t;if(4&n&&"object"==typeof t&&t&&t.__esModule)return t;var o=Object.create(null);if(e.r(o),Object.defineProperty(o,"default",{enumerable:!0,value:t}),2&n&&"string"!=typeof t)for(var r in t)e.d(o,r,function(e){return t[e]}.bind(null,r));return o},e.n=function(t){var n=t&&t.__esModule?function(){return t.default}:function(){return t};return e.d(n,"a",n),n},e.o=function(t,e){return Object.prototype.hasOwnProperty.call(t,e)},e.p="",e(e.s=0)}({0:function(t,e,n){t.exports=n("qtAY")},"8MIm":function(t,e,n){(t.exports=n("I1BE")(void 0)).push([t.i,'.video-js .vjs-big-play-button .vjs-icon-placeholder:before,.video-js .vjs-modal-dialog,.vjs-button>.vjs-icon-placeholder:before,.vjs-modal-dialog .vjs-modal-dialog-content{position:absolute;top:0;left:0;width:100%;height:100%}.video-js .vjs-big-play-button .vjs-icon-placeholder:before,.vjs-button>.vjs-icon-placeholder:before{text-align:center}@font-face{font-family:VideoJS;src:url(data:application/font-woff;charset=utf-8;base64,d09GRgABAAAAABDkAAsAAAAAG6gAAQAAAAAAAAAAAAAAAAAAAAAAAAAAAABHU1VCAAABCAAAADsAAABUIIslek9TLzIAAAFEAAAAPgAAAFZRiV3hY21hcAAAAYQAAADaAAADPv749/pnbHlmAAACYAAAC3AAABHQZg6OcWhlYWQAAA3QAAAAKwAAADYZw251aGhlYQAADfwAAAAdAAAAJA+RCLFobXR4AAAOHAAAABMAAACM744AAGxvY2EAAA4wAAAASAAAAEhF6kqubWF4cAAADngAAAAfAAAAIAE0AIFuYW1lAAAOmAAAASUAAAIK1cf1oHBvc3QAAA/AAAABJAAAAdPExYuNeJxjYGRgYOBiMGCwY2BycfMJYeDLSSzJY5BiYGGAAJA8MpsxJzM9kYEDxgPKsYBpDiBmg4gCACY7BUgAeJxjYGS7wTiBgZWBgaWQ5RkDA8MvCM0cwxDOeI6BgYmBlZkBKwhIc01hcPjI+FGJHcRdyA4RZgQRADK3CxEAAHic7dFZbsMgAEXRS0ycyZnnOeG7y+qC8pU1dHusIOXxuoxaOlwZYWQB0Aea4quIEN4E9LzKbKjzDeM6H/mua6Lmc/p8yhg0lvdYx15ZG8uOLQOGjMp3EzqmzJizYMmKNRu27Nhz4MiJMxeu3Ljz4Ekqm7T8P52G8PP3lnTOVk++Z6iN6QZzNN1F7ptuN7eGOjDUoaGODHVsuvU8MdTO9Hd5aqgzQ50b6sJQl4a6MtS1oW4MdWuoO0PdG+rBUI+GejLUs6FeDPVqqDdDvRvqw1CfhpqM9At0iFLaAAB4nJ1YDXBTVRZ+5/22TUlJ8we0pHlJm7RJf5O8F2j6EymlSPkpxaL8U2xpa3DKj0CBhc2IW4eWKSokIoLsuMqssM64f+jA4HSdWXXXscBq67IOs3FXZ1ZYWVyRFdo899yXtIBQZ90k7717zz3v3HPPOfd854YCCj9cL9dL0RQFOqCbGJnrHb5EayiKIWN8iA/hWBblo6hUWm8TtCDwE80WMJus/irwyxOdxeB0MDb14VNJHnXYoLLSl6FfCUYO9nYPTA8Epg9090LprfbBbZ2hY0UlJUXHQp3/vtWkS6EBv8+rPMq5u9692f/dNxJNiqwC1xPE9TCUgCsSdQWgE3XQD25lkG4CN2xmTcOXWBOyser6RN6KnGbKSbmQ3+d0OI1m2W8QzLLkI2sykrWAgJJEtA8vGGW/2Q+CmT3n8zS9wZwu2DCvtuZKZN3xkrLh36yCZuUomQSqGpY8t/25VfHVhw8z4ebGBtfLb0ya9PCaDc+8dGTvk2dsh6z7WzvowlXKUSWo9MJ15a3KrEP2loOr2Ojhw6iW6hf2BDdEccQvZGpaAy7YovSwq8kr7HGllxpd71rkS6G0Sf11sl9OvMK1+jwPPODxjUwkOim9CU3ix1wNjXDfmJSEn618Bs6lpWwUpU+8PCqLMY650zjq8VhCIP17NEKTx3eaLL+s5Pi6yJWaWjTHLR1jYzPSV9VF/6Ojdb/1kO3Mk3uhHC0x6gc1BjlKQ+nQFxTYdaJkZ7ySVxLBbhR1dsboNXp1tCYKW2LRaEzpYcIx2BKNxaL0ZaUnSqfFoiNhHKR/GkX6PWUSAaJelQaqZL1EpoHNsajSEyPSoJ9IjhIxTdjHLmwZvhRDOiFTY/YeQnvrVZmiTQtGncECXtFTBZLOVwwMRgoXHAkXzMzPn1nAJJ8jYSbMDaqN2waGLzNhih/bZynUBMpIWSg7VYi7DRx2m8ALkIdRCJwI6ArJx2EI8kaDWeTQKeAFk9fjl/1AvwktjQ1P7NjyMGQyfd4vjipX6M/i52D7Cq80kqlcxEcGXRr/FEcgs0u5uGgB4VWuMFfpdn2Re6Hi3PqzmxWKsz6+ae2Pn9hXXw/fqM859UiGC0oKYYILJBqJrsn1Z1E5qOs9rQCiUQRREjm8yJcbHF5cUJufX1vAHlefw0XgUoboS3ETfQlTxBC4SOtuE8VPRJTBSCQSjZCpk7Gqzu+masaZ2y7Zjehho4F3g82BNDkAHpORG4+OCS+f6JTPmtRn/PH1kch6d04sp7AQb25aQ/pqUyXeQ8vrebG8OYQdXOQ+585u0sdW9rqalzRURiJ+9F4MweRFrKUjl1GUYhH1A27WOHw5cTFSFPMo9EeUIGnQTZHIaJ7AHLaOKsOODaNF9jkBjYG2QEsQ2xjMUAx2bBEbeTBWMHwskBjngq56S/yfgkBnWBa4K9sqKtq2t1UI8S9He5XuBRbawAdatrQEAi30Aks2+LM8WeCbalVZkWNylvJ+dqJnzVb+OHlSoKW8nPCP7Rd+CcZ2DdWAGqJ2CBFOphgywFFCFBNtfAbGtNPBCwxvygHeYMZMY9ZboBqwq/pVrsbgN5tkv152ODlbMfiqwGMBgxa4Exz3QhovRIUp6acqZmQzRq0ypDXS2TPLT02YIkQETnOE445oOGxOmXAqUJNNG7XgupMjPq2ua9asrj5yY/yuKteO1Kx0YNJTufrirLe1mZnat7OL6rnUdCWenpW6I8mAnbsY8KWs1PuSovCW9A/Z25PQ24a7cNOqgmTkLmBMgh4THgc4b9k2IVv1/g/F5nGljwPLfOgHAzJzh45V/4+WenTzmMtR5Z7us2Tys909UHqrPY7KbckoxRvRHhmVc3cJGE97uml0R1S0jdULVl7EvZtDFVBF35N9cEdjpgmAiOlFZ+Dtoh93+D3zzHr8RRNZQhnCNMNbcegOvpEwZoL+06cJQ07h+th3fZ/7PVbVC6ngTAV/KoLFuO6+2KFcU651gEb5ugPSIb1D+Xp8V4+k3sEIGnw5mYe4If4k1lFYr6SCzmM2EQ8iWtmwjnBI9kTwe1TlfAmXh7H02by9fW2gsjKwtv0aaURKil4OdV7rDL1MXIFNrhdxohcZXYTnq47WisrKitaObbf5+yvkLi5J6lCNZZ+B6GC38VNBZBDidSS/+mSvh6s+srgC8pyKMvDtt+de3c9fU76ZPfuM8ud4Kv0fyP/LqfepMT/3oZxSqpZaTa1DaQYLY8TFsHYbWYsPoRhRWfL5eSSQbhUGgGC3YLbVMk6PitTFNGpAsNrC6D1VNBKgBHMejaiuRWEWGgsSDBTJjqWIl8kJLlsaLJ2tXDr6xGfT85bM2Q06a46x2HTgvdnV8z5YDy/27J4zt6x2VtkzjoYpkq36kaBr4eQSg7tyiVweWubXZugtadl58ydapfbORfKsDTuZ0OBgx4cfdjCf5tbWNITnL120fdOi1RV1C3uKGzNdwYLcMvZ3BxoPyTOCD1XvXTp7U10gWCVmTV9b3r2z0SkGWovb2hp9I89O8a2smlyaO8muMU+dRmtzp60IzAoFpjLr1n388boLyf0dRvxhsHZ0qbWqDkwqvvpkj4l0fY6EIXRi5sQSrAvsVYwXRy4qJ2EVtD1AN7a0HWth9ymvL1xc3WTUKK/TAHA/bXDVtVWfOMfuGxGZv4Ln/jVr9jc3j1yMv0tndmyt9Vq88Y9gH1wtLX3KWjot5++jWHgAoZZkQ14wGQ20Fli71UmKJAy4xKMSTGbVdybW7FDDAut9XpD5AzWrYO7zQ8qffqF8+Ynd/clrHcdyxGy3a/3+mfNnzC/cBsveTjnTvXf1o6vzOlZw7WtqtdmPK/Errz/6NNtD72zmNOZfbmYdTGHfoofqI79Oc+R2n1lrnL6pOm0Up7kwxhTW12Amm7WYkXR2qYrF2AmgmbAsxZjwy1xpg/m1Je2vrp8v/nz2xpmlBg4E9hrMU341wVpTOh/OfmGvAnra8q6uctr60ZQHV3Q+WMQJykMj8ZsWn2QBOmmHMB+m5pDIpTFonYigiaKAhGEiAHF7EliVnQkjoLVIMPtJpBKHYd3A8GYH9jJzrWwmHx5Qjp7vDAX0suGRym1vtm/9W1/HyR8vczfMs6Sk8DSv855/5dlX9oQq52hT8syyp2rx5Id17IAyAM3wIjQPMOHzytEB64q6D5zT91yNbnx3V/nqnd017S9Y0605k3izoXLpsxde2n38yoOV9s1LcjwzNjbdX6asnBVaBj/6/DwKwPkpcqbDG7BnsXoSqWnUAmottYF6jMSdVyYZh3zVXCjwTiwwHH6sGuRiEHQGzuRX6whZkp123oy1BWE2mEfJ/tvIRtM4ZM5bDXiMsPMaAKOTyc5uL57rqyyc5y5JE5pm1i2S2iUX0CcaQ6lC6Zog7JqSqZmYlosl2K6pwNA84zRnQW6SaALYZQGW5lhCtU/W34N6o+bKfZ8cf3/Cl/+iTX3wBzpOY4mRkeNf3rptycGSshQWgGbYt5jFc2e0+DglIrwl6DVWQ7BuwaJ3Xk1J4VL5urnLl/Wf+gHU/hZoZdKNym6lG+I34FaNeZKcSpJIo2IeCVvpdsDGfKvzJnAwmeD37Ow65ZWwSowpgwX5T69s/rB55dP5BcpgDKFV8p7q2sn/1uc93bVzT/w6UrCqDTWvfCq/oCD/qZXNoUj8BL5Kp6GU017frfNXkAtiiyf/SOCEeLqnd8R/Ql9GlCRfctS6k5chvIBuQ1zCCjoCHL2DHNHIXxMJ3kQeO8lbsUXONeSfA5EjcG6/E+KdhN4bP04vBhdi883+BFBzQbxFbvZzQeY9LNBZc0FNfn5NwfDn6rCTnTw6R8o+gfpf5hCom33cRuiTlss3KHmZjD+BPN+5gXuA2ziS/Q73mLxUkpbKN/eqwz5uK0X9F3h2d1V4nGNgZGBgAOJd776+iue3+crAzc4AAje5Bfcg0xz9YHEOBiYQBQA8FQlFAHicY2BkYGBnAAGOPgaG//85+hkYGVCBMgBGGwNYAAAAeJxjYGBgYB8EmKOPgQEAQ04BfgAAAAAAAA4AaAB+AMwA4AECAUIBbAGYAcICGAJYArQC4AMwA7AD3gQwBJYE3AUkBWYFigYgBmYGtAbqB1gIEghYCG4IhAi2COh4nGNgZGBgUGYoZWBnAAEmIOYCQgaG/2A+AwAYCQG2AHicXZBNaoNAGIZfE5PQCKFQ2lUps2oXBfOzzAESyDKBQJdGR2NQR3QSSE/QE/QEPUUPUHqsvsrXjTMw83zPvPMNCuAWP3DQDAejdm1GjzwS7pMmwi75XngAD4/CQ/oX4TFe4Qt7uMMbOzjuDc0EmXCP/C7cJ38Iu+RP4QEe8CU8pP8WHmOPX2EPz87TPo202ey2OjlnQSXV/6arOjWFmvszMWtd6CqwOlKHq6ovycLaWMWVydXKFFZnmVFlZU46tP7R2nI5ncbi/dDkfDtFBA2DDXbYkhKc+V0Bqs5Zt9JM1HQGBRTm/EezTmZNKtpcAMs9Yu6AK9caF76zoLWIWcfMGOSkVduvSWechqZsz040Ib2PY3urxBJTzriT95lipz+TN1fmAAAAeJxtkMl2wjAMRfOAhABlKm2h80C3+ajgCKKDY6cegP59TYBzukAL+z1Zsq8ctaJTTKPrsUQLbXQQI0EXKXroY4AbDDHCGBNMcYsZ7nCPB8yxwCOe8IwXvOIN7/jAJ76wxHfUqWX+OzgumWAjJMV17i0Ndlr6irLKO+qftdT7i6y4uFSUvCknay+lFYZIZaQcmfH/xIFdYn98bqhra1aKTM/6lWMnyaYirx1rFUQZFBkb2zJUtoXeJCeg0WnLtHeSFc3OtrnozNwqi0TkSpBMDB1nSde5oJXW23hTS2/T0LilglXX7dmFVxLnq5U0vYATHFk3zX3BOisoQHNDFDeZnqKDy9hRNawN7Vh727hFzcJ5c8TILrKZfH7tIPxAFP0BpLeJPA==) format("woff");font-weight:400;font-style:normal}.video-js .vjs-big-play-button .vjs-icon-placeholder:before,.video-js .vjs-play-control .vjs-icon-placeholder,.vjs-icon-play{font-family:VideoJS;font-weight:400;font-style:normal}.video-js .vjs-big-play-button .vjs-icon-placeholder:before,.video-js .vjs-play-control .vjs-icon-placeholder:before,.vjs-icon-play:before{content:"\\F101"}.vjs-icon-play-circle{font-family:VideoJS;font-weight:400;font-style:normal}.vjs-icon-play-circle:before{content:"\\F102"}.video-js .vjs-play-control.vjs-playing .vjs-icon-placeholder,.vjs-icon-pause{font-family:VideoJS;font-weight:400;font-style:normal}.video-js .vjs-play-control.vjs-playing .vjs-icon-placeholder:before,.vjs-icon-pause:before{content:"\\F103"}.video-js .vjs-mute-control.vjs-vol-0 .vjs-icon-placeholder,.vjs-icon-volume-mute{font-family:VideoJS;font-weight:400;font-style:normal}.video-js .vjs-mute-control.vjs-vol-0 .vjs-icon-placeholder:before,.vjs-icon-volume-mute:before{content:"\\F104"}.video-js .vjs-mute-control.vjs-vol-1 .vjs-icon-placeholder,.vjs-icon-volume-low{font-family:VideoJS;font-weight:400;font-style:normal}.video-js .vjs-mute-control.vjs-vol-1 .vjs-icon-placeholder:before,.vjs-icon-volume-low:before{content:"\\F105"}.video-js .vjs-mute-control.vjs-vol-2 .vjs-icon-placeholder,.vjs-icon-volume-mid{font-family:VideoJS;font-weight:400;font-style:normal}.video-js .vjs-mute-control.vjs-vol-2 .vjs-icon-placeholder:before,.vjs-icon-volume-mid:before{content:"\\F106"}.video-js .vjs-mute-control .vjs-icon-placeholder,.vjs-icon-volume-high{font-family:VideoJS;font-weight:400;font-style:normal}.video-js .vjs-mute-control .vjs-icon-placeholder:before,.vjs-icon-volume-high:before{content:"\\F107"}.video-js .vjs-fullscreen-control .vjs-icon-placeholder,.vjs-icon-fullscreen-enter{font-family:VideoJS;font-weight:400;font-style:normal}.video-js .vjs-fullscreen-control .vjs-icon-placeholder:before,.vjs-icon-fullscreen-enter:before{content:"\\F108"}.video-js.vjs-fullscreen .vjs-fullscreen-control .vjs-icon-placeholder,.vjs-icon-fullscreen-exit{font-family:VideoJS;font-weight:400;font-style:normal}.video-js.vjs-fullscreen .vjs-fullscreen-control .vjs-icon-placeholder:before,.vjs-icon-fullscreen-exit:before{content:"\\F109"}.vjs-icon-square{font-family:VideoJS;font-weight:400;font-style:normal}.vjs-icon-square:before{content:"\\F10A"}.vjs-icon-spinner{font-family:VideoJS;font-weight:400;font-style:normal}.vjs-icon-spinner:before{content:"\\F10B"}.video-js.video-js:lang(en-AU) .vjs-subs-caps-button .vjs-icon-placeholder,.video-js.video-js:lang(en-GB) .vjs-subs-caps-button .vjs-icon-placeholder,.video-js.video-js:lang(en-IE) .vjs-subs-caps-button .vjs-icon-placeholder,.video-js.video-js:lang(en-NZ) .vjs-subs-caps-button .vjs-icon-placeholder,.video-js .vjs-subs-caps-button .vjs-icon-placeholder,.video-js .vjs-subtitles-button .vjs-icon-placeholder,.vjs-icon-subtitles{font-family:VideoJS;font-weight:400;font-style:normal}.video-js.video-js:lang(en-AU) .vjs-subs-caps-button .vjs-icon-placeholder:before,.video-js.video-js:lang(en-GB) .vjs-subs-caps-button .vjs-icon-placeholder:before,.video-js.video-js:lang(en-IE) .vjs-subs-caps-button .vjs-icon-placeholder:before,.video-js.video-js:lang(en-NZ) .vjs-subs-caps-button .vjs-icon-placeholder:before,.video-js .vjs-subs-caps-button .vjs-icon-placeholder:before,.video-js .vjs-subtitles-button .vjs-icon-placeholder:before,.vjs-icon-subtitles:before{content:"\\F10C"}.video-js .vjs-captions-button .vjs-icon-placeholder,.video-js:lang(en) .vjs-subs-caps-button .vjs-icon-placeholder,.video-js:lang(fr-CA) .vjs-subs-caps-button .vjs-icon-placeholder,.vjs-icon-captions{font-family:VideoJS;font-weight:400;font-style:normal}.video-js .vjs-captions-button .vjs-icon-placeholder:before,.video-js:lang(en) .vjs-subs-caps-button .vjs-icon-placeholder:before,.video-js:lang(fr-CA) .vjs-subs-caps-button .vjs-icon-placeholder:before,.vjs-icon-captions:before{content:"\\F10D"}.video-js .vjs-chapters-button .vjs-icon-placeholder,.vjs-icon-chapters{font-family:VideoJS;font-weight:400;font-style:normal}.video-js .vjs-chapters-button .vjs-icon-placeholder:before,.vjs-icon-chapters:before{content:"\\F10E"}.vjs-icon-share{font-family:VideoJS;font-weight:400;font-style:normal}.vjs-icon-share:before{content:"\\F10F"}.vjs-icon-cog{font-family:VideoJS;font-weight:400;font-style:normal}.vjs-icon-cog:before{content:"\\F110"}.video-js .vjs-play-progress,.video-js .vjs-volume-level,.vjs-icon-circle,.vjs-seek-to-live-control .vjs-icon-placeholder{font-family:VideoJS;font-weight:400;font-style:normal}.video-js .vjs-play-progress:before,.video-js .vjs-volume-level:before,.vjs-icon-circle:before,.vjs-seek-to-live-control .vjs-icon-placeholder:before{content:"\\F111"}.vjs-icon-circle-outline{font-family:VideoJS;font-weight:400;font-style:normal}.vjs-icon-circle-outline:before{content:"\\F112"}.vjs-icon-circle-inner-circle{font-family:VideoJS;font-weight:400;font-style:normal}.vjs-icon-circle-inner-circle:before{content:"\\F113"}.vjs-icon-hd{font-family:VideoJS;font-weight:400;font-style:normal}.vjs-icon-hd:before{content:"\\F114"}.video-js .vjs-control.vjs-close-button .vjs-icon-placeholder,.vjs-icon-cancel{font-family:VideoJS;font-weight:400;font-style:normal}.video-js .vjs-control.vjs-close-button .vjs-icon-placeholder:before,.vjs-icon-cancel:before{content:"\\F115"}.video-js .vjs-play-control.vjs-ended .vjs-icon-placeholder,.vjs-icon-replay{font-family:VideoJS;font-weight:400;font-style:normal}.video-js .vjs-play-control.vjs-ended .vjs-icon-placeholder:before,.vjs-icon-replay:before{content:"\\F116"}.vjs-icon-facebook{font-family:VideoJS;font-weight:400;font-style:normal}.vjs-icon-facebook:before{content:"\\F117"}.vjs-icon-gplus{font-family:VideoJS;font-weight:400;font-style:normal}.vjs-icon-gplus:before{content:"\\F118"}.vjs-icon-linkedin{font-family:VideoJS;font-weight:400;font-style:normal}.vjs-icon-linkedin:before{content:"\\F119"}.vjs-icon-twitter{font-family:VideoJS;font-weight:400;font-style:normal}.vjs-icon-twitter:before{content:"\\F11A"}.vjs-icon-tumblr{font-family:VideoJS;font-weight:400;font-style:normal}.vjs-icon-tumblr:before{content:"\\F11B"}.vjs-icon-pinterest{font-family:VideoJS;font-weight:400;font-style:normal}.vjs-icon-pinterest:before{content:"\\F11C"}.video-js .vjs-descriptions-button .vjs-icon-placeholder,.vjs-icon-audio-description{font-family:VideoJS;font-weight:400;font-style:normal}.video-js .vjs-descriptions-button .vjs-icon-placeholder:before,.vjs-icon-audio-description:before{content:"\\F11D"}.video-js .vjs-audio-button .vjs-icon-placeholder,.vjs-icon-audio{font-family:VideoJS;font-weight:400;font-style:normal}.video-js .vjs-audio-button .vjs-icon-placeholder:before,.vjs-icon-audio:before{content:"\\F11E"}.vjs-icon-next-item{font-family:VideoJS;font-weight:400;font-style:normal}.vjs-icon-next-item:before{content:"\\F11F"}.vjs-icon-previous-item{font-family:VideoJS;font-weight:400;font-style:normal}.vjs-icon-previous-item:before{content:"\\F120"}.video-js .vjs-picture-in-picture-control .vjs-icon-placeholder,.vjs-icon-picture-in-picture-enter{font-family:VideoJS;font-weight:400;font-style:normal}.video-js .vjs-picture-in-picture-control .vjs-icon-placeholder:before,.vjs-icon-picture-in-picture-enter:before{content:"\\F121"}.video-js.vjs-picture-in-picture .vjs-picture-in-picture-control .vjs-icon-placeholder,.vjs-icon-picture-in-picture-exit{font-family:VideoJS;font-weight:400;font-style:normal}.video-js.vjs-picture-in-picture .vjs-picture-in-picture-control .vjs-icon-placeholder:before,.vjs-icon-picture-in-picture-exit:before{content:"\\F122"}.video-js{display:block;vertical-align:top;box-sizing:border-box;color:#fff;background-color:#000;position:relative;padding:0;font-size:10px;line-height:1;font-weight:400;font-style:normal;font-family:Arial,Helvetica,sans-serif;word-break:normal}.video-js:-moz-full-screen{position:absolute}.video-js:-webkit-full-screen{width:100%!important;height:100%!important}.video-js[tabindex="-1"]{outline:none}.video-js *,.video-js :after,.video-js :before{box-sizing:inherit}.video-js ul{font-family:inherit;font-size:inherit;line-height:inherit;list-style-position:outside;margin:0}.video-js.vjs-4-3,.video-js.vjs-16-9,.video-js.vjs-fluid{width:100%;max-width:100%;height:0}.video-js.vjs-16-9{padding-top:56.25%}.video-js.vjs-4-3{padding-top:75%}.video-js.vjs-fill,.video-js .vjs-tech{width:100%;height:100%}.video-js .vjs-tech{position:absolute;top:0;left:0}body.vjs-full-window{padding:0;margin:0;height:100%}.vjs-full-window .video-js.vjs-fullscreen{position:fixed;overflow:hidden;z-index:1000;left:0;top:0;bottom:0;right:0}.video-js.vjs-fullscreen{width:100%!important;height:100%!important;padding-top:0!important}.video-js.vjs-fullscreen.vjs-user-inactive{cursor:none}.vjs-hidden{display:none!important}.vjs-disabled{opacity:.5;cursor:default}.video-js .vjs-offscreen{height:1px;left:-9999px;position:absolute;top:0;width:1px}.vjs-lock-showing{display:block!important;opacity:1;visibility:visible}.vjs-no-js{padding:20px;color:#fff;background-color:#000;font-size:18px;font-family:Arial,Helvetica,sans-serif;text-align:center;width:300px;height:150px;margin:0 auto}.vjs-no-js a,.vjs-no-js a:visited{color:#66a8cc}.video-js .vjs-big-play-button{font-size:3em;line-height:1.5em;height:1.63332em;width:3em;display:block;position:absolute;top:10px;left:10px;padding:0;cursor:pointer;opacity:1;border:.06666em solid #fff;background-color:#2b333f;background-color:rgba(43,51,63,.7);border-radius:.3em;transition:all .4s}.vjs-big-play-centered .vjs-big-play-button{top:50%;left:50%;margin-top:-.81666em;margin-left:-1.5em}.video-js .vjs-big-play-button:focus,.video-js:hover .vjs-big-play-button{border-color:#fff;background-color:#73859f;background-color:rgba(115,133,159,.5);transition:all 0s}.vjs-controls-disabled .vjs-big-play-button,.vjs-error .vjs-big-play-button,.vjs-has-started .vjs-big-play-button,.vjs-using-native-controls .vjs-big-play-button{display:none}.vjs-has-started.vjs-paused.vjs-show-big-play-button-on-pause .vjs-big-play-button{display:block}.video-js button{background:none;border:none;color:inherit;display:inline-block;font-size:inherit;line-height:inherit;text-transform:none;text-decoration:none;transition:none;-webkit-appearance:none;-moz-appearance:none;appearance:none}.vjs-control .vjs-button{width:100%;height:100%}.video-js .vjs-control.vjs-close-button{cursor:pointer;height:3em;position:absolute;right:0;top:.5em;z-index:2}.video-js .vjs-modal-dialog{background:rgba(0,0,0,.8);background:linear-gradient(180deg,rgba(0,0,0,.8),hsla(0,0%,100%,0));overflow:auto}.video-js .vjs-modal-dialog>*{box-sizing:border-box}.vjs-modal-dialog .vjs-modal-dialog-content{font-size:1.2em;line-height:1.5;padding:20px 24px;z-index:1}.vjs-menu-button{cursor:pointer}.vjs-menu-button.vjs-disabled{cursor:default}.vjs-workinghover .vjs-menu-button.vjs-disabled:hover .vjs-menu{display:none}.vjs-menu .vjs-menu-content{display:block;padding:0;margin:0;font-family:Arial,Helvetica,sans-serif;overflow:auto}.vjs-menu .vjs-menu-content>*{box-sizing:border-box}.vjs-scrubbing .vjs-control.vjs-menu-button:hover .vjs-menu{display:none}.vjs-menu li{list-style:none;margin:0;padding:.2em 0;line-height:1.4em;font-size:1.2em;text-align:center;text-transform:lowercase}.js-focus-visible .vjs-menu li.vjs-menu-item:hover,.vjs-menu li.vjs-menu-item:focus,.vjs-menu li.vjs-menu-item:hover{background-color:#73859f;background-color:rgba(115,133,159,.5)}.js-focus-visible .vjs-menu li.vjs-selected:hover,.vjs-menu li.vjs-selected,.vjs-menu li.vjs-selected:focus,.vjs-menu li.vjs-selected:hover{background-color:#fff;color:#2b333f}.vjs-menu li.vjs-menu-title{text-align:center;text-transform:uppercase;font-size:1em;line-height:2em;padding:0;margin:0 0 .3em;font-weight:700;cursor:default}.vjs-menu-button-popup .vjs-menu{display:none;position:absolute;bottom:0;width:10em;left:-3em;height:0;margin-bottom:1.5em;border-top-color:rgba(43,51,63,.7)}.vjs-menu-button-popup .vjs-menu .vjs-menu-content{background-color:#2b333f;background-color:rgba(43,51,63,.7);position:absolute;width:100%;bottom:1.5em;max-height:15em}.vjs-layout-tiny .vjs-menu-button-popup .vjs-menu .vjs-menu-content,.vjs-layout-x-small .vjs-menu-button-popup .vjs-menu .vjs-menu-content{max-height:5em}.vjs-layout-small .vjs-menu-button-popup .vjs-menu .vjs-menu-content{max-height:10em}.vjs-layout-medium .vjs-menu-button-popup .vjs-menu .vjs-menu-content{max-height:14em}.vjs-layout-huge .vjs-menu-button-popup .vjs-menu .vjs-menu-content,.vjs-layout-large .vjs-menu-button-popup .vjs-menu .vjs-menu-content,.vjs-layout-x-large .vjs-menu-button-popup .vjs-menu .vjs-menu-content{max-height:25em}.vjs-menu-button-popup .vjs-menu.vjs-lock-showing,.vjs-workinghover .vjs-menu-button-popup.vjs-hover .vjs-menu{display:block}.video-js .vjs-menu-button-inline{transition:all .4s;overflow:hidden}.video-js .vjs-menu-button-inline:before{width:2.222222222em}.video-js .vjs-menu-button-inline.vjs-slider-active,.video-js .vjs-menu-button-inline:focus,.video-js .vjs-menu-button-inline:hover,.video-js.vjs-no-flex .vjs-menu-button-inline{width:12em}.vjs-menu-button-inline .vjs-menu{opacity:0;height:100%;width:auto;position:absolute;left:4em;top:0;padding:0;margin:0;transition:all .4s}.vjs-menu-button-inline.vjs-slider-active .vjs-menu,.vjs-menu-button-inline:focus .vjs-menu,.vjs-menu-button-inline:hover .vjs-menu{display:block;opacity:1}.vjs-no-flex .vjs-menu-button-inline .vjs-menu{display:block;opacity:1;position:relative;width:auto}.vjs-no-flex .vjs-menu-button-inline.vjs-slider-active .vjs-menu,.vjs-no-flex .vjs-menu-button-inline:focus .vjs-menu,.vjs-no-flex .vjs-menu-button-inline:hover .vjs-menu{width:auto}.vjs-menu-button-inline .vjs-menu-content{width:auto;height:100%;margin:0;overflow:hidden}.video-js .vjs-control-bar{display:none;width:100%;position:absolute;bottom:0;left:0;right:0;height:3em;background-color:#2b333f;background-color:rgba(43,51,63,.7)}.vjs-has-started .vjs-control-bar{display:flex;visibility:visible;opacity:1;transition:visibility .1s,opacity .1s}.vjs-has-started.vjs-user-inactive.vjs-playing .vjs-control-bar{visibility:visible;opacity:0;transition:visibility 1s,opacity 1s}.vjs-controls-disabled .vjs-control-bar,.vjs-error .vjs-control-bar,.vjs-using-native-controls .vjs-control-bar{display:none!important}.vjs-audio.vjs-has-started.vjs-user-inactive.vjs-playing .vjs-control-bar{opacity:1;visibility:visible}.vjs-has-started.vjs-no-flex .vjs-control-bar{display:table}.video-js .vjs-control{position:relative;text-align:center;margin:0;padding:0;height:100%;width:4em;flex:none}.vjs-button>.vjs-icon-placeholder:before{font-size:1.8em;line-height:1.67}.video-js .vjs-control:focus,.video-js .vjs-control:focus:before,.video-js .vjs-control:hover:before{text-shadow:0 0 1em #fff}.video-js .vjs-control-text{border:0;clip:rect(0 0 0 0);height:1px;overflow:hidden;padding:0;position:absolute;width:1px}.vjs-no-flex .vjs-control{display:table-cell;vertical-align:middle}.video-js .vjs-custom-control-spacer{display:none}.video-js .vjs-progress-control{cursor:pointer;flex:auto;display:flex;align-items:center;min-width:4em;touch-action:none}.video-js .vjs-progress-control.disabled{cursor:default}.vjs-live .vjs-progress-control{display:none}.vjs-liveui .vjs-progress-control{display:flex;align-items:center}.vjs-no-flex .vjs-progress-control{width:auto}.video-js .vjs-progress-holder{flex:auto;transition:all .2s;height:.3em}.video-js .vjs-progress-control .vjs-progress-holder{margin:0 10px}.video-js .vjs-progress-control:hover .vjs-progress-holder{font-size:1.6666666667em}.video-js .vjs-progress-control:hover .vjs-progress-holder.disabled{font-size:1em}.video-js .vjs-progress-holder .vjs-load-progress,.video-js .vjs-progress-holder .vjs-load-progress div,.video-js .vjs-progress-holder .vjs-play-progress{position:absolute;display:block;height:100%;margin:0;padding:0;width:0}.video-js .vjs-play-progress{background-color:#fff}.video-js .vjs-play-progress:before{font-size:.9em;position:absolute;right:-.5em;top:-.3333333333em;z-index:1}.video-js .vjs-load-progress{background:rgba(115,133,159,.5)}.video-js .vjs-load-progress div{background:rgba(115,133,159,.75)}.video-js .vjs-time-tooltip{background-color:#fff;background-color:hsla(0,0%,100%,.8);border-radius:.3em;color:#000;float:right;font-family:Arial,Helvetica,sans-serif;font-size:1em;padding:6px 8px 8px;pointer-events:none;position:absolute;top:-3.4em;visibility:hidden;z-index:1}.video-js .vjs-progress-holder:focus .vjs-time-tooltip{display:none}.video-js .vjs-progress-control:hover .vjs-progress-holder:focus .vjs-time-tooltip,.video-js .vjs-progress-control:hover .vjs-time-tooltip{display:block;font-size:.6em;visibility:visible}.video-js .vjs-progress-control.disabled:hover .vjs-time-tooltip{font-size:1em}.video-js .vjs-progress-control .vjs-mouse-display{display:none;position:absolute;width:1px;height:100%;background-color:#000;z-index:1}.vjs-no-flex .vjs-progress-control .vjs-mouse-display{z-index:0}.video-js .vjs-progress-control:hover .vjs-mouse-display{display:block}.video-js.vjs-user-inactive .vjs-progress-control .vjs-mouse-display{visibility:hidden;opacity:0;transition:visibility 1s,opacity 1s}.video-js.vjs-user-inactive.vjs-no-flex .vjs-progress-control .vjs-mouse-display{display:none}.vjs-mouse-display .vjs-time-tooltip{color:#fff;background-color:#000;background-color:rgba(0,0,0,.8)}.video-js .vjs-slider{position:relative;cursor:pointer;padding:0;margin:0 .45em;-webkit-touch-callout:none;-webkit-user-select:none;-moz-user-select:none;-ms-user-select:none;user-select:none;background-color:#73859f;background-color:rgba(115,133,159,.5)}.video-js .vjs-slider.disabled{cursor:default}.video-js .vjs-slider:focus{text-shadow:0 0 1em #fff;box-shadow:0 0 1em #fff}.video-js .vjs-mute-control{cursor:pointer;flex:none}.video-js .vjs-volume-control{cursor:pointer;margin-right:1em;display:flex}.video-js .vjs-volume-control.vjs-volume-horizontal{width:5em}.video-js .vjs-volume-panel .vjs-volume-control{visibility:visible;opacity:0;width:1px;height:1px;margin-left:-1px}.video-js .vjs-volume-panel{transition:width 1s}.video-js .vjs-volume-panel.vjs-hover .vjs-mute-control~.vjs-volume-control,.video-js .vjs-volume-panel.vjs-hover .vjs-volume-control,.video-js .vjs-volume-panel .vjs-volume-control.vjs-slider-active,.video-js .vjs-volume-panel .vjs-volume-control:active,.video-js .vjs-volume-panel:active .vjs-volume-control,.video-js .vjs-volume-panel:focus .vjs-volume-control{visibility:visible;opacity:1;position:relative;transition:visibility .1s,opacity .1s,height .1s,width .1s,left 0s,top 0s}.video-js .vjs-volume-panel.vjs-hover .vjs-mute-control~.vjs-volume-control.vjs-volume-horizontal,.video-js .vjs-volume-panel.vjs-hover .vjs-volume-control.vjs-volume-horizontal,.video-js .vjs-volume-panel .vjs-volume-control.vjs-slider-active.vjs-volume-horizontal,.video-js .vjs-volume-panel .vjs-volume-control:active.vjs-volume-horizontal,.video-js .vjs-volume-panel:active .vjs-volume-control.vjs-volume-horizontal,.video-js .vjs-volume-panel:focus .vjs-volume-control.vjs-volume-horizontal{width:5em;height:3em}.video-js .vjs-volume-panel.vjs-hover .vjs-mute-control~.vjs-volume-control.vjs-volume-vertical,.video-js .vjs-volume-panel.vjs-hover .vjs-volume-control.vjs-volume-vertical,.video-js .vjs-volume-panel .vjs-volume-control.vjs-slider-active.vjs-volume-vertical,.video-js .vjs-volume-panel .vjs-volume-control:active.vjs-volume-vertical,.video-js .vjs-volume-panel:active .vjs-volume-control.vjs-volume-vertical,.video-js .vjs-volume-panel:focus .vjs-volume-control.vjs-volume-vertical{left:-3.5em;transition:left 0s}.video-js .vjs-volume-panel.vjs-volume-panel-horizontal.vjs-hover,.video-js .vjs-volume-panel.vjs-volume-panel-horizontal.vjs-slider-active,.video-js .vjs-volume-panel.vjs-volume-panel-horizontal:active{width:9em;transition:width .1s}.video-js .vjs-volume-panel.vjs-volume-panel-horizontal.vjs-mute-toggle-only{width:4em}.video-js .vjs-volume-panel .vjs-volume-control.vjs-volume-vertical{height:8em;width:3em;left:-3000em;transition:visibility 1s,opacity 1s,height 1s 1s,width 1s 1s,left 1s 1s,top 1s 1s}.video-js .vjs-volume-panel .vjs-volume-control.vjs-volume-horizontal{transition:visibility 1s,opacity 1s,height 1s 1s,width 1s,left 1s 1s,top 1s 1s}.video-js.vjs-no-flex .vjs-volume-panel .vjs-volume-control.vjs-volume-horizontal{width:5em;height:3em;visibility:visible;opacity:1;position:relative;transition:none}.video-js.vjs-no-flex .vjs-volume-control.vjs-volume-vertical,.video-js.vjs-no-flex .vjs-volume-panel .vjs-volume-control.vjs-volume-vertical{position:absolute;bottom:3em;left:.5em}.video-js .vjs-volume-panel{display:flex}.video-js .vjs-volume-bar{margin:1.35em .45em}.vjs-volume-bar.vjs-slider-horizontal{width:5em;height:.3em}.vjs-volume-bar.vjs-slider-vertical{width:.3em;height:5em;margin:1.35em auto}.video-js .vjs-volume-level{position:absolute;bottom:0;left:0;background-color:#fff}.video-js .vjs-volume-level:before{position:absolute;font-size:.9em}.vjs-slider-vertical .vjs-volume-level{width:.3em}.vjs-slider-vertical .vjs-volume-level:before{top:-.5em;left:-.3em}.vjs-slider-horizontal .vjs-volume-level{height:.3em}.vjs-slider-horizontal .vjs-volume-level:before{top:-.3em;right:-.5em}.video-js .vjs-volume-panel.vjs-volume-panel-vertical{width:4em}.vjs-volume-bar.vjs-slider-vertical .vjs-volume-level{height:100%}.vjs-volume-bar.vjs-slider-horizontal .vjs-volume-level{width:100%}.video-js .vjs-volume-vertical{width:3em;height:8em;bottom:8em;background-color:#2b333f;background-color:rgba(43,51,63,.7)}.video-js .vjs-volume-horizontal .vjs-menu{left:-2em}.vjs-poster{display:inline-block;vertical-align:middle;background-repeat:no-repeat;background-position:50% 50%;background-size:contain;background-color:#000;cursor:pointer;margin:0;padding:0;position:absolute;top:0;right:0;bottom:0;left:0;height:100%}.vjs-has-started .vjs-poster{display:none}.vjs-audio.vjs-has-started .vjs-poster{display:block}.vjs-using-native-controls .vjs-poster{display:none}.video-js .vjs-live-control{display:flex;align-items:flex-start;flex:auto;font-size:1em;line-height:3em}.vjs-no-flex .vjs-live-control{display:table-cell;width:auto;text-align:left}.video-js.vjs-liveui .vjs-live-control,.video-js:not(.vjs-live) .vjs-live-control{display:none}.video-js .vjs-seek-to-live-control{cursor:pointer;flex:none;display:inline-flex;height:100%;padding-left:.5em;padding-right:.5em;font-size:1em;line-height:3em;width:auto;min-width:4em}.vjs-no-flex .vjs-seek-to-live-control{display:table-cell;width:auto;text-align:left}.video-js.vjs-live:not(.vjs-liveui) .vjs-seek-to-live-control,.video-js:not(.vjs-live) .vjs-seek-to-live-control{display:none}.vjs-seek-to-live-control.vjs-control.vjs-at-live-edge{cursor:auto}.vjs-seek-to-live-control .vjs-icon-placeholder{margin-right:.5em;color:#888}.vjs-seek-to-live-control.vjs-control.vjs-at-live-edge .vjs-icon-placeholder{color:red}.video-js .vjs-time-control{flex:none;font-size:1em;line-height:3em;min-width:2em;width:auto;padding-left:1em;padding-right:1em}.video-js .vjs-current-time,.video-js .vjs-duration,.vjs-live .vjs-time-control,.vjs-no-flex .vjs-current-time,.vjs-no-flex .vjs-duration{display:none}.vjs-time-divider{display:none;line-height:3em}.vjs-live .vjs-time-divider{display:none}.video-js .vjs-play-control{cursor:pointer}.video-js .vjs-play-control .vjs-icon-placeholder{flex:none}.vjs-text-track-display{position:absolute;bottom:3em;left:0;right:0;top:0;pointer-events:none}.video-js.vjs-user-inactive.vjs-playing .vjs-text-track-display{bottom:1em}.video-js .vjs-text-track{font-size:1.4em;text-align:center;margin-bottom:.1em}.vjs-subtitles{color:#fff}.vjs-captions{color:#fc6}.vjs-tt-cue{display:block}video::-webkit-media-text-track-display{transform:translateY(-3em)}.video-js.vjs-user-inactive.vjs-playing video::-webkit-media-text-track-display{transform:translateY(-1.5em)}.video-js .vjs-fullscreen-control,.video-js .vjs-picture-in-picture-control{cursor:pointer;flex:none}.vjs-playback-rate .vjs-playback-rate-value,.vjs-playback-rate>.vjs-menu-button{position:absolute;top:0;left:0;width:100%;height:100%}.vjs-playback-rate .vjs-playback-rate-value{pointer-events:none;font-size:1.5em;line-height:2;text-align:center}.vjs-playback-rate .vjs-menu{width:4em;left:0}.vjs-error .vjs-error-display .vjs-modal-dialog-content{font-size:1.4em;text-align:center}.vjs-error .vjs-error-display:before{color:#fff;content:"X";font-family:Arial,Helvetica,sans-serif;font-size:4em;left:0;line-height:1;margin-top:-.5em;position:absolute;text-shadow:.05em .05em .1em #000;text-align:center;top:50%;vertical-align:middle;width:100%}.vjs-loading-spinner{display:none;position:absolute;top:50%;left:50%;margin:-25px 0 0 -25px;opacity:.85;text-align:left;border:6px solid rgba(43,51,63,.7);box-sizing:border-box;background-clip:padding-box;width:50px;height:50px;border-radius:25px;visibility:hidden}.vjs-seeking .vjs-loading-spinner,.vjs-waiting .vjs-loading-spinner{display:block;-webkit-animation:vjs-spinner-show 0s linear .3s forwards;animation:vjs-spinner-show 0s linear .3s forwards}.vjs-loading-spinner:after,.vjs-loading-spinner:before{content:"";position:absolute;margin:-6px;box-sizing:inherit;width:inherit;height:inherit;border-radius:inherit;opacity:1;border:inherit;border-color:transparent;border-top-color:#fff}.vjs-seeking .vjs-loading-spinner:after,.vjs-seeking .vjs-loading-spinner:before,.vjs-waiting .vjs-loading-spinner:after,.vjs-waiting .vjs-loading-spinner:before{-webkit-animation:vjs-spinner-spin 1.1s cubic-bezier(.6,.2,0,.8) infinite,vjs-spinner-fade 1.1s linear infinite;animation:vjs-spinner-spin 1.1s cubic-bezier(.6,.2,0,.8) infinite,vjs-spinner-fade 1.1s linear infinite}.vjs-seeking .vjs-loading-spinner:before,.vjs-waiting .vjs-loading-spinner:before{border-top-color:#fff}.vjs-seeking .vjs-loading-spinner:after,.vjs-waiting .vjs-loading-spinner:after{border-top-color:#fff;-webkit-animation-delay:.44s;animation-delay:.44s}@keyframes vjs-spinner-show{to{visibility:visible}}@-webkit-keyframes vjs-spinner-show{to{visibility:visible}}@keyframes vjs-spinner-spin{to{transform:rotate(1turn)}}@-webkit-keyframes vjs-spinner-spin{to{-webkit-transform:rotate(1turn)}}@keyframes vjs-spinner-fade{0%{border-top-color:#73859f}20%{border-top-color:#73859f}35%{border-top-color:#fff}60%{border-top-color:#73859f}to{border-top-color:#73859f}}@-webkit-keyframes vjs-spinner-fade{0%{border-top-color:#73859f}20%{border-top-color:#73859f}35%{border-top-color:#fff}60%{border-top-color:#73859f}to{border-top-color:#73859f}}.vjs-chapters-button .vjs-menu ul{width:24em}.video-js .vjs-subs-caps-button+.vjs-menu .vjs-captions-menu-item .vjs-menu-item-text .vjs-icon-placeholder{vertical-align:middle;display:inline-block;margin-bottom:-.1em}.video-js .vjs-subs-caps-button+.vjs-menu .vjs-captions-menu-item .vjs-menu-item-text .vjs-icon-placeholder:before{font-family:VideoJS;content:"\\F10D";font-size:1.5em;line-height:inherit}.video-js .vjs-audio-button+.vjs-menu .vjs-main-desc-menu-item .vjs-menu-item-text .vjs-icon-placeholder{vertical-align:middle;display:inline-block;margin-bottom:-.1em}.video-js .vjs-audio-button+.vjs-menu .vjs-main-desc-menu-item .vjs-menu-item-text .vjs-icon-placeholder:before{font-family:VideoJS;content:" \\F11D";font-size:1.5em;line-height:inherit}.video-js:not(.vjs-fullscreen).vjs-layout-small .vjs-audio-button,.video-js:not(.vjs-fullscreen).vjs-layout-small .vjs-captions-button,.video-js:not(.vjs-fullscreen).vjs-layout-small .vjs-chapters-button,.video-js:not(.vjs-fullscreen).vjs-layout-small .vjs-current-time,.video-js:not(.vjs-fullscreen).vjs-layout-small .vjs-descriptions-button,.video-js:not(.vjs-fullscreen).vjs-layout-small .vjs-duration,.video-js:not(.vjs-fullscreen).vjs-layout-small .vjs-playback-rate,.video-js:not(.vjs-fullscreen).vjs-layout-small .vjs-remaining-time,.video-js:not(.vjs-fullscreen).vjs-layout-small .vjs-subtitles-button,.video-js:not(.vjs-fullscreen).vjs-layout-small .vjs-time-divider,.video-js:not(.vjs-fullscreen).vjs-layout-small .vjs-volume-control,.video-js:not(.vjs-fullscreen).vjs-layout-tiny .vjs-audio-button,.video-js:not(.vjs-fullscreen).vjs-layout-tiny .vjs-captions-button,.video-js:not(.vjs-fullscreen).vjs-layout-tiny .vjs-chapters-button,.video-js:not(.vjs-fullscreen).vjs-layout-tiny .vjs-current-time,.video-js:not(.vjs-fullscreen).vjs-layout-tiny .vjs-descriptions-button,.video-js:not(.vjs-fullscreen).vjs-layout-tiny .vjs-duration,.video-js:not(.vjs-fullscreen).vjs-layout-tiny .vjs-playback-rate,.video-js:not(.vjs-fullscreen).vjs-layout-tiny .vjs-remaining-time,.video-js:not(.vjs-fullscreen).vjs-layout-tiny .vjs-subtitles-button,.video-js:not(.vjs-fullscreen).vjs-layout-tiny .vjs-time-divider,.video-js:not(.vjs-fullscreen).vjs-layout-tiny .vjs-volume-control,.video-js:not(.vjs-fullscreen).vjs-layout-x-small .vjs-audio-button,.video-js:not(.vjs-fullscreen).vjs-layout-x-small .vjs-captions-button,.video-js:not(.vjs-fullscreen).vjs-layout-x-small .vjs-chapters-button,.video-js:not(.vjs-fullscreen).vjs-layout-x-small .vjs-current-time,.video-js:not(.vjs-fullscreen).vjs-layout-x-small .vjs-descriptions-button,.video-js:not(.vjs-fullscreen).vjs-layout-x-small .vjs-duration,.video-js:not(.vjs-fullscreen).vjs-layout-x-small .vjs-playback-rate,.video-js:not(.vjs-fullscreen).vjs-layout-x-small .vjs-remaining-time,.video-js:not(.vjs-fullscreen).vjs-layout-x-small .vjs-subtitles-button,.video-js:not(.vjs-fullscreen).vjs-layout-x-small .vjs-time-divider,.video-js:not(.vjs-fullscreen).vjs-layout-x-small .vjs-volume-control{display:none}.video-js:not(.vjs-fullscreen).vjs-layout-small .vjs-volume-panel.vjs-volume-panel-horizontal.vjs-slider-active,.video-js:not(.vjs-fullscreen).vjs-layout-small .vjs-volume-panel.vjs-volume-panel-horizontal:active,.video-js:not(.vjs-fullscreen).vjs-layout-small .vjs-volume-panel.vjs-volume-panel-horizontal:hover,.video-js:not(.vjs-fullscreen).vjs-layout-tiny .vjs-volume-panel.vjs-volume-panel-horizontal.vjs-slider-active,.video-js:not(.vjs-fullscreen).vjs-layout-tiny .vjs-volume-panel.vjs-volume-panel-horizontal:active,.video-js:not(.vjs-fullscreen).vjs-layout-tiny .vjs-volume-panel.vjs-volume-panel-horizontal:hover,.video-js:not(.vjs-fullscreen).vjs-layout-x-small .vjs-volume-panel.vjs-volume-panel-horizontal.vjs-slider-active,.video-js:not(.vjs-fullscreen).vjs-layout-x-small .vjs-volume-panel.vjs-volume-panel-horizontal:active,.video-js:not(.vjs-fullscreen).vjs-layout-x-small .vjs-volume-panel.vjs-volume-panel-horizontal:hover{width:auto}.video-js:not(.vjs-fullscreen).vjs-layout-tiny .vjs-subs-caps-button,.video-js:not(.vjs-fullscreen).vjs-layout-x-small:not(.vjs-live) .vjs-subs-caps-button,.video-js:not(.vjs-fullscreen).vjs-layout-x-small:not(.vjs-liveui) .vjs-subs-caps-button{display:none}.video-js:not(.vjs-fullscreen).vjs-layout-tiny .vjs-custom-control-spacer,.video-js:not(.vjs-fullscreen).vjs-layout-x-small.vjs-liveui .vjs-custom-control-spacer{flex:auto;display:block}.video-js:not(.vjs-fullscreen).vjs-layout-tiny.vjs-no-flex .vjs-custom-control-spacer,.video-js:not(.vjs-fullscreen).vjs-layout-x-small.vjs-liveui.vjs-no-flex .vjs-custom-control-spacer{width:auto}.video-js:not(.vjs-fullscreen).vjs-layout-tiny .vjs-progress-control,.video-js:not(.vjs-fullscreen).vjs-layout-x-small.vjs-liveui .vjs-progress-control{display:none}.vjs-modal-dialog.vjs-text-track-settings{background-color:#2b333f;background-color:rgba(43,51,63,.75);color:#fff;height:70%}.vjs-text-track-settings .vjs-modal-dialog-content{display:table}.vjs-text-track-settings .vjs-track-settings-colors,.vjs-text-track-settings .vjs-track-settings-controls,.vjs-text-track-settings .vjs-track-settings-font{display:table-cell}.vjs-text-track-settings .vjs-track-settings-controls{text-align:right;vertical-align:bottom}@supports (display:grid){.vjs-text-track-settings .vjs-modal-dialog-content{display:grid;grid-template-columns:1fr 1fr;grid-template-rows:1fr;padding:20px 24px 0}.vjs-track-settings-controls .vjs-default-button{margin-bottom:20px}.vjs-text-track-settings .vjs-track-settings-controls{grid-column:1/-1}.vjs-layout-small .vjs-text-track-settings .vjs-modal-dialog-content,.vjs-layout-tiny .vjs-text-track-settings .vjs-modal-dialog-content,.vjs-layout-x-small .vjs-text-track-settings .vjs-modal-dialog-content{grid-template-columns:1fr}}.vjs-track-setting>select{margin-right:1em;margin-bottom:.5em}.vjs-text-track-settings fieldset{margin:5px;padding:3px;border:none}.vjs-text-track-settings fieldset span{display:inline-block}.vjs-text-track-settings fieldset span>select{max-width:7.3em}.vjs-text-track-settings legend{color:#fff;margin:0 0 5px}.vjs-text-track-settings .vjs-label{position:absolute;clip:rect(1px 1px 1px 1px);clip:rect(1px,1px,1px,1px);display:block;margin:0 0 5px;padding:0;border:0;height:1px;width:1px;overflow:hidden}.vjs-track-settings-controls button:active,.vjs-track-settings-controls button:focus{outline-style:solid;outline-width:medium;background-image:linear-gradient(0deg,#fff 88%,#73859f)}.vjs-track-settings-controls button:hover{color:rgba(43,51,63,.75)}.vjs-track-settings-controls button{background-color:#fff;background-image:linear-gradient(-180deg,#fff 88%,#73859f);color:#2b333f;cursor:pointer;border-radius:2px}.vjs-track-settings-controls .vjs-default-button{margin-right:1em}@media print{.video-js>:not(.vjs-tech):not(.vjs-poster){visibility:hidden}}.vjs-resize-manager{position:absolute;top:0;left:0;width:100%;height:100%;border:none;z-index:-1000}.js-focus-visible .video-js :focus:not(.focus-visible),.video-js .vjs-menu :focus:not(:focus-visible),.video-js :focus:not(:focus-visible){outline:none;background:none}',""])},"8eWk":function(t,e,n){var o=n("LboF"),r=n("qFNJ");"string"==typeof(r=r.__esModule?r.default:r)&&(r=[[t.i,r,""]]),o(r,{insert:"head",singleton:!1}),t.exports=r.locals||{}},I1BE:function(t,e){t.exports=function(t){var e=[];return e.toString=function(){return this.map(function(e){var n=function(t,e){var n=t[1]||"",o=t[3];if(!o)return n;if(e&&"function"==typeof btoa){var r=(i=o,"/*# sourceMappingURL=data:application/json;charset=utf-8;base64,"+btoa(unescape(encodeURIComponent(JSON.stringify(i))))+" */");return[n].concat(o.sources.map(function(t){return"/*# sourceURL="+o.sourceRoot+t+" */"})).concat([r]).join("\n")}var i;return[n].join("\n")}(e,t);return e[2]?"@media "+e[2]+"{"+n+"}":n}).join("")},e.i=function(t,n){"string"==typeof t&&(t=[[null,t,""]]);for(var o={},r=0;r<this.length;r++){var i=this[r][0];"number"==typeof i&&(o[i]=!0)}for(r=0;r<t.length;r++){var a=t[r];"number"==typeof a[0]&&o[a[0]]||(n&&!a[2]?a[2]=n:n&&(a[2]="("+a[2]+") and ("+n+")"),e.push(a))}},e}},Iab2:function(t,e,n){(function(n){var o,r,i;r=[],void 0===(i="function"==typeof(o=function(){"use strict";function e(t,e){return void 0===e?e={autoBom:!1}:"object"!=typeof e&&(console.warn("Deprecated: Expected third argument to be a object"),e={autoBom:!e}),e.autoBom&&/^\s*(?:text\/\S*|application\/xml|\S*\/\S*\+xml)\s*;.*charset\s*=\s*utf-8/i.test(t.type)?new Blob(["\ufeff",t],{type:t.type}):t}function o(t,e,n){var o=new XMLHttpRequest;o.open("GET",t),o.responseType="blob",o.onload=function(){s(o.response,e,n)},o.onerror=function(){console.error("could not download file")},o.send()}function r(t){var e=new XMLHttpRequest;return e.open("HEAD",t,!1),e.send(),200<=e.status&&299>=e.status}function i(t){try{t.dispatchEvent(new MouseEvent("click"))}catch(n){var e=document.createEvent("MouseEvents");e.initMouseEvent("click",!0,!0,window,0,0,0,80,20,!1,!1,!1,!1,0,null),t.dispatchEvent(e)}}var a="object"==typeof window&&window.window===window?window:"object"==typeof self&&self.self===self?self:"object"==typeof n&&n.global===n?n:void 0,s=a.saveAs||("object"!=typeof window||window!==a?function(){}:"download"in HTMLAnchorElement.prototype?function(t,e,n){var s=a.URL||a.webkitURL,l=document.createElement("a");e=e||t.name||"download",l.download=e,l.rel="noopener","string"==typeof t?(l.href=t,l.origin===location.origin?i(l):r(l.href)?o(t,e,n):i(l,l.target="_blank")):(l.href=s.createObjectURL(t),setTimeout(function(){s.revokeObjectURL(l.href)},4e4),setTimeout(function(){i(l)},0))}:"msSaveOrOpenBlob"in navigator?function(t,n,a){if(n=n||t.name||"download","string"!=typeof t)navigator.msSaveOrOpenBlob(e(t,a),n);else if(r(t))o(t,n,a);else{var s=document.createElement("a");s.href=t,s.target="_blank",setTimeout(function(){i(s)})}}:function(t,e,n,r){if((r=r||open("","_blank"))&&(r.document.title=r.document.body.innerText="downloading..."),"string"==typeof t)return o(t,e,n);var i="application/octet-stream"===t.type,s=/constructor/i.test(a.HTMLElement)||a.safari,l=/CriOS\/[\d]+/.test(navigator.userAgent);if((l||i&&s)&&"object"==typeof FileReader){var u=new FileReader;u.onloadend=function(){var t=u.result;t=l?t:t.replace(/^data:[^;]*;/,"data:attachment/file;"),r?r.location.href=t:location=t,r=null},u.readAsDataURL(t)}else{var c=a.URL||a.webkitURL,d=c.createObjectURL(t);r?r.location=d:location.href=d,r=null,setTimeout(function(){c.revokeObjectURL(d)},4e4)}});a.saveAs=s.saveAs=s,t.exports=s})?o.apply(e,r):o)||(t.exports=i)}).call(this,n("yLpj"))},LboF:function(t,e,n){"use strict";function o(t){for(var e=-1,n=0;n<v.length;n++)if(v[n].identifier===t){e=n;break}return e}function r(t,e){for(var n={},r=[],i=0;i<t.length;i++){var a=t[i],s=e.base?a[0]+e.base:a[0],u=n[s]||0,c="".concat(s," ").concat(u);n[s]=u+1;var d=o(c),f={css:a[1],media:a[2],sourceMap:a[3]};-1!==d?(v[d].references++,v[d].updater(f)):v.push({identifier:c,updater:l(f,e),references:1}),r.push(c)}return r}function i(t){var e=document.createElement("style"),o=t.attributes||{};if(void 0===o.nonce){var r=n.nc;r&&(o.nonce=r)}if(Object.keys(o).forEach(function(t){e.setAttribute(t,o[t])}),"function"==typeof t.insert)t.insert(e);else{var i=f(t.insert||"head");if(!i)throw new Error("Couldn't find a style target. This probably means that the value for the 'insert' parameter is invalid.");i.appendChild(e)}return e}function a(t,e,n,o){var r=n?"":o.media?"@media ".concat(o.media," {").concat(o.css,"}"):o.css;if(t.styleSheet)t.styleSheet.cssText=p(e,r);else{var i=document.createTextNode(r),a=t.childNodes;a[e]&&t.removeChild(a[e]),a.length?t.insertBefore(i,a[e]):t.appendChild(i)}}function s(t,e,n){var o=n.css,r=n.media,i=n.sourceMap;if(r?t.setAttribute("media",r):t.removeAttribute("media"),i&&btoa&&(o+="\n/*# sourceMappingURL=data:application/json;base64,".concat(btoa(unescape(encodeURIComponent(JSON.stringify(i))))," */")),t.styleSheet)t.styleSheet.cssText=o;else{for(;t.firstChild;)t.removeChild(t.firstChild);t.appendChild(document.createTextNode(o))}}function l(t,e){var n,o,r;if(e.singleton){var l=m++;n=h||(h=i(e)),o=a.bind(null,n,l,!1),r=a.bind(null,n,l,!0)}else n=i(e),o=s.bind(null,n,e),r=function(){!function(t){if(null===t.parentNode)return!1;t.parentNode.removeChild(t)}(n)};return o(t),function(e){if(e){if(e.css===t.css&&e.media===t.media&&e.sourceMap===t.sourceMap)return;o(t=e)}else r()}}var u,c,d=function(){return void 0===u&&(u=Boolean(window&&document&&document.all&&!window.atob)),u},f=function(){var t={};return function(e){if(void 0===t[e]){var n=document.querySelector(e);if(window.HTMLIFrameElement&&n instanceof window.HTMLIFrameElement)try{n=n.contentDocument.head}catch(t){n=null}t[e]=n}return t[e]}}(),v=[],p=(c=[],function(t,e){return c[t]=e,c.filter(Boolean).join("\n")}),h=null,m=0;t.exports=function(t,e){(e=e||{}).singleton||"boolean"==typeof e.singleton||(e.singleton=d());var n=r(t=t||[],e);return function(t){if(t=t||[],"[object Array]"===Object.prototype.toString.call(t)){for(var i=0;i<n.length;i++){var a=o(n[i]);v[a].references--}for(var s=r(t,e),l=0;l<n.length;l++){var u=o(n[l]);0===v[u].references&&(v[u].updater(),v.splice(u,1))}n=s}}}},LvDl:function(t,e,n){(function(t,o){var r;(function(){function i(t,e,n){switch(n.length){case 0:return t.call(e);case 1:return t.call(e,n[0]);case 2:return t.call(e,n[0],n[1]);case 3:return t.call(e,n[0],n[1],n[2])}return t.apply(e,n)}function a(t,e,n,o){for(var r=-1,i=null==t?0:t.length;++r<i;){var a=t[r];e(o,a,n(a),t)}return o}function s(t,e){for(var n=-1,o=null==t?0:t.length;++n<o&&!1!==e(t[n],n,t););return t}function l(t,e){for(var n=null==t?0:t.length;n--&&!1!==e(t[n],n,t););return t}function u(t,e){for(var n=-1,o=null==t?0:t.length;++n<o;)if(!e(t[n],n,t))return!1;return!0}function c(t,e){for(var n=-1,o=null==t?0:t.length,r=0,i=[];++n<o;){var a=t[n];e(a,n,t)&&(i[r++]=a)}return i}function d(t,e){return!!(null==t?0:t.length)&&_(t,e,0)>-1}function f(t,e,n){for(var o=-1,r=null==t?0:t.length;++o<r;)if(n(e,t[o]))return!0;return!1}function v(t,e){for(var n=-1,o=null==t?0:t.length,r=Array(o);++n<o;)r[n]=e(t[n],n,t);return r}function p(t,e){for(var n=-1,o=e.length,r=t.length;++n<o;)t[r+n]=e[n];return t}function h(t,e,n,o){var r=-1,i=null==t?0:t.length;for(o&&i&&(n=t[++r]);++r<i;)n=e(n,t[r],r,t);return n}function m(t,e,n,o){var r=null==t?0:t.length;for(o&&r&&(n=t[--r]);r--;)n=e(n,t[r],r,t);return n}function g(t,e){for(var n=-1,o=null==t?0:t.length;++n<o;)if(e(t[n],n,t))return!0;return!1}function b(t,e,n){var o;return n(t,function(t,n,r){if(e(t,n,r))return o=n,!1}),o}function y(t,e,n,o){for(var r=t.length,i=n+(o?1:-1);o?i--:++i<r;)if(e(t[i],i,t))return i;return-1}function _(t,e,n){return e==e?function(t,e,n){for(var o=n-1,r=t.length;++o<r;)if(t[o]===e)return o;return-1}(t,e,n):y(t,j,n)}function w(t,e,n,o){for(var r=n-1,i=t.length;++r<i;)if(o(t[r],e))return r;return-1}function j(t){return t!=t}function x(t,e){var n=null==t?0:t.length;return n?S(t,e)/n:ot}function C(t){return function(e){return null==e?q:e[t]}}function k(t){return function(e){return null==t?q:t[e]}}function D(t,e,n,o,r){return r(t,function(t,r,i){n=o?(o=!1,t):e(n,t,r,i)}),n}function S(t,e){for(var n,o=-1,r=t.length;++o<r;){var i=e(t[o]);i!==q&&(n=n===q?i:n+i)}return n}function I(t,e){for(var n=-1,o=Array(t);++n<t;)o[n]=e(n);return o}function T(t){return t?t.slice(0,H(t)+1).replace(Jt,""):t}function P(t){return function(e){return t(e)}}function L(t,e){return v(e,function(e){return t[e]})}function A(t,e){return t.has(e)}function M(t,e){for(var n=-1,o=t.length;++n<o&&_(e,t[n],0)>-1;);return n}function R(t,e){for(var n=t.length;n--&&_(e,t[n],0)>-1;);return n}function $(t,e){for(var n=t.length,o=0;n--;)t[n]===e&&++o;return o}function z(t){return"\\"+Ve[t]}function O(t){return Oe.test(t)}function N(t){var e=-1,n=Array(t.size);return t.forEach(function(t,o){n[++e]=[o,t]}),n}function F(t,e){return function(n){return t(e(n))}}function U(t,e){for(var n=-1,o=t.length,r=0,i=[];++n<o;){var a=t[n];a!==e&&a!==X||(t[n]=X,i[r++]=n)}return i}function E(t){var e=-1,n=Array(t.size);return t.forEach(function(t){n[++e]=t}),n}function B(t){var e=-1,n=Array(t.size);return t.forEach(function(t){n[++e]=[t,t]}),n}function V(t){return O(t)?function(t){for(var e=$e.lastIndex=0;$e.test(t);)++e;return e}(t):sn(t)}function Y(t){return O(t)?function(t){return t.match($e)||[]}(t):function(t){return t.split("")}(t)}function H(t){for(var e=t.length;e--&&Xt.test(t.charAt(e)););return e}var q,W="Expected a function",J="__lodash_hash_undefined__",X="__lodash_placeholder__",Z=16,G=32,Q=64,K=128,tt=256,et=1/0,nt=9007199254740991,ot=NaN,rt=4294967295,it=[["ary",K],["bind",1],["bindKey",2],["curry",8],["curryRight",Z],["flip",512],["partial",G],["partialRight",Q],["rearg",tt]],at="[object Arguments]",st="[object Array]",lt="[object Boolean]",ut="[object Date]",ct="[object Error]",dt="[object Function]",ft="[object GeneratorFunction]",vt="[object Map]",pt="[object Number]",ht="[object Object]",mt="[object Promise]",gt="[object RegExp]",bt="[object Set]",yt="[object String]",_t="[object Symbol]",wt="[object WeakMap]",jt="[object ArrayBuffer]",xt="[object DataView]",Ct="[object Float32Array]",kt="[object Float64Array]",Dt="[object Int8Array]",St="[object Int16Array]",It="[object Int32Array]",Tt="[object Uint8Array]",Pt="[object Uint8ClampedArray]",Lt="[object Uint16Array]",At="[object Uint32Array]",Mt=/\b__p \+= '';/g,Rt=/\b(__p \+=) '' \+/g,$t=/(__e\(.*?\)|\b__t\)) \+\n'';/g,zt=/&(?:amp|lt|gt|quot|#39);/g,Ot=/[&<>"']/g,Nt=RegExp(zt.source),Ft=RegExp(Ot.source),Ut=/<%-([\s\S]+?)%>/g,Et=/<%([\s\S]+?)%>/g,Bt=/<%=([\s\S]+?)%>/g,Vt=/\.|\[(?:[^[\]]*|(["'])(?:(?!\1)[^\\]|\\.)*?\1)\]/,Yt=/^\w*$/,Ht=/[^.[\]]+|\[(?:(-?\d+(?:\.\d+)?)|(["'])((?:(?!\2)[^\\]|\\.)*?)\2)\]|(?=(?:\.|\[\])(?:\.|\[\]|$))/g,qt=/[\\^$.*+?()[\]{}|]/g,Wt=RegExp(qt.source),Jt=/^\s+/,Xt=/\s/,Zt=/\{(?:\n\/\* \[wrapped with .+\] \*\/)?\n?/,Gt=/\{\n\/\* \[wrapped with (.+)\] \*/,Qt=/,? & /,Kt=/[^\x00-\x2f\x3a-\x40\x5b-\x60\x7b-\x7f]+/g,te=/[()=,{}\[\]\/\s]/,ee=/\\(\\)?/g,ne=/\$\{([^\\}]*(?:\\.[^\\}]*)*)\}/g,oe=/\w*$/,re=/^[-+]0x[0-9a-f]+$/i,ie=/^0b[01]+$/i,ae=/^\[object .+?Constructor\]$/,se=/^0o[0-7]+$/i,le=/^(?:0|[1-9]\d*)$/,ue=/[\xc0-\xd6\xd8-\xf6\xf8-\xff\u0100-\u017f]/g,ce=/($^)/,de=/['\n\r\u2028\u2029\\]/g,fe="\\u0300-\\u036f\\ufe20-\\ufe2f\\u20d0-\\u20ff",ve="a-z\\xdf-\\xf6\\xf8-\\xff",pe="A-Z\\xc0-\\xd6\\xd8-\\xde",he="\\xac\\xb1\\xd7\\xf7\\x00-\\x2f\\x3a-\\x40\\x5b-\\x60\\x7b-\\xbf\\u2000-\\u206f \\t\\x0b\\f\\xa0\\ufeff\\n\\r\\u2028\\u2029\\u1680\\u180e\\u2000\\u2001\\u2002\\u2003\\u2004\\u2005\\u2006\\u2007\\u2008\\u2009\\u200a\\u202f\\u205f\\u3000",me="["+he+"]",ge="["+fe+"]",be="["+ve+"]",ye="[^\\ud800-\\udfff"+he+"\\d+\\u2700-\\u27bf"+ve+pe+"]",_e="\\ud83c[\\udffb-\\udfff]",we="[^\\ud800-\\udfff]",je="(?:\\ud83c[\\udde6-\\uddff]){2}",xe="[\\ud800-\\udbff][\\udc00-\\udfff]",Ce="["+pe+"]",ke="(?:"+be+"|"+ye+")",De="(?:['’](?:d|ll|m|re|s|t|ve))?",Se="(?:['’](?:D|LL|M|RE|S|T|VE))?",Ie="(?:"+ge+"|"+_e+")?",Te="[\\ufe0e\\ufe0f]?",Pe=Te+Ie+"(?:\\u200d(?:"+[we,je,xe].join("|")+")"+Te+Ie+")*",Le="(?:"+["[\\u2700-\\u27bf]",je,xe].join("|")+")"+Pe,Ae="(?:"+[we+ge+"?",ge,je,xe,"[\\ud800-\\udfff]"].join("|")+")",Me=RegExp("['’]","g"),Re=RegExp(ge,"g"),$e=RegExp(_e+"(?="+_e+")|"+Ae+Pe,"g"),ze=RegExp([Ce+"?"+be+"+"+De+"(?="+[me,Ce,"$"].join("|")+")","(?:[A-Z\\xc0-\\xd6\\xd8-\\xde]|[^\\ud800-\\udfff\\xac\\xb1\\xd7\\xf7\\x00-\\x2f\\x3a-\\x40\\x5b-\\x60\\x7b-\\xbf\\u2000-\\u206f \\t\\x0b\\f\\xa0\\ufeff\\n\\r\\u2028\\u2029\\u1680\\u180e\\u2000\\u2001\\u2002\\u2003\\u2004\\u2005\\u2006\\u2007\\u2008\\u2009\\u200a\\u202f\\u205f\\u3000\\d+\\u2700-\\u27bfa-z\\xdf-\\xf6\\xf8-\\xffA-Z\\xc0-\\xd6\\xd8-\\xde])+"+Se+"(?="+[me,Ce+ke,"$"].join("|")+")",Ce+"?"+ke+"+"+De,Ce+"+"+Se,"\\d*(?:1ST|2ND|3RD|(?![123])\\dTH)(?=\\b|[a-z_])","\\d*(?:1st|2nd|3rd|(?![123])\\dth)(?=\\b|[A-Z_])","\\d+",Le].join("|"),"g"),Oe=RegExp("[\\u200d\\ud800-\\udfff"+fe+"\\ufe0e\\ufe0f]"),Ne=/[a-z][A-Z]|[A-Z]{2}[a-z]|[0-9][a-zA-Z]|[a-zA-Z][0-9]|[^a-zA-Z0-9 ]/,Fe=["Array","Buffer","DataView","Date","Error","Float32Array","Float64Array","Function","Int8Array","Int16Array","Int32Array","Map","Math","Object","Promise","RegExp","Set","String","Symbol","TypeError","Uint8Array","Uint8ClampedArray","Uint16Array","Uint32Array","WeakMap","_","clearTimeout","isFinite","parseInt","setTimeout"],Ue=-1,Ee={};Ee[Ct]=Ee[kt]=Ee[Dt]=Ee[St]=Ee[It]=Ee[Tt]=Ee[Pt]=Ee[Lt]=Ee[At]=!0,Ee[at]=Ee[st]=Ee[jt]=Ee[lt]=Ee[xt]=Ee[ut]=Ee[ct]=Ee[dt]=Ee[vt]=Ee[pt]=Ee[ht]=Ee[gt]=Ee[bt]=Ee[yt]=Ee[wt]=!1;var Be={};Be[at]=Be[st]=Be[jt]=Be[xt]=Be[lt]=Be[ut]=Be[Ct]=Be[kt]=Be[Dt]=Be[St]=Be[It]=Be[vt]=Be[pt]=Be[ht]=Be[gt]=Be[bt]=Be[yt]=Be[_t]=Be[Tt]=Be[Pt]=Be[Lt]=Be[At]=!0,Be[ct]=Be[dt]=Be[wt]=!1;var Ve={"\\":"\\","'":"'","\n":"n","\r":"r","\u2028":"u2028","\u2029":"u2029"},Ye=parseFloat,He=parseInt,qe="object"==typeof t&&t&&t.Object===Object&&t,We="object"==typeof self&&self&&self.Object===Object&&self,Je=qe||We||Function("return this")(),Xe=e&&!e.nodeType&&e,Ze=Xe&&"object"==typeof o&&o&&!o.nodeType&&o,Ge=Ze&&Ze.exports===Xe,Qe=Ge&&qe.process,Ke=function(){try{return Ze&&Ze.require&&Ze.require("util").types||Qe&&Qe.binding&&Qe.binding("util")}catch(t){}}(),tn=Ke&&Ke.isArrayBuffer,en=Ke&&Ke.isDate,nn=Ke&&Ke.isMap,on=Ke&&Ke.isRegExp,rn=Ke&&Ke.isSet,an=Ke&&Ke.isTypedArray,sn=C("length"),ln=k({"À":"A","Á":"A","Â":"A","Ã":"A","Ä":"A","Å":"A","à":"a","á":"a","â":"a","ã":"a","ä":"a","å":"a","Ç":"C","ç":"c","Ð":"D","ð":"d","È":"E","É":"E","Ê":"E","Ë":"E","è":"e","é":"e","ê":"e","ë":"e","Ì":"I","Í":"I","Î":"I","Ï":"I","ì":"i","í":"i","î":"i","ï":"i","Ñ":"N","ñ":"n","Ò":"O","Ó":"O","Ô":"O","Õ":"O","Ö":"O","Ø":"O","ò":"o","ó":"o","ô":"o","õ":"o","ö":"o","ø":"o","Ù":"U","Ú":"U","Û":"U","Ü":"U","ù":"u","ú":"u","û":"u","ü":"u","Ý":"Y","ý":"y","ÿ":"y","Æ":"Ae","æ":"ae","Þ":"Th","þ":"th","ß":"ss","Ā":"A","Ă":"A","Ą":"A","ā":"a","ă":"a","ą":"a","Ć":"C","Ĉ":"C","Ċ":"C","Č":"C","ć":"c","ĉ":"c","ċ":"c","č":"c","Ď":"D","Đ":"D","ď":"d","đ":"d","Ē":"E","Ĕ":"E","Ė":"E","Ę":"E","Ě":"E","ē":"e","ĕ":"e","ė":"e","ę":"e","ě":"e","Ĝ":"G","Ğ":"G","Ġ":"G","Ģ":"G","ĝ":"g","ğ":"g","ġ":"g","ģ":"g","Ĥ":"H","Ħ":"H","ĥ":"h","ħ":"h","Ĩ":"I","Ī":"I","Ĭ":"I","Į":"I","İ":"I","ĩ":"i","ī":"i","ĭ":"i","į":"i","ı":"i","Ĵ":"J","ĵ":"j","Ķ":"K","ķ":"k","ĸ":"k","Ĺ":"L","Ļ":"L","Ľ":"L","Ŀ":"L","Ł":"L","ĺ":"l","ļ":"l","ľ":"l","ŀ":"l","ł":"l","Ń":"N","Ņ":"N","Ň":"N","Ŋ":"N","ń":"n","ņ":"n","ň":"n","ŋ":"n","Ō":"O","Ŏ":"O","Ő":"O","ō":"o","ŏ":"o","ő":"o","Ŕ":"R","Ŗ":"R","Ř":"R","ŕ":"r","ŗ":"r","ř":"r","Ś":"S","Ŝ":"S","Ş":"S","Š":"S","ś":"s","ŝ":"s","ş":"s","š":"s","Ţ":"T","Ť":"T","Ŧ":"T","ţ":"t","ť":"t","ŧ":"t","Ũ":"U","Ū":"U","Ŭ":"U","Ů":"U","Ű":"U","Ų":"U","ũ":"u","ū":"u","ŭ":"u","ů":"u","ű":"u","ų":"u","Ŵ":"W","ŵ":"w","Ŷ":"Y","ŷ":"y","Ÿ":"Y","Ź":"Z","Ż":"Z","Ž":"Z","ź":"z","ż":"z","ž":"z","Ĳ":"IJ","ĳ":"ij","Œ":"Oe","œ":"oe","ŉ":"'n","ſ":"s"}),un=k({"&":"&amp;","<":"&lt;",">":"&gt;",'"':"&quot;","'":"&#39;"}),cn=k({"&amp;":"&","&lt;":"<","&gt;":">","&quot;":'"',"&#39;":"'"}),dn=function t(e){function n(t){if(Fr(t)&&!Ps(t)&&!(t instanceof k)){if(t instanceof r)return t;if(Ti.call(t,"__wrapped__"))return dr(t)}return new r(t)}function o(){}function r(t,e){this.__wrapped__=t,this.__actions__=[],this.__chain__=!!e,this.__index__=0,this.__values__=q}function k(t){this.__wrapped__=t,this.__actions__=[],this.__dir__=1,this.__filtered__=!1,this.__iteratees__=[],this.__takeCount__=rt,this.__views__=[]}function Xt(t){var e=-1,n=null==t?0:t.length;for(this.clear();++e<n;){var o=t[e];this.set(o[0],o[1])}}function fe(t){var e=-1,n=null==t?0:t.length;for(this.clear();++e<n;){var o=t[e];this.set(o[0],o[1])}}function ve(t){var e=-1,n=null==t?0:t.length;for(this.clear();++e<n;){var o=t[e];this.set(o[0],o[1])}}function pe(t){var e=-1,n=null==t?0:t.length;for(this.__data__=new ve;++e<n;)this.add(t[e])}function he(t){var e=this.__data__=new fe(t);this.size=e.size}function me(t,e){var n=Ps(t),o=!n&&Ts(t),r=!n&&!o&&As(t),i=!n&&!o&&!r&&Os(t),a=n||o||r||i,s=a?I(t.length,ji):[],l=s.length;for(var u in t)!e&&!Ti.call(t,u)||a&&("length"==u||r&&("offset"==u||"parent"==u)||i&&("buffer"==u||"byteLength"==u||"byteOffset"==u)||Xo(u,l))||s.push(u);return s}function ge(t){var e=t.length;return e?t[Mn(0,e-1)]:q}function be(t,e){return lr(co(t),Se(e,0,t.length))}function ye(t){return lr(co(t))}function _e(t,e,n){(n!==q&&!Lr(t[e],n)||n===q&&!(e in t))&&ke(t,e,n)}function we(t,e,n){var o=t[e];Ti.call(t,e)&&Lr(o,n)&&(n!==q||e in t)||ke(t,e,n)}function je(t,e){for(var n=t.length;n--;)if(Lr(t[n][0],e))return n;return-1}function xe(t,e,n,o){return Sa(t,function(t,r,i){e(o,t,n(t),i)}),o}function Ce(t,e){return t&&fo(e,Kr(e),t)}function ke(t,e,n){"__proto__"==e&&Wi?Wi(t,e,{configurable:!0,enumerable:!0,value:n,writable:!0}):t[e]=n}function De(t,e){for(var n=-1,o=e.length,r=hi(o),i=null==t;++n<o;)r[n]=i?q:Gr(t,e[n]);return r}function Se(t,e,n){return t==t&&(n!==q&&(t=t<=n?t:n),e!==q&&(t=t>=e?t:e)),t}function Ie(t,e,n,o,r,i){var a,l=1&e,u=2&e,c=4&e;if(n&&(a=r?n(t,o,r,i):n(t)),a!==q)return a;if(!Nr(t))return t;var d=Ps(t);if(d){if(a=function(t){var e=t.length,n=new t.constructor(e);return e&&"string"==typeof t[0]&&Ti.call(t,"index")&&(n.index=t.index,n.input=t.input),n}(t),!l)return co(t,a)}else{var f=Fa(t),v=f==dt||f==ft;if(As(t))return ro(t,l);if(f==ht||f==at||v&&!r){if(a=u||v?{}:Wo(t),!l)return u?function(t,e){return fo(t,Na(t),e)}(t,function(t,e){return t&&fo(e,ti(e),t)}(a,t)):function(t,e){return fo(t,Oa(t),e)}(t,Ce(a,t))}else{if(!Be[f])return r?t:{};a=function(t,e,n){var o=t.constructor;switch(e){case jt:return io(t);case lt:case ut:return new o(+t);case xt:return function(t,e){var n=e?io(t.buffer):t.buffer;return new t.constructor(n,t.byteOffset,t.byteLength)}(t,n);case Ct:case kt:case Dt:case St:case It:case Tt:case Pt:case Lt:case At:return ao(t,n);case vt:return new o;case pt:case yt:return new o(t);case gt:return function(t){var e=new t.constructor(t.source,oe.exec(t));return e.lastIndex=t.lastIndex,e}(t);case bt:return new o;case _t:return r=t,Ca?_i(Ca.call(r)):{}}var r}(t,f,l)}}i||(i=new he);var p=i.get(t);if(p)return p;i.set(t,a),zs(t)?t.forEach(function(o){a.add(Ie(o,e,n,o,t,i))}):Rs(t)&&t.forEach(function(o,r){a.set(r,Ie(o,e,n,r,t,i))});var h=d?q:(c?u?Fo:No:u?ti:Kr)(t);return s(h||t,function(o,r){h&&(o=t[r=o]),we(a,r,Ie(o,e,n,r,t,i))}),a}function Te(t,e,n){var o=n.length;if(null==t)return!o;for(t=_i(t);o--;){var r=n[o],i=e[r],a=t[r];if(a===q&&!(r in t)||!i(a))return!1}return!0}function Pe(t,e,n){if("function"!=typeof t)throw new xi(W);return Ba(function(){t.apply(q,n)},e)}function Le(t,e,n,o){var r=-1,i=d,a=!0,s=t.length,l=[],u=e.length;if(!s)return l;n&&(e=v(e,P(n))),o?(i=f,a=!1):e.length>=200&&(i=A,a=!1,e=new pe(e));t:for(;++r<s;){var c=t[r],p=null==n?c:n(c);if(c=o||0!==c?c:0,a&&p==p){for(var h=u;h--;)if(e[h]===p)continue t;l.push(c)}else i(e,p,o)||l.push(c)}return l}function Ae(t,e){var n=!0;return Sa(t,function(t,o,r){return n=!!e(t,o,r)}),n}function $e(t,e,n){for(var o=-1,r=t.length;++o<r;){var i=t[o],a=e(i);if(null!=a&&(s===q?a==a&&!Vr(a):n(a,s)))var s=a,l=i}return l}function Oe(t,e){var n=[];return Sa(t,function(t,o,r){e(t,o,r)&&n.push(t)}),n}function Ve(t,e,n,o,r){var i=-1,a=t.length;for(n||(n=Jo),r||(r=[]);++i<a;){var s=t[i];e>0&&n(s)?e>1?Ve(s,e-1,n,o,r):p(r,s):o||(r[r.length]=s)}return r}function qe(t,e){return t&&Ta(t,e,Kr)}function We(t,e){return t&&Pa(t,e,Kr)}function Xe(t,e){return c(e,function(e){return $r(t[e])})}function Ze(t,e){for(var n=0,o=(e=no(e,t)).length;null!=t&&n<o;)t=t[ur(e[n++])];return n&&n==o?t:q}function Qe(t,e,n){var o=e(t);return Ps(t)?o:p(o,n(t))}function Ke(t){return null==t?t===q?"[object Undefined]":"[object Null]":qi&&qi in _i(t)?function(t){var e=Ti.call(t,qi),n=t[qi];try{t[qi]=q;var o=!0}catch(t){}var r=Ai.call(t);return o&&(e?t[qi]=n:delete t[qi]),r}(t):function(t){return Ai.call(t)}(t)}function sn(t,e){return t>e}function fn(t,e){return null!=t&&Ti.call(t,e)}function vn(t,e){return null!=t&&e in _i(t)}function pn(t,e,n){for(var o=n?f:d,r=t[0].length,i=t.length,a=i,s=hi(i),l=1/0,u=[];a--;){var c=t[a];a&&e&&(c=v(c,P(e))),l=ia(c.length,l),s[a]=!n&&(e||r>=120&&c.length>=120)?new pe(a&&c):q}c=t[0];var p=-1,h=s[0];t:for(;++p<r&&u.length<l;){var m=c[p],g=e?e(m):m;if(m=n||0!==m?m:0,!(h?A(h,g):o(u,g,n))){for(a=i;--a;){var b=s[a];if(!(b?A(b,g):o(t[a],g,n)))continue t}h&&h.push(g),u.push(m)}}return u}function hn(t,e,n){var o=null==(t=or(t,e=no(e,t)))?t:t[ur(mr(e))];return null==o?q:i(o,t,n)}function mn(t){return Fr(t)&&Ke(t)==at}function gn(t,e,n,o,r){return t===e||(null==t||null==e||!Fr(t)&&!Fr(e)?t!=t&&e!=e:function(t,e,n,o,r,i){var a=Ps(t),s=Ps(e),l=a?st:Fa(t),u=s?st:Fa(e),c=(l=l==at?ht:l)==ht,d=(u=u==at?ht:u)==ht,f=l==u;if(f&&As(t)){if(!As(e))return!1;a=!0,c=!1}if(f&&!c)return i||(i=new he),a||Os(t)?zo(t,e,n,o,r,i):function(t,e,n,o,r,i,a){switch(n){case xt:if(t.byteLength!=e.byteLength||t.byteOffset!=e.byteOffset)return!1;t=t.buffer,e=e.buffer;case jt:return!(t.byteLength!=e.byteLength||!i(new Ni(t),new Ni(e)));case lt:case ut:case pt:return Lr(+t,+e);case ct:return t.name==e.name&&t.message==e.message;case gt:case yt:return t==e+"";case vt:var s=N;case bt:var l=1&o;if(s||(s=E),t.size!=e.size&&!l)return!1;var u=a.get(t);if(u)return u==e;o|=2,a.set(t,e);var c=zo(s(t),s(e),o,r,i,a);return a.delete(t),c;case _t:if(Ca)return Ca.call(t)==Ca.call(e)}return!1}(t,e,l,n,o,r,i);if(!(1&n)){var v=c&&Ti.call(t,"__wrapped__"),p=d&&Ti.call(e,"__wrapped__");if(v||p){var h=v?t.value():t,m=p?e.value():e;return i||(i=new he),r(h,m,n,o,i)}}return!!f&&(i||(i=new he),function(t,e,n,o,r,i){var a=1&n,s=No(t),l=s.length;if(l!=No(e).length&&!a)return!1;for(var u=l;u--;){var c=s[u];if(!(a?c in e:Ti.call(e,c)))return!1}var d=i.get(t),f=i.get(e);if(d&&f)return d==e&&f==t;var v=!0;i.set(t,e),i.set(e,t);for(var p=a;++u<l;){var h=t[c=s[u]],m=e[c];if(o)var g=a?o(m,h,c,e,t,i):o(h,m,c,t,e,i);if(!(g===q?h===m||r(h,m,n,o,i):g)){v=!1;break}p||(p="constructor"==c)}if(v&&!p){var b=t.constructor,y=e.constructor;b==y||!("constructor"in t)||!("constructor"in e)||"function"==typeof b&&b instanceof b&&"function"==typeof y&&y instanceof y||(v=!1)}return i.delete(t),i.delete(e),v}(t,e,n,o,r,i))}(t,e,n,o,gn,r))}function bn(t,e,n,o){var r=n.length,i=r,a=!o;if(null==t)return!i;for(t=_i(t);r--;){var s=n[r];if(a&&s[2]?s[1]!==t[s[0]]:!(s[0]in t))return!1}for(;++r<i;){var l=(s=n[r])[0],u=t[l],c=s[1];if(a&&s[2]){if(u===q&&!(l in t))return!1}else{var d=new he;if(o)var f=o(u,c,l,t,e,d);if(!(f===q?gn(c,u,3,o,d):f))return!1}}return!0}function yn(t){return!(!Nr(t)||(e=t,Li&&Li in e))&&($r(t)?$i:ae).test(cr(t));var e}function _n(t){return"function"==typeof t?t:null==t?si:"object"==typeof t?Ps(t)?Dn(t[0],t[1]):kn(t):di(t)}function wn(t){if(!Ko(t))return oa(t);var e=[];for(var n in _i(t))Ti.call(t,n)&&"constructor"!=n&&e.push(n);return e}function jn(t){if(!Nr(t))return function(t){var e=[];if(null!=t)for(var n in _i(t))e.push(n);return e}(t);var e=Ko(t),n=[];for(var o in t)("constructor"!=o||!e&&Ti.call(t,o))&&n.push(o);return n}function xn(t,e){return t<e}function Cn(t,e){var n=-1,o=Ar(t)?hi(t.length):[];return Sa(t,function(t,r,i){o[++n]=e(t,r,i)}),o}function kn(t){var e=Yo(t);return 1==e.length&&e[0][2]?er(e[0][0],e[0][1]):function(n){return n===t||bn(n,t,e)}}function Dn(t,e){return Go(t)&&tr(e)?er(ur(t),e):function(n){var o=Gr(n,t);return o===q&&o===e?Qr(n,t):gn(e,o,3)}}function Sn(t,e,n,o,r){t!==e&&Ta(e,function(i,a){if(r||(r=new he),Nr(i))!function(t,e,n,o,r,i,a){var s=ir(t,n),l=ir(e,n),u=a.get(l);if(u)return void _e(t,n,u);var c=i?i(s,l,n+"",t,e,a):q,d=c===q;if(d){var f=Ps(l),v=!f&&As(l),p=!f&&!v&&Os(l);c=l,f||v||p?Ps(s)?c=s:Mr(s)?c=co(s):v?(d=!1,c=ro(l,!0)):p?(d=!1,c=ao(l,!0)):c=[]:Er(l)||Ts(l)?(c=s,Ts(s)?c=Xr(s):Nr(s)&&!$r(s)||(c=Wo(l))):d=!1}d&&(a.set(l,c),r(c,l,o,i,a),a.delete(l)),_e(t,n,c)}(t,e,a,n,Sn,o,r);else{var s=o?o(ir(t,a),i,a+"",t,e,r):q;s===q&&(s=i),_e(t,a,s)}},ti)}function In(t,e){var n=t.length;if(n)return Xo(e+=e<0?n:0,n)?t[e]:q}function Tn(t,e,n){e=e.length?v(e,function(t){return Ps(t)?function(e){return Ze(e,1===t.length?t[0]:t)}:t}):[si];var o=-1;return e=v(e,P(Bo())),function(t,e){var n=t.length;for(t.sort(e);n--;)t[n]=t[n].value;return t}(Cn(t,function(t,n,r){return{criteria:v(e,function(e){return e(t)}),index:++o,value:t}}),function(t,e){return function(t,e,n){for(var o=-1,r=t.criteria,i=e.criteria,a=r.length,s=n.length;++o<a;){var l=so(r[o],i[o]);if(l)return o>=s?l:l*("desc"==n[o]?-1:1)}return t.index-e.index}(t,e,n)})}function Pn(t,e,n){for(var o=-1,r=e.length,i={};++o<r;){var a=e[o],s=Ze(t,a);n(s,a)&&Nn(i,no(a,t),s)}return i}function Ln(t,e,n,o){var r=o?w:_,i=-1,a=e.length,s=t;for(t===e&&(e=co(e)),n&&(s=v(t,P(n)));++i<a;)for(var l=0,u=e[i],c=n?n(u):u;(l=r(s,c,l,o))>-1;)s!==t&&Vi.call(s,l,1),Vi.call(t,l,1);return t}function An(t,e){for(var n=t?e.length:0,o=n-1;n--;){var r=e[n];if(n==o||r!==i){var i=r;Xo(r)?Vi.call(t,r,1):Jn(t,r)}}return t}function Mn(t,e){return t+Qi(la()*(e-t+1))}function Rn(t,e){var n="";if(!t||e<1||e>nt)return n;do{e%2&&(n+=t),(e=Qi(e/2))&&(t+=t)}while(e);return n}function $n(t,e){return Va(nr(t,e,si),t+"")}function zn(t){return ge(ni(t))}function On(t,e){var n=ni(t);return lr(n,Se(e,0,n.length))}function Nn(t,e,n,o){if(!Nr(t))return t;for(var r=-1,i=(e=no(e,t)).length,a=i-1,s=t;null!=s&&++r<i;){var l=ur(e[r]),u=n;if("__proto__"===l||"constructor"===l||"prototype"===l)return t;if(r!=a){var c=s[l];(u=o?o(c,l,s):q)===q&&(u=Nr(c)?c:Xo(e[r+1])?[]:{})}we(s,l,u),s=s[l]}return t}function Fn(t){return lr(ni(t))}function Un(t,e,n){var o=-1,r=t.length;e<0&&(e=-e>r?0:r+e),(n=n>r?r:n)<0&&(n+=r),r=e>n?0:n-e>>>0,e>>>=0;for(var i=hi(r);++o<r;)i[o]=t[o+e];return i}function En(t,e){var n;return Sa(t,function(t,o,r){return!(n=e(t,o,r))}),!!n}function Bn(t,e,n){var o=0,r=null==t?o:t.length;if("number"==typeof e&&e==e&&r<=2147483647){for(;o<r;){var i=o+r>>>1,a=t[i];null!==a&&!Vr(a)&&(n?a<=e:a<e)?o=i+1:r=i}return r}return Vn(t,e,si,n)}function Vn(t,e,n,o){var r=0,i=null==t?0:t.length;if(0===i)return 0;for(var a=(e=n(e))!=e,s=null===e,l=Vr(e),u=e===q;r<i;){var c=Qi((r+i)/2),d=n(t[c]),f=d!==q,v=null===d,p=d==d,h=Vr(d);if(a)var m=o||p;else m=u?p&&(o||f):s?p&&f&&(o||!v):l?p&&f&&!v&&(o||!h):!v&&!h&&(o?d<=e:d<e);m?r=c+1:i=c}return ia(i,4294967294)}function Yn(t,e){for(var n=-1,o=t.length,r=0,i=[];++n<o;){var a=t[n],s=e?e(a):a;if(!n||!Lr(s,l)){var l=s;i[r++]=0===a?0:a}}return i}function Hn(t){return"number"==typeof t?t:Vr(t)?ot:+t}function qn(t){if("string"==typeof t)return t;if(Ps(t))return v(t,qn)+"";if(Vr(t))return ka?ka.call(t):"";var e=t+"";return"0"==e&&1/t==-1/0?"-0":e}function Wn(t,e,n){var o=-1,r=d,i=t.length,a=!0,s=[],l=s;if(n)a=!1,r=f;else if(i>=200){var u=e?null:$a(t);if(u)return E(u);a=!1,r=A,l=new pe}else l=e?[]:s;t:for(;++o<i;){var c=t[o],v=e?e(c):c;if(c=n||0!==c?c:0,a&&v==v){for(var p=l.length;p--;)if(l[p]===v)continue t;e&&l.push(v),s.push(c)}else r(l,v,n)||(l!==s&&l.push(v),s.push(c))}return s}function Jn(t,e){return null==(t=or(t,e=no(e,t)))||delete t[ur(mr(e))]}function Xn(t,e,n,o){return Nn(t,e,n(Ze(t,e)),o)}function Zn(t,e,n,o){for(var r=t.length,i=o?r:-1;(o?i--:++i<r)&&e(t[i],i,t););return n?Un(t,o?0:i,o?i+1:r):Un(t,o?i+1:0,o?r:i)}function Gn(t,e){var n=t;return n instanceof k&&(n=n.value()),h(e,function(t,e){return e.func.apply(e.thisArg,p([t],e.args))},n)}function Qn(t,e,n){var o=t.length;if(o<2)return o?Wn(t[0]):[];for(var r=-1,i=hi(o);++r<o;)for(var a=t[r],s=-1;++s<o;)s!=r&&(i[r]=Le(i[r]||a,t[s],e,n));return Wn(Ve(i,1),e,n)}function Kn(t,e,n){for(var o=-1,r=t.length,i=e.length,a={};++o<r;){var s=o<i?e[o]:q;n(a,t[o],s)}return a}function to(t){return Mr(t)?t:[]}function eo(t){return"function"==typeof t?t:si}function no(t,e){return Ps(t)?t:Go(t,e)?[t]:Ya(Zr(t))}function oo(t,e,n){var o=t.length;return n=n===q?o:n,!e&&n>=o?t:Un(t,e,n)}function ro(t,e){if(e)return t.slice();var n=t.length,o=Fi?Fi(n):new t.constructor(n);return t.copy(o),o}function io(t){var e=new t.constructor(t.byteLength);return new Ni(e).set(new Ni(t)),e}function ao(t,e){var n=e?io(t.buffer):t.buffer;return new t.constructor(n,t.byteOffset,t.length)}function so(t,e){if(t!==e){var n=t!==q,o=null===t,r=t==t,i=Vr(t),a=e!==q,s=null===e,l=e==e,u=Vr(e);if(!s&&!u&&!i&&t>e||i&&a&&l&&!s&&!u||o&&a&&l||!n&&l||!r)return 1;if(!o&&!i&&!u&&t<e||u&&n&&r&&!o&&!i||s&&n&&r||!a&&r||!l)return-1}return 0}function lo(t,e,n,o){for(var r=-1,i=t.length,a=n.length,s=-1,l=e.length,u=ra(i-a,0),c=hi(l+u),d=!o;++s<l;)c[s]=e[s];for(;++r<a;)(d||r<i)&&(c[n[r]]=t[r]);for(;u--;)c[s++]=t[r++];return c}function uo(t,e,n,o){for(var r=-1,i=t.length,a=-1,s=n.length,l=-1,u=e.length,c=ra(i-s,0),d=hi(c+u),f=!o;++r<c;)d[r]=t[r];for(var v=r;++l<u;)d[v+l]=e[l];for(;++a<s;)(f||r<i)&&(d[v+n[a]]=t[r++]);return d}function co(t,e){var n=-1,o=t.length;for(e||(e=hi(o));++n<o;)e[n]=t[n];return e}function fo(t,e,n,o){var r=!n;n||(n={});for(var i=-1,a=e.length;++i<a;){var s=e[i],l=o?o(n[s],t[s],s,n,t):q;l===q&&(l=t[s]),r?ke(n,s,l):we(n,s,l)}return n}function vo(t,e){return function(n,o){var r=Ps(n)?a:xe,i=e?e():{};return r(n,t,Bo(o,2),i)}}function po(t){return $n(function(e,n){var o=-1,r=n.length,i=r>1?n[r-1]:q,a=r>2?n[2]:q;for(i=t.length>3&&"function"==typeof i?(r--,i):q,a&&Zo(n[0],n[1],a)&&(i=r<3?q:i,r=1),e=_i(e);++o<r;){var s=n[o];s&&t(e,s,o,i)}return e})}function ho(t,e){return function(n,o){if(null==n)return n;if(!Ar(n))return t(n,o);for(var r=n.length,i=e?r:-1,a=_i(n);(e?i--:++i<r)&&!1!==o(a[i],i,a););return n}}function mo(t){return function(e,n,o){for(var r=-1,i=_i(e),a=o(e),s=a.length;s--;){var l=a[t?s:++r];if(!1===n(i[l],l,i))break}return e}}function go(t){return function(e){var n=O(e=Zr(e))?Y(e):q,o=n?n[0]:e.charAt(0),r=n?oo(n,1).join(""):e.slice(1);return o[t]()+r}}function bo(t){return function(e){return h(ii(ri(e).replace(Me,"")),t,"")}}function yo(t){return function(){var e=arguments;switch(e.length){case 0:return new t;case 1:return new t(e[0]);case 2:return new t(e[0],e[1]);case 3:return new t(e[0],e[1],e[2]);case 4:return new t(e[0],e[1],e[2],e[3]);case 5:return new t(e[0],e[1],e[2],e[3],e[4]);case 6:return new t(e[0],e[1],e[2],e[3],e[4],e[5]);case 7:return new t(e[0],e[1],e[2],e[3],e[4],e[5],e[6])}var n=Da(t.prototype),o=t.apply(n,e);return Nr(o)?o:n}}function _o(t){return function(e,n,o){var r=_i(e);if(!Ar(e)){var i=Bo(n,3);e=Kr(e),n=function(t){return i(r[t],t,r)}}var a=t(e,n,o);return a>-1?r[i?e[a]:a]:q}}function wo(t){return Oo(function(e){var n=e.length,o=n,i=r.prototype.thru;for(t&&e.reverse();o--;){var a=e[o];if("function"!=typeof a)throw new xi(W);if(i&&!s&&"wrapper"==Uo(a))var s=new r([],!0)}for(o=s?o:n;++o<n;){var l=Uo(a=e[o]),u="wrapper"==l?za(a):q;s=u&&Qo(u[0])&&424==u[1]&&!u[4].length&&1==u[9]?s[Uo(u[0])].apply(s,u[3]):1==a.length&&Qo(a)?s[l]():s.thru(a)}return function(){var t=arguments,o=t[0];if(s&&1==t.length&&Ps(o))return s.plant(o).value();for(var r=0,i=n?e[r].apply(this,t):o;++r<n;)i=e[r].call(this,i);return i}})}function jo(t,e,n,o,r,i,a,s,l,u){var c=e&K,d=1&e,f=2&e,v=24&e,p=512&e,h=f?q:yo(t);return function m(){for(var g=arguments.length,b=hi(g),y=g;y--;)b[y]=arguments[y];if(v)var _=Eo(m),w=$(b,_);if(o&&(b=lo(b,o,r,v)),i&&(b=uo(b,i,a,v)),g-=w,v&&g<u){var j=U(b,_);return To(t,e,jo,m.placeholder,n,b,j,s,l,u-g)}var x=d?n:this,C=f?x[t]:t;return g=b.length,s?b=rr(b,s):p&&g>1&&b.reverse(),c&&l<g&&(b.length=l),this&&this!==Je&&this instanceof m&&(C=h||yo(C)),C.apply(x,b)}}function xo(t,e){return function(n,o){return function(t,e,n,o){return qe(t,function(t,r,i){e(o,n(t),r,i)}),o}(n,t,e(o),{})}}function Co(t,e){return function(n,o){var r;if(n===q&&o===q)return e;if(n!==q&&(r=n),o!==q){if(r===q)return o;"string"==typeof n||"string"==typeof o?(n=qn(n),o=qn(o)):(n=Hn(n),o=Hn(o)),r=t(n,o)}return r}}function ko(t){return Oo(function(e){return e=v(e,P(Bo())),$n(function(n){var o=this;return t(e,function(t){return i(t,o,n)})})})}function Do(t,e){var n=(e=e===q?" ":qn(e)).length;if(n<2)return n?Rn(e,t):e;var o=Rn(e,Gi(t/V(e)));return O(e)?oo(Y(o),0,t).join(""):o.slice(0,t)}function So(t){return function(e,n,o){return o&&"number"!=typeof o&&Zo(e,n,o)&&(n=o=q),e=Hr(e),n===q?(n=e,e=0):n=Hr(n),function(t,e,n,o){for(var r=-1,i=ra(Gi((e-t)/(n||1)),0),a=hi(i);i--;)a[o?i:++r]=t,t+=n;return a}(e,n,o=o===q?e<n?1:-1:Hr(o),t)}}function Io(t){return function(e,n){return"string"==typeof e&&"string"==typeof n||(e=Jr(e),n=Jr(n)),t(e,n)}}function To(t,e,n,o,r,i,a,s,l,u){var c=8&e;e|=c?G:Q,4&(e&=~(c?Q:G))||(e&=-4);var d=[t,e,r,c?i:q,c?a:q,c?q:i,c?q:a,s,l,u],f=n.apply(q,d);return Qo(t)&&Ea(f,d),f.placeholder=o,ar(f,t,e)}function Po(t){var e=yi[t];return function(t,n){if(t=Jr(t),(n=null==n?0:ia(qr(n),292))&&ea(t)){var o=(Zr(t)+"e").split("e");return+((o=(Zr(e(o[0]+"e"+(+o[1]+n)))+"e").split("e"))[0]+"e"+(+o[1]-n))}return e(t)}}function Lo(t){return function(e){var n=Fa(e);return n==vt?N(e):n==bt?B(e):function(t,e){return v(e,function(e){return[e,t[e]]})}(e,t(e))}}function Ao(t,e,n,o,r,a,s,l){var u=2&e;if(!u&&"function"!=typeof t)throw new xi(W);var c=o?o.length:0;if(c||(e&=-97,o=r=q),s=s===q?s:ra(qr(s),0),l=l===q?l:qr(l),c-=r?r.length:0,e&Q){var d=o,f=r;o=r=q}var v=u?q:za(t),p=[t,e,n,o,r,d,f,a,s,l];if(v&&function(t,e){var n=t[1],o=e[1],r=n|o,i=r<131,a=o==K&&8==n||o==K&&n==tt&&t[7].length<=e[8]||384==o&&e[7].length<=e[8]&&8==n;if(!i&&!a)return t;1&o&&(t[2]=e[2],r|=1&n?0:4);var s=e[3];if(s){var l=t[3];t[3]=l?lo(l,s,e[4]):s,t[4]=l?U(t[3],X):e[4]}(s=e[5])&&(l=t[5],t[5]=l?uo(l,s,e[6]):s,t[6]=l?U(t[5],X):e[6]),(s=e[7])&&(t[7]=s),o&K&&(t[8]=null==t[8]?e[8]:ia(t[8],e[8])),null==t[9]&&(t[9]=e[9]),t[0]=e[0],t[1]=r}(p,v),t=p[0],e=p[1],n=p[2],o=p[3],r=p[4],!(l=p[9]=p[9]===q?u?0:t.length:ra(p[9]-c,0))&&24&e&&(e&=-25),e&&1!=e)h=8==e||e==Z?function(t,e,n){var o=yo(t);return function r(){for(var a=arguments.length,s=hi(a),l=a,u=Eo(r);l--;)s[l]=arguments[l];var c=a<3&&s[0]!==u&&s[a-1]!==u?[]:U(s,u);return(a-=c.length)<n?To(t,e,jo,r.placeholder,q,s,c,q,q,n-a):i(this&&this!==Je&&this instanceof r?o:t,this,s)}}(t,e,l):e!=G&&33!=e||r.length?jo.apply(q,p):function(t,e,n,o){var r=1&e,a=yo(t);return function e(){for(var s=-1,l=arguments.length,u=-1,c=o.length,d=hi(c+l),f=this&&this!==Je&&this instanceof e?a:t;++u<c;)d[u]=o[u];for(;l--;)d[u++]=arguments[++s];return i(f,r?n:this,d)}}(t,e,n,o);else var h=function(t,e,n){var o=1&e,r=yo(t);return function e(){return(this&&this!==Je&&this instanceof e?r:t).apply(o?n:this,arguments)}}(t,e,n);return ar((v?La:Ea)(h,p),t,e)}function Mo(t,e,n,o){return t===q||Lr(t,Di[n])&&!Ti.call(o,n)?e:t}function Ro(t,e,n,o,r,i){return Nr(t)&&Nr(e)&&(i.set(e,t),Sn(t,e,q,Ro,i),i.delete(e)),t}function $o(t){return Er(t)?q:t}function zo(t,e,n,o,r,i){var a=1&n,s=t.length,l=e.length;if(s!=l&&!(a&&l>s))return!1;var u=i.get(t),c=i.get(e);if(u&&c)return u==e&&c==t;var d=-1,f=!0,v=2&n?new pe:q;for(i.set(t,e),i.set(e,t);++d<s;){var p=t[d],h=e[d];if(o)var m=a?o(h,p,d,e,t,i):o(p,h,d,t,e,i);if(m!==q){if(m)continue;f=!1;break}if(v){if(!g(e,function(t,e){if(!A(v,e)&&(p===t||r(p,t,n,o,i)))return v.push(e)})){f=!1;break}}else if(p!==h&&!r(p,h,n,o,i)){f=!1;break}}return i.delete(t),i.delete(e),f}function Oo(t){return Va(nr(t,q,pr),t+"")}function No(t){return Qe(t,Kr,Oa)}function Fo(t){return Qe(t,ti,Na)}function Uo(t){for(var e=t.name+"",n=ga[e],o=Ti.call(ga,e)?n.length:0;o--;){var r=n[o],i=r.func;if(null==i||i==t)return r.name}return e}function Eo(t){return(Ti.call(n,"placeholder")?n:t).placeholder}function Bo(){var t=n.iteratee||li;return t=t===li?_n:t,arguments.length?t(arguments[0],arguments[1]):t}function Vo(t,e){var n,o,r=t.__data__;return("string"==(o=typeof(n=e))||"number"==o||"symbol"==o||"boolean"==o?"__proto__"!==n:null===n)?r["string"==typeof e?"string":"hash"]:r.map}function Yo(t){for(var e=Kr(t),n=e.length;n--;){var o=e[n],r=t[o];e[n]=[o,r,tr(r)]}return e}function Ho(t,e){var n=function(t,e){return null==t?q:t[e]}(t,e);return yn(n)?n:q}function qo(t,e,n){for(var o=-1,r=(e=no(e,t)).length,i=!1;++o<r;){var a=ur(e[o]);if(!(i=null!=t&&n(t,a)))break;t=t[a]}return i||++o!=r?i:!!(r=null==t?0:t.length)&&Or(r)&&Xo(a,r)&&(Ps(t)||Ts(t))}function Wo(t){return"function"!=typeof t.constructor||Ko(t)?{}:Da(Ui(t))}function Jo(t){return Ps(t)||Ts(t)||!!(Yi&&t&&t[Yi])}function Xo(t,e){var n=typeof t;return!!(e=null==e?nt:e)&&("number"==n||"symbol"!=n&&le.test(t))&&t>-1&&t%1==0&&t<e}function Zo(t,e,n){if(!Nr(n))return!1;var o=typeof e;return!!("number"==o?Ar(n)&&Xo(e,n.length):"string"==o&&e in n)&&Lr(n[e],t)}function Go(t,e){if(Ps(t))return!1;var n=typeof t;return!("number"!=n&&"symbol"!=n&&"boolean"!=n&&null!=t&&!Vr(t))||Yt.test(t)||!Vt.test(t)||null!=e&&t in _i(e)}function Qo(t){var e=Uo(t),o=n[e];if("function"!=typeof o||!(e in k.prototype))return!1;if(t===o)return!0;var r=za(o);return!!r&&t===r[0]}function Ko(t){var e=t&&t.constructor;return t===("function"==typeof e&&e.prototype||Di)}function tr(t){return t==t&&!Nr(t)}function er(t,e){return function(n){return null!=n&&n[t]===e&&(e!==q||t in _i(n))}}function nr(t,e,n){return e=ra(e===q?t.length-1:e,0),function(){for(var o=arguments,r=-1,a=ra(o.length-e,0),s=hi(a);++r<a;)s[r]=o[e+r];r=-1;for(var l=hi(e+1);++r<e;)l[r]=o[r];return l[e]=n(s),i(t,this,l)}}function or(t,e){return e.length<2?t:Ze(t,Un(e,0,-1))}function rr(t,e){for(var n=t.length,o=ia(e.length,n),r=co(t);o--;){var i=e[o];t[o]=Xo(i,n)?r[i]:q}return t}function ir(t,e){if(("constructor"!==e||"function"!=typeof t[e])&&"__proto__"!=e)return t[e]}function ar(t,e,n){var o=e+"";return Va(t,function(t,e){var n=e.length;if(!n)return t;var o=n-1;return e[o]=(n>1?"& ":"")+e[o],e=e.join(n>2?", ":" "),t.replace(Zt,"{\n/* [wrapped with "+e+"] */\n")}(o,function(t,e){return s(it,function(n){var o="_."+n[0];e&n[1]&&!d(t,o)&&t.push(o)}),t.sort()}(function(t){var e=t.match(Gt);return e?e[1].split(Qt):[]}(o),n)))}function sr(t){var e=0,n=0;return function(){var o=aa(),r=16-(o-n);if(n=o,r>0){if(++e>=800)return arguments[0]}else e=0;return t.apply(q,arguments)}}function lr(t,e){var n=-1,o=t.length,r=o-1;for(e=e===q?o:e;++n<e;){var i=Mn(n,r),a=t[i];t[i]=t[n],t[n]=a}return t.length=e,t}function ur(t){if("string"==typeof t||Vr(t))return t;var e=t+"";return"0"==e&&1/t==-1/0?"-0":e}function cr(t){if(null!=t){try{return Ii.call(t)}catch(t){}try{return t+""}catch(t){}}return""}function dr(t){if(t instanceof k)return t.clone();var e=new r(t.__wrapped__,t.__chain__);return e.__actions__=co(t.__actions__),e.__index__=t.__index__,e.__values__=t.__values__,e}function fr(t,e,n){var o=null==t?0:t.length;if(!o)return-1;var r=null==n?0:qr(n);return r<0&&(r=ra(o+r,0)),y(t,Bo(e,3),r)}function vr(t,e,n){var o=null==t?0:t.length;if(!o)return-1;var r=o-1;return n!==q&&(r=qr(n),r=n<0?ra(o+r,0):ia(r,o-1)),y(t,Bo(e,3),r,!0)}function pr(t){return(null==t?0:t.length)?Ve(t,1):[]}function hr(t){return t&&t.length?t[0]:q}function mr(t){var e=null==t?0:t.length;return e?t[e-1]:q}function gr(t,e){return t&&t.length&&e&&e.length?Ln(t,e):t}function br(t){return null==t?t:ua.call(t)}function yr(t){if(!t||!t.length)return[];var e=0;return t=c(t,function(t){if(Mr(t))return e=ra(t.length,e),!0}),I(e,function(e){return v(t,C(e))})}function _r(t,e){if(!t||!t.length)return[];var n=yr(t);return null==e?n:v(n,function(t){return i(e,q,t)})}function wr(t){var e=n(t);return e.__chain__=!0,e}function jr(t,e){return e(t)}function xr(t,e){return(Ps(t)?s:Sa)(t,Bo(e,3))}function Cr(t,e){return(Ps(t)?l:Ia)(t,Bo(e,3))}function kr(t,e){return(Ps(t)?v:Cn)(t,Bo(e,3))}function Dr(t,e,n){return e=n?q:e,e=t&&null==e?t.length:e,Ao(t,K,q,q,q,q,e)}function Sr(t,e){var n;if("function"!=typeof e)throw new xi(W);return t=qr(t),function(){return--t>0&&(n=e.apply(this,arguments)),t<=1&&(e=q),n}}function Ir(t,e,n){function o(e){var n=u,o=c;return u=c=q,h=e,f=t.apply(o,n)}function r(t){return h=t,v=Ba(a,e),m?o(t):f}function i(t){var n=t-p;return p===q||n>=e||n<0||g&&t-h>=d}function a(){var t=gs();if(i(t))return s(t);v=Ba(a,function(t){var n=e-(t-p);return g?ia(n,d-(t-h)):n}(t))}function s(t){return v=q,b&&u?o(t):(u=c=q,f)}function l(){var t=gs(),n=i(t);if(u=arguments,c=this,p=t,n){if(v===q)return r(p);if(g)return Ra(v),v=Ba(a,e),o(p)}return v===q&&(v=Ba(a,e)),f}var u,c,d,f,v,p,h=0,m=!1,g=!1,b=!0;if("function"!=typeof t)throw new xi(W);return e=Jr(e)||0,Nr(n)&&(m=!!n.leading,d=(g="maxWait"in n)?ra(Jr(n.maxWait)||0,e):d,b="trailing"in n?!!n.trailing:b),l.cancel=function(){v!==q&&Ra(v),h=0,u=p=c=v=q},l.flush=function(){return v===q?f:s(gs())},l}function Tr(t,e){if("function"!=typeof t||null!=e&&"function"!=typeof e)throw new xi(W);var n=function(){var o=arguments,r=e?e.apply(this,o):o[0],i=n.cache;if(i.has(r))return i.get(r);var a=t.apply(this,o);return n.cache=i.set(r,a)||i,a};return n.cache=new(Tr.Cache||ve),n}function Pr(t){if("function"!=typeof t)throw new xi(W);return function(){var e=arguments;switch(e.length){case 0:return!t.call(this);case 1:return!t.call(this,e[0]);case 2:return!t.call(this,e[0],e[1]);case 3:return!t.call(this,e[0],e[1],e[2])}return!t.apply(this,e)}}function Lr(t,e){return t===e||t!=t&&e!=e}function Ar(t){return null!=t&&Or(t.length)&&!$r(t)}function Mr(t){return Fr(t)&&Ar(t)}function Rr(t){if(!Fr(t))return!1;var e=Ke(t);return e==ct||"[object DOMException]"==e||"string"==typeof t.message&&"string"==typeof t.name&&!Er(t)}function $r(t){if(!Nr(t))return!1;var e=Ke(t);return e==dt||e==ft||"[object AsyncFunction]"==e||"[object Proxy]"==e}function zr(t){return"number"==typeof t&&t==qr(t)}function Or(t){return"number"==typeof t&&t>-1&&t%1==0&&t<=nt}function Nr(t){var e=typeof t;return null!=t&&("object"==e||"function"==e)}function Fr(t){return null!=t&&"object"==typeof t}function Ur(t){return"number"==typeof t||Fr(t)&&Ke(t)==pt}function Er(t){if(!Fr(t)||Ke(t)!=ht)return!1;var e=Ui(t);if(null===e)return!0;var n=Ti.call(e,"constructor")&&e.constructor;return"function"==typeof n&&n instanceof n&&Ii.call(n)==Mi}function Br(t){return"string"==typeof t||!Ps(t)&&Fr(t)&&Ke(t)==yt}function Vr(t){return"symbol"==typeof t||Fr(t)&&Ke(t)==_t}function Yr(t){if(!t)return[];if(Ar(t))return Br(t)?Y(t):co(t);if(Hi&&t[Hi])return function(t){for(var e,n=[];!(e=t.next()).done;)n.push(e.value);return n}(t[Hi]());var e=Fa(t);return(e==vt?N:e==bt?E:ni)(t)}function Hr(t){return t?(t=Jr(t))===et||t===-1/0?1.7976931348623157e308*(t<0?-1:1):t==t?t:0:0===t?t:0}function qr(t){var e=Hr(t),n=e%1;return e==e?n?e-n:e:0}function Wr(t){return t?Se(qr(t),0,rt):0}function Jr(t){if("number"==typeof t)return t;if(Vr(t))return ot;if(Nr(t)){var e="function"==typeof t.valueOf?t.valueOf():t;t=Nr(e)?e+"":e}if("string"!=typeof t)return 0===t?t:+t;t=T(t);var n=ie.test(t);return n||se.test(t)?He(t.slice(2),n?2:8):re.test(t)?ot:+t}function Xr(t){return fo(t,ti(t))}function Zr(t){return null==t?"":qn(t)}function Gr(t,e,n){var o=null==t?q:Ze(t,e);return o===q?n:o}function Qr(t,e){return null!=t&&qo(t,e,vn)}function Kr(t){return Ar(t)?me(t):wn(t)}function ti(t){return Ar(t)?me(t,!0):jn(t)}function ei(t,e){if(null==t)return{};var n=v(Fo(t),function(t){return[t]});return e=Bo(e),Pn(t,n,function(t,n){return e(t,n[0])})}function ni(t){return null==t?[]:L(t,Kr(t))}function oi(t){return ul(Zr(t).toLowerCase())}function ri(t){return(t=Zr(t))&&t.replace(ue,ln).replace(Re,"")}function ii(t,e,n){return t=Zr(t),(e=n?q:e)===q?function(t){return Ne.test(t)}(t)?function(t){return t.match(ze)||[]}(t):function(t){return t.match(Kt)||[]}(t):t.match(e)||[]}function ai(t){return function(){return t}}function si(t){return t}function li(t){return _n("function"==typeof t?t:Ie(t,1))}function ui(t,e,n){var o=Kr(e),r=Xe(e,o);null!=n||Nr(e)&&(r.length||!o.length)||(n=e,e=t,t=this,r=Xe(e,Kr(e)));var i=!(Nr(n)&&"chain"in n&&!n.chain),a=$r(t);return s(r,function(n){var o=e[n];t[n]=o,a&&(t.prototype[n]=function(){var e=this.__chain__;if(i||e){var n=t(this.__wrapped__);return(n.__actions__=co(this.__actions__)).push({func:o,args:arguments,thisArg:t}),n.__chain__=e,n}return o.apply(t,p([this.value()],arguments))})}),t}function ci(){}function di(t){return Go(t)?C(ur(t)):function(t){return function(e){return Ze(e,t)}}(t)}function fi(){return[]}function vi(){return!1}var pi,hi=(e=null==e?Je:dn.defaults(Je.Object(),e,dn.pick(Je,Fe))).Array,mi=e.Date,gi=e.Error,bi=e.Function,yi=e.Math,_i=e.Object,wi=e.RegExp,ji=e.String,xi=e.TypeError,Ci=hi.prototype,ki=bi.prototype,Di=_i.prototype,Si=e["__core-js_shared__"],Ii=ki.toString,Ti=Di.hasOwnProperty,Pi=0,Li=(pi=/[^.]+$/.exec(Si&&Si.keys&&Si.keys.IE_PROTO||""))?"Symbol(src)_1."+pi:"",Ai=Di.toString,Mi=Ii.call(_i),Ri=Je._,$i=wi("^"+Ii.call(Ti).replace(qt,"\\$&").replace(/hasOwnProperty|(function).*?(?=\\\()| for .+?(?=\\\])/g,"$1.*?")+"$"),zi=Ge?e.Buffer:q,Oi=e.Symbol,Ni=e.Uint8Array,Fi=zi?zi.allocUnsafe:q,Ui=F(_i.getPrototypeOf,_i),Ei=_i.create,Bi=Di.propertyIsEnumerable,Vi=Ci.splice,Yi=Oi?Oi.isConcatSpreadable:q,Hi=Oi?Oi.iterator:q,qi=Oi?Oi.toStringTag:q,Wi=function(){try{var t=Ho(_i,"defineProperty");return t({},"",{}),t}catch(t){}}(),Ji=e.clearTimeout!==Je.clearTimeout&&e.clearTimeout,Xi=mi&&mi.now!==Je.Date.now&&mi.now,Zi=e.setTimeout!==Je.setTimeout&&e.setTimeout,Gi=yi.ceil,Qi=yi.floor,Ki=_i.getOwnPropertySymbols,ta=zi?zi.isBuffer:q,ea=e.isFinite,na=Ci.join,oa=F(_i.keys,_i),ra=yi.max,ia=yi.min,aa=mi.now,sa=e.parseInt,la=yi.random,ua=Ci.reverse,ca=Ho(e,"DataView"),da=Ho(e,"Map"),fa=Ho(e,"Promise"),va=Ho(e,"Set"),pa=Ho(e,"WeakMap"),ha=Ho(_i,"create"),ma=pa&&new pa,ga={},ba=cr(ca),ya=cr(da),_a=cr(fa),wa=cr(va),ja=cr(pa),xa=Oi?Oi.prototype:q,Ca=xa?xa.valueOf:q,ka=xa?xa.toString:q,Da=function(){function t(){}return function(e){if(!Nr(e))return{};if(Ei)return Ei(e);t.prototype=e;var n=new t;return t.prototype=q,n}}();n.templateSettings={escape:Ut,evaluate:Et,interpolate:Bt,variable:"",imports:{_:n}},n.prototype=o.prototype,n.prototype.constructor=n,r.prototype=Da(o.prototype),r.prototype.constructor=r,k.prototype=Da(o.prototype),k.prototype.constructor=k,Xt.prototype.clear=function(){this.__data__=ha?ha(null):{},this.size=0},Xt.prototype.delete=function(t){var e=this.has(t)&&delete this.__data__[t];return this.size-=e?1:0,e},Xt.prototype.get=function(t){var e=this.__data__;if(ha){var n=e[t];return n===J?q:n}return Ti.call(e,t)?e[t]:q},Xt.prototype.has=function(t){var e=this.__data__;return ha?e[t]!==q:Ti.call(e,t)},Xt.prototype.set=function(t,e){var n=this.__data__;return this.size+=this.has(t)?0:1,n[t]=ha&&e===q?J:e,this},fe.prototype.clear=function(){this.__data__=[],this.size=0},fe.prototype.delete=function(t){var e=this.__data__,n=je(e,t);return!(n<0||(n==e.length-1?e.pop():Vi.call(e,n,1),--this.size,0))},fe.prototype.get=function(t){var e=this.__data__,n=je(e,t);return n<0?q:e[n][1]},fe.prototype.has=function(t){return je(this.__data__,t)>-1},fe.prototype.set=function(t,e){var n=this.__data__,o=je(n,t);return o<0?(++this.size,n.push([t,e])):n[o][1]=e,this},ve.prototype.clear=function(){this.size=0,this.__data__={hash:new Xt,map:new(da||fe),string:new Xt}},ve.prototype.delete=function(t){var e=Vo(this,t).delete(t);return this.size-=e?1:0,e},ve.prototype.get=function(t){return Vo(this,t).get(t)},ve.prototype.has=function(t){return Vo(this,t).has(t)},ve.prototype.set=function(t,e){var n=Vo(this,t),o=n.size;return n.set(t,e),this.size+=n.size==o?0:1,this},pe.prototype.add=pe.prototype.push=function(t){return this.__data__.set(t,J),this},pe.prototype.has=function(t){return this.__data__.has(t)},he.prototype.clear=function(){this.__data__=new fe,this.size=0},he.prototype.delete=function(t){var e=this.__data__,n=e.delete(t);return this.size=e.size,n},he.prototype.get=function(t){return this.__data__.get(t)},he.prototype.has=function(t){return this.__data__.has(t)},he.prototype.set=function(t,e){var n=this.__data__;if(n instanceof fe){var o=n.__data__;if(!da||o.length<199)return o.push([t,e]),this.size=++n.size,this;n=this.__data__=new ve(o)}return n.set(t,e),this.size=n.size,this};var Sa=ho(qe),Ia=ho(We,!0),Ta=mo(),Pa=mo(!0),La=ma?function(t,e){return ma.set(t,e),t}:si,Aa=Wi?function(t,e){return Wi(t,"toString",{configurable:!0,enumerable:!1,value:ai(e),writable:!0})}:si,Ma=$n,Ra=Ji||function(t){return Je.clearTimeout(t)},$a=va&&1/E(new va([,-0]))[1]==et?function(t){return new va(t)}:ci,za=ma?function(t){return ma.get(t)}:ci,Oa=Ki?function(t){return null==t?[]:(t=_i(t),c(Ki(t),function(e){return Bi.call(t,e)}))}:fi,Na=Ki?function(t){for(var e=[];t;)p(e,Oa(t)),t=Ui(t);return e}:fi,Fa=Ke;(ca&&Fa(new ca(new ArrayBuffer(1)))!=xt||da&&Fa(new da)!=vt||fa&&Fa(fa.resolve())!=mt||va&&Fa(new va)!=bt||pa&&Fa(new pa)!=wt)&&(Fa=function(t){var e=Ke(t),n=e==ht?t.constructor:q,o=n?cr(n):"";if(o)switch(o){case ba:return xt;case ya:return vt;case _a:return mt;case wa:return bt;case ja:return wt}return e});var Ua=Si?$r:vi,Ea=sr(La),Ba=Zi||function(t,e){return Je.setTimeout(t,e)},Va=sr(Aa),Ya=function(t){var e=Tr(t,function(t){return 500===n.size&&n.clear(),t}),n=e.cache;return e}(function(t){var e=[];return 46===t.charCodeAt(0)&&e.push(""),t.replace(Ht,function(t,n,o,r){e.push(o?r.replace(ee,"$1"):n||t)}),e}),Ha=$n(function(t,e){return Mr(t)?Le(t,Ve(e,1,Mr,!0)):[]}),qa=$n(function(t,e){var n=mr(e);return Mr(n)&&(n=q),Mr(t)?Le(t,Ve(e,1,Mr,!0),Bo(n,2)):[]}),Wa=$n(function(t,e){var n=mr(e);return Mr(n)&&(n=q),Mr(t)?Le(t,Ve(e,1,Mr,!0),q,n):[]}),Ja=$n(function(t){var e=v(t,to);return e.length&&e[0]===t[0]?pn(e):[]}),Xa=$n(function(t){var e=mr(t),n=v(t,to);return e===mr(n)?e=q:n.pop(),n.length&&n[0]===t[0]?pn(n,Bo(e,2)):[]}),Za=$n(function(t){var e=mr(t),n=v(t,to);return(e="function"==typeof e?e:q)&&n.pop(),n.length&&n[0]===t[0]?pn(n,q,e):[]}),Ga=$n(gr),Qa=Oo(function(t,e){var n=null==t?0:t.length,o=De(t,e);return An(t,v(e,function(t){return Xo(t,n)?+t:t}).sort(so)),o}),Ka=$n(function(t){return Wn(Ve(t,1,Mr,!0))}),ts=$n(function(t){var e=mr(t);return Mr(e)&&(e=q),Wn(Ve(t,1,Mr,!0),Bo(e,2))}),es=$n(function(t){var e=mr(t);return e="function"==typeof e?e:q,Wn(Ve(t,1,Mr,!0),q,e)}),ns=$n(function(t,e){return Mr(t)?Le(t,e):[]}),os=$n(function(t){return Qn(c(t,Mr))}),rs=$n(function(t){var e=mr(t);return Mr(e)&&(e=q),Qn(c(t,Mr),Bo(e,2))}),is=$n(function(t){var e=mr(t);return e="function"==typeof e?e:q,Qn(c(t,Mr),q,e)}),as=$n(yr),ss=$n(function(t){var e=t.length,n=e>1?t[e-1]:q;return n="function"==typeof n?(t.pop(),n):q,_r(t,n)}),ls=Oo(function(t){var e=t.length,n=e?t[0]:0,o=this.__wrapped__,i=function(e){return De(e,t)};return!(e>1||this.__actions__.length)&&o instanceof k&&Xo(n)?((o=o.slice(n,+n+(e?1:0))).__actions__.push({func:jr,args:[i],thisArg:q}),new r(o,this.__chain__).thru(function(t){return e&&!t.length&&t.push(q),t})):this.thru(i)}),us=vo(function(t,e,n){Ti.call(t,n)?++t[n]:ke(t,n,1)}),cs=_o(fr),ds=_o(vr),fs=vo(function(t,e,n){Ti.call(t,n)?t[n].push(e):ke(t,n,[e])}),vs=$n(function(t,e,n){var o=-1,r="function"==typeof e,a=Ar(t)?hi(t.length):[];return Sa(t,function(t){a[++o]=r?i(e,t,n):hn(t,e,n)}),a}),ps=vo(function(t,e,n){ke(t,n,e)}),hs=vo(function(t,e,n){t[n?0:1].push(e)},function(){return[[],[]]}),ms=$n(function(t,e){if(null==t)return[];var n=e.length;return n>1&&Zo(t,e[0],e[1])?e=[]:n>2&&Zo(e[0],e[1],e[2])&&(e=[e[0]]),Tn(t,Ve(e,1),[])}),gs=Xi||function(){return Je.Date.now()},bs=$n(function(t,e,n){var o=1;if(n.length){var r=U(n,Eo(bs));o|=G}return Ao(t,o,e,n,r)}),ys=$n(function(t,e,n){var o=3;if(n.length){var r=U(n,Eo(ys));o|=G}return Ao(e,o,t,n,r)}),_s=$n(function(t,e){return Pe(t,1,e)}),ws=$n(function(t,e,n){return Pe(t,Jr(e)||0,n)});Tr.Cache=ve;var js,xs=Ma(function(t,e){var n=(e=1==e.length&&Ps(e[0])?v(e[0],P(Bo())):v(Ve(e,1),P(Bo()))).length;return $n(function(o){for(var r=-1,a=ia(o.length,n);++r<a;)o[r]=e[r].call(this,o[r]);return i(t,this,o)})}),Cs=$n(function(t,e){var n=U(e,Eo(Cs));return Ao(t,G,q,e,n)}),ks=$n(function(t,e){var n=U(e,Eo(ks));return Ao(t,Q,q,e,n)}),Ds=Oo(function(t,e){return Ao(t,tt,q,q,q,e)}),Ss=Io(sn),Is=Io(function(t,e){return t>=e}),Ts=mn(function(){return arguments}())?mn:function(t){return Fr(t)&&Ti.call(t,"callee")&&!Bi.call(t,"callee")},Ps=hi.isArray,Ls=tn?P(tn):function(t){return Fr(t)&&Ke(t)==jt},As=ta||vi,Ms=en?P(en):function(t){return Fr(t)&&Ke(t)==ut},Rs=nn?P(nn):function(t){return Fr(t)&&Fa(t)==vt},$s=on?P(on):function(t){return Fr(t)&&Ke(t)==gt},zs=rn?P(rn):function(t){return Fr(t)&&Fa(t)==bt},Os=an?P(an):function(t){return Fr(t)&&Or(t.length)&&!!Ee[Ke(t)]},Ns=Io(xn),Fs=Io(function(t,e){return t<=e}),Us=po(function(t,e){if(Ko(e)||Ar(e))fo(e,Kr(e),t);else for(var n in e)Ti.call(e,n)&&we(t,n,e[n])}),Es=po(function(t,e){fo(e,ti(e),t)}),Bs=po(function(t,e,n,o){fo(e,ti(e),t,o)}),Vs=po(function(t,e,n,o){fo(e,Kr(e),t,o)}),Ys=Oo(De),Hs=$n(function(t,e){t=_i(t);var n=-1,o=e.length,r=o>2?e[2]:q;for(r&&Zo(e[0],e[1],r)&&(o=1);++n<o;)for(var i=e[n],a=ti(i),s=-1,l=a.length;++s<l;){var u=a[s],c=t[u];(c===q||Lr(c,Di[u])&&!Ti.call(t,u))&&(t[u]=i[u])}return t}),qs=$n(function(t){return t.push(q,Ro),i(Gs,q,t)}),Ws=xo(function(t,e,n){null!=e&&"function"!=typeof e.toString&&(e=Ai.call(e)),t[e]=n},ai(si)),Js=xo(function(t,e,n){null!=e&&"function"!=typeof e.toString&&(e=Ai.call(e)),Ti.call(t,e)?t[e].push(n):t[e]=[n]},Bo),Xs=$n(hn),Zs=po(function(t,e,n){Sn(t,e,n)}),Gs=po(function(t,e,n,o){Sn(t,e,n,o)}),Qs=Oo(function(t,e){var n={};if(null==t)return n;var o=!1;e=v(e,function(e){return e=no(e,t),o||(o=e.length>1),e}),fo(t,Fo(t),n),o&&(n=Ie(n,7,$o));for(var r=e.length;r--;)Jn(n,e[r]);return n}),Ks=Oo(function(t,e){return null==t?{}:function(t,e){return Pn(t,e,function(e,n){return Qr(t,n)})}(t,e)}),tl=Lo(Kr),el=Lo(ti),nl=bo(function(t,e,n){return e=e.toLowerCase(),t+(n?oi(e):e)}),ol=bo(function(t,e,n){return t+(n?"-":"")+e.toLowerCase()}),rl=bo(function(t,e,n){return t+(n?" ":"")+e.toLowerCase()}),il=go("toLowerCase"),al=bo(function(t,e,n){return t+(n?"_":"")+e.toLowerCase()}),sl=bo(function(t,e,n){return t+(n?" ":"")+ul(e)}),ll=bo(function(t,e,n){return t+(n?" ":"")+e.toUpperCase()}),ul=go("toUpperCase"),cl=$n(function(t,e){try{return i(t,q,e)}catch(t){return Rr(t)?t:new gi(t)}}),dl=Oo(function(t,e){return s(e,function(e){e=ur(e),ke(t,e,bs(t[e],t))}),t}),fl=wo(),vl=wo(!0),pl=$n(function(t,e){return function(n){return hn(n,t,e)}}),hl=$n(function(t,e){return function(n){return hn(t,n,e)}}),ml=ko(v),gl=ko(u),bl=ko(g),yl=So(),_l=So(!0),wl=Co(function(t,e){return t+e},0),jl=Po("ceil"),xl=Co(function(t,e){return t/e},1),Cl=Po("floor"),kl=Co(function(t,e){return t*e},1),Dl=Po("round"),Sl=Co(function(t,e){return t-e},0);return n.after=function(t,e){if("function"!=typeof e)throw new xi(W);return t=qr(t),function(){if(--t<1)return e.apply(this,arguments)}},n.ary=Dr,n.assign=Us,n.assignIn=Es,n.assignInWith=Bs,n.assignWith=Vs,n.at=Ys,n.before=Sr,n.bind=bs,n.bindAll=dl,n.bindKey=ys,n.castArray=function(){if(!arguments.length)return[];var t=arguments[0];return Ps(t)?t:[t]},n.chain=wr,n.chunk=function(t,e,n){e=(n?Zo(t,e,n):e===q)?1:ra(qr(e),0);var o=null==t?0:t.length;if(!o||e<1)return[];for(var r=0,i=0,a=hi(Gi(o/e));r<o;)a[i++]=Un(t,r,r+=e);return a},n.compact=function(t){for(var e=-1,n=null==t?0:t.length,o=0,r=[];++e<n;){var i=t[e];i&&(r[o++]=i)}return r},n.concat=function(){var t=arguments.length;if(!t)return[];for(var e=hi(t-1),n=arguments[0],o=t;o--;)e[o-1]=arguments[o];return p(Ps(n)?co(n):[n],Ve(e,1))},n.cond=function(t){var e=null==t?0:t.length,n=Bo();return t=e?v(t,function(t){if("function"!=typeof t[1])throw new xi(W);return[n(t[0]),t[1]]}):[],$n(function(n){for(var o=-1;++o<e;){var r=t[o];if(i(r[0],this,n))return i(r[1],this,n)}})},n.conforms=function(t){return function(t){var e=Kr(t);return function(n){return Te(n,t,e)}}(Ie(t,1))},n.constant=ai,n.countBy=us,n.create=function(t,e){var n=Da(t);return null==e?n:Ce(n,e)},n.curry=function t(e,n,o){var r=Ao(e,8,q,q,q,q,q,n=o?q:n);return r.placeholder=t.placeholder,r},n.curryRight=function t(e,n,o){var r=Ao(e,Z,q,q,q,q,q,n=o?q:n);return r.placeholder=t.placeholder,r},n.debounce=Ir,n.defaults=Hs,n.defaultsDeep=qs,n.defer=_s,n.delay=ws,n.difference=Ha,n.differenceBy=qa,n.differenceWith=Wa,n.drop=function(t,e,n){var o=null==t?0:t.length;return o?Un(t,(e=n||e===q?1:qr(e))<0?0:e,o):[]},n.dropRight=function(t,e,n){var o=null==t?0:t.length;return o?Un(t,0,(e=o-(e=n||e===q?1:qr(e)))<0?0:e):[]},n.dropRightWhile=function(t,e){return t&&t.length?Zn(t,Bo(e,3),!0,!0):[]},n.dropWhile=function(t,e){return t&&t.length?Zn(t,Bo(e,3),!0):[]},n.fill=function(t,e,n,o){var r=null==t?0:t.length;return r?(n&&"number"!=typeof n&&Zo(t,e,n)&&(n=0,o=r),function(t,e,n,o){var r=t.length;for((n=qr(n))<0&&(n=-n>r?0:r+n),(o=o===q||o>r?r:qr(o))<0&&(o+=r),o=n>o?0:Wr(o);n<o;)t[n++]=e;return t}(t,e,n,o)):[]},n.filter=function(t,e){return(Ps(t)?c:Oe)(t,Bo(e,3))},n.flatMap=function(t,e){return Ve(kr(t,e),1)},n.flatMapDeep=function(t,e){return Ve(kr(t,e),et)},n.flatMapDepth=function(t,e,n){return n=n===q?1:qr(n),Ve(kr(t,e),n)},n.flatten=pr,n.flattenDeep=function(t){return(null==t?0:t.length)?Ve(t,et):[]},n.flattenDepth=function(t,e){return(null==t?0:t.length)?Ve(t,e=e===q?1:qr(e)):[]},n.flip=function(t){return Ao(t,512)},n.flow=fl,n.flowRight=vl,n.fromPairs=function(t){for(var e=-1,n=null==t?0:t.length,o={};++e<n;){var r=t[e];o[r[0]]=r[1]}return o},n.functions=function(t){return null==t?[]:Xe(t,Kr(t))},n.functionsIn=function(t){return null==t?[]:Xe(t,ti(t))},n.groupBy=fs,n.initial=function(t){return(null==t?0:t.length)?Un(t,0,-1):[]},n.intersection=Ja,n.intersectionBy=Xa,n.intersectionWith=Za,n.invert=Ws,n.invertBy=Js,n.invokeMap=vs,n.iteratee=li,n.keyBy=ps,n.keys=Kr,n.keysIn=ti,n.map=kr,n.mapKeys=function(t,e){var n={};return e=Bo(e,3),qe(t,function(t,o,r){ke(n,e(t,o,r),t)}),n},n.mapValues=function(t,e){var n={};return e=Bo(e,3),qe(t,function(t,o,r){ke(n,o,e(t,o,r))}),n},n.matches=function(t){return kn(Ie(t,1))},n.matchesProperty=function(t,e){return Dn(t,Ie(e,1))},n.memoize=Tr,n.merge=Zs,n.mergeWith=Gs,n.method=pl,n.methodOf=hl,n.mixin=ui,n.negate=Pr,n.nthArg=function(t){return t=qr(t),$n(function(e){return In(e,t)})},n.omit=Qs,n.omitBy=function(t,e){return ei(t,Pr(Bo(e)))},n.once=function(t){return Sr(2,t)},n.orderBy=function(t,e,n,o){return null==t?[]:(Ps(e)||(e=null==e?[]:[e]),Ps(n=o?q:n)||(n=null==n?[]:[n]),Tn(t,e,n))},n.over=ml,n.overArgs=xs,n.overEvery=gl,n.overSome=bl,n.partial=Cs,n.partialRight=ks,n.partition=hs,n.pick=Ks,n.pickBy=ei,n.property=di,n.propertyOf=function(t){return function(e){return null==t?q:Ze(t,e)}},n.pull=Ga,n.pullAll=gr,n.pullAllBy=function(t,e,n){return t&&t.length&&e&&e.length?Ln(t,e,Bo(n,2)):t},n.pullAllWith=function(t,e,n){return t&&t.length&&e&&e.length?Ln(t,e,q,n):t},n.pullAt=Qa,n.range=yl,n.rangeRight=_l,n.rearg=Ds,n.reject=function(t,e){return(Ps(t)?c:Oe)(t,Pr(Bo(e,3)))},n.remove=function(t,e){var n=[];if(!t||!t.length)return n;var o=-1,r=[],i=t.length;for(e=Bo(e,3);++o<i;){var a=t[o];e(a,o,t)&&(n.push(a),r.push(o))}return An(t,r),n},n.rest=function(t,e){if("function"!=typeof t)throw new xi(W);return $n(t,e=e===q?e:qr(e))},n.reverse=br,n.sampleSize=function(t,e,n){return e=(n?Zo(t,e,n):e===q)?1:qr(e),(Ps(t)?be:On)(t,e)},n.set=function(t,e,n){return null==t?t:Nn(t,e,n)},n.setWith=function(t,e,n,o){return o="function"==typeof o?o:q,null==t?t:Nn(t,e,n,o)},n.shuffle=function(t){return(Ps(t)?ye:Fn)(t)},n.slice=function(t,e,n){var o=null==t?0:t.length;return o?(n&&"number"!=typeof n&&Zo(t,e,n)?(e=0,n=o):(e=null==e?0:qr(e),n=n===q?o:qr(n)),Un(t,e,n)):[]},n.sortBy=ms,n.sortedUniq=function(t){return t&&t.length?Yn(t):[]},n.sortedUniqBy=function(t,e){return t&&t.length?Yn(t,Bo(e,2)):[]},n.split=function(t,e,n){return n&&"number"!=typeof n&&Zo(t,e,n)&&(e=n=q),(n=n===q?rt:n>>>0)?(t=Zr(t))&&("string"==typeof e||null!=e&&!$s(e))&&!(e=qn(e))&&O(t)?oo(Y(t),0,n):t.split(e,n):[]},n.spread=function(t,e){if("function"!=typeof t)throw new xi(W);return e=null==e?0:ra(qr(e),0),$n(function(n){var o=n[e],r=oo(n,0,e);return o&&p(r,o),i(t,this,r)})},n.tail=function(t){var e=null==t?0:t.length;return e?Un(t,1,e):[]},n.take=function(t,e,n){return t&&t.length?Un(t,0,(e=n||e===q?1:qr(e))<0?0:e):[]},n.takeRight=function(t,e,n){var o=null==t?0:t.length;return o?Un(t,(e=o-(e=n||e===q?1:qr(e)))<0?0:e,o):[]},n.takeRightWhile=function(t,e){return t&&t.length?Zn(t,Bo(e,3),!1,!0):[]},n.takeWhile=function(t,e){return t&&t.length?Zn(t,Bo(e,3)):[]},n.tap=function(t,e){return e(t),t},n.throttle=function(t,e,n){var o=!0,r=!0;if("function"!=typeof t)throw new xi(W);return Nr(n)&&(o="leading"in n?!!n.leading:o,r="trailing"in n?!!n.trailing:r),Ir(t,e,{leading:o,maxWait:e,trailing:r})},n.thru=jr,n.toArray=Yr,n.toPairs=tl,n.toPairsIn=el,n.toPath=function(t){return Ps(t)?v(t,ur):Vr(t)?[t]:co(Ya(Zr(t)))},n.toPlainObject=Xr,n.transform=function(t,e,n){var o=Ps(t),r=o||As(t)||Os(t);if(e=Bo(e,4),null==n){var i=t&&t.constructor;n=r?o?new i:[]:Nr(t)&&$r(i)?Da(Ui(t)):{}}return(r?s:qe)(t,function(t,o,r){return e(n,t,o,r)}),n},n.unary=function(t){return Dr(t,1)},n.union=Ka,n.unionBy=ts,n.unionWith=es,n.uniq=function(t){return t&&t.length?Wn(t):[]},n.uniqBy=function(t,e){return t&&t.length?Wn(t,Bo(e,2)):[]},n.uniqWith=function(t,e){return e="function"==typeof e?e:q,t&&t.length?Wn(t,q,e):[]},n.unset=function(t,e){return null==t||Jn(t,e)},n.unzip=yr,n.unzipWith=_r,n.update=function(t,e,n){return null==t?t:Xn(t,e,eo(n))},n.updateWith=function(t,e,n,o){return o="function"==typeof o?o:q,null==t?t:Xn(t,e,eo(n),o)},n.values=ni,n.valuesIn=function(t){return null==t?[]:L(t,ti(t))},n.without=ns,n.words=ii,n.wrap=function(t,e){return Cs(eo(e),t)},n.xor=os,n.xorBy=rs,n.xorWith=is,n.zip=as,n.zipObject=function(t,e){return Kn(t||[],e||[],we)},n.zipObjectDeep=function(t,e){return Kn(t||[],e||[],Nn)},n.zipWith=ss,n.entries=tl,n.entriesIn=el,n.extend=Es,n.extendWith=Bs,ui(n,n),n.add=wl,n.attempt=cl,n.camelCase=nl,n.capitalize=oi,n.ceil=jl,n.clamp=function(t,e,n){return n===q&&(n=e,e=q),n!==q&&(n=(n=Jr(n))==n?n:0),e!==q&&(e=(e=Jr(e))==e?e:0),Se(Jr(t),e,n)},n.clone=function(t){return Ie(t,4)},n.cloneDeep=function(t){return Ie(t,5)},n.cloneDeepWith=function(t,e){return Ie(t,5,e="function"==typeof e?e:q)},n.cloneWith=function(t,e){return Ie(t,4,e="function"==typeof e?e:q)},n.conformsTo=function(t,e){return null==e||Te(t,e,Kr(e))},n.deburr=ri,n.defaultTo=function(t,e){return null==t||t!=t?e:t},n.divide=xl,n.endsWith=function(t,e,n){t=Zr(t),e=qn(e);var o=t.length,r=n=n===q?o:Se(qr(n),0,o);return(n-=e.length)>=0&&t.slice(n,r)==e},n.eq=Lr,n.escape=function(t){return(t=Zr(t))&&Ft.test(t)?t.replace(Ot,un):t},n.escapeRegExp=function(t){return(t=Zr(t))&&Wt.test(t)?t.replace(qt,"\\$&"):t},n.every=function(t,e,n){var o=Ps(t)?u:Ae;return n&&Zo(t,e,n)&&(e=q),o(t,Bo(e,3))},n.find=cs,n.findIndex=fr,n.findKey=function(t,e){return b(t,Bo(e,3),qe)},n.findLast=ds,n.findLastIndex=vr,n.findLastKey=function(t,e){return b(t,Bo(e,3),We)},n.floor=Cl,n.forEach=xr,n.forEachRight=Cr,n.forIn=function(t,e){return null==t?t:Ta(t,Bo(e,3),ti)},n.forInRight=function(t,e){return null==t?t:Pa(t,Bo(e,3),ti)},n.forOwn=function(t,e){return t&&qe(t,Bo(e,3))},n.forOwnRight=function(t,e){return t&&We(t,Bo(e,3))},n.get=Gr,n.gt=Ss,n.gte=Is,n.has=function(t,e){return null!=t&&qo(t,e,fn)},n.hasIn=Qr,n.head=hr,n.identity=si,n.includes=function(t,e,n,o){t=Ar(t)?t:ni(t),n=n&&!o?qr(n):0;var r=t.length;return n<0&&(n=ra(r+n,0)),Br(t)?n<=r&&t.indexOf(e,n)>-1:!!r&&_(t,e,n)>-1},n.indexOf=function(t,e,n){var o=null==t?0:t.length;if(!o)return-1;var r=null==n?0:qr(n);return r<0&&(r=ra(o+r,0)),_(t,e,r)},n.inRange=function(t,e,n){return e=Hr(e),n===q?(n=e,e=0):n=Hr(n),function(t,e,n){return t>=ia(e,n)&&t<ra(e,n)}(t=Jr(t),e,n)},n.invoke=Xs,n.isArguments=Ts,n.isArray=Ps,n.isArrayBuffer=Ls,n.isArrayLike=Ar,n.isArrayLikeObject=Mr,n.isBoolean=function(t){return!0===t||!1===t||Fr(t)&&Ke(t)==lt},n.isBuffer=As,n.isDate=Ms,n.isElement=function(t){return Fr(t)&&1===t.nodeType&&!Er(t)},n.isEmpty=function(t){if(null==t)return!0;if(Ar(t)&&(Ps(t)||"string"==typeof t||"function"==typeof t.splice||As(t)||Os(t)||Ts(t)))return!t.length;var e=Fa(t);if(e==vt||e==bt)return!t.size;if(Ko(t))return!wn(t).length;for(var n in t)if(Ti.call(t,n))return!1;return!0},n.isEqual=function(t,e){return gn(t,e)},n.isEqualWith=function(t,e,n){var o=(n="function"==typeof n?n:q)?n(t,e):q;return o===q?gn(t,e,q,n):!!o},n.isError=Rr,n.isFinite=function(t){return"number"==typeof t&&ea(t)},n.isFunction=$r,n.isInteger=zr,n.isLength=Or,n.isMap=Rs,n.isMatch=function(t,e){return t===e||bn(t,e,Yo(e))},n.isMatchWith=function(t,e,n){return n="function"==typeof n?n:q,bn(t,e,Yo(e),n)},n.isNaN=function(t){return Ur(t)&&t!=+t},n.isNative=function(t){if(Ua(t))throw new gi("Unsupported core-js use. Try https://npms.io/search?q=ponyfill.");return yn(t)},n.isNil=function(t){return null==t},n.isNull=function(t){return null===t},n.isNumber=Ur,n.isObject=Nr,n.isObjectLike=Fr,n.isPlainObject=Er,n.isRegExp=$s,n.isSafeInteger=function(t){return zr(t)&&t>=-9007199254740991&&t<=nt},n.isSet=zs,n.isString=Br,n.isSymbol=Vr,n.isTypedArray=Os,n.isUndefined=function(t){return t===q},n.isWeakMap=function(t){return Fr(t)&&Fa(t)==wt},n.isWeakSet=function(t){return Fr(t)&&"[object WeakSet]"==Ke(t)},n.join=function(t,e){return null==t?"":na.call(t,e)},n.kebabCase=ol,n.last=mr,n.lastIndexOf=function(t,e,n){var o=null==t?0:t.length;if(!o)return-1;var r=o;return n!==q&&(r=(r=qr(n))<0?ra(o+r,0):ia(r,o-1)),e==e?function(t,e,n){for(var o=n+1;o--;)if(t[o]===e)return o;return o}(t,e,r):y(t,j,r,!0)},n.lowerCase=rl,n.lowerFirst=il,n.lt=Ns,n.lte=Fs,n.max=function(t){return t&&t.length?$e(t,si,sn):q},n.maxBy=function(t,e){return t&&t.length?$e(t,Bo(e,2),sn):q},n.mean=function(t){return x(t,si)},n.meanBy=function(t,e){return x(t,Bo(e,2))},n.min=function(t){return t&&t.length?$e(t,si,xn):q},n.minBy=function(t,e){return t&&t.length?$e(t,Bo(e,2),xn):q},n.stubArray=fi,n.stubFalse=vi,n.stubObject=function(){return{}},n.stubString=function(){return""},n.stubTrue=function(){return!0},n.multiply=kl,n.nth=function(t,e){return t&&t.length?In(t,qr(e)):q},n.noConflict=function(){return Je._===this&&(Je._=Ri),this},n.noop=ci,n.now=gs,n.pad=function(t,e,n){t=Zr(t);var o=(e=qr(e))?V(t):0;if(!e||o>=e)return t;var r=(e-o)/2;return Do(Qi(r),n)+t+Do(Gi(r),n)},n.padEnd=function(t,e,n){t=Zr(t);var o=(e=qr(e))?V(t):0;return e&&o<e?t+Do(e-o,n):t},n.padStart=function(t,e,n){t=Zr(t);var o=(e=qr(e))?V(t):0;return e&&o<e?Do(e-o,n)+t:t},n.parseInt=function(t,e,n){return n||null==e?e=0:e&&(e=+e),sa(Zr(t).replace(Jt,""),e||0)},n.random=function(t,e,n){if(n&&"boolean"!=typeof n&&Zo(t,e,n)&&(e=n=q),n===q&&("boolean"==typeof e?(n=e,e=q):"boolean"==typeof t&&(n=t,t=q)),t===q&&e===q?(t=0,e=1):(t=Hr(t),e===q?(e=t,t=0):e=Hr(e)),t>e){var o=t;t=e,e=o}if(n||t%1||e%1){var r=la();return ia(t+r*(e-t+Ye("1e-"+((r+"").length-1))),e)}return Mn(t,e)},n.reduce=function(t,e,n){var o=Ps(t)?h:D,r=arguments.length<3;return o(t,Bo(e,4),n,r,Sa)},n.reduceRight=function(t,e,n){var o=Ps(t)?m:D,r=arguments.length<3;return o(t,Bo(e,4),n,r,Ia)},n.repeat=function(t,e,n){return e=(n?Zo(t,e,n):e===q)?1:qr(e),Rn(Zr(t),e)},n.replace=function(){var t=arguments,e=Zr(t[0]);return t.length<3?e:e.replace(t[1],t[2])},n.result=function(t,e,n){var o=-1,r=(e=no(e,t)).length;for(r||(r=1,t=q);++o<r;){var i=null==t?q:t[ur(e[o])];i===q&&(o=r,i=n),t=$r(i)?i.call(t):i}return t},n.round=Dl,n.runInContext=t,n.sample=function(t){return(Ps(t)?ge:zn)(t)},n.size=function(t){if(null==t)return 0;if(Ar(t))return Br(t)?V(t):t.length;var e=Fa(t);return e==vt||e==bt?t.size:wn(t).length},n.snakeCase=al,n.some=function(t,e,n){var o=Ps(t)?g:En;return n&&Zo(t,e,n)&&(e=q),o(t,Bo(e,3))},n.sortedIndex=function(t,e){return Bn(t,e)},n.sortedIndexBy=function(t,e,n){return Vn(t,e,Bo(n,2))},n.sortedIndexOf=function(t,e){var n=null==t?0:t.length;if(n){var o=Bn(t,e);if(o<n&&Lr(t[o],e))return o}return-1},n.sortedLastIndex=function(t,e){return Bn(t,e,!0)},n.sortedLastIndexBy=function(t,e,n){return Vn(t,e,Bo(n,2),!0)},n.sortedLastIndexOf=function(t,e){if(null==t?0:t.length){var n=Bn(t,e,!0)-1;if(Lr(t[n],e))return n}return-1},n.startCase=sl,n.startsWith=function(t,e,n){return t=Zr(t),n=null==n?0:Se(qr(n),0,t.length),e=qn(e),t.slice(n,n+e.length)==e},n.subtract=Sl,n.sum=function(t){return t&&t.length?S(t,si):0},n.sumBy=function(t,e){return t&&t.length?S(t,Bo(e,2)):0},n.template=function(t,e,o){var r=n.templateSettings;o&&Zo(t,e,o)&&(e=q),t=Zr(t),e=Bs({},e,r,Mo);var i,a,s=Bs({},e.imports,r.imports,Mo),l=Kr(s),u=L(s,l),c=0,d=e.interpolate||ce,f="__p += '",v=wi((e.escape||ce).source+"|"+d.source+"|"+(d===Bt?ne:ce).source+"|"+(e.evaluate||ce).source+"|$","g"),p="//# sourceURL="+(Ti.call(e,"sourceURL")?(e.sourceURL+"").replace(/\s/g," "):"lodash.templateSources["+ ++Ue+"]")+"\n";t.replace(v,function(e,n,o,r,s,l){return o||(o=r),f+=t.slice(c,l).replace(de,z),n&&(i=!0,f+="' +\n__e("+n+") +\n'"),s&&(a=!0,f+="';\n"+s+";\n__p += '"),o&&(f+="' +\n((__t = ("+o+")) == null ? '' : __t) +\n'"),c=l+e.length,e}),f+="';\n";var h=Ti.call(e,"variable")&&e.variable;if(h){if(te.test(h))throw new gi("Invalid `variable` option passed into `_.template`")}else f="with (obj) {\n"+f+"\n}\n";f=(a?f.replace(Mt,""):f).replace(Rt,"$1").replace($t,"$1;"),f="function("+(h||"obj")+") {\n"+(h?"":"obj || (obj = {});\n")+"var __t, __p = ''"+(i?", __e = _.escape":"")+(a?", __j = Array.prototype.join;\nfunction print() { __p += __j.call(arguments, '') }\n":";\n")+f+"return __p\n}";var m=cl(function(){return bi(l,p+"return "+f).apply(q,u)});if(m.source=f,Rr(m))throw m;return m},n.times=function(t,e){if((t=qr(t))<1||t>nt)return[];var n=rt,o=ia(t,rt);e=Bo(e),t-=rt;for(var r=I(o,e);++n<t;)e(n);return r},n.toFinite=Hr,n.toInteger=qr,n.toLength=Wr,n.toLower=function(t){return Zr(t).toLowerCase()},n.toNumber=Jr,n.toSafeInteger=function(t){return t?Se(qr(t),-9007199254740991,nt):0===t?t:0},n.toString=Zr,n.toUpper=function(t){return Zr(t).toUpperCase()},n.trim=function(t,e,n){if((t=Zr(t))&&(n||e===q))return T(t);if(!t||!(e=qn(e)))return t;var o=Y(t),r=Y(e);return oo(o,M(o,r),R(o,r)+1).join("")},n.trimEnd=function(t,e,n){if((t=Zr(t))&&(n||e===q))return t.slice(0,H(t)+1);if(!t||!(e=qn(e)))return t;var o=Y(t);return oo(o,0,R(o,Y(e))+1).join("")},n.trimStart=function(t,e,n){if((t=Zr(t))&&(n||e===q))return t.replace(Jt,"");if(!t||!(e=qn(e)))return t;var o=Y(t);return oo(o,M(o,Y(e))).join("")},n.truncate=function(t,e){var n=30,o="...";if(Nr(e)){var r="separator"in e?e.separator:r;n="length"in e?qr(e.length):n,o="omission"in e?qn(e.omission):o}var i=(t=Zr(t)).length;if(O(t)){var a=Y(t);i=a.length}if(n>=i)return t;var s=n-V(o);if(s<1)return o;var l=a?oo(a,0,s).join(""):t.slice(0,s);if(r===q)return l+o;if(a&&(s+=l.length-s),$s(r)){if(t.slice(s).search(r)){var u,c=l;for(r.global||(r=wi(r.source,Zr(oe.exec(r))+"g")),r.lastIndex=0;u=r.exec(c);)var d=u.index;l=l.slice(0,d===q?s:d)}}else if(t.indexOf(qn(r),s)!=s){var f=l.lastIndexOf(r);f>-1&&(l=l.slice(0,f))}return l+o},n.unescape=function(t){return(t=Zr(t))&&Nt.test(t)?t.replace(zt,cn):t},n.uniqueId=function(t){var e=++Pi;return Zr(t)+e},n.upperCase=ll,n.upperFirst=ul,n.each=xr,n.eachRight=Cr,n.first=hr,ui(n,(js={},qe(n,function(t,e){Ti.call(n.prototype,e)||(js[e]=t)}),js),{chain:!1}),n.VERSION="4.17.21",s(["bind","bindKey","curry","curryRight","partial","partialRight"],function(t){n[t].placeholder=n}),s(["drop","take"],function(t,e){k.prototype[t]=function(n){n=n===q?1:ra(qr(n),0);var o=this.__filtered__&&!e?new k(this):this.clone();return o.__filtered__?o.__takeCount__=ia(n,o.__takeCount__):o.__views__.push({size:ia(n,rt),type:t+(o.__dir__<0?"Right":"")}),o},k.prototype[t+"Right"]=function(e){return this.reverse()[t](e).reverse()}}),s(["filter","map","takeWhile"],function(t,e){var n=e+1,o=1==n||3==n;k.prototype[t]=function(t){var e=this.clone();return e.__iteratees__.push({iteratee:Bo(t,3),type:n}),e.__filtered__=e.__filtered__||o,e}}),s(["head","last"],function(t,e){var n="take"+(e?"Right":"");k.prototype[t]=function(){return this[n](1).value()[0]}}),s(["initial","tail"],function(t,e){var n="drop"+(e?"":"Right");k.prototype[t]=function(){return this.__filtered__?new k(this):this[n](1)}}),k.prototype.compact=function(){return this.filter(si)},k.prototype.find=function(t){return this.filter(t).head()},k.prototype.findLast=function(t){return this.reverse().find(t)},k.prototype.invokeMap=$n(function(t,e){return"function"==typeof t?new k(this):this.map(function(n){return hn(n,t,e)})}),k.prototype.reject=function(t){return this.filter(Pr(Bo(t)))},k.prototype.slice=function(t,e){t=qr(t);var n=this;return n.__filtered__&&(t>0||e<0)?new k(n):(t<0?n=n.takeRight(-t):t&&(n=n.drop(t)),e!==q&&(n=(e=qr(e))<0?n.dropRight(-e):n.take(e-t)),n)},k.prototype.takeRightWhile=function(t){return this.reverse().takeWhile(t).reverse()},k.prototype.toArray=function(){return this.take(rt)},qe(k.prototype,function(t,e){var o=/^(?:filter|find|map|reject)|While$/.test(e),i=/^(?:head|last)$/.test(e),a=n[i?"take"+("last"==e?"Right":""):e],s=i||/^find/.test(e);a&&(n.prototype[e]=function(){var e=this.__wrapped__,l=i?[1]:arguments,u=e instanceof k,c=l[0],d=u||Ps(e),f=function(t){var e=a.apply(n,p([t],l));return i&&v?e[0]:e};d&&o&&"function"==typeof c&&1!=c.length&&(u=d=!1);var v=this.__chain__,h=!!this.__actions__.length,m=s&&!v,g=u&&!h;if(!s&&d){e=g?e:new k(this);var b=t.apply(e,l);return b.__actions__.push({func:jr,args:[f],thisArg:q}),new r(b,v)}return m&&g?t.apply(this,l):(b=this.thru(f),m?i?b.value()[0]:b.value():b)})}),s(["pop","push","shift","sort","splice","unshift"],function(t){var e=Ci[t],o=/^(?:push|sort|unshift)$/.test(t)?"tap":"thru",r=/^(?:pop|shift)$/.test(t);n.prototype[t]=function(){var t=arguments;if(r&&!this.__chain__){var n=this.value();return e.apply(Ps(n)?n:[],t)}return this[o](function(n){return e.apply(Ps(n)?n:[],t)})}}),qe(k.prototype,function(t,e){var o=n[e];if(o){var r=o.name+"";Ti.call(ga,r)||(ga[r]=[]),ga[r].push({name:e,func:o})}}),ga[jo(q,2).name]=[{name:"wrapper",func:q}],k.prototype.clone=function(){var t=new k(this.__wrapped__);return t.__actions__=co(this.__actions__),t.__dir__=this.__dir__,t.__filtered__=this.__filtered__,t.__iteratees__=co(this.__iteratees__),t.__takeCount__=this.__takeCount__,t.__views__=co(this.__views__),t},k.prototype.reverse=function(){if(this.__filtered__){var t=new k(this);t.__dir__=-1,t.__filtered__=!0}else(t=this.clone()).__dir__*=-1;return t},k.prototype.value=function(){var t=this.__wrapped__.value(),e=this.__dir__,n=Ps(t),o=e<0,r=n?t.length:0,i=function(t,e,n){for(var o=-1,r=n.length;++o<r;){var i=n[o],a=i.size;switch(i.type){case"drop":t+=a;break;case"dropRight":e-=a;break;case"take":e=ia(e,t+a);break;case"takeRight":t=ra(t,e-a)}}return{start:t,end:e}}(0,r,this.__views__),a=i.start,s=i.end,l=s-a,u=o?s:a-1,c=this.__iteratees__,d=c.length,f=0,v=ia(l,this.__takeCount__);if(!n||!o&&r==l&&v==l)return Gn(t,this.__actions__);var p=[];t:for(;l--&&f<v;){for(var h=-1,m=t[u+=e];++h<d;){var g=c[h],b=g.iteratee,y=g.type,_=b(m);if(2==y)m=_;else if(!_){if(1==y)continue t;break t}}p[f++]=m}return p},n.prototype.at=ls,n.prototype.chain=function(){return wr(this)},n.prototype.commit=function(){return new r(this.value(),this.__chain__)},n.prototype.next=function(){this.__values__===q&&(this.__values__=Yr(this.value()));var t=this.__index__>=this.__values__.length;return{done:t,value:t?q:this.__values__[this.__index__++]}},n.prototype.plant=function(t){for(var e,n=this;n instanceof o;){var r=dr(n);r.__index__=0,r.__values__=q,e?i.__wrapped__=r:e=r;var i=r;n=n.__wrapped__}return i.__wrapped__=t,e},n.prototype.reverse=function(){var t=this.__wrapped__;if(t instanceof k){var e=t;return this.__actions__.length&&(e=new k(this)),(e=e.reverse()).__actions__.push({func:jr,args:[br],thisArg:q}),new r(e,this.__chain__)}return this.thru(br)},n.prototype.toJSON=n.prototype.valueOf=n.prototype.value=function(){return Gn(this.__wrapped__,this.__actions__)},n.prototype.first=n.prototype.head,Hi&&(n.prototype[Hi]=function(){return this}),n}();Je._=dn,(r=function(){return dn}.call(e,n,e,o))===q||(o.exports=r)}).call(this)}).call(this,n("yLpj"),n("YuTi")(t))},OKyS:function(t,e,n){var o=n("LboF"),r=n("8MIm");"string"==typeof(r=r.__esModule?r.default:r)&&(r=[[t.i,r,""]]),o(r,{insert:"head",singleton:!1}),t.exports=r.locals||{}},UBF9:function(t,e,n){"use strict";var o=n("8eWk");n.n(o).a},YuTi:function(t,e){t.exports=function(t){return t.webpackPolyfill||(t.deprecate=function(){},t.paths=[],t.children||(t.children=[]),Object.defineProperty(t,"loaded",{enumerable:!0,get:function(){return t.l}}),Object.defineProperty(t,"id",{enumerable:!0,get:function(){return t.i}}),t.webpackPolyfill=1),t}},qFNJ:function(t,e,n){(t.exports=n("I1BE")(void 0)).push([t.i,'.video-wrapper .show-time .video-js .vjs-time-control:not(.vjs-remaining-time){padding-left:.5em;padding-right:0;min-width:0;display:block}.video-wrapper .video-js .vjs-remaining-time{display:none}.video-wrapper .live .vjs-progress-control{visibility:hidden}.video-wrapper .av-loading .vjs-big-play-button,.video-wrapper .live .vjs-remaining-time,.video-wrapper .live .vjs-time-control{display:none}.video-wrapper .hide-big-play-button .vjs-big-play-button,.video-wrapper .hide-controls .vjs-control-bar,.video-wrapper .hide-fluent-button .live-player-fluent-btn,.video-wrapper .hide-fullscreen-button .vjs-fullscreen-control,.video-wrapper .hide-live-text .video-js .vjs-live-control .vjs-live-display,.video-wrapper .hide-pic-in-pic .video-js .vjs-picture-in-picture-control,.video-wrapper .hide-snapshot-button .vjs-snapshot-control,.video-wrapper .hide-stretch-button .live-player-stretch-btn,.video-wrapper .hide-waiting .vjs-seeking .vjs-loading-spinner,.video-wrapper .hide-waiting .vjs-waiting .vjs-loading-spinner,.video-wrapper .zoom-drawing .video-slot,.video-wrapper .zoom-drawing .video-title,.video-wrapper .zoom-drawing .vjs-control-bar{display:none!important}.video-wrapper .alt{position:absolute;left:0;top:0;right:0;bottom:0;vertical-align:middle;background-repeat:no-repeat;background-position:50% 50%;background-size:contain;background-color:#000;color:#fff;text-align:center}.video-wrapper .alt>table{width:100%;height:100%}.video-wrapper .alt>table tr>td{vertical-align:middle}.video-wrapper .video-js .video-water{pointer-events:none;left:0;top:0;right:0;bottom:0;position:absolute}.video-wrapper .video-js .video-slot{font-size:14px}.video-wrapper .video-js .video-title{font-size:14px;position:absolute;top:5px;right:5px;color:#fff;background-color:hsla(0,0%,50%,.5);border-radius:2px;padding:5px;max-width:120px;overflow:hidden;white-space:nowrap;text-overflow:ellipsis}.video-wrapper .video-js .vjs-control-bar .vjs-button{cursor:pointer;outline:none}.video-wrapper .video-js .vjs-control-bar .vjs-icon-cog:before,.video-wrapper .video-js .vjs-control-bar .vjs-icon-spinner:before{font-size:1.4em;line-height:1.87}.video-wrapper .video-js .vjs-control-bar .vjs-playback-rate .vjs-menu :focus:not(:focus-visible){outline:none;background:#fff}.video-wrapper .vjs-error-display{display:none}.video-wrapper .video-js .vjs-time-control{font-size:1.035em;line-height:2.93em}.video-wrapper .vjs-resolution-button{color:#ccc;font-family:VideoJS}.video-wrapper .vjs-resolution-button .vjs-resolution-button-staticlabel:before{content:"\\F110";font-size:1.8em;line-height:1.7em}.video-wrapper .vjs-resolution-button .vjs-resolution-button-label{width:100%;height:.6em;display:block!important}.video-wrapper .vjs-resolution-button .vjs-resolution-button-label-ie{width:100%;height:.4em;display:block!important}.video-wrapper .vjs-resolution-button ul.vjs-menu-content{width:4em!important}.video-wrapper .vjs-resolution-button .vjs-menu{left:0}.video-wrapper .vjs-resolution-button .vjs-menu li{text-transform:none;font-size:1em;line-height:1.5em;font-family:Arial,Helvetica,sans-serif}.video-wrapper .vjs-audio-button{display:none!important}.video-wrapper .video-js .vjs-big-play-button .vjs-icon-placeholder:before,.video-wrapper .vjs-button>.vjs-icon-placeholder:before{position:relative!important}.video-wrapper .video-js .vjs-play-progress:before{top:-.33em!important}.video-wrapper .video-js .vjs-control:focus,.video-wrapper .video-js .vjs-control:focus:before,.video-wrapper .video-js .vjs-control:hover:before{text-shadow:none!important}@media screen and (-webkit-min-device-pixel-ratio:0){.video-wrapper .video-js .vjs-control-bar{font-size:14px}.video-wrapper .video-js .vjs-slider-vertical .vjs-volume-level:before{left:-.33em!important}}.video-wrapper.video-wrapper-stretch .alt,.video-wrapper.video-wrapper-stretch .video-js .vjs-poster{background-size:100% 100%}',""])},qtAY:function(t,e,n){"use strict";n.r(e),n("OKyS");var o=n("LvDl"),r=n.n(o),i=n("Iab2"),a=n.n(i);videojs.options.controlBar={volumePanel:{inline:!1}},videojs.options.flash.swf="liveplayer.swf",videojs.options.techOrder=["html5","flash"],videojs.log.level("off"),flvjs.LoggingControl.enableAll=!1;var s={name:"live-player",data:function(){return{player:null,liveCheckTimer:0,hlsTimer:0,fluentBtnCls:"live-player-fluent-btn",stretchBtnCls:"live-player-stretch-btn",snapFromOutside:!1,snapForPoster:!1,snapForReload:!1,showSlot:!1,fluent_:!0,muted_:!0,stretch_:!1,poster_:"",subVideoUrl_:"",avLoading:!1,reloadCnt:0,updateTimeAt:0,startAt:0,playAt:0,pauseAt:0,pauseBgAt:0,endedAt:0,errorAt:0,flvMediaDataSource:{},flvConfig:{},resolutionName:{sd:"标清",hd:"高清",fhd:"超清",yh:"原画"},playbackRate_:1,fullscreen:!1,visible:!0,visibleChangeAt:0,speeds:[],zoomDrawing:!1}},props:{videoUrl:{type:String,default:""},subVideoUrl:{type:String,default:""},videoTitle:{type:String,default:""},poster:{default:""},autoplay:{type:Boolean,default:!0},live:{type:Boolean,default:!1},smart:{type:Boolean,default:!1},raw:{type:Boolean,default:!0},loading:{type:Boolean,default:!1},currentTime:{type:Number,default:0},timeout:{type:Number,default:20},alt:{type:String,default:""},muted:{type:Boolean,default:!0},aspect:{type:String,default:"16:9"},videoBufferSize:{type:Number,default:204800},audioBufferSize:{type:Number,default:131072},hlsErrorSeek:{type:Number,default:5},hlsTestMethod:{type:String,default:"HEAD"},hlsTestInterval:{type:Number,default:1e3},reloadInterval:{type:Number,default:1e3},liveInterval:{type:Number,default:15e3},maxPauseSeconds:{type:Number,default:0},pauseWhenHidden:{type:Boolean,default:!0},fluent:{type:Boolean,default:!0},stretch:{type:Boolean,default:!1},loop:{type:Boolean,default:!1},controls:{type:Boolean,default:!0},hideBigPlayButton:{type:Boolean,default:!1},hideSnapshotButton:{type:Boolean,default:!1},hideFullscreenButton:{type:Boolean,default:!1},hideFluentButton:{type:Boolean,default:!1},hideStretchButton:{type:Boolean,default:!1},hidePicInPic:{type:Boolean,default:!0},hideWaiting:{type:Boolean,default:!0},hideLiveText:{type:Boolean,default:!1},showTime:{type:Boolean,default:function(){return!videojs.browser.IS_IOS&&!videojs.browser.IS_ANDROID}},showSpeed:{type:Boolean,default:!1},showCustomButton:{type:Boolean,default:!0},showCameraButton:{type:Boolean,default:!videojs.browser.IS_SAFARI&&!videojs.browser.IS_IOS&&!videojs.browser.IS_ANDROID},debug:{type:Boolean,default:!1},hasvideo:{type:Boolean,default:void 0},hasaudio:{type:Boolean,default:void 0},resolution:{type:String,default:""},resolutiondefault:{type:String,default:"hd"},playbackRates:{type:Array,default:function(){return videojs.browser.IS_IOS||videojs.browser.IS_ANDROID?[]:[.5,1,2,3]}},playbackRate:{type:Number,default:1},cors:{type:Boolean,default:!0},withCredentials:{type:Boolean,default:!1},customButtons:{type:String,default:""},autofocus:{type:Boolean,default:!1},dblclickFullscreen:{type:Boolean,default:!0},language:{type:String,default:navigator&&(navigator.languages&&navigator.languages[0]||navigator.userLanguage||navigator.language)||"en"},disableContextMenu:{type:Boolean,default:!1},water:{type:String,default:""},waterMark:{type:String,default:""},watermark:{type:String,default:""},wm:{type:String,default:""},waterFont:{type:String,default:""},waterColor:{type:String,default:"rgba(240, 240, 240, 0.25)"},waterWidth:{type:Number,default:256},waterHeight:{type:Number,default:144},waterRotate:{type:Number,default:-20*Math.PI/120},rotate:{type:Number,default:0},rotateX:{type:Number,default:0},rotateY:{type:Number,default:0},bgTimeout:{type:Number,default:0},digitalZoom:{type:Boolean,default:!1},digitalZoomColor:{type:String,default:"rgba(235, 235, 235, 0.8)"}},beforeDestroy:function(){this.destroyVideoJS()},deactivated:function(){this.destroyVideoJS()},watch:{videoUrl:function(t){this.videoUrl?this.poster_=this.poster:this.poster_="",this.smart&&!this.subVideoUrl&&this.videoUrl&&(0!=this.videoUrl.indexOf("webrtc")||/_sub(\?|$)/.test(this.videoUrl)?/.flv(\?|$)/.test(this.videoUrl)&&!/_sub.flv(\?|$)/.test(this.videoUrl)?this.subVideoUrl_=this.videoUrl.replace(/(.flv(\?|$))/,"_sub$1"):/\/live.m3u8(\?|$)/.test(this.videoUrl)&&!/_sub\/live.m3u8(\?|$)/.test(this.videoUrl)&&(this.subVideoUrl_=this.videoUrl.replace(/(\/live.m3u8(\?|$))/,"_sub$1")):this.subVideoUrl_=this.videoUrl.replace(/(\?|$)/,"_sub$1")),this.videoUrl||this.subVideoUrl||(this.subVideoUrl_=""),this.reload()},subVideoUrl:function(t){this.subVideoUrl_=this.subVideoUrl,this.smart&&!this.subVideoUrl_&&this.videoUrl&&(0!=this.videoUrl.indexOf("webrtc")||/_sub(\?|$)/.test(this.videoUrl)?/.flv(\?|$)/.test(this.videoUrl)&&!/_sub.flv(\?|$)/.test(this.videoUrl)?this.subVideoUrl_=this.videoUrl.replace(/(.flv(\?|$))/,"_sub$1"):/\/live.m3u8(\?|$)/.test(this.videoUrl)&&!/_sub\/live.m3u8(\?|$)/.test(this.videoUrl)&&(this.subVideoUrl_=this.videoUrl.replace(/(\/live.m3u8(\?|$))/,"_sub$1")):this.subVideoUrl_=this.videoUrl.replace(/(\?|$)/,"_sub$1")),this.videoUrl||this.subVideoUrl||(this.subVideoUrl_=""),this.reload()},fullscreen:function(t){this.videoUrl&&this.subVideoUrl_&&this.videoUrl!=this.subVideoUrl_&&(this.webrtc&&0==this.videoUrl.indexOf("webrtc")&&0==this.subVideoUrl_.indexOf("webrtc")?this.rtcReloadTech():(this.http||this.ws)&&this.flv&&/\.flv.*$/.test(this.videoUrl)&&/\.flv.*$/.test(this.subVideoUrl_)&&this.flvReloadTech())},fluent:function(t){this.fluent_=t,this.reload()},muted:function(t){this.muted_=t,this.reload()},stretch:function(t){this.stretch_=t,this.reload()},poster:function(t){this.poster_=t},loop:function(t){this.reload()},currentTime:function(t){this.player&&this.player.currentTime(t)},language:function(t){this.reload()}},mounted:function(){this.muted_=this.muted,this.fluent_=this.fluent,this.stretch_=this.stretch,this.poster_=this.poster,this.playbackRate_=this.playbackRate,this.subVideoUrl_=this.subVideoUrl,this.smart&&!this.subVideoUrl_&&this.videoUrl&&(0!=this.videoUrl.indexOf("webrtc")||/_sub(\?|$)/.test(this.videoUrl)?/.flv(\?|$)/.test(this.videoUrl)&&!/_sub.flv(\?|$)/.test(this.videoUrl)?this.subVideoUrl_=this.videoUrl.replace(/(.flv(\?|$))/,"_sub$1"):/\/live.m3u8(\?|$)/.test(this.videoUrl)&&!/_sub\/live.m3u8(\?|$)/.test(this.videoUrl)&&(this.subVideoUrl_=this.videoUrl.replace(/(\/live.m3u8(\?|$))/,"_sub$1")):this.subVideoUrl_=this.videoUrl.replace(/(\?|$)/,"_sub$1")),this.debug&&(videojs.log.level("info"),flvjs.LoggingControl.enableAll=!0),this.initVideoJS()},computed:{videoType:function(){var t="video/mp4";return this.rtmp?t="rtmp/mp4":(this.http||this.ws)&&this.flv?t="video/x-flv":this.m3u8&&(t="application/x-mpegURL"),t},videoWrapperStyle:function(){if("100%"==this.aspect||"fullscreen"==this.aspect)return{width:"100%",height:"100%"};var t=this.aspect.split(":"),e=parseInt(t[0])||16;return{paddingBottom:(parseInt(t[1])||9)/e*100+"%",position:"relative",margin:"0 auto",overflow:"hidden"}},waterStyle:function(){return this.waterText?{background:"url("+this.createBase64()+") left top repeat"}:{}},waterText:function(){return this.water||this.waterMark||this.watermark||this.wm},rtmp:function(){return 0===(this.src||"").indexOf("rtmp")},http:function(){return 0===(this.src||"").indexOf("http")||(this.src||"").indexOf("://")<0},webrtc:function(){return 0===(this.src||"").indexOf("webrtc")},ws:function(){return 0===(this.src||"").indexOf("ws")},mp4:function(){return/\.mp4.*$/.test(this.src||"")},m3u8:function(){return/\.m3u8.*$/.test(this.src||"")},flv:function(){return/\.flv.*$/.test(this.src||"")},alt2:function(){return this.alt?this.alt:"zh-CN"===this.language?"无信号":"No Video"},src:function(){var t=this.subVideoUrl_||this.videoUrl;if(this.fullscreen&&(t=this.videoUrl||this.subVideoUrl_),!t)return"";if(0===t.indexOf("//"))t=location.protocol+t;else if(0===t.indexOf("/"))t=location.protocol+"//"+location.host+t;else if(0===t.indexOf("./")){var e=location.pathname.lastIndexOf("/");t=location.protocol+"//"+location.host+location.pathname.slice(0,e+1)+t.slice(2)}if(this.raw&&flvjs.getFeatureList()&&flvjs.getFeatureList().nativeMP4H265Playback&&(/\.flv.*$/.test(t)||/\.m3u8.*$/.test(t))){var n=t.indexOf("?"),o="";n<0?o="?":t.indexOf("raw=")<n&&(o="&"),o&&(t=t+o+"raw=1")}return t},blankHtml:function(){return this.poster_&&this.src?'\n                    <div class="alt" style="background-image:url(\''+this.poster_+"');\">\n                    </div>\n                ":'\n                    <div class="alt">\n                        <table>\n                            <tr>\n                                <td>'+this.alt2+"</td>\n                            </tr>\n                        </table>\n                    </div>\n                "},videoHtml:function(){return this.src?this.rtmp||this.http||this.flv||this.mp4||this.webrtc?'\n                        <video class="video-js vjs-default-skin vjs-big-play-centered" style="width: 100%; height: 100%; '+(this.stretch_?"object-fit: fill;":"")+'"\n                            webkit-playsinline="" playsinline="" x-webkit-airplay="allow" x5-playsinline=""\n                            preload="none" poster="'+this.poster_+'" '+(this.muted_?"muted":"")+" "+(this.loop?"loop":"")+'>\n                            <source src="'+this.src+'" type="'+this.videoType+'"></source>\n                            <p class="vjs-no-js">\n                                To view this video please enable JavaScript, and consider upgrading to a web browser that\n                                <a href="http://videojs.com/html5-video-support/" target="_blank">\n                                    supports HTML5 video\n                                </a>\n                            </p>\n                        </video>\n                    ':'\n                        <canvas style="width: 100%; height: 100%;"></canvas>\n                    ':this.blankHtml}},methods:{isMobile:function(){return videojs.browser.IS_IOS||videojs.browser.IS_ANDROID},hasUsableSWF:function(){return!!(void 0!==window.ActiveXObject?new ActiveXObject("ShockwaveFlash.ShockwaveFlash"):navigator.plugins["Shockwave Flash"])},reload:function(){this.reloadCnt++,this.debug&&console.log((new Date).toLocaleString()+" "+this.src+" reload["+this.reloadCnt+"]"),this.destroyVideoJS(),this.initVideoJS()},flvError:function(t){var e=this;this.debug&&console.log((new Date).toLocaleString()+" "+this.src+" error",t),this.$emit("error",t),this.live&&this.src&&this.reloadInterval>0&&(this.reloadCnt?setTimeout(function(){e.flvReload()},this.reloadInterval):this.flvReload())},flvReload:function(){this.player&&(this.avLoading=!0,!this.endedAt&&!this.player.isFullscreen()&&this.player.cameraButton&&this.visible?this.snapForReload||(this.debug&&console.log((new Date).toLocaleString()+" "+this.src+" snap reload"),this.snapForReload=!0,this.pauseAt=0,this.player.cameraButton.el().click()):this.flvReloadTech())},flvReloadTech:function(){var t=this;if(this.debug&&console.log((new Date).toLocaleString()+" "+this.src+" tech reload"),this.reloadCnt++,this.pauseAt=0,this.player.tech_.flvPlayer.unload(),this.player.tech_.flvPlayer.detachMediaElement(),this.player.tech_.flvPlayer.destroy(),this.player.tech_.flvPlayer=window.flvjs.createPlayer(Object.assign({},this.flvMediaDataSource,{type:void 0===this.flvMediaDataSource.type?"flv":this.flvMediaDataSource.type,url:this.getVideoUrl()}),this.flvConfig),this.player.tech_.flvPlayer.attachMediaElement(this.player.tech_.el_),this.player.tech_.flvPlayer.on("error",function(e){t.flvError(e)}),this.player.tech_.flvPlayer.on("media_info",function(e){t.$emit("media_info",e)}),this.showSpeed){var e=null;this.player.tech_.flvPlayer.on("statistics_info",function(n){if(n&&n.speed)if(t.speeds.length<3){if(t.speeds.push(n.speed),!e){var o=t.$el.querySelectorAll(".video-js .vjs-control-bar .vjs-live-display");o.length>0&&(e=o[0])}}else if(e){t.speeds.shift(),t.speeds.push(n.speed);for(var r=0,i=0;i<t.speeds.length;i++)r+=t.speeds[i]||0;var a=r/t.speeds.length;a=8*a.toFixed(0),e.innerHTML=a+"Kbps"}})}this.player.tech_.flvPlayer.load()},rtcError:function(t){var e=this;this.live&&this.src&&this.reloadInterval>0&&setTimeout(function(){e.rtcReload()},this.reloadInterval)},rtcReload:function(){this.player&&(this.avLoading=!0,!this.endedAt&&!this.player.isFullscreen()&&this.player.cameraButton&&this.visible?this.snapForReload||(this.debug&&console.log((new Date).toLocaleString()+" "+this.src+" snap reload"),this.snapForReload=!0,this.pauseAt=0,this.player.cameraButton.el().click()):this.rtcReloadTech())},rtcReloadTech:function(){this.debug&&console.log((new Date).toLocaleString()+" "+this.src+" tech reload"),this.reloadCnt++,this.pauseAt=0,this.player.tech_.src=this.getVideoUrl(),this.player.tech_.reload()},loadResolutionlist:function(t){var e=this;if(""!=this.resolution){for(var n,o=this.resolution.split(","),r=[],i=0;i<o.length;i++)o[i],this.resolutiondefault,n="yh"==o[i]?this.videoUrl:this.videoUrl.replace(".m3u8","_"+o[i]+".m3u8"),r.push({src:n,type:this.videoType,label:this.resolutionName[o[i]],res:o[i]});t.updateSrc(r),t.on("resolutionchange",function(){e.debug&&console.log("change resolution")})}},getCustomBtn:function(t){var e=this.$el.querySelectorAll(".video-js .vjs-control-bar .vjs-button."+t);return e.length>0?e[0]:null},customFlashButton:function(){var t=this;if(!this.getCustomBtn(this.fluentBtnCls)){for(var e=(r=this.player.controlBar.children().length)-1;e>=0;e--)if("FullscreenToggle"==this.player.controlBar.children()[e].name_)r=e;else if("SnapButton"==this.player.controlBar.children()[e].name_){r=e;break}var n=this.player.controlBar.addChild("button",{},r);n.name_="FluentButton",n.el_.classList.add(this.fluentBtnCls),n.el_.innerText=this.fluent_?this.player.localize("Fluent"):this.player.localize("Fast"),n.el_.title=this.fluent_?this.player.localize("Click switch to fast play"):this.player.localize("Click switch to fluent play");var o=function(){t.fluent_=!t.fluent_,n.el_.innerText=t.fluent_?t.player.localize("Fluent"):t.player.localize("Fast"),n.el_.title=t.fluent_?t.player.localize("Click switch to fast play"):t.player.localize("Click switch to fluent play"),t.player.tech_.setFluent(t.fluent_)};n.on("click",o),n.on("tap",o)}if(!this.getCustomBtn(this.stretchBtnCls)){var r;for(e=(r=this.player.controlBar.children().length)-1;e>=0;e--)if("FullscreenToggle"==this.player.controlBar.children()[e].name_)r=e;else if("SnapButton"==this.player.controlBar.children()[e].name_)r=e;else if("FluentButton"==this.player.controlBar.children()[e].name_){r=e;break}var i=this.player.controlBar.addChild("button",{},r);i.name_="StretchButton",i.el_.classList.add(this.stretchBtnCls),i.el_.innerText=this.stretch_?this.player.localize("STR"):this.player.localize("STD"),i.el_.title=this.stretch_?this.player.localize("Click switch to standard display"):this.player.localize("Click switch to stretch display"),o=function(){t.stretch_=!t.stretch_,i.el_.innerText=t.stretch_?t.player.localize("STR"):t.player.localize("STD"),i.el_.title=t.stretch_?t.player.localize("Click switch to standard display"):t.player.localize("Click switch to stretch display"),t.player.tech_.setStretch(t.stretch_)},i.on("click",o),i.on("tap",o)}},customH5Button:function(){var t=this;if(!this.getCustomBtn(this.stretchBtnCls)&&!videojs.browser.IE_VERSION){for(var e=this.player.controlBar.children().length,n=e-1;n>=0;n--)if("FullscreenToggle"==this.player.controlBar.children()[n].name_)e=n;else if("SnapButton"==this.player.controlBar.children()[n].name_){e=n;break}var o=this.player.controlBar.addChild("button",{},e);o.name_="StretchButton",o.el_.classList.add(this.stretchBtnCls),o.el_.innerText=this.stretch_?this.player.localize("STR"):this.player.localize("STD"),o.el_.title=this.stretch_?this.player.localize("Click switch to standard display"):this.player.localize("Click switch to stretch display");var r=function(){t.stretch_=!t.stretch_,o.el_.innerText=t.stretch_?t.player.localize("STR"):t.player.localize("STD"),o.el_.title=t.stretch_?t.player.localize("Click switch to standard display"):t.player.localize("Click switch to stretch display");var e=t.$el.querySelector("video");e?e.style.objectFit=t.stretch_?"fill":"":t.player.tech_.setStretch(t.stretch_)};o.on("click",r),o.on("tap",r)}this.addCustomButtons()},addCustomButtons:function(){var t=this;if(""!=this.customButtons){for(var e=this.player.controlBar.children().length,n=e-1;n>=0;n--)if("FullscreenToggle"==this.player.controlBar.children()[n].name_)e=n;else if("SnapButton"==this.player.controlBar.children()[n].name_)e=n;else if("StretchButton"==this.player.controlBar.children()[n].name_){e=n;break}var o=this.customButtons.split(",");for(n=o.length-1;n>=0;n--){var r=o[n].split(":"),i=this.player.controlBar.addChild("button",{},e);i.el_.innerHTML=r.length>1?"<i class='"+r[1]+"'></i>":o[n],i.el_.title=r[0],i.name_=r[0];var a=function(e){t.$emit("customButtons",(e.currentTarget||e.target).getAttribute("title"))};i.on("click",a),i.on("tap",a)}}},hotkeysOpt:function(){var t={volumeStep:.1,seekStep:5,enableModifiersForNumbers:!1,enableVolumeScroll:!1};return this.autofocus||(t.skipInitialFocus=!0,t.enableInactiveFocus=!1),t},destroyVideoJS:function(){this.liveCheckTimer&&(clearInterval(this.liveCheckTimer),this.liveCheckTimer=0),this.hlsTimer&&(clearTimeout(this.hlsTimer),this.hlsTimer=0),document.removeEventListener("visibilitychange",this.onVisibleChange),this.updateTimeAt=0,this.startAt=0,this.playAt=0,this.pauseAt=0,this.pauseBgAt=0,this.endedAt=0,this.errorAt=0,this.visibleChangeAt=0,this.showSlot=!1;var t=this.$el.querySelector(".video-js"),e=this.$el.querySelector(".video-wrapper");if(t&&e){var n=t.querySelector(".video-water");n&&(t.removeChild(n),e.appendChild(n));var o=t.querySelector(".video-slot");o&&(t.removeChild(o),e.appendChild(o));var r=t.querySelector(".video-title");r&&(t.removeChild(r),e.appendChild(r))}this.player&&this.player.dispose&&(this.player.dispose(),this.player=null),this.player&&this.player.destroy&&(this.player.destroy(),this.player=null),this.flvMediaDataSource={},this.flvConfig={},this.$el.querySelector(".video-inner").innerHTML=this.blankHtml,this.$emit("update:loading",!1)},getCurrentTime:function(){return this.player&&"function"==typeof this.player.currentTime?this.player.currentTime():-1},setCurrentTime:function(t){this.player&&"function"==typeof this.player.currentTime&&this.player.currentTime(t)},snap:function(){this.player&&this.player.cameraButton?(this.snapFromOutside=!0,this.player.cameraButton.el().click()):console.log("snap not support")},play:function(){this.player&&this.player.play()},pause:function(){this.player&&this.player.pause()},paused:function(){return!!this.player&&this.player.paused()},setMuted:function(t){this.player&&this.player.muted(!!t)},getMuted:function(){return!!this.player&&this.player.muted()},setVolume:function(t){this.player&&this.player.volume(t)},getVolume:function(){return this.player?this.player.volume():0},setDuration:function(t){this.player&&this.player.duration(t)},getDuration:function(){return this.player?this.player.duration():0},getPlayer:function(){return this.player},getMediaInfo:function(){try{return this.player.tech_.flvPlayer._transmuxer._controller._demuxer._mediaInfo}catch(t){}return{}},isFullscreen:function(){return!!this.player&&this.player.isFullscreen()},getVideoUrl:function(){var t=this.subVideoUrl_||this.videoUrl;if(this.isFullscreen()&&(t=this.videoUrl||this.subVideoUrl_),!t)return"";if(0===t.indexOf("//"))t=location.protocol+t;else if(0===t.indexOf("/"))t=location.protocol+"//"+location.host+t;else if(0===t.indexOf("./")){var e=location.pathname.lastIndexOf("/");t=location.protocol+"//"+location.host+location.pathname.slice(0,e+1)+t.slice(2)}if(this.raw&&flvjs.getFeatureList()&&flvjs.getFeatureList().nativeMP4H265Playback&&(/\.flv.*$/.test(t)||/\.m3u8.*$/.test(t))&&this.playAt){var n=t.indexOf("?"),o="";n<0?o="?":t.indexOf("raw=")<n&&(o="&"),o&&(t=t+o+"raw=1")}return t},requestFullscreen:function(){this.player&&this.player.requestFullscreen()},exitFullscreen:function(){this.player&&this.player.exitFullscreen()},toggleFullscreen:function(){this.player&&(this.player.isFullscreen()?this.player.exitFullscreen():this.player.requestFullscreen())},convertDataURIToBinary:function(t){for(var e=";base64,",n=t.indexOf(e)+e.length,o=t.substring(n),r=window.atob(o),i=r.length,a=new Uint8Array(i),s=0;s<i;s++)a[s]=r.charCodeAt(s);return a},convertDataURIToMIME:function(){var t=arguments.length>0&&void 0!==arguments[0]?arguments[0]:"",e="data:",n=t.indexOf(e);0==n&&(n+=e.length);var o=t.indexOf(";base64,");return n>=0&&o>n?t.substring(n,o):""},saveFile:function(t){if(t&&t.length>100){this.poster_=t;var e=this.$el.querySelector("video");e&&e.setAttribute("poster",t)}if(this.snapForReload)return this.snapForReload=!1,void this.reload();if(this.snapForPoster)this.snapForPoster=!1;else{if(this.snapFromOutside)return this.$emit("snapOutside",t),void(this.snapFromOutside=!1);if(this.$emit("snapInside",t),t&&t.length>100){var n=this.convertDataURIToBinary(t),o=this.convertDataURIToMIME(t),r=".jpg",i=o.indexOf("/");i>0&&(r="."+o.substring(i+1)),a.a.saveAs(new Blob([n],{type:o}),(this.videoTitle||"snap")+"_"+(new Date).getTime()+r)}}},onVisibleChange:function(){this.visible="visible"===document.visibilityState,this.debug&&console.log((new Date).toLocaleString()+" "+this.src+" visible["+this.visible+"]");var t=this.visibleChangeAt;if(this.visibleChangeAt=(new Date).getTime(),this.visible&&this.live&&this.videoUrl){var e;if(this.bgTimeout>0&&t&&(e=(new Date).getTime()-t)>=this.bgTimeout&&this.src&&(!this.player||!this.player.isFullscreen()))return this.debug&&console.log((new Date).toLocaleString()+" "+this.src+" visible bg timeout reload d = "+e),void this.reload();if(this.liveInterval>0&&this.pauseBgAt&&(e=(new Date).getTime()-this.pauseBgAt)>=this.liveInterval&&this.src&&(!this.player||!this.player.isFullscreen()))return this.debug&&console.log((new Date).toLocaleString()+" "+this.src+" visible bg pause reload d = "+e),void this.reload()}},debounceInitVideoJS:r.a.debounce(function(){this.initVideoJS()},500),initVideoJSOK:function(){var t=this;this.player&&(document.addEventListener("visibilitychange",this.onVisibleChange),this.player.muted(this.muted_),this.player.on("canplay",function(){t.$emit("canplay",t.player.duration())}),this.player.on("pause",function(){t.updateTimeAt=0,t.pauseAt=(new Date).getTime(),t.visible||(t.pauseBgAt=t.pauseAt),t.$emit("pause",t.player.currentTime()),t.debug&&console.log((new Date).toLocaleString()+" "+t.src+" pause")}),this.player.on("snap",function(e,n){t.saveFile(n)}),this.player.on("volumechange",function(e,n){t.muted_=t.player.muted(),t.$emit("volumechange",t.player.volume(),t.muted_)}),this.player.on("fullscreenchange",function(){setTimeout(function(){t.fullscreen=t.isFullscreen(),t.$emit("fullscreen",t.fullscreen)},0)}),(this.waterText||this.disableContextMenu)&&(this.player.tech_.el_.oncontextmenu=function(t){t.preventDefault()}),this.rotate&&(this.player.tech_.el_.style.transform="rotate("+this.rotate+"deg)"),this.rotateX&&(this.player.tech_.el_.style.transform="rotateX("+this.rotateX+"deg)"),this.rotateY&&(this.player.tech_.el_.style.transform="rotateY("+this.rotateY+"deg)"),this.startAt=(new Date).getTime(),this.initDigitalZoom());var e=this.$el.querySelector(".video-js"),n=this.$el.querySelector(".video-wrapper");if(e&&n){var o=n.querySelector(".video-water");o&&(n.removeChild(o),e.appendChild(o));var r=n.querySelector(".video-slot");r&&(n.removeChild(r),e.appendChild(r));var i=n.querySelector(".video-title");i&&(n.removeChild(i),e.appendChild(i))}this.showSlot=!0},initVideoJS:function(){var t=this,e=arguments.length>0&&void 0!==arguments[0]?arguments[0]:0;if(this.$el.querySelector(".video-inner").innerHTML=this.videoHtml,this.src){if(this.startAt=(new Date).getTime(),this.rtmp){var n=document.title;this.player=videojs(this.$el.querySelector("video"),{notSupportedMessage:"您的浏览器没有安装或开启Flash",tech:["flash"],autoplay:this.autoplay,stretch:this.stretch,fluent:this.fluent,controls:!0,userActions:{doubleClick:this.dblclickFullscreen},language:this.language||navigator&&(navigator.languages&&navigator.languages[0]||navigator.userLanguage||navigator.language)||"en"}),this.showCameraButton&&!this.waterText&&this.player.snapshot(),this.player.one("ready",function(){if(t.initVideoJSOK(),videojs.browser.IE_VERSION&&t.player.tech_.off("fullscreentoggle"),t.$emit("update:loading",!1),document.title=n,t.player.hotkeys(t.hotkeysOpt()),t.player.on("ended",function(){t.endedAt=(new Date).getTime(),t.$emit("ended"),t.debug&&console.log((new Date).toLocaleString()+" "+t.src+" ended")}),t.player.on("error",function(e){t.errorAt=(new Date).getTime(),t.$emit("error",e)}),t.player.on("timeupdate",function(){t.updateTimeAt=(new Date).getTime(),t.pauseAt=0,t.endedAt=0,t.errorAt=0,t.reloadCnt=0,t.$emit("timeupdate",t.player.currentTime())}),t.player.on("playing",function(){t.playAt=(new Date).getTime(),t.$emit("play",t.player.currentTime()),t.debug&&console.log((new Date).toLocaleString()+" "+t.src+" playing")}),t.showCustomButton&&t.customFlashButton(),!t.hasUsableSWF()){var e=t.$el.querySelector(".vjs-poster");e&&(e.style.display="none")}document.getElementsByClassName("vjs-picture-in-picture-control")[0].style.display="none"}),document.title=n,this.player.on("error",function(e){(e=t.$el.querySelector(".vjs-error .vjs-error-display .vjs-modal-dialog-content")).innerHTML="<a href='http://www.adobe.com/go/getflashplayer' target='_blank'>"+e.textContent+"</a>"})}else if((this.http||this.ws)&&this.flv)this.flvMediaDataSource={isLive:this.live,cors:this.cors,withCredentials:this.withCredentials},this.flvConfig={enableWorker:!1,enableStashBuffer:!1},videojs.browser.IE_VERSION&&(this.flvConfig.enableStashBuffer=!0,this.flvConfig.stashInitialSize=512e3),null!=this.hasaudio&&(this.flvMediaDataSource.hasAudio=this.hasaudio),null!=this.hasvideo&&(this.flvMediaDataSource.hasVideo=this.hasvideo),this.player=videojs(this.$el.querySelector("video"),{techOrder:["flvjs","html5"],controls:!0,flvjs:{mediaDataSource:this.flvMediaDataSource,config:this.flvConfig},userActions:{doubleClick:this.dblclickFullscreen},language:this.language||navigator&&(navigator.languages&&navigator.languages[0]||navigator.userLanguage||navigator.language)||"en"}),this.showCameraButton&&!this.waterText&&this.player.snapshot(),this.player.one("ready",function(){if(t.initVideoJSOK(),t.$emit("update:loading",!1),t.stretch_&&(t.player.tech_.el_.style.objectFit="fill"),t.player.hotkeys(t.hotkeysOpt()),t.player.tech_.flvPlayer.on("error",function(e){t.errorAt=(new Date).getTime(),t.flvError(e)}),t.player.tech_.flvPlayer.on("media_info",function(e){t.$emit("media_info",e)}),t.showSpeed){var e=null;t.player.tech_.flvPlayer.on("statistics_info",function(n){if(n&&n.speed)if(t.speeds.length<3){if(t.speeds.push(n.speed),!e){var o=t.$el.querySelectorAll(".video-js .vjs-control-bar .vjs-live-display");o.length>0&&(e=o[0])}}else if(e){t.speeds.shift(),t.speeds.push(n.speed);for(var r=0,i=0;i<t.speeds.length;i++)r+=t.speeds[i]||0;var a=r/t.speeds.length;a=8*a.toFixed(0),e.innerHTML=a+"Kbps"}})}t.player.on("canplay",function(){t.autoplay&&t.player.tech_.flvPlayer.play().catch(function(){})}),t.player.on("timeupdate",function(){t.updateTimeAt=(new Date).getTime(),t.pauseAt=0,t.endedAt=0,t.errorAt=0;var e=t.player.currentTime();!t.snapForPoster&&!t.poster_&&e>2&&t.player.cameraButton&&(t.snapForPoster=!0,t.player.cameraButton.el().click()),t.reloadCnt=0,t.$emit("timeupdate",e)}),t.player.on("error",function(e){t.errorAt=(new Date).getTime(),t.player.error_&&4===t.player.error_.code?t.flvError("MediaError"):t.flvError(t.player.error_||e)}),t.player.on("ended",function(){t.endedAt=(new Date).getTime(),t.live&&t.flvError("ended"),t.$emit("ended"),t.debug&&console.log((new Date).toLocaleString()+" "+t.src+" ended")}),t.player.on("playing",function(){t.playAt=(new Date).getTime(),t.avLoading=!1,t.$emit("play",t.player.currentTime()),t.debug&&console.log((new Date).toLocaleString()+" "+t.src+" playing")}),t.showCustomButton&&t.customH5Button()});else if(this.http&&this.m3u8){var o=(new Date).getTime(),r=function(){if(!t.player){var n=document.title;""!=t.resolution?(-1==t.resolution.indexOf(t.resolutiondefault)&&(t.resolutiondefault="yh"),t.player=videojs(t.$el.querySelector("video"),{autoplay:t.autoplay,stretch:t.stretch,controls:!0,plugins:{videoJsResolutionSwitcher:{default:t.resolutiondefault,dynamicLabel:!0}},playbackRates:t.live?[]:t.playbackRates,userActions:{doubleClick:t.dblclickFullscreen},language:t.language||navigator&&(navigator.languages&&navigator.languages[0]||navigator.userLanguage||navigator.language)||"en"}),t.loadResolutionlist(t.player)):t.player=videojs(t.$el.querySelector("video"),{autoplay:t.autoplay,stretch:t.stretch,controls:!0,playbackRates:t.live?[]:t.playbackRates,userActions:{doubleClick:t.dblclickFullscreen},language:t.language||navigator&&(navigator.languages&&navigator.languages[0]||navigator.userLanguage||navigator.language)||"en"}),t.live||1==t.playbackRate_||t.player.playbackRate(t.playbackRate_),t.player.on("ratechange",function(){t.playbackRate_=t.player.playbackRate()}),t.showCameraButton&&!t.waterText&&t.player.snapshot(),t.player.one("ready",function(){if(t.initVideoJSOK(),t.avLoading=!1,t.$emit("update:loading",!1),document.title=n,t.player.hotkeys(t.hotkeysOpt()),t.player.on("ended",function(){t.endedAt=(new Date).getTime(),t.$emit("ended"),t.debug&&console.log((new Date).toLocaleString()+" "+t.src+" ended")}),t.player.on("timeupdate",function(){t.updateTimeAt=(new Date).getTime(),t.pauseAt=0,t.endedAt=0,t.errorAt=0,t.reloadCnt=0,t.$emit("timeupdate",t.player.currentTime())}),t.player.on("play",function(){t.player.removeClass("vjs-has-started"),t.debug&&console.log((new Date).toLocaleString()+" "+t.src+" play")}),t.player.on("playing",function(){t.player.addClass("vjs-has-started"),t.playAt=(new Date).getTime(),t.$emit("play",t.player.currentTime()),t.debug&&console.log((new Date).toLocaleString()+" "+t.src+" playing")}),t.player.tech().on("usage",function(e){t.debug&&console.log((new Date).toLocaleString()+" "+t.src+" hls usage",e),e&&"hls-live-resync"===e.name&&t.$emit("error",e.name)}),t.player.one("unknow-segment-type",function(e){t.debug&&(console.log((new Date).toLocaleString()+" "+t.src+" hls unknow-segment-type, reload"),e&&e.name&&console.log("unknow-segment-type: "+e.name)),t.reloadInterval>0&&setTimeout(function(){t.destroyVideoJS(),t.initVideoJS()},t.reloadInterval/2)}),t.player.one("error",function(e,n){t.errorAt=(new Date).getTime();var o=t.player.tech();if(o&&"livehls"==o.name_){var r=o.error();r&&3===r.code&&(e="MediaError")}if(t.$emit("error",e),t.live)t.reloadInterval>0&&setTimeout(function(){t.destroyVideoJS(),t.initVideoJS()},t.reloadInterval);else if(t.hlsErrorSeek>0){var i=t.player.currentTime()+t.hlsErrorSeek;t.destroyVideoJS(),t.initVideoJS(i)}}),document.title=n,t.live)t.player&&t.player.reloadSourceOnError();else if(t.player&&(t.currentTime>0||e>0)){var o=e||t.currentTime;videojs.browser.IE_VERSION?t.player.on("loadeddata",function(){setTimeout(function(){t.player.currentTime(o)},500)}):t.isMobile()?setTimeout(function(){var e=t.$el.querySelector("video");e&&(e.currentTime=o)},3200):t.player.currentTime(o)}t.autoplay&&setTimeout(function(){var e=t.$el.querySelector(".vjs-big-play-button");if(e){var n=window.getComputedStyle(e);n&&"block"===n.display&&e.click()}},2e3),t.showCustomButton&&t.customH5Button()})}};this.live&&this.hlsTestMethod&&this.hlsTestInterval>0?function e(){var n=(new Date).getTime();if(t.timeout>0&&n-o>1e3*t.timeout)return t.hlsTimer&&clearTimeout(t.hlsTimer),t.hlsTimer=0,t.$emit("update:loading",!1),t.avLoading=!1,void t.$emit("message",{type:"error",message:"加载播放资源失败"});if(!t.src)return t.hlsTimer&&clearTimeout(t.hlsTimer),t.hlsTimer=0,t.$emit("update:loading",!1),void(t.avLoading=!1);t.$emit("update:loading",!0);var i=new XMLHttpRequest;i.open(t.hlsTestMethod,t.src,!0),i.onreadystatechange=function(){4==i.readyState&&(i.status>=200&&i.status<300||304===i.status?(t.hlsTimer&&clearTimeout(t.hlsTimer),t.hlsTimer=0,r()):(t.debug&&console.log((new Date).toLocaleString()+" "+t.src+" HLS "+t.hlsTestMethod,i.status,i.statusText),t.hlsTimer=setTimeout(e,t.hlsTestInterval)))},i.send()}():r()}else this.webrtc?(this.player=videojs(this.$el.querySelector("video"),{techOrder:["webrtc","html5"],autoplay:this.autoplay,stretch:this.stretch,controls:!0,webrtc:{debug:this.debug,autoplay:this.autoplay},userActions:{doubleClick:this.dblclickFullscreen},language:this.language||navigator&&(navigator.languages&&navigator.languages[0]||navigator.userLanguage||navigator.language)||"en"}),this.showCameraButton&&!this.waterText&&this.player.snapshot(),this.player.one("ready",function(){t.initVideoJSOK(),t.$emit("update:loading",!1),t.stretch_&&(t.player.tech_.el_.style.objectFit="fill"),t.player.hotkeys(t.hotkeysOpt()),t.player.on("error",function(e){t.errorAt=(new Date).getTime(),t.$emit("error",e),t.rtcError(e),t.debug&&console.log((new Date).toLocaleString()+" "+t.src+" player error",e)}),t.player.tech().on("error",function(e){t.errorAt=(new Date).getTime(),t.$emit("error",e),t.rtcError(e),t.debug&&console.log((new Date).toLocaleString()+" "+t.src+" rtc error",e)}),t.player.on("timeupdate",function(){t.updateTimeAt=(new Date).getTime(),t.pauseAt=0,t.endedAt=0,t.errorAt=0;var e=t.player.currentTime();!t.snapForPoster&&!t.poster_&&e>2&&t.player.cameraButton&&(t.snapForPoster=!0,t.player.cameraButton.el().click()),t.reloadCnt=0,t.$emit("timeupdate",e)}),t.player.on("ended",function(){t.endedAt=(new Date).getTime(),t.live&&t.rtcError("ended"),t.$emit("ended"),t.debug&&console.log((new Date).toLocaleString()+" "+t.src+" ended")}),t.player.on("playing",function(){t.playAt=(new Date).getTime(),t.avLoading=!1,t.$emit("play",t.player.currentTime()),t.debug&&console.log((new Date).toLocaleString()+" "+t.src+" playing")}),t.showCustomButton&&t.customH5Button()})):(this.player=videojs(this.$el.querySelector("video"),{autoplay:this.autoplay,stretch:this.stretch,controls:!0,playbackRates:this.live?[]:this.playbackRates,userActions:{doubleClick:this.dblclickFullscreen},language:this.language||navigator&&(navigator.languages&&navigator.languages[0]||navigator.userLanguage||navigator.language)||"en"}),this.live||1==this.playbackRate_||this.player.playbackRate(this.playbackRate_),this.player.on("ratechange",function(){t.playbackRate_=t.player.playbackRate()}),this.showCameraButton&&!this.waterText&&this.player.snapshot(),this.player.one("ready",function(){t.initVideoJSOK(),t.$emit("update:loading",!1),t.stretch_&&(t.player.tech_.el_.style.objectFit="fill"),t.player.hotkeys(t.hotkeysOpt()),t.showCustomButton&&t.customH5Button(),t.player.on("ended",function(){t.endedAt=(new Date).getTime(),t.$emit("ended"),t.debug&&console.log((new Date).toLocaleString()+" "+t.src+" ended")}),t.player.on("timeupdate",function(){t.updateTimeAt=(new Date).getTime(),t.pauseAt=0,t.endedAt=0,t.errorAt=0,t.reloadCnt=0,t.$emit("timeupdate",t.player.currentTime())}),t.player.on("playing",function(){t.playAt=(new Date).getTime(),t.avLoading=!1,t.$emit("play",t.player.currentTime()),t.debug&&console.log((new Date).toLocaleString()+" "+t.src+" playing")}),t.player.on("error",function(e){t.errorAt=(new Date).getTime(),t.$emit("error",e)})}));if(this.live&&this.liveInterval>0&&!(this.isMobile()&&this.http&&this.m3u8)){this.liveCheckTimer&&(clearInterval(this.liveCheckTimer),this.liveCheckTimer=0);var i=0;this.liveCheckTimer=setInterval(function(){if(!t.updateTimeAt){if(!t.playAt){var e=(new Date).getTime()-t.startAt;return t.debug&&console.log((new Date).toLocaleString()+" "+t.src+" live check never play d = "+e),void(!(e>=t.liveInterval&&t.src)||t.player&&t.player.isFullscreen()||(t.debug&&console.log((new Date).toLocaleString()+" "+t.src+" live check never play reload d = "+e),t.reload()))}return!t.pauseAt||t.errorAt||t.endedAt?(i++,t.debug&&console.log((new Date).toLocaleString()+" "+t.src+" live check update time error cnt = "+i),void(!(i>=3&&t.src)||t.player&&t.player.isFullscreen()||(t.debug&&console.log((new Date).toLocaleString()+" "+t.src+" live check update time error reload cnt = "+i),t.reload()))):(e=(new Date).getTime()-t.pauseAt,t.debug&&console.log((new Date).toLocaleString()+" "+t.src+" live check pause d = "+e),void(!(t.maxPauseSeconds>0&&e>=1e3*t.maxPauseSeconds&&t.src)||t.player&&t.player.isFullscreen()||(t.debug&&console.log((new Date).toLocaleString()+" "+t.src+" live check pause reload d = "+e),t.reload())))}i=0,e=(new Date).getTime()-t.updateTimeAt,t.debug&&console.log((new Date).toLocaleString()+" "+t.src+" live check d = "+e),!(e>=t.liveInterval&&t.src)||t.player&&t.player.isFullscreen()||(t.debug&&console.log((new Date).toLocaleString()+" "+t.src+" live check reload d = "+e),t.reload())},this.liveInterval)}}},createBase64:function(){var t=this.waterWidth,e=this.waterHeight,n=document.createElement("canvas"),o=n.getContext("2d");if(o){o.fillStyle=this.waterColor,o.textAlign="left",o.textBaseline="middle";var r=24;this.waterFont?o.font=this.waterFont:(o.font="24px Arial, Helvetica, sans-serif",o.measureText(this.waterText+"田").width>t&&(r=20,o.font="20px Arial, Helvetica, sans-serif",o.measureText(this.waterText+"田").width>t&&(r=16,o.font="16px Arial, Helvetica, sans-serif",o.measureText(this.waterText+"田").width>t&&(r=12,o.font="12px Arial, Helvetica, sans-serif")))),o.rotate(this.waterRotate);for(var i=this.waterText.split(/\\n/),a=0;a<i.length;a++)o.fillText(i[a],t/20,e+a*(r+4))}return n.toDataURL("image/png")},initDigitalZoom:function(){var t=this;if(this.digitalZoom){var e=this.debug,n=this.$el.querySelector("video");if(this.player&&n){var o=this.player.getAttribute("id");if(o){e&&console.log("digital zoom init ok id is "+o);var r=document.getElementById(o);if(r){var i=null,a=!1,s=!1,l=!1,u=void 0,c=void 0,d=void 0,f=void 0,v=null,p=!1,h=this.digitalZoomColor,m=null,g=o+"digital-zoom-rectangle",b=0,y=!1;r.addEventListener("mousedown",function(t){if(t.target==n&&0==(i=t.button)){var r=Date.now();if(r-b<500)return y=!0,a=!1,u=0,c=0,void(e&&console.log("digital zoom["+o+"] double click down ingnore"));y=!1,b=r,a=!0,u=t.offsetX,c=t.offsetY,e&&console.log("digital zoom["+o+"] mousedown",t)}}),r.addEventListener("mousemove",function(b){if(!p&&!y&&0==i){if(l){if(l=!1,a||!(1==b.which||b.key&&1===b.key.length&&1==b.key.charCodeAt()))return n.style.cursor="",void(p?j():w());a=!0}if(!a)return;d=b.offsetX,f=b.offsetY;var x=Math.abs(d-u),C=Math.abs(f-c),k=void 0,D=void 0;if(D=f<c?f:c,(k=d<u?d:u)<0||D<0)return;if(x<3||C<3)return;m||(m=b.target),(v=document.querySelector("."+g))||((v=document.createElement("div")).className=g,v.style.cursor="zoom-in",v.style.position="absolute",v.style.border="2px dashed "+h,r.appendChild(v)),s=!0,n.style.cursor="zoom-in",t.zoomDrawing=!0,b.target==m?(v.style.left=k+"px",v.style.top=D+"px",v.style.width=x+"px",v.style.height=C+"px"):b&&b.target&&b.target.className==g?(v.style.width=Math.abs(d)+"px",v.style.height=Math.abs(f)+"px"):_(b),e&&console.log("digital zoom["+o+"] mousemove->left=",k,",top=",D,",width=",x,",height=",C,",currentX=",d,",startX=",u,",currentY=",f,",startY=",c)}}),r.addEventListener("mouseup",function(t){a=!1,0!=i||y||(p?t.target==n&&j():w())});var _=function(t){l=!0,0==i&&(a=!1)};r.addEventListener("mouseleave",_);var w=function(i){var a,l,u,c;if(v){if(a=parseInt(v.style.left),l=parseInt(v.style.top),u=parseInt(v.style.width),c=parseInt(v.style.height),r.removeChild(v),v=null,t.zoomDrawing=!1,!(u<3||c<3)){s&&(n.style.cursor="zoom-out"),e&&console.log("digital zoom["+o+"] 放大开始->left=",a,",top=",l,",width=",u,",height=",c);var d=n,f=d.offsetWidth,h=d.offsetHeight,m=f/u*100,g=h/c*100,b=l*(h/c),y=a*(f/u);t.stretch_||(f/u>h/c?(g=f/u*100,b=l*(f/u)):(m=h/c*100,y=a*(h/c))),d.style.top="-"+b+"px",d.style.left="-"+y+"px",d.style.width=m+"%",d.style.height=g+"%",e&&console.log("digital zoom["+o+"] 放大结束->outWidth=",f,",width=",u,",widthX=",m,",outHeight=",h,",height=",c,",heightX=",g,",top=",l,",topX=",b,",left=",a,",leftX=",y),p=!0}}else t.zoomDrawing=!1},j=function(t){n.style.cursor="",p=!1,s=!1,u=0,c=0,e&&console.log("digital zoom["+o+"] 触发恢复");var r=n;r.style.top="0px",r.style.left="0px",r.style.width="100%",r.style.height="100%",i=null}}else e&&console.log("digital zoom init error, drawing area not found")}else e&&console.log("digital zoom init error, id not found")}else e&&console.log("digital zoom init error player not ready")}}}};n("UBF9");var l=function(t,e,n,o,r,i,a,s){var l,u="function"==typeof t?t.options:t;if(e&&(u.render=e,u.staticRenderFns=n,u._compiled=!0),r&&(l=r),l)if(u.functional){u._injectStyles=l;var c=u.render;u.render=function(t,e){return l.call(e),c(t,e)}}else{var d=u.beforeCreate;u.beforeCreate=d?[].concat(d,l):[l]}return{exports:t,options:u}}(s,function(){var t=this,e=t.$createElement,n=t._self._c||e;return n("div",{staticClass:"player-wrapper"},[n("div",{class:["video-wrapper",{"video-wrapper-stretch":this.stretch_}],style:t.videoWrapperStyle},[n("div",{staticClass:"video-inner",class:{live:t.live,"av-loading":t.avLoading,"hide-waiting":t.hideWaiting,"hide-controls":!t.controls,"zoom-drawing":t.zoomDrawing,"hide-big-play-button":t.hideBigPlayButton,"hide-snapshot-button":t.hideSnapshotButton,"hide-fullscreen-button":t.hideFullscreenButton,"hide-stretch-button":t.hideStretchButton,"hide-fluent-button":t.hideFluentButton,"hide-pic-in-pic":t.hidePicInPic,"hide-live-text":t.hideLiveText,"show-time":t.showTime&&!t.live},staticStyle:{position:"absolute",top:"0",bottom:"0",left:"0",right:"0"}}),t._v(" "),n("div",{directives:[{name:"show",rawName:"v-show",value:t.waterText&&t.showSlot,expression:"waterText && showSlot"}],staticClass:"video-water",style:t.waterStyle}),t._v(" "),n("div",{directives:[{name:"show",rawName:"v-show",value:t.showSlot,expression:"showSlot"}],staticClass:"video-slot"},[t._t("default")],2),t._v(" "),n("span",{directives:[{name:"show",rawName:"v-show",value:t.videoTitle&&t.showSlot,expression:"videoTitle && showSlot"}],staticClass:"video-title",attrs:{title:t.videoTitle}},[t._v(t._s(t.videoTitle))])])])},[],0,null).exports;l.install=function(t){"undefined"!=typeof window&&window.Vue&&(t=window.Vue),t.component(l.name,l)},e.default=l},yLpj:function(t,e){var n;n=function(){return this}();try{n=n||new Function("return this")()}catch(t){"object"==typeof window&&(n=window)}t.exports=n}})})},etei:function(t,e,n){"use strict";function o(t){n("ql7U"),n("ib2l")}Object.defineProperty(e,"__esModule",{value:!0});var r=n("irhb"),i=n.n(r);for(var a in r)["default","default"].indexOf(a)<0&&function(t){n.d(e,t,function(){return r[t]})}(a);var s=n("33FN"),l=n("VU/8"),u=o,c=l(i.a,s.a,!1,u,"data-v-1785224b",null);e.default=c.exports},gJt0:function(t,e,n){"use strict";var o=function(){var t=this,e=t.$createElement,n=t._self._c||e;return n("div",{},[n("div",{staticClass:"box box-primary"},[n("div",{staticClass:"box-header"},[n("h4",{staticClass:"text-primary text-center"},[t._v("设备录像列表("+t._s(t.devid)+"-"+t._s(t.channel)+")")])]),t._v(" "),n("div",{staticClass:"box-body"},[n("div",{staticClass:"form-inline",attrs:{autocomplete:"off",spellcheck:"false"}},[n("div",{staticClass:"form-group form-group-sm"},["no"!=t.$getQueryString("menu")?n("button",{staticClass:"btn btn-sm btn-primary",attrs:{type:"button"},on:{click:function(e){e.preventDefault(),t.$router.go(-1)}}},[n("i",{staticClass:"fa fa-chevron-left"}),t._v(" 返回\n                        ")]):t._e()]),t._v(" "),n("div",{staticClass:"form-group pull-right"},[n("div",{staticClass:"input-group input-group-sm"},[n("DatePicker",{ref:"datePicker",staticClass:"form-control",attrs:{day:t.day},on:{"update:day":t.updateDay}}),t._v(" "),n("div",{staticClass:"input-group-btn"},[n("button",{staticClass:"btn btn-sm btn-default",attrs:{type:"button"},on:{click:function(e){e.preventDefault(),t.showDatePicker(e)}}},[n("i",{staticClass:"fa fa-calendar"})]),t._v(" "),n("button",{staticClass:"btn btn-default btn-sm",on:{click:function(e){e.preventDefault(),t.toTimeView(e)}}},[n("i",{staticClass:"fa fa-hand-o-right"}),t._v(" 时间轴视图\n                                ")])])],1)])]),t._v(" "),n("br"),t._v(" "),n("div",{staticClass:"clearfix"}),t._v(" "),n("el-table",{directives:[{name:"loading",rawName:"v-loading",value:t.loading,expression:"loading"}],ref:"playbackTable",attrs:{stripe:"","element-loading-text":"加载中...",data:t.pageData,"default-sort":{prop:"StartTime",order:"ascending"}},on:{"sort-change":t.sortChange}},[n("el-table-column",{attrs:{prop:"DeviceID",label:"通道国标编号","min-width":"200","show-overflow-tooltip":""}}),t._v(" "),n("el-table-column",{attrs:{label:"操作","min-width":"190",fixed:!t.isMobile()&&"right"},scopedSlots:t._u([{key:"default",fn:function(e){return[n("div",{staticClass:"btn-group btn-group-xs"},[n("button",{staticClass:"btn btn-primary",attrs:{type:"button",disabled:e.row.Starting},on:{click:function(n){n.preventDefault(),t.startPlayback(e.row)}}},[n("i",{staticClass:"fa fa-play-circle"}),t._v(" 播放")]),t._v(" "),n("button",{staticClass:"btn btn-info",attrs:{type:"button",disabled:e.row.Starting},on:{click:function(n){n.preventDefault(),t.downloadPlayback(e.row)}}},[n("i",{staticClass:"fa fa-download"},[t._v(" 下载")])]),t._v(" "),n("a",{staticClass:"btn btn-warning",attrs:{href:"/play.html?type=playback&ptz=no&serial="+t.devid+"&code="+e.row.DeviceID+"&starttime="+e.row.StartTime+"&endtime="+e.row.EndTime,role:"button",target:"_blank"}},[n("i",{staticClass:"fa fa-share"}),t._v(" 分享页")])])]}}])}),t._v(" "),n("el-table-column",{attrs:{prop:"Name",label:"通道名称","min-width":"120",formatter:t.formatName,"show-overflow-tooltip":""}}),t._v(" "),n("el-table-column",{attrs:{prop:"StartTime",label:"开始时间","min-width":"160",formatter:t.formatName,sortable:""}}),t._v(" "),n("el-table-column",{attrs:{prop:"EndTime",label:"结束时间","min-width":"160",formatter:t.formatName,sortable:""}})],1)],1),t._v(" "),t.total>0?n("div",{staticClass:"box-footer clearfix"},[n("el-pagination",{staticClass:"pull-right",attrs:{layout:"total,prev,pager,next","pager-count":t.isMobile()?3:5,total:t.total,"page-size":t.pageSize,"current-page":t.currentPage},on:{"update:pageSize":function(e){t.pageSize=e},"update:currentPage":function(e){t.currentPage=e}}})],1):t._e()]),t._v(" "),n("PlaybackVideoDlg",{ref:"playbackVideoDlg",attrs:{serverInfo:t.serverInfo,userInfo:t.userInfo},on:{close:t.onClose}}),t._v(" "),n("PlaybackDownloadDlg",{ref:"playbackDownloadDlg",on:{download:t.onDownload}})],1)},r=[],i={render:o,staticRenderFns:r};e.a=i},hxIF:function(t,e,n){"use strict";var o=function(){var t=this,e=t.$createElement,n=t._self._c||e;return n("FormDlg",{ref:"dlg",attrs:{title:"导出通道列表",disabled:t.errors.any()},on:{hide:t.onHide,show:t.onShow,submit:t.onSubmit}},[t.devid?n("div",{class:{"form-group":!0}},[n("div",{staticClass:"col-sm-12 checkbox text-center"},[n("el-checkbox",{staticStyle:{"margin-left":"-19px","margin-top":"-5px"},model:{value:t.downloadThisDev,callback:function(e){t.downloadThisDev="string"==typeof e?e.trim():e},expression:"downloadThisDev"}},[t._v("\n                只导出当前设备("+t._s(t.devid)+")的通道\n            ")])],1)]):t._e(),t._v(" "),n("div",{directives:[{name:"show",rawName:"v-show",value:t.showPage,expression:"showPage"}],class:{"form-group":!0,"has-error":t.errors.has("start")}},[n("label",{staticClass:"col-sm-3 control-label",attrs:{for:"input-start"}},[t._v("开始\n            "),n("span",{staticClass:"text-red"},[t._v("*")])]),t._v(" "),n("div",{staticClass:"col-sm-7"},[n("input",{directives:[{name:"model",rawName:"v-model.trim",value:t.start,expression:"start",modifiers:{trim:!0}},{name:"validate",rawName:"v-validate",value:"numeric|min_value:0",expression:"'numeric|min_value:0'"}],staticClass:"form-control",attrs:{type:"text",id:"input-start",name:"start","data-vv-as":"开始"},domProps:{value:t.start},on:{input:function(e){e.target.composing||(t.start=e.target.value.trim())},blur:function(e){t.$forceUpdate()}}})])]),t._v(" "),n("div",{directives:[{name:"show",rawName:"v-show",value:t.showPage,expression:"showPage"}],class:{"form-group":!0,"has-error":t.errors.has("limit")}},[n("label",{staticClass:"col-sm-3 control-label",attrs:{for:"input-limit"}},[t._v("上限\n            "),n("span",{staticClass:"text-red"},[t._v("*")])]),t._v(" "),n("div",{staticClass:"col-sm-7"},[n("input",{directives:[{name:"model",rawName:"v-model.trim",value:t.limit,expression:"limit",modifiers:{trim:!0}},{name:"validate",rawName:"v-validate",value:"numeric|min_value:1",expression:"'numeric|min_value:1'"}],staticClass:"form-control",attrs:{type:"text",id:"input-limit",name:"limit","data-vv-as":"上限"},domProps:{value:t.limit},on:{input:function(e){e.target.composing||(t.limit=e.target.value.trim())},blur:function(e){t.$forceUpdate()}}})])])])},r=[],i={render:o,staticRenderFns:r};e.a=i},ib2l:function(t,e,n){var o=n("4Dib");"string"==typeof o&&(o=[[t.i,o,""]]),o.locals&&(t.exports=o.locals);n("rjj0")("402e076f",o,!0,{})},irhb:function(t,e,n){"use strict";(function(t){function o(t){return t&&t.__esModule?t:{default:t}}Object.defineProperty(e,"__esModule",{value:!0});var r=Object.assign||function(t){for(var e=1;e<arguments.length;e++){var n=arguments[e];for(var o in n)Object.prototype.hasOwnProperty.call(n,o)&&(t[o]=n[o])}return t};n("pFnw");var i=n("M4fF"),a=(o(i),n("NYxO")),s=n("eAMf"),l=o(s),u=n("JK3F"),c=n("/aob"),d=o(c),f=n("wZh5"),v=o(f);e.default={props:{},data:function(){var t=this;return{q:"",loading:!1,showTree:!0,showGroupTree:!0,playerIdx:0,playersLength:4,players:[],pnode:null,contextMenuTarget:null,contextMenuVisible:!1,contextMenuNodeData:null,treeProps:{label:function(e,n){n.serial=e.serial,n.code=e.code;var o=e.customName||e.name||e.id;return!t.treeLeaf(e)&&e.subCount>0&&(o+=" ["+e.onlineSubCount+"/"+e.subCount+"]"),o},isLeaf:function(e,n){return t.treeLeaf(e)},disabled:function(e,n){return t.treeLeaf(e)&&"ON"!=e.status}},customListDlgTitle:"选择通道",sticky:null}},components:{LivePlayer:l.default,VueContextMenu:u.component,DeviceTreeNodeEditDlg:d.default,ChannelCustomListDlg:v.default},computed:r({},(0,a.mapState)(["userInfo","serverInfo"]),{playing:function(){return!!(this.players[this.playerIdx]||{}).url},canPTZ:function(){var t=this.players[this.playerIdx]||{};return!!t.url&&(0===t.ptzType||1===t.ptzType)}}),mounted:function(){this.setPlayersLength(this.playersLength),this.contextMenuTarget=document.querySelector("#tab-tree-wrapper"),t(document).on("mouseup touchend",this.ctrlStop),t(document).on("expanded.pushMenu collapsed.pushMenu",this.updateSticky),t("#dev-tree-right").sticky({topSpacing:40,bottomSpacing:80})},beforeDestroy:function(){this.ctrlStop(),t(document).off("mouseup touchend",this.ctrlStop),t(document).off("expanded.pushMenu collapsed.pushMenu",this.updateSticky),this.clearVideos(),t("#dev-tree-right").unstick()},beforeRouteLeave:function(t,e,n){this.ctrlStop(),this.clearVideos(),n()},beforeRouteUpdate:function(t,e,n){this.ctrlStop(),this.clearVideos(),n()},watch:{q:function(t,e){this.$refs.devTree.filter(t),this.$refs.groupTree.filter(t)}},methods:{treeLoad:function(e,n){var o=this,r=e.serial||"",i=e.code||"";t.get("/api/v1/device/channeltree",{serial:r,pcode:i}).then(function(t){n((t||[]).map(function(t){return Object.assign(t,{key:t.id})})),o.$refs.devTree.filter(o.q)})},groupTreeLoad:function(e,n){var o=this,r=e.serial||"",i=e.code||"";t.get("/api/v1/device/grouptree",{serial:r,pcode:i}).then(function(t){n((t||[]).map(function(t){return Object.assign(t,{key:t.id})})),o.$refs.groupTree.filter(o.q)})},treeFilter:function(t,e){return!t||(-1!==e.name.indexOf(t)||-1!==e.customName.indexOf(t)||-1!==e.id.indexOf(t))},treeNodeRefresh:function(t){var e=this.$refs.devTree.getNode(t);e&&(e.loaded=!1,e.expand())},treeNodeRightClick:function(t,e,n,o){if(this.hasAnyRole(this.serverInfo,this.userInfo,"管理员")){this.contextMenuNodeData=e,this.pnode=n.parent;var r;"function"==typeof MouseEvent?r=new MouseEvent(t.type,t):(r=document.createEvent("MouseEvents"),r.initMouseEvent(t.type,!0,!0,t.view,t.detail,t.screenX,t.screenY,t.clientX,t.clientY,t.ctrlKey,t.altKey,t.shiftKey,t.metaKey,t.button,t)),this.contextMenuTarget.dispatchEvent(r)}},treeNodeClick:function(e,n,o){var r=this;if(this.contextMenuNodeData=null,this.treeLeaf(e)&&"ON"===e.status){var i=this.players[this.playerIdx]||{};if(i.bLoading)return;this.closeVideo(i),i.serial=e.serial,i.code=e.code,i.ptzType=e.ptzType,i.bLoading=!0,t.post("/api/v1/stream/start",{serial:e.serial,code:e.code}).then(function(t){t=t||{};var e=t.HLS,n="HLS";r.flvSupported()&&(t.WS_FLV?(e=t.WS_FLV,n="WS_FLV"):t.FLV&&(e=t.FLV,n="FLV")),i.protocol=n,i.poster="RTMP"==n?"":t.SnapURL,r.$nextTick(function(){i.url=e||""})}).fail(function(){r.$nextTick(function(){i.bLoading=!1})}),this.setPlayerIdx(this.playerIdx+1)}},showNodeEditDlg:function(){this.contextMenuVisible=!1;var t=Object.assign({},this.contextMenuNodeData,{parent:this.getParentData(),add:!1});this.$refs.nodeEditDlg.show(t)},showNodeAddDlg:function(){this.contextMenuVisible=!1;var t=Object.assign({serial:this.contextMenuNodeData.serial||"",code:"",name:"",customName:""},{parent:this.contextMenuNodeData,add:!0});this.$refs.nodeEditDlg.show(t)},showCustomListDlg:function(){this.contextMenuVisible=!1,this.customListDlgTitle="选择通道("+(this.contextMenuNodeData.customName||this.contextMenuNodeData.name||this.contextMenuNodeData.code)+")",this.$refs.customListDlg.show(this.contextMenuNodeData.code)},removeCustomNode:function(){var e=this;if(this.contextMenuVisible=!1,this.contextMenuNodeData){var n=this.contextMenuNodeData.customName||this.contextMenuNodeData.name||this.contextMenuNodeData.id;this.$confirm("确认删除 "+n,"提示",{lockScroll:!1}).then(function(){t.post("/api/v1/channel/remove",{serial:e.contextMenuNodeData.serial,code:e.contextMenuNodeData.code}).always(function(){e.treeNodeRefresh(e.pnode)})}).catch(function(){})}},treeRefresh:function(){var t=this;if(this.contextMenuVisible=!1,this.pnode)return void this.treeNodeRefresh(this.pnode);this.showTree=!1,this.$nextTick(function(){t.showTree=!0})},treeLeaf:function(t){if(t&&0==t.subCount&&t.serial&&t.code&&!t.custom){if(this.serverInfo.StrictChannelParental&&t.parental)return!1;if(this.serverInfo.StrictChannelCode&&20!=t.code.length)return!1;if((!t.parental||"LiveQing"!=t.manufacturer)&&(20!=t.code.length||"216"!=t.code.substring(10,13)&&"215"!=t.code.substring(10,13)))return!0}return!1},getParentData:function(){if(!this.contextMenuNodeData)return null;if(!this.$refs.devTree)return null;var t=this.$refs.devTree.getNode(this.contextMenuNodeData);return t&&t.parent?t.parent.data:null},resetCloseTimer:function(t){t&&(t.bCloseShow=!0,t.closeTimer&&clearTimeout(t.closeTimer),t.closeTimer=setTimeout(function(){t.bCloseShow=!1},2e3))},closeVideo:function(t){t&&(t.bLoading=!1,t.bCloseShow=!1,t.poster="",t.url="")},clearVideos:function(){for(var t in this.players)this.closeVideo(this.players[t])},setPlayersLength:function(t){if(t!=this.players.length){this.clearVideos(),this.players=[],this.playersLength=t;for(var e=0;e<t;e++)this.players.push({serial:"",code:"",url:"",protocol:"",poster:"",bLoading:!1,bCloseShow:!1})}},setPlayerIdx:function(t){this.ctrlStop(),this.playerIdx=t%this.players.length},clickPlayer:function(e,n,o){t(o.target).hasClass("video-close")||this.setPlayerIdx(n)},ptzControl:function(e){var n=this.players[this.playerIdx];if(n&&n.serial&&n.code){var o=t(e.currentTarget);t.post("/api/v1/control/ptz",{serial:n.serial,code:n.code,command:o.attr("command")}),o.addClass("active")}},ptzStop:function(){var e=this.players[this.playerIdx];e&&e.serial&&e.code&&t(this.$el).find(".ptz-cell.active").length>0&&(t.post("/api/v1/control/ptz",{serial:e.serial,code:e.code,command:"stop"}),t(this.$el).find(".ptz-cell.active").removeClass("active"))},talkStart:function(e){var n=this;if(!this.ws){var o=this.wsTalkURL();if(!o)return;var r=new WebSocket(o);r.onopen=function(t){console.log("ws talk open"),n.ws=r},r.onclose=function(t){console.log("ws talk close")},r.onerror=function(t){console.log("ws talk error",t)}}var i=t(e.currentTarget);if(this.recorder)return i.addClass("active"),void this.recorder.start();LiveRecorder.get(function(t,e){if(e)return void alert(e);i.addClass("active"),n.recorder=t,n.recorder.start()},{sampleBits:16,sampleRate:8e3,pcmCallback:function(t){var e=new window.FileReader;e.onloadend=function(){var t=e.result,t=t.split(",")[1];n.ws&&n.ws.send(t)},e.readAsDataURL(t)}})},talkStop:function(){this.recorder&&(this.recorder.stop(),t(this.$el).find(".fa-microphone.active").removeClass("active")),this.ws&&(this.ws.close(),this.ws=null)},ctrlStop:function(){this.talkStop(),this.ptzStop()},wsTalkURL:function(){var t=this.players[this.playerIdx];if(!t||!t.serial||!t.code)return"";var e="ws:";return location.protocol.startsWith("https")&&(e="wss:"),e+"//"+location.host+"/api/v1/control/ws-talk/"+t.serial+"/"+t.code+"?format=pcm"},resetTreeMaxHeight:function(){var e=t("#dev-tree, #group-tree");this.isMobile()?e.css("max-height",200):this.$nextTick(function(){var n=t("#dev-tree-player").height()-90,o=t(window).height()-450,r=o>n?o:n;e.css("max-height",r)})},updateSticky:function(){t("#dev-tree-right").sticky("update")}}}}).call(e,n("0iPh"))},jPLH:function(t,e,n){e=t.exports=n("FZ+f")(!1),e.push([t.i,".modal-title[data-v-3ea1a7b5]{overflow:hidden;white-space:nowrap;text-overflow:ellipsis}.modal-body[data-v-3ea1a7b5]{padding:10px}.ptz-area[data-v-3ea1a7b5]{padding-left:0}.ptz-block[data-v-3ea1a7b5]{width:150px;height:200px;margin:0 auto;text-align:center;position:relative;font-size:24px;color:#333}.ptz-block .ptz-cell[data-v-3ea1a7b5]{width:50px;height:50px;line-height:50px;position:absolute;cursor:pointer}.ptz-block .ptz-cell.active[data-v-3ea1a7b5]{color:#ccc}.ptz-block .fa-microphone-slash[data-v-3ea1a7b5],.ptz-block .fa-microphone[data-v-3ea1a7b5]{padding:10px 13px;border-radius:25px}.ptz-block .fa-microphone.active[data-v-3ea1a7b5]{color:#fff}.ptz-block .mic-level-bar[data-v-3ea1a7b5]{position:absolute;transform:rotate(-90deg);width:100%;top:70px;left:-80px}.ptz-block .ptz-center[data-v-3ea1a7b5]{top:50px;left:50px;border-radius:25px;background-color:#ccc}.ptz-block .ptz-up[data-v-3ea1a7b5]{top:0;left:50px}.ptz-block .ptz-left[data-v-3ea1a7b5]{top:50px;left:0}.ptz-block .ptz-right[data-v-3ea1a7b5]{top:50px;left:100px}.ptz-block .ptz-down[data-v-3ea1a7b5]{top:100px;left:50px}.ptz-block .ptz-zoomin[data-v-3ea1a7b5]{top:150px;left:20px}.ptz-block .ptz-zoomout[data-v-3ea1a7b5]{top:150px;left:80px}.stream-info[data-v-3ea1a7b5]{line-height:24px;margin:0;font-size:12px}.stream-info .col-sm-3[data-v-3ea1a7b5]{padding:1px 0}.stream-info .col-sm-9[data-v-3ea1a7b5]{padding:1px;max-height:120px;word-break:break-all}#protocol-switcher label[data-v-3ea1a7b5]{margin-bottom:0}@media screen and (min-width:768px){.modal-dialog.modal-lgg[data-v-3ea1a7b5]{width:90%}.tab-info[data-v-3ea1a7b5]{display:none}}@media screen and (min-width:1200px){.modal-dialog.modal-lgg[data-v-3ea1a7b5]{width:1200px}.tab-info[data-v-3ea1a7b5]{display:block}}",""])},jThn:function(t,e,n){e=t.exports=n("FZ+f")(!1),e.push([t.i,".modal-content[data-v-4494c1da]{overflow:hidden}@media screen and (min-width:992px){.modal-dialog.modal-lgg[data-v-4494c1da]{width:90%}}@media screen and (min-width:1200px){.modal-dialog.modal-lgg[data-v-4494c1da]{width:1200px}}",""])},jjQp:function(t,e,n){"use strict";function o(t){n("RE+l")}Object.defineProperty(e,"__esModule",{value:!0});var r=n("aonT"),i=n.n(r);for(var a in r)["default","default"].indexOf(a)<0&&function(t){n.d(e,t,function(){return r[t]})}(a);var s=n("ci/Y"),l=n("VU/8"),u=o,c=l(i.a,s.a,!1,u,"data-v-3ea1a7b5",null);e.default=c.exports},kayV:function(t,e,n){var o=n("tS9P");"string"==typeof o&&(o=[[t.i,o,""]]),o.locals&&(t.exports=o.locals);n("rjj0")("d95b18c0",o,!0,{})},kbZy:function(t,e,n){var o=n("aiu8");"string"==typeof o&&(o=[[t.i,o,""]]),o.locals&&(t.exports=o.locals);n("rjj0")("bf8ab964",o,!0,{})},lGU5:function(t,e,n){"use strict";var o=function(){var t=this,e=t.$createElement,n=t._self._c||e;return n("div",{staticClass:"modal fade upload-dlg",attrs:{"data-backdrop":"static","data-keyboard":"true",tabindex:"-1"}},[n("div",{staticClass:"modal-dialog"},[n("div",{staticClass:"modal-content"},[n("div",{staticClass:"modal-header"},[t._m(0),t._v(" "),n("h4",{staticClass:"modal-title text-center text-info"},[n("span",[t._v(t._s(t.title))])])]),t._v(" "),n("div",{staticClass:"modal-body"},[t._t("header"),t._v(" "),n("el-upload",{ref:"uploader",attrs:{drag:t.drag,action:t.url,multiple:t.multiple,"show-file-list":t.showFileList,limit:1,"on-success":t.onSuccess,"on-progress":t.onProgress,"on-error":t.onError,"on-remove":t.onRemove,accept:t.accept,"before-upload":t.beforeUpload,"auto-upload":t.autoUpload}},[n("i",{staticClass:"el-icon-upload"}),t._v(" "),n("div",{staticClass:"el-upload__text"},[t._v("将配置数据拖到此处，或 "),n("em",[t._v("点击上传")])]),t._v(" "),n("div",{staticClass:"el-upload__tip text-center",attrs:{slot:"tip"},slot:"tip"},[t._v(t._s(t.tip)),n("span",{staticStyle:{color:"red"}},[t._v(" *上传成功会自动删除原有数据，请注意导出备份*")])])]),t._v(" "),t._t("footer")],2),t._v(" "),n("div",{staticClass:"modal-footer"},[n("button",{staticClass:"btn btn-default",attrs:{type:"button","data-dismiss":"modal",disabled:t.bProgress}},[t._v("关闭")])])])])])},r=[function(){var t=this,e=t.$createElement,n=t._self._c||e;return n("button",{staticClass:"close",attrs:{type:"button","data-dismiss":"modal","aria-label":"Close"}},[n("span",{attrs:{"aria-hidden":"true"}},[t._v("×")])])}],i={render:o,staticRenderFns:r};e.a=i},lN7H:function(t,e,n){"use strict";(function(t){function o(t){return t&&t.__esModule?t:{default:t}}Object.defineProperty(e,"__esModule",{value:!0});var r=Object.assign||function(t){for(var e=1;e<arguments.length;e++){var n=arguments[e];for(var o in n)Object.prototype.hasOwnProperty.call(n,o)&&(t[o]=n[o])}return t},i=n("M4fF"),a=o(i),s=n("VMXz"),l=o(s),u=n("5gxX"),c=o(u),d=n("IaS+"),f=o(d),v=n("sJmG"),p=o(v),h=n("jjQp"),m=o(h),g=n("MR46"),b=o(g),y=n("8+DQ"),_=o(y),w=n("NYxO");e.default={props:{devid:{type:String,default:""},name:{type:String,default:""}},data:function(){return{q:"",online:"",devOnline:!1,channel_type:"",dir_serial:"",total:0,pageSize:10,currentPage:1,sort:"Channel",order:"asc",loading:!1,bgLoading:!1,timer:0,channels:[],uploadThisDev:!1,catalogMap:{}}},computed:r({},(0,w.mapState)(["userInfo","serverInfo"]),{uploadURL:function(){return this.uploadThisDev&&this.devid?"/api/v1/channel/import?serial="+this.devid:"/api/v1/channel/import"}}),components:{ChannelExportDlg:l.default,UploadDlg:c.default,ChannelNameEditDlg:f.default,ChannelPositionEditDlg:p.default,VideoDlg:m.default,DeviceLogDlg:b.default,DecodeChannelListDlg:_.default},mounted:function(){},beforeDestroy:function(){this.timer&&(clearInterval(this.timer),this.timer=0)},methods:{ready:function(){var t=this;this.unWatchQ=this.$watch("q",function(t,e){this.doDelaySearch()}),this.unWatchOnline=this.$watch("online",function(t,e){this.doSearch()}),this.unWatchChannelType=this.$watch("channel_type",function(t,e){this.doSearch()}),this.unWatchDirSerial=this.$watch("dir_serial",function(t,e){this.doSearch()}),this.unWatchCurrentPage=this.$watch("currentPage",function(t,e){this.doSearch(t)}),this.timer=setInterval(function(){t.getChannels(!1)},3e3)},undoReady:function(){this.unWatchQ&&(this.unWatchQ(),this.unWatchQ=null),this.unWatchOnline&&(this.unWatchOnline(),this.unWatchOnline=null),this.unWatchChannelType&&(this.unWatchChannelType(),this.unWatchChannelType=null),this.unWatchDirSerial&&(this.unWatchDirSerial(),this.unWatchDirSerial=null),this.unWatchCurrentPage&&(this.unWatchCurrentPage(),this.unWatchCurrentPage=null),this.timer&&(clearInterval(this.timer),this.timer=0)},keyDown:function(t){27==t.keyCode&&this.$el.querySelector(".fa-chevron-left").click()},doSearch:function(){var t=arguments.length>0&&void 0!==arguments[0]?arguments[0]:1,e={};this.q&&(e.q=this.q),this.online&&(e.online=this.online),this.channel_type&&(e.channel_type=this.channel_type),this.dir_serial&&(e.dir_serial=this.dir_serial),this.$router.replace({path:"/devices/channels/"+this.devid+"/"+t,query:e})},doDelaySearch:a.default.debounce(function(){this.doSearch()},800),getChannels:function(){var e=this,n=!(arguments.length>0&&void 0!==arguments[0])||arguments[0];if(n)this.loading=!0;else{if(this.bgLoading||this.loading)return;this.bgLoading=!0}t.ajax({method:"GET",url:"/api/v1/device/channellist",global:n,data:{serial:this.devid,q:this.q,start:(this.currentPage-1)*this.pageSize,limit:this.pageSize,online:this.online,channel_type:this.channel_type,dir_serial:this.dir_serial,sort:this.sort,order:this.order}}).then(function(t){e.total=t.ChannelCount,e.channels=t.ChannelList}).always(function(){n?e.loading=!1:e.bgLoading=!1})},uploaded:function(){this.$message({type:"success",message:"上传成功！"}),this.getChannels(),this.$refs.uploadDlg.hide()},sortChange:function(t){this.sort=t.prop,this.order="ascending"==t.order?"asc":"desc",this.getChannels()},canPlay:function(t){if(t&&t.DeviceOnline&&"GB"==t.DeviceType&&"ON"==(t.CustomStatus||t.Status)&&!t.Custom&&0==t.SubCount){if(this.serverInfo.StrictChannelParental&&1==t.Parental)return!1;if(this.serverInfo.StrictChannelCode&&20!=t.ID.length)return!1;if((1!=t.Parental||"LiveQing"!=t.Manufacturer)&&(20!=t.ID.length||"216"!=t.ID.substring(10,13)&&"215"!=t.ID.substring(10,13)))return!0}return!1},canPlayback:function(t){if(t&&t.DeviceOnline&&"GB"==t.DeviceType&&!t.Custom&&0==t.SubCount){if(this.serverInfo.StrictChannelParental&&1==t.Parental)return!1;if(this.serverInfo.StrictChannelCode&&20!=t.ID.length)return!1;if((1!=t.Parental||"LiveQing"!=t.Manufacturer)&&(20!=t.ID.length||"216"!=t.ID.substring(10,13)&&"215"!=t.ID.substring(10,13)))return!0}return!1},canDecode:function(t){if(t&&t.DeviceOnline&&"Decode"==t.DeviceType&&"ON"==(t.CustomStatus||t.Status)&&!t.Custom&&0==t.SubCount){if(this.serverInfo.StrictChannelParental&&1==t.Parental)return!1;if(this.serverInfo.StrictChannelCode&&20!=t.ID.length)return!1;if((1!=t.Parental||"LiveQing"!=t.Manufacturer)&&(20!=t.ID.length||"216"!=t.ID.substring(10,13)&&"215"!=t.ID.substring(10,13)))return!0}return!1},canCloudRecord:function(t){if(t&&t.CloudRecord&&"GB"==t.DeviceType&&!t.Custom&&0==t.SubCount){if(this.serverInfo.StrictChannelParental&&1==t.Parental)return!1;if(this.serverInfo.StrictChannelCode&&20!=t.ID.length)return!1;if((1!=t.Parental||"LiveQing"!=t.Manufacturer)&&(20!=t.ID.length||"216"!=t.ID.substring(10,13)&&"215"!=t.ID.substring(10,13)))return!0}return!1},isDir:function(t){if(t){if(t.SubCount>0)return!0;if(this.serverInfo.StrictChannelParental&&1==t.Parental)return!0;if(this.serverInfo.StrictChannelCode&&t.ID.length<=10)return!0;if(1==t.Parental&&"LiveQing"==t.Manufacturer)return!0;if(20==t.ID.length&&("216"==t.ID.substring(10,13)||"215"==t.ID.substring(10,13)))return!0}return!1},canFetchCatalog:function(t){return!(!this.isDir(t)||!t.DeviceOnline||t.ID==this.devid)},formatName:function(t,e,n){return t.CustomName||t.Name||"-"},formatPTZType:function(t,e,n){var o="未知";switch(t.CustomPTZType||t.PTZType){case 1:o="球机";break;case 2:o="半球";break;case 3:o="固定枪机";break;case 4:o="遥控枪机"}return o},formatPosition:function(t,e,n){var o="-,-";return t.CustomLongitude||t.CustomLatitude?o=t.CustomLongitude+","+t.CustomLatitude:(t.Longitude||t.Latitude)&&(o=t.Longitude+","+t.Latitude),o},formatCatalogTitle:function(t){return this.catalogMap[this.devid+":"+t.ID]?"更新中...":""},setPTZType:function(e){var n=e.PTZType;n===e.row.PTZType&&(n=0),e.row.CustomPTZType=n,t.post("/api/v1/device/setchannelptztype",{serial:e.row.DeviceID,code:e.row.ID,ptz_type:n})},toggleAudio:function(e){t.post("/api/v1/device/setchannelaudio",{serial:e.DeviceID,code:e.ID,audio:!e.AudioEnable}).then(function(){e.AudioEnable=!e.AudioEnable})},editChannelName:function(t){this.$refs.channelNameEditDlg.show({serial:t.DeviceID,code:t.ID,name:t.Name,custom:t.Custom,customID:t.CustomID,customName:t.CustomName,description:t.Description})},editChannelPosition:function(t){this.$refs.channelPositionEditDlg.show({serial:t.DeviceID,code:t.ID,name:t.CustomName||t.Name,custom:t.Custom,longitude:t.Longitude,latitude:t.Latitude,customLongitude:t.CustomLongitude,customLatitude:t.CustomLatitude})},toggleOndemand:function(e){t.post("/api/v1/device/setchannelondemand",{serial:e.DeviceID,code:e.ID,ondemand:!e.Ondemand}).then(function(){e.Ondemand=!e.Ondemand})},toggleCloudRecord:function(e){var n=e.CloudRecord?"关闭云端录像, 是否同时设置 开启 按需直播?":"开启云端录像, 是否同时设置 关闭 按需直播?";this.$confirm(n,"提示",{distinguishCancelAndClose:!0,cancelButtonText:"不设置",lockScroll:!1}).then(function(){t.post("/api/v1/device/setchannelcloudrecord",{serial:e.DeviceID,code:e.ID,cloudrecord:!e.CloudRecord,ondemand:e.CloudRecord}).then(function(){e.Ondemand=e.CloudRecord,e.CloudRecord=!e.CloudRecord})}).catch(function(n){"cancel"===n&&t.post("/api/v1/device/setchannelcloudrecord",{serial:e.DeviceID,code:e.ID,cloudrecord:!e.CloudRecord}).then(function(){e.CloudRecord=!e.CloudRecord})})},toggleShared:function(e){t.post("/api/v1/device/setchannelshared",{serial:e.DeviceID,code:e.ID,shared:!e.Shared}).then(function(){e.Shared=!e.Shared})},playStream:function(e){var n=this;this.loading=!0,this.$set(e,"Locked",!0),t.post("/api/v1/stream/start",{serial:e.DeviceID,code:e.ID}).then(function(t){n.$refs.videoDlg.play(e.CustomName||e.Name||e.ID,e.DeviceID,e.ID,t)}).always(function(){n.loading=!1,n.$delete(e,"Locked")})},decodeStart:function(e){var n=this,o=(e.StreamID||"").split(":");3==o.length?(this.loading=!0,this.$set(e,"Locked",!0),t.post("/api/v1/stream/start",{serial:o[1],code:o[2]}).then(function(t){n.$refs.videoDlg.play(e.CustomName||e.Name||e.ID,o[1],o[2],t)}).always(function(){n.loading=!1,n.$delete(e,"Locked")})):this.$refs.decodeChannelListDlg.show(e)},decodeStream:function(e,n){var o=this;this.loading=!0,this.$set(e,"Locked",!0),t.post("/api/v1/stream/start",{serial:n.DeviceID,code:n.ID}).then(function(r){t.post("/api/v1/decode/start",{serial:e.DeviceID,code:e.ID,streamid:r.StreamID}).then(function(){o.$refs.videoDlg.play(e.CustomName||e.Name||e.ID,n.DeviceID,n.ID,r)}).always(function(){o.loading=!1,o.$delete(e,"Locked")})}).fail(function(){o.loading=!1,o.$delete(e,"Locked")})},stopStream:function(e){var n=this;if("Decode"==e.DeviceType)return void this.$confirm("确认停止解码 "+(e.Name||e.ID)+" ?","提示",{lockScroll:!1}).then(function(){t.post("/api/v1/decode/stop",{serial:n.devid,code:e.ID}).always(function(){n.getChannels()})}).catch(function(){});this.$confirm("确认停止直播 "+(e.Name||e.ID)+" ?","提示",{lockScroll:!1}).then(function(){t.post("/api/v1/stream/stop",{serial:n.devid,code:e.ID}).always(function(){n.getChannels()})}).catch(function(){})},fetchCatalog:function(e){var n=this;if(this.serverInfo&&!this.serverInfo.RemainDays)return void this.$message({type:"error",message:"授权过期"});this.$set(this.catalogMap,this.devid+":"+e.ID,!0),t.ajax({method:"GET",url:"/api/v1/device/fetchcatalog",global:!1,data:{serial:this.devid,code:e.ID}}).then(function(){n.$message({type:"success",message:"更新通道信息成功"})}).fail(function(t){t&&console.log("fetch catalog ajax error: "+t.status+" "+t.responseText)}).always(function(){n.$delete(n.catalogMap,n.devid+":"+e.ID)})},removeVirtual:function(e){var n=this;this.$confirm("确认删除虚拟通道 "+(e.Name||e.ID)+" ?","提示",{lockScroll:!1}).then(function(){t.post("/api/v1/channel/virtual/remove",{serial:n.devid,code:e.ID}).always(function(){n.getChannels()})}).catch(function(){})},showDeviceLog:function(t){this.$refs.deviceLogDlg.show("通道("+t.ID+")",t.DeviceID,t.ID)},showStatusLog:function(t){this.$refs.deviceLogDlg.showStatusLog("通道("+t.ID+")",t.DeviceID,t.ID)},showStreamLog:function(t){this.$refs.deviceLogDlg.showStreamLog("通道("+t.ID+")",t.DeviceID,t.ID)}},beforeRouteEnter:function(t,e,n){n(function(e){e.q=t.query.q||"",e.online=t.query.online||"",e.channel_type=t.query.channel_type||"",e.dir_serial=t.query.dir_serial||"",e.currentPage=parseInt(t.params.page)||1,e.ready()})},beforeRouteLeave:function(t,e,n){this.timer&&(clearInterval(this.timer),this.timer=0),n()},beforeRouteUpdate:function(t,e,n){var o=this;this.undoReady(),n(),this.$nextTick(function(){o.q=t.query.q||"",o.online=t.query.online||"",o.channel_type=t.query.channel_type||"",o.dir_serial=t.query.dir_serial||"",o.currentPage=parseInt(t.params.page)||1,o.channels=[],o.total=0,o.getChannels(),o.ready()})}}}).call(e,n("0iPh"))},lgdx:function(t,e,n){"use strict";(function(t){Object.defineProperty(e,"__esModule",{value:!0}),n("F8S1"),e.default={data:function(){return{bShow:!1,timer:0,title:"",streamID:"",progress:0,speed:1,inBitRate:0,fileUrl:"",fileError:""}},props:{fade:{type:Boolean,default:!1}},mounted:function(){var e=this;t(this.$el).find(".modal-content").draggable({handle:".modal-header",cancel:".modal-title span",addClasses:!1,containment:"document",delay:100,opacity:.5}),t(this.$el).on("shown.bs.modal",function(){e.bShow=!0,e.$emit("show"),e.streamID&&(e.timer=setInterval(function(){t.ajax({type:"GET",url:"/api/v1/playback/streaminfo",data:{streamid:e.streamID},global:!1}).then(function(t){e.progress=Math.ceil(100*t.Progress),e.fileUrl=t.PlaybackFileURL||"",e.fileError=t.PlaybackFileError||"",e.speed=t.PlaybackSpeed||1,e.inBitRate=t.InBitRate||0}).fail(function(){e.progress=100,e.inBitRate=0})},3e3))}).on("hidden.bs.modal",function(){e.bShow=!1,e.$emit("hide"),e.timer&&(clearInterval(e.timer),e.timer=0),e.stop()})},beforeDestroy:function(){this.timer&&(clearInterval(this.timer),this.timer=0)},methods:{show:function(){t(this.$el).modal("show")},hide:function(){this.stop(!0),t(this.$el).modal("hide")},doSubmit:function(){this.$emit("submit")},download:function(e,n){this.title=e,this.streamID=n,this.fileUrl="",this.fileError="",this.progress=0,this.speed=1,this.inBitRate=0,t(this.$el).modal("show")},scale:function(e){var n=this;this.streamID&&t.post("/api/v1/playback/control",{streamid:this.streamID,command:"scale",scale:e}).then(function(t){n.$message({type:"success",message:e+" 倍速设置成功"})})},stop:function(){var e=this,n=arguments.length>0&&void 0!==arguments[0]&&arguments[0];this.streamID&&(t.ajax({type:"POST",url:"/api/v1/playback/stop",data:{streamid:this.streamID},global:!1}).always(function(){e.fileUrl&&n&&window.open(e.fileUrl,"_blank"),e.fileUrl="",e.fileError="",e.progress=0,e.inBitRate=0,e.$emit("download")}),this.streamID="")}}}}).call(e,n("0iPh"))},mZnh:function(t,e,n){"use strict";function o(t){n("YgVS")}Object.defineProperty(e,"__esModule",{value:!0});var r=n("lN7H"),i=n.n(r);for(var a in r)["default","default"].indexOf(a)<0&&function(t){n.d(e,t,function(){return r[t]})}(a);var s=n("JT0P"),l=n("VU/8"),u=o,c=l(i.a,s.a,!1,u,null,null);e.default=c.exports},pCZB:function(t,e,n){"use strict";(function(t){function o(t){return t&&t.__esModule?t:{default:t}}Object.defineProperty(e,"__esModule",{value:!0});var r=Object.assign||function(t){for(var e=1;e<arguments.length;e++){var n=arguments[e];for(var o in n)Object.prototype.hasOwnProperty.call(n,o)&&(t[o]=n[o])}return t},i=n("M4fF"),a=(o(i),n("PJh5")),s=o(a),l=n("WEd6"),u=o(l),c=n("5aEn"),d=o(c),f=n("ENv+"),v=o(f),p=n("NYxO");e.default={props:{devid:{type:String,default:""},channel:{type:String,default:""},mode:{type:String,default:"list"},day:{type:String,default:function(){return(0,s.default)().format("YYYYMMDD")}}},data:function(){return{timerange:[(0,s.default)(this.day,"YYYYMMDD").startOf("hour").toDate(),(0,s.default)(this.day,"YYYYMMDD").startOf("hour").toDate()],currentPage:1,pageSize:10,loading:!1,sort:"",order:"",center:"",indistinct:"",streamID:"",records:[]}},components:{PlaybackVideoDlg:d.default,PlaybackDownloadDlg:v.default,DatePicker:u.default},computed:r({},(0,p.mapState)(["userInfo","serverInfo"]),{total:function(){return this.records.length},pageData:function(){var t=(this.currentPage-1)*this.pageSize,e=t+this.pageSize;return this.records.slice(t,e)}}),methods:{ready:function(){this.$watch("day",function(t,e){this.timerange=[(0,s.default)(this.day,"YYYYMMDD").startOf("hour").toDate(),(0,s.default)(this.day,"YYYYMMDD").startOf("hour").toDate()]}),this.$watch("center",function(t,e){this.timerange=[(0,s.default)(this.day,"YYYYMMDD").startOf("hour").toDate(),(0,s.default)(this.day,"YYYYMMDD").startOf("hour").toDate()]}),this.$watch("indistinct",function(t,e){this.timerange=[(0,s.default)(this.day,"YYYYMMDD").startOf("hour").toDate(),(0,s.default)(this.day,"YYYYMMDD").startOf("hour").toDate()]}),console.log(this.devid,this.channel,this.day),this.getRecords(!0),t(window).on("beforeunload",this.beforeUnload)},keyDown:function(t){27==t.keyCode&&this.$el.querySelector(".fa-chevron-left").click()},isMobile:function(){return videojs.browser.IS_IOS||videojs.browser.IS_ANDROID},showDatePicker:function(){t(this.$refs.datePicker.$el).focus()},updateDay:function(t){var e=this;this.$nextTick(function(){e.$router.replace({path:"/devices/playback/"+e.mode+"/"+e.devid+"/"+e.channel+"/"+t,query:Object.assign({},e.$route.query,{center:e.center,indistinct:e.indistinct})})})},nextTimeRange:function(){var t=(0,s.default)(this.day,"YYYYMMDD").endOf("day"),e=(0,s.default)().startOf("second");t.isAfter(e,"second")&&(t=e);var n=(0,s.default)(this.timerange[1]);if(n.isSameOrAfter(t,"second"))return!1;var o=(0,s.default)(this.timerange[1]).add(6,"hours");return o.isAfter(t)&&(o=t),!o.clone().startOf("minute").isSameOrBefore(n.clone().startOf("minute"),"second")&&(console.log(n.format("YY-MM-DD HH:mm:ss"),"~",o.format("YY-MM-DD HH:mm:ss"),"loading..."),this.timerange=[n.toDate(),o.toDate()],!0)},getRecords:function(e){var n=this;if(e&&(this.loading=!0,this.records=[],this.currentPage=1),!this.nextTimeRange())return this.sortRecords(),void this.$nextTick(function(){n.loading=!1});t.ajax("/api/v1/playback/recordlist",{type:"get",global:!1,data:{timeout:this.serverInfo.RecordListTimeout||5,serial:this.devid,code:this.channel,center:this.center,indistinct:this.indistinct,starttime:(0,s.default)(this.timerange[0]).format("YYYY-MM-DDTHH:mm:ss"),endtime:(0,s.default)(this.timerange[1]).format("YYYY-MM-DDTHH:mm:ss")}}).then(function(t){var e=t.RecordList||[];n.records=n.records.concat(e.filter(function(t){return!!(t&&t.StartTime&&t.EndTime)&&(!n.records.some(function(e){return e.StartTime===t.StartTime&&e.EndTime===t.EndTime})||(console.log("repeat",t.StartTime,"~",t.EndTime),!1))}))}).always(function(){n.$nextTick(function(){n.getRecords(!1)})})},formatName:function(t,e,n){return n||"-"},stopPlayback:function(){var e=this;return new Promise(function(n,o){if(!e.streamID)return void n();t.ajax({method:"POST",url:"/api/v1/playback/stop",global:!1,data:{streamid:e.streamID}}).always(function(){n()}),e.streamID=""})},beforeUnload:function(t){this.stopPlayback(),t.returnValue=""},downloadPlayback:function(e){var n=this;this.loading=!0,this.$set(e,"Starting",!0),t.post("/api/v1/playback/start",{serial:this.devid,code:this.channel,starttime:e.StartTime,endtime:e.EndTime,download:!0}).then(function(t){n.streamID=t.StreamID,n.$refs.playbackDownloadDlg.download(e.StartTime+" - "+e.EndTime,t.StreamID)}).always(function(){n.$nextTick(function(){n.loading=!1}),n.$delete(e,"Starting")})},startPlayback:function(e){var n=this;this.loading=!0,this.$set(e,"Starting",!0),t.post("/api/v1/playback/start",{serial:this.devid,code:this.channel,starttime:e.StartTime,endtime:e.EndTime}).then(function(t){n.streamID=t.StreamID;var o=t.HLS,r="HLS";switch(n.flvSupported()&&(r=n.isIE()?"WS_FLV":"FLV",o=n.isIE()?t.WS_FLV:t.FLV),String(n.serverInfo.PreferStreamFmt).toUpperCase()){case"WEBRTC":n.rtcSupported()&&(o=t.WEBRTC,r="WEBRTC");break;case"FLV":n.flvSupported()&&!n.isIE()&&(o=t.FLV,r="FLV");break;case"WS_FLV":case"WS-FLV":n.flvSupported()&&(o=t.WS_FLV,r="WS_FLV");break;case"HLS":o=t.HLS,r="HLS";break;case"RTMP":o=t.RTMP,r="RTMP"}var i="RTMP"==r?"":e.Snap||"";n.$refs.playbackVideoDlg.play(r,o,e.Name||e.DeviceID,i,t)}).always(function(){n.$nextTick(function(){n.loading=!1}),n.$delete(e,"Starting")})},toTimeView:function(){this.$router.replace({path:"/devices/playback/timebox/"+this.devid+"/"+this.channel+"/"+this.day,query:Object.assign({},this.$route.query,{center:this.center,indistinct:this.indistinct})})},sortChange:function(t){t&&t.prop&&t.order&&(this.sort=t.prop,this.order=t.order,this.sortRecords())},sortRecords:function(){var t=this;this.sort&&this.records.sort(function(e,n){var o=e[t.sort],r=n[t.sort],i=0;return o&&r?(o<r?i=-1:o>r&&(i=1),"desc"!==t.order&&"descending"!==t.order||(i*=-1),i):i})},onDownload:function(){this.streamID=""},onClose:function(){this.streamID=""}},mounted:function(){},beforeDestroy:function(){t(window).off("beforeunload",this.beforeUnload),this.stopPlayback()},beforeRouteLeave:function(t,e,n){this.stopPlayback(),n()},beforeRouteEnter:function(t,e,n){n(function(e){e.center=t.query.center,e.indistinct=t.query.indistinct,e.ready()})},beforeRouteUpdate:function(t,e,n){var o=this;if(!t.params.day)return void n({path:"/devices/playback/"+this.mode+"/"+t.params.devid+"/"+t.params.channel+"/"+(0,s.default)().format("YYYYMMDD"),query:Object.assign({},this.$route.query,{center:this.center,indistinct:this.indistinct}),replace:!0});this.center=t.query.center,this.indistinct=t.query.indistinct,this.stopPlayback(),n(),this.$nextTick(function(){o.loading||e.path==t.path||(console.log(o.devid,o.channel,o.day),o.getRecords(!0))})}}}).call(e,n("0iPh"))},pFnw:function(t,e,n){"use strict";var o,r,i,a="function"==typeof Symbol&&"symbol"==typeof Symbol.iterator?function(t){return typeof t}:function(t){return t&&"function"==typeof Symbol&&t.constructor===Symbol&&t!==Symbol.prototype?"symbol":typeof t};!function(a){r=[n("0iPh")],o=a,void 0!==(i="function"==typeof o?o.apply(e,r):o)&&(t.exports=i)}(function(t){var e=Array.prototype.slice,n=Array.prototype.splice,o={topSpacing:0,bottomSpacing:0,className:"sticky",wrapperClassName:"sticky-wrapper",center:!1,getWidthFrom:"",widthFromWrapper:!0,responsiveWidth:!1,zIndex:"auto"},r=t(window),i=t(document),s=[],l=r.height(),u=function(){for(var e=r.scrollTop(),n=i.height(),o=n-l,a=e>o?o-e:0,u=0,c=s.length;u<c;u++){var d=s[u],f=d.stickyWrapper.offset().top,v=f-d.topSpacing-a;if(d.stickyWrapper.css("height",d.stickyElement.outerHeight()),e<=v)null!==d.currentTop&&(d.stickyElement.css({width:"",position:"",top:"","z-index":""}),d.stickyElement.parent().removeClass(d.className),d.stickyElement.trigger("sticky-end",[d]),d.currentTop=null);else{var p=n-d.stickyElement.outerHeight()-d.topSpacing-d.bottomSpacing-e-a;if(p<0?p+=d.topSpacing:p=d.topSpacing,d.currentTop!==p){var h;d.getWidthFrom?h=t(d.getWidthFrom).width()||null:d.widthFromWrapper&&(h=d.stickyWrapper.width()),null==h&&(h=d.stickyElement.width()),d.stickyElement.css("width",h).css("position","fixed").css("top",p).css("z-index",d.zIndex),d.stickyElement.parent().addClass(d.className),null===d.currentTop?d.stickyElement.trigger("sticky-start",[d]):d.stickyElement.trigger("sticky-update",[d]),d.currentTop===d.topSpacing&&d.currentTop>p||null===d.currentTop&&p<d.topSpacing?d.stickyElement.trigger("sticky-bottom-reached",[d]):null!==d.currentTop&&p===d.topSpacing&&d.currentTop<p&&d.stickyElement.trigger("sticky-bottom-unreached",[d]),d.currentTop=p}var m=d.stickyWrapper.parent();d.stickyElement.offset().top+d.stickyElement.outerHeight()>=m.offset().top+m.outerHeight()&&d.stickyElement.offset().top<=d.topSpacing?d.stickyElement.css("position","absolute").css("top","").css("bottom",0).css("z-index",""):d.stickyElement.css("position","fixed").css("top",p).css("bottom","").css("z-index",d.zIndex)}}},c=function(){l=r.height();for(var e=0,n=s.length;e<n;e++){var o=s[e],i=null;o.getWidthFrom?o.responsiveWidth&&(i=t(o.getWidthFrom).width()):o.widthFromWrapper&&(i=o.stickyWrapper.width()),null!=i&&o.stickyElement.css("width",i)}},d={init:function(e){return this.each(function(){var n=t.extend({},o,e),r=t(this),i=r.attr("id"),a=i?i+"-wrapper":o.wrapperClassName,l=t("<div></div>").attr("id",a).addClass(n.wrapperClassName);r.wrapAll(function(){if(0==t(this).parent("#"+a).length)return l});var u=r.parent();n.center&&u.css({width:r.outerWidth(),marginLeft:"auto",marginRight:"auto"}),"right"===r.css("float")&&r.css({float:"none"}).parent().css({float:"right"}),n.stickyElement=r,n.stickyWrapper=u,n.currentTop=null,s.push(n),d.setWrapperHeight(this),d.setupChangeListeners(this)})},setWrapperHeight:function(e){var n=t(e),o=n.parent();o&&o.css("height",n.outerHeight())},setupChangeListeners:function(t){if(window.MutationObserver){new window.MutationObserver(function(e){(e[0].addedNodes.length||e[0].removedNodes.length)&&d.setWrapperHeight(t)}).observe(t,{subtree:!0,childList:!0})}else window.addEventListener?(t.addEventListener("DOMNodeInserted",function(){d.setWrapperHeight(t)},!1),t.addEventListener("DOMNodeRemoved",function(){d.setWrapperHeight(t)},!1)):window.attachEvent&&(t.attachEvent("onDOMNodeInserted",function(){d.setWrapperHeight(t)}),t.attachEvent("onDOMNodeRemoved",function(){d.setWrapperHeight(t)}))},update:function(){c(),u()},unstick:function(e){return this.each(function(){for(var e=this,o=t(e),r=-1,i=s.length;i-- >0;)s[i].stickyElement.get(0)===e&&(n.call(s,i,1),r=i);-1!==r&&(o.unwrap(),o.css({width:"",position:"",top:"",float:"","z-index":""}))})}};window.addEventListener?(window.addEventListener("scroll",u,!1),window.addEventListener("resize",c,!1)):window.attachEvent&&(window.attachEvent("onscroll",u),window.attachEvent("onresize",c)),t.fn.sticky=function(n){return d[n]?d[n].apply(this,e.call(arguments,1)):"object"!==(void 0===n?"undefined":a(n))&&n?void t.error("Method "+n+" does not exist on jQuery.sticky"):d.init.apply(this,arguments)},t.fn.unstick=function(n){return d[n]?d[n].apply(this,e.call(arguments,1)):"object"!==(void 0===n?"undefined":a(n))&&n?void t.error("Method "+n+" does not exist on jQuery.sticky"):d.unstick.apply(this,arguments)},t(function(){setTimeout(u,0)})})},qDl4:function(t,e,n){"use strict";Object.defineProperty(e,"__esModule",{value:!0});var o=n("JRgq"),r=n.n(o);for(var i in o)["default","default"].indexOf(i)<0&&function(t){n.d(e,t,function(){return o[t]})}(i);var a=n("JCFA"),s=n("VU/8"),l=s(r.a,a.a,!1,null,null,null);e.default=l.exports},qb8i:function(t,e,n){"use strict";Object.defineProperty(e,"__esModule",{value:!0}),n("F8S1");var o=n("0iPh"),r=function(t){return t&&t.__esModule?t:{default:t}}(o);e.default={props:{title:{default:"上传"},url:{type:String,default:""},multiple:{type:Boolean,default:!1},drag:{type:Boolean,default:!0},showFileList:{type:Boolean,default:!0},accept:{default:".xlsx"},tip:{default:"仅限上传.xlsx"},limit:{type:Number,default:0},autoUpload:{type:Boolean,default:!0}},data:function(){return{bShow:!1,bProgress:!1}},mounted:function(){var t=this;(0,r.default)(this.$el).find(".modal-content").draggable({handle:".modal-header",cancel:".modal-title span",addClasses:!1,containment:"document",delay:100,opacity:.5}),(0,r.default)(this.$el).on("shown.bs.modal",function(){t.$emit("show"),t.bShow=!0}).on("hidden.bs.modal",function(){t.$emit("hide"),t.bShow=!1,t.$refs.uploader.clearFiles()}).on("hide.bs.modal",function(){if(t.bProgress)return t.$message({type:"error",message:"正在上传, 请稍后..."}),!1})},methods:{show:function(){(0,r.default)(this.$el).modal("show")},hide:function(){(0,r.default)(this.$el).modal("hide")},beforeUpload:function(t){return!(this.limit&&t.size>this.limit)||(this.$message({type:"error",message:"文件["+t.name+"]大小超过限制["+this.limit+"], 不能上传!"}),!1)},onSuccess:function(t,e,n){var o=!0,r=!1,i=void 0;try{for(var a,s=n[Symbol.iterator]();!(o=(a=s.next()).done);o=!0){var l=a.value;if(100!=l.percentage&&0!==l.percentage)return void(this.bProgress=!0)}}catch(t){r=!0,i=t}finally{try{!o&&s.return&&s.return()}finally{if(r)throw i}}this.bProgress=!1,this.$emit("uploaded",e)},onProgress:function(t,e,n){this.bProgress=!0},onError:function(t,e,n){t&&this.$message({type:"error",message:t+""});var o=!0,r=!1,i=void 0;try{for(var a,s=n[Symbol.iterator]();!(o=(a=s.next()).done);o=!0){var l=a.value;if(100!=l.percentage&&0!==l.percentage)return void(this.bProgress=!0)}}catch(t){r=!0,i=t}finally{try{!o&&s.return&&s.return()}finally{if(r)throw i}}this.bProgress=!1},onRemove:function(t,e){var n=!0,o=!1,r=void 0;try{for(var i,a=e[Symbol.iterator]();!(n=(i=a.next()).done);n=!0){var s=i.value;if(100!=s.percentage&&0!==s.percentage)return void(this.bProgress=!0)}}catch(t){o=!0,r=t}finally{try{!n&&a.return&&a.return()}finally{if(o)throw r}}this.bProgress=!1}}}},ql7U:function(t,e,n){var o=n("d155");"string"==typeof o&&(o=[[t.i,o,""]]),o.locals&&(t.exports=o.locals);n("rjj0")("d4a0308e",o,!0,{})},rF1v:function(t,e,n){e=t.exports=n("FZ+f")(!1),e.push([t.i,".upload-dlg .modal-dialog .modal-body{overflow:hidden}.upload-dlg .modal-dialog .modal-body .el-upload{display:block}.upload-dlg .modal-dialog .modal-body .el-upload .el-upload-dragger{margin:0 auto}",""])},sJmG:function(t,e,n){"use strict";Object.defineProperty(e,"__esModule",{value:!0});var o=n("2xaA"),r=n.n(o);for(var i in o)["default","default"].indexOf(i)<0&&function(t){n.d(e,t,function(){return o[t]})}(i);var a=n("6Exk"),s=n("VU/8"),l=s(r.a,a.a,!1,null,null,null);e.default=l.exports},tHoF:function(t,e,n){"use strict";var o=function(){var t=this,e=t.$createElement,n=t._self._c||e;return n("FormDlg",{ref:"dlg",attrs:{title:"编辑通道",disabled:t.errors.any()},on:{hide:t.onHide,show:t.onShow,submit:t.onSubmit}},[n("div",{class:{"form-group":!0,"has-error":t.errors.has("serial")}},[n("label",{staticClass:"col-sm-4 control-label",attrs:{for:"input-serial"}},[t._v("设备编号\n            "),n("span",{staticClass:"text-red"},[t._v("*")])]),t._v(" "),n("div",{staticClass:"col-sm-7"},[n("input",{staticClass:"form-control",attrs:{type:"text",readonly:"readonly",id:"input-serial",name:"serial","data-vv-as":"设备编号"},domProps:{value:t.form.serial}})])]),t._v(" "),n("div",{class:{"form-group":!0,"has-error":t.errors.has("code")}},[n("label",{staticClass:"col-sm-4 control-label",attrs:{for:"input-code"}},[t._v("通道编号\n            "),n("span",{staticClass:"text-red"},[t._v("*")])]),t._v(" "),n("div",{staticClass:"col-sm-7"},[n("input",{staticClass:"form-control",attrs:{type:"text",readonly:"readonly",id:"input-code",name:"code","data-vv-as":"通道编号"},domProps:{value:t.form.code}})])]),t._v(" "),"标准版"!==t.serverInfo.VersionType?n("div",{class:{"form-group":!0,"has-error":t.errors.has("custom_id")}},[n("label",{staticClass:"col-sm-4 control-label",attrs:{for:"input-custom-id"}},[t._v("自定义通道编号\n        ")]),t._v(" "),n("div",{staticClass:"col-sm-7"},[n("input",{directives:[{name:"model",rawName:"v-model.trim",value:t.form.customID,expression:"form.customID",modifiers:{trim:!0}}],staticClass:"form-control",attrs:{type:"text",id:"input-custom-id",name:"custom_id",placeholder:"可选, 用于级联","data-vv-as":"自定义通道编号",oninput:"value=value.replace(/[^\\d]/g,'')"},domProps:{value:t.form.customID},on:{keydown:function(e){if(!("button"in e)&&t._k(e.keyCode,"enter",13,e.key))return null;e.preventDefault(),t.$el.querySelector("#input-name").focus()},input:function(e){e.target.composing||t.$set(t.form,"customID",e.target.value.trim())},blur:function(e){t.$forceUpdate()}}})])]):t._e(),t._v(" "),n("div",{class:{"form-group":!0,"has-error":t.errors.has("name")}},[n("label",{staticClass:"col-sm-4 control-label",attrs:{for:"input-name"}},[t._v("自定义名称\n        ")]),t._v(" "),n("div",{staticClass:"col-sm-7"},[n("div",{staticClass:"input-group",staticStyle:{width:"100%"}},[n("input",{directives:[{name:"model",rawName:"v-model.trim",value:t.form.customName,expression:"form.customName",modifiers:{trim:!0}}],staticClass:"form-control",attrs:{type:"text",id:"input-name",name:"name",placeholder:t.form.name||"-","data-vv-as":"自定义名称"},domProps:{value:t.form.customName},on:{keydown:function(e){if(!("button"in e)&&t._k(e.keyCode,"enter",13,e.key))return null;e.preventDefault(),t.$el.querySelector("#input-description").focus()},input:function(e){e.target.composing||t.$set(t.form,"customName",e.target.value.trim())},blur:function(e){t.$forceUpdate()}}}),t._v(" "),n("span",{directives:[{name:"show",rawName:"v-show",value:t.form.customName||t.form.name,expression:"form.customName || form.name"}],staticClass:"input-group-btn"},[n("button",{directives:[{name:"clipboard",rawName:"v-clipboard",value:t.form.customName||t.form.name,expression:"form.customName || form.name"}],staticClass:"btn btn-default",staticStyle:{"border-top-right-radius":"0","border-bottom-right-radius":"0"},attrs:{type:"button",title:t.form.customName?"拷贝自定义名称":"拷贝原名称"},on:{success:function(e){t.$message({type:"success",message:"成功拷贝到粘贴板"})}}},[n("i",{staticClass:"fa fa-copy"})])])])])]),t._v(" "),n("div",{class:{"form-group":!0,"has-error":t.errors.has("description")}},[n("label",{staticClass:"col-sm-4 control-label",attrs:{for:"input-description"}},[t._v("备注\n        ")]),t._v(" "),n("div",{staticClass:"col-sm-7"},[n("el-input",{attrs:{id:"input-description",type:"textarea",autosize:{minRows:3,maxRows:10},rows:3,placeholder:"可选"},model:{value:t.form.description,callback:function(e){t.$set(t.form,"description","string"==typeof e?e.trim():e)},expression:"form.description"}})],1)])])},r=[],i={render:o,staticRenderFns:r};e.a=i},tS9P:function(t,e,n){e=t.exports=n("FZ+f")(!1),e.push([t.i,".time-rule[data-v-4b1fba7c]{overflow:hidden;position:relative;height:50px;margin:0 auto;width:100%;font-size:12px;max-width:1440px;background-color:#ccc}.time-day[data-v-4b1fba7c]{position:absolute;left:0;top:0;height:100%;width:1440px;cursor:pointer;-ms-user-select:none;-webkit-user-select:none;-moz-user-select:none;user-select:none}.time-minute[data-v-4b1fba7c]{float:left;height:8px;margin:0;cursor:default}.time-minute.active[data-v-4b1fba7c]{background-color:#404d59;cursor:pointer}.time-text-first[data-v-4b1fba7c],.time-text[data-v-4b1fba7c]{float:left;width:60px;border-left:1px solid #999;border-top:1px solid #999;-ms-user-select:none;-webkit-user-select:none;-moz-user-select:none;user-select:none;text-align:center;height:25px;line-height:25px}.time-text-first[data-v-4b1fba7c]{border-left:0}.time-cursor[data-v-4b1fba7c]{position:absolute;left:0;top:0;height:30px;width:2px;background-color:red;text-align:center}.time-cursor-text[data-v-4b1fba7c]{position:absolute;padding:0 5px;width:60px;left:-30px;top:30px;border:1px solid red;height:15px;line-height:15px;cursor:move;background-color:#fff;-ms-user-select:none;-webkit-user-select:none;-moz-user-select:none;user-select:none}",""])},toVl:function(t,e,n){"use strict";(function(t){function o(t){return t&&t.__esModule?t:{default:t}}function r(t){return function(){var e=t.apply(this,arguments);return new Promise(function(t,n){function o(r,i){try{var a=e[r](i),s=a.value}catch(t){return void n(t)}if(!a.done)return Promise.resolve(s).then(function(t){o("next",t)},function(t){o("throw",t)});t(s)}return o("next")})}}Object.defineProperty(e,"__esModule",{value:!0});var i=Object.assign||function(t){for(var e=1;e<arguments.length;e++){var n=arguments[e];for(var o in n)Object.prototype.hasOwnProperty.call(n,o)&&(t[o]=n[o])}return t},a=n("M4fF"),s=(o(a),n("PJh5")),l=o(s),u=n("WEd6"),c=o(u),d=n("+ABx"),f=o(d),v=n("S4Bz"),p=o(v),h=n("ENv+"),m=o(h),g=n("eAMf"),b=o(g),y=n("NYxO");e.default={props:{devid:{type:String,default:""},channel:{type:String,default:""},mode:{type:String,default:"timebox"},day:{type:String,default:function(){return(0,l.default)().format("YYYYMMDD")}}},data:function(){return{timerange:[(0,l.default)(this.day,"YYYYMMDD").startOf("hour").toDate(),(0,l.default)(this.day,"YYYYMMDD").startOf("hour").toDate()],videoLoading:!1,loading:!1,center:"",indistinct:"",records:[],currentTime:null,videos:[],video:null,protocol:"",videoUrl:"",streamID:"",osd:"",touchTimer:0,switchTimer:0,speed:1,mediaInfo:null,bOutHevcTip:!1,bDownloading:!1,bShared:!1,bAudioEnable:!1}},computed:i({},(0,y.mapState)(["userInfo","serverInfo"]),{shareURL:function(){return this.bShared||!1===this.serverInfo.APIAuth||this.serverInfo.DemoUser?"/playback.html?type=device&serial="+this.devid+"&code="+this.channel+"&day="+this.day:""}}),components:{DatePicker:c.default,LivePlayer:b.default,TimeRule:f.default,TimeRangeDlg:p.default,PlaybackDownloadDlg:m.default},methods:{ready:function(){this.$watch("day",function(t,e){this.timerange=[(0,l.default)(this.day,"YYYYMMDD").startOf("hour").toDate(),(0,l.default)(this.day,"YYYYMMDD").startOf("hour").toDate()]}),this.$watch("center",function(t,e){this.timerange=[(0,l.default)(this.day,"YYYYMMDD").startOf("hour").toDate(),(0,l.default)(this.day,"YYYYMMDD").startOf("hour").toDate()]}),this.$watch("indistinct",function(t,e){this.timerange=[(0,l.default)(this.day,"YYYYMMDD").startOf("hour").toDate(),(0,l.default)(this.day,"YYYYMMDD").startOf("hour").toDate()]}),this.$watch("video",function(t,e){t&&t!=e?this.startPlayback():this.stopPlayback()});var e=(0,l.default)(),n=e.diff(e.clone().startOf("day"),"minutes");n-=10,n<0&&(n=0),this.$refs.timeRule.clickMinute(n),console.log(this.devid,this.channel,this.day),this.getRecords(!0),t(window).on("beforeunload",this.beforeUnload)},keyDown:function(t){27==t.keyCode&&this.$el.querySelector(".fa-chevron-left").click()},isMobile:function(){return videojs.browser.IS_IOS||videojs.browser.IS_ANDROID},showDatePicker:function(){t(this.$refs.datePicker.$el).focus()},updateDay:function(t){var e=this;this.$nextTick(function(){e.$router.replace({path:"/devices/playback/"+e.mode+"/"+e.devid+"/"+e.channel+"/"+t,query:Object.assign({},e.$route.query,{center:e.center,indistinct:e.indistinct})})})},nextTimeRange:function(){var t=(0,l.default)(this.day,"YYYYMMDD").endOf("day"),e=(0,l.default)().startOf("second");t.isAfter(e,"second")&&(t=e);var n=(0,l.default)(this.timerange[1]);if(n.isSameOrAfter(t,"second"))return!1;var o=(0,l.default)(this.timerange[1]).add(6,"hours");return o.isAfter(t)&&(o=t),!o.clone().startOf("minute").isSameOrBefore(n.clone().startOf("minute"),"second")&&(console.log(n.format("YY-MM-DD HH:mm:ss"),"~",o.format("YY-MM-DD HH:mm:ss"),"loading..."),this.timerange=[n.toDate(),o.toDate()],!0)},getRecords:function(e){var n=this;if(e&&(this.loading=!0,this.records=[]),!this.nextTimeRange())return this.videos=this.records,void this.$nextTick(function(){n.loading=!1});t.ajax({method:"GET",url:"/api/v1/playback/recordlist",global:!1,data:{timeout:this.serverInfo.RecordListTimeout||5,serial:this.devid,code:this.channel,center:this.center,indistinct:this.indistinct,starttime:(0,l.default)(this.timerange[0]).format("YYYY-MM-DDTHH:mm:ss"),endtime:(0,l.default)(this.timerange[1]).format("YYYY-MM-DDTHH:mm:ss")}}).then(function(t){n.bShared=!!t.Shared;var e=t.RecordList||[];n.records=n.records.concat(e.filter(function(t){return!!(t&&t.StartTime&&t.EndTime)&&(!n.records.some(function(e){return e.StartTime===t.StartTime&&e.EndTime===t.EndTime})||(console.log("repeat",t.StartTime,"~",t.EndTime),!1))}))}).always(function(){n.$nextTick(function(){n.getRecords(!1)})})},stopPlayback:function(){var e=this;return new Promise(function(n,o){if(e.switchTimer&&(clearTimeout(e.switchTimer),e.switchTimer=0),e.bOutHevcTip=!1,e.bAudioEnable=!1,e.videoUrl="",e.osd="",e.touchTimer&&(clearInterval(e.touchTimer),e.touchTimer=0),!e.streamID)return void n();t.ajax({method:"POST",url:"/api/v1/playback/stop",global:!1,data:{streamid:e.streamID}}).always(function(){n()}),e.streamID=""})},beforeUnload:function(t){this.stopPlayback(),t.returnValue=""},startPlayback:function(){var e=this;return r(regeneratorRuntime.mark(function n(){return regeneratorRuntime.wrap(function(n){for(;;)switch(n.prev=n.next){case 0:if(e.video){n.next=2;break}return n.abrupt("return");case 2:return e.videoLoading=!0,n.next=5,e.stopPlayback();case 5:t.post("/api/v1/playback/start",{serial:e.devid,code:e.channel,starttime:e.video.StartTime,endtime:e.video.EndTime}).then(function(t){var n="HLS",o=t.HLS;switch(e.flvSupported()&&(o=e.isIE()?t.WS_FLV:t.FLV,n=e.isIE()?"WS_FLV":"FLV"),String(e.serverInfo.PreferStreamFmt).toUpperCase()){case"WEBRTC":e.rtcSupported()&&(o=t.WEBRTC,n="WEBRTC");break;case"FLV":e.flvSupported()&&!e.isIE()&&(o=t.FLV,n="FLV");break;case"WS_FLV":case"WS-FLV":e.flvSupported()&&(o=t.WS_FLV,n="WS_FLV");break;case"HLS":o=t.HLS,n="HLS";break;case"RTMP":o=t.RTMP,n="RTMP"}e.streamID=t.StreamID,e.bAudioEnable=!!t.AudioEnable,e.osd=t.ChannelOSD||"",e.protocol=n,e.videoUrl=o,e.speed>0&&1!=e.speed&&e.scaleOnStart()}).always(function(){e.videoLoading=!1});case 6:case"end":return n.stop()}},n,e)}))()},downloadPlayback:function(e,n){var o=this;return r(regeneratorRuntime.mark(function r(){return regeneratorRuntime.wrap(function(r){for(;;)switch(r.prev=r.next){case 0:return o.bDownloading=!0,r.next=3,o.stopPlayback();case 3:t.post("/api/v1/playback/start",{serial:o.devid,code:o.channel,starttime:e,endtime:n,download:!0,download_speed:o.speed}).then(function(t){o.streamID=t.StreamID,o.$refs.playbackDownloadDlg.download(e+" - "+n,t.StreamID)}).always(function(){o.bDownloading=!1});case 4:case"end":return r.stop()}},r,o)}))()},toListView:function(){this.$router.replace({path:"/devices/playback/list/"+this.devid+"/"+this.channel+"/"+this.day,query:Object.assign({},this.$route.query,{center:this.center,indistinct:this.indistinct})})},onTimeChange:function(t){this.video=t},onMediaInfo:function(t){this.mediaInfo=t},onEnded:function(t){this.mediaInfo=null},onVideoError:function(t){if("MediaError"==t&&(this.mediaInfo&&String(this.mediaInfo.videoCodec).startsWith("hvc")||"HLS"==this.protocol)&&flvjs.getFeatureList()&&!flvjs.getFeatureList().nativeMP4H265Playback&&(this.bOutHevcTip=!0,console.log("提示: 正在播放 H265 直出流, 确保浏览器版本较新, 并且开启硬件加速")),this.video){var e=(0,l.default)(this.video.EndTime,"YYYY-MM-DDTHH:mm:ss");if(e.format("YYYYMMDD")==this.day){console.log("switch error",t);for(var n=1;n<1440;n++){var o=e.clone().add(n,"minutes");if(!o.isSame(e,"day")){this.video=null;break}var r=o.diff(o.clone().startOf("day"),"minutes");if(this.$refs.timeRule.minuteActiveClass(r)){this.$refs.timeRule.clickMinute(r);break}}}}},onVideoTimeUpdate:function(t){var e=this;if(!(t<1)&&this.video){var n=(0,l.default)(this.video.StartTime,"YYYY-MM-DDTHH:mm:ss"),o=n.clone().add(t,"seconds"),r=o.diff(o.clone().startOf("day"),"minutes");this.$refs.timeRule.clickMinute(r,!1);var i=(0,l.default)(this.video.EndTime,"YYYY-MM-DDTHH:mm:ss"),a=i.diff(o,"seconds");a<3&&!this.switchTimer&&i.format("YYYYMMDD")==this.day&&(console.log("switch timer",a),this.switchTimer=setTimeout(function(){for(var t=1;t<1440;t++){var n=i.clone().add(t,"minutes");if(!n.isSame(i,"day")){e.video=null;break}var o=n.diff(n.clone().startOf("day"),"minutes");if(e.$refs.timeRule.minuteActiveClass(o)){e.$refs.timeRule.clickMinute(o);break}}},3e3))}},onSpeedClick:function(e){var n=parseFloat(t(e.target).data("speed"));n>0&&this.speed!=n&&(this.streamID?this.scale(n):this.speed=n)},scale:function(){var e=this,n=arguments.length>0&&void 0!==arguments[0]?arguments[0]:1;this.streamID&&t.post("/api/v1/playback/control",{streamid:this.streamID,command:"scale",scale:n}).then(function(t){e.speed=n,e.$message({type:"success",message:n+" 倍速设置成功"})})},scaleOnStart:function(){this.streamID&&t.ajax({method:"POST",url:"/api/v1/playback/control",global:!1,data:{streamid:this.streamID,command:"scale",scale:this.speed}})},showTimeRangeDlg:function(){var t=(0,l.default)().second(0).subtract(30,"minutes"),e=(0,l.default)().second(0);t.isSame(e,"day")||(t=e.clone().startOf("day"));var n=this.$refs.timeRangeDlg;n.setDefault([t.toDate(),e.toDate()]),n.show()},onTimeRangeChange:function(t){if(t&&2==t.length){var e=""+this.day+t[0],n=""+this.day+t[1],o=(0,l.default)(e,"YYYYMMDDHHmmss"),r=(0,l.default)(n,"YYYYMMDDHHmmss");if(o.isBefore(r)){for(var i=!1,a=o.clone();a.isSameOrBefore(r);a=a.add(1,"minute")){var s=a.unix();s-=s%(60*(this.serverInfo.MinutesPerUnit||5));var u=l.default.unix(s).format("HH:mm");if(this.$refs.timeRule.activeMinutes[u]){i=!0;break}}return i?(this.$refs.timeRangeDlg.hide(),void this.downloadPlayback(o.format("YYYY-MM-DDTHH:mm:ss"),r.format("YYYY-MM-DDTHH:mm:ss"))):void this.$message({type:"error",message:o.format("HH:mm:ss")+" - "+r.format("HH:mm:ss")+" 没有录像"})}}this.$message({type:"error",message:"无效的时间段"})},onDownload:function(){this.streamID=""}},mounted:function(){t(document).on("click","#input-speed-menu a",this.onSpeedClick)},beforeDestroy:function(){t(window).off("beforeunload",this.beforeUnload),t(document).off("click","#input-speed-menu a",this.onSpeedClick),this.stopPlayback()},beforeRouteLeave:function(t,e,n){this.stopPlayback(),n()},beforeRouteEnter:function(t,e,n){n(function(e){e.center=t.query.center,e.indistinct=t.query.indistinct,e.ready()})},beforeRouteUpdate:function(t,e,n){var o=this;if(!t.params.day)return void n({path:"/devices/playback/"+this.mode+"/"+t.params.devid+"/"+t.params.channel+"/"+(0,l.default)().format("YYYYMMDD"),query:Object.assign({},this.$route.query,{center:this.center,indistinct:this.indistinct}),replace:!0});this.center=t.query.center,this.indistinct=t.query.indistinct,this.stopPlayback(),n(),this.$nextTick(function(){o.loading||e.path==t.path||(console.log(o.devid,o.channel,o.day),o.getRecords(!0))})}}}).call(e,n("0iPh"))},tviW:function(t,e,n){"use strict";function o(t){return t&&t.__esModule?t:{default:t}}function r(t){return function(){var e=t.apply(this,arguments);return new Promise(function(t,n){function o(r,i){try{var a=e[r](i),s=a.value}catch(t){return void n(t)}if(!a.done)return Promise.resolve(s).then(function(t){o("next",t)},function(t){o("throw",t)});t(s)}return o("next")})}}Object.defineProperty(e,"__esModule",{value:!0});var i=n("A9K1"),a=o(i),s=n("0iPh"),l=o(s);e.default={props:{useSeparateDevicePassword:{type:Boolean,default:!1}},data:function(){return{form:this.defForm(),smsList:[],smsGroupList:[],dropChannelTypes:[],innerChannelTypes:{134:"报警输入",135:"报警输出",136:"语音输入",137:"语音输出",200:"中心信令",215:"业务分组",216:"虚拟组织"},innerStreamModes:{"":"自动选择","stream:0":"主码流","stream:1":"子码流","streamnumber:0":"主码流(2022)","streamnumber:1":"子码流(2022)","streamprofile:0":"主码流","streamprofile:1":"子码流","streamMode:MAIN":"主码流","streamMode:SUB":"子码流"},debug:!1,showSubscribeInterval:!1,showPosition:!1}},components:{FormDlg:a.default},beforeDestroy:function(){(0,l.default)(this.$el).off("keydown",this.keyDown)},computed:{alertMsg:function(){var t=[];this.form.stream_mode&&t.push("码流索引, 需要下级支持");var e="";return t.length>0&&(e=t.join(", ")),e}},methods:{defForm:function(){return{serial:"",name:"",custom_name:"",media_transport:"UDP",media_transport_mode:"passive",stream_mode:"",sms_id:"",sms_group_id:"",recv_stream_ip:"",contact_ip:"",charset:"",catalog_interval:3600,subscribe_interval:0,catalog_subscribe:!1,alarm_subscribe:!1,position_subscribe:!1,ptz_subscribe:!1,record_center:!1,record_indistinct:!1,civil_code_first:!1,keep_original_tree:!1,password:"",drop_channel_type:"",longitude:0,latitude:0}},onHide:function(){this.debug=!1,this.showSubscribeInterval=!1,this.showPosition=!1,this.form=this.defForm(),this.dropChannelTypes=[],this.$emit("hide"),(0,l.default)(this.$el).off("keydown",this.keyDown)},onShow:function(){this.errors.clear(),this.$emit("show"),(0,l.default)(this.$el).on("keydown",this.keyDown)},onPasteLngLat:function(t){try{var e=t.clipboardData.getData("Text").trim().split(/\s*,\s*/);2==e.length&&(this.form.longitude=e[0],this.form.latitude=e[1],t.preventDefault())}catch(t){console.log(t)}},keyDown:function(t){if(t.altKey&&t.shiftKey)switch(t.key){case"D":t.preventDefault(),this.toggleDebug()}},toggleDebug:function(){this.debug=!this.debug},onMediaTransportChange:function(t){"UDP"==t&&(this.form.media_transport_mode="passive")},fetchSMSList:function(){return new Promise(function(t,e){l.default.get("/api/v1/sms/list").then(function(e){t(e.map(function(t){return{id:t.Serial||"",name:t.Serial||"",group:t.GroupID||""}}))}).fail(function(){t([])})})},onSubmit:function(){var t=this;return r(regeneratorRuntime.mark(function e(){var n,o,r;return regeneratorRuntime.wrap(function(e){for(;;)switch(e.prev=e.next){case 0:return e.next=2,t.$validator.validateAll();case 2:if(n=e.sent){e.next=8;break}return o=t.errors.items[0],t.$message({type:"error",message:o.msg}),(0,l.default)("[name="+o.field+"]").focus(),e.abrupt("return");case 8:r=Object.assign({},t.form),r.drop_channel_type=t.dropChannelTypes.join(","),delete r.name,r.longitude||(r.longitude=0),r.latitude||(r.latitude=0),l.default.post("/api/v1/device/setinfo",r).then(function(e){t.$refs.dlg.hide(),t.$emit("submit")});case 14:case"end":return e.stop()}},e,t)}))()},show:function(t){var e=this;return r(regeneratorRuntime.mark(function n(){var o,r,i,a,s,l,u,c,d,f;return regeneratorRuntime.wrap(function(n){for(;;)switch(n.prev=n.next){case 0:return e.errors.clear(),t&&Object.assign(e.form,t),e.form.drop_channel_type&&(e.dropChannelTypes=e.form.drop_channel_type.split(",")),e.form.subscribe_interval<0&&(e.showSubscribeInterval=!0),(e.form.longitude||e.form.latitude)&&(e.showPosition=!0),o=[],n.next=8,e.fetchSMSList();case 8:for(r=n.sent,i=!0,a=!1,s=void 0,n.prev=12,l=r[Symbol.iterator]();!(i=(u=l.next()).done);i=!0)c=u.value,c.group&&!o.some(function(t){return t.id==c.group})&&o.push({id:c.group,name:c.group});n.next=20;break;case 16:n.prev=16,n.t0=n.catch(12),a=!0,s=n.t0;case 20:n.prev=20,n.prev=21,!i&&l.return&&l.return();case 23:if(n.prev=23,!a){n.next=26;break}throw s;case 26:return n.finish(23);case 27:return n.finish(20);case 28:d=e.form.sms_id,d&&!r.some(function(t){return t.id==d})&&r.push({id:d,name:d+"(not found)"}),f=e.form.sms_group_id,f&&!o.some(function(t){return t.id==f})&&o.push({id:f,name:f+"(not found)"}),e.smsList=r,e.smsGroupList=o,e.form.subscribe_interval||(e.form.subscribe_interval=""),e.form.longitude||(e.form.longitude=""),e.form.latitude||(e.form.latitude=""),e.$nextTick(function(){e.$refs.dlg.show()});case 38:case"end":return n.stop()}},n,e,[[12,16,20,28],[21,,23,27]])}))()}}}},"u/lO":function(t,e,n){"use strict";var o=function(){var t=this,e=t.$createElement;return(t._self._c||e)("input",{attrs:{type:"text",placeholder:t.placeholder}})},r=[],i={render:o,staticRenderFns:r};e.a=i},wPWL:function(t,e,n){"use strict";var o=function(){var t=this,e=t.$createElement,n=t._self._c||e;return n("FormDlg",{ref:"dlg",attrs:{title:"编辑设备",disabled:t.errors.any(),size:"modal-lgg"},on:{hide:t.onHide,show:t.onShow,submit:t.onSubmit}},[n("div",{staticClass:"col-md-6"},[n("div",{class:{"form-group":!0,"has-error":t.errors.has("serial")}},[n("label",{staticClass:"col-sm-4 control-label",attrs:{for:"input-serial"}},[t._v("设备编号\n                "),n("span",{staticClass:"text-red"},[t._v("*")])]),t._v(" "),n("div",{staticClass:"col-sm-7"},[n("input",{directives:[{name:"model",rawName:"v-model.trim",value:t.form.serial,expression:"form.serial",modifiers:{trim:!0}},{name:"validate",rawName:"v-validate",value:"required",expression:"'required'"}],staticClass:"form-control",attrs:{type:"text",readonly:"readonly",id:"input-serial",name:"serial","data-vv-as":"设备编号"},domProps:{value:t.form.serial},on:{input:function(e){e.target.composing||t.$set(t.form,"serial",e.target.value.trim())},blur:function(e){t.$forceUpdate()}}})])]),t._v(" "),n("div",{class:{"form-group":!0,"has-error":t.errors.has("name")}},[n("label",{staticClass:"col-sm-4 control-label",attrs:{for:"input-name"}},[t._v("自定义名称\n            ")]),t._v(" "),n("div",{staticClass:"col-sm-7"},[n("div",{staticClass:"input-group",staticStyle:{width:"100%"}},[n("input",{directives:[{name:"model",rawName:"v-model.trim",value:t.form.custom_name,expression:"form.custom_name",modifiers:{trim:!0}}],staticClass:"form-control",attrs:{type:"text",id:"input-name",name:"name",placeholder:t.form.name||"-","data-vv-as":"自定义名称"},domProps:{value:t.form.custom_name},on:{keydown:function(e){if(!("button"in e)&&t._k(e.keyCode,"enter",13,e.key))return null;t.$el.querySelector("#input-password").focus()},input:function(e){e.target.composing||t.$set(t.form,"custom_name",e.target.value.trim())},blur:function(e){t.$forceUpdate()}}}),t._v(" "),n("span",{directives:[{name:"show",rawName:"v-show",value:t.form.custom_name||t.form.name,expression:"form.custom_name || form.name"}],staticClass:"input-group-btn"},[n("button",{directives:[{name:"clipboard",rawName:"v-clipboard",value:t.form.custom_name||t.form.name,expression:"form.custom_name || form.name"}],staticClass:"btn btn-default",staticStyle:{"border-top-right-radius":"0","border-bottom-right-radius":"0"},attrs:{type:"button",title:t.form.custom_name?"拷贝自定义名称":"拷贝原名称"},on:{success:function(e){t.$message({type:"success",message:"成功拷贝到粘贴板"})}}},[n("i",{staticClass:"fa fa-copy"})])])])])]),t._v(" "),n("div",{directives:[{name:"show",rawName:"v-show",value:t.useSeparateDevicePassword,expression:"useSeparateDevicePassword"}],class:{"form-group":!0,"has-error":t.errors.has("password")}},[n("label",{staticClass:"col-sm-4 control-label",attrs:{for:"input-password"}},[t._v("接入密码\n            ")]),t._v(" "),n("div",{staticClass:"col-sm-7"},[n("input",{directives:[{name:"model",rawName:"v-model.trim",value:t.form.password,expression:"form.password",modifiers:{trim:!0}}],staticClass:"form-control",attrs:{type:"text",id:"input-password",name:"password",placeholder:"默认使用 livecms.ini > sip > device_password","data-vv-as":"接入密码"},domProps:{value:t.form.password},on:{keydown:function(e){if(!("button"in e)&&t._k(e.keyCode,"enter",13,e.key))return null;t.$el.querySelector("#input-contact-ip").focus()},input:function(e){e.target.composing||t.$set(t.form,"password",e.target.value.trim())},blur:function(e){t.$forceUpdate()}}})])]),t._v(" "),n("div",{class:{"form-group":!0,"has-error":t.errors.has("contact_ip")}},[n("label",{staticClass:"col-sm-4 control-label",attrs:{for:"input-contact-ip"}},[t._v("信令联络 IP\n            ")]),t._v(" "),n("div",{staticClass:"col-sm-7"},[n("input",{directives:[{name:"model",rawName:"v-model.trim",value:t.form.contact_ip,expression:"form.contact_ip",modifiers:{trim:!0}}],staticClass:"form-control",attrs:{type:"text",id:"input-contact-ip",name:"contact_ip",placeholder:"默认使用 livecms.ini > sip > host","data-vv-as":"信令联络 IP"},domProps:{value:t.form.contact_ip},on:{keydown:function(e){if(!("button"in e)&&t._k(e.keyCode,"enter",13,e.key))return null;t.$el.querySelector("#input-recv-stream-ip").focus()},input:function(e){e.target.composing||t.$set(t.form,"contact_ip",e.target.value.trim())},blur:function(e){t.$forceUpdate()}}})])]),t._v(" "),n("div",{class:{"form-group":!0,"has-error":t.errors.has("sms_id")}},[n("label",{staticClass:"col-sm-4 control-label",attrs:{for:"input-sms-id"}},[t._v("SMS ID\n            ")]),t._v(" "),n("div",{staticClass:"col-sm-7"},[n("select",{directives:[{name:"model",rawName:"v-model.trim",value:t.form.sms_id,expression:"form.sms_id",modifiers:{trim:!0}},{name:"validate",rawName:"v-validate"}],staticClass:"form-control",attrs:{id:"input-sms-id",name:"sms_id","data-vv-as":"SMS"},on:{change:function(e){var n=Array.prototype.filter.call(e.target.options,function(t){return t.selected}).map(function(t){return"_value"in t?t._value:t.value});t.$set(t.form,"sms_id",e.target.multiple?n:n[0])}}},[n("option",{attrs:{value:""}},[t._v("自动选择负载最小")]),t._v(" "),t._l(t.smsList,function(e,o){return n("option",{key:o,domProps:{value:e.id}},[t._v(t._s(e.name))])})],2)])]),t._v(" "),n("div",{class:{"form-group":!0,"has-error":t.errors.has("sms_group_id")}},[n("label",{staticClass:"col-sm-4 control-label",attrs:{for:"input-sms-group-id"}},[t._v("SMS 分组\n            ")]),t._v(" "),n("div",{staticClass:"col-sm-7"},[n("select",{directives:[{name:"model",rawName:"v-model.trim",value:t.form.sms_group_id,expression:"form.sms_group_id",modifiers:{trim:!0}},{name:"validate",rawName:"v-validate"}],staticClass:"form-control",attrs:{id:"input-sms-group-id",name:"sms_group_id","data-vv-as":"SMSGroup"},on:{change:function(e){var n=Array.prototype.filter.call(e.target.options,function(t){return t.selected}).map(function(t){return"_value"in t?t._value:t.value});t.$set(t.form,"sms_group_id",e.target.multiple?n:n[0])}}},[n("option",{attrs:{value:""}},[t._v("自动选择负载最小")]),t._v(" "),t._l(t.smsGroupList,function(e,o){return n("option",{key:o,domProps:{value:e.id}},[t._v(t._s(e.name))])})],2)])]),t._v(" "),n("div",{class:{"form-group":!0,"has-error":t.errors.has("recv_stream_ip")}},[n("label",{staticClass:"col-sm-4 control-label",attrs:{for:"input-recv-stream-ip"}},[t._v("收流 IP\n            ")]),t._v(" "),n("div",{staticClass:"col-sm-7"},[n("input",{directives:[{name:"model",rawName:"v-model.trim",value:t.form.recv_stream_ip,expression:"form.recv_stream_ip",modifiers:{trim:!0}}],staticClass:"form-control",attrs:{type:"text",id:"input-recv-stream-ip",name:"recv_stream_ip",placeholder:"默认使用 livesms.ini > sip > host","data-vv-as":"收流 IP"},domProps:{value:t.form.recv_stream_ip},on:{keydown:function(e){if(!("button"in e)&&t._k(e.keyCode,"enter",13,e.key))return null;t.$el.querySelector("#input-catalog-interval").focus()},input:function(e){e.target.composing||t.$set(t.form,"recv_stream_ip",e.target.value.trim())},blur:function(e){t.$forceUpdate()}}})])]),t._v(" "),n("div",{class:{"form-group":!0,"has-error":t.errors.has("drop_channel_type")}},[n("label",{staticClass:"col-sm-4 control-label",attrs:{for:"input-drop-channel-type"}},[t._v("过滤通道类型")]),t._v(" "),n("div",{staticClass:"col-sm-7"},[n("el-select",{staticStyle:{width:"100%"},attrs:{id:"input-drop-channel-type",size:"medium",multiple:"",filterable:"","allow-create":"","default-first-option":"",placeholder:"选填, 需要丢弃的通道类型/编号"},model:{value:t.dropChannelTypes,callback:function(e){t.dropChannelTypes="string"==typeof e?e.trim():e},expression:"dropChannelTypes"}},t._l(t.innerChannelTypes,function(t,e){return n("el-option",{key:e,attrs:{label:e+" - "+t,value:e}})}))],1)]),t._v(" "),n("div",{directives:[{name:"show",rawName:"v-show",value:t.debug||t.showPosition,expression:"debug || showPosition"}],class:{"form-group":!0,"has-error":t.errors.has("longitude")}},[n("label",{staticClass:"col-sm-4 control-label",attrs:{for:"input-position-longitude"}},[t._v("经度\n            ")]),t._v(" "),n("div",{staticClass:"col-sm-7"},[n("input",{directives:[{name:"validate",rawName:"v-validate",value:"decimal",expression:"'decimal'"},{name:"model",rawName:"v-model.trim",value:t.form.longitude,expression:"form.longitude",modifiers:{trim:!0}}],staticClass:"form-control",attrs:{type:"text",id:"input-position-longitude",name:"longitude","data-vv-as":"经度"},domProps:{value:t.form.longitude},on:{paste:t.onPasteLngLat,input:function(e){e.target.composing||t.$set(t.form,"longitude",e.target.value.trim())},blur:function(e){t.$forceUpdate()}}})])]),t._v(" "),n("div",{directives:[{name:"show",rawName:"v-show",value:t.debug||t.showPosition,expression:"debug || showPosition"}],class:{"form-group":!0,"has-error":t.errors.has("latitude")}},[n("label",{staticClass:"col-sm-4 control-label",attrs:{for:"input-position-latitude"}},[t._v("纬度\n            ")]),t._v(" "),n("div",{staticClass:"col-sm-7"},[n("input",{directives:[{name:"validate",rawName:"v-validate",value:"decimal",expression:"'decimal'"},{name:"model",rawName:"v-model.trim",value:t.form.latitude,expression:"form.latitude",modifiers:{trim:!0}}],staticClass:"form-control",attrs:{type:"text",id:"input-position-latitude",name:"latitude","data-vv-as":"纬度"},domProps:{value:t.form.latitude},on:{paste:t.onPasteLngLat,input:function(e){e.target.composing||t.$set(t.form,"latitude",e.target.value.trim())},blur:function(e){t.$forceUpdate()}}})])])]),t._v(" "),n("div",{staticClass:"col-md-6"},[n("div",{class:{"form-group":!0,"has-error":t.errors.has("catalog_interval")}},[n("label",{staticClass:"col-sm-4 control-label",attrs:{for:"input-catalog-interval"}},[t._v("通道更新周期(秒)\n            ")]),t._v(" "),n("div",{staticClass:"col-sm-7"},[n("input",{directives:[{name:"model",rawName:"v-model.trim",value:t.form.catalog_interval,expression:"form.catalog_interval",modifiers:{trim:!0}},{name:"validate",rawName:"v-validate",value:"numeric",expression:"'numeric'"}],staticClass:"form-control",attrs:{type:"text",id:"input-catalog-interval",name:"catalog_interval",placeholder:"3600"},domProps:{value:t.form.catalog_interval},on:{keydown:function(e){if(!("button"in e)&&t._k(e.keyCode,"enter",13,e.key))return null;t.$el.querySelector("#input-subscribe-interval").focus()},input:function(e){e.target.composing||t.$set(t.form,"catalog_interval",e.target.value.trim())},blur:function(e){t.$forceUpdate()}}})])]),t._v(" "),n("div",{directives:[{name:"show",rawName:"v-show",value:t.debug||t.showSubscribeInterval,expression:"debug || showSubscribeInterval"}],class:{"form-group":!0,"has-error":t.errors.has("subscribe_interval")}},[n("label",{staticClass:"col-sm-4 control-label",attrs:{for:"input-subscribe-interval"}},[t._v("订阅周期(秒)\n            ")]),t._v(" "),n("div",{staticClass:"col-sm-7"},[n("input",{directives:[{name:"model",rawName:"v-model.trim",value:t.form.subscribe_interval,expression:"form.subscribe_interval",modifiers:{trim:!0}},{name:"validate",rawName:"v-validate",value:"integer",expression:"'integer'"}],staticClass:"form-control",attrs:{type:"text",id:"input-subscribe-interval",name:"subscribe_interval",placeholder:"默认使用全局订阅配置, 负数不订阅"},domProps:{value:t.form.subscribe_interval},on:{keydown:function(e){if(!("button"in e)&&t._k(e.keyCode,"enter",13,e.key))return null;t.onSubmit(e)},input:function(e){e.target.composing||t.$set(t.form,"subscribe_interval",e.target.value.trim())},blur:function(e){t.$forceUpdate()}}})])]),t._v(" "),n("div",{class:{"form-group":!0,"has-error":t.errors.has("charset")}},[n("label",{staticClass:"col-sm-4 control-label",attrs:{for:"input-charset"}},[t._v("字符集\n            ")]),t._v(" "),n("div",{staticClass:"col-sm-7"},[n("select",{directives:[{name:"model",rawName:"v-model.trim",value:t.form.charset,expression:"form.charset",modifiers:{trim:!0}},{name:"validate",rawName:"v-validate"}],staticClass:"form-control",attrs:{id:"input-charset",name:"charset","data-vv-as":"字符集"},on:{change:function(e){var n=Array.prototype.filter.call(e.target.options,function(t){return t.selected}).map(function(t){return"_value"in t?t._value:t.value});t.$set(t.form,"charset",e.target.multiple?n:n[0])}}},[n("option",{attrs:{value:""}},[t._v("自动识别")]),t._v(" "),n("option",{attrs:{value:"GB2312"}},[t._v("GB2312")]),t._v(" "),n("option",{attrs:{value:"GB18030"}},[t._v("GB18030")]),t._v(" "),n("option",{attrs:{value:"UTF-8"}},[t._v("UTF-8")])])])]),t._v(" "),n("div",{class:{"form-group":!0,"has-error":t.errors.has("stream_mode")}},[n("label",{staticClass:"col-sm-4 control-label",attrs:{for:"input-stream-mode"}},[t._v("码流索引\n            ")]),t._v(" "),n("div",{staticClass:"col-sm-7"},[n("el-select",{staticStyle:{width:"100%"},attrs:{id:"input-stream-mode",size:"medium",clearable:"",filterable:"","allow-create":"","default-first-option":"",placeholder:"自动选择"},model:{value:t.form.stream_mode,callback:function(e){t.$set(t.form,"stream_mode","string"==typeof e?e.trim():e)},expression:"form.stream_mode"}},t._l(t.innerStreamModes,function(t,e){return n("el-option",{key:e,attrs:{label:e?e+" - "+t:""+t,value:e}})}))],1)]),t._v(" "),n("div",{class:{"form-group":!0,"has-error":t.errors.has("media_transport")}},[n("label",{staticClass:"col-sm-4 control-label",attrs:{for:"input-media-transport"}},[t._v("流传输模式\n            ")]),t._v(" "),n("div",{staticClass:"col-sm-7"},[n("el-radio-group",{attrs:{size:"mini"},on:{change:t.onMediaTransportChange},model:{value:t.form.media_transport,callback:function(e){t.$set(t.form,"media_transport","string"==typeof e?e.trim():e)},expression:"form.media_transport"}},[n("el-radio-button",{attrs:{label:"TCP"}}),t._v(" "),n("el-radio-button",{attrs:{label:"UDP"}})],1),t._v("\n                  \n                "),n("el-radio-group",{directives:[{name:"show",rawName:"v-show",value:"TCP"==t.form.media_transport,expression:"form.media_transport == 'TCP'"}],attrs:{size:"mini"},model:{value:t.form.media_transport_mode,callback:function(e){t.$set(t.form,"media_transport_mode","string"==typeof e?e.trim():e)},expression:"form.media_transport_mode"}},[n("el-radio-button",{attrs:{label:"passive"}},[t._v("被动")]),t._v(" "),n("el-radio-button",{attrs:{label:"active"}},[t._v("主动")])],1)],1)]),t._v(" "),n("div",{class:{"form-group":!0,"has-error":t.errors.has("subscribe_items")}},[n("label",{staticClass:"col-sm-4 control-label",attrs:{for:"input-subscribe-items"}},[t._v("订阅项目\n            ")]),t._v(" "),n("div",{staticClass:"col-sm-7 checkbox"},[n("el-checkbox",{staticStyle:{"margin-left":"-19px","margin-top":"-5px"},attrs:{size:"small",name:"catalog_subscribe",disabled:t.form.subscribe_interval<0},model:{value:t.form.catalog_subscribe,callback:function(e){t.$set(t.form,"catalog_subscribe","string"==typeof e?e.trim():e)},expression:"form.catalog_subscribe"}},[t._v("\n                    目录  \n                ")]),t._v(" "),n("el-checkbox",{staticStyle:{"margin-left":"-19px","margin-top":"-5px"},attrs:{size:"small",name:"alarm_subscribe",disabled:t.form.subscribe_interval<0},model:{value:t.form.alarm_subscribe,callback:function(e){t.$set(t.form,"alarm_subscribe","string"==typeof e?e.trim():e)},expression:"form.alarm_subscribe"}},[t._v("\n                    报警  \n                ")]),t._v(" "),n("el-checkbox",{staticStyle:{"margin-left":"-19px","margin-top":"-5px"},attrs:{size:"small",name:"position_subscribe",disabled:t.form.subscribe_interval<0},model:{value:t.form.position_subscribe,callback:function(e){t.$set(t.form,"position_subscribe","string"==typeof e?e.trim():e)},expression:"form.position_subscribe"}},[t._v("\n                    位置  \n                ")]),t._v(" "),n("el-checkbox",{staticStyle:{"margin-left":"-19px","margin-top":"-5px"},attrs:{size:"small",name:"ptz_subscribe",disabled:t.form.subscribe_interval<0},model:{value:t.form.ptz_subscribe,callback:function(e){t.$set(t.form,"ptz_subscribe","string"==typeof e?e.trim():e)},expression:"form.ptz_subscribe"}},[t._v("\n                    PTZ(2022)\n                ")])],1)]),t._v(" "),n("div",{class:{"form-group":!0,"has-error":t.errors.has("record")}},[n("label",{staticClass:"col-sm-4 control-label",attrs:{for:"input-record"}},[t._v("设备录像\n            ")]),t._v(" "),n("div",{staticClass:"col-sm-7 checkbox"},[n("el-checkbox",{staticStyle:{"margin-left":"-19px","margin-top":"-5px"},attrs:{size:"small",name:"record_center"},model:{value:t.form.record_center,callback:function(e){t.$set(t.form,"record_center","string"==typeof e?e.trim():e)},expression:"form.record_center"}},[t._v("\n                    中心检索  \n                ")]),t._v(" "),n("el-checkbox",{staticStyle:{"margin-left":"-19px","margin-top":"-5px"},attrs:{size:"small",name:"record_indistinct"},model:{value:t.form.record_indistinct,callback:function(e){t.$set(t.form,"record_indistinct","string"==typeof e?e.trim():e)},expression:"form.record_indistinct"}},[t._v("\n                    模糊查询\n                ")])],1)]),t._v(" "),n("div",{class:{"form-group":!0,"has-error":t.errors.has("catalog")}},[n("label",{staticClass:"col-sm-4 control-label",attrs:{for:"input-catalog"}},[t._v("目录结构\n            ")]),t._v(" "),n("div",{staticClass:"col-sm-7 checkbox"},[n("el-checkbox",{staticStyle:{"margin-left":"-19px","margin-top":"-5px"},attrs:{size:"small",name:"civil_code_first"},model:{value:t.form.civil_code_first,callback:function(e){t.$set(t.form,"civil_code_first","string"==typeof e?e.trim():e)},expression:"form.civil_code_first"}},[t._v("\n                    行政区域优先  \n                ")]),t._v(" "),n("el-checkbox",{directives:[{name:"show",rawName:"v-show",value:t.debug,expression:"debug"}],staticStyle:{"margin-left":"-19px","margin-top":"-5px"},attrs:{size:"small",name:"keep_original_tree"},model:{value:t.form.keep_original_tree,callback:function(e){t.$set(t.form,"keep_original_tree","string"==typeof e?e.trim():e)},expression:"form.keep_original_tree"}},[t._v("\n                    保持原样\n                ")])],1)])]),t._v(" "),n("div",{staticClass:"clearfix"}),t._v(" "),n("template",{attrs:{slot:"footer"},slot:"footer"},[t.alertMsg?n("div",{staticClass:"inline text-orange"},[n("small",[n("strong",[n("i",{staticClass:"fa fa-info-circle"}),t._v(" 提示 :   ")]),t._v("\n                "+t._s(t.alertMsg)+"\n                  \n            ")])]):t._e()])],2)},r=[],i={render:o,staticRenderFns:r};e.a=i},wZh5:function(t,e,n){"use strict";function o(t){n("KBJn")}Object.defineProperty(e,"__esModule",{value:!0});var r=n("xi4u"),i=n.n(r);for(var a in r)["default","default"].indexOf(a)<0&&function(t){n.d(e,t,function(){return r[t]})}(a);var s=n("5w0Z"),l=n("VU/8"),u=o,c=l(i.a,s.a,!1,u,"data-v-6aa38924",null);e.default=c.exports},x5s2:function(t,e,n){"use strict";var o=function(){var t=this,e=t.$createElement,n=t._self._c||e;return n("li",{staticClass:"el-timeline-item"},[n("div",{staticClass:"el-timeline-item__tail"}),t._v(" "),t.$slots.dot?t._e():n("div",{staticClass:"el-timeline-item__node",class:["el-timeline-item__node--"+(t.size||""),"el-timeline-item__node--"+(t.type||"")],style:{backgroundColor:t.color}},[t.icon?n("i",{staticClass:"el-timeline-item__icon",class:t.icon}):t._e()]),t._v(" "),t.$slots.dot?n("div",{staticClass:"el-timeline-item__dot"},[t._t("dot")],2):t._e(),t._v(" "),n("div",{staticClass:"el-timeline-item__wrapper"},[t.hideTimestamp||"top"!==t.placement?t._e():n("div",{staticClass:"el-timeline-item__timestamp is-top"},[t._v("\n\t\t\t"+t._s(t.timestamp)+"\n\t\t")]),t._v(" "),n("div",{staticClass:"el-timeline-item__content"},[t._t("default")],2),t._v(" "),t.hideTimestamp||"bottom"!==t.placement?t._e():n("div",{staticClass:"el-timeline-item__timestamp is-bottom"},[t._v("\n\t\t\t"+t._s(t.timestamp)+"\n\t\t")])])])},r=[],i={render:o,staticRenderFns:r};e.a=i},xi4u:function(t,e,n){"use strict";function o(t){return t&&t.__esModule?t:{default:t}}Object.defineProperty(e,"__esModule",{value:!0}),n("F8S1");var r=n("0iPh"),i=o(r),a=n("M4fF"),s=o(a);e.default={props:{title:{default:""},size:{type:String,default:"modal-lgg"},fade:{type:Boolean,default:!1},serverInfo:{type:Object,default:function(){}},userInfo:{type:Object,default:function(){return null}}},data:function(){return{q:"",channel_type:"",online:"",total:0,relateCnt:0,pageSize:10,currentPage:1,sort:"",order:"",related:!1,reverse:!1,loading:!1,channels:[],selection:[],bak:{},pcode:""}},watch:{q:function(t,e){this.doDelaySearch()},channel_type:function(t,e){this.doSearch()},online:function(t,e){this.doSearch()},related:function(t,e){this.doSearch()},reverse:function(t,e){this.doSearch()},currentPage:function(t,e){this.doSearch(t)},pageSize:function(t,e){this.doSearch()}},mounted:function(){var t=this;(0,i.default)(this.$el).find(".modal-content").draggable({handle:".modal-header",cancel:".modal-title span",addClasses:!1,containment:"document",delay:100,opacity:.5}),(0,i.default)(this.$el).on("shown.bs.modal",function(){t.$emit("show")}).on("hidden.bs.modal",function(){t.errors.clear(),t.reset(),t.$emit("hide")})},directives:{focus:{inserted:function(t){t.focus(),t.select()}}},methods:{sortChange:function(t){this.sort=t.prop,this.order="ascending"==t.order?"asc":"desc",this.getChannels()},select:function(t,e){var n=this;if(t.indexOf(e)>=0)i.default.post("/api/v1/channel/setcustomparent",{customs:[e.DeviceID+":"+e.ID+":"+this.pcode]}).then(function(){n.bak[e.DeviceID+":"+e.ID]=e.CustomParentID||""}).always(function(){n.getChannels()});else{var o=this.bak[e.DeviceID+":"+e.ID]||"";i.default.post("/api/v1/channel/setcustomparent",{customs:[e.DeviceID+":"+e.ID+":"+o]}).always(function(){n.getChannels()})}},selectAll:function(t){var e=this,n=[],o={};if(t.length){var r=!0,a=!1,s=void 0;try{for(var l,u=t[Symbol.iterator]();!(r=(l=u.next()).done);r=!0){var c=l.value;this.selection.indexOf(c)<0&&(n.push(c.DeviceID+":"+c.ID+":"+this.pcode),o[c.DeviceID+":"+c.ID]=c.CustomParentID||"")}}catch(t){a=!0,s=t}finally{try{!r&&u.return&&u.return()}finally{if(a)throw s}}i.default.post("/api/v1/channel/setcustomparent",{customs:n}).then(function(){e.bak=Object.assign(e.bak,o)}).always(function(){e.getChannels()})}else{var d=!0,f=!1,v=void 0;try{for(var p,h=this.selection[Symbol.iterator]();!(d=(p=h.next()).done);d=!0){var c=p.value,m=this.bak[c.DeviceID+":"+c.ID]||"";n.push(c.DeviceID+":"+c.ID+":"+m)}}catch(t){f=!0,v=t}finally{try{!d&&h.return&&h.return()}finally{if(f)throw v}}i.default.post("/api/v1/channel/setcustomparent",{customs:n}).always(function(){e.getChannels()})}},doSearch:function(){var t=arguments.length>0&&void 0!==arguments[0]?arguments[0]:1;this.currentPage=t,this.getChannels()},doDelaySearch:s.default.debounce(function(){this.doSearch()},800),formatName:function(t,e,n){var o=t.DeviceCustomName||t.DeviceName||"",r=t.CustomName||t.Name||"";return o&&o!=r&&(r=r?r+"@"+o:o),r||"-"},formatDeviceName:function(t,e,n){return t.DeviceCustomName||t.DeviceName||"-"},formatChannelName:function(t,e,n){return t.CustomName||t.Name||"-"},formatManufacturer:function(t,e,n){return n||"-"},selectable:function(t,e){return!0},getChannels:function(){var t=this;this.pcode&&(this.loading=!0,i.default.get("/api/v1/channel/customlist",{pcode:this.pcode,q:this.q,start:(this.currentPage-1)*this.pageSize,limit:this.pageSize,channel_type:this.channel_type,online:"device"==this.channel_type?this.online:"",related:this.related,reverse:this.reverse,sort:this.sort,order:this.order}).then(function(e){t.$refs.channelTable.clearSelection(),t.total=e.ChannelCount,t.relateCnt=e.ChannelRelateCount,t.channels=e.ChannelList||[],t.selection=[],t.$nextTick(function(){t.channels.forEach(function(e){var n=e.CustomParentID==t.pcode;t.$refs.channelTable.toggleRowSelection(e,n),n&&t.selection.push(e)})})}).always(function(){t.$nextTick(function(){t.loading=!1})}))},isDir:function(t){if(t){if(t.SubCount>0)return!0;if(this.serverInfo.StrictChannelParental&&1==t.Parental)return!0;if(this.serverInfo.StrictChannelCode&&t.ID.length<=10)return!0;if(1==t.Parental&&"LiveQing"==t.Manufacturer)return!0;if(20==t.ID.length&&("216"==t.ID.substring(10,13)||"215"==t.ID.substring(10,13)))return!0}return!1},reset:function(){this.pcode="",this.$refs.channelTable.clearSelection(),this.channels=[],this.selection=[],this.bak={},this.q="",this.channel_type="",this.online="",this.related=!1,this.reverse=!1,this.total=0,this.relateCnt=0,this.currentPage=1,this.pageSize=10},setChannelID:function(t,e,n){var o=t.Editing;if(this.$set(t,"Editing",e),o&&!e){var r=n.target.value.trim();r==t.ID&&(r=""),t.CustomID!=r&&i.default.post("/api/v1/device/setchannelid",{serial:t.DeviceID,code:t.ID,id:r}).then(function(e){t.CustomID=r})}},show:function(t){this.pcode=t,(0,i.default)(this.$el).modal("show"),this.getChannels()},hide:function(){(0,i.default)(this.$el).modal("hide")},toggleReverse:function(){this.reverse=!this.reverse}}}},znKA:function(t,e,n){"use strict";var o=function(){var t=this,e=t.$createElement,n=t._self._c||e;return n("div",{ref:"dlg",staticClass:"modal",attrs:{"data-backdrop":"static","data-disable":"false","data-keyboard":"true",tabindex:"-1"}},[n("div",{class:["modal-dialog",t.size]},[n("div",{staticClass:"modal-content"},[n("div",{staticClass:"modal-header"},[t._m(0),t._v(" "),n("h4",{staticClass:"modal-title text-center text-primary"},[n("span",[t._v(t._s(t.title))])])]),t._v(" "),n("div",{staticClass:"modal-body"},[n("div",{staticClass:"text-center"},[t.visiable?n("el-time-picker",{attrs:{"is-range":"","arrow-control":t.arrow,"value-format":t.format,"default-value":t.vdefault,"start-placeholder":"开始时间","end-placeholder":"结束时间"},model:{value:t.value,callback:function(e){t.value=e},expression:"value"}}):t._e()],1)]),t._v(" "),n("div",{staticClass:"modal-footer"},[t._t("footer"),t._v(" "),n("button",{staticClass:"btn btn-default",attrs:{type:"button","data-dismiss":"modal"}},[t._v("取消")]),t._v(" "),n("button",{staticClass:"btn btn-primary",attrs:{type:"button",disabled:!t.value},on:{click:function(e){e.preventDefault(),t.doSubmit(e)}}},[t._v("确定")])],2)])])])},r=[function(){var t=this,e=t.$createElement,n=t._self._c||e;return n("button",{staticClass:"close",attrs:{type:"button","data-dismiss":"modal","aria-label":"Close"}},[n("span",{attrs:{"aria-hidden":"true"}},[t._v("×")])])}],i={render:o,staticRenderFns:r};e.a=i}});
\ No newline at end of file
+webpackJsonp([0],{"+ABx":function(t,e,n){"use strict";function o(t){n("kayV")}Object.defineProperty(e,"__esModule",{value:!0});var r=n("Xv6o"),i=n.n(r);for(var a in r)["default","default"].indexOf(a)<0&&function(t){n.d(e,t,function(){return r[t]})}(a);var s=n("IrJl"),l=n("VU/8"),u=o,c=l(i.a,s.a,!1,u,"data-v-4b1fba7c",null);e.default=c.exports},"/4HN":function(t,e,n){var o=n("jThn");"string"==typeof o&&(o=[[t.i,o,""]]),o.locals&&(t.exports=o.locals);n("rjj0")("6eb059c4",o,!0,{})},"/aob":function(t,e,n){"use strict";Object.defineProperty(e,"__esModule",{value:!0});var o=n("RUmD"),r=n.n(o);for(var i in o)["default","default"].indexOf(i)<0&&function(t){n.d(e,t,function(){return o[t]})}(i);var a=n("cnUC"),s=n("VU/8"),l=s(r.a,a.a,!1,null,null,null);e.default=l.exports},"/ePw":function(t,e,n){"use strict";Object.defineProperty(e,"__esModule",{value:!0});var o=n("9/zo"),r=n.n(o);for(var i in o)["default","default"].indexOf(i)<0&&function(t){n.d(e,t,function(){return o[t]})}(i);var a=n("RAAF"),s=n("VU/8"),l=s(r.a,a.a,!1,null,null,null);e.default=l.exports},"12k0":function(t,e,n){var o=n("2My4");"string"==typeof o&&(o=[[t.i,o,""]]),o.locals&&(t.exports=o.locals);n("rjj0")("6aba167c",o,!0,{})},"1XML":function(t,e,n){"use strict";function o(t){return t&&t.__esModule?t:{default:t}}Object.defineProperty(e,"__esModule",{value:!0}),n("F8S1");var r=n("0iPh"),i=o(r),a=n("M4fF"),s=o(a),l=n("5U11"),u=o(l),c=n("TTNq"),d=o(c),f=n("JkFl"),v=o(f),p=n("PJh5"),h=o(p);e.default={props:{size:{type:String,default:""},fade:{type:Boolean,default:!1},serverInfo:{type:Object,default:function(){}},userInfo:{type:Object,default:function(){return null}}},data:function(){var t=this;return{tabName:"status",chartColors:["#337ab7","#7FFFD4"],streamLogSettings:{area:!0,labelMap:{OutBytes:"发送",InBytes:"接收"}},streamLogExtend:{legend:{formatter:function(e){switch(e){case"接收":return"接收("+(0,u.default)(t.streamLogs.sumInBytes)+")";case"发送":return"发送("+(0,u.default)(t.streamLogs.sumOutBytes)+")"}return e}},tooltip:{formatter:function(e){var n=e[0].name;n="today"==t.streamLogType||"yesterday"==t.streamLogType?(0,h.default)(n,"YYYY-MM-DD HH:mm:ss").format("HH"):(0,h.default)(n,"YYYY-MM-DD HH:mm:ss").format("MM-DD");for(var o=n+"<br>"+e[0].seriesName+" "+(0,u.default)(e[0].value[1]),r=1;r<e.length;r++)o+="<br>"+e[r].seriesName+" "+(0,u.default)(e[r].value[1]);return o}},yAxis:{axisLabel:{formatter:function(t,e){return""+(0,u.default)(t)}}},xAxis:{axisLabel:{formatter:function(e,n){return"today"==t.streamLogType||"yesterday"==t.streamLogType?""+(0,h.default)(e,"YYYY-MM-DD HH:mm:ss").format("HH"):""+(0,h.default)(e,"YYYY-MM-DD HH:mm:ss").format("MM-DD")}}}},title:"状态记录",serial:"",code:"",limit:20,statusLogs:[],statusDays:0,bStatusLogLoading:!1,positionLogs:[],positionDays:0,bPositionLogLoading:!1,streamLogType:"today",streamLogs:{columns:["Time","OutBytes","InBytes"],rows:[],sumInBytes:0,sumOutBytes:0},bStreamLogLoading:!1,sessions:[],sessionNumOutputs:0,sessionTotal:0,sessionPageSize:10,sessionCurrentPage:1,sessionQ:"",sessionFilter:"",sessionSort:"",sessionOrder:"",sessionLoading:!1,shown:!1}},watch:{sessionQ:function(t,e){this.delayGetSessions()},sessionFilter:function(t,e){this.getSessions()},sessionCurrentPage:function(t,e){this.getSessions()},sessionPageSize:function(t,e){this.getSessions()}},components:{ElTimeline:d.default,ElTimelineItem:v.default},mounted:function(){var t=this;(0,i.default)(this.$el).find(".modal-content").draggable({handle:".modal-header",cancel:".modal-title span",addClasses:!1,containment:"document",delay:100,opacity:.5}),(0,i.default)(this.$el).on("shown.bs.modal",function(){t.shown=!0,t.$emit("show")}).on("hidden.bs.modal",function(){t.shown=!1,t.reset(),t.$emit("hide")})},methods:{getStatusLogs:function(){var t=this;this.serial&&(this.bStatusLogLoading=!0,this.statusLogs=[],i.default.get("/api/v1/device/statuslog",{serial:this.serial,code:this.code,limit:this.limit}).then(function(e){e&&(t.statusLogs=e.LogList||[],t.statusDays=e.LogReserveDays||0)}).always(function(){t.$nextTick(function(){t.bStatusLogLoading=!1})}))},getPositionLogs:function(){var t=this;this.serial&&(this.bPositionLogLoading=!0,this.positionLogs=[],i.default.get("/api/v1/device/positionlog",{serial:this.serial,code:this.code,limit:this.limit}).then(function(e){e&&(t.positionLogs=e.LogList||[],t.positionDays=e.LogReserveDays||0)}).always(function(){t.$nextTick(function(){t.bPositionLogLoading=!1})}))},getStreamLogs:function(){var t=this;this.serial&&(this.bStreamLogLoading=!0,this.streamLogs.rows=[],this.streamLogs.sumInBytes=0,this.streamLogs.sumOutBytes=0,i.default.get("/api/v1/device/streamlog",{serial:this.serial,code:this.code,type:this.streamLogType}).then(function(e){if(e){var n=!0,o=!1,r=void 0;try{for(var i,a=e.List[Symbol.iterator]();!(n=(i=a.next()).done);n=!0){var s=i.value;t.streamLogs.sumInBytes+=s.InBytes,t.streamLogs.sumOutBytes+=s.OutBytes}}catch(t){o=!0,r=t}finally{try{!n&&a.return&&a.return()}finally{if(o)throw r}}t.streamLogs.rows=e.List||[]}}).always(function(){t.$nextTick(function(){t.bStreamLogLoading=!1})}))},delayGetSessions:s.default.debounce(function(){this.getSessions()},800),getSessions:function(t){var e=this;this.shown&&(this.sessionLoading=!0,i.default.get("/api/v1/device/session/list",{serial:this.serial,filter:this.sessionFilter,q:this.sessionQ,start:(this.sessionCurrentPage-1)*this.sessionPageSize,limit:this.sessionPageSize,sort:this.sessionSort,order:this.sessionOrder}).then(function(n){n&&(e.sessionNumOutputs=n.NumOutputs||0,e.sessionTotal=n.SessionCount||0,e.sessions=n.SessionList||[],t&&t.target&&e.$message({type:"success",message:"刷新成功！"}))}).always(function(){e.$nextTick(function(){e.sessionLoading=!1})}))},clearSessions:function(){var t=this;(0,i.default)(this.$el).hide(),this.$confirm("确认清空 设备("+this.serial+") 会话?","提示",{lockScroll:!1}).then(function(){(0,i.default)(t.$el).show().focus(),i.default.post("/api/v1/device/session/clear",{serial:t.serial}).always(function(){t.getSessions()})}).catch(function(){(0,i.default)(t.$el).show().focus()})},stopSession:function(t){var e=this;(0,i.default)(this.$el).hide(),this.$confirm("确认停止 "+(t.ChannelName||t.ChannelID)+" 会话?","提示",{lockScroll:!1}).then(function(){(0,i.default)(e.$el).show().focus(),i.default.post("/api/v1/device/session/stop",{streamid:t.StreamID}).always(function(){e.getSessions()})}).catch(function(){(0,i.default)(e.$el).show().focus()})},sessionSortChange:function(t){this.sessionSort=t.prop,this.sessionOrder="ascending"==t.order?"asc":"desc",this.getSessions()},formatSessionChannelName:function(t,e,n){return n||"-"},formatSessionType:function(t,e,n){var o=(t.StreamID||"").split(":");if(o.length>1)switch(o[0]){case"stream":return"直播";case"playback":return"回放";case"download":return t.CloudRecord?"补录":"下载";case"broadcast":return"对讲";default:return o[0]||"-"}return"-"},formatSessionTypeTitle:function(t,e,n){return t.PlaybackStartTime&&t.PlaybackEndTime?t.PlaybackStartTime+" ~ "+t.PlaybackEndTime:""},formatSessionDuration:function(t,e,n){return this.formatDuration(t.Duration||0)},formatInBytes:function(t,e,n){return n?(0,u.default)(n):"-"},formatInBitRate:function(t,e,n){return n?n+" Kbps":"-"},formatVideoCodec:function(t,e,n){return"hevc"==n?"H265":"h264"==n?"H264":n||"-"},formatCloudRecord:function(t,e,n){return n?"✓":"✗"},statusTimeLineType:function(t){var e="primary";if(!t)return e;switch(t.Status){case"ON":e="primary";break;case"OFF":e="warning";break;case"DEL":e="danger";break;default:e="info"}return e},positionTimeLineType:function(t){var e="primary";if(!t)return e;switch(t.Method){case"移动位置上报":case"通道通知":case"通道检索":e="primary";break;case"接口设置":e="warning";break;case"报警位置上报":e="danger";break;default:e="info"}return e},tabClick:function(t){var e=this;"stream"==t.name&&this.$nextTick(function(){e.$refs.streamChart.resize()})},changeStreamLogType:function(){this.getStreamLogs()},reset:function(){this.title="状态记录",this.serial="",this.code="",this.statusLogs=[],this.statusDays=0,this.positionLogs=[],this.positionDays=0,this.streamLogs.rows=[],this.streamLogs.sumInBytes=0,this.streamLogs.sumOutBytes=0,this.streamLogType="today",this.limit=20,this.sessionQ="",this.sessionFilter="",this.sessionSort="",this.sessionOrder="",this.sessions=[],this.sessionCurrentPage=1,this.sessionTotal=0,this.sessionNumOutputs=0,this.sessionPageSize=10},show:function(t,e,n,o){this.title=t||this.title,this.serial=e||"",this.code=n||"",this.limit=o||20,(0,i.default)(this.$el).modal("show"),this.getStatusLogs(),this.getPositionLogs(),this.getStreamLogs()},showStatusLog:function(t,e,n,o){this.tabName="status",this.title=t||this.title,this.serial=e||"",this.code=n||"",this.limit=o||20,(0,i.default)(this.$el).modal("show"),this.getStatusLogs(),this.getPositionLogs(),this.getStreamLogs()},showStreamLog:function(t,e,n){var o=this;this.tabName="stream",this.title=t||this.title,this.serial=e||"",this.code=n||"",(0,i.default)(this.$el).modal("show"),this.getStatusLogs(),this.getPositionLogs(),this.getStreamLogs(),this.$nextTick(function(){o.$refs.streamChart.resize()})},hide:function(){(0,i.default)(this.$el).modal("hide")}}}},"2My4":function(t,e,n){e=t.exports=n("FZ+f")(!1),e.push([t.i,".modal-content[data-v-6f9217a2]{overflow:hidden}@media screen and (min-width:992px){.modal-dialog.modal-lgg[data-v-6f9217a2]{width:90%}}@media screen and (min-width:1200px){.modal-dialog.modal-lgg[data-v-6f9217a2]{width:1200px}}",""])},"2xaA":function(t,e,n){"use strict";function o(t){return t&&t.__esModule?t:{default:t}}function r(t){return function(){var e=t.apply(this,arguments);return new Promise(function(t,n){function o(r,i){try{var a=e[r](i),s=a.value}catch(t){return void n(t)}if(!a.done)return Promise.resolve(s).then(function(t){o("next",t)},function(t){o("throw",t)});t(s)}return o("next")})}}Object.defineProperty(e,"__esModule",{value:!0});var i=n("A9K1"),a=o(i),s=n("0iPh"),l=o(s);e.default={data:function(){return{form:this.defForm()}},components:{FormDlg:a.default},methods:{defForm:function(){return{serial:"",code:"",name:"",custom:!1,longitude:"",latitude:"",customLongitude:"",customLatitude:""}},onHide:function(){this.form=this.defForm(),this.$emit("hide")},onShow:function(){this.errors.clear(),this.$emit("show")},onPasteLngLat:function(t){try{var e=t.clipboardData.getData("Text").trim().split(/\s*,\s*/);2==e.length&&(this.form.customLongitude=e[0],this.form.customLatitude=e[1],t.preventDefault())}catch(t){console.log(t)}},onSubmit:function(){var t=this;return r(regeneratorRuntime.mark(function e(){var n,o;return regeneratorRuntime.wrap(function(e){for(;;)switch(e.prev=e.next){case 0:return e.next=2,t.$validator.validateAll();case 2:if(n=e.sent){e.next=8;break}return o=t.errors.items[0],t.$message({type:"error",message:o.msg}),(0,l.default)("[name="+o.field+"]").focus(),e.abrupt("return");case 8:l.default.post("/api/v1/device/setchannelposition",{serial:t.form.serial,code:t.form.code,longitude:t.form.customLongitude,latitude:t.form.customLatitude}).then(function(e){t.$refs.dlg.hide(),t.$emit("submit")});case 9:case"end":return e.stop()}},e,t)}))()},show:function(t){var e=this;this.errors.clear(),t&&(this.form.serial=t.serial,this.form.code=t.code,this.form.name=t.name,this.form.longitude=t.longitude||"",this.form.latitude=t.latitude||"",this.form.customLongitude=t.customLongitude||"",this.form.customLatitude=t.customLatitude||""),this.$nextTick(function(){e.$refs.dlg.show()})}}}},"33FN":function(t,e,n){"use strict";var o=function(){var t=this,e=t.$createElement,n=t._self._c||e;return n("div",{staticClass:"box box-primary"},[t._m(0),t._v(" "),n("div",{staticClass:"box-body"},[n("div",{staticClass:"form-inline",attrs:{autocomplete:"off",spellcheck:"false"}},[n("div",{staticClass:"form-group form-group-sm"},[n("label",[t._v("搜索")]),t._v(" "),n("input",{directives:[{name:"model",rawName:"v-model.trim",value:t.q,expression:"q",modifiers:{trim:!0}}],ref:"q",staticClass:"form-control",attrs:{type:"text",placeholder:"关键字"},domProps:{value:t.q},on:{keydown:function(e){if(!("button"in e)&&t._k(e.keyCode,"enter",13,e.key))return null;e.preventDefault()},input:function(e){e.target.composing||(t.q=e.target.value.trim())},blur:function(e){t.$forceUpdate()}}})]),t._v(" "),n("span",{staticClass:"hidden-xs"},[t._v("  ")]),t._v(" "),n("div",{staticClass:"form-group pull-right"},[n("router-link",{staticClass:"btn btn-default btn-sm",attrs:{to:"/devices/1"}},[n("i",{staticClass:"fa fa-table"}),t._v(" 列表视图\n                ")])],1)]),t._v(" "),n("br"),t._v(" "),n("div",{staticClass:"clearfix"}),t._v(" "),n("div",{staticClass:"content"},[n("div",{class:[{"col-md-3":t.hasAnyRole(t.serverInfo,t.userInfo,"管理员","操作员"),"col-md-4":!t.hasAnyRole(t.serverInfo,t.userInfo,"管理员","操作员")}]},[t._m(1),t._v(" "),n("div",{staticClass:"tab-content",staticStyle:{margin:"10px 0"},attrs:{id:"tab-tree-wrapper"}},[n("div",{ref:"devTreeWrapper",staticClass:"tab-pane active",attrs:{id:"dev-tree-wrapper"}},[t.showTree?n("el-tree",{ref:"devTree",style:(t.isMobile()?"max-height:200px;":"")+";min-height:200px;overflow:auto;",attrs:{id:"dev-tree","node-key":"key",props:t.treeProps,load:t.treeLoad,"filter-node-method":t.treeFilter,lazy:""},on:{"node-click":t.treeNodeClick,"node-contextmenu":t.treeNodeRightClick},scopedSlots:t._u([{key:"default",fn:function(e){var o=e.node,r=e.data;return n("span",{staticClass:"custom-tree-node"},[n("span",{class:{"text-green":t.treeLeaf(r)&&"ON"===r.status}},[n("i",{class:["fa",{"fa-sitemap":!t.treeLeaf(r),"fa-camera":t.treeLeaf(r)}]}),t._v(" "),n("span",{staticClass:"ellipsis",attrs:{title:o.label}},[t._v(t._s(o.label))])])])}}])}):t._e()],1),t._v(" "),n("div",{ref:"groupTreeWrapper",staticClass:"tab-pane",attrs:{id:"group-tree-wrapper"}},[t.showGroupTree?n("el-tree",{ref:"groupTree",style:(t.isMobile()?"max-height:200px;":"")+";min-height:200px;overflow:auto;",attrs:{id:"group-tree","node-key":"key",props:t.treeProps,load:t.groupTreeLoad,"filter-node-method":t.treeFilter,lazy:""},on:{"node-click":t.treeNodeClick,"node-contextmenu":t.treeNodeRightClick},scopedSlots:t._u([{key:"default",fn:function(e){var o=e.node,r=e.data;return n("span",{staticClass:"custom-tree-node"},[n("span",{class:{"text-green":"ON"===r.status&&0===r.subCount&&r.code&&r.serial&&!r.custom}},[n("i",{class:["fa",{"fa-home":!r.code,"fa-sitemap":r.code&&!t.treeLeaf(r),"fa-camera":t.treeLeaf(r)}]}),t._v(" "),n("span",{staticClass:"ellipsis",attrs:{title:o.label}},[t._v(t._s(o.label))])])])}}])}):t._e()],1)])]),t._v(" "),n("VueContextMenu",{staticClass:"right-menu",attrs:{target:t.contextMenuTarget,show:t.contextMenuVisible},on:{"update:show":function(e){return t.contextMenuVisible=e}}},[n("a",{directives:[{name:"show",rawName:"v-show",value:t.contextMenuNodeData,expression:"contextMenuNodeData"}],attrs:{role:"button"},on:{click:t.treeRefresh}},[n("i",{staticClass:"fa fa-refresh"}),t._v(" 刷新节点\n                ")]),t._v(" "),n("a",{directives:[{name:"show",rawName:"v-show",value:t.contextMenuNodeData&&t.contextMenuNodeData.custom,expression:"contextMenuNodeData && contextMenuNodeData.custom"}],attrs:{role:"button"},on:{click:t.showNodeAddDlg}},[n("i",{staticClass:"fa fa-plus"}),t._v(" 新建节点\n                ")]),t._v(" "),n("a",{directives:[{name:"show",rawName:"v-show",value:t.contextMenuNodeData&&t.contextMenuNodeData.custom&&t.contextMenuNodeData.code,expression:"contextMenuNodeData && contextMenuNodeData.custom && contextMenuNodeData.code"}],attrs:{role:"button"},on:{click:t.showCustomListDlg}},[n("i",{staticClass:"fa fa-check"}),t._v(" 选择通道\n                ")]),t._v(" "),n("a",{directives:[{name:"show",rawName:"v-show",value:t.contextMenuNodeData&&!(t.contextMenuNodeData.custom&&!t.contextMenuNodeData.code),expression:"contextMenuNodeData && !(contextMenuNodeData.custom && !contextMenuNodeData.code)"}],attrs:{role:"button"},on:{click:t.showNodeEditDlg}},[n("i",{staticClass:"fa fa-edit"}),t._v(" 编辑节点\n                ")]),t._v(" "),n("a",{directives:[{name:"show",rawName:"v-show",value:t.contextMenuNodeData&&t.contextMenuNodeData.custom&&t.contextMenuNodeData.code,expression:"contextMenuNodeData && contextMenuNodeData.custom && contextMenuNodeData.code"}],attrs:{role:"button"},on:{click:t.removeCustomNode}},[n("i",{staticClass:"fa fa-remove"}),t._v(" 删除节点\n                ")])]),t._v(" "),n("div",{class:["row",{"col-md-9":t.hasAnyRole(t.serverInfo,t.userInfo,"管理员","操作员"),"col-md-8":!t.hasAnyRole(t.serverInfo,t.userInfo,"管理员","操作员")}],attrs:{id:"dev-tree-right","data-sticky-wrap":"","data-sticky-for":"1000","data-sticky-class":"sticky"}},[n("div",{staticClass:"col-md-9 col-lg-10",attrs:{id:"dev-tree-player"}},[n("div",{staticClass:"view-list row"},[n("div",{staticClass:"video-show"},[n("div",t._l(t.players,function(e,o){return n("div",{key:o,staticClass:"no-margin no-padding video col-sm-6",class:{active:o==t.playerIdx,"col-sm-12":1==t.playersLength,"col-sm-6":4==t.playersLength,"col-sm-4":9==t.playersLength,"col-sm-3":16==t.playersLength},on:{click:function(n){t.clickPlayer(e,o,n)},mousemove:function(n){t.resetCloseTimer(e)},touchstart:function(n){t.resetCloseTimer(e)}}},[n("LivePlayer",{directives:[{name:"loading",rawName:"v-loading",value:e.bLoading,expression:"player.bLoading"}],attrs:{videoUrl:e.url,poster:e.poster,live:"",muted:"",stretch:"",digitalZoom:"",hideBigPlayButton:!!t.serverInfo.HideBigPlayButton,loading:e.bLoading,"element-loading-text":"加载中...","element-loading-background":"#000"},on:{message:t.$message,"update:loading":function(n){t.$set(e,"bLoading",n)}}}),t._v(" "),n("div",{directives:[{name:"show",rawName:"v-show",value:e.url&&e.bCloseShow,expression:"player.url && player.bCloseShow"}],staticClass:"video-close",on:{click:function(n){t.closeVideo(e)}}},[t._v("关闭")])],1)}))])]),t._v(" "),n("br"),t._v(" "),n("br")]),t._v(" "),t.hasAnyRole(t.serverInfo,t.userInfo,"管理员","操作员")?n("div",{staticClass:"col-md-3 col-lg-2",attrs:{id:"dev-tree-ptz"}},[n("div",{staticClass:"ptz-block"},[n("div",{staticClass:"ptz-cell ptz-up",class:{readonly:!t.playing},attrs:{command:"up",title:"上"},on:{mousedown:function(e){e.preventDefault(),t.ptzControl(e)}}},[n("i",{staticClass:"fa fa-chevron-up"})]),t._v(" "),n("div",{staticClass:"ptz-cell ptz-left",class:{readonly:!t.playing},attrs:{command:"left",title:"左"},on:{mousedown:function(e){e.preventDefault(),t.ptzControl(e)}}},[n("i",{staticClass:"fa fa-chevron-left"})]),t._v(" "),n("div",{staticClass:"ptz-cell ptz-center",attrs:{title:"云台控制"}},[t.canTalk()&&"标准版"!=t.serverInfo.VersionType?n("i",{staticClass:"fa fa-microphone",class:{readonly:!t.playing},attrs:{title:"按住喊话"},on:{mousedown:function(e){e.preventDefault(),t.talkStart(e)}}}):t._e(),t._v(" "),t.canTalk()||"标准版"==t.serverInfo.VersionType?t._e():n("i",{staticClass:"fa fa-microphone-slash",class:{readonly:!t.playing},attrs:{title:"由于浏览器安全策略, 非 HTTPS 或 localhost 访问, 对讲不可用"}})]),t._v(" "),n("div",{staticClass:"ptz-cell ptz-right",class:{readonly:!t.playing},attrs:{command:"right",title:"右"},on:{mousedown:function(e){e.preventDefault(),t.ptzControl(e)}}},[n("i",{staticClass:"fa fa-chevron-right"})]),t._v(" "),n("div",{staticClass:"ptz-cell ptz-down",class:{readonly:!t.playing},attrs:{command:"down",title:"下"},on:{mousedown:function(e){e.preventDefault(),t.ptzControl(e)}}},[n("i",{staticClass:"fa fa-chevron-down"})]),t._v(" "),n("div",{staticClass:"ptz-cell ptz-zoomin",class:{readonly:!t.playing},attrs:{command:"zoomin",title:"放大"},on:{mousedown:function(e){e.preventDefault(),t.ptzControl(e)}}},[n("i",{staticClass:"fa fa-plus"})]),t._v(" "),n("div",{staticClass:"ptz-cell ptz-zoomout",class:{readonly:!t.playing},attrs:{command:"zoomout",title:"缩小"},on:{mousedown:function(e){e.preventDefault(),t.ptzControl(e)}}},[n("i",{staticClass:"fa fa-minus"})])])]):t._e()]),t._v(" "),n("div",{staticClass:"clearfix"}),t._v(" "),t.isDemoUser(t.serverInfo,t.userInfo)?n("div",{staticClass:"text-center text-gray"},[n("br"),t._v("\n                提示: 演示系统限制匿名登录播放时间, 若需测试长时间播放, 请"),n("a",{attrs:{target:"_blank",href:"//www.liveqing.com/docs/download/LiveGBS.html"}},[t._v("下载使用")])]):t._e(),t._v(" "),n("br")],1)]),t._v(" "),n("DeviceTreeNodeEditDlg",{ref:"nodeEditDlg",staticStyle:{"z-index":"2001"},attrs:{serverInfo:t.serverInfo,userInfo:t.userInfo},on:{submit:t.treeRefresh}}),t._v(" "),n("ChannelCustomListDlg",{ref:"customListDlg",staticStyle:{"z-index":"2001"},attrs:{serverInfo:t.serverInfo,userInfo:t.userInfo,size:"modal-lg",title:t.customListDlgTitle},on:{hide:t.treeRefresh}})],1)},r=[function(){var t=this,e=t.$createElement,n=t._self._c||e;return n("div",{staticClass:"box-header"},[n("h4",{staticClass:"text-primary text-center"},[t._v("设备树")])])},function(){var t=this,e=t.$createElement,n=t._self._c||e;return n("ul",{staticClass:"nav nav-tabs"},[n("li",{staticClass:"active"},[n("a",{attrs:{href:"#dev-tree-wrapper","data-toggle":"tab"}},[t._v("设备树")])]),t._v(" "),n("li",[n("a",{attrs:{href:"#group-tree-wrapper","data-toggle":"tab"}},[t._v("分 组")])])])}],i={render:o,staticRenderFns:r};e.a=i},"3f1d":function(t,e,n){"use strict";(function(t){Object.defineProperty(e,"__esModule",{value:!0}),n("F8S1");var o=n("eAMf"),r=function(t){return t&&t.__esModule?t:{default:t}}(o);e.default={data:function(){return{protocol:"",videoUrl:"",videoTitle:"",osd:"",snapUrl:"",serial:"",code:"",streamID:"",speed:1,inBitRate:0,timer:0,bShow:!1,bLoading:!1,mediaInfo:null,bOutHevcTip:!1,bAudioEnable:!1}},props:{fade:{type:Boolean,default:!1},digitalZoom:{type:Boolean,default:!0},serverInfo:{type:Object,default:function(){}},userInfo:{type:Object,default:function(){return null}}},components:{LivePlayer:r.default},mounted:function(){var e=this;t(this.$el).find(".modal-content").draggable({handle:".modal-header",cancel:".modal-title span",addClasses:!1,containment:"document",delay:100,opacity:.5}),t(this.$el).on("hidden.bs.modal",function(){e.bShow=!1,e.timer&&(clearInterval(e.timer),e.timer=0),e.stop(),e.reset()}).on("shown.bs.modal",function(){e.bShow=!0,e.streamID&&(e.timer=setInterval(function(){t.ajax({type:"GET",url:"/api/v1/playback/streaminfo",data:{streamid:e.streamID},global:!1}).then(function(t){e.speed=t.PlaybackSpeed||1,e.inBitRate=t.InBitRate||0}).fail(function(){e.inBitRate=0})},3e3))})},beforeDestroy:function(){this.timer&&(clearInterval(this.timer),this.timer=0)},methods:{reset:function(){this.protocol="",this.videoUrl="",this.osd="",this.snapUrl="",this.serial="",this.code="",this.streamID="",this.speed=1,this.inBitRate=0,this.timer=0,this.mediaInfo=null,this.bOutHevcTip=!1,this.bAudioEnable=!1},play:function(e,n,o,r,i){this.protocol=e||"",this.videoTitle=o||"",this.snapUrl=r||"",this.serial=i.DeviceID||"",this.code=i.ChannelID||"",this.streamID=i.StreamID||"",this.osd=i.ChannelOSD||"",this.speed=i.PlaybackSpeed||1,this.inBitRate=i.InBitRate||0,this.bAudioEnable=!!i.AudioEnable,this.videoUrl=n||"",t(this.$el).modal("show")},scale:function(){var e=this,n=arguments.length>0&&void 0!==arguments[0]?arguments[0]:1;this.streamID&&t.post("/api/v1/playback/control",{streamid:this.streamID,command:"scale",scale:n}).then(function(t){e.$message({type:"success",message:n+" 倍速设置成功"})})},stop:function(){var e=this;this.streamID&&(t.ajax({type:"POST",url:"/api/v1/playback/stop",data:{streamid:this.streamID},global:!1}).always(function(){e.$emit("close")}),this.streamID="")},onMediaInfo:function(t){this.mediaInfo=t},onEnded:function(t){this.mediaInfo=null},onError:function(t){"MediaError"==t&&(this.mediaInfo&&String(this.mediaInfo.videoCodec).startsWith("hvc")||"HLS"==this.protocol)&&flvjs.getFeatureList()&&!flvjs.getFeatureList().nativeMP4H265Playback&&(this.bOutHevcTip=!0,console.log("提示: 正在播放 H265 直出流, 确保浏览器版本较新, 并且开启硬件加速"))}}}}).call(e,n("0iPh"))},"49HP":function(t,e,n){"use strict";(function(t){Object.defineProperty(e,"__esModule",{value:!0});var o=n("PJh5"),r=function(t){return t&&t.__esModule?t:{default:t}}(o);e.default={data:function(){return{flags:{},defaultFlags:"0000000000000000000000000000000"}},props:{placeholder:{default:"选择日期"},clearBtn:{type:Boolean,default:!1},day:{default:""}},mounted:function(){var e=this;t(this.$el).datepicker({language:"zh-CN",autoclose:!0,clearBtn:this.clearBtn,format:"yyyy-mm-dd",todayHighlight:!0}).on("changeDate",function(t){t.date?e.$emit("update:day",(0,r.default)(t.date).format("YYYYMMDD")):e.$emit("update:day","")}).on("change",function(){e.$el.value||e.$emit("update:day","")}),this.day&&t(this.$el).datepicker("setDate",(0,r.default)(this.day,"YYYYMMDD").toDate()),this.update()},watch:{day:function(e){if(e){var n=t(this.$el).datepicker("getDate");e!=(0,r.default)(n).format("YYYYMMDD")&&t(this.$el).datepicker("setDate",(0,r.default)(e,"YYYYMMDD").toDate())}}},computed:{},methods:{update:function(){t(this.$el).datepicker("update")}}}}).call(e,n("0iPh"))},"4Dib":function(t,e,n){e=t.exports=n("FZ+f")(!1),e.push([t.i,"#dev-tree-player .view-list .video-show .video{border:1px solid #fff}#dev-tree-player .view-list .video-show .video.active .video-inner{border:2px solid red}#dev-tree-player .view-list .video-show .col-sm-12:first-child{border:0}#dev-tree-player .view-list .video-show .col-sm-6:first-child,#dev-tree-player .view-list .video-show .col-sm-6:nth-child(2){border-top-color:transparent}#dev-tree-player .view-list .video-show .col-sm-6:nth-child(2),#dev-tree-player .view-list .video-show .col-sm-6:nth-child(4){border-right-color:transparent}#dev-tree-player .view-list .video-show .col-sm-6:first-child,#dev-tree-player .view-list .video-show .col-sm-6:nth-child(3){border-left-color:transparent}#dev-tree-player .view-list .video-show .col-sm-6:nth-child(3),#dev-tree-player .view-list .video-show .col-sm-6:nth-child(4){border-bottom-color:transparent}#dev-tree-player .view-list .video-show .col-sm-4:first-child,#dev-tree-player .view-list .video-show .col-sm-4:nth-child(2),#dev-tree-player .view-list .video-show .col-sm-4:nth-child(3){border-top-color:transparent}#dev-tree-player .view-list .video-show .col-sm-4:nth-child(3),#dev-tree-player .view-list .video-show .col-sm-4:nth-child(6),#dev-tree-player .view-list .video-show .col-sm-4:nth-child(9){border-right-color:transparent}#dev-tree-player .view-list .video-show .col-sm-4:nth-child(7),#dev-tree-player .view-list .video-show .col-sm-4:nth-child(8),#dev-tree-player .view-list .video-show .col-sm-4:nth-child(9){border-bottom-color:transparent}#dev-tree-player .view-list .video-show .col-sm-4:first-child,#dev-tree-player .view-list .video-show .col-sm-4:nth-child(4),#dev-tree-player .view-list .video-show .col-sm-4:nth-child(7){border-left-color:transparent}#dev-tree-player .view-list .video-show .col-sm-3:first-child,#dev-tree-player .view-list .video-show .col-sm-3:nth-child(2),#dev-tree-player .view-list .video-show .col-sm-3:nth-child(3),#dev-tree-player .view-list .video-show .col-sm-3:nth-child(4){border-top-color:transparent}#dev-tree-player .view-list .video-show .col-sm-3:nth-child(4),#dev-tree-player .view-list .video-show .col-sm-3:nth-child(8),#dev-tree-player .view-list .video-show .col-sm-3:nth-child(12),#dev-tree-player .view-list .video-show .col-sm-3:nth-child(16){border-right-color:transparent}#dev-tree-player .view-list .video-show .col-sm-3:nth-child(13),#dev-tree-player .view-list .video-show .col-sm-3:nth-child(14),#dev-tree-player .view-list .video-show .col-sm-3:nth-child(15),#dev-tree-player .view-list .video-show .col-sm-3:nth-child(16){border-bottom-color:transparent}#dev-tree-player .view-list .video-show .col-sm-3:first-child,#dev-tree-player .view-list .video-show .col-sm-3:nth-child(5),#dev-tree-player .view-list .video-show .col-sm-3:nth-child(9),#dev-tree-player .view-list .video-show .col-sm-3:nth-child(13){border-left-color:transparent}",""])},"5aEn":function(t,e,n){"use strict";function o(t){n("kbZy")}Object.defineProperty(e,"__esModule",{value:!0});var r=n("3f1d"),i=n.n(r);for(var a in r)["default","default"].indexOf(a)<0&&function(t){n.d(e,t,function(){return r[t]})}(a);var s=n("SHtI"),l=n("VU/8"),u=o,c=l(i.a,s.a,!1,u,"data-v-66201e86",null);e.default=c.exports},"5gxX":function(t,e,n){"use strict";function o(t){n("LbQQ")}Object.defineProperty(e,"__esModule",{value:!0});var r=n("qb8i"),i=n.n(r);for(var a in r)["default","default"].indexOf(a)<0&&function(t){n.d(e,t,function(){return r[t]})}(a);var s=n("lGU5"),l=n("VU/8"),u=o,c=l(i.a,s.a,!1,u,null,null);e.default=c.exports},"5w0Z":function(t,e,n){"use strict";var o=function(){var t=this,e=t.$createElement,n=t._self._c||e;return n("div",{class:["modal",{fade:t.fade}],attrs:{"data-backdrop":"static","data-disable":"false","data-keyboard":"true",tabindex:"-1"}},[n("div",{class:["modal-dialog",t.size]},[n("div",{staticClass:"modal-content"},[n("div",{staticClass:"modal-header"},[t._m(0),t._v(" "),n("h4",{staticClass:"modal-title text-center text-primary"},[n("span",[t._v(t._s(t.title))])])]),t._v(" "),n("div",{staticClass:"modal-body"},[n("div",{staticClass:"form-inline",attrs:{autocomplete:"off",spellcheck:"false"}},[n("div",{staticClass:"form-group form-group-sm"},[n("label",[t._v("搜索")]),t._v(" "),n("input",{directives:[{name:"model",rawName:"v-model.trim",value:t.q,expression:"q",modifiers:{trim:!0}}],ref:"q",staticClass:"form-control",attrs:{type:"text",placeholder:"关键字"},domProps:{value:t.q},on:{keydown:function(e){if(!("button"in e)&&t._k(e.keyCode,"enter",13,e.key))return null;e.preventDefault()},input:function(e){e.target.composing||(t.q=e.target.value.trim())},blur:function(e){t.$forceUpdate()}}})]),t._v(" "),n("span",{staticClass:"hidden-xs"},[t._v("  ")]),t._v(" "),n("div",{staticClass:"form-group form-group-sm"},[n("label",[t._v("通道类型")]),t._v(" "),n("select",{directives:[{name:"model",rawName:"v-model.trim",value:t.channel_type,expression:"channel_type",modifiers:{trim:!0}}],staticClass:"form-control",on:{change:function(e){var n=Array.prototype.filter.call(e.target.options,function(t){return t.selected}).map(function(t){return"_value"in t?t._value:t.value});t.channel_type=e.target.multiple?n:n[0]}}},[n("option",{attrs:{value:""}},[t._v("全部")]),t._v(" "),n("option",{attrs:{value:"device"}},[t._v("设备")]),t._v(" "),n("option",{attrs:{value:"dir"}},[t._v("子目录")])])]),t._v(" "),n("span",{staticClass:"hidden-xs"},[t._v("  ")]),t._v(" "),"device"==t.channel_type?n("div",{staticClass:"form-group form-group-sm"},[n("label",[t._v("在线状态")]),t._v(" "),n("select",{directives:[{name:"model",rawName:"v-model.trim",value:t.online,expression:"online",modifiers:{trim:!0}}],staticClass:"form-control",on:{change:function(e){var n=Array.prototype.filter.call(e.target.options,function(t){return t.selected}).map(function(t){return"_value"in t?t._value:t.value});t.online=e.target.multiple?n:n[0]}}},[n("option",{attrs:{value:""}},[t._v("全部")]),t._v(" "),n("option",{attrs:{value:"true"}},[t._v("在线")]),t._v(" "),n("option",{attrs:{value:"false"}},[t._v("离线")])])]):t._e(),t._v(" "),"device"==t.channel_type?n("span",{staticClass:"hidden-xs"},[t._v("  ")]):t._e(),t._v(" "),n("div",{staticClass:"form-group form-group-sm"},[n("div",{staticClass:"checkbox",staticStyle:{"user-select":"none"}},[n("el-checkbox",{staticStyle:{"margin-top":"-5px","padding-left":"0"},attrs:{size:"small",name:"Related"},model:{value:t.related,callback:function(e){t.related="string"==typeof e?e.trim():e},expression:"related"}},[t._v("\n                                只看"+t._s(t.reverse?"未选":"已选")+"("+t._s(t.relateCnt)+")\n                            ")]),t._v(" "),n("span",[n("a",{attrs:{role:"button"},on:{click:t.toggleReverse}},[n("i",{staticClass:"fa fa-sort"})])])],1)])]),t._v(" "),n("br"),t._v(" "),n("el-table",{directives:[{name:"loading",rawName:"v-loading",value:t.loading,expression:"loading"}],ref:"channelTable",attrs:{data:t.channels,stripe:"","max-height":500,"element-loading-text":"加载中..."},on:{"sort-change":t.sortChange,select:t.select,"select-all":t.selectAll}},[n("el-table-column",{attrs:{type:"selection",width:"55",fixed:"",selectable:t.selectable}}),t._v(" "),n("el-table-column",{attrs:{prop:"DeviceID",label:"设备国标编号","min-width":"200","show-overflow-tooltip":"",sortable:"custom"}}),t._v(" "),n("el-table-column",{attrs:{prop:"ID",label:"通道国标编号","min-width":"200","show-overflow-tooltip":"",sortable:"custom"}}),t._v(" "),"标准版"!=t.serverInfo.VersionType?n("el-table-column",{attrs:{prop:"CustomID",label:"自定义通道国标编号","min-width":"200","show-overflow-tooltip":"",sortable:"custom"},scopedSlots:t._u([{key:"default",fn:function(e){return[e.row.Editing?n("input",{directives:[{name:"focus",rawName:"v-focus"}],staticStyle:{width:"170px",padding:"2px","line-height":"100%"},attrs:{type:"text",oninput:"value=value.replace(/[^\\d]/g,'')"},domProps:{value:e.row.CustomID||e.row.ID},on:{keydown:[function(n){if(!("button"in n)&&t._k(n.keyCode,"esc",27,n.key))return null;n.stopPropagation(),n.preventDefault(),t.setChannelID(e.row,!1,n)},function(n){if(!("button"in n)&&t._k(n.keyCode,"enter",13,n.key))return null;n.stopPropagation(),n.preventDefault(),t.setChannelID(e.row,!1,n)}],blur:function(n){t.setChannelID(e.row,!1,n)}}}):n("a",{class:{"text-orange":!!e.row.CustomID},attrs:{role:"button"},on:{click:function(n){n.preventDefault(),t.setChannelID(e.row,!0,n)}}},[t._v(t._s(e.row.CustomID||e.row.ID))])]}}])}):t._e(),t._v(" "),n("el-table-column",{attrs:{prop:"Name",label:"通道名称","min-width":"160",formatter:t.formatName,"show-overflow-tooltip":""}}),t._v(" "),n("el-table-column",{attrs:{"min-width":"100",label:"快照"},scopedSlots:t._u([{key:"default",fn:function(e){return[t.isDir(e.row)?n("span",[n("i",{staticClass:"fa fa-sitemap"})]):n("el-popover",{attrs:{"open-delay":1e3,"close-delay":10,placement:"left",title:e.row.ID,width:"400",trigger:"hover"}},[n("img",{staticStyle:{width:"100%",height:"100%"},attrs:{onerror:"this.src='/images/default_snap.png';",src:e.row.SnapURL}}),t._v(" "),n("img",{staticStyle:{height:"30px",width:"50px"},attrs:{slot:"reference",onerror:"this.src='/images/default_snap.png';",src:e.row.SnapURL},slot:"reference"})])]}}])}),t._v(" "),n("el-table-column",{attrs:{prop:"Status",label:"在线状态","min-width":"100"},scopedSlots:t._u([{key:"default",fn:function(e){return[t.isDir(e.row)?n("span",[t._v("-")]):e.row.DeviceOnline&&"ON"==(e.row.CustomStatus||e.row.Status)?n("span",{class:{"text-success":"ON"==e.row.Status,"text-orange":!!e.row.CustomStatus}},[t._v("在线")]):n("span",{class:{"text-orange":!!e.row.CustomStatus}},[t._v("离线")])]}}])}),t._v(" "),n("el-table-column",{attrs:{prop:"Manufacturer",label:"厂家","min-width":"120",formatter:t.formatManufacturer,"show-overflow-tooltip":""}})],1),t._v(" "),t.total>0?n("el-pagination",{staticClass:"pull-right",attrs:{layout:"total,prev,pager,next,sizes","pager-count":t.isMobile()?3:5,total:t.total,"page-size":t.pageSize,"current-page":t.currentPage},on:{"update:pageSize":function(e){t.pageSize=e},"update:currentPage":function(e){t.currentPage=e}}}):t._e(),t._v(" "),n("div",{staticClass:"clearfix"})],1)])])])},r=[function(){var t=this,e=t.$createElement,n=t._self._c||e;return n("button",{staticClass:"close",attrs:{type:"button","data-dismiss":"modal","aria-label":"Close"}},[n("span",{attrs:{"aria-hidden":"true"}},[t._v("×")])])}],i={render:o,staticRenderFns:r};e.a=i},"6Exk":function(t,e,n){"use strict";var o=function(){var t=this,e=t.$createElement,n=t._self._c||e;return n("FormDlg",{ref:"dlg",attrs:{title:"编辑通道位置",disabled:t.errors.any()||t.form.custom},on:{hide:t.onHide,show:t.onShow,submit:t.onSubmit}},[n("div",{class:{"form-group":!0,"has-error":t.errors.has("serial")}},[n("label",{staticClass:"col-sm-4 control-label",attrs:{for:"input-position-serial"}},[t._v("设备编号\n            "),n("span",{staticClass:"text-red"},[t._v("*")])]),t._v(" "),n("div",{staticClass:"col-sm-7"},[n("input",{staticClass:"form-control",attrs:{type:"text",readonly:"readonly",id:"input-position-serial",name:"serial","data-vv-as":"设备编号"},domProps:{value:t.form.serial}})])]),t._v(" "),n("div",{class:{"form-group":!0,"has-error":t.errors.has("code")}},[n("label",{staticClass:"col-sm-4 control-label",attrs:{for:"input-position-code"}},[t._v("通道编号\n            "),n("span",{staticClass:"text-red"},[t._v("*")])]),t._v(" "),n("div",{staticClass:"col-sm-7"},[n("input",{staticClass:"form-control",attrs:{type:"text",readonly:"readonly",id:"input-position-code",name:"code","data-vv-as":"通道编号"},domProps:{value:t.form.code}})])]),t._v(" "),n("div",{class:{"form-group":!0,"has-error":t.errors.has("name")}},[n("label",{staticClass:"col-sm-4 control-label",attrs:{for:"input-position-name"}},[t._v("通道名称\n        ")]),t._v(" "),n("div",{staticClass:"col-sm-7"},[n("input",{staticClass:"form-control",attrs:{type:"text",readonly:"readonly",id:"input-position-name",name:"name","data-vv-as":"通道名称"},domProps:{value:t.form.name||"-"}})])]),t._v(" "),n("div",{class:{"form-group":!0,"has-error":t.errors.has("longitude")}},[n("label",{staticClass:"col-sm-4 control-label",attrs:{for:"input-position-longitude"}},[t._v("经度\n        ")]),t._v(" "),n("div",{staticClass:"col-sm-7"},[n("input",{directives:[{name:"validate",rawName:"v-validate",value:"decimal",expression:"'decimal'"},{name:"model",rawName:"v-model.trim",value:t.form.customLongitude,expression:"form.customLongitude",modifiers:{trim:!0}}],staticClass:"form-control",attrs:{type:"text",id:"input-position-longitude",name:"longitude",placeholder:t.form.longitude||"-","data-vv-as":"经度"},domProps:{value:t.form.customLongitude},on:{paste:t.onPasteLngLat,keydown:function(e){if(!("button"in e)&&t._k(e.keyCode,"enter",13,e.key))return null;t.$el.querySelector("#input-position-latitude").focus()},input:function(e){e.target.composing||t.$set(t.form,"customLongitude",e.target.value.trim())},blur:function(e){t.$forceUpdate()}}})])]),t._v(" "),n("div",{class:{"form-group":!0,"has-error":t.errors.has("latitude")}},[n("label",{staticClass:"col-sm-4 control-label",attrs:{for:"input-position-latitude"}},[t._v("纬度\n        ")]),t._v(" "),n("div",{staticClass:"col-sm-7"},[n("input",{directives:[{name:"validate",rawName:"v-validate",value:"decimal",expression:"'decimal'"},{name:"model",rawName:"v-model.trim",value:t.form.customLatitude,expression:"form.customLatitude",modifiers:{trim:!0}}],staticClass:"form-control",attrs:{type:"text",id:"input-position-latitude",name:"latitude",placeholder:t.form.latitude||"-","data-vv-as":"纬度"},domProps:{value:t.form.customLatitude},on:{paste:t.onPasteLngLat,keydown:function(e){if(!("button"in e)&&t._k(e.keyCode,"enter",13,e.key))return null;t.onSubmit(e)},input:function(e){e.target.composing||t.$set(t.form,"customLatitude",e.target.value.trim())},blur:function(e){t.$forceUpdate()}}})])])])},r=[],i={render:o,staticRenderFns:r};e.a=i},"6O2P":function(t,e,n){e=t.exports=n("FZ+f")(!1),e.push([t.i,".opt-group .cell{overflow:visible}",""])},"7KHA":function(t,e,n){e=t.exports=n("FZ+f")(!1),e.push([t.i,".modal-content[data-v-65020dbb]{overflow:hidden}@media screen and (min-width:992px){.modal-dialog.modal-lgg[data-v-65020dbb]{width:90%}}@media screen and (min-width:1200px){.modal-dialog.modal-lgg[data-v-65020dbb]{width:1200px}}@media screen and (max-width:767px){.el-date-editor--daterange.el-input[data-v-65020dbb],.el-date-editor--daterange.el-input__inner[data-v-65020dbb],.el-date-editor--timerange.el-input[data-v-65020dbb],.el-date-editor--timerange.el-input__inner[data-v-65020dbb]{width:250px}}",""])},"8+DQ":function(t,e,n){"use strict";function o(t){n("/4HN")}Object.defineProperty(e,"__esModule",{value:!0});var r=n("SLv4"),i=n.n(r);for(var a in r)["default","default"].indexOf(a)<0&&function(t){n.d(e,t,function(){return r[t]})}(a);var s=n("WGgy"),l=n("VU/8"),u=o,c=l(i.a,s.a,!1,u,"data-v-4494c1da",null);e.default=c.exports},"9/zo":function(t,e,n){"use strict";(function(t){function o(t){return t&&t.__esModule?t:{default:t}}Object.defineProperty(e,"__esModule",{value:!0});var r=Object.assign||function(t){for(var e=1;e<arguments.length;e++){var n=arguments[e];for(var o in n)Object.prototype.hasOwnProperty.call(n,o)&&(t[o]=n[o])}return t},i=n("M4fF"),a=o(i),s=n("5gxX"),l=o(s),u=n("KToh"),c=o(u),d=n("MR46"),f=o(d),v=n("NYxO");e.default={props:{},data:function(){return{q:"",online:"",total:0,pageSize:10,currentPage:1,sort:"ID",order:"asc",devices:[],loading:!1,bgLoading:!1,timer:0,statusLogs:[],bStatusLogLoading:!1,removeDlg:!1,removeUACheck:!1,removeIPCheck:!1,forbidUACheck:!1,forbidIPCheck:!1,removeID:"",removeName:"",removeUA:"",removeIP:"",debug:!1,checkCatalogProgress:!0,catalogMap:{},network:!1,region:!1}},components:{UploadDlg:l.default,DeviceEditDlg:c.default,DeviceLogDlg:f.default},computed:r({},(0,v.mapState)(["userInfo","serverInfo"])),mounted:function(){t(document).on("keydown",this.keyDown)},beforeDestroy:function(){this.timer&&(this.timer.close(),this.timer=0),t(document).off("keydown",this.keyDown)},methods:{ready:function(){var t=this;this.$watch("q",function(t,e){this.doDelaySearch()}),this.$watch("online",function(t,e){this.doSearch()}),this.$watch("currentPage",function(t,e){this.doSearch(t)}),this.timer=subscribeEvents(["online","offline","keepalive_timeout","catalog"],function(){t.getDeviceList(!1)})},doSearch:function(){var t=arguments.length>0&&void 0!==arguments[0]?arguments[0]:1,e={};this.q&&(e.q=this.q),this.online&&(e.online=this.online),this.$router.replace({path:"/devices/"+t,query:e})},doDelaySearch:a.default.debounce(function(){this.doSearch()},800),getDeviceList:function(){var e=this,n=!(arguments.length>0&&void 0!==arguments[0])||arguments[0];if(n)this.loading=!0;else{if(this.bgLoading||this.loading)return;this.bgLoading=!0}t.ajax({method:"GET",url:"/api/v1/device/list",global:n,data:{q:this.q,start:(this.currentPage-1)*this.pageSize,limit:this.pageSize,online:this.online,sort:this.sort,order:this.order,check_catalog_progress:this.checkCatalogProgress}}).then(function(t){e.total=t.DeviceCount,e.devices=t.DeviceList,e.network=!1!==t.DeviceNetwork,e.region=!!t.DeviceRegion}).always(function(){n?e.loading=!1:e.bgLoading=!1})},sortChange:function(t){this.sort=t.prop,this.order="ascending"==t.order?"asc":"desc",this.getDeviceList()},fetchCatalog:function(e){var n=this;if(this.serverInfo&&!this.serverInfo.RemainDays)return void this.$message({type:"error",message:"授权过期"});this.$set(this.catalogMap,e.ID,!0),t.ajax({method:"GET",url:"/api/v1/device/fetchcatalog",global:!1,data:{serial:e.ID}}).then(function(t){n.$message({type:"success",message:"更新通道信息成功"})}).fail(function(t){t&&console.log("fetch catalog ajax error: "+t.status+" "+t.responseText)}).always(function(){n.$delete(n.catalogMap,e.ID)})},download:function(){window.open("/api/v1/device/export")},uploaded:function(){this.$message({type:"success",message:"上传成功！"}),this.getDeviceList(),this.$refs.uploadDlg.hide()},removeBatch:function(){var e=this;this.removeDlg=!1;var n={serial:this.removeID};this.removeUACheck&&this.removeUA?(n.ua=this.removeUA,this.forbidUACheck&&(n.forbid=!0)):this.removeIPCheck&&this.removeIP&&(n.ip=this.removeIP,this.forbidIPCheck&&(n.forbid=!0)),t.post("/api/v1/device/remove",n).always(function(){e.getDeviceList()})},removeDevice:function(e){var n=this;if(!e.Online&&!e.ChannelCount&&"0001-01-01 00:00:00"===e.LastKeepaliveAt&&(e.Manufacturer||e.RemoteIP)&&e.ID&&e.ID.length<10)return this.removeID=e.ID,this.removeName=e.Name,this.removeUA=e.Manufacturer,this.removeIP=e.RemoteIP,this.removeUACheck=!1,this.removeIPCheck=!1,this.forbidUACheck=!1,this.forbidIPCheck=!1,void(this.removeDlg=!0);this.$confirm("确认删除 "+(e.Name||e.ID)+" ?","提示",{lockScroll:!1}).then(function(){t.post("/api/v1/device/remove",{serial:e.ID}).always(function(){n.getDeviceList()})}).catch(function(){})},editDevice:function(t){this.$refs.deviceEditDlg.show({serial:t.ID,name:t.Name,custom_name:t.CustomName,media_transport:t.MediaTransport,media_transport_mode:t.MediaTransportMode,stream_mode:t.StreamMode,recv_stream_ip:t.RecvStreamIP,contact_ip:t.ContactIP,sms_id:t.SMSID,sms_group_id:t.SMSGroupID,charset:t.Charset,catalog_interval:t.CatalogInterval,subscribe_interval:t.SubscribeInterval,catalog_subscribe:t.CatalogSubscribe,alarm_subscribe:t.AlarmSubscribe,position_subscribe:t.PositionSubscribe,ptz_subscribe:t.PTZSubscribe,password:t.Password,record_center:t.RecordCenter,record_indistinct:t.RecordIndistinct,civil_code_first:t.CivilCodeFirst,keep_original_tree:t.KeepOriginalTree,drop_channel_type:t.DropChannelType,longitude:t.Longitude,latitude:t.Latitude})},formatName:function(t,e,n){return n||"-"},formatRemoteIP:function(t,e,n){if(!t.RemoteIP)return"-";var o=t.RemoteIP;return t.RemotePort&&(o=t.RemoteIP+":"+t.RemotePort),t.CommandTransport&&(o=String(t.CommandTransport).toLowerCase()+"://"+o),o},formatTransport:function(t,e,n){var o=String(t.MediaTransport).toUpperCase();return"TCP"==o&&(o+="active"==t.MediaTransportMode?" 主动":" 被动"),o},formatCatalogTitle:function(t){return t.CatalogProgress?"更新中("+t.CatalogProgress+")":this.catalogMap[t.ID]?"更新中...":""},formatCatalogPercent:function(t){if(!t||!t.CatalogProgress)return 0;var e=t.CatalogProgress.split("/");if(2!=e.length)return 0;var n=parseInt(e[1],10);if(!n)return 0;var o=parseInt(e[0],10)||0,r=parseInt(100*o/n,10);return isNaN(r)||r<0?0:r>100?100:r},setMediaTransport:function(e){var n=this,o=e.MediaTransport||e.row.MediaTransport,r=e.MediaTransportMode||e.row.MediaTransportMode;t.post("/api/v1/device/setmediatransport",{serial:e.row.ID,media_transport:o,media_transport_mode:r}).then(function(){n.$set(e.row,"MediaTransport",o),n.$set(e.row,"MediaTransportMode",r)})},showDeviceLog:function(t){this.$refs.deviceLogDlg.show("设备("+t.ID+")",t.ID)},showStatusLog:function(t){this.$refs.deviceLogDlg.showStatusLog("设备("+t.ID+")",t.ID)},showStreamLog:function(t){this.$refs.deviceLogDlg.showStreamLog("设备("+t.ID+")",t.ID)},keyDown:function(t){if(t.altKey&&t.shiftKey)switch(t.key){case"D":t.preventDefault(),this.toggleDebug()}},toggleDebug:function(){this.debug=!this.debug}},beforeRouteEnter:function(t,e,n){n(function(e){e.q=t.query.q||"",e.online=t.query.online||"",e.checkCatalogProgress="yes"===(t.query.checkCatalogProgress||"yes"),e.currentPage=parseInt(t.params.page)||1,e.ready()})},beforeRouteLeave:function(t,e,n){this.timer&&(clearInterval(this.timer),this.timer=0),n()},beforeRouteUpdate:function(t,e,n){var o=this;n(),this.$nextTick(function(){o.q=t.query.q||"",o.online=t.query.online||"",o.checkCatalogProgress="yes"===(t.query.checkCatalogProgress||"yes"),o.currentPage=parseInt(t.params.page)||1,o.devices=[],o.total=0,o.getDeviceList()})}}}).call(e,n("0iPh"))},"9N7t":function(t,e,n){"use strict";var o=function(){var t=this,e=t.$createElement,n=t._self._c||e;return n("div",{},[n("div",{staticClass:"box box-primary"},[n("div",{staticClass:"box-header"},[n("h4",{staticClass:"text-primary text-center"},[t._v("设备录像时间轴("+t._s(t.devid)+"-"+t._s(t.channel)+")")])]),t._v(" "),n("div",{staticClass:"box-body"},[n("div",{staticClass:"form-inline",attrs:{autocomplete:"off",spellcheck:"false"}},[n("div",{staticClass:"form-group form-group-sm"},["no"!=t.$getQueryString("menu")?n("button",{staticClass:"btn btn-sm btn-primary",attrs:{type:"button"},on:{click:function(e){e.preventDefault(),t.$router.go(-1)}}},[n("i",{staticClass:"fa fa-chevron-left"}),t._v(" 返回\r\n                    ")]):t._e(),t._v(" "),t.shareURL?n("span",[t._v("  ")]):t._e(),t._v(" "),t.shareURL?n("a",{staticClass:"btn btn-default btn-sm",attrs:{role:"button",href:t.shareURL,target:"_blank"}},[n("i",{staticClass:"fa fa-share"}),t._v(" 分享页\r\n                    ")]):t._e(),t._v(" "),n("span",[t._v("  ")]),t._v(" "),n("div",{staticClass:"btn-group btn-group-sm",attrs:{role:"group"}},[n("button",{staticClass:"btn btn-default dropdown-toggle",attrs:{id:"input-speed-dropdown",type:"button","data-toggle":"dropdown"}},[t._v("\r\n                            "+t._s(t.speed)+" 倍速 "),n("span",{staticClass:"caret"})]),t._v(" "),n("ul",{staticClass:"dropdown-menu",attrs:{id:"input-speed-menu"}},[n("li",{class:{active:.5==t.speed}},[n("a",{attrs:{role:"button","data-speed":"0.5"}},[t._v("0.5x")])]),t._v(" "),n("li",{class:{active:1==t.speed}},[n("a",{attrs:{role:"button","data-speed":"1"}},[t._v("1x")])]),t._v(" "),n("li",{class:{active:2==t.speed}},[n("a",{attrs:{role:"button","data-speed":"2"}},[t._v("2x")])]),t._v(" "),n("li",{class:{active:4==t.speed}},[n("a",{attrs:{role:"button","data-speed":"4"}},[t._v("4x")])])])]),t._v(" "),!t.isDemoUser(t.serverInfo,t.userInfo)&&t.videos.length>0?n("span",[t._v("  ")]):t._e(),t._v(" "),!t.isDemoUser(t.serverInfo,t.userInfo)&&t.videos.length>0?n("button",{directives:[{name:"loading",rawName:"v-loading.fullscreen.lock",value:t.bDownloading,expression:"bDownloading",modifiers:{fullscreen:!0,lock:!0}}],staticClass:"btn btn-default btn-sm",attrs:{type:"button","element-loading-text":"加载中..."},on:{click:function(e){e.preventDefault(),t.showTimeRangeDlg(e)}}},[n("i",{staticClass:"fa fa-download"}),t._v(" 下载\r\n                    ")]):t._e()]),t._v(" "),n("div",{staticClass:"form-group pull-right"},[n("div",{staticClass:"input-group input-group-sm"},[n("DatePicker",{ref:"datePicker",staticClass:"form-control",attrs:{day:t.day},on:{"update:day":t.updateDay}}),t._v(" "),n("div",{staticClass:"input-group-btn"},[n("button",{staticClass:"btn btn-sm btn-default",attrs:{type:"button"},on:{click:function(e){e.preventDefault(),t.showDatePicker(e)}}},[n("i",{staticClass:"fa fa-calendar"})]),t._v(" "),n("button",{staticClass:"btn btn-default btn-sm",on:{click:function(e){e.preventDefault(),t.toListView(e)}}},[n("i",{staticClass:"fa fa-hand-o-right"}),t._v(" 列表视图\r\n                            ")])])],1)])]),t._v(" "),n("br"),t._v(" "),n("div",{staticClass:"clearfix"}),t._v(" "),n("LivePlayer",{directives:[{name:"loading",rawName:"v-loading",value:t.videoLoading,expression:"videoLoading"}],attrs:{videoUrl:t.videoUrl,muted:!t.bAudioEnable,waterMark:t.osd,live:"",hideLiveText:"",digitalZoom:"",currentTime:t.currentTime,hideBigPlayButton:!!t.serverInfo.HideBigPlayButton,"element-loading-text":"加载中...","element-loading-background":"#000"},on:{media_info:t.onMediaInfo,ended:t.onEnded,error:t.onVideoError,timeupdate:t.onVideoTimeUpdate}}),t._v(" "),t.isDemoUser(t.serverInfo,t.userInfo)&&!t.bOutHevcTip?n("div",{staticClass:"text-center"},[n("br"),t._v("\r\n                提示: 演示系统限制匿名登录播放时间, 若需测试长时间播放, 请"),n("a",{attrs:{target:"_blank",href:"//www.liveqing.com/docs/download/LiveGBS.html"}},[t._v("下载使用")])]):t._e(),t._v(" "),t.bOutHevcTip?n("div",{staticClass:"text-center text-red"},[n("br"),t._v("\r\n                提示: 正在播放 H265 直出流, 确保浏览器版本较新, 并且开启硬件加速\r\n            ")]):t._e(),t._v(" "),n("br"),t._v(" "),n("br"),t._v(" "),n("TimeRule",{directives:[{name:"loading",rawName:"v-loading",value:t.loading,expression:"loading"}],ref:"timeRule",attrs:{videos:t.videos,minutesPerUnit:t.serverInfo.MinutesPerUnit||5},on:{timeChange:t.onTimeChange}}),t._v(" "),n("div",{staticClass:"clearfix"}),t._v(" "),n("br")],1)]),t._v(" "),n("TimeRangeDlg",{ref:"timeRangeDlg",attrs:{format:"HHmmss"},on:{submit:t.onTimeRangeChange}}),t._v(" "),n("PlaybackDownloadDlg",{ref:"playbackDownloadDlg",on:{download:t.onDownload}})],1)},r=[],i={render:o,staticRenderFns:r};e.a=i},AU86:function(t,e,n){"use strict";function o(t){return t&&t.__esModule?t:{default:t}}function r(t){return function(){var e=t.apply(this,arguments);return new Promise(function(t,n){function o(r,i){try{var a=e[r](i),s=a.value}catch(t){return void n(t)}if(!a.done)return Promise.resolve(s).then(function(t){o("next",t)},function(t){o("throw",t)});t(s)}return o("next")})}}Object.defineProperty(e,"__esModule",{value:!0});var i=n("A9K1"),a=o(i),s=n("0iPh"),l=o(s);e.default={data:function(){return{debug:!1,devid:"",start:0,limit:1e4,downloadThisDev:!1}},components:{FormDlg:a.default},beforeDestroy:function(){(0,l.default)(this.$el).off("keydown",this.keyDown)},computed:{showPage:function(){return this.debug||this.start||1e4!=this.limit},downloadURL:function(){return this.downloadThisDev&&this.devid?"/api/v1/channel/export?serial="+this.devid+"&start="+this.start+"&limit="+this.limit:"/api/v1/channel/export?start="+this.start+"&limit="+this.limit}},methods:{onHide:function(){this.debug=!1,this.devid="",this.downloadThisDev=!1,this.start=0,this.limit=1e4,this.$emit("hide"),(0,l.default)(this.$el).off("keydown",this.keyDown)},onShow:function(){this.errors.clear(),this.$emit("show"),(0,l.default)(this.$el).on("keydown",this.keyDown)},keyDown:function(t){if(t.altKey&&t.shiftKey)switch(t.key){case"D":t.preventDefault(),this.toggleDebug()}},toggleDebug:function(){this.debug=!this.debug},onSubmit:function(){var t=this;return r(regeneratorRuntime.mark(function e(){var n,o;return regeneratorRuntime.wrap(function(e){for(;;)switch(e.prev=e.next){case 0:return e.next=2,t.$validator.validateAll();case 2:if(n=e.sent){e.next=8;break}return o=t.errors.items[0],t.$message({type:"error",message:o.msg}),(0,l.default)("[name="+o.field+"]").focus(),e.abrupt("return");case 8:window.open(t.downloadURL,"_blank"),t.$refs.dlg.hide(),t.$emit("submit");case 11:case"end":return e.stop()}},e,t)}))()},show:function(t){var e=this;this.errors.clear(),this.devid=t,this.$nextTick(function(){e.$refs.dlg.show()})}}}},BgxG:function(t,e,n){"use strict";Object.defineProperty(e,"__esModule",{value:!0}),e.default={name:"ElTimelineItem",inject:["timeline"],props:{timestamp:String,hideTimestamp:{type:Boolean,default:!1},placement:{type:String,default:"bottom"},type:String,color:String,size:{type:String,default:"normal"},icon:String}}},DJXc:function(t,e,n){e=t.exports=n("FZ+f")(!1),e.push([t.i,".modal-title[data-v-7fc8ec04]{overflow:hidden;white-space:nowrap;text-overflow:ellipsis}#speed-switcher-download[data-v-7fc8ec04]{margin-right:10px}#speed-switcher-download label[data-v-7fc8ec04]{margin-bottom:0}",""])},DKbk:function(t,e,n){var o=n("DJXc");"string"==typeof o&&(o=[[t.i,o,""]]),o.locals&&(t.exports=o.locals);n("rjj0")("1da98724",o,!0,{})},Dwyu:function(t,e,n){"use strict";function o(t){return t&&t.__esModule?t:{default:t}}function r(t){return function(){var e=t.apply(this,arguments);return new Promise(function(t,n){function o(r,i){try{var a=e[r](i),s=a.value}catch(t){return void n(t)}if(!a.done)return Promise.resolve(s).then(function(t){o("next",t)},function(t){o("throw",t)});t(s)}return o("next")})}}Object.defineProperty(e,"__esModule",{value:!0});var i=n("A9K1"),a=o(i),s=n("0iPh"),l=o(s);e.default={props:{serverInfo:{type:Object,default:function(){}},userInfo:{type:Object,default:function(){return null}}},data:function(){return{form:this.defForm()}},components:{FormDlg:a.default},methods:{defForm:function(){return{serial:"",code:"",name:"",custom:!1,customName:"",customID:"",description:""}},onHide:function(){this.form=this.defForm(),this.$emit("hide")},onShow:function(){this.errors.clear(),this.$emit("show"),this.$el.querySelector("#input-name").focus()},onSubmit:function(){var t=this;return r(regeneratorRuntime.mark(function e(){var n,o;return regeneratorRuntime.wrap(function(e){for(;;)switch(e.prev=e.next){case 0:return e.next=2,t.$validator.validateAll();case 2:if(n=e.sent){e.next=8;break}return o=t.errors.items[0],t.$message({type:"error",message:o.msg}),(0,l.default)("[name="+o.field+"]").focus(),e.abrupt("return");case 8:l.default.post("/api/v1/device/setchannelname",{serial:t.form.serial,code:t.form.code,name:t.form.customName,custom_id:t.form.customID,description:t.form.description}).then(function(e){t.$refs.dlg.hide(),t.$emit("submit")});case 9:case"end":return e.stop()}},e,t)}))()},show:function(t){var e=this;this.errors.clear(),t&&(this.form.serial=t.serial,this.form.code=t.code,this.form.name=t.name,this.form.custom=!!t.custom,this.form.customName=t.customName,this.form.customID=t.customID,this.form.description=t.description,t.custom&&(this.form.customName=t.name,this.form.name="")),this.$nextTick(function(){e.$refs.dlg.show()})}}}},E3p4:function(t,e,n){"use strict";var o=function(){var t=this,e=t.$createElement,n=t._self._c||e;return n("div",{class:["modal",{fade:t.fade}],attrs:{"data-backdrop":"static","data-disable":"false","data-keyboard":"true",tabindex:"-1"}},[n("div",{class:["modal-dialog",t.size]},[n("div",{staticClass:"modal-content"},[n("div",{staticClass:"modal-header"},[t._m(0),t._v(" "),n("h4",{staticClass:"modal-title text-center text-primary"},[n("span",[t._v(t._s(t.title))])])]),t._v(" "),n("div",{staticClass:"modal-body"},[n("el-tabs",{on:{"tab-click":t.tabClick},model:{value:t.tabName,callback:function(e){t.tabName=e},expression:"tabName"}},[n("el-tab-pane",{directives:[{name:"loading",rawName:"v-loading",value:t.bStatusLogLoading,expression:"bStatusLogLoading"}],attrs:{name:"status","element-loading-text":"加载中..."}},[n("span",{attrs:{slot:"label"},slot:"label"},[n("i",{staticClass:"fa fa-list-ul"}),t._v(" 状态记录")]),t._v(" "),t.statusLogs.length>0?n("el-timeline",{staticStyle:{"margin-top":"10px","margin-bottom":"10px","min-height":"200px","max-height":"450px","overflow-y":"auto"}},t._l(t.statusLogs,function(e,o){return n("el-timeline-item",{key:o,attrs:{type:t.statusTimeLineType(e),timestamp:e.CreatedAt}},[t._v("\n                                "+t._s(e.Description)+"\n                            ")])})):n("p",{staticClass:"text-center"},[n("br"),t._v(" "),t.bStatusLogLoading?n("span",[t._v("暂无数据")]):t.statusDays&&t.statusDays>0?n("span",[t._v("最近"+t._s(t.statusDays)+"天没有状态变更记录")]):n("span",[t._v("未找到最近的状态变更记录")])])],1),t._v(" "),n("el-tab-pane",{directives:[{name:"loading",rawName:"v-loading",value:t.bStreamLogLoading,expression:"bStreamLogLoading"}],attrs:{name:"stream","element-loading-text":"加载中..."}},[n("span",{attrs:{slot:"label"},slot:"label"},[n("i",{staticClass:"fa fa-bar-chart"}),t._v(" 流量统计")]),t._v(" "),n("br"),t._v(" "),n("el-radio-group",{attrs:{size:"mini"},on:{change:t.changeStreamLogType},model:{value:t.streamLogType,callback:function(e){t.streamLogType=e},expression:"streamLogType"}},[n("el-radio-button",{attrs:{label:"today"}},[t._v("今天")]),t._v(" "),n("el-radio-button",{attrs:{label:"yesterday"}},[t._v("昨天")]),t._v(" "),n("el-radio-button",{attrs:{label:"week"}},[t._v("最近7天")]),t._v(" "),n("el-radio-button",{attrs:{label:"month"}},[t._v("最近30天")])],1),t._v(" "),n("br"),t._v(" "),n("br"),t._v(" "),n("br"),t._v(" "),n("ve-line",{ref:"streamChart",attrs:{height:"300px",colors:t.chartColors,data:t.streamLogs,extend:t.streamLogExtend,settings:t.streamLogSettings,"legend-position":"bottom","legend-visible":!0,title:{text:"流量使用",left:"center"}}})],1),t._v(" "),n("el-tab-pane",{directives:[{name:"loading",rawName:"v-loading",value:t.bPositionLogLoading,expression:"bPositionLogLoading"}],attrs:{name:"position","element-loading-text":"加载中..."}},[n("span",{attrs:{slot:"label"},slot:"label"},[n("i",{staticClass:"fa fa-location-arrow"}),t._v(" 移动轨迹")]),t._v(" "),t.positionLogs.length>0?n("el-timeline",{staticStyle:{"margin-top":"10px","margin-bottom":"10px","min-height":"200px","max-height":"450px","overflow-y":"auto"}},t._l(t.positionLogs,function(e,o){return n("el-timeline-item",{key:o,attrs:{type:t.positionTimeLineType(e),timestamp:e.CreatedAt}},[t._v("\n                                "+t._s(e.Method)+" "+t._s(e.Longitude)+", "+t._s(e.Latitude)+"\n                            ")])})):n("p",{staticClass:"text-center"},[n("br"),t._v(" "),t.bPositionLogLoading?n("span",[t._v("暂无数据")]):t.positionDays&&t.positionDays>0?n("span",[t._v("最近"+t._s(t.positionDays)+"天没有位置变更记录")]):n("span",[t._v("未找到最近的位置变更记录")])])],1),t._v(" "),t.serial&&!t.code?n("el-tab-pane",{attrs:{name:"session"}},[n("span",{attrs:{slot:"label"},slot:"label"},[n("i",{staticClass:"fa fa-align-left"}),t._v(" 会话列表")]),t._v(" "),n("div",{staticClass:"form-inline"},[n("div",{staticClass:"form-group form-group-sm"},[n("label",[t._v("搜索")]),t._v(" "),n("input",{directives:[{name:"model",rawName:"v-model.trim",value:t.sessionQ,expression:"sessionQ",modifiers:{trim:!0}}],ref:"sessionQ",staticClass:"form-control",attrs:{type:"text",placeholder:"关键字"},domProps:{value:t.sessionQ},on:{keydown:function(e){if(!("button"in e)&&t._k(e.keyCode,"enter",13,e.key))return null;e.preventDefault()},input:function(e){e.target.composing||(t.sessionQ=e.target.value.trim())},blur:function(e){t.$forceUpdate()}}})]),t._v(" "),n("span",{staticClass:"hidden-xs"},[t._v("  ")]),t._v(" "),n("div",{staticClass:"form-group form-group-sm"},[n("label",[t._v("筛选")]),t._v(" "),n("select",{directives:[{name:"model",rawName:"v-model.trim",value:t.sessionFilter,expression:"sessionFilter",modifiers:{trim:!0}}],staticClass:"form-control",on:{change:function(e){var n=Array.prototype.filter.call(e.target.options,function(t){return t.selected}).map(function(t){return"_value"in t?t._value:t.value});t.sessionFilter=e.target.multiple?n:n[0]}}},[n("option",{attrs:{value:""}},[t._v("全部")]),t._v(" "),n("option",{attrs:{value:"stream"}},[t._v("直播")]),t._v(" "),n("option",{attrs:{value:"playback"}},[t._v("回放")]),t._v(" "),n("option",{attrs:{value:"broadcast"}},[t._v("对讲")]),t._v(" "),n("option",{attrs:{value:"playing"}},[t._v("播放")]),t._v(" "),n("option",{attrs:{value:"record"}},[t._v("录像")]),t._v(" "),n("option",{attrs:{value:"cascade"}},[t._v("级联")]),t._v(" "),n("option",{attrs:{value:"udp"}},[t._v("UDP")]),t._v(" "),n("option",{attrs:{value:"tcp"}},[t._v("TCP")]),t._v(" "),n("option",{attrs:{value:"h264"}},[t._v("H264")]),t._v(" "),n("option",{attrs:{value:"hevc"}},[t._v("H265")])])]),t._v(" "),t.sessionNumOutputs&&"playing"==t.sessionFilter?n("span",{staticClass:"hidden-xs"},[t._v("  ")]):t._e(),t._v(" "),t.sessionNumOutputs&&"playing"==t.sessionFilter?n("span",[t._v("在线人数(合计): "+t._s(t.sessionNumOutputs))]):t._e(),t._v(" "),n("div",{staticClass:"form-group form-group-sm pull-right"},[n("div",{staticClass:"btn-group btn-group-sm"},[t.sessions.length&&t.hasAnyRole(t.serverInfo,t.userInfo,"超级管理员")?n("button",{staticClass:"btn btn-danger",attrs:{type:"button"},on:{click:function(e){e.preventDefault(),t.clearSessions(e)}}},[n("i",{staticClass:"fa fa-remove"}),t._v(" 清空")]):t._e(),t._v(" "),n("button",{staticClass:"btn btn-primary",attrs:{type:"button"},on:{click:function(e){e.preventDefault(),t.getSessions(e)}}},[n("i",{staticClass:"fa fa-refresh"}),t._v(" 刷新")])])])]),t._v(" "),n("br"),t._v(" "),"session"==t.tabName?n("el-table",{directives:[{name:"loading",rawName:"v-loading",value:t.sessionLoading,expression:"sessionLoading"}],attrs:{data:t.sessions,stripe:"","default-sort":{prop:"StartAt",order:"descending"},"max-height":400,"element-loading-text":"加载中..."},on:{"sort-change":t.sessionSortChange}},[n("el-table-column",{attrs:{prop:"ChannelID",label:"通道国标编号","min-width":"200","show-overflow-tooltip":"",sortable:"custom"}}),t._v(" "),n("el-table-column",{attrs:{prop:"ChannelName",label:"通道名称","min-width":"120",formatter:t.formatSessionChannelName,"show-overflow-tooltip":""}}),t._v(" "),n("el-table-column",{attrs:{prop:"SessionType",label:"会话类型","min-width":"100"},scopedSlots:t._u([{key:"default",fn:function(e){return[n("span",{attrs:{title:t.formatSessionTypeTitle(e.row)}},[t._v(t._s(t.formatSessionType(e.row)))])]}}])}),t._v(" "),n("el-table-column",{attrs:{prop:"StartAt",label:"开始时间","min-width":"160",sortable:"custom"}}),t._v(" "),n("el-table-column",{attrs:{prop:"Duration",label:"持续时长","min-width":"120",formatter:t.formatSessionDuration,align:"right",sortable:"custom"}}),t._v(" "),n("el-table-column",{attrs:{prop:"InBytes",label:"收流大小","min-width":"120",formatter:t.formatInBytes,align:"right","show-overflow-tooltip":"",sortable:"custom"}}),t._v(" "),n("el-table-column",{attrs:{prop:"InBitRate",label:"收流码率","min-width":"120",formatter:t.formatInBitRate,align:"right","show-overflow-tooltip":"",sortable:"custom"}}),t._v(" "),n("el-table-column",{attrs:{prop:"Transport",label:"流传输","min-width":"100","show-overflow-tooltip":""}}),t._v(" "),n("el-table-column",{attrs:{prop:"SourceVideoCodecName",label:"视频编码","min-width":"100",formatter:t.formatVideoCodec}}),t._v(" "),n("el-table-column",{attrs:{prop:"CloudRecord",label:"云端录像","min-width":"100",formatter:t.formatCloudRecord,align:"center"}}),t._v(" "),n("el-table-column",{attrs:{prop:"NumOutputs",label:"在线人数","min-width":"120",sortable:"custom",fixed:"playing"==t.sessionFilter&&"right"}}),t._v(" "),n("el-table-column",{attrs:{prop:"CascadeSize",label:"级联数","min-width":"100",sortable:"custom"}}),t._v(" "),n("el-table-column",{attrs:{prop:"SMSID",label:"SMS","min-width":"200","show-overflow-tooltip":"",sortable:"custom"}}),t._v(" "),t.hasAnyRole(t.serverInfo,t.userInfo,"超级管理员")?n("el-table-column",{attrs:{label:"操作","min-width":"100","class-name":"opt-group"},scopedSlots:t._u([{key:"default",fn:function(e){return[n("div",{staticClass:"btn-group btn-group-xs"},[n("button",{staticClass:"btn btn-danger",attrs:{type:"button"},on:{click:function(n){n.preventDefault(),t.stopSession(e.row)}}},[n("i",{staticClass:"fa fa-stop"}),t._v(" 停止\n                                        ")])])]}}])}):t._e()],1):t._e(),t._v(" "),t.sessionTotal>0?n("el-pagination",{staticClass:"pull-right",attrs:{layout:"total,prev,pager,next,sizes","pager-count":t.isMobile()?3:5,total:t.sessionTotal,"page-size":t.sessionPageSize,"current-page":t.sessionCurrentPage},on:{"update:pageSize":function(e){t.sessionPageSize=e},"update:currentPage":function(e){t.sessionCurrentPage=e}}}):t._e(),t._v(" "),n("div",{staticClass:"clearfix"})],1):t._e()],1)],1),t._v(" "),"position"==t.tabName&&t.serial&&t.code&&t.positionLogs.length>0&&t.serverInfo.MapInfo&&"标准版"!=t.serverInfo.VersionType?n("div",{staticClass:"modal-footer"},[n("a",{staticClass:"btn btn-primary",attrs:{role:"button",href:"/map.html?serial="+t.serial+"&code="+t.code+"&trace=yes",target:"_blank"}},[n("i",{staticClass:"fa fa-paper-plane"}),t._v(" 地图展示\n                ")])]):t._e()])])])},r=[function(){var t=this,e=t.$createElement,n=t._self._c||e;return n("button",{staticClass:"close",attrs:{type:"button","data-dismiss":"modal","aria-label":"Close"}},[n("span",{attrs:{"aria-hidden":"true"}},[t._v("×")])])}],i={render:o,staticRenderFns:r};e.a=i},"ENv+":function(t,e,n){"use strict";function o(t){n("DKbk")}Object.defineProperty(e,"__esModule",{value:!0});var r=n("lgdx"),i=n.n(r);for(var a in r)["default","default"].indexOf(a)<0&&function(t){n.d(e,t,function(){return r[t]})}(a);var s=n("KsAq"),l=n("VU/8"),u=o,c=l(i.a,s.a,!1,u,"data-v-7fc8ec04",null);e.default=c.exports},G5yr:function(t,e,n){e=t.exports=n("FZ+f")(!1),e.push([t.i,".datepicker{z-index:9999!important}",""])},GB08:function(t,e,n){"use strict";Object.defineProperty(e,"__esModule",{value:!0}),n("F8S1");var o=n("0iPh"),r=function(t){return t&&t.__esModule?t:{default:t}}(o);e.default={props:{size:{type:String,default:""},title:{type:String,default:"选择时间段"},arrow:{type:Boolean,default:!1},format:{type:String,default:"HH:mm:ss"}},data:function(){return{visiable:!1,value:"",vdefault:void 0}},mounted:function(){var t=this;(0,r.default)(this.$el).find(".modal-content").draggable({handle:".modal-header",cancel:".modal-title span",addClasses:!1,containment:"document",delay:100,opacity:.5}),(0,r.default)(this.$el).on("shown.bs.modal",function(){t.visiable=!0,t.$emit("show")}).on("hidden.bs.modal",function(){t.visiable=!1,t.value="",t.$emit("hide")})},methods:{show:function(){(0,r.default)(this.$el).modal("show")},hide:function(){(0,r.default)(this.$el).modal("hide")},setValue:function(t){this.value=t},setDefault:function(t){this.vdefault=t},doSubmit:function(){this.$emit("submit",this.value)}}}},IEpb:function(t,e,n){var o=n("G5yr");"string"==typeof o&&(o=[[t.i,o,""]]),o.locals&&(t.exports=o.locals);n("rjj0")("47dd6f6e",o,!0,{})},"IaS+":function(t,e,n){"use strict";Object.defineProperty(e,"__esModule",{value:!0});var o=n("Dwyu"),r=n.n(o);for(var i in o)["default","default"].indexOf(i)<0&&function(t){n.d(e,t,function(){return o[t]})}(i);var a=n("tHoF"),s=n("VU/8"),l=s(r.a,a.a,!1,null,null,null);e.default=l.exports},IrJl:function(t,e,n){"use strict";var o=function(){var t=this,e=t.$createElement,n=t._self._c||e;return n("div",{staticClass:"time-rule"},[n("div",{ref:"day",staticClass:"time-day",style:{left:t.timeDayX+"px"}},[t._l(1440/t.minutesPerUnit,function(e){return n("div",{key:e,class:["time-minute",t.minuteActiveClass((e-1)*t.minutesPerUnit)],style:{width:t.minutesPerUnit+"px"},attrs:{title:t.minuteTitle((e-1)*t.minutesPerUnit)},on:{click:function(n){n.preventDefault(),t.clickMinute((e-1)*t.minutesPerUnit)}}})}),t._v(" "),t._l(24,function(e){return n("div",{key:e,class:[1==e?"time-text-first":"time-text"]},[t._v(t._s(t.hourText(e-1)))])})],2),t._v(" "),n("div",{ref:"cursor",staticClass:"time-cursor",style:{left:t.timeCursorX+"px"}},[n("div",{staticClass:"time-cursor-text"},[t._v(t._s(t.timeCursorText))])])])},r=[],i={render:o,staticRenderFns:r};e.a=i},JCFA:function(t,e,n){"use strict";var o=function(){var t=this,e=t.$createElement,n=t._self._c||e;return n("div",[n("el-table",{directives:[{name:"loading",rawName:"v-loading",value:t.loading,expression:"loading"}],ref:"presetsTable",attrs:{size:"mini",data:t.presets,stripe:"","max-height":235,"element-loading-text":"获取中..."}},[n("el-table-column",{attrs:{prop:"PresetID",label:"序号","min-width":"40","show-overflow-tooltip":""}}),t._v(" "),n("el-table-column",{attrs:{prop:"PresetName",label:"备注","min-width":"80","show-overflow-tooltip":""},scopedSlots:t._u([{key:"default",fn:function(e){return[e.row.Editing?n("input",{directives:[{name:"focus",rawName:"v-focus"}],staticStyle:{width:"80px",padding:"2px","line-height":"100%"},attrs:{type:"text"},domProps:{value:e.row.PresetName},on:{keydown:[function(n){if(!("button"in n)&&t._k(n.keyCode,"esc",27,n.key))return null;n.stopPropagation(),n.preventDefault(),t.setCustomName(e.row,!1,n)},function(n){if(!("button"in n)&&t._k(n.keyCode,"enter",13,n.key))return null;n.stopPropagation(),n.preventDefault(),t.setCustomName(e.row,!1,n)}],blur:function(n){t.setCustomName(e.row,!1,n)}}}):n("a",{attrs:{role:"button"},on:{click:function(n){n.preventDefault(),t.setCustomName(e.row,!0,n)}}},[t._v(t._s(e.row.PresetName||"-"))])]}}])}),t._v(" "),n("el-table-column",{attrs:{fixed:"right",label:"操作",width:"110"},scopedSlots:t._u([{key:"default",fn:function(e){return[e.row.PresetEnable&&!e.row.Going?n("span",{staticStyle:{cursor:"pointer",color:"#00acd6"},attrs:{title:"调用预置位"},on:{click:function(n){n.preventDefault(),t.gotoPreet(e.row)}}},[t._v("\r\n                    调用\r\n                ")]):e.row.Going?n("span",[n("i",{staticClass:"fa fa-spinner fa-spin"})]):t._e(),t._v(" "),e.row.Setting?n("span",[n("i",{staticClass:"fa fa-spinner fa-spin"})]):n("span",{staticStyle:{cursor:"pointer",color:"#00acd6"},attrs:{title:"设置预置位"},on:{click:function(n){n.preventDefault(),t.setPreset(e.row)}}},[t._v("\r\n                    设置\r\n                ")]),t._v(" "),e.row.PresetEnable?n("span",{style:e.row.Confirm?"cursor:pointer;color:#e08e0b;":"cursor:pointer;color:#dd4b39;",attrs:{title:"删除预置位"},on:{click:function(n){n.preventDefault(),t.removePreset(e.row)}}},[t._v("\r\n                    "+t._s(e.row.Confirm?"确认?":"删除")+"\r\n                ")]):t._e()]}}])})],1)],1)},r=[],i={render:o,staticRenderFns:r};e.a=i},JK3F:function(t,e,n){!function(e,n){t.exports=n()}(0,function(){return function(t){function e(o){if(n[o])return n[o].exports;var r=n[o]={i:o,l:!1,exports:{}};return t[o].call(r.exports,r,r.exports,e),r.l=!0,r.exports}var n={};return e.m=t,e.c=n,e.i=function(t){return t},e.d=function(t,n,o){e.o(t,n)||Object.defineProperty(t,n,{configurable:!1,enumerable:!0,get:o})},e.n=function(t){var n=t&&t.__esModule?function(){return t.default}:function(){return t};return e.d(n,"a",n),n},e.o=function(t,e){return Object.prototype.hasOwnProperty.call(t,e)},e.p="",e(e.s=4)}([function(t,e,n){var o=n(2)(n(1),n(3),null,null,null);o.options.__file="C:\\Users\\Administrator\\Documents\\vue-context-menu\\src\\VueContextMenu.vue",o.esModule&&Object.keys(o.esModule).some(function(t){return"default"!==t&&"__"!==t.substr(0,2)})&&console.error("named exports are not supported in *.vue files."),o.options.functional&&console.error("[vue-loader] VueContextMenu.vue: functional components are not supported with templates, they should use render functions."),t.exports=o.exports},function(t,e,n){"use strict";Object.defineProperty(e,"__esModule",{value:!0}),e.default={name:"context-menu",data:function(){return{triggerShowFn:function(){},triggerHideFn:function(){},x:null,y:null,style:{},binded:!1}},props:{target:null,show:Boolean},mounted:function(){this.bindEvents()},watch:{show:function(t){t?this.bindHideEvents():this.unbindHideEvents()},target:function(t){this.bindEvents()}},methods:{bindEvents:function(){var t=this;this.$nextTick(function(){t.target&&!t.binded&&(t.triggerShowFn=t.contextMenuHandler.bind(t),t.target.addEventListener("contextmenu",t.triggerShowFn),t.binded=!0)})},unbindEvents:function(){this.target&&this.target.removeEventListener("contextmenu",this.triggerShowFn)},bindHideEvents:function(){this.triggerHideFn=this.clickDocumentHandler.bind(this),document.addEventListener("mousedown",this.triggerHideFn),document.addEventListener("mousewheel",this.triggerHideFn)},unbindHideEvents:function(){document.removeEventListener("mousedown",this.triggerHideFn),document.removeEventListener("mousewheel",this.triggerHideFn)},clickDocumentHandler:function(t){this.$emit("update:show",!1)},contextMenuHandler:function(t){this.x=t.clientX,this.y=t.clientY,this.$emit("update:show",!0),t.preventDefault(),this.$nextTick(this.layout)},layout:function(){if(this.y+this.$el.offsetHeight>document.documentElement.clientHeight)return void(this.style={left:this.x+"px",bottom:0});this.style={left:this.x+"px",top:this.y+"px"}}}}},function(t,e){t.exports=function(t,e,n,o,r){var i,a=t=t||{},s=typeof t.default;"object"!==s&&"function"!==s||(i=t,a=t.default);var l="function"==typeof a?a.options:a;e&&(l.render=e.render,l.staticRenderFns=e.staticRenderFns),o&&(l._scopeId=o);var u;if(r?(u=function(t){t=t||this.$vnode&&this.$vnode.ssrContext||this.parent&&this.parent.$vnode&&this.parent.$vnode.ssrContext,t||"undefined"==typeof __VUE_SSR_CONTEXT__||(t=__VUE_SSR_CONTEXT__),n&&n.call(this,t),t&&t._registeredComponents&&t._registeredComponents.add(r)},l._ssrRegister=u):n&&(u=n),u){var c=l.functional,d=c?l.render:l.beforeCreate;c?l.render=function(t,e){return u.call(e),d(t,e)}:l.beforeCreate=d?[].concat(d,u):[u]}return{esModule:i,exports:a,options:l}}},function(t,e,n){t.exports={render:function(){var t=this,e=t.$createElement;return(t._self._c||e)("div",{directives:[{name:"show",rawName:"v-show",value:t.show,expression:"show"}],staticStyle:{display:"block"},style:t.style,on:{mousedown:function(t){t.stopPropagation()},contextmenu:function(t){t.preventDefault()}}},[t._t("default")],2)},staticRenderFns:[]},t.exports.render._withStripped=!0},function(t,e,n){const o=n(0),r={};r.install=function(t,e){t.component(o.name,o)},r.component=o,"undefined"!=typeof window&&window.Vue&&window.Vue.use(r),t.exports=r}])})},JRgq:function(t,e,n){"use strict";(function(t){Object.defineProperty(e,"__esModule",{value:!0}),e.default={props:{serial:{type:String,default:""},code:{type:String,default:""},activeName:{type:String,default:""}},data:function(){return{presets:[],loading:!1}},watch:{activeName:function(t,e){"preset-info"==t&&this.getPresets()}},mounted:function(){},directives:{focus:{inserted:function(t){t.focus(),t.select()}}},methods:{getPresets:function(){var e=this;this.loading=!0,t.ajax({method:"GET",url:"/api/v1/device/fetchpreset",global:!1,data:{serial:this.serial,code:this.code,timeout:5}}).then(function(t){e.presets=t.PresetItemList||[]}).fail(function(t){t&&(console.log("fetchpreset["+e.serial+":"+e.code+"] ajax error: "+t.status+" "+t.responseText),401==t.status&&e.$nextTick(function(){location.href="/login?r="+encodeURIComponent(window.location.href)}))}).always(function(){e.$nextTick(function(){e.loading=!1})})},setCustomName:function(e,n,o){var r=this,i=e.Editing;if(this.$set(e,"Editing",n),i&&!n){var a=o.target.value.trim();e.PresetName!=a&&t.post("/api/v1/device/setpresetname",{serial:this.serial,code:this.code,preset:e.PresetID,name:a}).always(function(){r.getPresets()})}},setPreset:function(e){var n=this;this.$set(e,"Setting",!0),t.post("/api/v1/control/preset",{serial:this.serial,code:this.code,command:"set",preset:e.PresetID,name:e.PresetName}).always(function(){n.$delete(e,"Setting"),n.getPresets()})},removePreset:function(e){var n=this;e.Confirm?t.post("/api/v1/control/preset",{serial:this.serial,code:this.code,command:"remove",preset:e.PresetID}).always(function(){n.$delete(e,"Confirm"),n.getPresets()}):(this.$set(e,"Confirm",!0),setTimeout(function(){n.$delete(e,"Confirm")},3e3))},gotoPreet:function(e){var n=this;this.$set(e,"Going",!0),t.post("/api/v1/control/preset",{serial:this.serial,code:this.code,command:"goto",preset:e.PresetID}).always(function(){n.$delete(e,"Going")})}}}}).call(e,n("0iPh"))},JT0P:function(t,e,n){"use strict";var o=function(){var t=this,e=t.$createElement,n=t._self._c||e;return n("div",[n("div",{staticClass:"box box-primary"},[n("div",{staticClass:"box-header"},[n("h4",{staticClass:"text-primary text-center"},[t._v("通道列表("+t._s(t.name||t.devid)),t.dir_serial?n("span",[t._v(":"+t._s(t.dir_serial))]):t._e(),t._v(")")])]),t._v(" "),n("div",{staticClass:"box-body"},[n("div",{staticClass:"form-inline",attrs:{autocomplete:"off",spellcheck:"false"}},["no"!=t.$getQueryString("menu")?n("div",{staticClass:"form-group form-group-sm"},[n("button",{staticClass:"btn btn-sm btn-primary",attrs:{type:"button"},on:{click:function(e){e.preventDefault(),t.$router.go(-1)}}},[n("i",{staticClass:"fa fa-chevron-left"}),t._v(" 返回\r\n                    ")])]):t._e(),t._v(" "),n("span",{staticClass:"hidden-xs"},[t._v("  ")]),t._v(" "),n("div",{staticClass:"form-group form-group-sm"},[n("label",[t._v("搜索")]),t._v(" "),!t.isMobile()&&t.hasAnyRole(t.serverInfo,t.userInfo,"超级管理员")?n("div",{staticClass:"input-group input-group-sm"},[n("input",{directives:[{name:"model",rawName:"v-model.trim",value:t.q,expression:"q",modifiers:{trim:!0}}],ref:"q",staticClass:"form-control",attrs:{type:"text",placeholder:"关键字"},domProps:{value:t.q},on:{keydown:function(e){if(!("button"in e)&&t._k(e.keyCode,"enter",13,e.key))return null;e.preventDefault()},input:function(e){e.target.composing||(t.q=e.target.value.trim())},blur:function(e){t.$forceUpdate()}}}),t._v(" "),n("div",{staticClass:"input-group-btn"},[n("button",{staticClass:"btn btn-sm btn-primary",attrs:{type:"button",title:"导出通道列表"},on:{click:function(e){e.preventDefault(),t.$refs.downloadDlg.show(t.devid)}}},[n("i",{staticClass:"fa fa-download"})]),t._v(" "),n("button",{staticClass:"btn btn-sm btn-primary",attrs:{type:"button",title:"导入通道列表"},on:{click:function(e){e.preventDefault(),t.$refs.uploadDlg.show()}}},[n("i",{staticClass:"fa fa-upload"})])])]):n("input",{directives:[{name:"model",rawName:"v-model.trim",value:t.q,expression:"q",modifiers:{trim:!0}}],ref:"q",staticClass:"form-control",attrs:{type:"text",placeholder:"关键字"},domProps:{value:t.q},on:{keydown:function(e){if(!("button"in e)&&t._k(e.keyCode,"enter",13,e.key))return null;e.preventDefault()},input:function(e){e.target.composing||(t.q=e.target.value.trim())},blur:function(e){t.$forceUpdate()}}})]),t._v(" "),n("span",{staticClass:"hidden-xs"},[t._v("  ")]),t._v(" "),n("div",{staticClass:"form-group form-group-sm"},[n("label",[t._v("通道类型")]),t._v(" "),n("select",{directives:[{name:"model",rawName:"v-model.trim",value:t.channel_type,expression:"channel_type",modifiers:{trim:!0}}],staticClass:"form-control",on:{change:function(e){var n=Array.prototype.filter.call(e.target.options,function(t){return t.selected}).map(function(t){return"_value"in t?t._value:t.value});t.channel_type=e.target.multiple?n:n[0]}}},[n("option",{attrs:{value:""}},[t._v("全部")]),t._v(" "),n("option",{attrs:{value:"device"}},[t._v("设备")]),t._v(" "),n("option",{attrs:{value:"dir"}},[t._v("子目录")])])]),t._v(" "),n("span",{staticClass:"hidden-xs"},[t._v("  ")]),t._v(" "),n("div",{staticClass:"form-group form-group-sm"},[n("label",[t._v("在线状态")]),t._v(" "),n("select",{directives:[{name:"model",rawName:"v-model.trim",value:t.online,expression:"online",modifiers:{trim:!0}}],staticClass:"form-control",on:{change:function(e){var n=Array.prototype.filter.call(e.target.options,function(t){return t.selected}).map(function(t){return"_value"in t?t._value:t.value});t.online=e.target.multiple?n:n[0]}}},[n("option",{attrs:{value:""}},[t._v("全部")]),t._v(" "),n("option",{attrs:{value:"true"}},[t._v("在线")]),t._v(" "),n("option",{attrs:{value:"false"}},[t._v("离线")])])])]),t._v(" "),n("br"),t._v(" "),n("el-table",{directives:[{name:"loading",rawName:"v-loading",value:t.loading,expression:"loading"}],attrs:{data:t.channels,stripe:"","default-sort":{prop:"Channel",order:"ascending"},"element-loading-text":"加载中..."},on:{"sort-change":t.sortChange}},[n("el-table-column",{attrs:{prop:"Channel",label:"通道号","min-width":"100","show-overflow-tooltip":"",sortable:"custom"}}),t._v(" "),n("el-table-column",{attrs:{label:"操作","min-width":"240",fixed:!t.isMobile()&&"right","class-name":"opt-group"},scopedSlots:t._u([{key:"default",fn:function(e){return[n("div",{staticClass:"btn-group btn-group-xs"},[t.canPlay(e.row)?n("button",{staticClass:"btn btn-primary",attrs:{type:"button",disabled:e.row.Locked},on:{click:function(n){n.preventDefault(),t.playStream(e.row)}}},[n("i",{staticClass:"fa fa-play-circle"}),t._v(" 播放\r\n                            ")]):t._e(),t._v(" "),t.canPlay(e.row)&&(e.row.Shared||!1===t.serverInfo.APIAuth||t.serverInfo.GlobalChannelShared||t.isDemoUser(t.serverInfo,t.userInfo))?n("el-dropdown",{staticClass:"btn btn-primary",staticStyle:{"padding-left":"1px"}},[n("i",{staticClass:"el-icon-arrow-down el-icon--right",staticStyle:{color:"white"}}),t._v(" "),n("el-dropdown-menu",{attrs:{slot:"dropdown"},slot:"dropdown"},[n("el-dropdown-item",[n("a",{attrs:{href:"/play.html?serial="+e.row.DeviceID+"&code="+e.row.ID,target:"_blank"}},[n("i",{staticClass:"fa fa-share"}),t._v(" 分享页\r\n                                        ")])])],1)],1):t._e(),t._v(" "),t.canDecode(e.row)?n("button",{staticClass:"btn btn-primary",attrs:{type:"button",disabled:e.row.Locked},on:{click:function(n){n.preventDefault(),t.decodeStart(e.row)}}},[n("i",{staticClass:"fa fa-play-circle"}),t._v(" 解码\r\n                            ")]):t._e(),t._v(" "),e.row.StreamID&&t.hasAnyRole(t.serverInfo,t.userInfo,"管理员")?n("button",{staticClass:"btn btn-danger",attrs:{type:"button"},on:{click:function(n){n.preventDefault(),t.stopStream(e.row)}}},[n("i",{staticClass:"fa fa-stop"}),t._v(" 停止\r\n                            ")]):t._e(),t._v(" "),t.canPlayback(e.row)?n("router-link",{staticClass:"btn btn-info",attrs:{to:"/devices/playback/timebox/"+t.devid+"/"+e.row.ID}},[n("i",{staticClass:"fa fa-file-video-o"}),t._v(" 设备录像\r\n                            ")]):t._e(),t._v(" "),t.canPlayback(e.row)&&t.canCloudRecord(e.row)?n("el-dropdown",{staticClass:"btn btn-info",staticStyle:{"padding-left":"1px"}},[n("i",{staticClass:"el-icon-arrow-down el-icon--right",staticStyle:{color:"white"}}),t._v(" "),n("el-dropdown-menu",{attrs:{slot:"dropdown"},slot:"dropdown"},[n("el-dropdown-item",[n("router-link",{attrs:{to:"/cloudrecord/timeview/"+t.devid+"/"+e.row.ID}},[n("i",{staticClass:"fa fa-file-video-o"}),t._v(" 云端录像\r\n                                        ")])],1)],1)],1):!e.row.DeviceOnline&&t.canCloudRecord(e.row)?n("router-link",{staticClass:"btn btn-info",attrs:{to:"/cloudrecord/timeview/"+t.devid+"/"+e.row.ID}},[n("i",{staticClass:"fa fa-file-video-o"}),t._v(" 云端录像\r\n                            ")]):t._e(),t._v(" "),t.isDir(e.row)?n("router-link",{staticClass:"btn btn-info",attrs:{to:"/devices/channels/"+t.devid+"/1?dir_serial="+e.row.ID,exact:""}},[n("i",{staticClass:"fa fa-info"}),t._v(" 查看子目录\r\n                            ")]):t._e(),t._v(" "),t.canFetchCatalog(e.row)&&t.hasAnyRole(t.serverInfo,t.userInfo,"管理员")?n("button",{staticClass:"btn btn-primary",attrs:{type:"button",disabled:t.catalogMap[t.devid+":"+e.row.ID],title:t.formatCatalogTitle(e.row)},on:{click:function(n){n.preventDefault(),t.fetchCatalog(e.row)}}},[n("i",{class:["fa","fa-refresh",{"fa-spin":t.catalogMap[t.devid+":"+e.row.ID]}]}),t._v(" 更新通道\r\n                            ")]):t._e(),t._v(" "),"virtual"==e.row.Manufacturer&&t.hasAnyRole(t.serverInfo,t.userInfo,"超级管理员")?n("button",{staticClass:"btn btn-danger",attrs:{type:"button"},on:{click:function(n){n.preventDefault(),t.removeVirtual(e.row)}}},[n("i",{staticClass:"fa fa-remove"}),t._v(" 删除\r\n                            ")]):t._e()],1)]}}])}),t._v(" "),n("el-table-column",{attrs:{prop:"ID",label:"通道国标编号","min-width":"200","show-overflow-tooltip":"",sortable:"custom"}}),t._v(" "),n("el-table-column",{attrs:{prop:"Name",label:"通道名称","min-width":"120","show-overflow-tooltip":""},scopedSlots:t._u([{key:"default",fn:function(e){return[t.hasAnyRole(t.serverInfo,t.userInfo,"管理员")?n("a",{class:{"text-orange":!!e.row.CustomName},attrs:{role:"button",title:e.row.CustomName?e.row.Name||"-":""},on:{click:function(n){n.preventDefault(),t.editChannelName(e.row)}}},[t._v("\r\n                            "+t._s(e.row.CustomName||e.row.Name||"-")+"\r\n                        ")]):n("span",[t._v("\r\n                            "+t._s(e.row.CustomName||e.row.Name||"-")+"\r\n                        ")])]}}])}),t._v(" "),n("el-table-column",{attrs:{"min-width":"100",label:"快照"},scopedSlots:t._u([{key:"default",fn:function(e){return[t.isDir(e.row)?n("span",[n("i",{staticClass:"fa fa-sitemap"})]):n("el-popover",{attrs:{"open-delay":1e3,"close-delay":10,placement:"left",title:e.row.ID,width:"400",trigger:"hover"}},[n("img",{staticStyle:{width:"100%",height:"100%"},attrs:{onerror:"this.src='/images/default_snap.png';",src:e.row.SnapURL}}),t._v(" "),n("img",{staticStyle:{height:"30px",width:"50px"},attrs:{slot:"reference",onerror:"this.src='/images/default_snap.png';",src:e.row.SnapURL},slot:"reference"})])]}}])}),t._v(" "),n("el-table-column",{attrs:{prop:"Status",label:"在线状态","min-width":"100"},scopedSlots:t._u([{key:"default",fn:function(e){return[t.isDir(e.row)?n("span",[t._v("-")]):t.hasAnyRole(t.serverInfo,t.userInfo,"管理员")?n("span",[n("a",{class:[{"text-success":"ON"==e.row.Status,"text-orange":!!e.row.CustomStatus,"text-gray":"ON"!=(e.row.CustomStatus||e.row.Status)}],attrs:{role:"button",title:"点击查看通道状态记录"},on:{click:function(n){n.preventDefault(),t.showStatusLog(e.row)}}},[t._v("\r\n                                "+t._s("ON"==(e.row.CustomStatus||e.row.Status)?"在线":"离线")+"\r\n                            ")]),t._v(" "),n("a",{class:{"text-success":"ON"==e.row.Status,"text-orange":!!e.row.CustomStatus,"text-gray":"ON"!=(e.row.CustomStatus||e.row.Status)},attrs:{role:"button",title:"点击查看通道流量统计"},on:{click:function(n){n.preventDefault(),t.showStreamLog(e.row)}}},[n("i",{staticClass:"fa fa-bar-chart"})])]):"ON"==(e.row.CustomStatus||e.row.Status)?n("span",{class:[{"text-success":"ON"==e.row.Status,"text-orange":!!e.row.CustomStatus}]},[t._v("\r\n                            在线\r\n                        ")]):n("span",{staticClass:"text-gray"},[t._v("\r\n                            离线\r\n                        ")])]}}])}),t._v(" "),!t.serverInfo.GlobalChannelAudio&&t.hasAnyRole(t.serverInfo,t.userInfo,"管理员")?n("el-table-column",{attrs:{prop:"AudioEnable",label:"开启音频","min-width":"100"},scopedSlots:t._u([{key:"default",fn:function(e){return[n("el-switch",{attrs:{value:e.row.AudioEnable,disabled:t.isDir(e.row)||"Decode"==e.row.DeviceType},on:{change:function(n){t.toggleAudio(e.row)}}})]}}])}):t._e(),t._v(" "),t.hasAnyRole(t.serverInfo,t.userInfo,"管理员")?n("el-table-column",{attrs:{prop:"Ondemand",label:"按需直播","min-width":"100"},scopedSlots:t._u([{key:"default",fn:function(e){return[n("el-switch",{attrs:{value:e.row.Ondemand,disabled:t.isDir(e.row)||"Decode"==e.row.DeviceType},on:{change:function(n){t.toggleOndemand(e.row)}}})]}}])}):t._e(),t._v(" "),t.hasAnyRole(t.serverInfo,t.userInfo,"管理员")&&"标准版"!=t.serverInfo.VersionType?n("el-table-column",{attrs:{prop:"CloudRecord",label:"云端录像","min-width":"100"},scopedSlots:t._u([{key:"default",fn:function(e){return[n("el-switch",{attrs:{value:e.row.CloudRecord,disabled:t.isDir(e.row)||"Decode"==e.row.DeviceType},on:{change:function(n){t.toggleCloudRecord(e.row)}}})]}}])}):t._e(),t._v(" "),!t.serverInfo.GlobalChannelShared&&t.hasAnyRole(t.serverInfo,t.userInfo,"管理员")?n("el-table-column",{attrs:{prop:"Shared",label:"开启分享","min-width":"100"},scopedSlots:t._u([{key:"default",fn:function(e){return[n("el-switch",{attrs:{value:e.row.Shared,disabled:t.isDir(e.row)||"Decode"==e.row.DeviceType},on:{change:function(n){t.toggleShared(e.row)}}})]}}])}):t._e(),t._v(" "),t.hasAnyRole(t.serverInfo,t.userInfo,"管理员")?n("el-table-column",{attrs:{prop:"NumOutputs",label:"在线人数","min-width":"100"}}):t._e(),t._v(" "),t.hasAnyRole(t.serverInfo,t.userInfo,"管理员")&&t.hasAllChannel(t.serverInfo,t.userInfo)?n("el-table-column",{attrs:{prop:"SubCount",label:"子节点数","min-width":"100"}}):t._e(),t._v(" "),n("el-table-column",{attrs:{prop:"Manufacturer",label:"厂家","min-width":"120","show-overflow-tooltip":""},scopedSlots:t._u([{key:"default",fn:function(e){return[n("span",{class:{"text-orange":!!e.row.CustomManufacturer}},[t._v("\r\n                            "+t._s(e.row.CustomManufacturer||e.row.Manufacturer||"-")+"\r\n                        ")])]}}])}),t._v(" "),t.hasAnyRole(t.serverInfo,t.userInfo,"管理员","操作员")?n("el-table-column",{attrs:{prop:"PTZType",label:"云台类型","min-width":"140"},scopedSlots:t._u([{key:"default",fn:function(e){return[t.hasAnyRole(t.serverInfo,t.userInfo,"管理员")?n("el-dropdown",{attrs:{size:"small",trigger:"click"},on:{command:t.setPTZType}},[n("span",{class:["el-dropdown-link",{"text-orange":e.row.CustomPTZType}]},[t._v("\r\n                                "+t._s(t.formatPTZType(e.row))+" "),n("i",{staticClass:"el-icon-arrow-down el-icon--right"})]),t._v(" "),n("el-dropdown-menu",{attrs:{slot:"dropdown"},slot:"dropdown"},[n("el-dropdown-item",{attrs:{command:{row:e.row,PTZType:0}}},[t._v("0-未知")]),t._v(" "),n("el-dropdown-item",{attrs:{command:{row:e.row,PTZType:1}}},[t._v("1-球机")]),t._v(" "),n("el-dropdown-item",{attrs:{command:{row:e.row,PTZType:2}}},[t._v("2-半球机")]),t._v(" "),n("el-dropdown-item",{attrs:{command:{row:e.row,PTZType:3}}},[t._v("3-固定枪机")]),t._v(" "),n("el-dropdown-item",{attrs:{command:{row:e.row,PTZType:4}}},[t._v("4-遥控枪机")])],1)],1):n("span",{class:{"text-orange":e.row.CustumPTZType}},[t._v(t._s(t.formatPTZType(e.row)))])]}}])}):t._e(),t._v(" "),n("el-table-column",{attrs:{prop:"Longitude",label:"位置","min-width":"180","show-overflow-tooltip":""},scopedSlots:t._u([{key:"default",fn:function(e){return[t.hasAnyRole(t.serverInfo,t.userInfo,"管理员")?n("a",{class:{"text-orange":e.row.CustomLongitude||e.row.CustomLatitude},attrs:{role:"button"},on:{click:function(n){n.preventDefault(),t.editChannelPosition(e.row)}}},[t._v("\r\n                            "+t._s(t.formatPosition(e.row))+"\r\n                        ")]):n("span",{class:{"text-orange":e.row.CustomLongitude||e.row.CustomLatitude}},[t._v(t._s(t.formatPosition(e.row)))])]}}])}),t._v(" "),t.hasAnyRole(t.serverInfo,t.userInfo,"管理员")?n("el-table-column",{attrs:{prop:"UpdatedAt",label:"更新时间","min-width":"160",sortable:"custom"}}):t._e(),t._v(" "),t.hasAnyRole(t.serverInfo,t.userInfo,"管理员")?n("el-table-column",{attrs:{prop:"CreatedAt",label:"创建时间","min-width":"160",sortable:"custom"}}):t._e()],1)],1),t._v(" "),t.total>0?n("div",{staticClass:"box-footer"},[n("el-pagination",{staticClass:"pull-right",attrs:{layout:"total,prev,pager,next","pager-count":t.isMobile()?3:5,total:t.total,"page-size":t.pageSize,"current-page":t.currentPage},on:{"update:pageSize":function(e){t.pageSize=e},"update:currentPage":function(e){t.currentPage=e}}})],1):t._e()]),t._v(" "),n("ChannelExportDlg",{ref:"downloadDlg"}),t._v(" "),n("UploadDlg",{ref:"uploadDlg",attrs:{title:"上传通道列表",url:t.uploadURL},on:{uploaded:t.uploaded,hide:function(e){t.uploadThisDev=!1}}},[n("template",{attrs:{slot:"header"},slot:"header"},[t.devid?n("div",{staticClass:"checkbox text-center"},[n("el-checkbox",{staticStyle:{"margin-left":"-19px","margin-top":"-5px"},model:{value:t.uploadThisDev,callback:function(e){t.uploadThisDev="string"==typeof e?e.trim():e},expression:"uploadThisDev"}},[t._v("\r\n                    只导入当前设备("+t._s(t.devid)+")的通道\r\n                ")]),t._v(" "),n("br"),t._v(" "),n("br")],1):t._e()])],2),t._v(" "),n("ChannelNameEditDlg",{ref:"channelNameEditDlg",attrs:{serverInfo:t.serverInfo,userInfo:t.userInfo},on:{submit:t.getChannels}}),t._v(" "),n("ChannelPositionEditDlg",{ref:"channelPositionEditDlg",on:{submit:t.getChannels}}),t._v(" "),n("VideoDlg",{ref:"videoDlg",attrs:{serverInfo:t.serverInfo,userInfo:t.userInfo}}),t._v(" "),n("DeviceLogDlg",{ref:"deviceLogDlg",attrs:{serverInfo:t.serverInfo,userInfo:t.userInfo}}),t._v(" "),n("DecodeChannelListDlg",{ref:"decodeChannelListDlg",attrs:{serverInfo:t.serverInfo,userInfo:t.userInfo},on:{selected:t.decodeStream}})],1)},r=[],i={render:o,staticRenderFns:r};e.a=i},JkFl:function(t,e,n){"use strict";Object.defineProperty(e,"__esModule",{value:!0});var o=n("BgxG"),r=n.n(o);for(var i in o)["default","default"].indexOf(i)<0&&function(t){n.d(e,t,function(){return o[t]})}(i);var a=n("x5s2"),s=n("VU/8"),l=s(r.a,a.a,!1,null,null,null);e.default=l.exports},KBJn:function(t,e,n){var o=n("Ynuf");"string"==typeof o&&(o=[[t.i,o,""]]),o.locals&&(t.exports=o.locals);n("rjj0")("0dc1678c",o,!0,{})},KToh:function(t,e,n){"use strict";Object.defineProperty(e,"__esModule",{value:!0});var o=n("tviW"),r=n.n(o);for(var i in o)["default","default"].indexOf(i)<0&&function(t){n.d(e,t,function(){return o[t]})}(i);var a=n("wPWL"),s=n("VU/8"),l=s(r.a,a.a,!1,null,null,null);e.default=l.exports},KsAq:function(t,e,n){"use strict";var o=function(){var t=this,e=t.$createElement,n=t._self._c||e;return n("div",{class:["modal",{fade:t.fade}],attrs:{"data-keyboard":"true","data-backdrop":"static",tabindex:"-1"}},[n("div",{staticClass:"modal-dialog modal-md"},[n("div",{staticClass:"modal-content"},[n("div",{staticClass:"modal-header"},[t._m(0),t._v(" "),n("h4",{staticClass:"modal-title text-primary text-center"},[n("span",[t._v(t._s(t.title))])])]),t._v(" "),n("div",{staticClass:"modal-body"},[n("el-progress",{attrs:{"text-inside":!0,"stroke-width":18,percentage:t.progress}}),t._v(" "),t.fileError?n("p",{staticClass:"text-red text-center"},[n("br"),t._v(" "),n("span",{staticClass:"text-bold"},[t._v("异常终止提示：")]),t._v("\n                        "+t._s(t.fileError)+"\n                    ")]):t._e()],1),t._v(" "),n("div",{staticClass:"modal-footer"},[n("span",{directives:[{name:"show",rawName:"v-show",value:t.inBitRate,expression:"inBitRate"}]},[t._v(t._s(t.inBitRate)+"Kbps  ")]),t._v(" "),n("el-radio-group",{directives:[{name:"show",rawName:"v-show",value:t.fileUrl,expression:"fileUrl"}],attrs:{id:"speed-switcher-download",size:"small"},on:{change:t.scale},model:{value:t.speed,callback:function(e){t.speed=t._n(e)},expression:"speed"}},[n("el-radio-button",{attrs:{label:"0.5"}},[t._v("0.5x")]),t._v(" "),n("el-radio-button",{attrs:{label:"1"}},[t._v("1x")]),t._v(" "),n("el-radio-button",{attrs:{label:"2"}},[t._v("2x")]),t._v(" "),n("el-radio-button",{attrs:{label:"4"}},[t._v("4x")])],1),t._v(" "),n("button",{directives:[{name:"show",rawName:"v-show",value:t.fileUrl,expression:"fileUrl"}],staticClass:"btn btn-primary",attrs:{type:"button"},on:{click:function(e){e.preventDefault(),t.hide(e)}}},[t._v("下载")])],1)])])])},r=[function(){var t=this,e=t.$createElement,n=t._self._c||e;return n("button",{staticClass:"close",attrs:{type:"button","data-dismiss":"modal","aria-label":"Close"}},[n("span",{attrs:{"aria-hidden":"true"}},[t._v("×")])])}],i={render:o,staticRenderFns:r};e.a=i},LbQQ:function(t,e,n){var o=n("rF1v");"string"==typeof o&&(o=[[t.i,o,""]]),o.locals&&(t.exports=o.locals);n("rjj0")("080cae9a",o,!0,{})},MR46:function(t,e,n){"use strict";function o(t){n("12k0")}Object.defineProperty(e,"__esModule",{value:!0});var r=n("1XML"),i=n.n(r);for(var a in r)["default","default"].indexOf(a)<0&&function(t){n.d(e,t,function(){return r[t]})}(a);var s=n("E3p4"),l=n("VU/8"),u=o,c=l(i.a,s.a,!1,u,"data-v-6f9217a2",null);e.default=c.exports},OObi:function(t,e,n){"use strict";Object.defineProperty(e,"__esModule",{value:!0}),e.default={name:"ElTimeline",props:{reverse:{type:Boolean,default:!1}},provide:function(){return{timeline:this}},render:function(){var t=arguments[0],e=this.reverse,n={"el-timeline":!0,"is-reverse":e},o=this.$slots.default||[];return e&&(o=o.reverse()),t("ul",{class:n},[o])}}},RAAF:function(t,e,n){"use strict";var o=function(){var t=this,e=t.$createElement,n=t._self._c||e;return n("div",[n("div",{staticClass:"box box-primary"},[t._m(0),t._v(" "),n("div",{staticClass:"box-body"},[n("div",{staticClass:"form-inline",attrs:{autocomplete:"off",spellcheck:"false"}},[n("div",{staticClass:"form-group form-group-sm"},[n("label",[t._v("搜索")]),t._v(" "),!t.isMobile()&&t.hasAnyRole(t.serverInfo,t.userInfo,"超级管理员")?n("div",{staticClass:"input-group input-group-sm"},[n("input",{directives:[{name:"model",rawName:"v-model.trim",value:t.q,expression:"q",modifiers:{trim:!0}}],ref:"q",staticClass:"form-control",attrs:{type:"text",placeholder:"关键字"},domProps:{value:t.q},on:{keydown:function(e){if(!("button"in e)&&t._k(e.keyCode,"enter",13,e.key))return null;e.preventDefault()},input:function(e){e.target.composing||(t.q=e.target.value.trim())},blur:function(e){t.$forceUpdate()}}}),t._v(" "),n("div",{staticClass:"input-group-btn"},[n("button",{staticClass:"btn btn-sm btn-primary",attrs:{type:"button",title:"导出设备列表"},on:{click:function(e){e.preventDefault(),t.download(e)}}},[n("i",{staticClass:"fa fa-download"})]),t._v(" "),n("button",{staticClass:"btn btn-sm btn-primary",attrs:{type:"button",title:"导入设备列表"},on:{click:function(e){e.preventDefault(),t.$refs.uploadDlg.show()}}},[n("i",{staticClass:"fa fa-upload"})])])]):n("input",{directives:[{name:"model",rawName:"v-model.trim",value:t.q,expression:"q",modifiers:{trim:!0}}],ref:"q",staticClass:"form-control",attrs:{type:"text",placeholder:"关键字"},domProps:{value:t.q},on:{keydown:function(e){if(!("button"in e)&&t._k(e.keyCode,"enter",13,e.key))return null;e.preventDefault()},input:function(e){e.target.composing||(t.q=e.target.value.trim())},blur:function(e){t.$forceUpdate()}}})]),t._v(" "),n("span",{staticClass:"hidden-xs"},[t._v("  ")]),t._v(" "),n("div",{staticClass:"form-group form-group-sm"},[n("label",[t._v("在线状态")]),t._v(" "),n("select",{directives:[{name:"model",rawName:"v-model.trim",value:t.online,expression:"online",modifiers:{trim:!0}}],staticClass:"form-control",on:{change:function(e){var n=Array.prototype.filter.call(e.target.options,function(t){return t.selected}).map(function(t){return"_value"in t?t._value:t.value});t.online=e.target.multiple?n:n[0]}}},[n("option",{attrs:{value:""}},[t._v("全部")]),t._v(" "),n("option",{attrs:{value:"true"}},[t._v("在线")]),t._v(" "),n("option",{attrs:{value:"false"}},[t._v("离线")])])])]),t._v(" "),n("br"),t._v(" "),n("div",{staticClass:"clearfix"}),t._v(" "),n("el-table",{directives:[{name:"loading",rawName:"v-loading",value:t.loading,expression:"loading"}],attrs:{data:t.devices,stripe:"","default-sort":{prop:"ID",order:"ascending"},"element-loading-text":"加载中..."},on:{"sort-change":t.sortChange}},[n("el-table-column",{attrs:{prop:"ID",label:"设备国标编号","min-width":"200",sortable:"custom","show-overflow-tooltip":""}}),t._v(" "),n("el-table-column",{attrs:{label:"操作","min-width":t.hasAnyRole(t.serverInfo,t.userInfo,"管理员")?240:120,fixed:!t.isMobile()&&"right"},scopedSlots:t._u([{key:"default",fn:function(e){return[n("div",{staticClass:"btn-group btn-group-xs"},[n("router-link",{staticClass:"btn btn-info",attrs:{to:"/devices/channels/"+e.row.ID+"/1"}},[n("i",{staticClass:"fa fa-info"}),t._v(" 查看通道\n                                ")]),t._v(" "),e.row.Online&&t.hasAnyRole(t.serverInfo,t.userInfo,"管理员")?n("button",{staticClass:"btn btn-primary",attrs:{type:"button",disabled:(t.catalogMap[e.row.ID]||e.row.CatalogProgress)&&!t.debug,title:t.formatCatalogTitle(e.row)},on:{click:function(n){n.preventDefault(),t.fetchCatalog(e.row)}}},[n("i",{class:["fa","fa-refresh",{"fa-spin":t.catalogMap[e.row.ID]||e.row.CatalogProgress}]}),t._v(" 更新通道\n                                ")]):t._e(),t._v(" "),t.hasAnyRole(t.serverInfo,t.userInfo,"管理员")?n("button",{staticClass:"btn btn-warning",attrs:{type:"button"},on:{click:function(n){n.preventDefault(),t.editDevice(e.row)}}},[n("i",{staticClass:"fa fa-edit"}),t._v(" 编辑\n                                ")]):t._e(),t._v(" "),!e.row.Online&&t.hasAnyRole(t.serverInfo,t.userInfo,"管理员")?n("button",{staticClass:"btn btn-danger",attrs:{type:"button"},on:{click:function(n){n.preventDefault(),t.removeDevice(e.row)}}},[n("i",{staticClass:"fa fa-remove"}),t._v(" 删除\n                                ")]):t._e()],1)]}}])}),t._v(" "),n("el-table-column",{attrs:{prop:"Name",label:"名称","min-width":"140","show-overflow-tooltip":""},scopedSlots:t._u([{key:"default",fn:function(e){return[n("span",{class:{"text-orange":!!e.row.CustomName},attrs:{title:e.row.CustomName?e.row.Name||"-":""}},[t._v("\n\t\t\t\t\t\t\t\t"+t._s(e.row.CustomName||e.row.Name||"-")+"\n\t\t\t\t\t\t\t")])]}}])}),t._v(" "),t.hasAnyRole(t.serverInfo,t.userInfo,"管理员")?n("el-table-column",{attrs:{prop:"MediaTransport",label:"流传输模式","min-width":"140"},scopedSlots:t._u([{key:"default",fn:function(e){return[t.hasAnyRole(t.serverInfo,t.userInfo,"管理员")?n("el-dropdown",{attrs:{size:"small",trigger:"click"},on:{command:t.setMediaTransport}},[n("span",{staticClass:"el-dropdown-link"},[t._v("\n\t\t\t\t\t\t\t\t\t"+t._s(t.formatTransport(e.row))+" "),n("i",{staticClass:"el-icon-arrow-down el-icon--right"})]),t._v(" "),n("el-dropdown-menu",{attrs:{slot:"dropdown"},slot:"dropdown"},[n("el-dropdown-item",{attrs:{command:{row:e.row,MediaTransport:"UDP",MediaTransportMode:"passive"}}},[t._v("UDP")]),t._v(" "),n("el-dropdown-item",{attrs:{command:{row:e.row,MediaTransport:"TCP",MediaTransportMode:"passive"}}},[t._v("TCP 被动")]),t._v(" "),n("el-dropdown-item",{attrs:{command:{row:e.row,MediaTransport:"TCP",MediaTransportMode:"active"}}},[t._v("TCP 主动")])],1)],1):n("span",[t._v(t._s(t.formatTransport(e.row)))])]}}])}):t._e(),t._v(" "),t.hasAnyRole(t.serverInfo,t.userInfo,"管理员")&&t.hasAllChannel(t.serverInfo,t.userInfo)?n("el-table-column",{attrs:{prop:"ChannelCount",label:"通道数","min-width":"120",sortable:"custom","show-overflow-tooltip":""},scopedSlots:t._u([{key:"default",fn:function(e){return[e.row.ChannelOverLoad&&e.row.Online?n("span",{staticClass:"text-red"},[t._v("\n\t\t\t\t\t\t\t\t"+t._s(e.row.ChannelCount)+" (授权满)\n\t\t\t\t\t\t\t")]):e.row.CatalogProgress&&2==e.row.CatalogProgress.split("/").length?n("span",[t._v(t._s(e.row.ChannelCount)+"\n\t\t\t\t\t\t\t\t"),n("el-progress",{attrs:{percentage:t.formatCatalogPercent(e.row),"text-inside":"","stroke-width":14,title:t.formatCatalogTitle(e.row)}})],1):n("span",[t._v(t._s(e.row.ChannelCount))])]}}])}):t._e(),t._v(" "),n("el-table-column",{attrs:{prop:"Online",label:"在线状态","min-width":"100"},scopedSlots:t._u([{key:"default",fn:function(e){return[t.hasAnyRole(t.serverInfo,t.userInfo,"管理员")?n("span",[n("a",{class:[{"text-success":e.row.Online,"text-gray":!e.row.Online}],attrs:{role:"button",title:"点击查看设备状态记录"},on:{click:function(n){n.preventDefault(),t.showStatusLog(e.row)}}},[t._v("\n\t\t\t\t\t\t\t\t\t"+t._s(e.row.Online?"在线":"离线")+"\n\t\t\t\t\t\t\t\t")]),t._v(" "),n("a",{class:[{"text-success":e.row.Online,"text-gray":!e.row.Online}],attrs:{role:"button",title:"点击查看设备流量统计"},on:{click:function(n){n.preventDefault(),t.showStreamLog(e.row)}}},[n("i",{staticClass:"fa fa-bar-chart"})])]):e.row.Online?n("span",{staticClass:"text-success"},[t._v("\n\t\t\t\t\t\t\t\t在线\n\t\t\t\t\t\t\t")]):n("span",{staticClass:"text-gray"},[t._v("\n\t\t\t\t\t\t\t\t离线\n\t\t\t\t\t\t\t")])]}}])}),t._v(" "),t.hasAnyRole(t.serverInfo,t.userInfo,"管理员")&&t.network?n("el-table-column",{attrs:{prop:"RemoteIP",label:"出口 IP","min-width":"210",formatter:t.formatRemoteIP,"show-overflow-tooltip":""}}):t._e(),t._v(" "),t.hasAnyRole(t.serverInfo,t.userInfo,"管理员")&&t.region?n("el-table-column",{attrs:{prop:"RemoteRegion",label:"所在地区","min-width":"150","show-overflow-tooltip":""}}):t._e(),t._v(" "),n("el-table-column",{attrs:{prop:"Manufacturer",label:"厂家","min-width":"150","show-overflow-tooltip":""},scopedSlots:t._u([{key:"default",fn:function(e){return[n("span",[t._v(t._s(e.row.Manufacturer||"-"))]),e.row.GBVer?n("span",[t._v(", GB"+t._s(e.row.GBVer))]):t._e(),t._v(" "),"Decode"==e.row.Type?n("span",{staticClass:"badge"},[t._v("解码器")]):t._e()]}}])}),t._v(" "),t.hasAnyRole(t.serverInfo,t.userInfo,"管理员")?n("el-table-column",{attrs:{prop:"LastKeepaliveAt",label:"最近心跳","min-width":"160",sortable:"custom"}}):t._e(),t._v(" "),t.hasAnyRole(t.serverInfo,t.userInfo,"管理员")?n("el-table-column",{attrs:{prop:"LastRegisterAt",label:"最近注册","min-width":"160",sortable:"custom"}}):t._e(),t._v(" "),t.hasAnyRole(t.serverInfo,t.userInfo,"管理员")?n("el-table-column",{attrs:{prop:"UpdatedAt",label:"更新时间","min-width":"160",sortable:"custom"}}):t._e(),t._v(" "),t.hasAnyRole(t.serverInfo,t.userInfo,"管理员")?n("el-table-column",{attrs:{prop:"CreatedAt",label:"创建时间","min-width":"160",sortable:"custom"}}):t._e()],1)],1),t._v(" "),t.total>0?n("div",{staticClass:"box-footer"},[n("el-pagination",{staticClass:"pull-right",attrs:{layout:"total,prev,pager,next","pager-count":t.isMobile()?3:5,total:t.total,"page-size":t.pageSize,"current-page":t.currentPage},on:{"update:pageSize":function(e){t.pageSize=e},"update:currentPage":function(e){t.currentPage=e}}})],1):t._e()]),t._v(" "),t.serverInfo.DemoUser?n("div",{staticClass:"alert text-center"},[t._m(1)]):t._e(),t._v(" "),n("UploadDlg",{ref:"uploadDlg",attrs:{title:"上传设备列表",url:"/api/v1/device/import"},on:{uploaded:t.uploaded}}),t._v(" "),n("DeviceEditDlg",{ref:"deviceEditDlg",attrs:{useSeparateDevicePassword:!0===t.serverInfo.UseSeparateDevicePassword},on:{submit:t.getDeviceList}}),t._v(" "),n("DeviceLogDlg",{ref:"deviceLogDlg",attrs:{serverInfo:t.serverInfo,userInfo:t.userInfo}}),t._v(" "),n("el-dialog",{attrs:{title:"提示",visible:t.removeDlg,"lock-scroll":!1},on:{"update:visible":function(e){t.removeDlg=e}}},[n("p",[t._v("确认删除 "+t._s(t.removeName||t.removeID)+" ?")]),t._v(" "),n("br"),t._v(" "),t.removeUA?n("div",{staticClass:"checkbox"},[n("el-checkbox",{staticStyle:{"margin-left":"-19px","margin-top":"-5px"},model:{value:t.removeUACheck,callback:function(e){t.removeUACheck="string"==typeof e?e.trim():e},expression:"removeUACheck"}},[t._v("\n                    批量删除 "),n("strong",[t._v(t._s(t.removeUA))])]),t._v(" "),n("br"),n("br"),t._v(" "),n("el-checkbox",{directives:[{name:"show",rawName:"v-show",value:t.removeUACheck,expression:"removeUACheck"}],staticStyle:{"margin-left":"-19px","margin-top":"-5px"},model:{value:t.forbidUACheck,callback:function(e){t.forbidUACheck="string"==typeof e?e.trim():e},expression:"forbidUACheck"}},[t._v("\n                    禁止 "),n("strong",[t._v(t._s(t.removeUA))]),t._v(" 接入\n\t\t\t\t")])],1):t.removeIP?n("div",{staticClass:"checkbox"},[n("el-checkbox",{staticStyle:{"margin-left":"-19px","margin-top":"-5px"},model:{value:t.removeIPCheck,callback:function(e){t.removeIPCheck="string"==typeof e?e.trim():e},expression:"removeIPCheck"}},[t._v("\n                    批量删除 "),n("strong",[t._v(t._s(t.removeIP))])]),t._v(" "),n("br"),n("br"),t._v(" "),n("el-checkbox",{directives:[{name:"show",rawName:"v-show",value:t.removeIPCheck,expression:"removeIPCheck"}],staticStyle:{"margin-left":"-19px","margin-top":"-5px"},model:{value:t.forbidIPCheck,callback:function(e){t.forbidIPCheck="string"==typeof e?e.trim():e},expression:"forbidIPCheck"}},[t._v("\n                    禁止 "),n("strong",[t._v(t._s(t.removeIP))]),t._v(" 接入\n\t\t\t\t")])],1):t._e(),t._v(" "),n("span",{staticClass:"dialog-footer",attrs:{slot:"footer"},slot:"footer"},[n("el-button",{attrs:{size:"small"},on:{click:function(e){t.removeDlg=!1}}},[t._v("取 消")]),t._v(" "),n("el-button",{attrs:{type:"primary",size:"small"},on:{click:t.removeBatch}},[t._v("确 定")])],1)])],1)},r=[function(){var t=this,e=t.$createElement,n=t._self._c||e;return n("div",{staticClass:"box-header"},[n("h4",{staticClass:"text-primary text-center"},[t._v("设备列表")])])},function(){var t=this,e=t.$createElement,n=t._self._c||e;return n("small",[n("strong",[n("i",{staticClass:"fa fa-info-circle"}),t._v(" 提示 : ")]),t._v('\n                可向 Redis 订阅 device 消息以获取设备实时状态 > SUBSCRIBE device; 消息内容为 "设备国标编号 ON/OFF"\n\t\t\t')])}],i={render:o,staticRenderFns:r};e.a=i},"RE+l":function(t,e,n){var o=n("jPLH");"string"==typeof o&&(o=[[t.i,o,""]]),o.locals&&(t.exports=o.locals);n("rjj0")("56eb6553",o,!0,{})},RUmD:function(t,e,n){"use strict";function o(t){return t&&t.__esModule?t:{default:t}}function r(t){return function(){var e=t.apply(this,arguments);return new Promise(function(t,n){function o(r,i){try{var a=e[r](i),s=a.value}catch(t){return void n(t)}if(!a.done)return Promise.resolve(s).then(function(t){o("next",t)},function(t){o("throw",t)});t(s)}return o("next")})}}Object.defineProperty(e,"__esModule",{value:!0});var i=n("A9K1"),a=o(i),s=n("0iPh"),l=o(s);e.default={props:{serverInfo:{type:Object,default:function(){}},userInfo:{type:Object,default:function(){return null}}},data:function(){return{bImport:!1,bLoading:!1,devices:[],form:this.defForm()}},components:{FormDlg:a.default},computed:{title:function(){return this.form.add?this.bImport?"导入设备":this.form.custom?"新建分组":"新建节点":this.form.custom?"编辑分组":"编辑名称"},labelHeader:function(){return this.form.add?"分组":this.form.custom?"分组":this.form.serial&&!this.form.code?"设备":this.form.serial&&this.form.code?"通道":"节点"},codePlaceholder:function(){return this.form.add?!this.form.serial||this.form.serial.length<4?"1~20位数字":"1~20位数字, 推荐使用行政编号, 如: "+this.form.serial.substring(0,4):""}},methods:{defForm:function(){return{id:"",serial:"",code:"",name:"",custom_id:"",custom_name:"",parent_id:"",parent_name:"根节点",custom:!1,add:!1,virtual:!1,import_serial:"",new_code:""}},onHide:function(){this.form=this.defForm(),this.bLoading=!1,this.bImport=!1,this.devices=[],this.$emit("hide")},onShow:function(){this.errors.clear(),this.$emit("show"),"编辑名称"==this.title&&this.$el.querySelector("#input-name").focus()},onSubmit:function(){var t=this;return r(regeneratorRuntime.mark(function e(){var n,o;return regeneratorRuntime.wrap(function(e){for(;;)switch(e.prev=e.next){case 0:return e.next=2,t.$validator.validateAll();case 2:if(n=e.sent){e.next=8;break}return o=t.errors.items[0],t.$message({type:"error",message:o.msg}),(0,l.default)("[name="+o.field+"]").focus(),e.abrupt("return");case 8:t.form.virtual&&t.form.add?l.default.post("/api/v1/channel/virtual/add",{serial:t.form.serial,code:t.form.code,name:t.form.custom_name,parent_id:t.form.parent_id}).then(function(e){t.$refs.dlg.hide(),t.$emit("submit")}):l.default.post("/api/v1/channel/save",{serial:t.form.serial,code:t.form.code,name:t.form.custom_name,parent_id:t.form.parent_id,add:t.form.add,import_serial:t.form.import_serial,new_code:t.form.new_code,custom_id:t.form.custom_id}).then(function(e){t.$refs.dlg.hide(),t.$emit("submit")});case 9:case"end":return e.stop()}},e,t)}))()},show:function(t){var e=this;this.errors.clear(),t&&(this.bImport=!!t.import,this.form.add=!!t.add,this.form.id=t.id,this.form.serial=t.serial,this.form.code=t.code,this.form.name=t.name,this.form.custom_id=t.customID,this.form.custom_name=t.customName,this.form.custom=!!t.custom,t.custom&&(this.form.new_code=t.code,this.form.custom_name=t.name,this.form.custom_id="",this.form.name=""),t.parent&&(this.form.parent_id=t.parent.code,this.form.parent_name=t.parent.customName||t.parent.name||t.parent.id,this.form.virtual="virtual"===t.parent.manufacturer)),this.bImport&&this.loadDevice(),this.$nextTick(function(){e.$refs.dlg.show()})},loadDevice:function(t){var e=this;this.bLoading=!0,l.default.get("/api/v1/device/list",{q:t,limit:10}).then(function(t){e.devices=t.DeviceList||[]}).always(function(){e.$nextTick(function(){e.bLoading=!1})})},changeDevice:function(t){var e=this;t?(this.form.code=t,20==t.length&&"216"!=t.substring(10,13)&&(this.form.code=t.substring(0,10)+"216"+t.substring(13)),this.form.import_serial=t,this.$nextTick(function(){e.form.custom_name=e.$refs.selectDevice.selectedLabel})):(this.form.code="",this.form.custom_name="",this.form.import_serial="")},clearDevice:function(){this.form.code="",this.form.custom_name="",this.form.import_serial="",this.loadDevice()}}}},S4Bz:function(t,e,n){"use strict";function o(t){n("aIgG")}Object.defineProperty(e,"__esModule",{value:!0});var r=n("GB08"),i=n.n(r);for(var a in r)["default","default"].indexOf(a)<0&&function(t){n.d(e,t,function(){return r[t]})}(a);var s=n("znKA"),l=n("VU/8"),u=o,c=l(i.a,s.a,!1,u,"data-v-65020dbb",null);e.default=c.exports},SHtI:function(t,e,n){"use strict";var o=function(){var t=this,e=t.$createElement,n=t._self._c||e;return n("div",{class:["modal",{fade:t.fade}],attrs:{"data-keyboard":"true","data-backdrop":"static",tabindex:"-1"}},[n("div",{staticClass:"modal-dialog modal-lg"},[n("div",{staticClass:"modal-content"},[n("div",{staticClass:"modal-header"},[t._m(0),t._v(" "),n("h4",{staticClass:"modal-title text-primary text-center"},[n("span",[t._v(t._s(t.videoTitle))])])]),t._v(" "),n("div",{staticClass:"modal-body"},[t.bShow?n("LivePlayer",{directives:[{name:"loading",rawName:"v-loading",value:t.bLoading,expression:"bLoading"}],attrs:{videoUrl:t.videoUrl,muted:!t.bAudioEnable,waterMark:t.osd,digitalZoom:t.digitalZoom,hideLiveText:"",live:"",snapUrl:t.snapUrl,hideBigPlayButton:!!t.serverInfo.HideBigPlayButton,loading:t.bLoading,"element-loading-text":"加载中...","element-loading-background":"#000"},on:{media_info:t.onMediaInfo,ended:t.onEnded,error:t.onError,message:t.$message,"update:loading":function(e){t.bLoading=e}}}):t._e(),t._v(" "),t.isDemoUser(t.serverInfo,t.userInfo)&&!t.bOutHevcTip?n("div",{staticClass:"text-center"},[n("br"),t._v("\r\n                    提示: 演示系统限制匿名登录播放时间, 若需测试长时间播放, 请"),n("a",{attrs:{target:"_blank",href:"//www.liveqing.com/docs/download/LiveGBS.html"}},[t._v("下载使用")])]):t._e(),t._v(" "),t.bOutHevcTip?n("div",{staticClass:"text-center text-red"},[n("br"),t._v("\r\n                    提示: 正在播放 H265 直出流, 确保浏览器版本较新, 并且开启硬件加速\r\n                ")]):t._e()],1),t._v(" "),n("div",{staticClass:"modal-footer"},[n("span",{directives:[{name:"show",rawName:"v-show",value:t.inBitRate,expression:"inBitRate"}]},[t._v(t._s(t.inBitRate)+"Kbps  ")]),t._v(" "),n("el-radio-group",{directives:[{name:"show",rawName:"v-show",value:t.streamID,expression:"streamID"}],attrs:{id:"speed-switcher-playback",size:"small"},on:{change:t.scale},model:{value:t.speed,callback:function(e){t.speed=t._n(e)},expression:"speed"}},[n("el-radio-button",{attrs:{label:"0.5"}},[t._v("0.5x")]),t._v(" "),n("el-radio-button",{attrs:{label:"1"}},[t._v("1x")]),t._v(" "),n("el-radio-button",{attrs:{label:"2"}},[t._v("2x")]),t._v(" "),n("el-radio-button",{attrs:{label:"4"}},[t._v("4x")])],1),t._v(" "),n("button",{staticClass:"btn btn-default",attrs:{type:"button","data-dismiss":"modal"}},[t._v("关闭")])],1)])])])},r=[function(){var t=this,e=t.$createElement,n=t._self._c||e;return n("button",{staticClass:"close",attrs:{type:"button","data-dismiss":"modal","aria-label":"Close"}},[n("span",{attrs:{"aria-hidden":"true"}},[t._v("×")])])}],i={render:o,staticRenderFns:r};e.a=i},SLv4:function(t,e,n){"use strict";function o(t){return t&&t.__esModule?t:{default:t}}Object.defineProperty(e,"__esModule",{value:!0});var r=n("M4fF"),i=o(r),a=n("0iPh"),s=o(a);n("F8S1"),e.default={props:{title:{default:"选择解码视频源"},size:{type:String,default:"modal-lgg"},fade:{type:Boolean,default:!1},serverInfo:{type:Object,default:function(){}},userInfo:{type:Object,default:function(){return null}}},data:function(){return{q:"",online:"true",total:0,pageSize:10,currentPage:1,sort:"",order:"",loading:!1,channels:[],dstRow:null}},watch:{q:function(t,e){this.doDelaySearch()},online:function(t,e){this.doSearch()},currentPage:function(t,e){this.doSearch(t)},pageSize:function(t,e){this.doSearch()}},mounted:function(){var t=this;(0,s.default)(this.$el).find(".modal-content").draggable({handle:".modal-header",cancel:".modal-title span",addClasses:!1,containment:"document",delay:100,opacity:.5}),(0,s.default)(this.$el).on("shown.bs.modal",function(){t.$emit("show")}).on("hidden.bs.modal",function(){t.errors.clear(),t.$emit("hide")})},methods:{sortChange:function(t){this.sort=t.prop,this.order="ascending"==t.order?"asc":"desc",this.getChannels()},doSearch:function(){var t=arguments.length>0&&void 0!==arguments[0]?arguments[0]:1;this.currentPage=t,this.getChannels()},doDelaySearch:i.default.debounce(function(){this.doSearch()},800),formatName:function(t,e,n){var o=t.DeviceCustomName||t.DeviceName||"",r=t.CustomName||t.Name||"";return o&&o!=r&&(r=r?r+"@"+o:o),r||"-"},formatDeviceName:function(t,e,n){return t.DeviceCustomName||t.DeviceName||"-"},formatChannelName:function(t,e,n){return t.CustomName||t.Name||"-"},formatManufacturer:function(t,e,n){return n||"-"},getChannels:function(){var t=this;this.loading=!0,s.default.get("/api/v1/device/channellist",{q:this.q,start:(this.currentPage-1)*this.pageSize,limit:this.pageSize,channel_type:"device",online:this.online,sort:this.sort,order:this.order}).then(function(e){t.total=e.ChannelCount,t.channels=e.ChannelList||[]}).always(function(){t.$nextTick(function(){t.loading=!1})})},rowClick:function(t,e,n){"ON"===(t.CustomStatus||t.Status)&&(this.serverInfo.StrictChannelParental&&1==t.Parental||this.serverInfo.StrictChannelCode&&20!=t.ID.length||(this.$emit("selected",this.dstRow,t),this.hide()))},rowDblclick:function(t,e,n){this.serverInfo.StrictChannelParental&&1==t.Parental||this.serverInfo.StrictChannelCode&&20!=t.ID.length||(this.$emit("selected",this.dstRow,t),this.hide())},rowStyle:function(t){var e=t.row;t.rowIndex;return"ON"===(e.CustomStatus||e.Status)?this.serverInfo.StrictChannelParental&&1==e.Parental?"":this.serverInfo.StrictChannelCode&&20!=e.ID.length?"":"cursor:pointer":""},isDir:function(t){if(t){if(t.SubCount>0)return!0;if(this.serverInfo.StrictChannelParental&&1==t.Parental)return!0;if(this.serverInfo.StrictChannelCode&&t.ID.length<=10)return!0;if(1==t.Parental&&"LiveQing"==t.Manufacturer)return!0;if(20==t.ID.length&&("216"==t.ID.substring(10,13)||"215"==t.ID.substring(10,13)))return!0}return!1},reset:function(){this.dstRow=null,this.channels=[],this.q="",this.online="true",this.currentPage=1,this.pageSize=10},show:function(t){this.dstRow=t,(0,s.default)(this.$el).modal("show"),this.getChannels()},hide:function(){(0,s.default)(this.$el).modal("hide")}}}},TTNq:function(t,e,n){"use strict";Object.defineProperty(e,"__esModule",{value:!0});var o=n("OObi"),r=n.n(o);for(var i in o)["default","default"].indexOf(i)<0&&function(t){n.d(e,t,function(){return o[t]})}(i);var a=n("VU/8"),s=a(r.a,null,!1,null,null,null);e.default=s.exports},VMXz:function(t,e,n){"use strict";Object.defineProperty(e,"__esModule",{value:!0});var o=n("AU86"),r=n.n(o);for(var i in o)["default","default"].indexOf(i)<0&&function(t){n.d(e,t,function(){return o[t]})}(i);var a=n("hxIF"),s=n("VU/8"),l=s(r.a,a.a,!1,null,null,null);e.default=l.exports},WEd6:function(t,e,n){"use strict";function o(t){n("IEpb")}Object.defineProperty(e,"__esModule",{value:!0});var r=n("49HP"),i=n.n(r);for(var a in r)["default","default"].indexOf(a)<0&&function(t){n.d(e,t,function(){return r[t]})}(a);var s=n("u/lO"),l=n("VU/8"),u=o,c=l(i.a,s.a,!1,u,null,null);e.default=c.exports},WGgy:function(t,e,n){"use strict";var o=function(){var t=this,e=t.$createElement,n=t._self._c||e;return n("div",{class:["modal",{fade:t.fade}],attrs:{"data-backdrop":"static","data-disable":"false","data-keyboard":"true",tabindex:"-1"}},[n("div",{class:["modal-dialog",t.size]},[n("div",{staticClass:"modal-content"},[n("div",{staticClass:"modal-header"},[t._m(0),t._v(" "),n("h4",{staticClass:"modal-title text-center text-primary"},[n("span",[t._v(t._s(t.title))])])]),t._v(" "),n("div",{staticClass:"modal-body"},[n("div",{staticClass:"form-inline",attrs:{autocomplete:"off",spellcheck:"false"}},[n("div",{staticClass:"form-group form-group-sm"},[n("label",[t._v("搜索")]),t._v(" "),n("input",{directives:[{name:"model",rawName:"v-model.trim",value:t.q,expression:"q",modifiers:{trim:!0}}],ref:"q",staticClass:"form-control",attrs:{type:"text",placeholder:"关键字"},domProps:{value:t.q},on:{keydown:function(e){if(!("button"in e)&&t._k(e.keyCode,"enter",13,e.key))return null;e.preventDefault()},input:function(e){e.target.composing||(t.q=e.target.value.trim())},blur:function(e){t.$forceUpdate()}}})]),t._v(" "),n("span",{staticClass:"hidden-xs"},[t._v("  ")]),t._v(" "),n("div",{staticClass:"form-group form-group-sm"},[n("label",[t._v("在线状态")]),t._v(" "),n("select",{directives:[{name:"model",rawName:"v-model.trim",value:t.online,expression:"online",modifiers:{trim:!0}}],staticClass:"form-control",on:{change:function(e){var n=Array.prototype.filter.call(e.target.options,function(t){return t.selected}).map(function(t){return"_value"in t?t._value:t.value});t.online=e.target.multiple?n:n[0]}}},[n("option",{attrs:{value:""}},[t._v("全部")]),t._v(" "),n("option",{attrs:{value:"true"}},[t._v("在线")]),t._v(" "),n("option",{attrs:{value:"false"}},[t._v("离线")])])])]),t._v(" "),n("br"),t._v(" "),n("el-table",{directives:[{name:"loading",rawName:"v-loading",value:t.loading,expression:"loading"}],ref:"channelTable",attrs:{data:t.channels,stripe:"","max-height":500,"row-style":t.rowStyle,"element-loading-text":"加载中..."},on:{"sort-change":t.sortChange,"row-click":t.rowClick,"row-dblclick":t.rowDblclick}},[n("el-table-column",{attrs:{prop:"DeviceID",label:"设备国标编号","min-width":"200","show-overflow-tooltip":"",sortable:"custom"}}),t._v(" "),n("el-table-column",{attrs:{prop:"ID",label:"通道国标编号","min-width":"200","show-overflow-tooltip":"",sortable:"custom"}}),t._v(" "),n("el-table-column",{attrs:{prop:"Name",label:"通道名称","min-width":"160",formatter:t.formatName,"show-overflow-tooltip":""}}),t._v(" "),n("el-table-column",{attrs:{"min-width":"100",label:"快照"},scopedSlots:t._u([{key:"default",fn:function(e){return[t.isDir(e.row)?n("span",[n("i",{staticClass:"fa fa-sitemap"})]):n("el-popover",{attrs:{"open-delay":1e3,"close-delay":10,placement:"left",title:e.row.ID,width:"400",trigger:"hover"}},[n("img",{staticStyle:{width:"100%",height:"100%"},attrs:{onerror:"this.src='/images/default_snap.png';",src:e.row.SnapURL}}),t._v(" "),n("img",{staticStyle:{height:"30px",width:"50px"},attrs:{slot:"reference",onerror:"this.src='/images/default_snap.png';",src:e.row.SnapURL},slot:"reference"})])]}}])}),t._v(" "),n("el-table-column",{attrs:{prop:"Status",label:"在线状态","min-width":"100"},scopedSlots:t._u([{key:"default",fn:function(e){return[t.isDir(e.row)?n("span",[t._v("-")]):"ON"==(e.row.CustomStatus||e.row.Status)?n("span",{class:{"text-success":"ON"==e.row.Status,"text-orange":!!e.row.CustomStatus}},[t._v("在线")]):n("span",{class:{"text-orange":!!e.row.CustomStatus}},[t._v("离线")])]}}])}),t._v(" "),n("el-table-column",{attrs:{prop:"Manufacturer",label:"厂家","min-width":"120",formatter:t.formatManufacturer,"show-overflow-tooltip":""}})],1),t._v(" "),t.total>0?n("el-pagination",{staticClass:"pull-right",attrs:{layout:"total,prev,pager,next","pager-count":t.isMobile()?3:5,total:t.total,"page-size":t.pageSize,"current-page":t.currentPage},on:{"update:pageSize":function(e){t.pageSize=e},"update:currentPage":function(e){t.currentPage=e}}}):t._e(),t._v(" "),n("div",{staticClass:"clearfix"})],1)])])])},r=[function(){var t=this,e=t.$createElement,n=t._self._c||e;return n("button",{staticClass:"close",attrs:{type:"button","data-dismiss":"modal","aria-label":"Close"}},[n("span",{attrs:{"aria-hidden":"true"}},[t._v("×")])])}],i={render:o,staticRenderFns:r};e.a=i},"Xtk+":function(t,e,n){"use strict";function o(t){n("Y6/U")}Object.defineProperty(e,"__esModule",{value:!0});var r=n("toVl"),i=n.n(r);for(var a in r)["default","default"].indexOf(a)<0&&function(t){n.d(e,t,function(){return r[t]})}(a);var s=n("9N7t"),l=n("VU/8"),u=o,c=l(i.a,s.a,!1,u,"data-v-793c4316",null);e.default=c.exports},Xv6o:function(t,e,n){"use strict";(function(t){Object.defineProperty(e,"__esModule",{value:!0});var o=n("PJh5"),r=function(t){return t&&t.__esModule?t:{default:t}}(o);e.default={data:function(){return{timeCursorX:0,timeDayX:0,bMoving:!1}},props:{minutesPerUnit:{default:5,type:Number},videos:{default:function(){return[]}},start:{default:0,type:Number},end:{default:0,type:Number}},mounted:function(){function e(e){var n=t(i).data("originPageX"),o=e.pageX-n;s.timeCursorX=t(i).position().left+o,t(i).data("originPageX",e.pageX)}function n(e){var n=e.originalEvent.targetTouches[0],o=t(i).data("originPageX"),r=n.pageX-o;s.timeCursorX=t(i).position().left+r,t(i).data("originPageX",n.pageX)}function o(e){var n=t(a).data("originPageX"),o=e.pageX-n;s.timeDayX=t(a).position().left+o,t(a).data("originPageX",e.pageX)}function r(e){var n=e.originalEvent.targetTouches[0],o=t(a).data("originPageX"),r=n.pageX-o;s.timeDayX=t(a).position().left+r,t(a).data("originPageX",n.pageX)}var i=this.$refs.cursor,a=this.$refs.day,s=(this.$el,this);t(i).on("mousedown",function(n){t(i).data("originPageX",n.pageX),s.bMoving=!0,t(document).on("mousemove",e).one("mouseup",function(n){t(document).off("mousemove",e),t(i).removeData("originPageX"),s.triggerTimeChange(),s.bMoving=!1})}).on("touchstart",function(e){var o=e.originalEvent.targetTouches[0];t(i).data("originPageX",o.pageX),s.bMoving=!0,t(document).on("touchmove",n).one("touchend",function(e){t(document).off("touchmove",n),t(i).removeData("originPageX"),s.triggerTimeChange(),s.bMoving=!1})}),t(a).on("mousedown",function(e){if(t(e.target).hasClass("time-minute"))return!1;t(a).data("originPageX",e.pageX),s.bMoving=!0,t(document).on("mousemove",o).one("mouseup",function(e){t(document).off("mousemove",o),t(a).removeData("originPageX"),s.triggerTimeChange(),s.bMoving=!1})}).on("touchstart",function(e){if(t(e.target).hasClass("time-minute"))return!1;var n=e.originalEvent.targetTouches[0];t(a).data("originPageX",n.pageX),s.bMoving=!0,t(document).on("touchmove",r).one("touchend",function(e){t(document).off("touchmove",r),t(a).removeData("originPageX"),s.triggerTimeChange(),s.bMoving=!1})})},watch:{videos:function(t){this.triggerTimeChange()}},methods:{hourText:function(t){return(0,r.default)().hour(t).minute(0).second(0).format("HH:mm")},minuteActiveClass:function(t){var e=(0,r.default)().hour(0).minute(t),n=e.format("HH:mm");return Object.keys(this.activeMinutes).indexOf(n)>=0?"active":""},minuteTitle:function(t){var e=(0,r.default)().hour(0).minute(t),n=e.format("HH:mm");return Object.keys(this.activeMinutes).indexOf(n)>=0?n:""},clickMinute:function(t){var e=!(arguments.length>1&&void 0!==arguments[1])||arguments[1];this.bMoving||(this.timeCursorX=t+this.timeDayX,e&&this.triggerTimeChange())},triggerTimeChange:function(){this.$emit("timeChange",this.activeMinutes[this.timeCursorText])}},computed:{timeCursorText:function(){this.timeCursorX>=t(this.$el).innerWidth()&&(this.timeCursorX=t(this.$el).innerWidth()-1),this.timeCursorX<0&&(this.timeCursorX=0),this.timeDayX<t(this.$el).innerWidth()-t(this.$refs.day).outerWidth()&&(this.timeDayX=t(this.$el).innerWidth()-t(this.$refs.day).outerWidth()),this.timeDayX>0&&(this.timeDayX=0),this.timeCursorX-this.timeDayX>=1440&&(this.timeDayX=t(this.$el).innerWidth()-t(this.$refs.day).outerWidth(),this.timeCursorX=t(this.$el).innerWidth()-1);var e=parseInt((this.timeCursorX-this.timeDayX)/this.minutesPerUnit)*this.minutesPerUnit;return(0,r.default)().hour(0).minute(e).format("HH:mm")},activeMinutes:function(){var t={},e=0,n=!0,o=!1,i=void 0;try{for(var a,s=this.videos[Symbol.iterator]();!(n=(a=s.next()).done);n=!0){var l=a.value,u=(0,r.default)(l.StartTime,"YYYY-MM-DDTHH:mm:ss"),c=(0,r.default)(l.EndTime,"YYYY-MM-DDTHH:mm:ss");u.isSame(c,"day")||(0==e?u=(0,r.default)(c).startOf("day"):c=(0,r.default)(u).endOf("day"));var d,f,v=(0,r.default)(u).startOf("hour");this.start&&(d=(0,r.default)(u).startOf("day").add(this.start,"minute")),this.end&&(f=(0,r.default)(u).startOf("day").add(this.end,"minute"));for(var p=0;;p+=this.minutesPerUnit){var h=(0,r.default)(v).add(p,"minute");if(!h.isBefore(u,"minute")){if(h.isAfter(c,"minute"))break;if(!d||!h.isBefore(d,"minute")){if(f&&h.isAfter(f,"minute"))break;t[h.format("HH:mm")]=Object.assign({},l,{StartTime:h.format("YYYY-MM-DDTHH:mm:ss")})}}}e++}}catch(t){o=!0,i=t}finally{try{!n&&s.return&&s.return()}finally{if(o)throw i}}return t}}}}).call(e,n("0iPh"))},"Y6/U":function(t,e,n){var o=n("bCqn");"string"==typeof o&&(o=[[t.i,o,""]]),o.locals&&(t.exports=o.locals);n("rjj0")("6d28fafe",o,!0,{})},YgVS:function(t,e,n){var o=n("6O2P");"string"==typeof o&&(o=[[t.i,o,""]]),o.locals&&(t.exports=o.locals);n("rjj0")("5d4deb38",o,!0,{})},Ynuf:function(t,e,n){e=t.exports=n("FZ+f")(!1),e.push([t.i,".modal-content[data-v-6aa38924]{overflow:hidden}@media screen and (min-width:992px){.modal-dialog.modal-lgg[data-v-6aa38924]{width:90%}}@media screen and (min-width:1200px){.modal-dialog.modal-lgg[data-v-6aa38924]{width:1200px}}",""])},Zurf:function(t,e,n){"use strict";Object.defineProperty(e,"__esModule",{value:!0});var o=n("pCZB"),r=n.n(o);for(var i in o)["default","default"].indexOf(i)<0&&function(t){n.d(e,t,function(){return o[t]})}(i);var a=n("gJt0"),s=n("VU/8"),l=s(r.a,a.a,!1,null,null,null);e.default=l.exports},aIgG:function(t,e,n){var o=n("7KHA");"string"==typeof o&&(o=[[t.i,o,""]]),o.locals&&(t.exports=o.locals);n("rjj0")("48b908d3",o,!0,{})},aiu8:function(t,e,n){e=t.exports=n("FZ+f")(!1),e.push([t.i,".modal-title[data-v-66201e86]{overflow:hidden;white-space:nowrap;text-overflow:ellipsis}#speed-switcher-playback[data-v-66201e86]{margin-right:10px}#speed-switcher-playback label[data-v-66201e86]{margin-bottom:0}",""])},aonT:function(t,e,n){"use strict";(function(t){function o(t){return t&&t.__esModule?t:{default:t}}Object.defineProperty(e,"__esModule",{value:!0}),n("F8S1");var r=n("eAMf"),i=o(r),a=n("qDl4"),s=o(a);e.default={data:function(){return{videoUrl:"",videoTitle:"",snapUrl:"",serial:"",code:"",timer:0,active:!1,osd:"",streamid:"",protocol:"",streamInfo:null,bRecording:!1,bAudioEnable:!1,recordFileURL:"",bShow:!1,bLoading:!1,recorder:null,ws:null,level:0,statsTicker:0,SourceVideoWidth:0,SourceVideoHeight:0,SourceVideoCodecName:"",SourceAudioCodecName:"",StartAt:"",Transport:"",InBitRate:0,RTPLostRate:0,FrameRate:0,Duration:0,NumOutputs:0,activeName:"steam-info",mediaInfo:null,bOutHevcTip:!1,bRTPLostTip:!1}},props:{fade:{type:Boolean,default:!1},digitalZoom:{type:Boolean,default:!0},serverInfo:{type:Object,default:function(){}},userInfo:{type:Object,default:function(){return null}}},beforeDestroy:function(){this.timer&&(clearTimeout(this.timer),this.timer=0),this.statsTicker&&(clearInterval(this.statsTicker),this.statsTicker=0),this.ws&&(this.ws.close(),this.ws=null),this.ctrlStop(),t(document).off("mouseup touchend",this.mouseUp)},mounted:function(){var e=this;t(this.$el).find(".modal-content").draggable({handle:".modal-header",cancel:".modal-title span",addClasses:!1,containment:"document",delay:100,opacity:.5}),t(this.$el).on("hide.bs.modal",function(){e.streamid="",e.streamInfo=null,e.mediaInfo=null,e.bOutHevcTip=!1,e.bRTPLostTip=!1,e.protocol="",e.snapUrl="",e.videoUrl="",e.osd="",e.bRecording=!1,e.bAudioEnable=!1,e.recordFileURL="",e.activeName="steam-info",e.timer&&(clearTimeout(e.timer),e.timer=0),e.statsTicker&&(clearInterval(e.statsTicker),e.statsTicker=0),e.ctrlStop(),e.bShow=!1}).on("show.bs.modal",function(){e.bShow=!0}),t(document).on("mouseup touchend",this.mouseUp)},components:{LivePlayer:i.default,Preset:s.default},methods:{play:function(e,n,o,r){var i=this;r=r||{};var a=r.HLS,s="HLS";switch(this.flvSupported()&&(a=this.isIE()?r.WS_FLV:r.FLV,s=this.isIE()?"WS_FLV":"FLV"),String(this.serverInfo.PreferStreamFmt||"").toUpperCase()){case"WEBRTC":this.rtcSupported()&&(a=r.WEBRTC,s="WEBRTC");break;case"FLV":this.flvSupported()&&!this.isIE()&&(a=r.FLV,s="FLV");break;case"WS_FLV":case"WS-FLV":this.flvSupported()&&(a=r.WS_FLV,s="WS_FLV");break;case"HLS":a=r.HLS,s="HLS";break;case"RTMP":a=r.RTMP,s="RTMP"}this.protocol=s,this.serial=n||"",this.code=o||"",this.streamid=r.StreamID||"",this.streamInfo=r,this.readStreamInfo(r),this.videoTitle=e||"",this.osd=r.ChannelOSD||"",this.snapUrl="RTMP"==s?"":r.SnapURL||"",this.videoUrl=a||"",t(this.$el).modal("show"),this.doActive(),this.hasAnyRole(this.serverInfo,this.userInfo,"管理员","操作员")&&this.streamid&&(this.statsTicker=setInterval(function(){i.updateStreamInfo()},3e3))},updateStreamInfo:function(){var e=this;this.streamid&&t.ajax({method:"GET",url:"/api/v1/stream/info",global:!1,data:{streamid:this.streamid}}).then(function(t){t&&(e.streamInfo=t,e.readStreamInfo(t))}).fail(function(t){t&&console.log("update stream info ajax error: "+t.status+" "+t.responseText)})},readStreamInfo:function(t){t=t||{},this.bRecording||this.recordFileURL||!t.RecordStartAt||this.queryRecord(),this.bRecording=""!=t.RecordStartAt,this.bAudioEnable=!!t.AudioEnable,this.SourceVideoWidth=t.SourceVideoWidth||0,this.SourceVideoHeight=t.SourceVideoHeight||0,this.SourceVideoCodecName=t.SourceVideoCodecName||"","hevc"==this.SourceVideoCodecName&&(this.SourceVideoCodecName="h265"),this.SourceAudioCodecName=t.SourceAudioCodecName||"",this.StartAt=t.StartAt||"",this.Transport=t.Transport||"",this.InBitRate=t.InBitRate||0,this.FrameRate=t.SourceVideoFrameRate||0,this.RTPLostRate=t.RTPLostRate||0,this.Duration=t.Duration||0,this.NumOutputs=t.NumOutputs||0},setProtocol:function(t){if(this.streamInfo){switch(this.bOutHevcTip&&!String(t).endsWith("FLV")&&"HLS"!=t&&(this.bOutHevcTip=!1),t){case"WEBRTC":this.videoUrl=this.streamInfo.WEBRTC;break;case"FLV":this.videoUrl=this.streamInfo.FLV;break;case"WS_FLV":this.videoUrl=this.streamInfo.WS_FLV;break;case"RTMP":this.snapUrl="",this.videoUrl=this.streamInfo.RTMP;break;case"HLS":this.videoUrl=this.streamInfo.HLS}this.doActive()}},ptzControl:function(e,n){var o=this;t.ajax({method:"POST",url:"/api/v1/control/ptz",global:!1,data:{serial:this.serial,code:this.code,command:e}}).fail(function(t){t&&(console.log("ptz "+e+" ajax error: "+t.status+" "+t.responseText),401==t.status&&o.$nextTick(function(){location.href="/login?r="+encodeURIComponent(window.location.href)}))}),t(n.target).closest(".ptz-cell").addClass("active")},ptzStop:function(){t(this.$el).find(".ptz-cell.active").length>0&&(t.ajax({method:"POST",url:"/api/v1/control/ptz",global:!1,data:{serial:this.serial,code:this.code,command:"stop"}}).fail(function(t){t&&console.log("ptz stop ajax error: "+t.status+" "+t.responseText)}),t(this.$el).find(".ptz-cell.active").removeClass("active"))},talkStart:function(e){var n=this;if(this.ws){if(this.talkHold)return void this.talkStop()}else this.ws=new WebSocket(this.wsTalkURL()),this.ws.onopen=function(t){console.log("ws talk open")},this.ws.onclose=function(t){console.log("ws talk close")},this.ws.onerror=function(t){console.log("ws talk error",t)};var o=t(e.currentTarget);if(this.recorder)return o.addClass("active"),void this.recorder.start();LiveRecorder.get(function(t,e){if(e)return alert(e),void n.talkStop();o.addClass("active"),n.recorder=t,n.recorder.start()},{sampleBits:16,sampleRate:8e3,pcmCallback:function(t,e){var o=new window.FileReader;o.onloadend=function(){var t=o.result,t=t.split(",")[1];n.ws&&n.ws.readyState===WebSocket.OPEN&&(n.ws.send(t),n.level=e)},o.readAsDataURL(t)}})},talkStop:function(){this.recorder&&(this.recorder.destroy(),this.recorder=null,t(this.$el).find(".fa-microphone.active").removeClass("active")),this.ws&&(this.ws.close(),this.ws=null),this.level=0},ctrlStop:function(){this.talkStop(),this.ptzStop()},mouseUp:function(){this.talkHold||this.talkStop(),this.ptzStop()},toggleRecord:function(){var e=this;this.bRecording?t.ajax({method:"POST",url:"/api/v1/record/stop",global:!1,data:{streamid:this.streamid}}).then(function(t){var n="",o=!0,r=!1,i=void 0;try{for(var a,s=t.RecordList[Symbol.iterator]();!(o=(a=s.next()).done);o=!0){var l=a.value;if(l.DownloadURL){n=l.DownloadURL;break}}}catch(t){r=!0,i=t}finally{try{!o&&s.return&&s.return()}finally{if(r)throw i}}n&&window.open(n,"_blank"),e.bRecording=!1,e.recordFileURL=""}).fail(function(t){if(t&&console.log("record stop ajax error: "+t.status+" "+t.responseText),e.recordFileURL)window.open(e.recordFileURL,"_blank"),e.bRecording=!1,e.recordFileURL="";else if(t&&t.status){var n=t.responseText||"网络请求失败";try{n=JSON.parse(n)}catch(t){}e.$message({type:"error",message:n})}}):t.post("/api/v1/record/start",{streamid:this.streamid}).then(function(t){e.bRecording=!0,e.recordFileURL=t.DownloadURL||""})},queryRecord:function(){var e=this;t.ajax({method:"GET",url:"/api/v1/record/list",global:!1,data:{streamid:this.streamid}}).then(function(t){var n=!0,o=!1,r=void 0;try{for(var i,a=t.RecordList[Symbol.iterator]();!(n=(i=a.next()).done);n=!0){var s=i.value;if(s.DownloadURL){e.recordFileURL=s.DownloadURL;break}}}catch(t){o=!0,r=t}finally{try{!n&&a.return&&a.return()}finally{if(o)throw r}}}).fail(function(t){t&&console.log("record query ajax error: "+t.status+" "+t.responseText)})},wsTalkURL:function(){var t="ws:";return location.protocol.startsWith("https")&&(t="wss:"),t+"//"+location.host+"/api/v1/control/ws-talk/"+this.serial+"/"+this.code+"?format=pcm"},doActive:function(){var t=this;this.active=!0,this.timer&&(clearTimeout(this.timer),this.timer=0),this.timer=setTimeout(function(){t.active=!1},2e3)},onMediaInfo:function(t){this.mediaInfo=t},onEnded:function(t){this.mediaInfo=null},onError:function(t){"MediaError"==t&&(this.mediaInfo&&String(this.mediaInfo.videoCodec).startsWith("hvc")||"HLS"==this.protocol)&&flvjs.getFeatureList()&&!flvjs.getFeatureList().nativeMP4H265Playback&&(this.bOutHevcTip=!0,console.log("提示: 正在播放 H265 直出流, 确保浏览器版本较新, 并且开启硬件加速"))}}}}).call(e,n("0iPh"))},bCqn:function(t,e,n){e=t.exports=n("FZ+f")(!1),e.push([t.i,"#input-speed-dropdown[data-v-793c4316]{color:#444!important;background-color:#f4f4f4!important;border-color:#ddd!important}#input-speed-menu[data-v-793c4316]{min-width:unset}.player-wrapper[data-v-793c4316]{margin:0 auto;max-width:700px}@media screen and (min-width:1600px){.player-wrapper[data-v-793c4316]{max-width:50%}}",""])},"ci/Y":function(t,e,n){"use strict";var o=function(){var t=this,e=t.$createElement,n=t._self._c||e;return n("div",{class:["modal",{fade:t.fade}],attrs:{"data-keyboard":"true","data-backdrop":"static",tabindex:"-1"}},[n("div",{staticClass:"modal-dialog modal-lgg"},[n("div",{staticClass:"modal-content"},[n("div",{staticClass:"modal-header"},[t._m(0),t._v(" "),n("h4",{staticClass:"modal-title text-primary text-center"},[n("span",[t._v(t._s(t.videoTitle))])])]),t._v(" "),n("div",{staticClass:"modal-body"},[n("div",{staticClass:"row"},[n("div",{class:["play-area",{"user-active":t.active,"col-sm-9":t.hasAnyRole(t.serverInfo,t.userInfo,"管理员","操作员"),"col-sm-12":!t.hasAnyRole(t.serverInfo,t.userInfo,"管理员","操作员")}],on:{mousemove:t.doActive}},[t.bShow?n("LivePlayer",{directives:[{name:"loading",rawName:"v-loading",value:t.bLoading,expression:"bLoading"}],ref:"player",attrs:{videoUrl:t.videoUrl,waterMark:t.osd,digitalZoom:t.digitalZoom,poster:t.snapUrl,hideBigPlayButton:!!t.serverInfo.HideBigPlayButton,live:"",muted:!t.bAudioEnable,loading:t.bLoading,"element-loading-text":"加载中...","element-loading-background":"#000"},on:{media_info:t.onMediaInfo,ended:t.onEnded,error:t.onError,message:t.$message,"update:loading":function(e){t.bLoading=e}}}):t._e()],1),t._v(" "),t.hasAnyRole(t.serverInfo,t.userInfo,"管理员","操作员")?n("div",{staticClass:"ptz-area col-sm-3"},[n("div",{staticClass:"form-group hide"},[n("div",{staticClass:"input-group"},[n("input",{directives:[{name:"model",rawName:"v-model.trim",value:t.osd,expression:"osd",modifiers:{trim:!0}}],staticClass:"form-control",attrs:{type:"text"},domProps:{value:t.osd},on:{input:function(e){e.target.composing||(t.osd=e.target.value.trim())},blur:function(e){t.$forceUpdate()}}}),t._v(" "),n("span",{staticClass:"input-group-btn"},[n("button",{staticClass:"btn btn-default",attrs:{type:"button"},on:{click:function(e){e.preventDefault(),t.setOSD(e)}}},[t._v("设置OSD")])])])]),t._v(" "),n("div",{staticClass:"form-group",staticStyle:{margin:"0px"}},[n("div",{staticClass:"ptz-block"},[t.ws?n("el-progress",{staticClass:"mic-level-bar",attrs:{percentage:t.level,"text-inside":!0}}):t._e(),t._v(" "),n("div",{staticClass:"ptz-cell ptz-up",attrs:{command:"up",title:"上"},on:{mousedown:function(e){e.preventDefault(),t.ptzControl("up",e)},touchstart:function(e){e.preventDefault(),t.ptzControl("up",e)}}},[n("i",{staticClass:"fa fa-chevron-up"})]),t._v(" "),n("div",{staticClass:"ptz-cell ptz-left",attrs:{command:"left",title:"左"},on:{mousedown:function(e){e.preventDefault(),t.ptzControl("left",e)},touchstart:function(e){e.preventDefault(),t.ptzControl("left",e)}}},[n("i",{staticClass:"fa fa-chevron-left"})]),t._v(" "),n("div",{staticClass:"ptz-cell ptz-center",attrs:{title:"云台控制"}},[t.canTalk()&&"标准版"!==t.serverInfo.VersionType?n("i",{staticClass:"fa fa-microphone",attrs:{title:"按住喊话"},on:{mousedown:function(e){e.preventDefault(),t.talkStart(e)},touchstart:function(e){e.preventDefault(),t.talkStart(e)}}}):t._e(),t._v(" "),t.canTalk()||"标准版"===t.serverInfo.VersionType?t._e():n("i",{staticClass:"fa fa-microphone-slash",attrs:{title:"由于浏览器安全策略, 非 HTTPS 或 localhost 访问, 对讲不可用"}})]),t._v(" "),n("div",{staticClass:"ptz-cell ptz-right",attrs:{command:"right",title:"右"},on:{mousedown:function(e){e.preventDefault(),t.ptzControl("right",e)},touchstart:function(e){e.preventDefault(),t.ptzControl("right",e)}}},[n("i",{staticClass:"fa fa-chevron-right"})]),t._v(" "),n("div",{staticClass:"ptz-cell ptz-down",attrs:{command:"down",title:"下"},on:{mousedown:function(e){e.preventDefault(),t.ptzControl("down",e)},touchstart:function(e){e.preventDefault(),t.ptzControl("down",e)}}},[n("i",{staticClass:"fa fa-chevron-down"})]),t._v(" "),n("div",{staticClass:"ptz-cell ptz-zoomin",attrs:{command:"zoomin",title:"放大"},on:{mousedown:function(e){e.preventDefault(),t.ptzControl("zoomin",e)},touchstart:function(e){e.preventDefault(),t.ptzControl("zoomin",e)}}},[n("i",{staticClass:"fa fa-plus"})]),t._v(" "),n("div",{staticClass:"ptz-cell ptz-zoomout",attrs:{command:"zoomout",title:"缩小"},on:{mousedown:function(e){e.preventDefault(),t.ptzControl("zoomout",e)},touchstart:function(e){e.preventDefault(),t.ptzControl("zoomout",e)}}},[n("i",{staticClass:"fa fa-minus"})])],1),t._v(" "),n("div",{staticClass:"clearfix"}),t._v(" "),t.SourceVideoCodecName&&t.SourceVideoWidth&&t.SourceVideoHeight?n("el-tabs",{staticClass:"tab-info hidden-xs hidden-sm",model:{value:t.activeName,callback:function(e){t.activeName=e},expression:"activeName"}},[n("el-tab-pane",{attrs:{size:"mini",label:"流信息",name:"steam-info"}},[n("div",{staticClass:"row stream-info hidden-xs hidden-sm"},[n("div",{staticClass:"col-sm-3"},[t._v("\r\n                                            分辨率:\r\n                                        ")]),t._v(" "),n("div",{staticClass:"col-sm-3"},[t._v("\r\n                                            "+t._s(t.SourceVideoWidth)+"x"+t._s(t.SourceVideoHeight)+"\r\n                                        ")]),t._v(" "),n("div",{staticClass:"col-sm-3"},[t._v("\r\n                                            帧率:\r\n                                        ")]),t._v(" "),n("div",{staticClass:"col-sm-3"},[t._v("\r\n                                            "+t._s(t.FrameRate)+"fps\r\n                                        ")]),t._v(" "),n("div",{staticClass:"clearfix"},[n("div",{staticClass:"col-sm-3"},[t._v("\r\n\t\t\t\t\t\t\t\t\t\t\t\t码率:\r\n\t\t\t\t\t\t\t\t\t\t\t")]),t._v(" "),n("div",{staticClass:"col-sm-3"},[t._v("\r\n\t\t\t\t\t\t\t\t\t\t\t\t"+t._s(t.InBitRate)+"Kbps\r\n\t\t\t\t\t\t\t\t\t\t\t")]),t._v(" "),n("div",{staticClass:"col-sm-3"},[t._v("\r\n\t\t\t\t\t\t\t\t\t\t\t\t丢包率:\r\n\t\t\t\t\t\t\t\t\t\t\t")]),t._v(" "),n("div",{staticClass:"col-sm-3"},[t.RTPLostRate>0&&"UDP"==t.Transport&&!t.isDemoUser(t.serverInfo,t.userInfo)&&t.hasAnyRole(t.serverInfo,t.userInfo,"管理员")?n("a",{staticClass:"text-orange",attrs:{role:"button"},on:{click:function(e){t.bRTPLostTip=!t.bRTPLostTip}}},[n("u",[t._v(t._s(parseFloat(t.RTPLostRate).toFixed(2))+"% "),n("i",{staticClass:"glyphicon glyphicon-exclamation-sign"})])]):n("span",{class:{"text-orange":t.RTPLostRate>0}},[t._v("\r\n\t\t\t\t\t\t\t\t\t\t\t\t\t"+t._s(parseFloat(t.RTPLostRate).toFixed(2))+"%\r\n\t\t\t\t\t\t\t\t\t\t\t\t")])])]),t._v(" "),n("div",{staticClass:"clearfix"},[n("div",{staticClass:"col-sm-3"},[t._v("\r\n\t\t\t\t\t\t\t\t\t\t\t\t视频编码:\r\n\t\t\t\t\t\t\t\t\t\t\t")]),t._v(" "),n("div",{staticClass:"col-sm-3"},[t._v("\r\n\t\t\t\t\t\t\t\t\t\t\t\t"+t._s(t.SourceVideoCodecName)+"\r\n\t\t\t\t\t\t\t\t\t\t\t")]),t._v(" "),n("div",{staticClass:"col-sm-3"},[t._v("\r\n\t\t\t\t\t\t\t\t\t\t\t\t音频编码:\r\n\t\t\t\t\t\t\t\t\t\t\t")]),t._v(" "),n("div",{class:["col-sm-3",{"text-gray":!t.bAudioEnable}]},[t._v("\r\n\t\t\t\t\t\t\t\t\t\t\t\t"+t._s(t.SourceAudioCodecName)+"\r\n\t\t\t\t\t\t\t\t\t\t\t")])]),t._v(" "),n("div",{staticClass:"clearfix"},[n("div",{staticClass:"col-sm-3"},[t._v("\r\n\t\t\t\t\t\t\t\t\t\t\t\t传输模式:\r\n\t\t\t\t\t\t\t\t\t\t\t")]),t._v(" "),n("div",{staticClass:"col-sm-3"},[t._v("\r\n\t\t\t\t\t\t\t\t\t\t\t\t"+t._s(t.Transport)+"\r\n\t\t\t\t\t\t\t\t\t\t\t")]),t._v(" "),n("div",{staticClass:"col-sm-3"},[t._v("\r\n\t\t\t\t\t\t\t\t\t\t\t\t持续时长:\r\n\t\t\t\t\t\t\t\t\t\t\t")]),t._v(" "),n("div",{staticClass:"col-sm-3"},[t._v("\r\n\t\t\t\t\t\t\t\t\t\t\t\t"+t._s(t.formatDuration(t.Duration))+"\r\n\t\t\t\t\t\t\t\t\t\t\t")])]),t._v(" "),!t.isDemoUser(t.serverInfo,t.userInfo)&&t.hasAnyRole(t.serverInfo,t.userInfo,"超级管理员")?n("div",{staticClass:"clearfix"},[n("div",{staticClass:"col-sm-3"},[t._v("\r\n\t\t\t\t\t\t\t\t\t\t\t\t流地址:\r\n\t\t\t\t\t\t\t\t\t\t\t")]),t._v(" "),n("div",{staticClass:"col-sm-9"},[n("i",{directives:[{name:"clipboard",rawName:"v-clipboard",value:t.videoUrl,expression:"videoUrl"}],staticClass:"fa fa-copy",attrs:{title:"点击拷贝",role:"button"},on:{success:function(e){t.$message({type:"success",message:"成功拷贝到粘贴板"})}}}),t._v("\r\n\t\t\t\t\t\t\t\t\t\t\t\t"+t._s(t.videoUrl)+"\r\n\t\t\t\t\t\t\t\t\t\t\t")])]):t._e(),t._v(" "),t.isDemoUser(t.serverInfo,t.userInfo)?n("div",{staticClass:"clearfix"},[n("div",{staticClass:"col-sm-3 text-bold text-red"},[t._v("\r\n\t\t\t\t\t\t\t\t\t\t\t\t提示:\r\n\t\t\t\t\t\t\t\t\t\t\t")]),t._v(" "),n("div",{staticClass:"col-sm-9"},[t._v("\r\n\t\t\t\t\t\t\t\t\t\t\t\t演示系统限制匿名登录播放时间, 若需测试长时间播放, 请"),n("a",{attrs:{target:"_blank",href:"//www.liveqing.com/docs/download/LiveGBS.html"}},[t._v("下载使用")])])]):t._e()])]),t._v(" "),n("el-tab-pane",{attrs:{size:"mini",label:"预置位",name:"preset-info"}},[n("Preset",{ref:"presetList",attrs:{serial:t.serial,code:t.code,activeName:t.activeName}})],1)],1):t._e()],1)]):t._e()])]),t._v(" "),n("div",{staticClass:"modal-footer"},[n("el-radio-group",{staticClass:"hidden-xs pull-left",attrs:{size:"small",id:"protocol-switcher"},on:{change:t.setProtocol},model:{value:t.protocol,callback:function(e){t.protocol="string"==typeof e?e.trim():e},expression:"protocol"}},[t.flvSupported()&&!t.isIE()?n("el-radio-button",{attrs:{label:"FLV"}}):t._e(),t._v(" "),t.flvSupported()?n("el-radio-button",{attrs:{label:"WS_FLV"}}):t._e(),t._v(" "),t.rtcSupported()?n("el-radio-button",{attrs:{label:"WEBRTC"}}):t._e(),t._v(" "),n("el-radio-button",{attrs:{label:"HLS"}}),t._v(" "),n("el-radio-button",{attrs:{label:"RTMP"}})],1),t._v(" "),t.bOutHevcTip?n("span",{staticClass:"text-red small"},[t._v("\r\n                    正在播放 H265 直出流, 确保浏览器版本较新, 并开启硬件加速  \r\n                ")]):t.bRTPLostTip&&"UDP"==t.Transport?n("span",{staticClass:"text-orange small hidden-xs hidden-sm hidden-md"},[t._v("\r\n                    存在 UDP 丢包, 尝试返回设备列表将「流传输模式」设为「TCP 被动」并停止重新播放  \r\n                ")]):t._e(),t._v(" "),t.streamid&&t.hasAnyRole(t.serverInfo,t.userInfo,"管理员","操作员")&&"标准版"!==t.serverInfo.VersionType?n("button",{class:["btn",{"btn-primary":!t.bRecording,"btn-danger":t.bRecording}],attrs:{type:"button"},on:{click:function(e){e.preventDefault(),t.toggleRecord(e)}}},[n("i",{class:["fa",{"fa-save":!t.bRecording,"fa-stop":t.bRecording}]}),t._v("\r\n                    "+t._s(t.bRecording?"停止录像":"实时录像")+"\r\n                ")]):t._e(),t._v(" "),n("button",{staticClass:"btn btn-default",attrs:{type:"button","data-dismiss":"modal"}},[t._v("关闭")])],1)])])])},r=[function(){var t=this,e=t.$createElement,n=t._self._c||e;return n("button",{staticClass:"close",attrs:{type:"button","data-dismiss":"modal","aria-label":"Close"}},[n("span",{attrs:{"aria-hidden":"true"}},[t._v("×")])])}],i={render:o,staticRenderFns:r};e.a=i},cnUC:function(t,e,n){"use strict";var o=function(){var t=this,e=t.$createElement,n=t._self._c||e;return n("FormDlg",{ref:"dlg",attrs:{title:t.title,disabled:t.errors.any()},on:{hide:t.onHide,show:t.onShow,submit:t.onSubmit}},[n("div",{directives:[{name:"show",rawName:"v-show",value:t.form.parent_id||t.form.parent_name,expression:"form.parent_id || form.parent_name"}],class:{"form-group":!0,"has-error":t.errors.has("parentid")}},[n("label",{staticClass:"col-sm-4 control-label",attrs:{for:"input-parentid"}},[t._v("父节点\n            ")]),t._v(" "),n("div",{staticClass:"col-sm-7"},[n("select",{directives:[{name:"model",rawName:"v-model.trim",value:t.form.parent_id,expression:"form.parent_id",modifiers:{trim:!0}}],staticClass:"form-control",attrs:{id:"input-parentid","data-vv-as":"父节点",disabled:"disabled"},on:{change:function(e){var n=Array.prototype.filter.call(e.target.options,function(t){return t.selected}).map(function(t){return"_value"in t?t._value:t.value});t.$set(t.form,"parent_id",e.target.multiple?n:n[0])}}},[n("option",{domProps:{value:t.form.parent_id}},[t._v(t._s(t.form.parent_name))])])])]),t._v(" "),n("div",{class:{"form-group":!0,"has-error":t.errors.has("serial")}},[n("label",{staticClass:"col-sm-4 control-label",attrs:{for:"input-serial"}},[t._v("设备编号\n            "),n("span",{staticClass:"text-red"},[t._v("*")])]),t._v(" "),n("div",{staticClass:"col-sm-7"},[n("input",{staticClass:"form-control",attrs:{type:"text",readonly:"readonly",id:"input-serial",name:"serial","data-vv-as":"设备编号"},domProps:{value:t.form.serial}})])]),t._v(" "),t.bImport?n("div",{class:{"form-group":!0,"has-error":t.errors.has("import_serial")}},[n("label",{staticClass:"col-sm-4 control-label",attrs:{for:"input-serial"}},[t._v("导入设备\n            "),n("span",{staticClass:"text-red"},[t._v("*")])]),t._v(" "),n("div",{staticClass:"col-sm-7"},[n("el-select",{directives:[{name:"validate",rawName:"v-validate",value:"required",expression:"'required'"}],ref:"selectDevice",staticStyle:{width:"100%"},attrs:{filterable:"",clearable:"",remote:"","remote-method":t.loadDevice,loading:t.bLoading,id:"input-import-serial",name:"import_serial","data-vv-as":"导入设备",placeholder:"搜索",size:"small"},on:{change:t.changeDevice,clear:t.clearDevice},model:{value:t.form.import_serial,callback:function(e){t.$set(t.form,"import_serial",e)},expression:"form.import_serial"}},t._l(t.devices,function(t){return n("el-option",{key:t.ID,attrs:{label:t.CustomName||t.Name||t.ID,value:t.ID}})}))],1)]):t._e(),t._v(" "),n("div",{directives:[{name:"show",rawName:"v-show",value:t.form.add||t.form.code,expression:"form.add || form.code"}],class:{"form-group":!0,"has-error":t.errors.has("code")}},[n("label",{staticClass:"col-sm-4 control-label",attrs:{for:"input-code"}},[t._v(t._s(t.labelHeader)+"编号\n            "),t.form.add||t.form.custom?n("span",{staticClass:"text-red"},[t._v("*")]):t._e()]),t._v(" "),n("div",{staticClass:"col-sm-7"},[t.form.add?n("input",{directives:[{name:"model",rawName:"v-model.trim",value:t.form.code,expression:"form.code",modifiers:{trim:!0}},{name:"validate",rawName:"v-validate",value:"required|regex:^[0-9]+$",expression:"'required|regex:^[0-9]+$'"}],staticClass:"form-control",attrs:{type:"text",id:"input-code",name:"code",placeholder:t.codePlaceholder,"data-vv-as":"节点编号"},domProps:{value:t.form.code},on:{keydown:function(e){if(!("button"in e)&&t._k(e.keyCode,"enter",13,e.key))return null;t.$el.querySelector("#input-name").focus()},input:function(e){e.target.composing||t.$set(t.form,"code",e.target.value.trim())},blur:function(e){t.$forceUpdate()}}}):t.form.custom?n("input",{directives:[{name:"model",rawName:"v-model.trim",value:t.form.new_code,expression:"form.new_code",modifiers:{trim:!0}},{name:"validate",rawName:"v-validate",value:"required|regex:^[0-9]+$",expression:"'required|regex:^[0-9]+$'"}],staticClass:"form-control",attrs:{type:"text",id:"input-code",name:"code",placeholder:t.codePlaceholder,"data-vv-as":"节点编号"},domProps:{value:t.form.new_code},on:{keydown:function(e){if(!("button"in e)&&t._k(e.keyCode,"enter",13,e.key))return null;t.$el.querySelector("#input-name").focus()},input:function(e){e.target.composing||t.$set(t.form,"new_code",e.target.value.trim())},blur:function(e){t.$forceUpdate()}}}):n("input",{directives:[{name:"model",rawName:"v-model.trim",value:t.form.code,expression:"form.code",modifiers:{trim:!0}}],staticClass:"form-control",attrs:{type:"text",readonly:"readonly",id:"input-code",name:"code","data-vv-as":"节点编号"},domProps:{value:t.form.code},on:{input:function(e){e.target.composing||t.$set(t.form,"code",e.target.value.trim())},blur:function(e){t.$forceUpdate()}}})])]),t._v(" "),"标准版"!=t.serverInfo.VersionType&&!t.form.add&&!t.form.custom&&t.form.serial&&t.form.code?n("div",{class:{"form-group":!0,"has-error":t.errors.has("custom_id")}},[n("label",{staticClass:"col-sm-4 control-label",attrs:{for:"input-custom-id"}},[t._v("自定义通道编号\n        ")]),t._v(" "),n("div",{staticClass:"col-sm-7"},[n("input",{directives:[{name:"model",rawName:"v-model.trim",value:t.form.custom_id,expression:"form.custom_id",modifiers:{trim:!0}}],staticClass:"form-control",attrs:{type:"text",id:"input-custom-id",name:"custom_id",placeholder:"可选, 用于级联","data-vv-as":"自定义通道编号",oninput:"value=value.replace(/[^\\d]/g,'')"},domProps:{value:t.form.custom_id},on:{keydown:function(e){if(!("button"in e)&&t._k(e.keyCode,"enter",13,e.key))return null;e.preventDefault(),t.$el.querySelector("#input-name").focus()},input:function(e){e.target.composing||t.$set(t.form,"custom_id",e.target.value.trim())},blur:function(e){t.$forceUpdate()}}})])]):t._e(),t._v(" "),n("div",{class:{"form-group":!0,"has-error":t.errors.has("name")}},[n("label",{staticClass:"col-sm-4 control-label",attrs:{for:"input-name"}},[t._v(t._s(t.labelHeader)+"名称\n        ")]),t._v(" "),n("div",{staticClass:"col-sm-7"},[n("div",{staticClass:"input-group",staticStyle:{width:"100%"}},[n("input",{directives:[{name:"model",rawName:"v-model.trim",value:t.form.custom_name,expression:"form.custom_name",modifiers:{trim:!0}}],staticClass:"form-control",attrs:{type:"text",id:"input-name",name:"name",placeholder:t.form.name||t.form.code||t.form.serial,"data-vv-as":"节点名称"},domProps:{value:t.form.custom_name},on:{keydown:function(e){if(!("button"in e)&&t._k(e.keyCode,"enter",13,e.key))return null;t.onSubmit(e)},input:function(e){e.target.composing||t.$set(t.form,"custom_name",e.target.value.trim())},blur:function(e){t.$forceUpdate()}}}),t._v(" "),n("span",{directives:[{name:"show",rawName:"v-show",value:t.form.custom_name||t.form.name,expression:"form.custom_name || form.name"}],staticClass:"input-group-btn"},[n("button",{directives:[{name:"clipboard",rawName:"v-clipboard",value:t.form.custom_name||t.form.name,expression:"form.custom_name || form.name"}],staticClass:"btn btn-default",staticStyle:{"border-top-right-radius":"0","border-bottom-right-radius":"0"},attrs:{type:"button",title:t.form.custom?"拷贝名称":t.form.custom_name?"拷贝自定义名称":"拷贝原名称"},on:{success:function(e){t.$message({type:"success",message:"成功拷贝到粘贴板"})}}},[n("i",{staticClass:"fa fa-copy"})])])])])])])},r=[],i={render:o,staticRenderFns:r};e.a=i},d155:function(t,e,n){e=t.exports=n("FZ+f")(!1),e.push([t.i,".right-menu[data-v-1785224b]{position:fixed;background:#fff;border:1px solid rgba(0,0,0,.2);border-radius:3px;z-index:999;display:none}.right-menu a[data-v-1785224b]{width:100px;height:28px;line-height:28px;text-align:center;display:block;color:#1a1a1a}.right-menu a[data-v-1785224b]:hover{background:#eee;color:#fff}.right-menu[data-v-1785224b]{border:1px solid #eee;box-shadow:0 .5em 1em 0 rgba(0,0,0,.1);border-radius:1px}a[data-v-1785224b]{text-decoration:none}.right-menu a[data-v-1785224b]{padding:2px}.right-menu a[data-v-1785224b]:hover{background:#42b983}.video[data-v-1785224b]{position:relative}.video .video-close[data-v-1785224b]{position:absolute;top:5px;right:5px;color:#fff;font-size:12px;background-color:hsla(0,0%,50%,.5);padding:2px 5px;cursor:pointer;border-radius:2px;max-width:120px;overflow:hidden;white-space:nowrap;text-overflow:ellipsis}.ptz-block[data-v-1785224b]{width:150px;height:180px;margin:0 auto;text-align:center;position:relative;font-size:24px}.ptz-cell[data-v-1785224b]{width:50px;height:50px;line-height:50px;position:absolute}.ptz-cell.active[data-v-1785224b]{color:#ccc;font-size:26px}.ptz-cell.readonly[data-v-1785224b]{color:#ddd}.fa-microphone.active[data-v-1785224b]{color:#fff}.fa-microphone-slash.readonly[data-v-1785224b],.fa-microphone.readonly[data-v-1785224b]{color:#ddd}.ptz-center[data-v-1785224b]{top:50px;left:50px;border-radius:25px;background-color:#ccc}.ptz-up[data-v-1785224b]{top:0;left:50px}.ptz-left[data-v-1785224b]{top:50px;left:0}.ptz-right[data-v-1785224b]{top:50px;left:100px}.ptz-down[data-v-1785224b]{top:100px;left:50px}.ptz-zoomin[data-v-1785224b]{top:150px;left:20px}.ptz-zoomout[data-v-1785224b]{top:150px;left:80px}.ptz-center>.fa-microphone[data-v-1785224b],.ptz-down[data-v-1785224b],.ptz-left[data-v-1785224b],.ptz-right[data-v-1785224b],.ptz-up[data-v-1785224b],.ptz-zoomin[data-v-1785224b],.ptz-zoomout[data-v-1785224b]{cursor:pointer}.ptz-center>.fa-microphone.readonly[data-v-1785224b],.ptz-down.readonly[data-v-1785224b],.ptz-left.readonly[data-v-1785224b],.ptz-right.readonly[data-v-1785224b],.ptz-up.readonly[data-v-1785224b],.ptz-zoomin.readonly[data-v-1785224b],.ptz-zoomout.readonly[data-v-1785224b]{cursor:auto}",""])},eAMf:function(t,e,n){!function(e,n){t.exports=n()}(window,function(){return function(t){function e(o){if(n[o])return n[o].exports;var r=n[o]={i:o,l:!1,exports:{}};return t[o].call(r.exports,r,r.exports,e),r.l=!0,r.exports}var n={};return e.m=t,e.c=n,e.d=function(t,n,o){e.o(t,n)||Object.defineProperty(t,n,{enumerable:!0,get:o})},e.r=function(t){"undefined"!=typeof Symbol&&Symbol.toStringTag&&Object.defineProperty(t,Symbol.toStringTag,{value:"Module"}),Object.defineProperty(t,"__esModule",{value:!0})},e.t=function(t,n){if(1&n&&(t=e(t)),8&n)return t;if(4&n&&"object"==typeof t&&t&&t.__esModule)return t;var o=Object.create(null);if(e.r(o),Object.defineProperty(o,"default",{enumerable:!0,value:t}),2&n&&"string"!=typeof t)for(var r in t)e.d(o,r,function(e){return t[e]}.bind(null,r));return o},e.n=function(t){var n=t&&t.__esModule?function(){return t.default}:function(){return t};return e.d(n,"a",n),n},e.o=function(t,e){return Object.prototype.hasOwnProperty.call(t,e)},e.p="",e(e.s=0)}({0:function(t,e,n){t.exports=n("qtAY")},"8MIm":function(t,e,n){(t.exports=n("I1BE")(void 0)).push([t.i,'.video-js .vjs-big-play-button .vjs-icon-placeholder:before,.video-js .vjs-modal-dialog,.vjs-button>.vjs-icon-placeholder:before,.vjs-modal-dialog .vjs-modal-dialog-content{position:absolute;top:0;left:0;width:100%;height:100%}.video-js .vjs-big-play-button .vjs-icon-placeholder:before,.vjs-button>.vjs-icon-placeholder:before{text-align:center}@font-face{font-family:VideoJS;src:url(data:application/font-woff;charset=utf-8;base64,d09GRgABAAAAABDkAAsAAAAAG6gAAQAAAAAAAAAAAAAAAAAAAAAAAAAAAABHU1VCAAABCAAAADsAAABUIIslek9TLzIAAAFEAAAAPgAAAFZRiV3hY21hcAAAAYQAAADaAAADPv749/pnbHlmAAACYAAAC3AAABHQZg6OcWhlYWQAAA3QAAAAKwAAADYZw251aGhlYQAADfwAAAAdAAAAJA+RCLFobXR4AAAOHAAAABMAAACM744AAGxvY2EAAA4wAAAASAAAAEhF6kqubWF4cAAADngAAAAfAAAAIAE0AIFuYW1lAAAOmAAAASUAAAIK1cf1oHBvc3QAAA/AAAABJAAAAdPExYuNeJxjYGRgYOBiMGCwY2BycfMJYeDLSSzJY5BiYGGAAJA8MpsxJzM9kYEDxgPKsYBpDiBmg4gCACY7BUgAeJxjYGS7wTiBgZWBgaWQ5RkDA8MvCM0cwxDOeI6BgYmBlZkBKwhIc01hcPjI+FGJHcRdyA4RZgQRADK3CxEAAHic7dFZbsMgAEXRS0ycyZnnOeG7y+qC8pU1dHusIOXxuoxaOlwZYWQB0Aea4quIEN4E9LzKbKjzDeM6H/mua6Lmc/p8yhg0lvdYx15ZG8uOLQOGjMp3EzqmzJizYMmKNRu27Nhz4MiJMxeu3Ljz4Ekqm7T8P52G8PP3lnTOVk++Z6iN6QZzNN1F7ptuN7eGOjDUoaGODHVsuvU8MdTO9Hd5aqgzQ50b6sJQl4a6MtS1oW4MdWuoO0PdG+rBUI+GejLUs6FeDPVqqDdDvRvqw1CfhpqM9At0iFLaAAB4nJ1YDXBTVRZ+5/22TUlJ8we0pHlJm7RJf5O8F2j6EymlSPkpxaL8U2xpa3DKj0CBhc2IW4eWKSokIoLsuMqssM64f+jA4HSdWXXXscBq67IOs3FXZ1ZYWVyRFdo899yXtIBQZ90k7717zz3v3HPPOfd854YCCj9cL9dL0RQFOqCbGJnrHb5EayiKIWN8iA/hWBblo6hUWm8TtCDwE80WMJus/irwyxOdxeB0MDb14VNJHnXYoLLSl6FfCUYO9nYPTA8Epg9090LprfbBbZ2hY0UlJUXHQp3/vtWkS6EBv8+rPMq5u9692f/dNxJNiqwC1xPE9TCUgCsSdQWgE3XQD25lkG4CN2xmTcOXWBOyser6RN6KnGbKSbmQ3+d0OI1m2W8QzLLkI2sykrWAgJJEtA8vGGW/2Q+CmT3n8zS9wZwu2DCvtuZKZN3xkrLh36yCZuUomQSqGpY8t/25VfHVhw8z4ebGBtfLb0ya9PCaDc+8dGTvk2dsh6z7WzvowlXKUSWo9MJ15a3KrEP2loOr2Ojhw6iW6hf2BDdEccQvZGpaAy7YovSwq8kr7HGllxpd71rkS6G0Sf11sl9OvMK1+jwPPODxjUwkOim9CU3ix1wNjXDfmJSEn618Bs6lpWwUpU+8PCqLMY650zjq8VhCIP17NEKTx3eaLL+s5Pi6yJWaWjTHLR1jYzPSV9VF/6Ojdb/1kO3Mk3uhHC0x6gc1BjlKQ+nQFxTYdaJkZ7ySVxLBbhR1dsboNXp1tCYKW2LRaEzpYcIx2BKNxaL0ZaUnSqfFoiNhHKR/GkX6PWUSAaJelQaqZL1EpoHNsajSEyPSoJ9IjhIxTdjHLmwZvhRDOiFTY/YeQnvrVZmiTQtGncECXtFTBZLOVwwMRgoXHAkXzMzPn1nAJJ8jYSbMDaqN2waGLzNhih/bZynUBMpIWSg7VYi7DRx2m8ALkIdRCJwI6ArJx2EI8kaDWeTQKeAFk9fjl/1AvwktjQ1P7NjyMGQyfd4vjipX6M/i52D7Cq80kqlcxEcGXRr/FEcgs0u5uGgB4VWuMFfpdn2Re6Hi3PqzmxWKsz6+ae2Pn9hXXw/fqM859UiGC0oKYYILJBqJrsn1Z1E5qOs9rQCiUQRREjm8yJcbHF5cUJufX1vAHlefw0XgUoboS3ETfQlTxBC4SOtuE8VPRJTBSCQSjZCpk7Gqzu+masaZ2y7Zjehho4F3g82BNDkAHpORG4+OCS+f6JTPmtRn/PH1kch6d04sp7AQb25aQ/pqUyXeQ8vrebG8OYQdXOQ+585u0sdW9rqalzRURiJ+9F4MweRFrKUjl1GUYhH1A27WOHw5cTFSFPMo9EeUIGnQTZHIaJ7AHLaOKsOODaNF9jkBjYG2QEsQ2xjMUAx2bBEbeTBWMHwskBjngq56S/yfgkBnWBa4K9sqKtq2t1UI8S9He5XuBRbawAdatrQEAi30Aks2+LM8WeCbalVZkWNylvJ+dqJnzVb+OHlSoKW8nPCP7Rd+CcZ2DdWAGqJ2CBFOphgywFFCFBNtfAbGtNPBCwxvygHeYMZMY9ZboBqwq/pVrsbgN5tkv152ODlbMfiqwGMBgxa4Exz3QhovRIUp6acqZmQzRq0ypDXS2TPLT02YIkQETnOE445oOGxOmXAqUJNNG7XgupMjPq2ua9asrj5yY/yuKteO1Kx0YNJTufrirLe1mZnat7OL6rnUdCWenpW6I8mAnbsY8KWs1PuSovCW9A/Z25PQ24a7cNOqgmTkLmBMgh4THgc4b9k2IVv1/g/F5nGljwPLfOgHAzJzh45V/4+WenTzmMtR5Z7us2Tys909UHqrPY7KbckoxRvRHhmVc3cJGE97uml0R1S0jdULVl7EvZtDFVBF35N9cEdjpgmAiOlFZ+Dtoh93+D3zzHr8RRNZQhnCNMNbcegOvpEwZoL+06cJQ07h+th3fZ/7PVbVC6ngTAV/KoLFuO6+2KFcU651gEb5ugPSIb1D+Xp8V4+k3sEIGnw5mYe4If4k1lFYr6SCzmM2EQ8iWtmwjnBI9kTwe1TlfAmXh7H02by9fW2gsjKwtv0aaURKil4OdV7rDL1MXIFNrhdxohcZXYTnq47WisrKitaObbf5+yvkLi5J6lCNZZ+B6GC38VNBZBDidSS/+mSvh6s+srgC8pyKMvDtt+de3c9fU76ZPfuM8ud4Kv0fyP/LqfepMT/3oZxSqpZaTa1DaQYLY8TFsHYbWYsPoRhRWfL5eSSQbhUGgGC3YLbVMk6PitTFNGpAsNrC6D1VNBKgBHMejaiuRWEWGgsSDBTJjqWIl8kJLlsaLJ2tXDr6xGfT85bM2Q06a46x2HTgvdnV8z5YDy/27J4zt6x2VtkzjoYpkq36kaBr4eQSg7tyiVweWubXZugtadl58ydapfbORfKsDTuZ0OBgx4cfdjCf5tbWNITnL120fdOi1RV1C3uKGzNdwYLcMvZ3BxoPyTOCD1XvXTp7U10gWCVmTV9b3r2z0SkGWovb2hp9I89O8a2smlyaO8muMU+dRmtzp60IzAoFpjLr1n388boLyf0dRvxhsHZ0qbWqDkwqvvpkj4l0fY6EIXRi5sQSrAvsVYwXRy4qJ2EVtD1AN7a0HWth9ymvL1xc3WTUKK/TAHA/bXDVtVWfOMfuGxGZv4Ln/jVr9jc3j1yMv0tndmyt9Vq88Y9gH1wtLX3KWjot5++jWHgAoZZkQ14wGQ20Fli71UmKJAy4xKMSTGbVdybW7FDDAut9XpD5AzWrYO7zQ8qffqF8+Ynd/clrHcdyxGy3a/3+mfNnzC/cBsveTjnTvXf1o6vzOlZw7WtqtdmPK/Errz/6NNtD72zmNOZfbmYdTGHfoofqI79Oc+R2n1lrnL6pOm0Up7kwxhTW12Amm7WYkXR2qYrF2AmgmbAsxZjwy1xpg/m1Je2vrp8v/nz2xpmlBg4E9hrMU341wVpTOh/OfmGvAnra8q6uctr60ZQHV3Q+WMQJykMj8ZsWn2QBOmmHMB+m5pDIpTFonYigiaKAhGEiAHF7EliVnQkjoLVIMPtJpBKHYd3A8GYH9jJzrWwmHx5Qjp7vDAX0suGRym1vtm/9W1/HyR8vczfMs6Sk8DSv855/5dlX9oQq52hT8syyp2rx5Id17IAyAM3wIjQPMOHzytEB64q6D5zT91yNbnx3V/nqnd017S9Y0605k3izoXLpsxde2n38yoOV9s1LcjwzNjbdX6asnBVaBj/6/DwKwPkpcqbDG7BnsXoSqWnUAmottYF6jMSdVyYZh3zVXCjwTiwwHH6sGuRiEHQGzuRX6whZkp123oy1BWE2mEfJ/tvIRtM4ZM5bDXiMsPMaAKOTyc5uL57rqyyc5y5JE5pm1i2S2iUX0CcaQ6lC6Zog7JqSqZmYlosl2K6pwNA84zRnQW6SaALYZQGW5lhCtU/W34N6o+bKfZ8cf3/Cl/+iTX3wBzpOY4mRkeNf3rptycGSshQWgGbYt5jFc2e0+DglIrwl6DVWQ7BuwaJ3Xk1J4VL5urnLl/Wf+gHU/hZoZdKNym6lG+I34FaNeZKcSpJIo2IeCVvpdsDGfKvzJnAwmeD37Ow65ZWwSowpgwX5T69s/rB55dP5BcpgDKFV8p7q2sn/1uc93bVzT/w6UrCqDTWvfCq/oCD/qZXNoUj8BL5Kp6GU017frfNXkAtiiyf/SOCEeLqnd8R/Ql9GlCRfctS6k5chvIBuQ1zCCjoCHL2DHNHIXxMJ3kQeO8lbsUXONeSfA5EjcG6/E+KdhN4bP04vBhdi883+BFBzQbxFbvZzQeY9LNBZc0FNfn5NwfDn6rCTnTw6R8o+gfpf5hCom33cRuiTlss3KHmZjD+BPN+5gXuA2ziS/Q73mLxUkpbKN/eqwz5uK0X9F3h2d1V4nGNgZGBgAOJd776+iue3+crAzc4AAje5Bfcg0xz9YHEOBiYQBQA8FQlFAHicY2BkYGBnAAGOPgaG//85+hkYGVCBMgBGGwNYAAAAeJxjYGBgYB8EmKOPgQEAQ04BfgAAAAAAAA4AaAB+AMwA4AECAUIBbAGYAcICGAJYArQC4AMwA7AD3gQwBJYE3AUkBWYFigYgBmYGtAbqB1gIEghYCG4IhAi2COh4nGNgZGBgUGYoZWBnAAEmIOYCQgaG/2A+AwAYCQG2AHicXZBNaoNAGIZfE5PQCKFQ2lUps2oXBfOzzAESyDKBQJdGR2NQR3QSSE/QE/QEPUUPUHqsvsrXjTMw83zPvPMNCuAWP3DQDAejdm1GjzwS7pMmwi75XngAD4/CQ/oX4TFe4Qt7uMMbOzjuDc0EmXCP/C7cJ38Iu+RP4QEe8CU8pP8WHmOPX2EPz87TPo202ey2OjlnQSXV/6arOjWFmvszMWtd6CqwOlKHq6ovycLaWMWVydXKFFZnmVFlZU46tP7R2nI5ncbi/dDkfDtFBA2DDXbYkhKc+V0Bqs5Zt9JM1HQGBRTm/EezTmZNKtpcAMs9Yu6AK9caF76zoLWIWcfMGOSkVduvSWechqZsz040Ib2PY3urxBJTzriT95lipz+TN1fmAAAAeJxtkMl2wjAMRfOAhABlKm2h80C3+ajgCKKDY6cegP59TYBzukAL+z1Zsq8ctaJTTKPrsUQLbXQQI0EXKXroY4AbDDHCGBNMcYsZ7nCPB8yxwCOe8IwXvOIN7/jAJ76wxHfUqWX+OzgumWAjJMV17i0Ndlr6irLKO+qftdT7i6y4uFSUvCknay+lFYZIZaQcmfH/xIFdYn98bqhra1aKTM/6lWMnyaYirx1rFUQZFBkb2zJUtoXeJCeg0WnLtHeSFc3OtrnozNwqi0TkSpBMDB1nSde5oJXW23hTS2/T0LilglXX7dmFVxLnq5U0vYATHFk3zX3BOisoQHNDFDeZnqKDy9hRNawN7Vh727hFzcJ5c8TILrKZfH7tIPxAFP0BpLeJPA==) format("woff");font-weight:400;font-style:normal}.video-js .vjs-big-play-button .vjs-icon-placeholder:before,.video-js .vjs-play-control .vjs-icon-placeholder,.vjs-icon-play{font-family:VideoJS;font-weight:400;font-style:normal}.video-js .vjs-big-play-button .vjs-icon-placeholder:before,.video-js .vjs-play-control .vjs-icon-placeholder:before,.vjs-icon-play:before{content:"\\F101"}.vjs-icon-play-circle{font-family:VideoJS;font-weight:400;font-style:normal}.vjs-icon-play-circle:before{content:"\\F102"}.video-js .vjs-play-control.vjs-playing .vjs-icon-placeholder,.vjs-icon-pause{font-family:VideoJS;font-weight:400;font-style:normal}.video-js .vjs-play-control.vjs-playing .vjs-icon-placeholder:before,.vjs-icon-pause:before{content:"\\F103"}.video-js .vjs-mute-control.vjs-vol-0 .vjs-icon-placeholder,.vjs-icon-volume-mute{font-family:VideoJS;font-weight:400;font-style:normal}.video-js .vjs-mute-control.vjs-vol-0 .vjs-icon-placeholder:before,.vjs-icon-volume-mute:before{content:"\\F104"}.video-js .vjs-mute-control.vjs-vol-1 .vjs-icon-placeholder,.vjs-icon-volume-low{font-family:VideoJS;font-weight:400;font-style:normal}.video-js .vjs-mute-control.vjs-vol-1 .vjs-icon-placeholder:before,.vjs-icon-volume-low:before{content:"\\F105"}.video-js .vjs-mute-control.vjs-vol-2 .vjs-icon-placeholder,.vjs-icon-volume-mid{font-family:VideoJS;font-weight:400;font-style:normal}.video-js .vjs-mute-control.vjs-vol-2 .vjs-icon-placeholder:before,.vjs-icon-volume-mid:before{content:"\\F106"}.video-js .vjs-mute-control .vjs-icon-placeholder,.vjs-icon-volume-high{font-family:VideoJS;font-weight:400;font-style:normal}.video-js .vjs-mute-control .vjs-icon-placeholder:before,.vjs-icon-volume-high:before{content:"\\F107"}.video-js .vjs-fullscreen-control .vjs-icon-placeholder,.vjs-icon-fullscreen-enter{font-family:VideoJS;font-weight:400;font-style:normal}.video-js .vjs-fullscreen-control .vjs-icon-placeholder:before,.vjs-icon-fullscreen-enter:before{content:"\\F108"}.video-js.vjs-fullscreen .vjs-fullscreen-control .vjs-icon-placeholder,.vjs-icon-fullscreen-exit{font-family:VideoJS;font-weight:400;font-style:normal}.video-js.vjs-fullscreen .vjs-fullscreen-control .vjs-icon-placeholder:before,.vjs-icon-fullscreen-exit:before{content:"\\F109"}.vjs-icon-square{font-family:VideoJS;font-weight:400;font-style:normal}.vjs-icon-square:before{content:"\\F10A"}.vjs-icon-spinner{font-family:VideoJS;font-weight:400;font-style:normal}.vjs-icon-spinner:before{content:"\\F10B"}.video-js.video-js:lang(en-AU) .vjs-subs-caps-button .vjs-icon-placeholder,.video-js.video-js:lang(en-GB) .vjs-subs-caps-button .vjs-icon-placeholder,.video-js.video-js:lang(en-IE) .vjs-subs-caps-button .vjs-icon-placeholder,.video-js.video-js:lang(en-NZ) .vjs-subs-caps-button .vjs-icon-placeholder,.video-js .vjs-subs-caps-button .vjs-icon-placeholder,.video-js .vjs-subtitles-button .vjs-icon-placeholder,.vjs-icon-subtitles{font-family:VideoJS;font-weight:400;font-style:normal}.video-js.video-js:lang(en-AU) .vjs-subs-caps-button .vjs-icon-placeholder:before,.video-js.video-js:lang(en-GB) .vjs-subs-caps-button .vjs-icon-placeholder:before,.video-js.video-js:lang(en-IE) .vjs-subs-caps-button .vjs-icon-placeholder:before,.video-js.video-js:lang(en-NZ) .vjs-subs-caps-button .vjs-icon-placeholder:before,.video-js .vjs-subs-caps-button .vjs-icon-placeholder:before,.video-js .vjs-subtitles-button .vjs-icon-placeholder:before,.vjs-icon-subtitles:before{content:"\\F10C"}.video-js .vjs-captions-button .vjs-icon-placeholder,.video-js:lang(en) .vjs-subs-caps-button .vjs-icon-placeholder,.video-js:lang(fr-CA) .vjs-subs-caps-button .vjs-icon-placeholder,.vjs-icon-captions{font-family:VideoJS;font-weight:400;font-style:normal}.video-js .vjs-captions-button .vjs-icon-placeholder:before,.video-js:lang(en) .vjs-subs-caps-button .vjs-icon-placeholder:before,.video-js:lang(fr-CA) .vjs-subs-caps-button .vjs-icon-placeholder:before,.vjs-icon-captions:before{content:"\\F10D"}.video-js .vjs-chapters-button .vjs-icon-placeholder,.vjs-icon-chapters{font-family:VideoJS;font-weight:400;font-style:normal}.video-js .vjs-chapters-button .vjs-icon-placeholder:before,.vjs-icon-chapters:before{content:"\\F10E"}.vjs-icon-share{font-family:VideoJS;font-weight:400;font-style:normal}.vjs-icon-share:before{content:"\\F10F"}.vjs-icon-cog{font-family:VideoJS;font-weight:400;font-style:normal}.vjs-icon-cog:before{content:"\\F110"}.video-js .vjs-play-progress,.video-js .vjs-volume-level,.vjs-icon-circle,.vjs-seek-to-live-control .vjs-icon-placeholder{font-family:VideoJS;font-weight:400;font-style:normal}.video-js .vjs-play-progress:before,.video-js .vjs-volume-level:before,.vjs-icon-circle:before,.vjs-seek-to-live-control .vjs-icon-placeholder:before{content:"\\F111"}.vjs-icon-circle-outline{font-family:VideoJS;font-weight:400;font-style:normal}.vjs-icon-circle-outline:before{content:"\\F112"}.vjs-icon-circle-inner-circle{font-family:VideoJS;font-weight:400;font-style:normal}.vjs-icon-circle-inner-circle:before{content:"\\F113"}.vjs-icon-hd{font-family:VideoJS;font-weight:400;font-style:normal}.vjs-icon-hd:before{content:"\\F114"}.video-js .vjs-control.vjs-close-button .vjs-icon-placeholder,.vjs-icon-cancel{font-family:VideoJS;font-weight:400;font-style:normal}.video-js .vjs-control.vjs-close-button .vjs-icon-placeholder:before,.vjs-icon-cancel:before{content:"\\F115"}.video-js .vjs-play-control.vjs-ended .vjs-icon-placeholder,.vjs-icon-replay{font-family:VideoJS;font-weight:400;font-style:normal}.video-js .vjs-play-control.vjs-ended .vjs-icon-placeholder:before,.vjs-icon-replay:before{content:"\\F116"}.vjs-icon-facebook{font-family:VideoJS;font-weight:400;font-style:normal}.vjs-icon-facebook:before{content:"\\F117"}.vjs-icon-gplus{font-family:VideoJS;font-weight:400;font-style:normal}.vjs-icon-gplus:before{content:"\\F118"}.vjs-icon-linkedin{font-family:VideoJS;font-weight:400;font-style:normal}.vjs-icon-linkedin:before{content:"\\F119"}.vjs-icon-twitter{font-family:VideoJS;font-weight:400;font-style:normal}.vjs-icon-twitter:before{content:"\\F11A"}.vjs-icon-tumblr{font-family:VideoJS;font-weight:400;font-style:normal}.vjs-icon-tumblr:before{content:"\\F11B"}.vjs-icon-pinterest{font-family:VideoJS;font-weight:400;font-style:normal}.vjs-icon-pinterest:before{content:"\\F11C"}.video-js .vjs-descriptions-button .vjs-icon-placeholder,.vjs-icon-audio-description{font-family:VideoJS;font-weight:400;font-style:normal}.video-js .vjs-descriptions-button .vjs-icon-placeholder:before,.vjs-icon-audio-description:before{content:"\\F11D"}.video-js .vjs-audio-button .vjs-icon-placeholder,.vjs-icon-audio{font-family:VideoJS;font-weight:400;font-style:normal}.video-js .vjs-audio-button .vjs-icon-placeholder:before,.vjs-icon-audio:before{content:"\\F11E"}.vjs-icon-next-item{font-family:VideoJS;font-weight:400;font-style:normal}.vjs-icon-next-item:before{content:"\\F11F"}.vjs-icon-previous-item{font-family:VideoJS;font-weight:400;font-style:normal}.vjs-icon-previous-item:before{content:"\\F120"}.video-js .vjs-picture-in-picture-control .vjs-icon-placeholder,.vjs-icon-picture-in-picture-enter{font-family:VideoJS;font-weight:400;font-style:normal}.video-js .vjs-picture-in-picture-control .vjs-icon-placeholder:before,.vjs-icon-picture-in-picture-enter:before{content:"\\F121"}.video-js.vjs-picture-in-picture .vjs-picture-in-picture-control .vjs-icon-placeholder,.vjs-icon-picture-in-picture-exit{font-family:VideoJS;font-weight:400;font-style:normal}.video-js.vjs-picture-in-picture .vjs-picture-in-picture-control .vjs-icon-placeholder:before,.vjs-icon-picture-in-picture-exit:before{content:"\\F122"}.video-js{display:block;vertical-align:top;box-sizing:border-box;color:#fff;background-color:#000;position:relative;padding:0;font-size:10px;line-height:1;font-weight:400;font-style:normal;font-family:Arial,Helvetica,sans-serif;word-break:normal}.video-js:-moz-full-screen{position:absolute}.video-js:-webkit-full-screen{width:100%!important;height:100%!important}.video-js[tabindex="-1"]{outline:none}.video-js *,.video-js :after,.video-js :before{box-sizing:inherit}.video-js ul{font-family:inherit;font-size:inherit;line-height:inherit;list-style-position:outside;margin:0}.video-js.vjs-4-3,.video-js.vjs-16-9,.video-js.vjs-fluid{width:100%;max-width:100%;height:0}.video-js.vjs-16-9{padding-top:56.25%}.video-js.vjs-4-3{padding-top:75%}.video-js.vjs-fill,.video-js .vjs-tech{width:100%;height:100%}.video-js .vjs-tech{position:absolute;top:0;left:0}body.vjs-full-window{padding:0;margin:0;height:100%}.vjs-full-window .video-js.vjs-fullscreen{position:fixed;overflow:hidden;z-index:1000;left:0;top:0;bottom:0;right:0}.video-js.vjs-fullscreen{width:100%!important;height:100%!important;padding-top:0!important}.video-js.vjs-fullscreen.vjs-user-inactive{cursor:none}.vjs-hidden{display:none!important}.vjs-disabled{opacity:.5;cursor:default}.video-js .vjs-offscreen{height:1px;left:-9999px;position:absolute;top:0;width:1px}.vjs-lock-showing{display:block!important;opacity:1;visibility:visible}.vjs-no-js{padding:20px;color:#fff;background-color:#000;font-size:18px;font-family:Arial,Helvetica,sans-serif;text-align:center;width:300px;height:150px;margin:0 auto}.vjs-no-js a,.vjs-no-js a:visited{color:#66a8cc}.video-js .vjs-big-play-button{font-size:3em;line-height:1.5em;height:1.63332em;width:3em;display:block;position:absolute;top:10px;left:10px;padding:0;cursor:pointer;opacity:1;border:.06666em solid #fff;background-color:#2b333f;background-color:rgba(43,51,63,.7);border-radius:.3em;transition:all .4s}.vjs-big-play-centered .vjs-big-play-button{top:50%;left:50%;margin-top:-.81666em;margin-left:-1.5em}.video-js .vjs-big-play-button:focus,.video-js:hover .vjs-big-play-button{border-color:#fff;background-color:#73859f;background-color:rgba(115,133,159,.5);transition:all 0s}.vjs-controls-disabled .vjs-big-play-button,.vjs-error .vjs-big-play-button,.vjs-has-started .vjs-big-play-button,.vjs-using-native-controls .vjs-big-play-button{display:none}.vjs-has-started.vjs-paused.vjs-show-big-play-button-on-pause .vjs-big-play-button{display:block}.video-js button{background:none;border:none;color:inherit;display:inline-block;font-size:inherit;line-height:inherit;text-transform:none;text-decoration:none;transition:none;-webkit-appearance:none;-moz-appearance:none;appearance:none}.vjs-control .vjs-button{width:100%;height:100%}.video-js .vjs-control.vjs-close-button{cursor:pointer;height:3em;position:absolute;right:0;top:.5em;z-index:2}.video-js .vjs-modal-dialog{background:rgba(0,0,0,.8);background:linear-gradient(180deg,rgba(0,0,0,.8),hsla(0,0%,100%,0));overflow:auto}.video-js .vjs-modal-dialog>*{box-sizing:border-box}.vjs-modal-dialog .vjs-modal-dialog-content{font-size:1.2em;line-height:1.5;padding:20px 24px;z-index:1}.vjs-menu-button{cursor:pointer}.vjs-menu-button.vjs-disabled{cursor:default}.vjs-workinghover .vjs-menu-button.vjs-disabled:hover .vjs-menu{display:none}.vjs-menu .vjs-menu-content{display:block;padding:0;margin:0;font-family:Arial,Helvetica,sans-serif;overflow:auto}.vjs-menu .vjs-menu-content>*{box-sizing:border-box}.vjs-scrubbing .vjs-control.vjs-menu-button:hover .vjs-menu{display:none}.vjs-menu li{list-style:none;margin:0;padding:.2em 0;line-height:1.4em;font-size:1.2em;text-align:center;text-transform:lowercase}.js-focus-visible .vjs-menu li.vjs-menu-item:hover,.vjs-menu li.vjs-menu-item:focus,.vjs-menu li.vjs-menu-item:hover{background-color:#73859f;background-color:rgba(115,133,159,.5)}.js-focus-visible .vjs-menu li.vjs-selected:hover,.vjs-menu li.vjs-selected,.vjs-menu li.vjs-selected:focus,.vjs-menu li.vjs-selected:hover{background-color:#fff;color:#2b333f}.vjs-menu li.vjs-menu-title{text-align:center;text-transform:uppercase;font-size:1em;line-height:2em;padding:0;margin:0 0 .3em;font-weight:700;cursor:default}.vjs-menu-button-popup .vjs-menu{display:none;position:absolute;bottom:0;width:10em;left:-3em;height:0;margin-bottom:1.5em;border-top-color:rgba(43,51,63,.7)}.vjs-menu-button-popup .vjs-menu .vjs-menu-content{background-color:#2b333f;background-color:rgba(43,51,63,.7);position:absolute;width:100%;bottom:1.5em;max-height:15em}.vjs-layout-tiny .vjs-menu-button-popup .vjs-menu .vjs-menu-content,.vjs-layout-x-small .vjs-menu-button-popup .vjs-menu .vjs-menu-content{max-height:5em}.vjs-layout-small .vjs-menu-button-popup .vjs-menu .vjs-menu-content{max-height:10em}.vjs-layout-medium .vjs-menu-button-popup .vjs-menu .vjs-menu-content{max-height:14em}.vjs-layout-huge .vjs-menu-button-popup .vjs-menu .vjs-menu-content,.vjs-layout-large .vjs-menu-button-popup .vjs-menu .vjs-menu-content,.vjs-layout-x-large .vjs-menu-button-popup .vjs-menu .vjs-menu-content{max-height:25em}.vjs-menu-button-popup .vjs-menu.vjs-lock-showing,.vjs-workinghover .vjs-menu-button-popup.vjs-hover .vjs-menu{display:block}.video-js .vjs-menu-button-inline{transition:all .4s;overflow:hidden}.video-js .vjs-menu-button-inline:before{width:2.222222222em}.video-js .vjs-menu-button-inline.vjs-slider-active,.video-js .vjs-menu-button-inline:focus,.video-js .vjs-menu-button-inline:hover,.video-js.vjs-no-flex .vjs-menu-button-inline{width:12em}.vjs-menu-button-inline .vjs-menu{opacity:0;height:100%;width:auto;position:absolute;left:4em;top:0;padding:0;margin:0;transition:all .4s}.vjs-menu-button-inline.vjs-slider-active .vjs-menu,.vjs-menu-button-inline:focus .vjs-menu,.vjs-menu-button-inline:hover .vjs-menu{display:block;opacity:1}.vjs-no-flex .vjs-menu-button-inline .vjs-menu{display:block;opacity:1;position:relative;width:auto}.vjs-no-flex .vjs-menu-button-inline.vjs-slider-active .vjs-menu,.vjs-no-flex .vjs-menu-button-inline:focus .vjs-menu,.vjs-no-flex .vjs-menu-button-inline:hover .vjs-menu{width:auto}.vjs-menu-button-inline .vjs-menu-content{width:auto;height:100%;margin:0;overflow:hidden}.video-js .vjs-control-bar{display:none;width:100%;position:absolute;bottom:0;left:0;right:0;height:3em;background-color:#2b333f;background-color:rgba(43,51,63,.7)}.vjs-has-started .vjs-control-bar{display:flex;visibility:visible;opacity:1;transition:visibility .1s,opacity .1s}.vjs-has-started.vjs-user-inactive.vjs-playing .vjs-control-bar{visibility:visible;opacity:0;transition:visibility 1s,opacity 1s}.vjs-controls-disabled .vjs-control-bar,.vjs-error .vjs-control-bar,.vjs-using-native-controls .vjs-control-bar{display:none!important}.vjs-audio.vjs-has-started.vjs-user-inactive.vjs-playing .vjs-control-bar{opacity:1;visibility:visible}.vjs-has-started.vjs-no-flex .vjs-control-bar{display:table}.video-js .vjs-control{position:relative;text-align:center;margin:0;padding:0;height:100%;width:4em;flex:none}.vjs-button>.vjs-icon-placeholder:before{font-size:1.8em;line-height:1.67}.video-js .vjs-control:focus,.video-js .vjs-control:focus:before,.video-js .vjs-control:hover:before{text-shadow:0 0 1em #fff}.video-js .vjs-control-text{border:0;clip:rect(0 0 0 0);height:1px;overflow:hidden;padding:0;position:absolute;width:1px}.vjs-no-flex .vjs-control{display:table-cell;vertical-align:middle}.video-js .vjs-custom-control-spacer{display:none}.video-js .vjs-progress-control{cursor:pointer;flex:auto;display:flex;align-items:center;min-width:4em;touch-action:none}.video-js .vjs-progress-control.disabled{cursor:default}.vjs-live .vjs-progress-control{display:none}.vjs-liveui .vjs-progress-control{display:flex;align-items:center}.vjs-no-flex .vjs-progress-control{width:auto}.video-js .vjs-progress-holder{flex:auto;transition:all .2s;height:.3em}.video-js .vjs-progress-control .vjs-progress-holder{margin:0 10px}.video-js .vjs-progress-control:hover .vjs-progress-holder{font-size:1.6666666667em}.video-js .vjs-progress-control:hover .vjs-progress-holder.disabled{font-size:1em}.video-js .vjs-progress-holder .vjs-load-progress,.video-js .vjs-progress-holder .vjs-load-progress div,.video-js .vjs-progress-holder .vjs-play-progress{position:absolute;display:block;height:100%;margin:0;padding:0;width:0}.video-js .vjs-play-progress{background-color:#fff}.video-js .vjs-play-progress:before{font-size:.9em;position:absolute;right:-.5em;top:-.3333333333em;z-index:1}.video-js .vjs-load-progress{background:rgba(115,133,159,.5)}.video-js .vjs-load-progress div{background:rgba(115,133,159,.75)}.video-js .vjs-time-tooltip{background-color:#fff;background-color:hsla(0,0%,100%,.8);border-radius:.3em;color:#000;float:right;font-family:Arial,Helvetica,sans-serif;font-size:1em;padding:6px 8px 8px;pointer-events:none;position:absolute;top:-3.4em;visibility:hidden;z-index:1}.video-js .vjs-progress-holder:focus .vjs-time-tooltip{display:none}.video-js .vjs-progress-control:hover .vjs-progress-holder:focus .vjs-time-tooltip,.video-js .vjs-progress-control:hover .vjs-time-tooltip{display:block;font-size:.6em;visibility:visible}.video-js .vjs-progress-control.disabled:hover .vjs-time-tooltip{font-size:1em}.video-js .vjs-progress-control .vjs-mouse-display{display:none;position:absolute;width:1px;height:100%;background-color:#000;z-index:1}.vjs-no-flex .vjs-progress-control .vjs-mouse-display{z-index:0}.video-js .vjs-progress-control:hover .vjs-mouse-display{display:block}.video-js.vjs-user-inactive .vjs-progress-control .vjs-mouse-display{visibility:hidden;opacity:0;transition:visibility 1s,opacity 1s}.video-js.vjs-user-inactive.vjs-no-flex .vjs-progress-control .vjs-mouse-display{display:none}.vjs-mouse-display .vjs-time-tooltip{color:#fff;background-color:#000;background-color:rgba(0,0,0,.8)}.video-js .vjs-slider{position:relative;cursor:pointer;padding:0;margin:0 .45em;-webkit-touch-callout:none;-webkit-user-select:none;-moz-user-select:none;-ms-user-select:none;user-select:none;background-color:#73859f;background-color:rgba(115,133,159,.5)}.video-js .vjs-slider.disabled{cursor:default}.video-js .vjs-slider:focus{text-shadow:0 0 1em #fff;box-shadow:0 0 1em #fff}.video-js .vjs-mute-control{cursor:pointer;flex:none}.video-js .vjs-volume-control{cursor:pointer;margin-right:1em;display:flex}.video-js .vjs-volume-control.vjs-volume-horizontal{width:5em}.video-js .vjs-volume-panel .vjs-volume-control{visibility:visible;opacity:0;width:1px;height:1px;margin-left:-1px}.video-js .vjs-volume-panel{transition:width 1s}.video-js .vjs-volume-panel.vjs-hover .vjs-mute-control~.vjs-volume-control,.video-js .vjs-volume-panel.vjs-hover .vjs-volume-control,.video-js .vjs-volume-panel .vjs-volume-control.vjs-slider-active,.video-js .vjs-volume-panel .vjs-volume-control:active,.video-js .vjs-volume-panel:active .vjs-volume-control,.video-js .vjs-volume-panel:focus .vjs-volume-control{visibility:visible;opacity:1;position:relative;transition:visibility .1s,opacity .1s,height .1s,width .1s,left 0s,top 0s}.video-js .vjs-volume-panel.vjs-hover .vjs-mute-control~.vjs-volume-control.vjs-volume-horizontal,.video-js .vjs-volume-panel.vjs-hover .vjs-volume-control.vjs-volume-horizontal,.video-js .vjs-volume-panel .vjs-volume-control.vjs-slider-active.vjs-volume-horizontal,.video-js .vjs-volume-panel .vjs-volume-control:active.vjs-volume-horizontal,.video-js .vjs-volume-panel:active .vjs-volume-control.vjs-volume-horizontal,.video-js .vjs-volume-panel:focus .vjs-volume-control.vjs-volume-horizontal{width:5em;height:3em}.video-js .vjs-volume-panel.vjs-hover .vjs-mute-control~.vjs-volume-control.vjs-volume-vertical,.video-js .vjs-volume-panel.vjs-hover .vjs-volume-control.vjs-volume-vertical,.video-js .vjs-volume-panel .vjs-volume-control.vjs-slider-active.vjs-volume-vertical,.video-js .vjs-volume-panel .vjs-volume-control:active.vjs-volume-vertical,.video-js .vjs-volume-panel:active .vjs-volume-control.vjs-volume-vertical,.video-js .vjs-volume-panel:focus .vjs-volume-control.vjs-volume-vertical{left:-3.5em;transition:left 0s}.video-js .vjs-volume-panel.vjs-volume-panel-horizontal.vjs-hover,.video-js .vjs-volume-panel.vjs-volume-panel-horizontal.vjs-slider-active,.video-js .vjs-volume-panel.vjs-volume-panel-horizontal:active{width:9em;transition:width .1s}.video-js .vjs-volume-panel.vjs-volume-panel-horizontal.vjs-mute-toggle-only{width:4em}.video-js .vjs-volume-panel .vjs-volume-control.vjs-volume-vertical{height:8em;width:3em;left:-3000em;transition:visibility 1s,opacity 1s,height 1s 1s,width 1s 1s,left 1s 1s,top 1s 1s}.video-js .vjs-volume-panel .vjs-volume-control.vjs-volume-horizontal{transition:visibility 1s,opacity 1s,height 1s 1s,width 1s,left 1s 1s,top 1s 1s}.video-js.vjs-no-flex .vjs-volume-panel .vjs-volume-control.vjs-volume-horizontal{width:5em;height:3em;visibility:visible;opacity:1;position:relative;transition:none}.video-js.vjs-no-flex .vjs-volume-control.vjs-volume-vertical,.video-js.vjs-no-flex .vjs-volume-panel .vjs-volume-control.vjs-volume-vertical{position:absolute;bottom:3em;left:.5em}.video-js .vjs-volume-panel{display:flex}.video-js .vjs-volume-bar{margin:1.35em .45em}.vjs-volume-bar.vjs-slider-horizontal{width:5em;height:.3em}.vjs-volume-bar.vjs-slider-vertical{width:.3em;height:5em;margin:1.35em auto}.video-js .vjs-volume-level{position:absolute;bottom:0;left:0;background-color:#fff}.video-js .vjs-volume-level:before{position:absolute;font-size:.9em}.vjs-slider-vertical .vjs-volume-level{width:.3em}.vjs-slider-vertical .vjs-volume-level:before{top:-.5em;left:-.3em}.vjs-slider-horizontal .vjs-volume-level{height:.3em}.vjs-slider-horizontal .vjs-volume-level:before{top:-.3em;right:-.5em}.video-js .vjs-volume-panel.vjs-volume-panel-vertical{width:4em}.vjs-volume-bar.vjs-slider-vertical .vjs-volume-level{height:100%}.vjs-volume-bar.vjs-slider-horizontal .vjs-volume-level{width:100%}.video-js .vjs-volume-vertical{width:3em;height:8em;bottom:8em;background-color:#2b333f;background-color:rgba(43,51,63,.7)}.video-js .vjs-volume-horizontal .vjs-menu{left:-2em}.vjs-poster{display:inline-block;vertical-align:middle;background-repeat:no-repeat;background-position:50% 50%;background-size:contain;background-color:#000;cursor:pointer;margin:0;padding:0;position:absolute;top:0;right:0;bottom:0;left:0;height:100%}.vjs-has-started .vjs-poster{display:none}.vjs-audio.vjs-has-started .vjs-poster{display:block}.vjs-using-native-controls .vjs-poster{display:none}.video-js .vjs-live-control{display:flex;align-items:flex-start;flex:auto;font-size:1em;line-height:3em}.vjs-no-flex .vjs-live-control{display:table-cell;width:auto;text-align:left}.video-js.vjs-liveui .vjs-live-control,.video-js:not(.vjs-live) .vjs-live-control{display:none}.video-js .vjs-seek-to-live-control{cursor:pointer;flex:none;display:inline-flex;height:100%;padding-left:.5em;padding-right:.5em;font-size:1em;line-height:3em;width:auto;min-width:4em}.vjs-no-flex .vjs-seek-to-live-control{display:table-cell;width:auto;text-align:left}.video-js.vjs-live:not(.vjs-liveui) .vjs-seek-to-live-control,.video-js:not(.vjs-live) .vjs-seek-to-live-control{display:none}.vjs-seek-to-live-control.vjs-control.vjs-at-live-edge{cursor:auto}.vjs-seek-to-live-control .vjs-icon-placeholder{margin-right:.5em;color:#888}.vjs-seek-to-live-control.vjs-control.vjs-at-live-edge .vjs-icon-placeholder{color:red}.video-js .vjs-time-control{flex:none;font-size:1em;line-height:3em;min-width:2em;width:auto;padding-left:1em;padding-right:1em}.video-js .vjs-current-time,.video-js .vjs-duration,.vjs-live .vjs-time-control,.vjs-no-flex .vjs-current-time,.vjs-no-flex .vjs-duration{display:none}.vjs-time-divider{display:none;line-height:3em}.vjs-live .vjs-time-divider{display:none}.video-js .vjs-play-control{cursor:pointer}.video-js .vjs-play-control .vjs-icon-placeholder{flex:none}.vjs-text-track-display{position:absolute;bottom:3em;left:0;right:0;top:0;pointer-events:none}.video-js.vjs-user-inactive.vjs-playing .vjs-text-track-display{bottom:1em}.video-js .vjs-text-track{font-size:1.4em;text-align:center;margin-bottom:.1em}.vjs-subtitles{color:#fff}.vjs-captions{color:#fc6}.vjs-tt-cue{display:block}video::-webkit-media-text-track-display{transform:translateY(-3em)}.video-js.vjs-user-inactive.vjs-playing video::-webkit-media-text-track-display{transform:translateY(-1.5em)}.video-js .vjs-fullscreen-control,.video-js .vjs-picture-in-picture-control{cursor:pointer;flex:none}.vjs-playback-rate .vjs-playback-rate-value,.vjs-playback-rate>.vjs-menu-button{position:absolute;top:0;left:0;width:100%;height:100%}.vjs-playback-rate .vjs-playback-rate-value{pointer-events:none;font-size:1.5em;line-height:2;text-align:center}.vjs-playback-rate .vjs-menu{width:4em;left:0}.vjs-error .vjs-error-display .vjs-modal-dialog-content{font-size:1.4em;text-align:center}.vjs-error .vjs-error-display:before{color:#fff;content:"X";font-family:Arial,Helvetica,sans-serif;font-size:4em;left:0;line-height:1;margin-top:-.5em;position:absolute;text-shadow:.05em .05em .1em #000;text-align:center;top:50%;vertical-align:middle;width:100%}.vjs-loading-spinner{display:none;position:absolute;top:50%;left:50%;margin:-25px 0 0 -25px;opacity:.85;text-align:left;border:6px solid rgba(43,51,63,.7);box-sizing:border-box;background-clip:padding-box;width:50px;height:50px;border-radius:25px;visibility:hidden}.vjs-seeking .vjs-loading-spinner,.vjs-waiting .vjs-loading-spinner{display:block;-webkit-animation:vjs-spinner-show 0s linear .3s forwards;animation:vjs-spinner-show 0s linear .3s forwards}.vjs-loading-spinner:after,.vjs-loading-spinner:before{content:"";position:absolute;margin:-6px;box-sizing:inherit;width:inherit;height:inherit;border-radius:inherit;opacity:1;border:inherit;border-color:transparent;border-top-color:#fff}.vjs-seeking .vjs-loading-spinner:after,.vjs-seeking .vjs-loading-spinner:before,.vjs-waiting .vjs-loading-spinner:after,.vjs-waiting .vjs-loading-spinner:before{-webkit-animation:vjs-spinner-spin 1.1s cubic-bezier(.6,.2,0,.8) infinite,vjs-spinner-fade 1.1s linear infinite;animation:vjs-spinner-spin 1.1s cubic-bezier(.6,.2,0,.8) infinite,vjs-spinner-fade 1.1s linear infinite}.vjs-seeking .vjs-loading-spinner:before,.vjs-waiting .vjs-loading-spinner:before{border-top-color:#fff}.vjs-seeking .vjs-loading-spinner:after,.vjs-waiting .vjs-loading-spinner:after{border-top-color:#fff;-webkit-animation-delay:.44s;animation-delay:.44s}@keyframes vjs-spinner-show{to{visibility:visible}}@-webkit-keyframes vjs-spinner-show{to{visibility:visible}}@keyframes vjs-spinner-spin{to{transform:rotate(1turn)}}@-webkit-keyframes vjs-spinner-spin{to{-webkit-transform:rotate(1turn)}}@keyframes vjs-spinner-fade{0%{border-top-color:#73859f}20%{border-top-color:#73859f}35%{border-top-color:#fff}60%{border-top-color:#73859f}to{border-top-color:#73859f}}@-webkit-keyframes vjs-spinner-fade{0%{border-top-color:#73859f}20%{border-top-color:#73859f}35%{border-top-color:#fff}60%{border-top-color:#73859f}to{border-top-color:#73859f}}.vjs-chapters-button .vjs-menu ul{width:24em}.video-js .vjs-subs-caps-button+.vjs-menu .vjs-captions-menu-item .vjs-menu-item-text .vjs-icon-placeholder{vertical-align:middle;display:inline-block;margin-bottom:-.1em}.video-js .vjs-subs-caps-button+.vjs-menu .vjs-captions-menu-item .vjs-menu-item-text .vjs-icon-placeholder:before{font-family:VideoJS;content:"\\F10D";font-size:1.5em;line-height:inherit}.video-js .vjs-audio-button+.vjs-menu .vjs-main-desc-menu-item .vjs-menu-item-text .vjs-icon-placeholder{vertical-align:middle;display:inline-block;margin-bottom:-.1em}.video-js .vjs-audio-button+.vjs-menu .vjs-main-desc-menu-item .vjs-menu-item-text .vjs-icon-placeholder:before{font-family:VideoJS;content:" \\F11D";font-size:1.5em;line-height:inherit}.video-js:not(.vjs-fullscreen).vjs-layout-small .vjs-audio-button,.video-js:not(.vjs-fullscreen).vjs-layout-small .vjs-captions-button,.video-js:not(.vjs-fullscreen).vjs-layout-small .vjs-chapters-button,.video-js:not(.vjs-fullscreen).vjs-layout-small .vjs-current-time,.video-js:not(.vjs-fullscreen).vjs-layout-small .vjs-descriptions-button,.video-js:not(.vjs-fullscreen).vjs-layout-small .vjs-duration,.video-js:not(.vjs-fullscreen).vjs-layout-small .vjs-playback-rate,.video-js:not(.vjs-fullscreen).vjs-layout-small .vjs-remaining-time,.video-js:not(.vjs-fullscreen).vjs-layout-small .vjs-subtitles-button,.video-js:not(.vjs-fullscreen).vjs-layout-small .vjs-time-divider,.video-js:not(.vjs-fullscreen).vjs-layout-small .vjs-volume-control,.video-js:not(.vjs-fullscreen).vjs-layout-tiny .vjs-audio-button,.video-js:not(.vjs-fullscreen).vjs-layout-tiny .vjs-captions-button,.video-js:not(.vjs-fullscreen).vjs-layout-tiny .vjs-chapters-button,.video-js:not(.vjs-fullscreen).vjs-layout-tiny .vjs-current-time,.video-js:not(.vjs-fullscreen).vjs-layout-tiny .vjs-descriptions-button,.video-js:not(.vjs-fullscreen).vjs-layout-tiny .vjs-duration,.video-js:not(.vjs-fullscreen).vjs-layout-tiny .vjs-playback-rate,.video-js:not(.vjs-fullscreen).vjs-layout-tiny .vjs-remaining-time,.video-js:not(.vjs-fullscreen).vjs-layout-tiny .vjs-subtitles-button,.video-js:not(.vjs-fullscreen).vjs-layout-tiny .vjs-time-divider,.video-js:not(.vjs-fullscreen).vjs-layout-tiny .vjs-volume-control,.video-js:not(.vjs-fullscreen).vjs-layout-x-small .vjs-audio-button,.video-js:not(.vjs-fullscreen).vjs-layout-x-small .vjs-captions-button,.video-js:not(.vjs-fullscreen).vjs-layout-x-small .vjs-chapters-button,.video-js:not(.vjs-fullscreen).vjs-layout-x-small .vjs-current-time,.video-js:not(.vjs-fullscreen).vjs-layout-x-small .vjs-descriptions-button,.video-js:not(.vjs-fullscreen).vjs-layout-x-small .vjs-duration,.video-js:not(.vjs-fullscreen).vjs-layout-x-small .vjs-playback-rate,.video-js:not(.vjs-fullscreen).vjs-layout-x-small .vjs-remaining-time,.video-js:not(.vjs-fullscreen).vjs-layout-x-small .vjs-subtitles-button,.video-js:not(.vjs-fullscreen).vjs-layout-x-small .vjs-time-divider,.video-js:not(.vjs-fullscreen).vjs-layout-x-small .vjs-volume-control{display:none}.video-js:not(.vjs-fullscreen).vjs-layout-small .vjs-volume-panel.vjs-volume-panel-horizontal.vjs-slider-active,.video-js:not(.vjs-fullscreen).vjs-layout-small .vjs-volume-panel.vjs-volume-panel-horizontal:active,.video-js:not(.vjs-fullscreen).vjs-layout-small .vjs-volume-panel.vjs-volume-panel-horizontal:hover,.video-js:not(.vjs-fullscreen).vjs-layout-tiny .vjs-volume-panel.vjs-volume-panel-horizontal.vjs-slider-active,.video-js:not(.vjs-fullscreen).vjs-layout-tiny .vjs-volume-panel.vjs-volume-panel-horizontal:active,.video-js:not(.vjs-fullscreen).vjs-layout-tiny .vjs-volume-panel.vjs-volume-panel-horizontal:hover,.video-js:not(.vjs-fullscreen).vjs-layout-x-small .vjs-volume-panel.vjs-volume-panel-horizontal.vjs-slider-active,.video-js:not(.vjs-fullscreen).vjs-layout-x-small .vjs-volume-panel.vjs-volume-panel-horizontal:active,.video-js:not(.vjs-fullscreen).vjs-layout-x-small .vjs-volume-panel.vjs-volume-panel-horizontal:hover{width:auto}.video-js:not(.vjs-fullscreen).vjs-layout-tiny .vjs-subs-caps-button,.video-js:not(.vjs-fullscreen).vjs-layout-x-small:not(.vjs-live) .vjs-subs-caps-button,.video-js:not(.vjs-fullscreen).vjs-layout-x-small:not(.vjs-liveui) .vjs-subs-caps-button{display:none}.video-js:not(.vjs-fullscreen).vjs-layout-tiny .vjs-custom-control-spacer,.video-js:not(.vjs-fullscreen).vjs-layout-x-small.vjs-liveui .vjs-custom-control-spacer{flex:auto;display:block}.video-js:not(.vjs-fullscreen).vjs-layout-tiny.vjs-no-flex .vjs-custom-control-spacer,.video-js:not(.vjs-fullscreen).vjs-layout-x-small.vjs-liveui.vjs-no-flex .vjs-custom-control-spacer{width:auto}.video-js:not(.vjs-fullscreen).vjs-layout-tiny .vjs-progress-control,.video-js:not(.vjs-fullscreen).vjs-layout-x-small.vjs-liveui .vjs-progress-control{display:none}.vjs-modal-dialog.vjs-text-track-settings{background-color:#2b333f;background-color:rgba(43,51,63,.75);color:#fff;height:70%}.vjs-text-track-settings .vjs-modal-dialog-content{display:table}.vjs-text-track-settings .vjs-track-settings-colors,.vjs-text-track-settings .vjs-track-settings-controls,.vjs-text-track-settings .vjs-track-settings-font{display:table-cell}.vjs-text-track-settings .vjs-track-settings-controls{text-align:right;vertical-align:bottom}@supports (display:grid){.vjs-text-track-settings .vjs-modal-dialog-content{display:grid;grid-template-columns:1fr 1fr;grid-template-rows:1fr;padding:20px 24px 0}.vjs-track-settings-controls .vjs-default-button{margin-bottom:20px}.vjs-text-track-settings .vjs-track-settings-controls{grid-column:1/-1}.vjs-layout-small .vjs-text-track-settings .vjs-modal-dialog-content,.vjs-layout-tiny .vjs-text-track-settings .vjs-modal-dialog-content,.vjs-layout-x-small .vjs-text-track-settings .vjs-modal-dialog-content{grid-template-columns:1fr}}.vjs-track-setting>select{margin-right:1em;margin-bottom:.5em}.vjs-text-track-settings fieldset{margin:5px;padding:3px;border:none}.vjs-text-track-settings fieldset span{display:inline-block}.vjs-text-track-settings fieldset span>select{max-width:7.3em}.vjs-text-track-settings legend{color:#fff;margin:0 0 5px}.vjs-text-track-settings .vjs-label{position:absolute;clip:rect(1px 1px 1px 1px);clip:rect(1px,1px,1px,1px);display:block;margin:0 0 5px;padding:0;border:0;height:1px;width:1px;overflow:hidden}.vjs-track-settings-controls button:active,.vjs-track-settings-controls button:focus{outline-style:solid;outline-width:medium;background-image:linear-gradient(0deg,#fff 88%,#73859f)}.vjs-track-settings-controls button:hover{color:rgba(43,51,63,.75)}.vjs-track-settings-controls button{background-color:#fff;background-image:linear-gradient(-180deg,#fff 88%,#73859f);color:#2b333f;cursor:pointer;border-radius:2px}.vjs-track-settings-controls .vjs-default-button{margin-right:1em}@media print{.video-js>:not(.vjs-tech):not(.vjs-poster){visibility:hidden}}.vjs-resize-manager{position:absolute;top:0;left:0;width:100%;height:100%;border:none;z-index:-1000}.js-focus-visible .video-js :focus:not(.focus-visible),.video-js .vjs-menu :focus:not(:focus-visible),.video-js :focus:not(:focus-visible){outline:none;background:none}',""])},"8eWk":function(t,e,n){var o=n("LboF"),r=n("qFNJ");"string"==typeof(r=r.__esModule?r.default:r)&&(r=[[t.i,r,""]]),o(r,{insert:"head",singleton:!1}),t.exports=r.locals||{}},I1BE:function(t,e){t.exports=function(t){var e=[];return e.toString=function(){return this.map(function(e){var n=function(t,e){var n=t[1]||"",o=t[3];if(!o)return n;if(e&&"function"==typeof btoa){var r=(i=o,"/*# sourceMappingURL=data:application/json;charset=utf-8;base64,"+btoa(unescape(encodeURIComponent(JSON.stringify(i))))+" */");return[n].concat(o.sources.map(function(t){return"/*# sourceURL="+o.sourceRoot+t+" */"})).concat([r]).join("\n")}var i;return[n].join("\n")}(e,t);return e[2]?"@media "+e[2]+"{"+n+"}":n}).join("")},e.i=function(t,n){"string"==typeof t&&(t=[[null,t,""]]);for(var o={},r=0;r<this.length;r++){var i=this[r][0];"number"==typeof i&&(o[i]=!0)}for(r=0;r<t.length;r++){var a=t[r];"number"==typeof a[0]&&o[a[0]]||(n&&!a[2]?a[2]=n:n&&(a[2]="("+a[2]+") and ("+n+")"),e.push(a))}},e}},Iab2:function(t,e,n){(function(n){var o,r,i;r=[],void 0===(i="function"==typeof(o=function(){"use strict";function e(t,e){return void 0===e?e={autoBom:!1}:"object"!=typeof e&&(console.warn("Deprecated: Expected third argument to be a object"),e={autoBom:!e}),e.autoBom&&/^\s*(?:text\/\S*|application\/xml|\S*\/\S*\+xml)\s*;.*charset\s*=\s*utf-8/i.test(t.type)?new Blob(["\ufeff",t],{type:t.type}):t}function o(t,e,n){var o=new XMLHttpRequest;o.open("GET",t),o.responseType="blob",o.onload=function(){s(o.response,e,n)},o.onerror=function(){console.error("could not download file")},o.send()}function r(t){var e=new XMLHttpRequest;return e.open("HEAD",t,!1),e.send(),200<=e.status&&299>=e.status}function i(t){try{t.dispatchEvent(new MouseEvent("click"))}catch(n){var e=document.createEvent("MouseEvents");e.initMouseEvent("click",!0,!0,window,0,0,0,80,20,!1,!1,!1,!1,0,null),t.dispatchEvent(e)}}var a="object"==typeof window&&window.window===window?window:"object"==typeof self&&self.self===self?self:"object"==typeof n&&n.global===n?n:void 0,s=a.saveAs||("object"!=typeof window||window!==a?function(){}:"download"in HTMLAnchorElement.prototype?function(t,e,n){var s=a.URL||a.webkitURL,l=document.createElement("a");e=e||t.name||"download",l.download=e,l.rel="noopener","string"==typeof t?(l.href=t,l.origin===location.origin?i(l):r(l.href)?o(t,e,n):i(l,l.target="_blank")):(l.href=s.createObjectURL(t),setTimeout(function(){s.revokeObjectURL(l.href)},4e4),setTimeout(function(){i(l)},0))}:"msSaveOrOpenBlob"in navigator?function(t,n,a){if(n=n||t.name||"download","string"!=typeof t)navigator.msSaveOrOpenBlob(e(t,a),n);else if(r(t))o(t,n,a);else{var s=document.createElement("a");s.href=t,s.target="_blank",setTimeout(function(){i(s)})}}:function(t,e,n,r){if((r=r||open("","_blank"))&&(r.document.title=r.document.body.innerText="downloading..."),"string"==typeof t)return o(t,e,n);var i="application/octet-stream"===t.type,s=/constructor/i.test(a.HTMLElement)||a.safari,l=/CriOS\/[\d]+/.test(navigator.userAgent);if((l||i&&s)&&"object"==typeof FileReader){var u=new FileReader;u.onloadend=function(){var t=u.result;t=l?t:t.replace(/^data:[^;]*;/,"data:attachment/file;"),r?r.location.href=t:location=t,r=null},u.readAsDataURL(t)}else{var c=a.URL||a.webkitURL,d=c.createObjectURL(t);r?r.location=d:location.href=d,r=null,setTimeout(function(){c.revokeObjectURL(d)},4e4)}});a.saveAs=s.saveAs=s,t.exports=s})?o.apply(e,r):o)||(t.exports=i)}).call(this,n("yLpj"))},LboF:function(t,e,n){"use strict";function o(t){for(var e=-1,n=0;n<v.length;n++)if(v[n].identifier===t){e=n;break}return e}function r(t,e){for(var n={},r=[],i=0;i<t.length;i++){var a=t[i],s=e.base?a[0]+e.base:a[0],u=n[s]||0,c="".concat(s," ").concat(u);n[s]=u+1;var d=o(c),f={css:a[1],media:a[2],sourceMap:a[3]};-1!==d?(v[d].references++,v[d].updater(f)):v.push({identifier:c,updater:l(f,e),references:1}),r.push(c)}return r}function i(t){var e=document.createElement("style"),o=t.attributes||{};if(void 0===o.nonce){var r=n.nc;r&&(o.nonce=r)}if(Object.keys(o).forEach(function(t){e.setAttribute(t,o[t])}),"function"==typeof t.insert)t.insert(e);else{var i=f(t.insert||"head");if(!i)throw new Error("Couldn't find a style target. This probably means that the value for the 'insert' parameter is invalid.");i.appendChild(e)}return e}function a(t,e,n,o){var r=n?"":o.media?"@media ".concat(o.media," {").concat(o.css,"}"):o.css;if(t.styleSheet)t.styleSheet.cssText=p(e,r);else{var i=document.createTextNode(r),a=t.childNodes;a[e]&&t.removeChild(a[e]),a.length?t.insertBefore(i,a[e]):t.appendChild(i)}}function s(t,e,n){var o=n.css,r=n.media,i=n.sourceMap;if(r?t.setAttribute("media",r):t.removeAttribute("media"),i&&btoa&&(o+="\n/*# sourceMappingURL=data:application/json;base64,".concat(btoa(unescape(encodeURIComponent(JSON.stringify(i))))," */")),t.styleSheet)t.styleSheet.cssText=o;else{for(;t.firstChild;)t.removeChild(t.firstChild);t.appendChild(document.createTextNode(o))}}function l(t,e){var n,o,r;if(e.singleton){var l=m++;n=h||(h=i(e)),o=a.bind(null,n,l,!1),r=a.bind(null,n,l,!0)}else n=i(e),o=s.bind(null,n,e),r=function(){!function(t){if(null===t.parentNode)return!1;t.parentNode.removeChild(t)}(n)};return o(t),function(e){if(e){if(e.css===t.css&&e.media===t.media&&e.sourceMap===t.sourceMap)return;o(t=e)}else r()}}var u,c,d=function(){return void 0===u&&(u=Boolean(window&&document&&document.all&&!window.atob)),u},f=function(){var t={};return function(e){if(void 0===t[e]){var n=document.querySelector(e);if(window.HTMLIFrameElement&&n instanceof window.HTMLIFrameElement)try{n=n.contentDocument.head}catch(t){n=null}t[e]=n}return t[e]}}(),v=[],p=(c=[],function(t,e){return c[t]=e,c.filter(Boolean).join("\n")}),h=null,m=0;t.exports=function(t,e){(e=e||{}).singleton||"boolean"==typeof e.singleton||(e.singleton=d());var n=r(t=t||[],e);return function(t){if(t=t||[],"[object Array]"===Object.prototype.toString.call(t)){for(var i=0;i<n.length;i++){var a=o(n[i]);v[a].references--}for(var s=r(t,e),l=0;l<n.length;l++){var u=o(n[l]);0===v[u].references&&(v[u].updater(),v.splice(u,1))}n=s}}}},LvDl:function(t,e,n){(function(t,o){var r;(function(){function i(t,e,n){switch(n.length){case 0:return t.call(e);case 1:return t.call(e,n[0]);case 2:return t.call(e,n[0],n[1]);case 3:return t.call(e,n[0],n[1],n[2])}return t.apply(e,n)}function a(t,e,n,o){for(var r=-1,i=null==t?0:t.length;++r<i;){var a=t[r];e(o,a,n(a),t)}return o}function s(t,e){for(var n=-1,o=null==t?0:t.length;++n<o&&!1!==e(t[n],n,t););return t}function l(t,e){for(var n=null==t?0:t.length;n--&&!1!==e(t[n],n,t););return t}function u(t,e){for(var n=-1,o=null==t?0:t.length;++n<o;)if(!e(t[n],n,t))return!1;return!0}function c(t,e){for(var n=-1,o=null==t?0:t.length,r=0,i=[];++n<o;){var a=t[n];e(a,n,t)&&(i[r++]=a)}return i}function d(t,e){return!!(null==t?0:t.length)&&_(t,e,0)>-1}function f(t,e,n){for(var o=-1,r=null==t?0:t.length;++o<r;)if(n(e,t[o]))return!0;return!1}function v(t,e){for(var n=-1,o=null==t?0:t.length,r=Array(o);++n<o;)r[n]=e(t[n],n,t);return r}function p(t,e){for(var n=-1,o=e.length,r=t.length;++n<o;)t[r+n]=e[n];return t}function h(t,e,n,o){var r=-1,i=null==t?0:t.length;for(o&&i&&(n=t[++r]);++r<i;)n=e(n,t[r],r,t);return n}function m(t,e,n,o){var r=null==t?0:t.length;for(o&&r&&(n=t[--r]);r--;)n=e(n,t[r],r,t);return n}function g(t,e){for(var n=-1,o=null==t?0:t.length;++n<o;)if(e(t[n],n,t))return!0;return!1}function b(t,e,n){var o;return n(t,function(t,n,r){if(e(t,n,r))return o=n,!1}),o}function y(t,e,n,o){for(var r=t.length,i=n+(o?1:-1);o?i--:++i<r;)if(e(t[i],i,t))return i;return-1}function _(t,e,n){return e==e?function(t,e,n){for(var o=n-1,r=t.length;++o<r;)if(t[o]===e)return o;return-1}(t,e,n):y(t,j,n)}function w(t,e,n,o){for(var r=n-1,i=t.length;++r<i;)if(o(t[r],e))return r;return-1}function j(t){return t!=t}function x(t,e){var n=null==t?0:t.length;return n?S(t,e)/n:ot}function C(t){return function(e){return null==e?q:e[t]}}function k(t){return function(e){return null==t?q:t[e]}}function D(t,e,n,o,r){return r(t,function(t,r,i){n=o?(o=!1,t):e(n,t,r,i)}),n}function S(t,e){for(var n,o=-1,r=t.length;++o<r;){var i=e(t[o]);i!==q&&(n=n===q?i:n+i)}return n}function I(t,e){for(var n=-1,o=Array(t);++n<t;)o[n]=e(n);return o}function T(t){return t?t.slice(0,H(t)+1).replace(Jt,""):t}function P(t){return function(e){return t(e)}}function L(t,e){return v(e,function(e){return t[e]})}function A(t,e){return t.has(e)}function M(t,e){for(var n=-1,o=t.length;++n<o&&_(e,t[n],0)>-1;);return n}function R(t,e){for(var n=t.length;n--&&_(e,t[n],0)>-1;);return n}function $(t,e){for(var n=t.length,o=0;n--;)t[n]===e&&++o;return o}function z(t){return"\\"+Ve[t]}function O(t){return Oe.test(t)}function N(t){var e=-1,n=Array(t.size);return t.forEach(function(t,o){n[++e]=[o,t]}),n}function F(t,e){return function(n){return t(e(n))}}function U(t,e){for(var n=-1,o=t.length,r=0,i=[];++n<o;){var a=t[n];a!==e&&a!==X||(t[n]=X,i[r++]=n)}return i}function E(t){var e=-1,n=Array(t.size);return t.forEach(function(t){n[++e]=t}),n}function B(t){var e=-1,n=Array(t.size);return t.forEach(function(t){n[++e]=[t,t]}),n}function V(t){return O(t)?function(t){for(var e=$e.lastIndex=0;$e.test(t);)++e;return e}(t):sn(t)}function Y(t){return O(t)?function(t){return t.match($e)||[]}(t):function(t){return t.split("")}(t)}function H(t){for(var e=t.length;e--&&Xt.test(t.charAt(e)););return e}var q,W="Expected a function",J="__lodash_hash_undefined__",X="__lodash_placeholder__",Z=16,G=32,Q=64,K=128,tt=256,et=1/0,nt=9007199254740991,ot=NaN,rt=4294967295,it=[["ary",K],["bind",1],["bindKey",2],["curry",8],["curryRight",Z],["flip",512],["partial",G],["partialRight",Q],["rearg",tt]],at="[object Arguments]",st="[object Array]",lt="[object Boolean]",ut="[object Date]",ct="[object Error]",dt="[object Function]",ft="[object GeneratorFunction]",vt="[object Map]",pt="[object Number]",ht="[object Object]",mt="[object Promise]",gt="[object RegExp]",bt="[object Set]",yt="[object String]",_t="[object Symbol]",wt="[object WeakMap]",jt="[object ArrayBuffer]",xt="[object DataView]",Ct="[object Float32Array]",kt="[object Float64Array]",Dt="[object Int8Array]",St="[object Int16Array]",It="[object Int32Array]",Tt="[object Uint8Array]",Pt="[object Uint8ClampedArray]",Lt="[object Uint16Array]",At="[object Uint32Array]",Mt=/\b__p \+= '';/g,Rt=/\b(__p \+=) '' \+/g,$t=/(__e\(.*?\)|\b__t\)) \+\n'';/g,zt=/&(?:amp|lt|gt|quot|#39);/g,Ot=/[&<>"']/g,Nt=RegExp(zt.source),Ft=RegExp(Ot.source),Ut=/<%-([\s\S]+?)%>/g,Et=/<%([\s\S]+?)%>/g,Bt=/<%=([\s\S]+?)%>/g,Vt=/\.|\[(?:[^[\]]*|(["'])(?:(?!\1)[^\\]|\\.)*?\1)\]/,Yt=/^\w*$/,Ht=/[^.[\]]+|\[(?:(-?\d+(?:\.\d+)?)|(["'])((?:(?!\2)[^\\]|\\.)*?)\2)\]|(?=(?:\.|\[\])(?:\.|\[\]|$))/g,qt=/[\\^$.*+?()[\]{}|]/g,Wt=RegExp(qt.source),Jt=/^\s+/,Xt=/\s/,Zt=/\{(?:\n\/\* \[wrapped with .+\] \*\/)?\n?/,Gt=/\{\n\/\* \[wrapped with (.+)\] \*/,Qt=/,? & /,Kt=/[^\x00-\x2f\x3a-\x40\x5b-\x60\x7b-\x7f]+/g,te=/[()=,{}\[\]\/\s]/,ee=/\\(\\)?/g,ne=/\$\{([^\\}]*(?:\\.[^\\}]*)*)\}/g,oe=/\w*$/,re=/^[-+]0x[0-9a-f]+$/i,ie=/^0b[01]+$/i,ae=/^\[object .+?Constructor\]$/,se=/^0o[0-7]+$/i,le=/^(?:0|[1-9]\d*)$/,ue=/[\xc0-\xd6\xd8-\xf6\xf8-\xff\u0100-\u017f]/g,ce=/($^)/,de=/['\n\r\u2028\u2029\\]/g,fe="\\u0300-\\u036f\\ufe20-\\ufe2f\\u20d0-\\u20ff",ve="a-z\\xdf-\\xf6\\xf8-\\xff",pe="A-Z\\xc0-\\xd6\\xd8-\\xde",he="\\xac\\xb1\\xd7\\xf7\\x00-\\x2f\\x3a-\\x40\\x5b-\\x60\\x7b-\\xbf\\u2000-\\u206f \\t\\x0b\\f\\xa0\\ufeff\\n\\r\\u2028\\u2029\\u1680\\u180e\\u2000\\u2001\\u2002\\u2003\\u2004\\u2005\\u2006\\u2007\\u2008\\u2009\\u200a\\u202f\\u205f\\u3000",me="["+he+"]",ge="["+fe+"]",be="["+ve+"]",ye="[^\\ud800-\\udfff"+he+"\\d+\\u2700-\\u27bf"+ve+pe+"]",_e="\\ud83c[\\udffb-\\udfff]",we="[^\\ud800-\\udfff]",je="(?:\\ud83c[\\udde6-\\uddff]){2}",xe="[\\ud800-\\udbff][\\udc00-\\udfff]",Ce="["+pe+"]",ke="(?:"+be+"|"+ye+")",De="(?:['’](?:d|ll|m|re|s|t|ve))?",Se="(?:['’](?:D|LL|M|RE|S|T|VE))?",Ie="(?:"+ge+"|"+_e+")?",Te="[\\ufe0e\\ufe0f]?",Pe=Te+Ie+"(?:\\u200d(?:"+[we,je,xe].join("|")+")"+Te+Ie+")*",Le="(?:"+["[\\u2700-\\u27bf]",je,xe].join("|")+")"+Pe,Ae="(?:"+[we+ge+"?",ge,je,xe,"[\\ud800-\\udfff]"].join("|")+")",Me=RegExp("['’]","g"),Re=RegExp(ge,"g"),$e=RegExp(_e+"(?="+_e+")|"+Ae+Pe,"g"),ze=RegExp([Ce+"?"+be+"+"+De+"(?="+[me,Ce,"$"].join("|")+")","(?:[A-Z\\xc0-\\xd6\\xd8-\\xde]|[^\\ud800-\\udfff\\xac\\xb1\\xd7\\xf7\\x00-\\x2f\\x3a-\\x40\\x5b-\\x60\\x7b-\\xbf\\u2000-\\u206f \\t\\x0b\\f\\xa0\\ufeff\\n\\r\\u2028\\u2029\\u1680\\u180e\\u2000\\u2001\\u2002\\u2003\\u2004\\u2005\\u2006\\u2007\\u2008\\u2009\\u200a\\u202f\\u205f\\u3000\\d+\\u2700-\\u27bfa-z\\xdf-\\xf6\\xf8-\\xffA-Z\\xc0-\\xd6\\xd8-\\xde])+"+Se+"(?="+[me,Ce+ke,"$"].join("|")+")",Ce+"?"+ke+"+"+De,Ce+"+"+Se,"\\d*(?:1ST|2ND|3RD|(?![123])\\dTH)(?=\\b|[a-z_])","\\d*(?:1st|2nd|3rd|(?![123])\\dth)(?=\\b|[A-Z_])","\\d+",Le].join("|"),"g"),Oe=RegExp("[\\u200d\\ud800-\\udfff"+fe+"\\ufe0e\\ufe0f]"),Ne=/[a-z][A-Z]|[A-Z]{2}[a-z]|[0-9][a-zA-Z]|[a-zA-Z][0-9]|[^a-zA-Z0-9 ]/,Fe=["Array","Buffer","DataView","Date","Error","Float32Array","Float64Array","Function","Int8Array","Int16Array","Int32Array","Map","Math","Object","Promise","RegExp","Set","String","Symbol","TypeError","Uint8Array","Uint8ClampedArray","Uint16Array","Uint32Array","WeakMap","_","clearTimeout","isFinite","parseInt","setTimeout"],Ue=-1,Ee={};Ee[Ct]=Ee[kt]=Ee[Dt]=Ee[St]=Ee[It]=Ee[Tt]=Ee[Pt]=Ee[Lt]=Ee[At]=!0,Ee[at]=Ee[st]=Ee[jt]=Ee[lt]=Ee[xt]=Ee[ut]=Ee[ct]=Ee[dt]=Ee[vt]=Ee[pt]=Ee[ht]=Ee[gt]=Ee[bt]=Ee[yt]=Ee[wt]=!1;var Be={};Be[at]=Be[st]=Be[jt]=Be[xt]=Be[lt]=Be[ut]=Be[Ct]=Be[kt]=Be[Dt]=Be[St]=Be[It]=Be[vt]=Be[pt]=Be[ht]=Be[gt]=Be[bt]=Be[yt]=Be[_t]=Be[Tt]=Be[Pt]=Be[Lt]=Be[At]=!0,Be[ct]=Be[dt]=Be[wt]=!1;var Ve={"\\":"\\","'":"'","\n":"n","\r":"r","\u2028":"u2028","\u2029":"u2029"},Ye=parseFloat,He=parseInt,qe="object"==typeof t&&t&&t.Object===Object&&t,We="object"==typeof self&&self&&self.Object===Object&&self,Je=qe||We||Function("return this")(),Xe=e&&!e.nodeType&&e,Ze=Xe&&"object"==typeof o&&o&&!o.nodeType&&o,Ge=Ze&&Ze.exports===Xe,Qe=Ge&&qe.process,Ke=function(){try{return Ze&&Ze.require&&Ze.require("util").types||Qe&&Qe.binding&&Qe.binding("util")}catch(t){}}(),tn=Ke&&Ke.isArrayBuffer,en=Ke&&Ke.isDate,nn=Ke&&Ke.isMap,on=Ke&&Ke.isRegExp,rn=Ke&&Ke.isSet,an=Ke&&Ke.isTypedArray,sn=C("length"),ln=k({"À":"A","Á":"A","Â":"A","Ã":"A","Ä":"A","Å":"A","à":"a","á":"a","â":"a","ã":"a","ä":"a","å":"a","Ç":"C","ç":"c","Ð":"D","ð":"d","È":"E","É":"E","Ê":"E","Ë":"E","è":"e","é":"e","ê":"e","ë":"e","Ì":"I","Í":"I","Î":"I","Ï":"I","ì":"i","í":"i","î":"i","ï":"i","Ñ":"N","ñ":"n","Ò":"O","Ó":"O","Ô":"O","Õ":"O","Ö":"O","Ø":"O","ò":"o","ó":"o","ô":"o","õ":"o","ö":"o","ø":"o","Ù":"U","Ú":"U","Û":"U","Ü":"U","ù":"u","ú":"u","û":"u","ü":"u","Ý":"Y","ý":"y","ÿ":"y","Æ":"Ae","æ":"ae","Þ":"Th","þ":"th","ß":"ss","Ā":"A","Ă":"A","Ą":"A","ā":"a","ă":"a","ą":"a","Ć":"C","Ĉ":"C","Ċ":"C","Č":"C","ć":"c","ĉ":"c","ċ":"c","č":"c","Ď":"D","Đ":"D","ď":"d","đ":"d","Ē":"E","Ĕ":"E","Ė":"E","Ę":"E","Ě":"E","ē":"e","ĕ":"e","ė":"e","ę":"e","ě":"e","Ĝ":"G","Ğ":"G","Ġ":"G","Ģ":"G","ĝ":"g","ğ":"g","ġ":"g","ģ":"g","Ĥ":"H","Ħ":"H","ĥ":"h","ħ":"h","Ĩ":"I","Ī":"I","Ĭ":"I","Į":"I","İ":"I","ĩ":"i","ī":"i","ĭ":"i","į":"i","ı":"i","Ĵ":"J","ĵ":"j","Ķ":"K","ķ":"k","ĸ":"k","Ĺ":"L","Ļ":"L","Ľ":"L","Ŀ":"L","Ł":"L","ĺ":"l","ļ":"l","ľ":"l","ŀ":"l","ł":"l","Ń":"N","Ņ":"N","Ň":"N","Ŋ":"N","ń":"n","ņ":"n","ň":"n","ŋ":"n","Ō":"O","Ŏ":"O","Ő":"O","ō":"o","ŏ":"o","ő":"o","Ŕ":"R","Ŗ":"R","Ř":"R","ŕ":"r","ŗ":"r","ř":"r","Ś":"S","Ŝ":"S","Ş":"S","Š":"S","ś":"s","ŝ":"s","ş":"s","š":"s","Ţ":"T","Ť":"T","Ŧ":"T","ţ":"t","ť":"t","ŧ":"t","Ũ":"U","Ū":"U","Ŭ":"U","Ů":"U","Ű":"U","Ų":"U","ũ":"u","ū":"u","ŭ":"u","ů":"u","ű":"u","ų":"u","Ŵ":"W","ŵ":"w","Ŷ":"Y","ŷ":"y","Ÿ":"Y","Ź":"Z","Ż":"Z","Ž":"Z","ź":"z","ż":"z","ž":"z","Ĳ":"IJ","ĳ":"ij","Œ":"Oe","œ":"oe","ŉ":"'n","ſ":"s"}),un=k({"&":"&amp;","<":"&lt;",">":"&gt;",'"':"&quot;","'":"&#39;"}),cn=k({"&amp;":"&","&lt;":"<","&gt;":">","&quot;":'"',"&#39;":"'"}),dn=function t(e){function n(t){if(Fr(t)&&!Ps(t)&&!(t instanceof k)){if(t instanceof r)return t;if(Ti.call(t,"__wrapped__"))return dr(t)}return new r(t)}function o(){}function r(t,e){this.__wrapped__=t,this.__actions__=[],this.__chain__=!!e,this.__index__=0,this.__values__=q}function k(t){this.__wrapped__=t,this.__actions__=[],this.__dir__=1,this.__filtered__=!1,this.__iteratees__=[],this.__takeCount__=rt,this.__views__=[]}function Xt(t){var e=-1,n=null==t?0:t.length;for(this.clear();++e<n;){var o=t[e];this.set(o[0],o[1])}}function fe(t){var e=-1,n=null==t?0:t.length;for(this.clear();++e<n;){var o=t[e];this.set(o[0],o[1])}}function ve(t){var e=-1,n=null==t?0:t.length;for(this.clear();++e<n;){var o=t[e];this.set(o[0],o[1])}}function pe(t){var e=-1,n=null==t?0:t.length;for(this.__data__=new ve;++e<n;)this.add(t[e])}function he(t){var e=this.__data__=new fe(t);this.size=e.size}function me(t,e){var n=Ps(t),o=!n&&Ts(t),r=!n&&!o&&As(t),i=!n&&!o&&!r&&Os(t),a=n||o||r||i,s=a?I(t.length,ji):[],l=s.length;for(var u in t)!e&&!Ti.call(t,u)||a&&("length"==u||r&&("offset"==u||"parent"==u)||i&&("buffer"==u||"byteLength"==u||"byteOffset"==u)||Xo(u,l))||s.push(u);return s}function ge(t){var e=t.length;return e?t[Mn(0,e-1)]:q}function be(t,e){return lr(co(t),Se(e,0,t.length))}function ye(t){return lr(co(t))}function _e(t,e,n){(n!==q&&!Lr(t[e],n)||n===q&&!(e in t))&&ke(t,e,n)}function we(t,e,n){var o=t[e];Ti.call(t,e)&&Lr(o,n)&&(n!==q||e in t)||ke(t,e,n)}function je(t,e){for(var n=t.length;n--;)if(Lr(t[n][0],e))return n;return-1}function xe(t,e,n,o){return Sa(t,function(t,r,i){e(o,t,n(t),i)}),o}function Ce(t,e){return t&&fo(e,Kr(e),t)}function ke(t,e,n){"__proto__"==e&&Wi?Wi(t,e,{configurable:!0,enumerable:!0,value:n,writable:!0}):t[e]=n}function De(t,e){for(var n=-1,o=e.length,r=hi(o),i=null==t;++n<o;)r[n]=i?q:Gr(t,e[n]);return r}function Se(t,e,n){return t==t&&(n!==q&&(t=t<=n?t:n),e!==q&&(t=t>=e?t:e)),t}function Ie(t,e,n,o,r,i){var a,l=1&e,u=2&e,c=4&e;if(n&&(a=r?n(t,o,r,i):n(t)),a!==q)return a;if(!Nr(t))return t;var d=Ps(t);if(d){if(a=function(t){var e=t.length,n=new t.constructor(e);return e&&"string"==typeof t[0]&&Ti.call(t,"index")&&(n.index=t.index,n.input=t.input),n}(t),!l)return co(t,a)}else{var f=Fa(t),v=f==dt||f==ft;if(As(t))return ro(t,l);if(f==ht||f==at||v&&!r){if(a=u||v?{}:Wo(t),!l)return u?function(t,e){return fo(t,Na(t),e)}(t,function(t,e){return t&&fo(e,ti(e),t)}(a,t)):function(t,e){return fo(t,Oa(t),e)}(t,Ce(a,t))}else{if(!Be[f])return r?t:{};a=function(t,e,n){var o=t.constructor;switch(e){case jt:return io(t);case lt:case ut:return new o(+t);case xt:return function(t,e){var n=e?io(t.buffer):t.buffer;return new t.constructor(n,t.byteOffset,t.byteLength)}(t,n);case Ct:case kt:case Dt:case St:case It:case Tt:case Pt:case Lt:case At:return ao(t,n);case vt:return new o;case pt:case yt:return new o(t);case gt:return function(t){var e=new t.constructor(t.source,oe.exec(t));return e.lastIndex=t.lastIndex,e}(t);case bt:return new o;case _t:return r=t,Ca?_i(Ca.call(r)):{}}var r}(t,f,l)}}i||(i=new he);var p=i.get(t);if(p)return p;i.set(t,a),zs(t)?t.forEach(function(o){a.add(Ie(o,e,n,o,t,i))}):Rs(t)&&t.forEach(function(o,r){a.set(r,Ie(o,e,n,r,t,i))});var h=d?q:(c?u?Fo:No:u?ti:Kr)(t);return s(h||t,function(o,r){h&&(o=t[r=o]),we(a,r,Ie(o,e,n,r,t,i))}),a}function Te(t,e,n){var o=n.length;if(null==t)return!o;for(t=_i(t);o--;){var r=n[o],i=e[r],a=t[r];if(a===q&&!(r in t)||!i(a))return!1}return!0}function Pe(t,e,n){if("function"!=typeof t)throw new xi(W);return Ba(function(){t.apply(q,n)},e)}function Le(t,e,n,o){var r=-1,i=d,a=!0,s=t.length,l=[],u=e.length;if(!s)return l;n&&(e=v(e,P(n))),o?(i=f,a=!1):e.length>=200&&(i=A,a=!1,e=new pe(e));t:for(;++r<s;){var c=t[r],p=null==n?c:n(c);if(c=o||0!==c?c:0,a&&p==p){for(var h=u;h--;)if(e[h]===p)continue t;l.push(c)}else i(e,p,o)||l.push(c)}return l}function Ae(t,e){var n=!0;return Sa(t,function(t,o,r){return n=!!e(t,o,r)}),n}function $e(t,e,n){for(var o=-1,r=t.length;++o<r;){var i=t[o],a=e(i);if(null!=a&&(s===q?a==a&&!Vr(a):n(a,s)))var s=a,l=i}return l}function Oe(t,e){var n=[];return Sa(t,function(t,o,r){e(t,o,r)&&n.push(t)}),n}function Ve(t,e,n,o,r){var i=-1,a=t.length;for(n||(n=Jo),r||(r=[]);++i<a;){var s=t[i];e>0&&n(s)?e>1?Ve(s,e-1,n,o,r):p(r,s):o||(r[r.length]=s)}return r}function qe(t,e){return t&&Ta(t,e,Kr)}function We(t,e){return t&&Pa(t,e,Kr)}function Xe(t,e){return c(e,function(e){return $r(t[e])})}function Ze(t,e){for(var n=0,o=(e=no(e,t)).length;null!=t&&n<o;)t=t[ur(e[n++])];return n&&n==o?t:q}function Qe(t,e,n){var o=e(t);return Ps(t)?o:p(o,n(t))}function Ke(t){return null==t?t===q?"[object Undefined]":"[object Null]":qi&&qi in _i(t)?function(t){var e=Ti.call(t,qi),n=t[qi];try{t[qi]=q;var o=!0}catch(t){}var r=Ai.call(t);return o&&(e?t[qi]=n:delete t[qi]),r}(t):function(t){return Ai.call(t)}(t)}function sn(t,e){return t>e}function fn(t,e){return null!=t&&Ti.call(t,e)}function vn(t,e){return null!=t&&e in _i(t)}function pn(t,e,n){for(var o=n?f:d,r=t[0].length,i=t.length,a=i,s=hi(i),l=1/0,u=[];a--;){var c=t[a];a&&e&&(c=v(c,P(e))),l=ia(c.length,l),s[a]=!n&&(e||r>=120&&c.length>=120)?new pe(a&&c):q}c=t[0];var p=-1,h=s[0];t:for(;++p<r&&u.length<l;){var m=c[p],g=e?e(m):m;if(m=n||0!==m?m:0,!(h?A(h,g):o(u,g,n))){for(a=i;--a;){var b=s[a];if(!(b?A(b,g):o(t[a],g,n)))continue t}h&&h.push(g),u.push(m)}}return u}function hn(t,e,n){var o=null==(t=or(t,e=no(e,t)))?t:t[ur(mr(e))];return null==o?q:i(o,t,n)}function mn(t){return Fr(t)&&Ke(t)==at}function gn(t,e,n,o,r){return t===e||(null==t||null==e||!Fr(t)&&!Fr(e)?t!=t&&e!=e:function(t,e,n,o,r,i){var a=Ps(t),s=Ps(e),l=a?st:Fa(t),u=s?st:Fa(e),c=(l=l==at?ht:l)==ht,d=(u=u==at?ht:u)==ht,f=l==u;if(f&&As(t)){if(!As(e))return!1;a=!0,c=!1}if(f&&!c)return i||(i=new he),a||Os(t)?zo(t,e,n,o,r,i):function(t,e,n,o,r,i,a){switch(n){case xt:if(t.byteLength!=e.byteLength||t.byteOffset!=e.byteOffset)return!1;t=t.buffer,e=e.buffer;case jt:return!(t.byteLength!=e.byteLength||!i(new Ni(t),new Ni(e)));case lt:case ut:case pt:return Lr(+t,+e);case ct:return t.name==e.name&&t.message==e.message;case gt:case yt:return t==e+"";case vt:var s=N;case bt:var l=1&o;if(s||(s=E),t.size!=e.size&&!l)return!1;var u=a.get(t);if(u)return u==e;o|=2,a.set(t,e);var c=zo(s(t),s(e),o,r,i,a);return a.delete(t),c;case _t:if(Ca)return Ca.call(t)==Ca.call(e)}return!1}(t,e,l,n,o,r,i);if(!(1&n)){var v=c&&Ti.call(t,"__wrapped__"),p=d&&Ti.call(e,"__wrapped__");if(v||p){var h=v?t.value():t,m=p?e.value():e;return i||(i=new he),r(h,m,n,o,i)}}return!!f&&(i||(i=new he),function(t,e,n,o,r,i){var a=1&n,s=No(t),l=s.length;if(l!=No(e).length&&!a)return!1;for(var u=l;u--;){var c=s[u];if(!(a?c in e:Ti.call(e,c)))return!1}var d=i.get(t),f=i.get(e);if(d&&f)return d==e&&f==t;var v=!0;i.set(t,e),i.set(e,t);for(var p=a;++u<l;){var h=t[c=s[u]],m=e[c];if(o)var g=a?o(m,h,c,e,t,i):o(h,m,c,t,e,i);if(!(g===q?h===m||r(h,m,n,o,i):g)){v=!1;break}p||(p="constructor"==c)}if(v&&!p){var b=t.constructor,y=e.constructor;b==y||!("constructor"in t)||!("constructor"in e)||"function"==typeof b&&b instanceof b&&"function"==typeof y&&y instanceof y||(v=!1)}return i.delete(t),i.delete(e),v}(t,e,n,o,r,i))}(t,e,n,o,gn,r))}function bn(t,e,n,o){var r=n.length,i=r,a=!o;if(null==t)return!i;for(t=_i(t);r--;){var s=n[r];if(a&&s[2]?s[1]!==t[s[0]]:!(s[0]in t))return!1}for(;++r<i;){var l=(s=n[r])[0],u=t[l],c=s[1];if(a&&s[2]){if(u===q&&!(l in t))return!1}else{var d=new he;if(o)var f=o(u,c,l,t,e,d);if(!(f===q?gn(c,u,3,o,d):f))return!1}}return!0}function yn(t){return!(!Nr(t)||(e=t,Li&&Li in e))&&($r(t)?$i:ae).test(cr(t));var e}function _n(t){return"function"==typeof t?t:null==t?si:"object"==typeof t?Ps(t)?Dn(t[0],t[1]):kn(t):di(t)}function wn(t){if(!Ko(t))return oa(t);var e=[];for(var n in _i(t))Ti.call(t,n)&&"constructor"!=n&&e.push(n);return e}function jn(t){if(!Nr(t))return function(t){var e=[];if(null!=t)for(var n in _i(t))e.push(n);return e}(t);var e=Ko(t),n=[];for(var o in t)("constructor"!=o||!e&&Ti.call(t,o))&&n.push(o);return n}function xn(t,e){return t<e}function Cn(t,e){var n=-1,o=Ar(t)?hi(t.length):[];return Sa(t,function(t,r,i){o[++n]=e(t,r,i)}),o}function kn(t){var e=Yo(t);return 1==e.length&&e[0][2]?er(e[0][0],e[0][1]):function(n){return n===t||bn(n,t,e)}}function Dn(t,e){return Go(t)&&tr(e)?er(ur(t),e):function(n){var o=Gr(n,t);return o===q&&o===e?Qr(n,t):gn(e,o,3)}}function Sn(t,e,n,o,r){t!==e&&Ta(e,function(i,a){if(r||(r=new he),Nr(i))!function(t,e,n,o,r,i,a){var s=ir(t,n),l=ir(e,n),u=a.get(l);if(u)return void _e(t,n,u);var c=i?i(s,l,n+"",t,e,a):q,d=c===q;if(d){var f=Ps(l),v=!f&&As(l),p=!f&&!v&&Os(l);c=l,f||v||p?Ps(s)?c=s:Mr(s)?c=co(s):v?(d=!1,c=ro(l,!0)):p?(d=!1,c=ao(l,!0)):c=[]:Er(l)||Ts(l)?(c=s,Ts(s)?c=Xr(s):Nr(s)&&!$r(s)||(c=Wo(l))):d=!1}d&&(a.set(l,c),r(c,l,o,i,a),a.delete(l)),_e(t,n,c)}(t,e,a,n,Sn,o,r);else{var s=o?o(ir(t,a),i,a+"",t,e,r):q;s===q&&(s=i),_e(t,a,s)}},ti)}function In(t,e){var n=t.length;if(n)return Xo(e+=e<0?n:0,n)?t[e]:q}function Tn(t,e,n){e=e.length?v(e,function(t){return Ps(t)?function(e){return Ze(e,1===t.length?t[0]:t)}:t}):[si];var o=-1;return e=v(e,P(Bo())),function(t,e){var n=t.length;for(t.sort(e);n--;)t[n]=t[n].value;return t}(Cn(t,function(t,n,r){return{criteria:v(e,function(e){return e(t)}),index:++o,value:t}}),function(t,e){return function(t,e,n){for(var o=-1,r=t.criteria,i=e.criteria,a=r.length,s=n.length;++o<a;){var l=so(r[o],i[o]);if(l)return o>=s?l:l*("desc"==n[o]?-1:1)}return t.index-e.index}(t,e,n)})}function Pn(t,e,n){for(var o=-1,r=e.length,i={};++o<r;){var a=e[o],s=Ze(t,a);n(s,a)&&Nn(i,no(a,t),s)}return i}function Ln(t,e,n,o){var r=o?w:_,i=-1,a=e.length,s=t;for(t===e&&(e=co(e)),n&&(s=v(t,P(n)));++i<a;)for(var l=0,u=e[i],c=n?n(u):u;(l=r(s,c,l,o))>-1;)s!==t&&Vi.call(s,l,1),Vi.call(t,l,1);return t}function An(t,e){for(var n=t?e.length:0,o=n-1;n--;){var r=e[n];if(n==o||r!==i){var i=r;Xo(r)?Vi.call(t,r,1):Jn(t,r)}}return t}function Mn(t,e){return t+Qi(la()*(e-t+1))}function Rn(t,e){var n="";if(!t||e<1||e>nt)return n;do{e%2&&(n+=t),(e=Qi(e/2))&&(t+=t)}while(e);return n}function $n(t,e){return Va(nr(t,e,si),t+"")}function zn(t){return ge(ni(t))}function On(t,e){var n=ni(t);return lr(n,Se(e,0,n.length))}function Nn(t,e,n,o){if(!Nr(t))return t;for(var r=-1,i=(e=no(e,t)).length,a=i-1,s=t;null!=s&&++r<i;){var l=ur(e[r]),u=n;if("__proto__"===l||"constructor"===l||"prototype"===l)return t;if(r!=a){var c=s[l];(u=o?o(c,l,s):q)===q&&(u=Nr(c)?c:Xo(e[r+1])?[]:{})}we(s,l,u),s=s[l]}return t}function Fn(t){return lr(ni(t))}function Un(t,e,n){var o=-1,r=t.length;e<0&&(e=-e>r?0:r+e),(n=n>r?r:n)<0&&(n+=r),r=e>n?0:n-e>>>0,e>>>=0;for(var i=hi(r);++o<r;)i[o]=t[o+e];return i}function En(t,e){var n;return Sa(t,function(t,o,r){return!(n=e(t,o,r))}),!!n}function Bn(t,e,n){var o=0,r=null==t?o:t.length;if("number"==typeof e&&e==e&&r<=2147483647){for(;o<r;){var i=o+r>>>1,a=t[i];null!==a&&!Vr(a)&&(n?a<=e:a<e)?o=i+1:r=i}return r}return Vn(t,e,si,n)}function Vn(t,e,n,o){var r=0,i=null==t?0:t.length;if(0===i)return 0;for(var a=(e=n(e))!=e,s=null===e,l=Vr(e),u=e===q;r<i;){var c=Qi((r+i)/2),d=n(t[c]),f=d!==q,v=null===d,p=d==d,h=Vr(d);if(a)var m=o||p;else m=u?p&&(o||f):s?p&&f&&(o||!v):l?p&&f&&!v&&(o||!h):!v&&!h&&(o?d<=e:d<e);m?r=c+1:i=c}return ia(i,4294967294)}function Yn(t,e){for(var n=-1,o=t.length,r=0,i=[];++n<o;){var a=t[n],s=e?e(a):a;if(!n||!Lr(s,l)){var l=s;i[r++]=0===a?0:a}}return i}function Hn(t){return"number"==typeof t?t:Vr(t)?ot:+t}function qn(t){if("string"==typeof t)return t;if(Ps(t))return v(t,qn)+"";if(Vr(t))return ka?ka.call(t):"";var e=t+"";return"0"==e&&1/t==-1/0?"-0":e}function Wn(t,e,n){var o=-1,r=d,i=t.length,a=!0,s=[],l=s;if(n)a=!1,r=f;else if(i>=200){var u=e?null:$a(t);if(u)return E(u);a=!1,r=A,l=new pe}else l=e?[]:s;t:for(;++o<i;){var c=t[o],v=e?e(c):c;if(c=n||0!==c?c:0,a&&v==v){for(var p=l.length;p--;)if(l[p]===v)continue t;e&&l.push(v),s.push(c)}else r(l,v,n)||(l!==s&&l.push(v),s.push(c))}return s}function Jn(t,e){return null==(t=or(t,e=no(e,t)))||delete t[ur(mr(e))]}function Xn(t,e,n,o){return Nn(t,e,n(Ze(t,e)),o)}function Zn(t,e,n,o){for(var r=t.length,i=o?r:-1;(o?i--:++i<r)&&e(t[i],i,t););return n?Un(t,o?0:i,o?i+1:r):Un(t,o?i+1:0,o?r:i)}function Gn(t,e){var n=t;return n instanceof k&&(n=n.value()),h(e,function(t,e){return e.func.apply(e.thisArg,p([t],e.args))},n)}function Qn(t,e,n){var o=t.length;if(o<2)return o?Wn(t[0]):[];for(var r=-1,i=hi(o);++r<o;)for(var a=t[r],s=-1;++s<o;)s!=r&&(i[r]=Le(i[r]||a,t[s],e,n));return Wn(Ve(i,1),e,n)}function Kn(t,e,n){for(var o=-1,r=t.length,i=e.length,a={};++o<r;){var s=o<i?e[o]:q;n(a,t[o],s)}return a}function to(t){return Mr(t)?t:[]}function eo(t){return"function"==typeof t?t:si}function no(t,e){return Ps(t)?t:Go(t,e)?[t]:Ya(Zr(t))}function oo(t,e,n){var o=t.length;return n=n===q?o:n,!e&&n>=o?t:Un(t,e,n)}function ro(t,e){if(e)return t.slice();var n=t.length,o=Fi?Fi(n):new t.constructor(n);return t.copy(o),o}function io(t){var e=new t.constructor(t.byteLength);return new Ni(e).set(new Ni(t)),e}function ao(t,e){var n=e?io(t.buffer):t.buffer;return new t.constructor(n,t.byteOffset,t.length)}function so(t,e){if(t!==e){var n=t!==q,o=null===t,r=t==t,i=Vr(t),a=e!==q,s=null===e,l=e==e,u=Vr(e);if(!s&&!u&&!i&&t>e||i&&a&&l&&!s&&!u||o&&a&&l||!n&&l||!r)return 1;if(!o&&!i&&!u&&t<e||u&&n&&r&&!o&&!i||s&&n&&r||!a&&r||!l)return-1}return 0}function lo(t,e,n,o){for(var r=-1,i=t.length,a=n.length,s=-1,l=e.length,u=ra(i-a,0),c=hi(l+u),d=!o;++s<l;)c[s]=e[s];for(;++r<a;)(d||r<i)&&(c[n[r]]=t[r]);for(;u--;)c[s++]=t[r++];return c}function uo(t,e,n,o){for(var r=-1,i=t.length,a=-1,s=n.length,l=-1,u=e.length,c=ra(i-s,0),d=hi(c+u),f=!o;++r<c;)d[r]=t[r];for(var v=r;++l<u;)d[v+l]=e[l];for(;++a<s;)(f||r<i)&&(d[v+n[a]]=t[r++]);return d}function co(t,e){var n=-1,o=t.length;for(e||(e=hi(o));++n<o;)e[n]=t[n];return e}function fo(t,e,n,o){var r=!n;n||(n={});for(var i=-1,a=e.length;++i<a;){var s=e[i],l=o?o(n[s],t[s],s,n,t):q;l===q&&(l=t[s]),r?ke(n,s,l):we(n,s,l)}return n}function vo(t,e){return function(n,o){var r=Ps(n)?a:xe,i=e?e():{};return r(n,t,Bo(o,2),i)}}function po(t){return $n(function(e,n){var o=-1,r=n.length,i=r>1?n[r-1]:q,a=r>2?n[2]:q;for(i=t.length>3&&"function"==typeof i?(r--,i):q,a&&Zo(n[0],n[1],a)&&(i=r<3?q:i,r=1),e=_i(e);++o<r;){var s=n[o];s&&t(e,s,o,i)}return e})}function ho(t,e){return function(n,o){if(null==n)return n;if(!Ar(n))return t(n,o);for(var r=n.length,i=e?r:-1,a=_i(n);(e?i--:++i<r)&&!1!==o(a[i],i,a););return n}}function mo(t){return function(e,n,o){for(var r=-1,i=_i(e),a=o(e),s=a.length;s--;){var l=a[t?s:++r];if(!1===n(i[l],l,i))break}return e}}function go(t){return function(e){var n=O(e=Zr(e))?Y(e):q,o=n?n[0]:e.charAt(0),r=n?oo(n,1).join(""):e.slice(1);return o[t]()+r}}function bo(t){return function(e){return h(ii(ri(e).replace(Me,"")),t,"")}}function yo(t){return function(){var e=arguments;switch(e.length){case 0:return new t;case 1:return new t(e[0]);case 2:return new t(e[0],e[1]);case 3:return new t(e[0],e[1],e[2]);case 4:return new t(e[0],e[1],e[2],e[3]);case 5:return new t(e[0],e[1],e[2],e[3],e[4]);case 6:return new t(e[0],e[1],e[2],e[3],e[4],e[5]);case 7:return new t(e[0],e[1],e[2],e[3],e[4],e[5],e[6])}var n=Da(t.prototype),o=t.apply(n,e);return Nr(o)?o:n}}function _o(t){return function(e,n,o){var r=_i(e);if(!Ar(e)){var i=Bo(n,3);e=Kr(e),n=function(t){return i(r[t],t,r)}}var a=t(e,n,o);return a>-1?r[i?e[a]:a]:q}}function wo(t){return Oo(function(e){var n=e.length,o=n,i=r.prototype.thru;for(t&&e.reverse();o--;){var a=e[o];if("function"!=typeof a)throw new xi(W);if(i&&!s&&"wrapper"==Uo(a))var s=new r([],!0)}for(o=s?o:n;++o<n;){var l=Uo(a=e[o]),u="wrapper"==l?za(a):q;s=u&&Qo(u[0])&&424==u[1]&&!u[4].length&&1==u[9]?s[Uo(u[0])].apply(s,u[3]):1==a.length&&Qo(a)?s[l]():s.thru(a)}return function(){var t=arguments,o=t[0];if(s&&1==t.length&&Ps(o))return s.plant(o).value();for(var r=0,i=n?e[r].apply(this,t):o;++r<n;)i=e[r].call(this,i);return i}})}function jo(t,e,n,o,r,i,a,s,l,u){var c=e&K,d=1&e,f=2&e,v=24&e,p=512&e,h=f?q:yo(t);return function m(){for(var g=arguments.length,b=hi(g),y=g;y--;)b[y]=arguments[y];if(v)var _=Eo(m),w=$(b,_);if(o&&(b=lo(b,o,r,v)),i&&(b=uo(b,i,a,v)),g-=w,v&&g<u){var j=U(b,_);return To(t,e,jo,m.placeholder,n,b,j,s,l,u-g)}var x=d?n:this,C=f?x[t]:t;return g=b.length,s?b=rr(b,s):p&&g>1&&b.reverse(),c&&l<g&&(b.length=l),this&&this!==Je&&this instanceof m&&(C=h||yo(C)),C.apply(x,b)}}function xo(t,e){return function(n,o){return function(t,e,n,o){return qe(t,function(t,r,i){e(o,n(t),r,i)}),o}(n,t,e(o),{})}}function Co(t,e){return function(n,o){var r;if(n===q&&o===q)return e;if(n!==q&&(r=n),o!==q){if(r===q)return o;"string"==typeof n||"string"==typeof o?(n=qn(n),o=qn(o)):(n=Hn(n),o=Hn(o)),r=t(n,o)}return r}}function ko(t){return Oo(function(e){return e=v(e,P(Bo())),$n(function(n){var o=this;return t(e,function(t){return i(t,o,n)})})})}function Do(t,e){var n=(e=e===q?" ":qn(e)).length;if(n<2)return n?Rn(e,t):e;var o=Rn(e,Gi(t/V(e)));return O(e)?oo(Y(o),0,t).join(""):o.slice(0,t)}function So(t){return function(e,n,o){return o&&"number"!=typeof o&&Zo(e,n,o)&&(n=o=q),e=Hr(e),n===q?(n=e,e=0):n=Hr(n),function(t,e,n,o){for(var r=-1,i=ra(Gi((e-t)/(n||1)),0),a=hi(i);i--;)a[o?i:++r]=t,t+=n;return a}(e,n,o=o===q?e<n?1:-1:Hr(o),t)}}function Io(t){return function(e,n){return"string"==typeof e&&"string"==typeof n||(e=Jr(e),n=Jr(n)),t(e,n)}}function To(t,e,n,o,r,i,a,s,l,u){var c=8&e;e|=c?G:Q,4&(e&=~(c?Q:G))||(e&=-4);var d=[t,e,r,c?i:q,c?a:q,c?q:i,c?q:a,s,l,u],f=n.apply(q,d);return Qo(t)&&Ea(f,d),f.placeholder=o,ar(f,t,e)}function Po(t){var e=yi[t];return function(t,n){if(t=Jr(t),(n=null==n?0:ia(qr(n),292))&&ea(t)){var o=(Zr(t)+"e").split("e");return+((o=(Zr(e(o[0]+"e"+(+o[1]+n)))+"e").split("e"))[0]+"e"+(+o[1]-n))}return e(t)}}function Lo(t){return function(e){var n=Fa(e);return n==vt?N(e):n==bt?B(e):function(t,e){return v(e,function(e){return[e,t[e]]})}(e,t(e))}}function Ao(t,e,n,o,r,a,s,l){var u=2&e;if(!u&&"function"!=typeof t)throw new xi(W);var c=o?o.length:0;if(c||(e&=-97,o=r=q),s=s===q?s:ra(qr(s),0),l=l===q?l:qr(l),c-=r?r.length:0,e&Q){var d=o,f=r;o=r=q}var v=u?q:za(t),p=[t,e,n,o,r,d,f,a,s,l];if(v&&function(t,e){var n=t[1],o=e[1],r=n|o,i=r<131,a=o==K&&8==n||o==K&&n==tt&&t[7].length<=e[8]||384==o&&e[7].length<=e[8]&&8==n;if(!i&&!a)return t;1&o&&(t[2]=e[2],r|=1&n?0:4);var s=e[3];if(s){var l=t[3];t[3]=l?lo(l,s,e[4]):s,t[4]=l?U(t[3],X):e[4]}(s=e[5])&&(l=t[5],t[5]=l?uo(l,s,e[6]):s,t[6]=l?U(t[5],X):e[6]),(s=e[7])&&(t[7]=s),o&K&&(t[8]=null==t[8]?e[8]:ia(t[8],e[8])),null==t[9]&&(t[9]=e[9]),t[0]=e[0],t[1]=r}(p,v),t=p[0],e=p[1],n=p[2],o=p[3],r=p[4],!(l=p[9]=p[9]===q?u?0:t.length:ra(p[9]-c,0))&&24&e&&(e&=-25),e&&1!=e)h=8==e||e==Z?function(t,e,n){var o=yo(t);return function r(){for(var a=arguments.length,s=hi(a),l=a,u=Eo(r);l--;)s[l]=arguments[l];var c=a<3&&s[0]!==u&&s[a-1]!==u?[]:U(s,u);return(a-=c.length)<n?To(t,e,jo,r.placeholder,q,s,c,q,q,n-a):i(this&&this!==Je&&this instanceof r?o:t,this,s)}}(t,e,l):e!=G&&33!=e||r.length?jo.apply(q,p):function(t,e,n,o){var r=1&e,a=yo(t);return function e(){for(var s=-1,l=arguments.length,u=-1,c=o.length,d=hi(c+l),f=this&&this!==Je&&this instanceof e?a:t;++u<c;)d[u]=o[u];for(;l--;)d[u++]=arguments[++s];return i(f,r?n:this,d)}}(t,e,n,o);else var h=function(t,e,n){var o=1&e,r=yo(t);return function e(){return(this&&this!==Je&&this instanceof e?r:t).apply(o?n:this,arguments)}}(t,e,n);return ar((v?La:Ea)(h,p),t,e)}function Mo(t,e,n,o){return t===q||Lr(t,Di[n])&&!Ti.call(o,n)?e:t}function Ro(t,e,n,o,r,i){return Nr(t)&&Nr(e)&&(i.set(e,t),Sn(t,e,q,Ro,i),i.delete(e)),t}function $o(t){return Er(t)?q:t}function zo(t,e,n,o,r,i){var a=1&n,s=t.length,l=e.length;if(s!=l&&!(a&&l>s))return!1;var u=i.get(t),c=i.get(e);if(u&&c)return u==e&&c==t;var d=-1,f=!0,v=2&n?new pe:q;for(i.set(t,e),i.set(e,t);++d<s;){var p=t[d],h=e[d];if(o)var m=a?o(h,p,d,e,t,i):o(p,h,d,t,e,i);if(m!==q){if(m)continue;f=!1;break}if(v){if(!g(e,function(t,e){if(!A(v,e)&&(p===t||r(p,t,n,o,i)))return v.push(e)})){f=!1;break}}else if(p!==h&&!r(p,h,n,o,i)){f=!1;break}}return i.delete(t),i.delete(e),f}function Oo(t){return Va(nr(t,q,pr),t+"")}function No(t){return Qe(t,Kr,Oa)}function Fo(t){return Qe(t,ti,Na)}function Uo(t){for(var e=t.name+"",n=ga[e],o=Ti.call(ga,e)?n.length:0;o--;){var r=n[o],i=r.func;if(null==i||i==t)return r.name}return e}function Eo(t){return(Ti.call(n,"placeholder")?n:t).placeholder}function Bo(){var t=n.iteratee||li;return t=t===li?_n:t,arguments.length?t(arguments[0],arguments[1]):t}function Vo(t,e){var n,o,r=t.__data__;return("string"==(o=typeof(n=e))||"number"==o||"symbol"==o||"boolean"==o?"__proto__"!==n:null===n)?r["string"==typeof e?"string":"hash"]:r.map}function Yo(t){for(var e=Kr(t),n=e.length;n--;){var o=e[n],r=t[o];e[n]=[o,r,tr(r)]}return e}function Ho(t,e){var n=function(t,e){return null==t?q:t[e]}(t,e);return yn(n)?n:q}function qo(t,e,n){for(var o=-1,r=(e=no(e,t)).length,i=!1;++o<r;){var a=ur(e[o]);if(!(i=null!=t&&n(t,a)))break;t=t[a]}return i||++o!=r?i:!!(r=null==t?0:t.length)&&Or(r)&&Xo(a,r)&&(Ps(t)||Ts(t))}function Wo(t){return"function"!=typeof t.constructor||Ko(t)?{}:Da(Ui(t))}function Jo(t){return Ps(t)||Ts(t)||!!(Yi&&t&&t[Yi])}function Xo(t,e){var n=typeof t;return!!(e=null==e?nt:e)&&("number"==n||"symbol"!=n&&le.test(t))&&t>-1&&t%1==0&&t<e}function Zo(t,e,n){if(!Nr(n))return!1;var o=typeof e;return!!("number"==o?Ar(n)&&Xo(e,n.length):"string"==o&&e in n)&&Lr(n[e],t)}function Go(t,e){if(Ps(t))return!1;var n=typeof t;return!("number"!=n&&"symbol"!=n&&"boolean"!=n&&null!=t&&!Vr(t))||Yt.test(t)||!Vt.test(t)||null!=e&&t in _i(e)}function Qo(t){var e=Uo(t),o=n[e];if("function"!=typeof o||!(e in k.prototype))return!1;if(t===o)return!0;var r=za(o);return!!r&&t===r[0]}function Ko(t){var e=t&&t.constructor;return t===("function"==typeof e&&e.prototype||Di)}function tr(t){return t==t&&!Nr(t)}function er(t,e){return function(n){return null!=n&&n[t]===e&&(e!==q||t in _i(n))}}function nr(t,e,n){return e=ra(e===q?t.length-1:e,0),function(){for(var o=arguments,r=-1,a=ra(o.length-e,0),s=hi(a);++r<a;)s[r]=o[e+r];r=-1;for(var l=hi(e+1);++r<e;)l[r]=o[r];return l[e]=n(s),i(t,this,l)}}function or(t,e){return e.length<2?t:Ze(t,Un(e,0,-1))}function rr(t,e){for(var n=t.length,o=ia(e.length,n),r=co(t);o--;){var i=e[o];t[o]=Xo(i,n)?r[i]:q}return t}function ir(t,e){if(("constructor"!==e||"function"!=typeof t[e])&&"__proto__"!=e)return t[e]}function ar(t,e,n){var o=e+"";return Va(t,function(t,e){var n=e.length;if(!n)return t;var o=n-1;return e[o]=(n>1?"& ":"")+e[o],e=e.join(n>2?", ":" "),t.replace(Zt,"{\n/* [wrapped with "+e+"] */\n")}(o,function(t,e){return s(it,function(n){var o="_."+n[0];e&n[1]&&!d(t,o)&&t.push(o)}),t.sort()}(function(t){var e=t.match(Gt);return e?e[1].split(Qt):[]}(o),n)))}function sr(t){var e=0,n=0;return function(){var o=aa(),r=16-(o-n);if(n=o,r>0){if(++e>=800)return arguments[0]}else e=0;return t.apply(q,arguments)}}function lr(t,e){var n=-1,o=t.length,r=o-1;for(e=e===q?o:e;++n<e;){var i=Mn(n,r),a=t[i];t[i]=t[n],t[n]=a}return t.length=e,t}function ur(t){if("string"==typeof t||Vr(t))return t;var e=t+"";return"0"==e&&1/t==-1/0?"-0":e}function cr(t){if(null!=t){try{return Ii.call(t)}catch(t){}try{return t+""}catch(t){}}return""}function dr(t){if(t instanceof k)return t.clone();var e=new r(t.__wrapped__,t.__chain__);return e.__actions__=co(t.__actions__),e.__index__=t.__index__,e.__values__=t.__values__,e}function fr(t,e,n){var o=null==t?0:t.length;if(!o)return-1;var r=null==n?0:qr(n);return r<0&&(r=ra(o+r,0)),y(t,Bo(e,3),r)}function vr(t,e,n){var o=null==t?0:t.length;if(!o)return-1;var r=o-1;return n!==q&&(r=qr(n),r=n<0?ra(o+r,0):ia(r,o-1)),y(t,Bo(e,3),r,!0)}function pr(t){return(null==t?0:t.length)?Ve(t,1):[]}function hr(t){return t&&t.length?t[0]:q}function mr(t){var e=null==t?0:t.length;return e?t[e-1]:q}function gr(t,e){return t&&t.length&&e&&e.length?Ln(t,e):t}function br(t){return null==t?t:ua.call(t)}function yr(t){if(!t||!t.length)return[];var e=0;return t=c(t,function(t){if(Mr(t))return e=ra(t.length,e),!0}),I(e,function(e){return v(t,C(e))})}function _r(t,e){if(!t||!t.length)return[];var n=yr(t);return null==e?n:v(n,function(t){return i(e,q,t)})}function wr(t){var e=n(t);return e.__chain__=!0,e}function jr(t,e){return e(t)}function xr(t,e){return(Ps(t)?s:Sa)(t,Bo(e,3))}function Cr(t,e){return(Ps(t)?l:Ia)(t,Bo(e,3))}function kr(t,e){return(Ps(t)?v:Cn)(t,Bo(e,3))}function Dr(t,e,n){return e=n?q:e,e=t&&null==e?t.length:e,Ao(t,K,q,q,q,q,e)}function Sr(t,e){var n;if("function"!=typeof e)throw new xi(W);return t=qr(t),function(){return--t>0&&(n=e.apply(this,arguments)),t<=1&&(e=q),n}}function Ir(t,e,n){function o(e){var n=u,o=c;return u=c=q,h=e,f=t.apply(o,n)}function r(t){return h=t,v=Ba(a,e),m?o(t):f}function i(t){var n=t-p;return p===q||n>=e||n<0||g&&t-h>=d}function a(){var t=gs();if(i(t))return s(t);v=Ba(a,function(t){var n=e-(t-p);return g?ia(n,d-(t-h)):n}(t))}function s(t){return v=q,b&&u?o(t):(u=c=q,f)}function l(){var t=gs(),n=i(t);if(u=arguments,c=this,p=t,n){if(v===q)return r(p);if(g)return Ra(v),v=Ba(a,e),o(p)}return v===q&&(v=Ba(a,e)),f}var u,c,d,f,v,p,h=0,m=!1,g=!1,b=!0;if("function"!=typeof t)throw new xi(W);return e=Jr(e)||0,Nr(n)&&(m=!!n.leading,d=(g="maxWait"in n)?ra(Jr(n.maxWait)||0,e):d,b="trailing"in n?!!n.trailing:b),l.cancel=function(){v!==q&&Ra(v),h=0,u=p=c=v=q},l.flush=function(){return v===q?f:s(gs())},l}function Tr(t,e){if("function"!=typeof t||null!=e&&"function"!=typeof e)throw new xi(W);var n=function(){var o=arguments,r=e?e.apply(this,o):o[0],i=n.cache;if(i.has(r))return i.get(r);var a=t.apply(this,o);return n.cache=i.set(r,a)||i,a};return n.cache=new(Tr.Cache||ve),n}function Pr(t){if("function"!=typeof t)throw new xi(W);return function(){var e=arguments;switch(e.length){case 0:return!t.call(this);case 1:return!t.call(this,e[0]);case 2:return!t.call(this,e[0],e[1]);case 3:return!t.call(this,e[0],e[1],e[2])}return!t.apply(this,e)}}function Lr(t,e){return t===e||t!=t&&e!=e}function Ar(t){return null!=t&&Or(t.length)&&!$r(t)}function Mr(t){return Fr(t)&&Ar(t)}function Rr(t){if(!Fr(t))return!1;var e=Ke(t);return e==ct||"[object DOMException]"==e||"string"==typeof t.message&&"string"==typeof t.name&&!Er(t)}function $r(t){if(!Nr(t))return!1;var e=Ke(t);return e==dt||e==ft||"[object AsyncFunction]"==e||"[object Proxy]"==e}function zr(t){return"number"==typeof t&&t==qr(t)}function Or(t){return"number"==typeof t&&t>-1&&t%1==0&&t<=nt}function Nr(t){var e=typeof t;return null!=t&&("object"==e||"function"==e)}function Fr(t){return null!=t&&"object"==typeof t}function Ur(t){return"number"==typeof t||Fr(t)&&Ke(t)==pt}function Er(t){if(!Fr(t)||Ke(t)!=ht)return!1;var e=Ui(t);if(null===e)return!0;var n=Ti.call(e,"constructor")&&e.constructor;return"function"==typeof n&&n instanceof n&&Ii.call(n)==Mi}function Br(t){return"string"==typeof t||!Ps(t)&&Fr(t)&&Ke(t)==yt}function Vr(t){return"symbol"==typeof t||Fr(t)&&Ke(t)==_t}function Yr(t){if(!t)return[];if(Ar(t))return Br(t)?Y(t):co(t);if(Hi&&t[Hi])return function(t){for(var e,n=[];!(e=t.next()).done;)n.push(e.value);return n}(t[Hi]());var e=Fa(t);return(e==vt?N:e==bt?E:ni)(t)}function Hr(t){return t?(t=Jr(t))===et||t===-1/0?1.7976931348623157e308*(t<0?-1:1):t==t?t:0:0===t?t:0}function qr(t){var e=Hr(t),n=e%1;return e==e?n?e-n:e:0}function Wr(t){return t?Se(qr(t),0,rt):0}function Jr(t){if("number"==typeof t)return t;if(Vr(t))return ot;if(Nr(t)){var e="function"==typeof t.valueOf?t.valueOf():t;t=Nr(e)?e+"":e}if("string"!=typeof t)return 0===t?t:+t;t=T(t);var n=ie.test(t);return n||se.test(t)?He(t.slice(2),n?2:8):re.test(t)?ot:+t}function Xr(t){return fo(t,ti(t))}function Zr(t){return null==t?"":qn(t)}function Gr(t,e,n){var o=null==t?q:Ze(t,e);return o===q?n:o}function Qr(t,e){return null!=t&&qo(t,e,vn)}function Kr(t){return Ar(t)?me(t):wn(t)}function ti(t){return Ar(t)?me(t,!0):jn(t)}function ei(t,e){if(null==t)return{};var n=v(Fo(t),function(t){return[t]});return e=Bo(e),Pn(t,n,function(t,n){return e(t,n[0])})}function ni(t){return null==t?[]:L(t,Kr(t))}function oi(t){return ul(Zr(t).toLowerCase())}function ri(t){return(t=Zr(t))&&t.replace(ue,ln).replace(Re,"")}function ii(t,e,n){return t=Zr(t),(e=n?q:e)===q?function(t){return Ne.test(t)}(t)?function(t){return t.match(ze)||[]}(t):function(t){return t.match(Kt)||[]}(t):t.match(e)||[]}function ai(t){return function(){return t}}function si(t){return t}function li(t){return _n("function"==typeof t?t:Ie(t,1))}function ui(t,e,n){var o=Kr(e),r=Xe(e,o);null!=n||Nr(e)&&(r.length||!o.length)||(n=e,e=t,t=this,r=Xe(e,Kr(e)));var i=!(Nr(n)&&"chain"in n&&!n.chain),a=$r(t);return s(r,function(n){var o=e[n];t[n]=o,a&&(t.prototype[n]=function(){var e=this.__chain__;if(i||e){var n=t(this.__wrapped__);return(n.__actions__=co(this.__actions__)).push({func:o,args:arguments,thisArg:t}),n.__chain__=e,n}return o.apply(t,p([this.value()],arguments))})}),t}function ci(){}function di(t){return Go(t)?C(ur(t)):function(t){return function(e){return Ze(e,t)}}(t)}function fi(){return[]}function vi(){return!1}var pi,hi=(e=null==e?Je:dn.defaults(Je.Object(),e,dn.pick(Je,Fe))).Array,mi=e.Date,gi=e.Error,bi=e.Function,yi=e.Math,_i=e.Object,wi=e.RegExp,ji=e.String,xi=e.TypeError,Ci=hi.prototype,ki=bi.prototype,Di=_i.prototype,Si=e["__core-js_shared__"],Ii=ki.toString,Ti=Di.hasOwnProperty,Pi=0,Li=(pi=/[^.]+$/.exec(Si&&Si.keys&&Si.keys.IE_PROTO||""))?"Symbol(src)_1."+pi:"",Ai=Di.toString,Mi=Ii.call(_i),Ri=Je._,$i=wi("^"+Ii.call(Ti).replace(qt,"\\$&").replace(/hasOwnProperty|(function).*?(?=\\\()| for .+?(?=\\\])/g,"$1.*?")+"$"),zi=Ge?e.Buffer:q,Oi=e.Symbol,Ni=e.Uint8Array,Fi=zi?zi.allocUnsafe:q,Ui=F(_i.getPrototypeOf,_i),Ei=_i.create,Bi=Di.propertyIsEnumerable,Vi=Ci.splice,Yi=Oi?Oi.isConcatSpreadable:q,Hi=Oi?Oi.iterator:q,qi=Oi?Oi.toStringTag:q,Wi=function(){try{var t=Ho(_i,"defineProperty");return t({},"",{}),t}catch(t){}}(),Ji=e.clearTimeout!==Je.clearTimeout&&e.clearTimeout,Xi=mi&&mi.now!==Je.Date.now&&mi.now,Zi=e.setTimeout!==Je.setTimeout&&e.setTimeout,Gi=yi.ceil,Qi=yi.floor,Ki=_i.getOwnPropertySymbols,ta=zi?zi.isBuffer:q,ea=e.isFinite,na=Ci.join,oa=F(_i.keys,_i),ra=yi.max,ia=yi.min,aa=mi.now,sa=e.parseInt,la=yi.random,ua=Ci.reverse,ca=Ho(e,"DataView"),da=Ho(e,"Map"),fa=Ho(e,"Promise"),va=Ho(e,"Set"),pa=Ho(e,"WeakMap"),ha=Ho(_i,"create"),ma=pa&&new pa,ga={},ba=cr(ca),ya=cr(da),_a=cr(fa),wa=cr(va),ja=cr(pa),xa=Oi?Oi.prototype:q,Ca=xa?xa.valueOf:q,ka=xa?xa.toString:q,Da=function(){function t(){}return function(e){if(!Nr(e))return{};if(Ei)return Ei(e);t.prototype=e;var n=new t;return t.prototype=q,n}}();n.templateSettings={escape:Ut,evaluate:Et,interpolate:Bt,variable:"",imports:{_:n}},n.prototype=o.prototype,n.prototype.constructor=n,r.prototype=Da(o.prototype),r.prototype.constructor=r,k.prototype=Da(o.prototype),k.prototype.constructor=k,Xt.prototype.clear=function(){this.__data__=ha?ha(null):{},this.size=0},Xt.prototype.delete=function(t){var e=this.has(t)&&delete this.__data__[t];return this.size-=e?1:0,e},Xt.prototype.get=function(t){var e=this.__data__;if(ha){var n=e[t];return n===J?q:n}return Ti.call(e,t)?e[t]:q},Xt.prototype.has=function(t){var e=this.__data__;return ha?e[t]!==q:Ti.call(e,t)},Xt.prototype.set=function(t,e){var n=this.__data__;return this.size+=this.has(t)?0:1,n[t]=ha&&e===q?J:e,this},fe.prototype.clear=function(){this.__data__=[],this.size=0},fe.prototype.delete=function(t){var e=this.__data__,n=je(e,t);return!(n<0||(n==e.length-1?e.pop():Vi.call(e,n,1),--this.size,0))},fe.prototype.get=function(t){var e=this.__data__,n=je(e,t);return n<0?q:e[n][1]},fe.prototype.has=function(t){return je(this.__data__,t)>-1},fe.prototype.set=function(t,e){var n=this.__data__,o=je(n,t);return o<0?(++this.size,n.push([t,e])):n[o][1]=e,this},ve.prototype.clear=function(){this.size=0,this.__data__={hash:new Xt,map:new(da||fe),string:new Xt}},ve.prototype.delete=function(t){var e=Vo(this,t).delete(t);return this.size-=e?1:0,e},ve.prototype.get=function(t){return Vo(this,t).get(t)},ve.prototype.has=function(t){return Vo(this,t).has(t)},ve.prototype.set=function(t,e){var n=Vo(this,t),o=n.size;return n.set(t,e),this.size+=n.size==o?0:1,this},pe.prototype.add=pe.prototype.push=function(t){return this.__data__.set(t,J),this},pe.prototype.has=function(t){return this.__data__.has(t)},he.prototype.clear=function(){this.__data__=new fe,this.size=0},he.prototype.delete=function(t){var e=this.__data__,n=e.delete(t);return this.size=e.size,n},he.prototype.get=function(t){return this.__data__.get(t)},he.prototype.has=function(t){return this.__data__.has(t)},he.prototype.set=function(t,e){var n=this.__data__;if(n instanceof fe){var o=n.__data__;if(!da||o.length<199)return o.push([t,e]),this.size=++n.size,this;n=this.__data__=new ve(o)}return n.set(t,e),this.size=n.size,this};var Sa=ho(qe),Ia=ho(We,!0),Ta=mo(),Pa=mo(!0),La=ma?function(t,e){return ma.set(t,e),t}:si,Aa=Wi?function(t,e){return Wi(t,"toString",{configurable:!0,enumerable:!1,value:ai(e),writable:!0})}:si,Ma=$n,Ra=Ji||function(t){return Je.clearTimeout(t)},$a=va&&1/E(new va([,-0]))[1]==et?function(t){return new va(t)}:ci,za=ma?function(t){return ma.get(t)}:ci,Oa=Ki?function(t){return null==t?[]:(t=_i(t),c(Ki(t),function(e){return Bi.call(t,e)}))}:fi,Na=Ki?function(t){for(var e=[];t;)p(e,Oa(t)),t=Ui(t);return e}:fi,Fa=Ke;(ca&&Fa(new ca(new ArrayBuffer(1)))!=xt||da&&Fa(new da)!=vt||fa&&Fa(fa.resolve())!=mt||va&&Fa(new va)!=bt||pa&&Fa(new pa)!=wt)&&(Fa=function(t){var e=Ke(t),n=e==ht?t.constructor:q,o=n?cr(n):"";if(o)switch(o){case ba:return xt;case ya:return vt;case _a:return mt;case wa:return bt;case ja:return wt}return e});var Ua=Si?$r:vi,Ea=sr(La),Ba=Zi||function(t,e){return Je.setTimeout(t,e)},Va=sr(Aa),Ya=function(t){var e=Tr(t,function(t){return 500===n.size&&n.clear(),t}),n=e.cache;return e}(function(t){var e=[];return 46===t.charCodeAt(0)&&e.push(""),t.replace(Ht,function(t,n,o,r){e.push(o?r.replace(ee,"$1"):n||t)}),e}),Ha=$n(function(t,e){return Mr(t)?Le(t,Ve(e,1,Mr,!0)):[]}),qa=$n(function(t,e){var n=mr(e);return Mr(n)&&(n=q),Mr(t)?Le(t,Ve(e,1,Mr,!0),Bo(n,2)):[]}),Wa=$n(function(t,e){var n=mr(e);return Mr(n)&&(n=q),Mr(t)?Le(t,Ve(e,1,Mr,!0),q,n):[]}),Ja=$n(function(t){var e=v(t,to);return e.length&&e[0]===t[0]?pn(e):[]}),Xa=$n(function(t){var e=mr(t),n=v(t,to);return e===mr(n)?e=q:n.pop(),n.length&&n[0]===t[0]?pn(n,Bo(e,2)):[]}),Za=$n(function(t){var e=mr(t),n=v(t,to);return(e="function"==typeof e?e:q)&&n.pop(),n.length&&n[0]===t[0]?pn(n,q,e):[]}),Ga=$n(gr),Qa=Oo(function(t,e){var n=null==t?0:t.length,o=De(t,e);return An(t,v(e,function(t){return Xo(t,n)?+t:t}).sort(so)),o}),Ka=$n(function(t){return Wn(Ve(t,1,Mr,!0))}),ts=$n(function(t){var e=mr(t);return Mr(e)&&(e=q),Wn(Ve(t,1,Mr,!0),Bo(e,2))}),es=$n(function(t){var e=mr(t);return e="function"==typeof e?e:q,Wn(Ve(t,1,Mr,!0),q,e)}),ns=$n(function(t,e){return Mr(t)?Le(t,e):[]}),os=$n(function(t){return Qn(c(t,Mr))}),rs=$n(function(t){var e=mr(t);return Mr(e)&&(e=q),Qn(c(t,Mr),Bo(e,2))}),is=$n(function(t){var e=mr(t);return e="function"==typeof e?e:q,Qn(c(t,Mr),q,e)}),as=$n(yr),ss=$n(function(t){var e=t.length,n=e>1?t[e-1]:q;return n="function"==typeof n?(t.pop(),n):q,_r(t,n)}),ls=Oo(function(t){var e=t.length,n=e?t[0]:0,o=this.__wrapped__,i=function(e){return De(e,t)};return!(e>1||this.__actions__.length)&&o instanceof k&&Xo(n)?((o=o.slice(n,+n+(e?1:0))).__actions__.push({func:jr,args:[i],thisArg:q}),new r(o,this.__chain__).thru(function(t){return e&&!t.length&&t.push(q),t})):this.thru(i)}),us=vo(function(t,e,n){Ti.call(t,n)?++t[n]:ke(t,n,1)}),cs=_o(fr),ds=_o(vr),fs=vo(function(t,e,n){Ti.call(t,n)?t[n].push(e):ke(t,n,[e])}),vs=$n(function(t,e,n){var o=-1,r="function"==typeof e,a=Ar(t)?hi(t.length):[];return Sa(t,function(t){a[++o]=r?i(e,t,n):hn(t,e,n)}),a}),ps=vo(function(t,e,n){ke(t,n,e)}),hs=vo(function(t,e,n){t[n?0:1].push(e)},function(){return[[],[]]}),ms=$n(function(t,e){if(null==t)return[];var n=e.length;return n>1&&Zo(t,e[0],e[1])?e=[]:n>2&&Zo(e[0],e[1],e[2])&&(e=[e[0]]),Tn(t,Ve(e,1),[])}),gs=Xi||function(){return Je.Date.now()},bs=$n(function(t,e,n){var o=1;if(n.length){var r=U(n,Eo(bs));o|=G}return Ao(t,o,e,n,r)}),ys=$n(function(t,e,n){var o=3;if(n.length){var r=U(n,Eo(ys));o|=G}return Ao(e,o,t,n,r)}),_s=$n(function(t,e){return Pe(t,1,e)}),ws=$n(function(t,e,n){return Pe(t,Jr(e)||0,n)});Tr.Cache=ve;var js,xs=Ma(function(t,e){var n=(e=1==e.length&&Ps(e[0])?v(e[0],P(Bo())):v(Ve(e,1),P(Bo()))).length;return $n(function(o){for(var r=-1,a=ia(o.length,n);++r<a;)o[r]=e[r].call(this,o[r]);return i(t,this,o)})}),Cs=$n(function(t,e){var n=U(e,Eo(Cs));return Ao(t,G,q,e,n)}),ks=$n(function(t,e){var n=U(e,Eo(ks));return Ao(t,Q,q,e,n)}),Ds=Oo(function(t,e){return Ao(t,tt,q,q,q,e)}),Ss=Io(sn),Is=Io(function(t,e){return t>=e}),Ts=mn(function(){return arguments}())?mn:function(t){return Fr(t)&&Ti.call(t,"callee")&&!Bi.call(t,"callee")},Ps=hi.isArray,Ls=tn?P(tn):function(t){return Fr(t)&&Ke(t)==jt},As=ta||vi,Ms=en?P(en):function(t){return Fr(t)&&Ke(t)==ut},Rs=nn?P(nn):function(t){return Fr(t)&&Fa(t)==vt},$s=on?P(on):function(t){return Fr(t)&&Ke(t)==gt},zs=rn?P(rn):function(t){return Fr(t)&&Fa(t)==bt},Os=an?P(an):function(t){return Fr(t)&&Or(t.length)&&!!Ee[Ke(t)]},Ns=Io(xn),Fs=Io(function(t,e){return t<=e}),Us=po(function(t,e){if(Ko(e)||Ar(e))fo(e,Kr(e),t);else for(var n in e)Ti.call(e,n)&&we(t,n,e[n])}),Es=po(function(t,e){fo(e,ti(e),t)}),Bs=po(function(t,e,n,o){fo(e,ti(e),t,o)}),Vs=po(function(t,e,n,o){fo(e,Kr(e),t,o)}),Ys=Oo(De),Hs=$n(function(t,e){t=_i(t);var n=-1,o=e.length,r=o>2?e[2]:q;for(r&&Zo(e[0],e[1],r)&&(o=1);++n<o;)for(var i=e[n],a=ti(i),s=-1,l=a.length;++s<l;){var u=a[s],c=t[u];(c===q||Lr(c,Di[u])&&!Ti.call(t,u))&&(t[u]=i[u])}return t}),qs=$n(function(t){return t.push(q,Ro),i(Gs,q,t)}),Ws=xo(function(t,e,n){null!=e&&"function"!=typeof e.toString&&(e=Ai.call(e)),t[e]=n},ai(si)),Js=xo(function(t,e,n){null!=e&&"function"!=typeof e.toString&&(e=Ai.call(e)),Ti.call(t,e)?t[e].push(n):t[e]=[n]},Bo),Xs=$n(hn),Zs=po(function(t,e,n){Sn(t,e,n)}),Gs=po(function(t,e,n,o){Sn(t,e,n,o)}),Qs=Oo(function(t,e){var n={};if(null==t)return n;var o=!1;e=v(e,function(e){return e=no(e,t),o||(o=e.length>1),e}),fo(t,Fo(t),n),o&&(n=Ie(n,7,$o));for(var r=e.length;r--;)Jn(n,e[r]);return n}),Ks=Oo(function(t,e){return null==t?{}:function(t,e){return Pn(t,e,function(e,n){return Qr(t,n)})}(t,e)}),tl=Lo(Kr),el=Lo(ti),nl=bo(function(t,e,n){return e=e.toLowerCase(),t+(n?oi(e):e)}),ol=bo(function(t,e,n){return t+(n?"-":"")+e.toLowerCase()}),rl=bo(function(t,e,n){return t+(n?" ":"")+e.toLowerCase()}),il=go("toLowerCase"),al=bo(function(t,e,n){return t+(n?"_":"")+e.toLowerCase()}),sl=bo(function(t,e,n){return t+(n?" ":"")+ul(e)}),ll=bo(function(t,e,n){return t+(n?" ":"")+e.toUpperCase()}),ul=go("toUpperCase"),cl=$n(function(t,e){try{return i(t,q,e)}catch(t){return Rr(t)?t:new gi(t)}}),dl=Oo(function(t,e){return s(e,function(e){e=ur(e),ke(t,e,bs(t[e],t))}),t}),fl=wo(),vl=wo(!0),pl=$n(function(t,e){return function(n){return hn(n,t,e)}}),hl=$n(function(t,e){return function(n){return hn(t,n,e)}}),ml=ko(v),gl=ko(u),bl=ko(g),yl=So(),_l=So(!0),wl=Co(function(t,e){return t+e},0),jl=Po("ceil"),xl=Co(function(t,e){return t/e},1),Cl=Po("floor"),kl=Co(function(t,e){return t*e},1),Dl=Po("round"),Sl=Co(function(t,e){return t-e},0);return n.after=function(t,e){if("function"!=typeof e)throw new xi(W);return t=qr(t),function(){if(--t<1)return e.apply(this,arguments)}},n.ary=Dr,n.assign=Us,n.assignIn=Es,n.assignInWith=Bs,n.assignWith=Vs,n.at=Ys,n.before=Sr,n.bind=bs,n.bindAll=dl,n.bindKey=ys,n.castArray=function(){if(!arguments.length)return[];var t=arguments[0];return Ps(t)?t:[t]},n.chain=wr,n.chunk=function(t,e,n){e=(n?Zo(t,e,n):e===q)?1:ra(qr(e),0);var o=null==t?0:t.length;if(!o||e<1)return[];for(var r=0,i=0,a=hi(Gi(o/e));r<o;)a[i++]=Un(t,r,r+=e);return a},n.compact=function(t){for(var e=-1,n=null==t?0:t.length,o=0,r=[];++e<n;){var i=t[e];i&&(r[o++]=i)}return r},n.concat=function(){var t=arguments.length;if(!t)return[];for(var e=hi(t-1),n=arguments[0],o=t;o--;)e[o-1]=arguments[o];return p(Ps(n)?co(n):[n],Ve(e,1))},n.cond=function(t){var e=null==t?0:t.length,n=Bo();return t=e?v(t,function(t){if("function"!=typeof t[1])throw new xi(W);return[n(t[0]),t[1]]}):[],$n(function(n){for(var o=-1;++o<e;){var r=t[o];if(i(r[0],this,n))return i(r[1],this,n)}})},n.conforms=function(t){return function(t){var e=Kr(t);return function(n){return Te(n,t,e)}}(Ie(t,1))},n.constant=ai,n.countBy=us,n.create=function(t,e){var n=Da(t);return null==e?n:Ce(n,e)},n.curry=function t(e,n,o){var r=Ao(e,8,q,q,q,q,q,n=o?q:n);return r.placeholder=t.placeholder,r},n.curryRight=function t(e,n,o){var r=Ao(e,Z,q,q,q,q,q,n=o?q:n);return r.placeholder=t.placeholder,r},n.debounce=Ir,n.defaults=Hs,n.defaultsDeep=qs,n.defer=_s,n.delay=ws,n.difference=Ha,n.differenceBy=qa,n.differenceWith=Wa,n.drop=function(t,e,n){var o=null==t?0:t.length;return o?Un(t,(e=n||e===q?1:qr(e))<0?0:e,o):[]},n.dropRight=function(t,e,n){var o=null==t?0:t.length;return o?Un(t,0,(e=o-(e=n||e===q?1:qr(e)))<0?0:e):[]},n.dropRightWhile=function(t,e){return t&&t.length?Zn(t,Bo(e,3),!0,!0):[]},n.dropWhile=function(t,e){return t&&t.length?Zn(t,Bo(e,3),!0):[]},n.fill=function(t,e,n,o){var r=null==t?0:t.length;return r?(n&&"number"!=typeof n&&Zo(t,e,n)&&(n=0,o=r),function(t,e,n,o){var r=t.length;for((n=qr(n))<0&&(n=-n>r?0:r+n),(o=o===q||o>r?r:qr(o))<0&&(o+=r),o=n>o?0:Wr(o);n<o;)t[n++]=e;return t}(t,e,n,o)):[]},n.filter=function(t,e){return(Ps(t)?c:Oe)(t,Bo(e,3))},n.flatMap=function(t,e){return Ve(kr(t,e),1)},n.flatMapDeep=function(t,e){return Ve(kr(t,e),et)},n.flatMapDepth=function(t,e,n){return n=n===q?1:qr(n),Ve(kr(t,e),n)},n.flatten=pr,n.flattenDeep=function(t){return(null==t?0:t.length)?Ve(t,et):[]},n.flattenDepth=function(t,e){return(null==t?0:t.length)?Ve(t,e=e===q?1:qr(e)):[]},n.flip=function(t){return Ao(t,512)},n.flow=fl,n.flowRight=vl,n.fromPairs=function(t){for(var e=-1,n=null==t?0:t.length,o={};++e<n;){var r=t[e];o[r[0]]=r[1]}return o},n.functions=function(t){return null==t?[]:Xe(t,Kr(t))},n.functionsIn=function(t){return null==t?[]:Xe(t,ti(t))},n.groupBy=fs,n.initial=function(t){return(null==t?0:t.length)?Un(t,0,-1):[]},n.intersection=Ja,n.intersectionBy=Xa,n.intersectionWith=Za,n.invert=Ws,n.invertBy=Js,n.invokeMap=vs,n.iteratee=li,n.keyBy=ps,n.keys=Kr,n.keysIn=ti,n.map=kr,n.mapKeys=function(t,e){var n={};return e=Bo(e,3),qe(t,function(t,o,r){ke(n,e(t,o,r),t)}),n},n.mapValues=function(t,e){var n={};return e=Bo(e,3),qe(t,function(t,o,r){ke(n,o,e(t,o,r))}),n},n.matches=function(t){return kn(Ie(t,1))},n.matchesProperty=function(t,e){return Dn(t,Ie(e,1))},n.memoize=Tr,n.merge=Zs,n.mergeWith=Gs,n.method=pl,n.methodOf=hl,n.mixin=ui,n.negate=Pr,n.nthArg=function(t){return t=qr(t),$n(function(e){return In(e,t)})},n.omit=Qs,n.omitBy=function(t,e){return ei(t,Pr(Bo(e)))},n.once=function(t){return Sr(2,t)},n.orderBy=function(t,e,n,o){return null==t?[]:(Ps(e)||(e=null==e?[]:[e]),Ps(n=o?q:n)||(n=null==n?[]:[n]),Tn(t,e,n))},n.over=ml,n.overArgs=xs,n.overEvery=gl,n.overSome=bl,n.partial=Cs,n.partialRight=ks,n.partition=hs,n.pick=Ks,n.pickBy=ei,n.property=di,n.propertyOf=function(t){return function(e){return null==t?q:Ze(t,e)}},n.pull=Ga,n.pullAll=gr,n.pullAllBy=function(t,e,n){return t&&t.length&&e&&e.length?Ln(t,e,Bo(n,2)):t},n.pullAllWith=function(t,e,n){return t&&t.length&&e&&e.length?Ln(t,e,q,n):t},n.pullAt=Qa,n.range=yl,n.rangeRight=_l,n.rearg=Ds,n.reject=function(t,e){return(Ps(t)?c:Oe)(t,Pr(Bo(e,3)))},n.remove=function(t,e){var n=[];if(!t||!t.length)return n;var o=-1,r=[],i=t.length;for(e=Bo(e,3);++o<i;){var a=t[o];e(a,o,t)&&(n.push(a),r.push(o))}return An(t,r),n},n.rest=function(t,e){if("function"!=typeof t)throw new xi(W);return $n(t,e=e===q?e:qr(e))},n.reverse=br,n.sampleSize=function(t,e,n){return e=(n?Zo(t,e,n):e===q)?1:qr(e),(Ps(t)?be:On)(t,e)},n.set=function(t,e,n){return null==t?t:Nn(t,e,n)},n.setWith=function(t,e,n,o){return o="function"==typeof o?o:q,null==t?t:Nn(t,e,n,o)},n.shuffle=function(t){return(Ps(t)?ye:Fn)(t)},n.slice=function(t,e,n){var o=null==t?0:t.length;return o?(n&&"number"!=typeof n&&Zo(t,e,n)?(e=0,n=o):(e=null==e?0:qr(e),n=n===q?o:qr(n)),Un(t,e,n)):[]},n.sortBy=ms,n.sortedUniq=function(t){return t&&t.length?Yn(t):[]},n.sortedUniqBy=function(t,e){return t&&t.length?Yn(t,Bo(e,2)):[]},n.split=function(t,e,n){return n&&"number"!=typeof n&&Zo(t,e,n)&&(e=n=q),(n=n===q?rt:n>>>0)?(t=Zr(t))&&("string"==typeof e||null!=e&&!$s(e))&&!(e=qn(e))&&O(t)?oo(Y(t),0,n):t.split(e,n):[]},n.spread=function(t,e){if("function"!=typeof t)throw new xi(W);return e=null==e?0:ra(qr(e),0),$n(function(n){var o=n[e],r=oo(n,0,e);return o&&p(r,o),i(t,this,r)})},n.tail=function(t){var e=null==t?0:t.length;return e?Un(t,1,e):[]},n.take=function(t,e,n){return t&&t.length?Un(t,0,(e=n||e===q?1:qr(e))<0?0:e):[]},n.takeRight=function(t,e,n){var o=null==t?0:t.length;return o?Un(t,(e=o-(e=n||e===q?1:qr(e)))<0?0:e,o):[]},n.takeRightWhile=function(t,e){return t&&t.length?Zn(t,Bo(e,3),!1,!0):[]},n.takeWhile=function(t,e){return t&&t.length?Zn(t,Bo(e,3)):[]},n.tap=function(t,e){return e(t),t},n.throttle=function(t,e,n){var o=!0,r=!0;if("function"!=typeof t)throw new xi(W);return Nr(n)&&(o="leading"in n?!!n.leading:o,r="trailing"in n?!!n.trailing:r),Ir(t,e,{leading:o,maxWait:e,trailing:r})},n.thru=jr,n.toArray=Yr,n.toPairs=tl,n.toPairsIn=el,n.toPath=function(t){return Ps(t)?v(t,ur):Vr(t)?[t]:co(Ya(Zr(t)))},n.toPlainObject=Xr,n.transform=function(t,e,n){var o=Ps(t),r=o||As(t)||Os(t);if(e=Bo(e,4),null==n){var i=t&&t.constructor;n=r?o?new i:[]:Nr(t)&&$r(i)?Da(Ui(t)):{}}return(r?s:qe)(t,function(t,o,r){return e(n,t,o,r)}),n},n.unary=function(t){return Dr(t,1)},n.union=Ka,n.unionBy=ts,n.unionWith=es,n.uniq=function(t){return t&&t.length?Wn(t):[]},n.uniqBy=function(t,e){return t&&t.length?Wn(t,Bo(e,2)):[]},n.uniqWith=function(t,e){return e="function"==typeof e?e:q,t&&t.length?Wn(t,q,e):[]},n.unset=function(t,e){return null==t||Jn(t,e)},n.unzip=yr,n.unzipWith=_r,n.update=function(t,e,n){return null==t?t:Xn(t,e,eo(n))},n.updateWith=function(t,e,n,o){return o="function"==typeof o?o:q,null==t?t:Xn(t,e,eo(n),o)},n.values=ni,n.valuesIn=function(t){return null==t?[]:L(t,ti(t))},n.without=ns,n.words=ii,n.wrap=function(t,e){return Cs(eo(e),t)},n.xor=os,n.xorBy=rs,n.xorWith=is,n.zip=as,n.zipObject=function(t,e){return Kn(t||[],e||[],we)},n.zipObjectDeep=function(t,e){return Kn(t||[],e||[],Nn)},n.zipWith=ss,n.entries=tl,n.entriesIn=el,n.extend=Es,n.extendWith=Bs,ui(n,n),n.add=wl,n.attempt=cl,n.camelCase=nl,n.capitalize=oi,n.ceil=jl,n.clamp=function(t,e,n){return n===q&&(n=e,e=q),n!==q&&(n=(n=Jr(n))==n?n:0),e!==q&&(e=(e=Jr(e))==e?e:0),Se(Jr(t),e,n)},n.clone=function(t){return Ie(t,4)},n.cloneDeep=function(t){return Ie(t,5)},n.cloneDeepWith=function(t,e){return Ie(t,5,e="function"==typeof e?e:q)},n.cloneWith=function(t,e){return Ie(t,4,e="function"==typeof e?e:q)},n.conformsTo=function(t,e){return null==e||Te(t,e,Kr(e))},n.deburr=ri,n.defaultTo=function(t,e){return null==t||t!=t?e:t},n.divide=xl,n.endsWith=function(t,e,n){t=Zr(t),e=qn(e);var o=t.length,r=n=n===q?o:Se(qr(n),0,o);return(n-=e.length)>=0&&t.slice(n,r)==e},n.eq=Lr,n.escape=function(t){return(t=Zr(t))&&Ft.test(t)?t.replace(Ot,un):t},n.escapeRegExp=function(t){return(t=Zr(t))&&Wt.test(t)?t.replace(qt,"\\$&"):t},n.every=function(t,e,n){var o=Ps(t)?u:Ae;return n&&Zo(t,e,n)&&(e=q),o(t,Bo(e,3))},n.find=cs,n.findIndex=fr,n.findKey=function(t,e){return b(t,Bo(e,3),qe)},n.findLast=ds,n.findLastIndex=vr,n.findLastKey=function(t,e){return b(t,Bo(e,3),We)},n.floor=Cl,n.forEach=xr,n.forEachRight=Cr,n.forIn=function(t,e){return null==t?t:Ta(t,Bo(e,3),ti)},n.forInRight=function(t,e){return null==t?t:Pa(t,Bo(e,3),ti)},n.forOwn=function(t,e){return t&&qe(t,Bo(e,3))},n.forOwnRight=function(t,e){return t&&We(t,Bo(e,3))},n.get=Gr,n.gt=Ss,n.gte=Is,n.has=function(t,e){return null!=t&&qo(t,e,fn)},n.hasIn=Qr,n.head=hr,n.identity=si,n.includes=function(t,e,n,o){t=Ar(t)?t:ni(t),n=n&&!o?qr(n):0;var r=t.length;return n<0&&(n=ra(r+n,0)),Br(t)?n<=r&&t.indexOf(e,n)>-1:!!r&&_(t,e,n)>-1},n.indexOf=function(t,e,n){var o=null==t?0:t.length;if(!o)return-1;var r=null==n?0:qr(n);return r<0&&(r=ra(o+r,0)),_(t,e,r)},n.inRange=function(t,e,n){return e=Hr(e),n===q?(n=e,e=0):n=Hr(n),function(t,e,n){return t>=ia(e,n)&&t<ra(e,n)}(t=Jr(t),e,n)},n.invoke=Xs,n.isArguments=Ts,n.isArray=Ps,n.isArrayBuffer=Ls,n.isArrayLike=Ar,n.isArrayLikeObject=Mr,n.isBoolean=function(t){return!0===t||!1===t||Fr(t)&&Ke(t)==lt},n.isBuffer=As,n.isDate=Ms,n.isElement=function(t){return Fr(t)&&1===t.nodeType&&!Er(t)},n.isEmpty=function(t){if(null==t)return!0;if(Ar(t)&&(Ps(t)||"string"==typeof t||"function"==typeof t.splice||As(t)||Os(t)||Ts(t)))return!t.length;var e=Fa(t);if(e==vt||e==bt)return!t.size;if(Ko(t))return!wn(t).length;for(var n in t)if(Ti.call(t,n))return!1;return!0},n.isEqual=function(t,e){return gn(t,e)},n.isEqualWith=function(t,e,n){var o=(n="function"==typeof n?n:q)?n(t,e):q;return o===q?gn(t,e,q,n):!!o},n.isError=Rr,n.isFinite=function(t){return"number"==typeof t&&ea(t)},n.isFunction=$r,n.isInteger=zr,n.isLength=Or,n.isMap=Rs,n.isMatch=function(t,e){return t===e||bn(t,e,Yo(e))},n.isMatchWith=function(t,e,n){return n="function"==typeof n?n:q,bn(t,e,Yo(e),n)},n.isNaN=function(t){return Ur(t)&&t!=+t},n.isNative=function(t){if(Ua(t))throw new gi("Unsupported core-js use. Try https://npms.io/search?q=ponyfill.");return yn(t)},n.isNil=function(t){return null==t},n.isNull=function(t){return null===t},n.isNumber=Ur,n.isObject=Nr,n.isObjectLike=Fr,n.isPlainObject=Er,n.isRegExp=$s,n.isSafeInteger=function(t){return zr(t)&&t>=-9007199254740991&&t<=nt},n.isSet=zs,n.isString=Br,n.isSymbol=Vr,n.isTypedArray=Os,n.isUndefined=function(t){return t===q},n.isWeakMap=function(t){return Fr(t)&&Fa(t)==wt},n.isWeakSet=function(t){return Fr(t)&&"[object WeakSet]"==Ke(t)},n.join=function(t,e){return null==t?"":na.call(t,e)},n.kebabCase=ol,n.last=mr,n.lastIndexOf=function(t,e,n){var o=null==t?0:t.length;if(!o)return-1;var r=o;return n!==q&&(r=(r=qr(n))<0?ra(o+r,0):ia(r,o-1)),e==e?function(t,e,n){for(var o=n+1;o--;)if(t[o]===e)return o;return o}(t,e,r):y(t,j,r,!0)},n.lowerCase=rl,n.lowerFirst=il,n.lt=Ns,n.lte=Fs,n.max=function(t){return t&&t.length?$e(t,si,sn):q},n.maxBy=function(t,e){return t&&t.length?$e(t,Bo(e,2),sn):q},n.mean=function(t){return x(t,si)},n.meanBy=function(t,e){return x(t,Bo(e,2))},n.min=function(t){return t&&t.length?$e(t,si,xn):q},n.minBy=function(t,e){return t&&t.length?$e(t,Bo(e,2),xn):q},n.stubArray=fi,n.stubFalse=vi,n.stubObject=function(){return{}},n.stubString=function(){return""},n.stubTrue=function(){return!0},n.multiply=kl,n.nth=function(t,e){return t&&t.length?In(t,qr(e)):q},n.noConflict=function(){return Je._===this&&(Je._=Ri),this},n.noop=ci,n.now=gs,n.pad=function(t,e,n){t=Zr(t);var o=(e=qr(e))?V(t):0;if(!e||o>=e)return t;var r=(e-o)/2;return Do(Qi(r),n)+t+Do(Gi(r),n)},n.padEnd=function(t,e,n){t=Zr(t);var o=(e=qr(e))?V(t):0;return e&&o<e?t+Do(e-o,n):t},n.padStart=function(t,e,n){t=Zr(t);var o=(e=qr(e))?V(t):0;return e&&o<e?Do(e-o,n)+t:t},n.parseInt=function(t,e,n){return n||null==e?e=0:e&&(e=+e),sa(Zr(t).replace(Jt,""),e||0)},n.random=function(t,e,n){if(n&&"boolean"!=typeof n&&Zo(t,e,n)&&(e=n=q),n===q&&("boolean"==typeof e?(n=e,e=q):"boolean"==typeof t&&(n=t,t=q)),t===q&&e===q?(t=0,e=1):(t=Hr(t),e===q?(e=t,t=0):e=Hr(e)),t>e){var o=t;t=e,e=o}if(n||t%1||e%1){var r=la();return ia(t+r*(e-t+Ye("1e-"+((r+"").length-1))),e)}return Mn(t,e)},n.reduce=function(t,e,n){var o=Ps(t)?h:D,r=arguments.length<3;return o(t,Bo(e,4),n,r,Sa)},n.reduceRight=function(t,e,n){var o=Ps(t)?m:D,r=arguments.length<3;return o(t,Bo(e,4),n,r,Ia)},n.repeat=function(t,e,n){return e=(n?Zo(t,e,n):e===q)?1:qr(e),Rn(Zr(t),e)},n.replace=function(){var t=arguments,e=Zr(t[0]);return t.length<3?e:e.replace(t[1],t[2])},n.result=function(t,e,n){var o=-1,r=(e=no(e,t)).length;for(r||(r=1,t=q);++o<r;){var i=null==t?q:t[ur(e[o])];i===q&&(o=r,i=n),t=$r(i)?i.call(t):i}return t},n.round=Dl,n.runInContext=t,n.sample=function(t){return(Ps(t)?ge:zn)(t)},n.size=function(t){if(null==t)return 0;if(Ar(t))return Br(t)?V(t):t.length;var e=Fa(t);return e==vt||e==bt?t.size:wn(t).length},n.snakeCase=al,n.some=function(t,e,n){var o=Ps(t)?g:En;return n&&Zo(t,e,n)&&(e=q),o(t,Bo(e,3))},n.sortedIndex=function(t,e){return Bn(t,e)},n.sortedIndexBy=function(t,e,n){return Vn(t,e,Bo(n,2))},n.sortedIndexOf=function(t,e){var n=null==t?0:t.length;if(n){var o=Bn(t,e);if(o<n&&Lr(t[o],e))return o}return-1},n.sortedLastIndex=function(t,e){return Bn(t,e,!0)},n.sortedLastIndexBy=function(t,e,n){return Vn(t,e,Bo(n,2),!0)},n.sortedLastIndexOf=function(t,e){if(null==t?0:t.length){var n=Bn(t,e,!0)-1;if(Lr(t[n],e))return n}return-1},n.startCase=sl,n.startsWith=function(t,e,n){return t=Zr(t),n=null==n?0:Se(qr(n),0,t.length),e=qn(e),t.slice(n,n+e.length)==e},n.subtract=Sl,n.sum=function(t){return t&&t.length?S(t,si):0},n.sumBy=function(t,e){return t&&t.length?S(t,Bo(e,2)):0},n.template=function(t,e,o){var r=n.templateSettings;o&&Zo(t,e,o)&&(e=q),t=Zr(t),e=Bs({},e,r,Mo);var i,a,s=Bs({},e.imports,r.imports,Mo),l=Kr(s),u=L(s,l),c=0,d=e.interpolate||ce,f="__p += '",v=wi((e.escape||ce).source+"|"+d.source+"|"+(d===Bt?ne:ce).source+"|"+(e.evaluate||ce).source+"|$","g"),p="//# sourceURL="+(Ti.call(e,"sourceURL")?(e.sourceURL+"").replace(/\s/g," "):"lodash.templateSources["+ ++Ue+"]")+"\n";t.replace(v,function(e,n,o,r,s,l){return o||(o=r),f+=t.slice(c,l).replace(de,z),n&&(i=!0,f+="' +\n__e("+n+") +\n'"),s&&(a=!0,f+="';\n"+s+";\n__p += '"),o&&(f+="' +\n((__t = ("+o+")) == null ? '' : __t) +\n'"),c=l+e.length,e}),f+="';\n";var h=Ti.call(e,"variable")&&e.variable;if(h){if(te.test(h))throw new gi("Invalid `variable` option passed into `_.template`")}else f="with (obj) {\n"+f+"\n}\n";f=(a?f.replace(Mt,""):f).replace(Rt,"$1").replace($t,"$1;"),f="function("+(h||"obj")+") {\n"+(h?"":"obj || (obj = {});\n")+"var __t, __p = ''"+(i?", __e = _.escape":"")+(a?", __j = Array.prototype.join;\nfunction print() { __p += __j.call(arguments, '') }\n":";\n")+f+"return __p\n}";var m=cl(function(){return bi(l,p+"return "+f).apply(q,u)});if(m.source=f,Rr(m))throw m;return m},n.times=function(t,e){if((t=qr(t))<1||t>nt)return[];var n=rt,o=ia(t,rt);e=Bo(e),t-=rt;for(var r=I(o,e);++n<t;)e(n);return r},n.toFinite=Hr,n.toInteger=qr,n.toLength=Wr,n.toLower=function(t){return Zr(t).toLowerCase()},n.toNumber=Jr,n.toSafeInteger=function(t){return t?Se(qr(t),-9007199254740991,nt):0===t?t:0},n.toString=Zr,n.toUpper=function(t){return Zr(t).toUpperCase()},n.trim=function(t,e,n){if((t=Zr(t))&&(n||e===q))return T(t);if(!t||!(e=qn(e)))return t;var o=Y(t),r=Y(e);return oo(o,M(o,r),R(o,r)+1).join("")},n.trimEnd=function(t,e,n){if((t=Zr(t))&&(n||e===q))return t.slice(0,H(t)+1);if(!t||!(e=qn(e)))return t;var o=Y(t);return oo(o,0,R(o,Y(e))+1).join("")},n.trimStart=function(t,e,n){if((t=Zr(t))&&(n||e===q))return t.replace(Jt,"");if(!t||!(e=qn(e)))return t;var o=Y(t);return oo(o,M(o,Y(e))).join("")},n.truncate=function(t,e){var n=30,o="...";if(Nr(e)){var r="separator"in e?e.separator:r;n="length"in e?qr(e.length):n,o="omission"in e?qn(e.omission):o}var i=(t=Zr(t)).length;if(O(t)){var a=Y(t);i=a.length}if(n>=i)return t;var s=n-V(o);if(s<1)return o;var l=a?oo(a,0,s).join(""):t.slice(0,s);if(r===q)return l+o;if(a&&(s+=l.length-s),$s(r)){if(t.slice(s).search(r)){var u,c=l;for(r.global||(r=wi(r.source,Zr(oe.exec(r))+"g")),r.lastIndex=0;u=r.exec(c);)var d=u.index;l=l.slice(0,d===q?s:d)}}else if(t.indexOf(qn(r),s)!=s){var f=l.lastIndexOf(r);f>-1&&(l=l.slice(0,f))}return l+o},n.unescape=function(t){return(t=Zr(t))&&Nt.test(t)?t.replace(zt,cn):t},n.uniqueId=function(t){var e=++Pi;return Zr(t)+e},n.upperCase=ll,n.upperFirst=ul,n.each=xr,n.eachRight=Cr,n.first=hr,ui(n,(js={},qe(n,function(t,e){Ti.call(n.prototype,e)||(js[e]=t)}),js),{chain:!1}),n.VERSION="4.17.21",s(["bind","bindKey","curry","curryRight","partial","partialRight"],function(t){n[t].placeholder=n}),s(["drop","take"],function(t,e){k.prototype[t]=function(n){n=n===q?1:ra(qr(n),0);var o=this.__filtered__&&!e?new k(this):this.clone();return o.__filtered__?o.__takeCount__=ia(n,o.__takeCount__):o.__views__.push({size:ia(n,rt),type:t+(o.__dir__<0?"Right":"")}),o},k.prototype[t+"Right"]=function(e){return this.reverse()[t](e).reverse()}}),s(["filter","map","takeWhile"],function(t,e){var n=e+1,o=1==n||3==n;k.prototype[t]=function(t){var e=this.clone();return e.__iteratees__.push({iteratee:Bo(t,3),type:n}),e.__filtered__=e.__filtered__||o,e}}),s(["head","last"],function(t,e){var n="take"+(e?"Right":"");k.prototype[t]=function(){return this[n](1).value()[0]}}),s(["initial","tail"],function(t,e){var n="drop"+(e?"":"Right");k.prototype[t]=function(){return this.__filtered__?new k(this):this[n](1)}}),k.prototype.compact=function(){return this.filter(si)},k.prototype.find=function(t){return this.filter(t).head()},k.prototype.findLast=function(t){return this.reverse().find(t)},k.prototype.invokeMap=$n(function(t,e){return"function"==typeof t?new k(this):this.map(function(n){return hn(n,t,e)})}),k.prototype.reject=function(t){return this.filter(Pr(Bo(t)))},k.prototype.slice=function(t,e){t=qr(t);var n=this;return n.__filtered__&&(t>0||e<0)?new k(n):(t<0?n=n.takeRight(-t):t&&(n=n.drop(t)),e!==q&&(n=(e=qr(e))<0?n.dropRight(-e):n.take(e-t)),n)},k.prototype.takeRightWhile=function(t){return this.reverse().takeWhile(t).reverse()},k.prototype.toArray=function(){return this.take(rt)},qe(k.prototype,function(t,e){var o=/^(?:filter|find|map|reject)|While$/.test(e),i=/^(?:head|last)$/.test(e),a=n[i?"take"+("last"==e?"Right":""):e],s=i||/^find/.test(e);a&&(n.prototype[e]=function(){var e=this.__wrapped__,l=i?[1]:arguments,u=e instanceof k,c=l[0],d=u||Ps(e),f=function(t){var e=a.apply(n,p([t],l));return i&&v?e[0]:e};d&&o&&"function"==typeof c&&1!=c.length&&(u=d=!1);var v=this.__chain__,h=!!this.__actions__.length,m=s&&!v,g=u&&!h;if(!s&&d){e=g?e:new k(this);var b=t.apply(e,l);return b.__actions__.push({func:jr,args:[f],thisArg:q}),new r(b,v)}return m&&g?t.apply(this,l):(b=this.thru(f),m?i?b.value()[0]:b.value():b)})}),s(["pop","push","shift","sort","splice","unshift"],function(t){var e=Ci[t],o=/^(?:push|sort|unshift)$/.test(t)?"tap":"thru",r=/^(?:pop|shift)$/.test(t);n.prototype[t]=function(){var t=arguments;if(r&&!this.__chain__){var n=this.value();return e.apply(Ps(n)?n:[],t)}return this[o](function(n){return e.apply(Ps(n)?n:[],t)})}}),qe(k.prototype,function(t,e){var o=n[e];if(o){var r=o.name+"";Ti.call(ga,r)||(ga[r]=[]),ga[r].push({name:e,func:o})}}),ga[jo(q,2).name]=[{name:"wrapper",func:q}],k.prototype.clone=function(){var t=new k(this.__wrapped__);return t.__actions__=co(this.__actions__),t.__dir__=this.__dir__,t.__filtered__=this.__filtered__,t.__iteratees__=co(this.__iteratees__),t.__takeCount__=this.__takeCount__,t.__views__=co(this.__views__),t},k.prototype.reverse=function(){if(this.__filtered__){var t=new k(this);t.__dir__=-1,t.__filtered__=!0}else(t=this.clone()).__dir__*=-1;return t},k.prototype.value=function(){var t=this.__wrapped__.value(),e=this.__dir__,n=Ps(t),o=e<0,r=n?t.length:0,i=function(t,e,n){for(var o=-1,r=n.length;++o<r;){var i=n[o],a=i.size;switch(i.type){case"drop":t+=a;break;case"dropRight":e-=a;break;case"take":e=ia(e,t+a);break;case"takeRight":t=ra(t,e-a)}}return{start:t,end:e}}(0,r,this.__views__),a=i.start,s=i.end,l=s-a,u=o?s:a-1,c=this.__iteratees__,d=c.length,f=0,v=ia(l,this.__takeCount__);if(!n||!o&&r==l&&v==l)return Gn(t,this.__actions__);var p=[];t:for(;l--&&f<v;){for(var h=-1,m=t[u+=e];++h<d;){var g=c[h],b=g.iteratee,y=g.type,_=b(m);if(2==y)m=_;else if(!_){if(1==y)continue t;break t}}p[f++]=m}return p},n.prototype.at=ls,n.prototype.chain=function(){return wr(this)},n.prototype.commit=function(){return new r(this.value(),this.__chain__)},n.prototype.next=function(){this.__values__===q&&(this.__values__=Yr(this.value()));var t=this.__index__>=this.__values__.length;return{done:t,value:t?q:this.__values__[this.__index__++]}},n.prototype.plant=function(t){for(var e,n=this;n instanceof o;){var r=dr(n);r.__index__=0,r.__values__=q,e?i.__wrapped__=r:e=r;var i=r;n=n.__wrapped__}return i.__wrapped__=t,e},n.prototype.reverse=function(){var t=this.__wrapped__;if(t instanceof k){var e=t;return this.__actions__.length&&(e=new k(this)),(e=e.reverse()).__actions__.push({func:jr,args:[br],thisArg:q}),new r(e,this.__chain__)}return this.thru(br)},n.prototype.toJSON=n.prototype.valueOf=n.prototype.value=function(){return Gn(this.__wrapped__,this.__actions__)},n.prototype.first=n.prototype.head,Hi&&(n.prototype[Hi]=function(){return this}),n}();Je._=dn,(r=function(){return dn}.call(e,n,e,o))===q||(o.exports=r)}).call(this)}).call(this,n("yLpj"),n("YuTi")(t))},OKyS:function(t,e,n){var o=n("LboF"),r=n("8MIm");"string"==typeof(r=r.__esModule?r.default:r)&&(r=[[t.i,r,""]]),o(r,{insert:"head",singleton:!1}),t.exports=r.locals||{}},UBF9:function(t,e,n){"use strict";var o=n("8eWk");n.n(o).a},YuTi:function(t,e){t.exports=function(t){return t.webpackPolyfill||(t.deprecate=function(){},t.paths=[],t.children||(t.children=[]),Object.defineProperty(t,"loaded",{enumerable:!0,get:function(){return t.l}}),Object.defineProperty(t,"id",{enumerable:!0,get:function(){return t.i}}),t.webpackPolyfill=1),t}},qFNJ:function(t,e,n){(t.exports=n("I1BE")(void 0)).push([t.i,'.video-wrapper .show-time .video-js .vjs-time-control:not(.vjs-remaining-time){padding-left:.5em;padding-right:0;min-width:0;display:block}.video-wrapper .video-js .vjs-remaining-time{display:none}.video-wrapper .live .vjs-progress-control{visibility:hidden}.video-wrapper .av-loading .vjs-big-play-button,.video-wrapper .live .vjs-remaining-time,.video-wrapper .live .vjs-time-control{display:none}.video-wrapper .hide-big-play-button .vjs-big-play-button,.video-wrapper .hide-controls .vjs-control-bar,.video-wrapper .hide-fluent-button .live-player-fluent-btn,.video-wrapper .hide-fullscreen-button .vjs-fullscreen-control,.video-wrapper .hide-live-text .video-js .vjs-live-control .vjs-live-display,.video-wrapper .hide-pic-in-pic .video-js .vjs-picture-in-picture-control,.video-wrapper .hide-snapshot-button .vjs-snapshot-control,.video-wrapper .hide-stretch-button .live-player-stretch-btn,.video-wrapper .hide-waiting .vjs-seeking .vjs-loading-spinner,.video-wrapper .hide-waiting .vjs-waiting .vjs-loading-spinner,.video-wrapper .zoom-drawing .video-slot,.video-wrapper .zoom-drawing .video-title,.video-wrapper .zoom-drawing .vjs-control-bar{display:none!important}.video-wrapper .alt{position:absolute;left:0;top:0;right:0;bottom:0;vertical-align:middle;background-repeat:no-repeat;background-position:50% 50%;background-size:contain;background-color:#000;color:#fff;text-align:center}.video-wrapper .alt>table{width:100%;height:100%}.video-wrapper .alt>table tr>td{vertical-align:middle}.video-wrapper .video-js .video-water{pointer-events:none;left:0;top:0;right:0;bottom:0;position:absolute}.video-wrapper .video-js .video-slot{font-size:14px}.video-wrapper .video-js .video-title{font-size:14px;position:absolute;top:5px;right:5px;color:#fff;background-color:hsla(0,0%,50%,.5);border-radius:2px;padding:5px;max-width:120px;overflow:hidden;white-space:nowrap;text-overflow:ellipsis}.video-wrapper .video-js .vjs-control-bar .vjs-button{cursor:pointer;outline:none}.video-wrapper .video-js .vjs-control-bar .vjs-icon-cog:before,.video-wrapper .video-js .vjs-control-bar .vjs-icon-spinner:before{font-size:1.4em;line-height:1.87}.video-wrapper .video-js .vjs-control-bar .vjs-playback-rate .vjs-menu :focus:not(:focus-visible){outline:none;background:#fff}.video-wrapper .vjs-error-display{display:none}.video-wrapper .video-js .vjs-time-control{font-size:1.035em;line-height:2.93em}.video-wrapper .vjs-resolution-button{color:#ccc;font-family:VideoJS}.video-wrapper .vjs-resolution-button .vjs-resolution-button-staticlabel:before{content:"\\F110";font-size:1.8em;line-height:1.7em}.video-wrapper .vjs-resolution-button .vjs-resolution-button-label{width:100%;height:.6em;display:block!important}.video-wrapper .vjs-resolution-button .vjs-resolution-button-label-ie{width:100%;height:.4em;display:block!important}.video-wrapper .vjs-resolution-button ul.vjs-menu-content{width:4em!important}.video-wrapper .vjs-resolution-button .vjs-menu{left:0}.video-wrapper .vjs-resolution-button .vjs-menu li{text-transform:none;font-size:1em;line-height:1.5em;font-family:Arial,Helvetica,sans-serif}.video-wrapper .vjs-audio-button{display:none!important}.video-wrapper .video-js .vjs-big-play-button .vjs-icon-placeholder:before,.video-wrapper .vjs-button>.vjs-icon-placeholder:before{position:relative!important}.video-wrapper .video-js .vjs-play-progress:before{top:-.33em!important}.video-wrapper .video-js .vjs-control:focus,.video-wrapper .video-js .vjs-control:focus:before,.video-wrapper .video-js .vjs-control:hover:before{text-shadow:none!important}@media screen and (-webkit-min-device-pixel-ratio:0){.video-wrapper .video-js .vjs-control-bar{font-size:14px}.video-wrapper .video-js .vjs-slider-vertical .vjs-volume-level:before{left:-.33em!important}}.video-wrapper.video-wrapper-stretch .alt,.video-wrapper.video-wrapper-stretch .video-js .vjs-poster{background-size:100% 100%}',""])},qtAY:function(t,e,n){"use strict";n.r(e),n("OKyS");var o=n("LvDl"),r=n.n(o),i=n("Iab2"),a=n.n(i);videojs.options.controlBar={volumePanel:{inline:!1}},videojs.options.flash.swf="liveplayer.swf",videojs.options.techOrder=["html5","flash"],videojs.log.level("off"),flvjs.LoggingControl.enableAll=!1;var s={name:"live-player",data:function(){return{player:null,liveCheckTimer:0,hlsTimer:0,fluentBtnCls:"live-player-fluent-btn",stretchBtnCls:"live-player-stretch-btn",snapFromOutside:!1,snapForPoster:!1,snapForReload:!1,showSlot:!1,fluent_:!0,muted_:!0,stretch_:!1,poster_:"",subVideoUrl_:"",avLoading:!1,reloadCnt:0,updateTimeAt:0,startAt:0,playAt:0,pauseAt:0,pauseBgAt:0,endedAt:0,errorAt:0,flvMediaDataSource:{},flvConfig:{},resolutionName:{sd:"标清",hd:"高清",fhd:"超清",yh:"原画"},playbackRate_:1,fullscreen:!1,visible:!0,visibleChangeAt:0,speeds:[],zoomDrawing:!1}},props:{videoUrl:{type:String,default:""},subVideoUrl:{type:String,default:""},videoTitle:{type:String,default:""},poster:{default:""},autoplay:{type:Boolean,default:!0},live:{type:Boolean,default:!1},smart:{type:Boolean,default:!1},raw:{type:Boolean,default:!0},loading:{type:Boolean,default:!1},currentTime:{type:Number,default:0},timeout:{type:Number,default:20},alt:{type:String,default:""},muted:{type:Boolean,default:!0},aspect:{type:String,default:"16:9"},videoBufferSize:{type:Number,default:204800},audioBufferSize:{type:Number,default:131072},hlsErrorSeek:{type:Number,default:5},hlsTestMethod:{type:String,default:"HEAD"},hlsTestInterval:{type:Number,default:1e3},reloadInterval:{type:Number,default:1e3},liveInterval:{type:Number,default:15e3},maxPauseSeconds:{type:Number,default:0},pauseWhenHidden:{type:Boolean,default:!0},fluent:{type:Boolean,default:!0},stretch:{type:Boolean,default:!1},loop:{type:Boolean,default:!1},controls:{type:Boolean,default:!0},hideBigPlayButton:{type:Boolean,default:!1},hideSnapshotButton:{type:Boolean,default:!1},hideFullscreenButton:{type:Boolean,default:!1},hideFluentButton:{type:Boolean,default:!1},hideStretchButton:{type:Boolean,default:!1},hidePicInPic:{type:Boolean,default:!0},hideWaiting:{type:Boolean,default:!0},hideLiveText:{type:Boolean,default:!1},showTime:{type:Boolean,default:function(){return!videojs.browser.IS_IOS&&!videojs.browser.IS_ANDROID}},showSpeed:{type:Boolean,default:!1},showCustomButton:{type:Boolean,default:!0},showCameraButton:{type:Boolean,default:!videojs.browser.IS_SAFARI&&!videojs.browser.IS_IOS&&!videojs.browser.IS_ANDROID},debug:{type:Boolean,default:!1},hasvideo:{type:Boolean,default:void 0},hasaudio:{type:Boolean,default:void 0},resolution:{type:String,default:""},resolutiondefault:{type:String,default:"hd"},playbackRates:{type:Array,default:function(){return videojs.browser.IS_IOS||videojs.browser.IS_ANDROID?[]:[.5,1,2,3]}},playbackRate:{type:Number,default:1},cors:{type:Boolean,default:!0},withCredentials:{type:Boolean,default:!1},customButtons:{type:String,default:""},autofocus:{type:Boolean,default:!1},dblclickFullscreen:{type:Boolean,default:!0},language:{type:String,default:navigator&&(navigator.languages&&navigator.languages[0]||navigator.userLanguage||navigator.language)||"en"},disableContextMenu:{type:Boolean,default:!1},water:{type:String,default:""},waterMark:{type:String,default:""},watermark:{type:String,default:""},wm:{type:String,default:""},waterFont:{type:String,default:""},waterColor:{type:String,default:"rgba(240, 240, 240, 0.25)"},waterWidth:{type:Number,default:256},waterHeight:{type:Number,default:144},waterRotate:{type:Number,default:-20*Math.PI/120},rotate:{type:Number,default:0},rotateX:{type:Number,default:0},rotateY:{type:Number,default:0},bgTimeout:{type:Number,default:0},digitalZoom:{type:Boolean,default:!1},digitalZoomColor:{type:String,default:"rgba(235, 235, 235, 0.8)"}},beforeDestroy:function(){this.destroyVideoJS()},deactivated:function(){this.destroyVideoJS()},watch:{videoUrl:function(t){this.videoUrl?this.poster_=this.poster:this.poster_="",this.smart&&!this.subVideoUrl&&this.videoUrl&&(0!=this.videoUrl.indexOf("webrtc")||/_sub(\?|$)/.test(this.videoUrl)?/.flv(\?|$)/.test(this.videoUrl)&&!/_sub.flv(\?|$)/.test(this.videoUrl)?this.subVideoUrl_=this.videoUrl.replace(/(.flv(\?|$))/,"_sub$1"):/\/live.m3u8(\?|$)/.test(this.videoUrl)&&!/_sub\/live.m3u8(\?|$)/.test(this.videoUrl)&&(this.subVideoUrl_=this.videoUrl.replace(/(\/live.m3u8(\?|$))/,"_sub$1")):this.subVideoUrl_=this.videoUrl.replace(/(\?|$)/,"_sub$1")),this.videoUrl||this.subVideoUrl||(this.subVideoUrl_=""),this.reload()},subVideoUrl:function(t){this.subVideoUrl_=this.subVideoUrl,this.smart&&!this.subVideoUrl_&&this.videoUrl&&(0!=this.videoUrl.indexOf("webrtc")||/_sub(\?|$)/.test(this.videoUrl)?/.flv(\?|$)/.test(this.videoUrl)&&!/_sub.flv(\?|$)/.test(this.videoUrl)?this.subVideoUrl_=this.videoUrl.replace(/(.flv(\?|$))/,"_sub$1"):/\/live.m3u8(\?|$)/.test(this.videoUrl)&&!/_sub\/live.m3u8(\?|$)/.test(this.videoUrl)&&(this.subVideoUrl_=this.videoUrl.replace(/(\/live.m3u8(\?|$))/,"_sub$1")):this.subVideoUrl_=this.videoUrl.replace(/(\?|$)/,"_sub$1")),this.videoUrl||this.subVideoUrl||(this.subVideoUrl_=""),this.reload()},fullscreen:function(t){this.videoUrl&&this.subVideoUrl_&&this.videoUrl!=this.subVideoUrl_&&(this.webrtc&&0==this.videoUrl.indexOf("webrtc")&&0==this.subVideoUrl_.indexOf("webrtc")?this.rtcReloadTech():(this.http||this.ws)&&this.flv&&/\.flv.*$/.test(this.videoUrl)&&/\.flv.*$/.test(this.subVideoUrl_)&&this.flvReloadTech())},fluent:function(t){this.fluent_=t,this.reload()},muted:function(t){this.muted_=t,this.reload()},stretch:function(t){this.stretch_=t,this.reload()},poster:function(t){this.poster_=t},loop:function(t){this.reload()},currentTime:function(t){this.player&&this.player.currentTime(t)},language:function(t){this.reload()}},mounted:function(){this.muted_=this.muted,this.fluent_=this.fluent,this.stretch_=this.stretch,this.poster_=this.poster,this.playbackRate_=this.playbackRate,this.subVideoUrl_=this.subVideoUrl,this.smart&&!this.subVideoUrl_&&this.videoUrl&&(0!=this.videoUrl.indexOf("webrtc")||/_sub(\?|$)/.test(this.videoUrl)?/.flv(\?|$)/.test(this.videoUrl)&&!/_sub.flv(\?|$)/.test(this.videoUrl)?this.subVideoUrl_=this.videoUrl.replace(/(.flv(\?|$))/,"_sub$1"):/\/live.m3u8(\?|$)/.test(this.videoUrl)&&!/_sub\/live.m3u8(\?|$)/.test(this.videoUrl)&&(this.subVideoUrl_=this.videoUrl.replace(/(\/live.m3u8(\?|$))/,"_sub$1")):this.subVideoUrl_=this.videoUrl.replace(/(\?|$)/,"_sub$1")),this.debug&&(videojs.log.level("info"),flvjs.LoggingControl.enableAll=!0),this.initVideoJS()},computed:{videoType:function(){var t="video/mp4";return this.rtmp?t="rtmp/mp4":(this.http||this.ws)&&this.flv?t="video/x-flv":this.m3u8&&(t="application/x-mpegURL"),t},videoWrapperStyle:function(){if("100%"==this.aspect||"fullscreen"==this.aspect)return{width:"100%",height:"100%"};var t=this.aspect.split(":"),e=parseInt(t[0])||16;return{paddingBottom:(parseInt(t[1])||9)/e*100+"%",position:"relative",margin:"0 auto",overflow:"hidden"}},waterStyle:function(){return this.waterText?{background:"url("+this.createBase64()+") left top repeat"}:{}},waterText:function(){return this.water||this.waterMark||this.watermark||this.wm},rtmp:function(){return 0===(this.src||"").indexOf("rtmp")},http:function(){return 0===(this.src||"").indexOf("http")||(this.src||"").indexOf("://")<0},webrtc:function(){return 0===(this.src||"").indexOf("webrtc")},ws:function(){return 0===(this.src||"").indexOf("ws")},mp4:function(){return/\.mp4.*$/.test(this.src||"")},m3u8:function(){return/\.m3u8.*$/.test(this.src||"")},flv:function(){return/\.flv.*$/.test(this.src||"")},alt2:function(){return this.alt?this.alt:"zh-CN"===this.language?"无信号":"No Video"},src:function(){var t=this.subVideoUrl_||this.videoUrl;if(this.fullscreen&&(t=this.videoUrl||this.subVideoUrl_),!t)return"";if(0===t.indexOf("//"))t=location.protocol+t;else if(0===t.indexOf("/"))t=location.protocol+"//"+location.host+t;else if(0===t.indexOf("./")){var e=location.pathname.lastIndexOf("/");t=location.protocol+"//"+location.host+location.pathname.slice(0,e+1)+t.slice(2)}if(this.raw&&flvjs.getFeatureList()&&flvjs.getFeatureList().nativeMP4H265Playback&&(/\.flv.*$/.test(t)||/\.m3u8.*$/.test(t))){var n=t.indexOf("?"),o="";n<0?o="?":t.indexOf("raw=")<n&&(o="&"),o&&(t=t+o+"raw=1")}return t},blankHtml:function(){return this.poster_&&this.src?'\n                    <div class="alt" style="background-image:url(\''+this.poster_+"');\">\n                    </div>\n                ":'\n                    <div class="alt">\n                        <table>\n                            <tr>\n                                <td>'+this.alt2+"</td>\n                            </tr>\n                        </table>\n                    </div>\n                "},videoHtml:function(){return this.src?this.rtmp||this.http||this.flv||this.mp4||this.webrtc?'\n                        <video class="video-js vjs-default-skin vjs-big-play-centered" style="width: 100%; height: 100%; '+(this.stretch_?"object-fit: fill;":"")+'"\n                            webkit-playsinline="" playsinline="" x-webkit-airplay="allow" x5-playsinline=""\n                            preload="none" poster="'+this.poster_+'" '+(this.muted_?"muted":"")+" "+(this.loop?"loop":"")+'>\n                            <source src="'+this.src+'" type="'+this.videoType+'"></source>\n                            <p class="vjs-no-js">\n                                To view this video please enable JavaScript, and consider upgrading to a web browser that\n                                <a href="http://videojs.com/html5-video-support/" target="_blank">\n                                    supports HTML5 video\n                                </a>\n                            </p>\n                        </video>\n                    ':'\n                        <canvas style="width: 100%; height: 100%;"></canvas>\n                    ':this.blankHtml}},methods:{isMobile:function(){return videojs.browser.IS_IOS||videojs.browser.IS_ANDROID},hasUsableSWF:function(){return!!(void 0!==window.ActiveXObject?new ActiveXObject("ShockwaveFlash.ShockwaveFlash"):navigator.plugins["Shockwave Flash"])},reload:function(){this.reloadCnt++,this.debug&&console.log((new Date).toLocaleString()+" "+this.src+" reload["+this.reloadCnt+"]"),this.destroyVideoJS(),this.initVideoJS()},flvError:function(t){var e=this;this.debug&&console.log((new Date).toLocaleString()+" "+this.src+" error",t),this.$emit("error",t),this.live&&this.src&&this.reloadInterval>0&&(this.reloadCnt?setTimeout(function(){e.flvReload()},this.reloadInterval):this.flvReload())},flvReload:function(){this.player&&(this.avLoading=!0,!this.endedAt&&!this.player.isFullscreen()&&this.player.cameraButton&&this.visible?this.snapForReload||(this.debug&&console.log((new Date).toLocaleString()+" "+this.src+" snap reload"),this.snapForReload=!0,this.pauseAt=0,this.player.cameraButton.el().click()):this.flvReloadTech())},flvReloadTech:function(){var t=this;if(this.debug&&console.log((new Date).toLocaleString()+" "+this.src+" tech reload"),this.reloadCnt++,this.pauseAt=0,this.player.tech_.flvPlayer.unload(),this.player.tech_.flvPlayer.detachMediaElement(),this.player.tech_.flvPlayer.destroy(),this.player.tech_.flvPlayer=window.flvjs.createPlayer(Object.assign({},this.flvMediaDataSource,{type:void 0===this.flvMediaDataSource.type?"flv":this.flvMediaDataSource.type,url:this.getVideoUrl()}),this.flvConfig),this.player.tech_.flvPlayer.attachMediaElement(this.player.tech_.el_),this.player.tech_.flvPlayer.on("error",function(e){t.flvError(e)}),this.player.tech_.flvPlayer.on("media_info",function(e){t.$emit("media_info",e)}),this.showSpeed){var e=null;this.player.tech_.flvPlayer.on("statistics_info",function(n){if(n&&n.speed)if(t.speeds.length<3){if(t.speeds.push(n.speed),!e){var o=t.$el.querySelectorAll(".video-js .vjs-control-bar .vjs-live-display");o.length>0&&(e=o[0])}}else if(e){t.speeds.shift(),t.speeds.push(n.speed);for(var r=0,i=0;i<t.speeds.length;i++)r+=t.speeds[i]||0;var a=r/t.speeds.length;a=8*a.toFixed(0),e.innerHTML=a+"Kbps"}})}this.player.tech_.flvPlayer.load()},rtcError:function(t){var e=this;this.live&&this.src&&this.reloadInterval>0&&setTimeout(function(){e.rtcReload()},this.reloadInterval)},rtcReload:function(){this.player&&(this.avLoading=!0,!this.endedAt&&!this.player.isFullscreen()&&this.player.cameraButton&&this.visible?this.snapForReload||(this.debug&&console.log((new Date).toLocaleString()+" "+this.src+" snap reload"),this.snapForReload=!0,this.pauseAt=0,this.player.cameraButton.el().click()):this.rtcReloadTech())},rtcReloadTech:function(){this.debug&&console.log((new Date).toLocaleString()+" "+this.src+" tech reload"),this.reloadCnt++,this.pauseAt=0,this.player.tech_.src=this.getVideoUrl(),this.player.tech_.reload()},loadResolutionlist:function(t){var e=this;if(""!=this.resolution){for(var n,o=this.resolution.split(","),r=[],i=0;i<o.length;i++)o[i],this.resolutiondefault,n="yh"==o[i]?this.videoUrl:this.videoUrl.replace(".m3u8","_"+o[i]+".m3u8"),r.push({src:n,type:this.videoType,label:this.resolutionName[o[i]],res:o[i]});t.updateSrc(r),t.on("resolutionchange",function(){e.debug&&console.log("change resolution")})}},getCustomBtn:function(t){var e=this.$el.querySelectorAll(".video-js .vjs-control-bar .vjs-button."+t);return e.length>0?e[0]:null},customFlashButton:function(){var t=this;if(!this.getCustomBtn(this.fluentBtnCls)){for(var e=(r=this.player.controlBar.children().length)-1;e>=0;e--)if("FullscreenToggle"==this.player.controlBar.children()[e].name_)r=e;else if("SnapButton"==this.player.controlBar.children()[e].name_){r=e;break}var n=this.player.controlBar.addChild("button",{},r);n.name_="FluentButton",n.el_.classList.add(this.fluentBtnCls),n.el_.innerText=this.fluent_?this.player.localize("Fluent"):this.player.localize("Fast"),n.el_.title=this.fluent_?this.player.localize("Click switch to fast play"):this.player.localize("Click switch to fluent play");var o=function(){t.fluent_=!t.fluent_,n.el_.innerText=t.fluent_?t.player.localize("Fluent"):t.player.localize("Fast"),n.el_.title=t.fluent_?t.player.localize("Click switch to fast play"):t.player.localize("Click switch to fluent play"),t.player.tech_.setFluent(t.fluent_)};n.on("click",o),n.on("tap",o)}if(!this.getCustomBtn(this.stretchBtnCls)){var r;for(e=(r=this.player.controlBar.children().length)-1;e>=0;e--)if("FullscreenToggle"==this.player.controlBar.children()[e].name_)r=e;else if("SnapButton"==this.player.controlBar.children()[e].name_)r=e;else if("FluentButton"==this.player.controlBar.children()[e].name_){r=e;break}var i=this.player.controlBar.addChild("button",{},r);i.name_="StretchButton",i.el_.classList.add(this.stretchBtnCls),i.el_.innerText=this.stretch_?this.player.localize("STR"):this.player.localize("STD"),i.el_.title=this.stretch_?this.player.localize("Click switch to standard display"):this.player.localize("Click switch to stretch display"),o=function(){t.stretch_=!t.stretch_,i.el_.innerText=t.stretch_?t.player.localize("STR"):t.player.localize("STD"),i.el_.title=t.stretch_?t.player.localize("Click switch to standard display"):t.player.localize("Click switch to stretch display"),t.player.tech_.setStretch(t.stretch_)},i.on("click",o),i.on("tap",o)}},customH5Button:function(){var t=this;if(!this.getCustomBtn(this.stretchBtnCls)&&!videojs.browser.IE_VERSION){for(var e=this.player.controlBar.children().length,n=e-1;n>=0;n--)if("FullscreenToggle"==this.player.controlBar.children()[n].name_)e=n;else if("SnapButton"==this.player.controlBar.children()[n].name_){e=n;break}var o=this.player.controlBar.addChild("button",{},e);o.name_="StretchButton",o.el_.classList.add(this.stretchBtnCls),o.el_.innerText=this.stretch_?this.player.localize("STR"):this.player.localize("STD"),o.el_.title=this.stretch_?this.player.localize("Click switch to standard display"):this.player.localize("Click switch to stretch display");var r=function(){t.stretch_=!t.stretch_,o.el_.innerText=t.stretch_?t.player.localize("STR"):t.player.localize("STD"),o.el_.title=t.stretch_?t.player.localize("Click switch to standard display"):t.player.localize("Click switch to stretch display");var e=t.$el.querySelector("video");e?e.style.objectFit=t.stretch_?"fill":"":t.player.tech_.setStretch(t.stretch_)};o.on("click",r),o.on("tap",r)}this.addCustomButtons()},addCustomButtons:function(){var t=this;if(""!=this.customButtons){for(var e=this.player.controlBar.children().length,n=e-1;n>=0;n--)if("FullscreenToggle"==this.player.controlBar.children()[n].name_)e=n;else if("SnapButton"==this.player.controlBar.children()[n].name_)e=n;else if("StretchButton"==this.player.controlBar.children()[n].name_){e=n;break}var o=this.customButtons.split(",");for(n=o.length-1;n>=0;n--){var r=o[n].split(":"),i=this.player.controlBar.addChild("button",{},e);i.el_.innerHTML=r.length>1?"<i class='"+r[1]+"'></i>":o[n],i.el_.title=r[0],i.name_=r[0];var a=function(e){t.$emit("customButtons",(e.currentTarget||e.target).getAttribute("title"))};i.on("click",a),i.on("tap",a)}}},hotkeysOpt:function(){var t={volumeStep:.1,seekStep:5,enableModifiersForNumbers:!1,enableVolumeScroll:!1};return this.autofocus||(t.skipInitialFocus=!0,t.enableInactiveFocus=!1),t},destroyVideoJS:function(){this.liveCheckTimer&&(clearInterval(this.liveCheckTimer),this.liveCheckTimer=0),this.hlsTimer&&(clearTimeout(this.hlsTimer),this.hlsTimer=0),document.removeEventListener("visibilitychange",this.onVisibleChange),this.updateTimeAt=0,this.startAt=0,this.playAt=0,this.pauseAt=0,this.pauseBgAt=0,this.endedAt=0,this.errorAt=0,this.visibleChangeAt=0,this.showSlot=!1;var t=this.$el.querySelector(".video-js"),e=this.$el.querySelector(".video-wrapper");if(t&&e){var n=t.querySelector(".video-water");n&&(t.removeChild(n),e.appendChild(n));var o=t.querySelector(".video-slot");o&&(t.removeChild(o),e.appendChild(o));var r=t.querySelector(".video-title");r&&(t.removeChild(r),e.appendChild(r))}this.player&&this.player.dispose&&(this.player.dispose(),this.player=null),this.player&&this.player.destroy&&(this.player.destroy(),this.player=null),this.flvMediaDataSource={},this.flvConfig={},this.$el.querySelector(".video-inner").innerHTML=this.blankHtml,this.$emit("update:loading",!1)},getCurrentTime:function(){return this.player&&"function"==typeof this.player.currentTime?this.player.currentTime():-1},setCurrentTime:function(t){this.player&&"function"==typeof this.player.currentTime&&this.player.currentTime(t)},snap:function(){this.player&&this.player.cameraButton?(this.snapFromOutside=!0,this.player.cameraButton.el().click()):console.log("snap not support")},play:function(){this.player&&this.player.play()},pause:function(){this.player&&this.player.pause()},paused:function(){return!!this.player&&this.player.paused()},setMuted:function(t){this.player&&this.player.muted(!!t)},getMuted:function(){return!!this.player&&this.player.muted()},setVolume:function(t){this.player&&this.player.volume(t)},getVolume:function(){return this.player?this.player.volume():0},setDuration:function(t){this.player&&this.player.duration(t)},getDuration:function(){return this.player?this.player.duration():0},getPlayer:function(){return this.player},getMediaInfo:function(){try{return this.player.tech_.flvPlayer._transmuxer._controller._demuxer._mediaInfo}catch(t){}return{}},isFullscreen:function(){return!!this.player&&this.player.isFullscreen()},getVideoUrl:function(){var t=this.subVideoUrl_||this.videoUrl;if(this.isFullscreen()&&(t=this.videoUrl||this.subVideoUrl_),!t)return"";if(0===t.indexOf("//"))t=location.protocol+t;else if(0===t.indexOf("/"))t=location.protocol+"//"+location.host+t;else if(0===t.indexOf("./")){var e=location.pathname.lastIndexOf("/");t=location.protocol+"//"+location.host+location.pathname.slice(0,e+1)+t.slice(2)}if(this.raw&&flvjs.getFeatureList()&&flvjs.getFeatureList().nativeMP4H265Playback&&(/\.flv.*$/.test(t)||/\.m3u8.*$/.test(t))&&this.playAt){var n=t.indexOf("?"),o="";n<0?o="?":t.indexOf("raw=")<n&&(o="&"),o&&(t=t+o+"raw=1")}return t},requestFullscreen:function(){this.player&&this.player.requestFullscreen()},exitFullscreen:function(){this.player&&this.player.exitFullscreen()},toggleFullscreen:function(){this.player&&(this.player.isFullscreen()?this.player.exitFullscreen():this.player.requestFullscreen())},convertDataURIToBinary:function(t){for(var e=";base64,",n=t.indexOf(e)+e.length,o=t.substring(n),r=window.atob(o),i=r.length,a=new Uint8Array(i),s=0;s<i;s++)a[s]=r.charCodeAt(s);return a},convertDataURIToMIME:function(){var t=arguments.length>0&&void 0!==arguments[0]?arguments[0]:"",e="data:",n=t.indexOf(e);0==n&&(n+=e.length);var o=t.indexOf(";base64,");return n>=0&&o>n?t.substring(n,o):""},saveFile:function(t){if(t&&t.length>100){this.poster_=t;var e=this.$el.querySelector("video");e&&e.setAttribute("poster",t)}if(this.snapForReload)return this.snapForReload=!1,void this.reload();if(this.snapForPoster)this.snapForPoster=!1;else{if(this.snapFromOutside)return this.$emit("snapOutside",t),void(this.snapFromOutside=!1);if(this.$emit("snapInside",t),t&&t.length>100){var n=this.convertDataURIToBinary(t),o=this.convertDataURIToMIME(t),r=".jpg",i=o.indexOf("/");i>0&&(r="."+o.substring(i+1)),a.a.saveAs(new Blob([n],{type:o}),(this.videoTitle||"snap")+"_"+(new Date).getTime()+r)}}},onVisibleChange:function(){this.visible="visible"===document.visibilityState,this.debug&&console.log((new Date).toLocaleString()+" "+this.src+" visible["+this.visible+"]");var t=this.visibleChangeAt;if(this.visibleChangeAt=(new Date).getTime(),this.visible&&this.live&&this.videoUrl){var e;if(this.bgTimeout>0&&t&&(e=(new Date).getTime()-t)>=this.bgTimeout&&this.src&&(!this.player||!this.player.isFullscreen()))return this.debug&&console.log((new Date).toLocaleString()+" "+this.src+" visible bg timeout reload d = "+e),void this.reload();if(this.liveInterval>0&&this.pauseBgAt&&(e=(new Date).getTime()-this.pauseBgAt)>=this.liveInterval&&this.src&&(!this.player||!this.player.isFullscreen()))return this.debug&&console.log((new Date).toLocaleString()+" "+this.src+" visible bg pause reload d = "+e),void this.reload()}},debounceInitVideoJS:r.a.debounce(function(){this.initVideoJS()},500),initVideoJSOK:function(){var t=this;this.player&&(document.addEventListener("visibilitychange",this.onVisibleChange),this.player.muted(this.muted_),this.player.on("canplay",function(){t.$emit("canplay",t.player.duration())}),this.player.on("pause",function(){t.updateTimeAt=0,t.pauseAt=(new Date).getTime(),t.visible||(t.pauseBgAt=t.pauseAt),t.$emit("pause",t.player.currentTime()),t.debug&&console.log((new Date).toLocaleString()+" "+t.src+" pause")}),this.player.on("snap",function(e,n){t.saveFile(n)}),this.player.on("volumechange",function(e,n){t.muted_=t.player.muted(),t.$emit("volumechange",t.player.volume(),t.muted_)}),this.player.on("fullscreenchange",function(){setTimeout(function(){t.fullscreen=t.isFullscreen(),t.$emit("fullscreen",t.fullscreen)},0)}),(this.waterText||this.disableContextMenu)&&(this.player.tech_.el_.oncontextmenu=function(t){t.preventDefault()}),this.rotate&&(this.player.tech_.el_.style.transform="rotate("+this.rotate+"deg)"),this.rotateX&&(this.player.tech_.el_.style.transform="rotateX("+this.rotateX+"deg)"),this.rotateY&&(this.player.tech_.el_.style.transform="rotateY("+this.rotateY+"deg)"),this.startAt=(new Date).getTime(),this.initDigitalZoom());var e=this.$el.querySelector(".video-js"),n=this.$el.querySelector(".video-wrapper");if(e&&n){var o=n.querySelector(".video-water");o&&(n.removeChild(o),e.appendChild(o));var r=n.querySelector(".video-slot");r&&(n.removeChild(r),e.appendChild(r));var i=n.querySelector(".video-title");i&&(n.removeChild(i),e.appendChild(i))}this.showSlot=!0},initVideoJS:function(){var t=this,e=arguments.length>0&&void 0!==arguments[0]?arguments[0]:0;if(this.$el.querySelector(".video-inner").innerHTML=this.videoHtml,this.src){if(this.startAt=(new Date).getTime(),this.rtmp){var n=document.title;this.player=videojs(this.$el.querySelector("video"),{notSupportedMessage:"您的浏览器没有安装或开启Flash",tech:["flash"],autoplay:this.autoplay,stretch:this.stretch,fluent:this.fluent,controls:!0,userActions:{doubleClick:this.dblclickFullscreen},language:this.language||navigator&&(navigator.languages&&navigator.languages[0]||navigator.userLanguage||navigator.language)||"en"}),this.showCameraButton&&!this.waterText&&this.player.snapshot(),this.player.one("ready",function(){if(t.initVideoJSOK(),videojs.browser.IE_VERSION&&t.player.tech_.off("fullscreentoggle"),t.$emit("update:loading",!1),document.title=n,t.player.hotkeys(t.hotkeysOpt()),t.player.on("ended",function(){t.endedAt=(new Date).getTime(),t.$emit("ended"),t.debug&&console.log((new Date).toLocaleString()+" "+t.src+" ended")}),t.player.on("error",function(e){t.errorAt=(new Date).getTime(),t.$emit("error",e)}),t.player.on("timeupdate",function(){t.updateTimeAt=(new Date).getTime(),t.pauseAt=0,t.endedAt=0,t.errorAt=0,t.reloadCnt=0,t.$emit("timeupdate",t.player.currentTime())}),t.player.on("playing",function(){t.playAt=(new Date).getTime(),t.$emit("play",t.player.currentTime()),t.debug&&console.log((new Date).toLocaleString()+" "+t.src+" playing")}),t.showCustomButton&&t.customFlashButton(),!t.hasUsableSWF()){var e=t.$el.querySelector(".vjs-poster");e&&(e.style.display="none")}document.getElementsByClassName("vjs-picture-in-picture-control")[0].style.display="none"}),document.title=n,this.player.on("error",function(e){(e=t.$el.querySelector(".vjs-error .vjs-error-display .vjs-modal-dialog-content")).innerHTML="<a href='http://www.adobe.com/go/getflashplayer' target='_blank'>"+e.textContent+"</a>"})}else if((this.http||this.ws)&&this.flv)this.flvMediaDataSource={isLive:this.live,cors:this.cors,withCredentials:this.withCredentials},this.flvConfig={enableWorker:!1,enableStashBuffer:!1},videojs.browser.IE_VERSION&&(this.flvConfig.enableStashBuffer=!0,this.flvConfig.stashInitialSize=512e3),null!=this.hasaudio&&(this.flvMediaDataSource.hasAudio=this.hasaudio),null!=this.hasvideo&&(this.flvMediaDataSource.hasVideo=this.hasvideo),this.player=videojs(this.$el.querySelector("video"),{techOrder:["flvjs","html5"],controls:!0,flvjs:{mediaDataSource:this.flvMediaDataSource,config:this.flvConfig},userActions:{doubleClick:this.dblclickFullscreen},language:this.language||navigator&&(navigator.languages&&navigator.languages[0]||navigator.userLanguage||navigator.language)||"en"}),this.showCameraButton&&!this.waterText&&this.player.snapshot(),this.player.one("ready",function(){if(t.initVideoJSOK(),t.$emit("update:loading",!1),t.stretch_&&(t.player.tech_.el_.style.objectFit="fill"),t.player.hotkeys(t.hotkeysOpt()),t.player.tech_.flvPlayer.on("error",function(e){t.errorAt=(new Date).getTime(),t.flvError(e)}),t.player.tech_.flvPlayer.on("media_info",function(e){t.$emit("media_info",e)}),t.showSpeed){var e=null;t.player.tech_.flvPlayer.on("statistics_info",function(n){if(n&&n.speed)if(t.speeds.length<3){if(t.speeds.push(n.speed),!e){var o=t.$el.querySelectorAll(".video-js .vjs-control-bar .vjs-live-display");o.length>0&&(e=o[0])}}else if(e){t.speeds.shift(),t.speeds.push(n.speed);for(var r=0,i=0;i<t.speeds.length;i++)r+=t.speeds[i]||0;var a=r/t.speeds.length;a=8*a.toFixed(0),e.innerHTML=a+"Kbps"}})}t.player.on("canplay",function(){t.autoplay&&t.player.tech_.flvPlayer.play().catch(function(){})}),t.player.on("timeupdate",function(){t.updateTimeAt=(new Date).getTime(),t.pauseAt=0,t.endedAt=0,t.errorAt=0;var e=t.player.currentTime();!t.snapForPoster&&!t.poster_&&e>2&&t.player.cameraButton&&(t.snapForPoster=!0,t.player.cameraButton.el().click()),t.reloadCnt=0,t.$emit("timeupdate",e)}),t.player.on("error",function(e){t.errorAt=(new Date).getTime(),t.player.error_&&4===t.player.error_.code?t.flvError("MediaError"):t.flvError(t.player.error_||e)}),t.player.on("ended",function(){t.endedAt=(new Date).getTime(),t.live&&t.flvError("ended"),t.$emit("ended"),t.debug&&console.log((new Date).toLocaleString()+" "+t.src+" ended")}),t.player.on("playing",function(){t.playAt=(new Date).getTime(),t.avLoading=!1,t.$emit("play",t.player.currentTime()),t.debug&&console.log((new Date).toLocaleString()+" "+t.src+" playing")}),t.showCustomButton&&t.customH5Button()});else if(this.http&&this.m3u8){var o=(new Date).getTime(),r=function(){if(!t.player){var n=document.title;""!=t.resolution?(-1==t.resolution.indexOf(t.resolutiondefault)&&(t.resolutiondefault="yh"),t.player=videojs(t.$el.querySelector("video"),{autoplay:t.autoplay,stretch:t.stretch,controls:!0,plugins:{videoJsResolutionSwitcher:{default:t.resolutiondefault,dynamicLabel:!0}},playbackRates:t.live?[]:t.playbackRates,userActions:{doubleClick:t.dblclickFullscreen},language:t.language||navigator&&(navigator.languages&&navigator.languages[0]||navigator.userLanguage||navigator.language)||"en"}),t.loadResolutionlist(t.player)):t.player=videojs(t.$el.querySelector("video"),{autoplay:t.autoplay,stretch:t.stretch,controls:!0,playbackRates:t.live?[]:t.playbackRates,userActions:{doubleClick:t.dblclickFullscreen},language:t.language||navigator&&(navigator.languages&&navigator.languages[0]||navigator.userLanguage||navigator.language)||"en"}),t.live||1==t.playbackRate_||t.player.playbackRate(t.playbackRate_),t.player.on("ratechange",function(){t.playbackRate_=t.player.playbackRate()}),t.showCameraButton&&!t.waterText&&t.player.snapshot(),t.player.one("ready",function(){if(t.initVideoJSOK(),t.avLoading=!1,t.$emit("update:loading",!1),document.title=n,t.player.hotkeys(t.hotkeysOpt()),t.player.on("ended",function(){t.endedAt=(new Date).getTime(),t.$emit("ended"),t.debug&&console.log((new Date).toLocaleString()+" "+t.src+" ended")}),t.player.on("timeupdate",function(){t.updateTimeAt=(new Date).getTime(),t.pauseAt=0,t.endedAt=0,t.errorAt=0,t.reloadCnt=0,t.$emit("timeupdate",t.player.currentTime())}),t.player.on("play",function(){t.player.removeClass("vjs-has-started"),t.debug&&console.log((new Date).toLocaleString()+" "+t.src+" play")}),t.player.on("playing",function(){t.player.addClass("vjs-has-started"),t.playAt=(new Date).getTime(),t.$emit("play",t.player.currentTime()),t.debug&&console.log((new Date).toLocaleString()+" "+t.src+" playing")}),t.player.tech().on("usage",function(e){t.debug&&console.log((new Date).toLocaleString()+" "+t.src+" hls usage",e),e&&"hls-live-resync"===e.name&&t.$emit("error",e.name)}),t.player.one("unknow-segment-type",function(e){t.debug&&(console.log((new Date).toLocaleString()+" "+t.src+" hls unknow-segment-type, reload"),e&&e.name&&console.log("unknow-segment-type: "+e.name)),t.reloadInterval>0&&setTimeout(function(){t.destroyVideoJS(),t.initVideoJS()},t.reloadInterval/2)}),t.player.one("error",function(e,n){t.errorAt=(new Date).getTime();var o=t.player.tech();if(o&&"livehls"==o.name_){var r=o.error();r&&3===r.code&&(e="MediaError")}if(t.$emit("error",e),t.live)t.reloadInterval>0&&setTimeout(function(){t.destroyVideoJS(),t.initVideoJS()},t.reloadInterval);else if(t.hlsErrorSeek>0){var i=t.player.currentTime()+t.hlsErrorSeek;t.destroyVideoJS(),t.initVideoJS(i)}}),document.title=n,t.live)t.player&&t.player.reloadSourceOnError();else if(t.player&&(t.currentTime>0||e>0)){var o=e||t.currentTime;videojs.browser.IE_VERSION?t.player.on("loadeddata",function(){setTimeout(function(){t.player.currentTime(o)},500)}):t.isMobile()?setTimeout(function(){var e=t.$el.querySelector("video");e&&(e.currentTime=o)},3200):t.player.currentTime(o)}t.autoplay&&setTimeout(function(){var e=t.$el.querySelector(".vjs-big-play-button");if(e){var n=window.getComputedStyle(e);n&&"block"===n.display&&e.click()}},2e3),t.showCustomButton&&t.customH5Button()})}};this.live&&this.hlsTestMethod&&this.hlsTestInterval>0?function e(){var n=(new Date).getTime();if(t.timeout>0&&n-o>1e3*t.timeout)return t.hlsTimer&&clearTimeout(t.hlsTimer),t.hlsTimer=0,t.$emit("update:loading",!1),t.avLoading=!1,void t.$emit("message",{type:"error",message:"加载播放资源失败"});if(!t.src)return t.hlsTimer&&clearTimeout(t.hlsTimer),t.hlsTimer=0,t.$emit("update:loading",!1),void(t.avLoading=!1);t.$emit("update:loading",!0);var i=new XMLHttpRequest;i.open(t.hlsTestMethod,t.src,!0),i.onreadystatechange=function(){4==i.readyState&&(i.status>=200&&i.status<300||304===i.status?(t.hlsTimer&&clearTimeout(t.hlsTimer),t.hlsTimer=0,r()):(t.debug&&console.log((new Date).toLocaleString()+" "+t.src+" HLS "+t.hlsTestMethod,i.status,i.statusText),t.hlsTimer=setTimeout(e,t.hlsTestInterval)))},i.send()}():r()}else this.webrtc?(this.player=videojs(this.$el.querySelector("video"),{techOrder:["webrtc","html5"],autoplay:this.autoplay,stretch:this.stretch,controls:!0,webrtc:{debug:this.debug,autoplay:this.autoplay},userActions:{doubleClick:this.dblclickFullscreen},language:this.language||navigator&&(navigator.languages&&navigator.languages[0]||navigator.userLanguage||navigator.language)||"en"}),this.showCameraButton&&!this.waterText&&this.player.snapshot(),this.player.one("ready",function(){t.initVideoJSOK(),t.$emit("update:loading",!1),t.stretch_&&(t.player.tech_.el_.style.objectFit="fill"),t.player.hotkeys(t.hotkeysOpt()),t.player.on("error",function(e){t.errorAt=(new Date).getTime(),t.$emit("error",e),t.rtcError(e),t.debug&&console.log((new Date).toLocaleString()+" "+t.src+" player error",e)}),t.player.tech().on("error",function(e){t.errorAt=(new Date).getTime(),t.$emit("error",e),t.rtcError(e),t.debug&&console.log((new Date).toLocaleString()+" "+t.src+" rtc error",e)}),t.player.on("timeupdate",function(){t.updateTimeAt=(new Date).getTime(),t.pauseAt=0,t.endedAt=0,t.errorAt=0;var e=t.player.currentTime();!t.snapForPoster&&!t.poster_&&e>2&&t.player.cameraButton&&(t.snapForPoster=!0,t.player.cameraButton.el().click()),t.reloadCnt=0,t.$emit("timeupdate",e)}),t.player.on("ended",function(){t.endedAt=(new Date).getTime(),t.live&&t.rtcError("ended"),t.$emit("ended"),t.debug&&console.log((new Date).toLocaleString()+" "+t.src+" ended")}),t.player.on("playing",function(){t.playAt=(new Date).getTime(),t.avLoading=!1,t.$emit("play",t.player.currentTime()),t.debug&&console.log((new Date).toLocaleString()+" "+t.src+" playing")}),t.showCustomButton&&t.customH5Button()})):(this.player=videojs(this.$el.querySelector("video"),{autoplay:this.autoplay,stretch:this.stretch,controls:!0,playbackRates:this.live?[]:this.playbackRates,userActions:{doubleClick:this.dblclickFullscreen},language:this.language||navigator&&(navigator.languages&&navigator.languages[0]||navigator.userLanguage||navigator.language)||"en"}),this.live||1==this.playbackRate_||this.player.playbackRate(this.playbackRate_),this.player.on("ratechange",function(){t.playbackRate_=t.player.playbackRate()}),this.showCameraButton&&!this.waterText&&this.player.snapshot(),this.player.one("ready",function(){t.initVideoJSOK(),t.$emit("update:loading",!1),t.stretch_&&(t.player.tech_.el_.style.objectFit="fill"),t.player.hotkeys(t.hotkeysOpt()),t.showCustomButton&&t.customH5Button(),t.player.on("ended",function(){t.endedAt=(new Date).getTime(),t.$emit("ended"),t.debug&&console.log((new Date).toLocaleString()+" "+t.src+" ended")}),t.player.on("timeupdate",function(){t.updateTimeAt=(new Date).getTime(),t.pauseAt=0,t.endedAt=0,t.errorAt=0,t.reloadCnt=0,t.$emit("timeupdate",t.player.currentTime())}),t.player.on("playing",function(){t.playAt=(new Date).getTime(),t.avLoading=!1,t.$emit("play",t.player.currentTime()),t.debug&&console.log((new Date).toLocaleString()+" "+t.src+" playing")}),t.player.on("error",function(e){t.errorAt=(new Date).getTime(),t.$emit("error",e)})}));if(this.live&&this.liveInterval>0&&!(this.isMobile()&&this.http&&this.m3u8)){this.liveCheckTimer&&(clearInterval(this.liveCheckTimer),this.liveCheckTimer=0);var i=0;this.liveCheckTimer=setInterval(function(){if(!t.updateTimeAt){if(!t.playAt){var e=(new Date).getTime()-t.startAt;return t.debug&&console.log((new Date).toLocaleString()+" "+t.src+" live check never play d = "+e),void(!(e>=t.liveInterval&&t.src)||t.player&&t.player.isFullscreen()||(t.debug&&console.log((new Date).toLocaleString()+" "+t.src+" live check never play reload d = "+e),t.reload()))}return!t.pauseAt||t.errorAt||t.endedAt?(i++,t.debug&&console.log((new Date).toLocaleString()+" "+t.src+" live check update time error cnt = "+i),void(!(i>=3&&t.src)||t.player&&t.player.isFullscreen()||(t.debug&&console.log((new Date).toLocaleString()+" "+t.src+" live check update time error reload cnt = "+i),t.reload()))):(e=(new Date).getTime()-t.pauseAt,t.debug&&console.log((new Date).toLocaleString()+" "+t.src+" live check pause d = "+e),void(!(t.maxPauseSeconds>0&&e>=1e3*t.maxPauseSeconds&&t.src)||t.player&&t.player.isFullscreen()||(t.debug&&console.log((new Date).toLocaleString()+" "+t.src+" live check pause reload d = "+e),t.reload())))}i=0,e=(new Date).getTime()-t.updateTimeAt,t.debug&&console.log((new Date).toLocaleString()+" "+t.src+" live check d = "+e),!(e>=t.liveInterval&&t.src)||t.player&&t.player.isFullscreen()||(t.debug&&console.log((new Date).toLocaleString()+" "+t.src+" live check reload d = "+e),t.reload())},this.liveInterval)}}},createBase64:function(){var t=this.waterWidth,e=this.waterHeight,n=document.createElement("canvas"),o=n.getContext("2d");if(o){o.fillStyle=this.waterColor,o.textAlign="left",o.textBaseline="middle";var r=24;this.waterFont?o.font=this.waterFont:(o.font="24px Arial, Helvetica, sans-serif",o.measureText(this.waterText+"田").width>t&&(r=20,o.font="20px Arial, Helvetica, sans-serif",o.measureText(this.waterText+"田").width>t&&(r=16,o.font="16px Arial, Helvetica, sans-serif",o.measureText(this.waterText+"田").width>t&&(r=12,o.font="12px Arial, Helvetica, sans-serif")))),o.rotate(this.waterRotate);for(var i=this.waterText.split(/\\n/),a=0;a<i.length;a++)o.fillText(i[a],t/20,e+a*(r+4))}return n.toDataURL("image/png")},initDigitalZoom:function(){var t=this;if(this.digitalZoom){var e=this.debug,n=this.$el.querySelector("video");if(this.player&&n){var o=this.player.getAttribute("id");if(o){e&&console.log("digital zoom init ok id is "+o);var r=document.getElementById(o);if(r){var i=null,a=!1,s=!1,l=!1,u=void 0,c=void 0,d=void 0,f=void 0,v=null,p=!1,h=this.digitalZoomColor,m=null,g=o+"digital-zoom-rectangle",b=0,y=!1;r.addEventListener("mousedown",function(t){if(t.target==n&&0==(i=t.button)){var r=Date.now();if(r-b<500)return y=!0,a=!1,u=0,c=0,void(e&&console.log("digital zoom["+o+"] double click down ingnore"));y=!1,b=r,a=!0,u=t.offsetX,c=t.offsetY,e&&console.log("digital zoom["+o+"] mousedown",t)}}),r.addEventListener("mousemove",function(b){if(!p&&!y&&0==i){if(l){if(l=!1,a||!(1==b.which||b.key&&1===b.key.length&&1==b.key.charCodeAt()))return n.style.cursor="",void(p?j():w());a=!0}if(!a)return;d=b.offsetX,f=b.offsetY;var x=Math.abs(d-u),C=Math.abs(f-c),k=void 0,D=void 0;if(D=f<c?f:c,(k=d<u?d:u)<0||D<0)return;if(x<3||C<3)return;m||(m=b.target),(v=document.querySelector("."+g))||((v=document.createElement("div")).className=g,v.style.cursor="zoom-in",v.style.position="absolute",v.style.border="2px dashed "+h,r.appendChild(v)),s=!0,n.style.cursor="zoom-in",t.zoomDrawing=!0,b.target==m?(v.style.left=k+"px",v.style.top=D+"px",v.style.width=x+"px",v.style.height=C+"px"):b&&b.target&&b.target.className==g?(v.style.width=Math.abs(d)+"px",v.style.height=Math.abs(f)+"px"):_(b),e&&console.log("digital zoom["+o+"] mousemove->left=",k,",top=",D,",width=",x,",height=",C,",currentX=",d,",startX=",u,",currentY=",f,",startY=",c)}}),r.addEventListener("mouseup",function(t){a=!1,0!=i||y||(p?t.target==n&&j():w())});var _=function(t){l=!0,0==i&&(a=!1)};r.addEventListener("mouseleave",_);var w=function(i){var a,l,u,c;if(v){if(a=parseInt(v.style.left),l=parseInt(v.style.top),u=parseInt(v.style.width),c=parseInt(v.style.height),r.removeChild(v),v=null,t.zoomDrawing=!1,!(u<3||c<3)){s&&(n.style.cursor="zoom-out"),e&&console.log("digital zoom["+o+"] 放大开始->left=",a,",top=",l,",width=",u,",height=",c);var d=n,f=d.offsetWidth,h=d.offsetHeight,m=f/u*100,g=h/c*100,b=l*(h/c),y=a*(f/u);t.stretch_||(f/u>h/c?(g=f/u*100,b=l*(f/u)):(m=h/c*100,y=a*(h/c))),d.style.top="-"+b+"px",d.style.left="-"+y+"px",d.style.width=m+"%",d.style.height=g+"%",e&&console.log("digital zoom["+o+"] 放大结束->outWidth=",f,",width=",u,",widthX=",m,",outHeight=",h,",height=",c,",heightX=",g,",top=",l,",topX=",b,",left=",a,",leftX=",y),p=!0}}else t.zoomDrawing=!1},j=function(t){n.style.cursor="",p=!1,s=!1,u=0,c=0,e&&console.log("digital zoom["+o+"] 触发恢复");var r=n;r.style.top="0px",r.style.left="0px",r.style.width="100%",r.style.height="100%",i=null}}else e&&console.log("digital zoom init error, drawing area not found")}else e&&console.log("digital zoom init error, id not found")}else e&&console.log("digital zoom init error player not ready")}}}};n("UBF9");var l=function(t,e,n,o,r,i,a,s){var l,u="function"==typeof t?t.options:t;if(e&&(u.render=e,u.staticRenderFns=n,u._compiled=!0),r&&(l=r),l)if(u.functional){u._injectStyles=l;var c=u.render;u.render=function(t,e){return l.call(e),c(t,e)}}else{var d=u.beforeCreate;u.beforeCreate=d?[].concat(d,l):[l]}return{exports:t,options:u}}(s,function(){var t=this,e=t.$createElement,n=t._self._c||e;return n("div",{staticClass:"player-wrapper"},[n("div",{class:["video-wrapper",{"video-wrapper-stretch":this.stretch_}],style:t.videoWrapperStyle},[n("div",{staticClass:"video-inner",class:{live:t.live,"av-loading":t.avLoading,"hide-waiting":t.hideWaiting,"hide-controls":!t.controls,"zoom-drawing":t.zoomDrawing,"hide-big-play-button":t.hideBigPlayButton,"hide-snapshot-button":t.hideSnapshotButton,"hide-fullscreen-button":t.hideFullscreenButton,"hide-stretch-button":t.hideStretchButton,"hide-fluent-button":t.hideFluentButton,"hide-pic-in-pic":t.hidePicInPic,"hide-live-text":t.hideLiveText,"show-time":t.showTime&&!t.live},staticStyle:{position:"absolute",top:"0",bottom:"0",left:"0",right:"0"}}),t._v(" "),n("div",{directives:[{name:"show",rawName:"v-show",value:t.waterText&&t.showSlot,expression:"waterText && showSlot"}],staticClass:"video-water",style:t.waterStyle}),t._v(" "),n("div",{directives:[{name:"show",rawName:"v-show",value:t.showSlot,expression:"showSlot"}],staticClass:"video-slot"},[t._t("default")],2),t._v(" "),n("span",{directives:[{name:"show",rawName:"v-show",value:t.videoTitle&&t.showSlot,expression:"videoTitle && showSlot"}],staticClass:"video-title",attrs:{title:t.videoTitle}},[t._v(t._s(t.videoTitle))])])])},[],0,null).exports;l.install=function(t){"undefined"!=typeof window&&window.Vue&&(t=window.Vue),t.component(l.name,l)},e.default=l},yLpj:function(t,e){var n;n=function(){return this}();try{n=n||new Function("return this")()}catch(t){"object"==typeof window&&(n=window)}t.exports=n}})})},etei:function(t,e,n){"use strict";function o(t){n("ql7U"),n("ib2l")}Object.defineProperty(e,"__esModule",{value:!0});var r=n("irhb"),i=n.n(r);for(var a in r)["default","default"].indexOf(a)<0&&function(t){n.d(e,t,function(){return r[t]})}(a);var s=n("33FN"),l=n("VU/8"),u=o,c=l(i.a,s.a,!1,u,"data-v-1785224b",null);e.default=c.exports},gJt0:function(t,e,n){"use strict";var o=function(){var t=this,e=t.$createElement,n=t._self._c||e;return n("div",{},[n("div",{staticClass:"box box-primary"},[n("div",{staticClass:"box-header"},[n("h4",{staticClass:"text-primary text-center"},[t._v("设备录像列表("+t._s(t.devid)+"-"+t._s(t.channel)+")")])]),t._v(" "),n("div",{staticClass:"box-body"},[n("div",{staticClass:"form-inline",attrs:{autocomplete:"off",spellcheck:"false"}},[n("div",{staticClass:"form-group form-group-sm"},["no"!=t.$getQueryString("menu")?n("button",{staticClass:"btn btn-sm btn-primary",attrs:{type:"button"},on:{click:function(e){e.preventDefault(),t.$router.go(-1)}}},[n("i",{staticClass:"fa fa-chevron-left"}),t._v(" 返回\n                        ")]):t._e()]),t._v(" "),n("div",{staticClass:"form-group pull-right"},[n("div",{staticClass:"input-group input-group-sm"},[n("DatePicker",{ref:"datePicker",staticClass:"form-control",attrs:{day:t.day},on:{"update:day":t.updateDay}}),t._v(" "),n("div",{staticClass:"input-group-btn"},[n("button",{staticClass:"btn btn-sm btn-default",attrs:{type:"button"},on:{click:function(e){e.preventDefault(),t.showDatePicker(e)}}},[n("i",{staticClass:"fa fa-calendar"})]),t._v(" "),n("button",{staticClass:"btn btn-default btn-sm",on:{click:function(e){e.preventDefault(),t.toTimeView(e)}}},[n("i",{staticClass:"fa fa-hand-o-right"}),t._v(" 时间轴视图\n                                ")])])],1)])]),t._v(" "),n("br"),t._v(" "),n("div",{staticClass:"clearfix"}),t._v(" "),n("el-table",{directives:[{name:"loading",rawName:"v-loading",value:t.loading,expression:"loading"}],ref:"playbackTable",attrs:{stripe:"","element-loading-text":"加载中...",data:t.pageData,"default-sort":{prop:"StartTime",order:"ascending"}},on:{"sort-change":t.sortChange}},[n("el-table-column",{attrs:{prop:"DeviceID",label:"通道国标编号","min-width":"200","show-overflow-tooltip":""}}),t._v(" "),n("el-table-column",{attrs:{label:"操作","min-width":"190",fixed:!t.isMobile()&&"right"},scopedSlots:t._u([{key:"default",fn:function(e){return[n("div",{staticClass:"btn-group btn-group-xs"},[n("button",{staticClass:"btn btn-primary",attrs:{type:"button",disabled:e.row.Starting},on:{click:function(n){n.preventDefault(),t.startPlayback(e.row)}}},[n("i",{staticClass:"fa fa-play-circle"}),t._v(" 播放")]),t._v(" "),n("button",{staticClass:"btn btn-info",attrs:{type:"button",disabled:e.row.Starting},on:{click:function(n){n.preventDefault(),t.downloadPlayback(e.row)}}},[n("i",{staticClass:"fa fa-download"},[t._v(" 下载")])]),t._v(" "),n("a",{staticClass:"btn btn-warning",attrs:{href:"/play.html?type=playback&ptz=no&serial="+t.devid+"&code="+e.row.DeviceID+"&starttime="+e.row.StartTime+"&endtime="+e.row.EndTime,role:"button",target:"_blank"}},[n("i",{staticClass:"fa fa-share"}),t._v(" 分享页")])])]}}])}),t._v(" "),n("el-table-column",{attrs:{prop:"Name",label:"通道名称","min-width":"120",formatter:t.formatName,"show-overflow-tooltip":""}}),t._v(" "),n("el-table-column",{attrs:{prop:"StartTime",label:"开始时间","min-width":"160",formatter:t.formatName,sortable:""}}),t._v(" "),n("el-table-column",{attrs:{prop:"EndTime",label:"结束时间","min-width":"160",formatter:t.formatName,sortable:""}})],1)],1),t._v(" "),t.total>0?n("div",{staticClass:"box-footer clearfix"},[n("el-pagination",{staticClass:"pull-right",attrs:{layout:"total,prev,pager,next","pager-count":t.isMobile()?3:5,total:t.total,"page-size":t.pageSize,"current-page":t.currentPage},on:{"update:pageSize":function(e){t.pageSize=e},"update:currentPage":function(e){t.currentPage=e}}})],1):t._e()]),t._v(" "),n("PlaybackVideoDlg",{ref:"playbackVideoDlg",attrs:{serverInfo:t.serverInfo,userInfo:t.userInfo},on:{close:t.onClose}}),t._v(" "),n("PlaybackDownloadDlg",{ref:"playbackDownloadDlg",on:{download:t.onDownload}})],1)},r=[],i={render:o,staticRenderFns:r};e.a=i},hxIF:function(t,e,n){"use strict";var o=function(){var t=this,e=t.$createElement,n=t._self._c||e;return n("FormDlg",{ref:"dlg",attrs:{title:"导出通道列表",disabled:t.errors.any()},on:{hide:t.onHide,show:t.onShow,submit:t.onSubmit}},[t.devid?n("div",{class:{"form-group":!0}},[n("div",{staticClass:"col-sm-12 checkbox text-center"},[n("el-checkbox",{staticStyle:{"margin-left":"-19px","margin-top":"-5px"},model:{value:t.downloadThisDev,callback:function(e){t.downloadThisDev="string"==typeof e?e.trim():e},expression:"downloadThisDev"}},[t._v("\n                只导出当前设备("+t._s(t.devid)+")的通道\n            ")])],1)]):t._e(),t._v(" "),n("div",{directives:[{name:"show",rawName:"v-show",value:t.showPage,expression:"showPage"}],class:{"form-group":!0,"has-error":t.errors.has("start")}},[n("label",{staticClass:"col-sm-3 control-label",attrs:{for:"input-start"}},[t._v("开始\n            "),n("span",{staticClass:"text-red"},[t._v("*")])]),t._v(" "),n("div",{staticClass:"col-sm-7"},[n("input",{directives:[{name:"model",rawName:"v-model.trim",value:t.start,expression:"start",modifiers:{trim:!0}},{name:"validate",rawName:"v-validate",value:"numeric|min_value:0",expression:"'numeric|min_value:0'"}],staticClass:"form-control",attrs:{type:"text",id:"input-start",name:"start","data-vv-as":"开始"},domProps:{value:t.start},on:{input:function(e){e.target.composing||(t.start=e.target.value.trim())},blur:function(e){t.$forceUpdate()}}})])]),t._v(" "),n("div",{directives:[{name:"show",rawName:"v-show",value:t.showPage,expression:"showPage"}],class:{"form-group":!0,"has-error":t.errors.has("limit")}},[n("label",{staticClass:"col-sm-3 control-label",attrs:{for:"input-limit"}},[t._v("上限\n            "),n("span",{staticClass:"text-red"},[t._v("*")])]),t._v(" "),n("div",{staticClass:"col-sm-7"},[n("input",{directives:[{name:"model",rawName:"v-model.trim",value:t.limit,expression:"limit",modifiers:{trim:!0}},{name:"validate",rawName:"v-validate",value:"numeric|min_value:1",expression:"'numeric|min_value:1'"}],staticClass:"form-control",attrs:{type:"text",id:"input-limit",name:"limit","data-vv-as":"上限"},domProps:{value:t.limit},on:{input:function(e){e.target.composing||(t.limit=e.target.value.trim())},blur:function(e){t.$forceUpdate()}}})])])])},r=[],i={render:o,staticRenderFns:r};e.a=i},ib2l:function(t,e,n){var o=n("4Dib");"string"==typeof o&&(o=[[t.i,o,""]]),o.locals&&(t.exports=o.locals);n("rjj0")("402e076f",o,!0,{})},irhb:function(t,e,n){"use strict";(function(t){function o(t){return t&&t.__esModule?t:{default:t}}Object.defineProperty(e,"__esModule",{value:!0});var r=Object.assign||function(t){for(var e=1;e<arguments.length;e++){var n=arguments[e];for(var o in n)Object.prototype.hasOwnProperty.call(n,o)&&(t[o]=n[o])}return t};n("pFnw");var i=n("M4fF"),a=(o(i),n("NYxO")),s=n("eAMf"),l=o(s),u=n("JK3F"),c=n("/aob"),d=o(c),f=n("wZh5"),v=o(f);e.default={props:{},data:function(){var t=this;return{q:"",loading:!1,showTree:!0,showGroupTree:!0,playerIdx:0,playersLength:4,players:[],pnode:null,contextMenuTarget:null,contextMenuVisible:!1,contextMenuNodeData:null,treeProps:{label:function(e,n){n.serial=e.serial,n.code=e.code;var o=e.customName||e.name||e.id;return!t.treeLeaf(e)&&e.subCount>0&&(o+=" ["+e.onlineSubCount+"/"+e.subCount+"]"),o},isLeaf:function(e,n){return t.treeLeaf(e)},disabled:function(e,n){return t.treeLeaf(e)&&"ON"!=e.status}},customListDlgTitle:"选择通道",sticky:null}},components:{LivePlayer:l.default,VueContextMenu:u.component,DeviceTreeNodeEditDlg:d.default,ChannelCustomListDlg:v.default},computed:r({},(0,a.mapState)(["userInfo","serverInfo"]),{playing:function(){return!!(this.players[this.playerIdx]||{}).url},canPTZ:function(){var t=this.players[this.playerIdx]||{};return!!t.url&&(0===t.ptzType||1===t.ptzType)}}),mounted:function(){this.setPlayersLength(this.playersLength),this.contextMenuTarget=document.querySelector("#tab-tree-wrapper"),t(document).on("mouseup touchend",this.ctrlStop),t(document).on("expanded.pushMenu collapsed.pushMenu",this.updateSticky),t("#dev-tree-right").sticky({topSpacing:40,bottomSpacing:80})},beforeDestroy:function(){this.ctrlStop(),t(document).off("mouseup touchend",this.ctrlStop),t(document).off("expanded.pushMenu collapsed.pushMenu",this.updateSticky),this.clearVideos(),t("#dev-tree-right").unstick()},beforeRouteLeave:function(t,e,n){this.ctrlStop(),this.clearVideos(),n()},beforeRouteUpdate:function(t,e,n){this.ctrlStop(),this.clearVideos(),n()},watch:{q:function(t,e){this.$refs.devTree.filter(t),this.$refs.groupTree.filter(t)}},methods:{treeLoad:function(e,n){var o=this,r=e.serial||"",i=e.code||"";t.get("/api/v1/device/channeltree",{serial:r,pcode:i}).then(function(t){n((t||[]).map(function(t){return Object.assign(t,{key:t.id})})),o.$refs.devTree.filter(o.q)})},groupTreeLoad:function(e,n){var o=this,r=e.serial||"",i=e.code||"";t.get("/api/v1/device/grouptree",{serial:r,pcode:i}).then(function(t){n((t||[]).map(function(t){return Object.assign(t,{key:t.id})})),o.$refs.groupTree.filter(o.q)})},treeFilter:function(t,e){return!t||(-1!==e.name.indexOf(t)||-1!==e.customName.indexOf(t)||-1!==e.id.indexOf(t))},treeNodeRefresh:function(t){var e=this.$refs.devTree.getNode(t);e&&(e.loaded=!1,e.expand())},treeNodeRightClick:function(t,e,n,o){if(this.hasAnyRole(this.serverInfo,this.userInfo,"管理员")){this.contextMenuNodeData=e,this.pnode=n.parent;var r;"function"==typeof MouseEvent?r=new MouseEvent(t.type,t):(r=document.createEvent("MouseEvents"),r.initMouseEvent(t.type,!0,!0,t.view,t.detail,t.screenX,t.screenY,t.clientX,t.clientY,t.ctrlKey,t.altKey,t.shiftKey,t.metaKey,t.button,t)),this.contextMenuTarget.dispatchEvent(r)}},treeNodeClick:function(e,n,o){var r=this;if(this.contextMenuNodeData=null,this.treeLeaf(e)&&"ON"===e.status){var i=this.players[this.playerIdx]||{};if(i.bLoading)return;this.closeVideo(i),i.serial=e.serial,i.code=e.code,i.ptzType=e.ptzType,i.bLoading=!0,t.post("/api/v1/stream/start",{serial:e.serial,code:e.code}).then(function(t){t=t||{};var e=t.HLS,n="HLS";r.flvSupported()&&(t.WS_FLV?(e=t.WS_FLV,n="WS_FLV"):t.FLV&&(e=t.FLV,n="FLV")),i.protocol=n,i.poster="RTMP"==n?"":t.SnapURL,r.$nextTick(function(){i.url=e||""})}).fail(function(){r.$nextTick(function(){i.bLoading=!1})}),this.setPlayerIdx(this.playerIdx+1)}},showNodeEditDlg:function(){this.contextMenuVisible=!1;var t=Object.assign({},this.contextMenuNodeData,{parent:this.getParentData(),add:!1});this.$refs.nodeEditDlg.show(t)},showNodeAddDlg:function(){this.contextMenuVisible=!1;var t=Object.assign({serial:this.contextMenuNodeData.serial||"",code:"",name:"",customName:""},{parent:this.contextMenuNodeData,add:!0});this.$refs.nodeEditDlg.show(t)},showCustomListDlg:function(){this.contextMenuVisible=!1,this.customListDlgTitle="选择通道("+(this.contextMenuNodeData.customName||this.contextMenuNodeData.name||this.contextMenuNodeData.code)+")",this.$refs.customListDlg.show(this.contextMenuNodeData.code)},removeCustomNode:function(){var e=this;if(this.contextMenuVisible=!1,this.contextMenuNodeData){var n=this.contextMenuNodeData.customName||this.contextMenuNodeData.name||this.contextMenuNodeData.id;this.$confirm("确认删除 "+n,"提示",{lockScroll:!1}).then(function(){t.post("/api/v1/channel/remove",{serial:e.contextMenuNodeData.serial,code:e.contextMenuNodeData.code}).always(function(){e.treeNodeRefresh(e.pnode)})}).catch(function(){})}},treeRefresh:function(){var t=this;if(this.contextMenuVisible=!1,this.pnode)return void this.treeNodeRefresh(this.pnode);this.showTree=!1,this.$nextTick(function(){t.showTree=!0})},treeLeaf:function(t){if(t&&0==t.subCount&&t.serial&&t.code&&!t.custom){if(this.serverInfo.StrictChannelParental&&t.parental)return!1;if(this.serverInfo.StrictChannelCode&&20!=t.code.length)return!1;if((!t.parental||"LiveQing"!=t.manufacturer)&&(20!=t.code.length||"216"!=t.code.substring(10,13)&&"215"!=t.code.substring(10,13)))return!0}return!1},getParentData:function(){if(!this.contextMenuNodeData)return null;if(!this.$refs.devTree)return null;var t=this.$refs.devTree.getNode(this.contextMenuNodeData);return t&&t.parent?t.parent.data:null},resetCloseTimer:function(t){t&&(t.bCloseShow=!0,t.closeTimer&&clearTimeout(t.closeTimer),t.closeTimer=setTimeout(function(){t.bCloseShow=!1},2e3))},closeVideo:function(t){t&&(t.bLoading=!1,t.bCloseShow=!1,t.poster="",t.url="")},clearVideos:function(){for(var t in this.players)this.closeVideo(this.players[t])},setPlayersLength:function(t){if(t!=this.players.length){this.clearVideos(),this.players=[],this.playersLength=t;for(var e=0;e<t;e++)this.players.push({serial:"",code:"",url:"",protocol:"",poster:"",bLoading:!1,bCloseShow:!1})}},setPlayerIdx:function(t){this.ctrlStop(),this.playerIdx=t%this.players.length},clickPlayer:function(e,n,o){t(o.target).hasClass("video-close")||this.setPlayerIdx(n)},ptzControl:function(e){var n=this.players[this.playerIdx];if(n&&n.serial&&n.code){var o=t(e.currentTarget);t.post("/api/v1/control/ptz",{serial:n.serial,code:n.code,command:o.attr("command")}),o.addClass("active")}},ptzStop:function(){var e=this.players[this.playerIdx];e&&e.serial&&e.code&&t(this.$el).find(".ptz-cell.active").length>0&&(t.post("/api/v1/control/ptz",{serial:e.serial,code:e.code,command:"stop"}),t(this.$el).find(".ptz-cell.active").removeClass("active"))},talkStart:function(e){var n=this;if(!this.ws){var o=this.wsTalkURL();if(!o)return;var r=new WebSocket(o);r.onopen=function(t){console.log("ws talk open"),n.ws=r},r.onclose=function(t){console.log("ws talk close")},r.onerror=function(t){console.log("ws talk error",t)}}var i=t(e.currentTarget);if(this.recorder)return i.addClass("active"),void this.recorder.start();LiveRecorder.get(function(t,e){if(e)return void alert(e);i.addClass("active"),n.recorder=t,n.recorder.start()},{sampleBits:16,sampleRate:8e3,pcmCallback:function(t){var e=new window.FileReader;e.onloadend=function(){var t=e.result,t=t.split(",")[1];n.ws&&n.ws.send(t)},e.readAsDataURL(t)}})},talkStop:function(){this.recorder&&(this.recorder.stop(),t(this.$el).find(".fa-microphone.active").removeClass("active")),this.ws&&(this.ws.close(),this.ws=null)},ctrlStop:function(){this.talkStop(),this.ptzStop()},wsTalkURL:function(){var t=this.players[this.playerIdx];if(!t||!t.serial||!t.code)return"";var e="ws:";return location.protocol.startsWith("https")&&(e="wss:"),e+"//"+location.host+"/api/v1/control/ws-talk/"+t.serial+"/"+t.code+"?format=pcm"},resetTreeMaxHeight:function(){var e=t("#dev-tree, #group-tree");this.isMobile()?e.css("max-height",200):this.$nextTick(function(){var n=t("#dev-tree-player").height()-90,o=t(window).height()-450,r=o>n?o:n;e.css("max-height",r)})},updateSticky:function(){t("#dev-tree-right").sticky("update")}}}}).call(e,n("0iPh"))},jPLH:function(t,e,n){e=t.exports=n("FZ+f")(!1),e.push([t.i,".modal-title[data-v-3ea1a7b5]{overflow:hidden;white-space:nowrap;text-overflow:ellipsis}.modal-body[data-v-3ea1a7b5]{padding:10px}.ptz-area[data-v-3ea1a7b5]{padding-left:0}.ptz-block[data-v-3ea1a7b5]{width:150px;height:200px;margin:0 auto;text-align:center;position:relative;font-size:24px;color:#333}.ptz-block .ptz-cell[data-v-3ea1a7b5]{width:50px;height:50px;line-height:50px;position:absolute;cursor:pointer}.ptz-block .ptz-cell.active[data-v-3ea1a7b5]{color:#ccc}.ptz-block .fa-microphone-slash[data-v-3ea1a7b5],.ptz-block .fa-microphone[data-v-3ea1a7b5]{padding:10px 13px;border-radius:25px}.ptz-block .fa-microphone.active[data-v-3ea1a7b5]{color:#fff}.ptz-block .mic-level-bar[data-v-3ea1a7b5]{position:absolute;transform:rotate(-90deg);width:100%;top:70px;left:-80px}.ptz-block .ptz-center[data-v-3ea1a7b5]{top:50px;left:50px;border-radius:25px;background-color:#ccc}.ptz-block .ptz-up[data-v-3ea1a7b5]{top:0;left:50px}.ptz-block .ptz-left[data-v-3ea1a7b5]{top:50px;left:0}.ptz-block .ptz-right[data-v-3ea1a7b5]{top:50px;left:100px}.ptz-block .ptz-down[data-v-3ea1a7b5]{top:100px;left:50px}.ptz-block .ptz-zoomin[data-v-3ea1a7b5]{top:150px;left:20px}.ptz-block .ptz-zoomout[data-v-3ea1a7b5]{top:150px;left:80px}.stream-info[data-v-3ea1a7b5]{line-height:24px;margin:0;font-size:12px}.stream-info .col-sm-3[data-v-3ea1a7b5]{padding:1px 0}.stream-info .col-sm-9[data-v-3ea1a7b5]{padding:1px;max-height:120px;word-break:break-all}#protocol-switcher label[data-v-3ea1a7b5]{margin-bottom:0}@media screen and (min-width:768px){.modal-dialog.modal-lgg[data-v-3ea1a7b5]{width:90%}.tab-info[data-v-3ea1a7b5]{display:none}}@media screen and (min-width:1200px){.modal-dialog.modal-lgg[data-v-3ea1a7b5]{width:1200px}.tab-info[data-v-3ea1a7b5]{display:block}}",""])},jThn:function(t,e,n){e=t.exports=n("FZ+f")(!1),e.push([t.i,".modal-content[data-v-4494c1da]{overflow:hidden}@media screen and (min-width:992px){.modal-dialog.modal-lgg[data-v-4494c1da]{width:90%}}@media screen and (min-width:1200px){.modal-dialog.modal-lgg[data-v-4494c1da]{width:1200px}}",""])},jjQp:function(t,e,n){"use strict";function o(t){n("RE+l")}Object.defineProperty(e,"__esModule",{value:!0});var r=n("aonT"),i=n.n(r);for(var a in r)["default","default"].indexOf(a)<0&&function(t){n.d(e,t,function(){return r[t]})}(a);var s=n("ci/Y"),l=n("VU/8"),u=o,c=l(i.a,s.a,!1,u,"data-v-3ea1a7b5",null);e.default=c.exports},kayV:function(t,e,n){var o=n("tS9P");"string"==typeof o&&(o=[[t.i,o,""]]),o.locals&&(t.exports=o.locals);n("rjj0")("d95b18c0",o,!0,{})},kbZy:function(t,e,n){var o=n("aiu8");"string"==typeof o&&(o=[[t.i,o,""]]),o.locals&&(t.exports=o.locals);n("rjj0")("bf8ab964",o,!0,{})},lGU5:function(t,e,n){"use strict";var o=function(){var t=this,e=t.$createElement,n=t._self._c||e;return n("div",{staticClass:"modal fade upload-dlg",attrs:{"data-backdrop":"static","data-keyboard":"true",tabindex:"-1"}},[n("div",{staticClass:"modal-dialog"},[n("div",{staticClass:"modal-content"},[n("div",{staticClass:"modal-header"},[t._m(0),t._v(" "),n("h4",{staticClass:"modal-title text-center text-info"},[n("span",[t._v(t._s(t.title))])])]),t._v(" "),n("div",{staticClass:"modal-body"},[t._t("header"),t._v(" "),n("el-upload",{ref:"uploader",attrs:{drag:t.drag,action:t.url,multiple:t.multiple,"show-file-list":t.showFileList,limit:1,"on-success":t.onSuccess,"on-progress":t.onProgress,"on-error":t.onError,"on-remove":t.onRemove,accept:t.accept,"before-upload":t.beforeUpload,"auto-upload":t.autoUpload}},[n("i",{staticClass:"el-icon-upload"}),t._v(" "),n("div",{staticClass:"el-upload__text"},[t._v("将配置数据拖到此处，或 "),n("em",[t._v("点击上传")])]),t._v(" "),n("div",{staticClass:"el-upload__tip text-center",attrs:{slot:"tip"},slot:"tip"},[t._v(t._s(t.tip)),n("span",{staticStyle:{color:"red"}},[t._v(" *上传成功会自动删除原有数据，请注意导出备份*")])])]),t._v(" "),t._t("footer")],2),t._v(" "),n("div",{staticClass:"modal-footer"},[n("button",{staticClass:"btn btn-default",attrs:{type:"button","data-dismiss":"modal",disabled:t.bProgress}},[t._v("关闭")])])])])])},r=[function(){var t=this,e=t.$createElement,n=t._self._c||e;return n("button",{staticClass:"close",attrs:{type:"button","data-dismiss":"modal","aria-label":"Close"}},[n("span",{attrs:{"aria-hidden":"true"}},[t._v("×")])])}],i={render:o,staticRenderFns:r};e.a=i},lN7H:function(t,e,n){"use strict";(function(t){function o(t){return t&&t.__esModule?t:{default:t}}Object.defineProperty(e,"__esModule",{value:!0});var r=Object.assign||function(t){for(var e=1;e<arguments.length;e++){var n=arguments[e];for(var o in n)Object.prototype.hasOwnProperty.call(n,o)&&(t[o]=n[o])}return t},i=n("M4fF"),a=o(i),s=n("VMXz"),l=o(s),u=n("5gxX"),c=o(u),d=n("IaS+"),f=o(d),v=n("sJmG"),p=o(v),h=n("jjQp"),m=o(h),g=n("MR46"),b=o(g),y=n("8+DQ"),_=o(y),w=n("NYxO");e.default={props:{devid:{type:String,default:""},name:{type:String,default:""}},data:function(){return{q:"",online:"",devOnline:!1,channel_type:"",dir_serial:"",total:0,pageSize:10,currentPage:1,sort:"Channel",order:"asc",loading:!1,bgLoading:!1,timer:0,channels:[],uploadThisDev:!1,catalogMap:{}}},computed:r({},(0,w.mapState)(["userInfo","serverInfo"]),{uploadURL:function(){return this.uploadThisDev&&this.devid?"/api/v1/channel/import?serial="+this.devid:"/api/v1/channel/import"}}),components:{ChannelExportDlg:l.default,UploadDlg:c.default,ChannelNameEditDlg:f.default,ChannelPositionEditDlg:p.default,VideoDlg:m.default,DeviceLogDlg:b.default,DecodeChannelListDlg:_.default},mounted:function(){},beforeDestroy:function(){this.timer&&(this.timer.close(),this.timer=0)},methods:{ready:function(){var t=this;this.unWatchQ=this.$watch("q",function(t,e){this.doDelaySearch()}),this.unWatchOnline=this.$watch("online",function(t,e){this.doSearch()}),this.unWatchChannelType=this.$watch("channel_type",function(t,e){this.doSearch()}),this.unWatchDirSerial=this.$watch("dir_serial",function(t,e){this.doSearch()}),this.unWatchCurrentPage=this.$watch("currentPage",function(t,e){this.doSearch(t)}),this.timer=subscribeEvents(["online","offline","catalog","channel_status","stream_start","stream_stop"],function(){t.getChannels(!1)})},undoReady:function(){this.unWatchQ&&(this.unWatchQ(),this.unWatchQ=null),this.unWatchOnline&&(this.unWatchOnline(),this.unWatchOnline=null),this.unWatchChannelType&&(this.unWatchChannelType(),this.unWatchChannelType=null),this.unWatchDirSerial&&(this.unWatchDirSerial(),this.unWatchDirSerial=null),this.unWatchCurrentPage&&(this.unWatchCurrentPage(),this.unWatchCurrentPage=null),this.timer&&(this.timer.close(),this.timer=0)},keyDown:function(t){27==t.keyCode&&this.$el.querySelector(".fa-chevron-left").click()},doSearch:function(){var t=arguments.length>0&&void 0!==arguments[0]?arguments[0]:1,e={};this.q&&(e.q=this.q),this.online&&(e.online=this.online),this.channel_type&&(e.channel_type=this.channel_type),this.dir_serial&&(e.dir_serial=this.dir_serial),this.$router.replace({path:"/devices/channels/"+this.devid+"/"+t,query:e})},doDelaySearch:a.default.debounce(function(){this.doSearch()},800),getChannels:function(){var e=this,n=!(arguments.length>0&&void 0!==arguments[0])||arguments[0];if(n)this.loading=!0;else{if(this.bgLoading||this.loading)return;this.bgLoading=!0}t.ajax({method:"GET",url:"/api/v1/device/channellist",global:n,data:{serial:this.devid,q:this.q,start:(this.currentPage-1)*this.pageSize,limit:this.pageSize,online:this.online,channel_type:this.channel_type,dir_serial:this.dir_serial,sort:this.sort,order:this.order}}).then(function(t){e.total=t.ChannelCount,e.channels=t.ChannelList}).always(function(){n?e.loading=!1:e.bgLoading=!1})},uploaded:function(){this.$message({type:"success",message:"上传成功！"}),this.getChannels(),this.$refs.uploadDlg.hide()},sortChange:function(t){this.sort=t.prop,this.order="ascending"==t.order?"asc":"desc",this.getChannels()},canPlay:function(t){if(t&&t.DeviceOnline&&"GB"==t.DeviceType&&"ON"==(t.CustomStatus||t.Status)&&!t.Custom&&0==t.SubCount){if(this.serverInfo.StrictChannelParental&&1==t.Parental)return!1;if(this.serverInfo.StrictChannelCode&&20!=t.ID.length)return!1;if((1!=t.Parental||"LiveQing"!=t.Manufacturer)&&(20!=t.ID.length||"216"!=t.ID.substring(10,13)&&"215"!=t.ID.substring(10,13)))return!0}return!1},canPlayback:function(t){if(t&&t.DeviceOnline&&"GB"==t.DeviceType&&!t.Custom&&0==t.SubCount){if(this.serverInfo.StrictChannelParental&&1==t.Parental)return!1;if(this.serverInfo.StrictChannelCode&&20!=t.ID.length)return!1;if((1!=t.Parental||"LiveQing"!=t.Manufacturer)&&(20!=t.ID.length||"216"!=t.ID.substring(10,13)&&"215"!=t.ID.substring(10,13)))return!0}return!1},canDecode:function(t){if(t&&t.DeviceOnline&&"Decode"==t.DeviceType&&"ON"==(t.CustomStatus||t.Status)&&!t.Custom&&0==t.SubCount){if(this.serverInfo.StrictChannelParental&&1==t.Parental)return!1;if(this.serverInfo.StrictChannelCode&&20!=t.ID.length)return!1;if((1!=t.Parental||"LiveQing"!=t.Manufacturer)&&(20!=t.ID.length||"216"!=t.ID.substring(10,13)&&"215"!=t.ID.substring(10,13)))return!0}return!1},canCloudRecord:function(t){if(t&&t.CloudRecord&&"GB"==t.DeviceType&&!t.Custom&&0==t.SubCount){if(this.serverInfo.StrictChannelParental&&1==t.Parental)return!1;if(this.serverInfo.StrictChannelCode&&20!=t.ID.length)return!1;if((1!=t.Parental||"LiveQing"!=t.Manufacturer)&&(20!=t.ID.length||"216"!=t.ID.substring(10,13)&&"215"!=t.ID.substring(10,13)))return!0}return!1},isDir:function(t){if(t){if(t.SubCount>0)return!0;if(this.serverInfo.StrictChannelParental&&1==t.Parental)return!0;if(this.serverInfo.StrictChannelCode&&t.ID.length<=10)return!0;if(1==t.Parental&&"LiveQing"==t.Manufacturer)return!0;if(20==t.ID.length&&("216"==t.ID.substring(10,13)||"215"==t.ID.substring(10,13)))return!0}return!1},canFetchCatalog:function(t){return!(!this.isDir(t)||!t.DeviceOnline||t.ID==this.devid)},formatName:function(t,e,n){return t.CustomName||t.Name||"-"},formatPTZType:function(t,e,n){var o="未知";switch(t.CustomPTZType||t.PTZType){case 1:o="球机";break;case 2:o="半球";break;case 3:o="固定枪机";break;case 4:o="遥控枪机"}return o},formatPosition:function(t,e,n){var o="-,-";return t.CustomLongitude||t.CustomLatitude?o=t.CustomLongitude+","+t.CustomLatitude:(t.Longitude||t.Latitude)&&(o=t.Longitude+","+t.Latitude),o},formatCatalogTitle:function(t){return this.catalogMap[this.devid+":"+t.ID]?"更新中...":""},setPTZType:function(e){var n=e.PTZType;n===e.row.PTZType&&(n=0),e.row.CustomPTZType=n,t.post("/api/v1/device/setchannelptztype",{serial:e.row.DeviceID,code:e.row.ID,ptz_type:n})},toggleAudio:function(e){t.post("/api/v1/device/setchannelaudio",{serial:e.DeviceID,code:e.ID,audio:!e.AudioEnable}).then(function(){e.AudioEnable=!e.AudioEnable})},editChannelName:function(t){this.$refs.channelNameEditDlg.show({serial:t.DeviceID,code:t.ID,name:t.Name,custom:t.Custom,customID:t.CustomID,customName:t.CustomName,description:t.Description})},editChannelPosition:function(t){this.$refs.channelPositionEditDlg.show({serial:t.DeviceID,code:t.ID,name:t.CustomName||t.Name,custom:t.Custom,longitude:t.Longitude,latitude:t.Latitude,customLongitude:t.CustomLongitude,customLatitude:t.CustomLatitude})},toggleOndemand:function(e){t.post("/api/v1/device/setchannelondemand",{serial:e.DeviceID,code:e.ID,ondemand:!e.Ondemand}).then(function(){e.Ondemand=!e.Ondemand})},toggleCloudRecord:function(e){var n=e.CloudRecord?"关闭云端录像, 是否同时设置 开启 按需直播?":"开启云端录像, 是否同时设置 关闭 按需直播?";this.$confirm(n,"提示",{distinguishCancelAndClose:!0,cancelButtonText:"不设置",lockScroll:!1}).then(function(){t.post("/api/v1/device/setchannelcloudrecord",{serial:e.DeviceID,code:e.ID,cloudrecord:!e.CloudRecord,ondemand:e.CloudRecord}).then(function(){e.Ondemand=e.CloudRecord,e.CloudRecord=!e.CloudRecord})}).catch(function(n){"cancel"===n&&t.post("/api/v1/device/setchannelcloudrecord",{serial:e.DeviceID,code:e.ID,cloudrecord:!e.CloudRecord}).then(function(){e.CloudRecord=!e.CloudRecord})})},toggleShared:function(e){t.post("/api/v1/device/setchannelshared",{serial:e.DeviceID,code:e.ID,shared:!e.Shared}).then(function(){e.Shared=!e.Shared})},playStream:function(e){var n=this;this.loading=!0,this.$set(e,"Locked",!0),t.post("/api/v1/stream/start",{serial:e.DeviceID,code:e.ID}).then(function(t){n.$refs.videoDlg.play(e.CustomName||e.Name||e.ID,e.DeviceID,e.ID,t)}).always(function(){n.loading=!1,n.$delete(e,"Locked")})},decodeStart:function(e){var n=this,o=(e.StreamID||"").split(":");3==o.length?(this.loading=!0,this.$set(e,"Locked",!0),t.post("/api/v1/stream/start",{serial:o[1],code:o[2]}).then(function(t){n.$refs.videoDlg.play(e.CustomName||e.Name||e.ID,o[1],o[2],t)}).always(function(){n.loading=!1,n.$delete(e,"Locked")})):this.$refs.decodeChannelListDlg.show(e)},decodeStream:function(e,n){var o=this;this.loading=!0,this.$set(e,"Locked",!0),t.post("/api/v1/stream/start",{serial:n.DeviceID,code:n.ID}).then(function(r){t.post("/api/v1/decode/start",{serial:e.DeviceID,code:e.ID,streamid:r.StreamID}).then(function(){o.$refs.videoDlg.play(e.CustomName||e.Name||e.ID,n.DeviceID,n.ID,r)}).always(function(){o.loading=!1,o.$delete(e,"Locked")})}).fail(function(){o.loading=!1,o.$delete(e,"Locked")})},stopStream:function(e){var n=this;if("Decode"==e.DeviceType)return void this.$confirm("确认停止解码 "+(e.Name||e.ID)+" ?","提示",{lockScroll:!1}).then(function(){t.post("/api/v1/decode/stop",{serial:n.devid,code:e.ID}).always(function(){n.getChannels()})}).catch(function(){});this.$confirm("确认停止直播 "+(e.Name||e.ID)+" ?","提示",{lockScroll:!1}).then(function(){t.post("/api/v1/stream/stop",{serial:n.devid,code:e.ID}).always(function(){n.getChannels()})}).catch(function(){})},fetchCatalog:function(e){var n=this;if(this.serverInfo&&!this.serverInfo.RemainDays)return void this.$message({type:"error",message:"授权过期"});this.$set(this.catalogMap,this.devid+":"+e.ID,!0),t.ajax({method:"GET",url:"/api/v1/device/fetchcatalog",global:!1,data:{serial:this.devid,code:e.ID}}).then(function(){n.$message({type:"success",message:"更新通道信息成功"})}).fail(function(t){t&&console.log("fetch catalog ajax error: "+t.status+" "+t.responseText)}).always(function(){n.$delete(n.catalogMap,n.devid+":"+e.ID)})},removeVirtual:function(e){var n=this;this.$confirm("确认删除虚拟通道 "+(e.Name||e.ID)+" ?","提示",{lockScroll:!1}).then(function(){t.post("/api/v1/channel/virtual/remove",{serial:n.devid,code:e.ID}).always(function(){n.getChannels()})}).catch(function(){})},showDeviceLog:function(t){this.$refs.deviceLogDlg.show("通道("+t.ID+")",t.DeviceID,t.ID)},showStatusLog:function(t){this.$refs.deviceLogDlg.showStatusLog("通道("+t.ID+")",t.DeviceID,t.ID)},showStreamLog:function(t){this.$refs.deviceLogDlg.showStreamLog("通道("+t.ID+")",t.DeviceID,t.ID)}},beforeRouteEnter:function(t,e,n){n(function(e){e.q=t.query.q||"",e.online=t.query.online||"",e.channel_type=t.query.channel_type||"",e.dir_serial=t.query.dir_serial||"",e.currentPage=parseInt(t.params.page)||1,e.ready()})},beforeRouteLeave:function(t,e,n){this.timer&&(clearInterval(this.timer),this.timer=0),n()},beforeRouteUpdate:function(t,e,n){var o=this;this.undoReady(),n(),this.$nextTick(function(){o.q=t.query.q||"",o.online=t.query.online||"",o.channel_type=t.query.channel_type||"",o.dir_serial=t.query.dir_serial||"",o.currentPage=parseInt(t.params.page)||1,o.channels=[],o.total=0,o.getChannels(),o.ready()})}}}).call(e,n("0iPh"))},lgdx:function(t,e,n){"use strict";(function(t){Object.defineProperty(e,"__esModule",{value:!0}),n("F8S1"),e.default={data:function(){return{bShow:!1,timer:0,title:"",streamID:"",progress:0,speed:1,inBitRate:0,fileUrl:"",fileError:""}},props:{fade:{type:Boolean,default:!1}},mounted:function(){var e=this;t(this.$el).find(".modal-content").draggable({handle:".modal-header",cancel:".modal-title span",addClasses:!1,containment:"document",delay:100,opacity:.5}),t(this.$el).on("shown.bs.modal",function(){e.bShow=!0,e.$emit("show"),e.streamID&&(e.timer=setInterval(function(){t.ajax({type:"GET",url:"/api/v1/playback/streaminfo",data:{streamid:e.streamID},global:!1}).then(function(t){e.progress=Math.ceil(100*t.Progress),e.fileUrl=t.PlaybackFileURL||"",e.fileError=t.PlaybackFileError||"",e.speed=t.PlaybackSpeed||1,e.inBitRate=t.InBitRate||0}).fail(function(){e.progress=100,e.inBitRate=0})},3e3))}).on("hidden.bs.modal",function(){e.bShow=!1,e.$emit("hide"),e.timer&&(clearInterval(e.timer),e.timer=0),e.stop()})},beforeDestroy:function(){this.timer&&(clearInterval(this.timer),this.timer=0)},methods:{show:function(){t(this.$el).modal("show")},hide:function(){this.stop(!0),t(this.$el).modal("hide")},doSubmit:function(){this.$emit("submit")},download:function(e,n){this.title=e,this.streamID=n,this.fileUrl="",this.fileError="",this.progress=0,this.speed=1,this.inBitRate=0,t(this.$el).modal("show")},scale:function(e){var n=this;this.streamID&&t.post("/api/v1/playback/control",{streamid:this.streamID,command:"scale",scale:e}).then(function(t){n.$message({type:"success",message:e+" 倍速设置成功"})})},stop:function(){var e=this,n=arguments.length>0&&void 0!==arguments[0]&&arguments[0];this.streamID&&(t.ajax({type:"POST",url:"/api/v1/playback/stop",data:{streamid:this.streamID},global:!1}).always(function(){e.fileUrl&&n&&window.open(e.fileUrl,"_blank"),e.fileUrl="",e.fileError="",e.progress=0,e.inBitRate=0,e.$emit("download")}),this.streamID="")}}}}).call(e,n("0iPh"))},mZnh:function(t,e,n){"use strict";function o(t){n("YgVS")}Object.defineProperty(e,"__esModule",{value:!0});var r=n("lN7H"),i=n.n(r);for(var a in r)["default","default"].indexOf(a)<0&&function(t){n.d(e,t,function(){return r[t]})}(a);var s=n("JT0P"),l=n("VU/8"),u=o,c=l(i.a,s.a,!1,u,null,null);e.default=c.exports},pCZB:function(t,e,n){"use strict";(function(t){function o(t){return t&&t.__esModule?t:{default:t}}Object.defineProperty(e,"__esModule",{value:!0});var r=Object.assign||function(t){for(var e=1;e<arguments.length;e++){var n=arguments[e];for(var o in n)Object.prototype.hasOwnProperty.call(n,o)&&(t[o]=n[o])}return t},i=n("M4fF"),a=(o(i),n("PJh5")),s=o(a),l=n("WEd6"),u=o(l),c=n("5aEn"),d=o(c),f=n("ENv+"),v=o(f),p=n("NYxO");e.default={props:{devid:{type:String,default:""},channel:{type:String,default:""},mode:{type:String,default:"list"},day:{type:String,default:function(){return(0,s.default)().format("YYYYMMDD")}}},data:function(){return{timerange:[(0,s.default)(this.day,"YYYYMMDD").startOf("hour").toDate(),(0,s.default)(this.day,"YYYYMMDD").startOf("hour").toDate()],currentPage:1,pageSize:10,loading:!1,sort:"",order:"",center:"",indistinct:"",streamID:"",records:[]}},components:{PlaybackVideoDlg:d.default,PlaybackDownloadDlg:v.default,DatePicker:u.default},computed:r({},(0,p.mapState)(["userInfo","serverInfo"]),{total:function(){return this.records.length},pageData:function(){var t=(this.currentPage-1)*this.pageSize,e=t+this.pageSize;return this.records.slice(t,e)}}),methods:{ready:function(){this.$watch("day",function(t,e){this.timerange=[(0,s.default)(this.day,"YYYYMMDD").startOf("hour").toDate(),(0,s.default)(this.day,"YYYYMMDD").startOf("hour").toDate()]}),this.$watch("center",function(t,e){this.timerange=[(0,s.default)(this.day,"YYYYMMDD").startOf("hour").toDate(),(0,s.default)(this.day,"YYYYMMDD").startOf("hour").toDate()]}),this.$watch("indistinct",function(t,e){this.timerange=[(0,s.default)(this.day,"YYYYMMDD").startOf("hour").toDate(),(0,s.default)(this.day,"YYYYMMDD").startOf("hour").toDate()]}),console.log(this.devid,this.channel,this.day),this.getRecords(!0),t(window).on("beforeunload",this.beforeUnload)},keyDown:function(t){27==t.keyCode&&this.$el.querySelector(".fa-chevron-left").click()},isMobile:function(){return videojs.browser.IS_IOS||videojs.browser.IS_ANDROID},showDatePicker:function(){t(this.$refs.datePicker.$el).focus()},updateDay:function(t){var e=this;this.$nextTick(function(){e.$router.replace({path:"/devices/playback/"+e.mode+"/"+e.devid+"/"+e.channel+"/"+t,query:Object.assign({},e.$route.query,{center:e.center,indistinct:e.indistinct})})})},nextTimeRange:function(){var t=(0,s.default)(this.day,"YYYYMMDD").endOf("day"),e=(0,s.default)().startOf("second");t.isAfter(e,"second")&&(t=e);var n=(0,s.default)(this.timerange[1]);if(n.isSameOrAfter(t,"second"))return!1;var o=(0,s.default)(this.timerange[1]).add(6,"hours");return o.isAfter(t)&&(o=t),!o.clone().startOf("minute").isSameOrBefore(n.clone().startOf("minute"),"second")&&(console.log(n.format("YY-MM-DD HH:mm:ss"),"~",o.format("YY-MM-DD HH:mm:ss"),"loading..."),this.timerange=[n.toDate(),o.toDate()],!0)},getRecords:function(e){var n=this;if(e&&(this.loading=!0,this.records=[],this.currentPage=1),!this.nextTimeRange())return this.sortRecords(),void this.$nextTick(function(){n.loading=!1});t.ajax("/api/v1/playback/recordlist",{type:"get",global:!1,data:{timeout:this.serverInfo.RecordListTimeout||5,serial:this.devid,code:this.channel,center:this.center,indistinct:this.indistinct,starttime:(0,s.default)(this.timerange[0]).format("YYYY-MM-DDTHH:mm:ss"),endtime:(0,s.default)(this.timerange[1]).format("YYYY-MM-DDTHH:mm:ss")}}).then(function(t){var e=t.RecordList||[];n.records=n.records.concat(e.filter(function(t){return!!(t&&t.StartTime&&t.EndTime)&&(!n.records.some(function(e){return e.StartTime===t.StartTime&&e.EndTime===t.EndTime})||(console.log("repeat",t.StartTime,"~",t.EndTime),!1))}))}).always(function(){n.$nextTick(function(){n.getRecords(!1)})})},formatName:function(t,e,n){return n||"-"},stopPlayback:function(){var e=this;return new Promise(function(n,o){if(!e.streamID)return void n();t.ajax({method:"POST",url:"/api/v1/playback/stop",global:!1,data:{streamid:e.streamID}}).always(function(){n()}),e.streamID=""})},beforeUnload:function(t){this.stopPlayback(),t.returnValue=""},downloadPlayback:function(e){var n=this;this.loading=!0,this.$set(e,"Starting",!0),t.post("/api/v1/playback/start",{serial:this.devid,code:this.channel,starttime:e.StartTime,endtime:e.EndTime,download:!0}).then(function(t){n.streamID=t.StreamID,n.$refs.playbackDownloadDlg.download(e.StartTime+" - "+e.EndTime,t.StreamID)}).always(function(){n.$nextTick(function(){n.loading=!1}),n.$delete(e,"Starting")})},startPlayback:function(e){var n=this;this.loading=!0,this.$set(e,"Starting",!0),t.post("/api/v1/playback/start",{serial:this.devid,code:this.channel,starttime:e.StartTime,endtime:e.EndTime}).then(function(t){n.streamID=t.StreamID;var o=t.HLS,r="HLS";switch(n.flvSupported()&&(r=n.isIE()?"WS_FLV":"FLV",o=n.isIE()?t.WS_FLV:t.FLV),String(n.serverInfo.PreferStreamFmt).toUpperCase()){case"WEBRTC":n.rtcSupported()&&(o=t.WEBRTC,r="WEBRTC");break;case"FLV":n.flvSupported()&&!n.isIE()&&(o=t.FLV,r="FLV");break;case"WS_FLV":case"WS-FLV":n.flvSupported()&&(o=t.WS_FLV,r="WS_FLV");break;case"HLS":o=t.HLS,r="HLS";break;case"RTMP":o=t.RTMP,r="RTMP"}var i="RTMP"==r?"":e.Snap||"";n.$refs.playbackVideoDlg.play(r,o,e.Name||e.DeviceID,i,t)}).always(function(){n.$nextTick(function(){n.loading=!1}),n.$delete(e,"Starting")})},toTimeView:function(){this.$router.replace({path:"/devices/playback/timebox/"+this.devid+"/"+this.channel+"/"+this.day,query:Object.assign({},this.$route.query,{center:this.center,indistinct:this.indistinct})})},sortChange:function(t){t&&t.prop&&t.order&&(this.sort=t.prop,this.order=t.order,this.sortRecords())},sortRecords:function(){var t=this;this.sort&&this.records.sort(function(e,n){var o=e[t.sort],r=n[t.sort],i=0;return o&&r?(o<r?i=-1:o>r&&(i=1),"desc"!==t.order&&"descending"!==t.order||(i*=-1),i):i})},onDownload:function(){this.streamID=""},onClose:function(){this.streamID=""}},mounted:function(){},beforeDestroy:function(){t(window).off("beforeunload",this.beforeUnload),this.stopPlayback()},beforeRouteLeave:function(t,e,n){this.stopPlayback(),n()},beforeRouteEnter:function(t,e,n){n(function(e){e.center=t.query.center,e.indistinct=t.query.indistinct,e.ready()})},beforeRouteUpdate:function(t,e,n){var o=this;if(!t.params.day)return void n({path:"/devices/playback/"+this.mode+"/"+t.params.devid+"/"+t.params.channel+"/"+(0,s.default)().format("YYYYMMDD"),query:Object.assign({},this.$route.query,{center:this.center,indistinct:this.indistinct}),replace:!0});this.center=t.query.center,this.indistinct=t.query.indistinct,this.stopPlayback(),n(),this.$nextTick(function(){o.loading||e.path==t.path||(console.log(o.devid,o.channel,o.day),o.getRecords(!0))})}}}).call(e,n("0iPh"))},pFnw:function(t,e,n){"use strict";var o,r,i,a="function"==typeof Symbol&&"symbol"==typeof Symbol.iterator?function(t){return typeof t}:function(t){return t&&"function"==typeof Symbol&&t.constructor===Symbol&&t!==Symbol.prototype?"symbol":typeof t};!function(a){r=[n("0iPh")],o=a,void 0!==(i="function"==typeof o?o.apply(e,r):o)&&(t.exports=i)}(function(t){var e=Array.prototype.slice,n=Array.prototype.splice,o={topSpacing:0,bottomSpacing:0,className:"sticky",wrapperClassName:"sticky-wrapper",center:!1,getWidthFrom:"",widthFromWrapper:!0,responsiveWidth:!1,zIndex:"auto"},r=t(window),i=t(document),s=[],l=r.height(),u=function(){for(var e=r.scrollTop(),n=i.height(),o=n-l,a=e>o?o-e:0,u=0,c=s.length;u<c;u++){var d=s[u],f=d.stickyWrapper.offset().top,v=f-d.topSpacing-a;if(d.stickyWrapper.css("height",d.stickyElement.outerHeight()),e<=v)null!==d.currentTop&&(d.stickyElement.css({width:"",position:"",top:"","z-index":""}),d.stickyElement.parent().removeClass(d.className),d.stickyElement.trigger("sticky-end",[d]),d.currentTop=null);else{var p=n-d.stickyElement.outerHeight()-d.topSpacing-d.bottomSpacing-e-a;if(p<0?p+=d.topSpacing:p=d.topSpacing,d.currentTop!==p){var h;d.getWidthFrom?h=t(d.getWidthFrom).width()||null:d.widthFromWrapper&&(h=d.stickyWrapper.width()),null==h&&(h=d.stickyElement.width()),d.stickyElement.css("width",h).css("position","fixed").css("top",p).css("z-index",d.zIndex),d.stickyElement.parent().addClass(d.className),null===d.currentTop?d.stickyElement.trigger("sticky-start",[d]):d.stickyElement.trigger("sticky-update",[d]),d.currentTop===d.topSpacing&&d.currentTop>p||null===d.currentTop&&p<d.topSpacing?d.stickyElement.trigger("sticky-bottom-reached",[d]):null!==d.currentTop&&p===d.topSpacing&&d.currentTop<p&&d.stickyElement.trigger("sticky-bottom-unreached",[d]),d.currentTop=p}var m=d.stickyWrapper.parent();d.stickyElement.offset().top+d.stickyElement.outerHeight()>=m.offset().top+m.outerHeight()&&d.stickyElement.offset().top<=d.topSpacing?d.stickyElement.css("position","absolute").css("top","").css("bottom",0).css("z-index",""):d.stickyElement.css("position","fixed").css("top",p).css("bottom","").css("z-index",d.zIndex)}}},c=function(){l=r.height();for(var e=0,n=s.length;e<n;e++){var o=s[e],i=null;o.getWidthFrom?o.responsiveWidth&&(i=t(o.getWidthFrom).width()):o.widthFromWrapper&&(i=o.stickyWrapper.width()),null!=i&&o.stickyElement.css("width",i)}},d={init:function(e){return this.each(function(){var n=t.extend({},o,e),r=t(this),i=r.attr("id"),a=i?i+"-wrapper":o.wrapperClassName,l=t("<div></div>").attr("id",a).addClass(n.wrapperClassName);r.wrapAll(function(){if(0==t(this).parent("#"+a).length)return l});var u=r.parent();n.center&&u.css({width:r.outerWidth(),marginLeft:"auto",marginRight:"auto"}),"right"===r.css("float")&&r.css({float:"none"}).parent().css({float:"right"}),n.stickyElement=r,n.stickyWrapper=u,n.currentTop=null,s.push(n),d.setWrapperHeight(this),d.setupChangeListeners(this)})},setWrapperHeight:function(e){var n=t(e),o=n.parent();o&&o.css("height",n.outerHeight())},setupChangeListeners:function(t){if(window.MutationObserver){new window.MutationObserver(function(e){(e[0].addedNodes.length||e[0].removedNodes.length)&&d.setWrapperHeight(t)}).observe(t,{subtree:!0,childList:!0})}else window.addEventListener?(t.addEventListener("DOMNodeInserted",function(){d.setWrapperHeight(t)},!1),t.addEventListener("DOMNodeRemoved",function(){d.setWrapperHeight(t)},!1)):window.attachEvent&&(t.attachEvent("onDOMNodeInserted",function(){d.setWrapperHeight(t)}),t.attachEvent("onDOMNodeRemoved",function(){d.setWrapperHeight(t)}))},update:function(){c(),u()},unstick:function(e){return this.each(function(){for(var e=this,o=t(e),r=-1,i=s.length;i-- >0;)s[i].stickyElement.get(0)===e&&(n.call(s,i,1),r=i);-1!==r&&(o.unwrap(),o.css({width:"",position:"",top:"",float:"","z-index":""}))})}};window.addEventListener?(window.addEventListener("scroll",u,!1),window.addEventListener("resize",c,!1)):window.attachEvent&&(window.attachEvent("onscroll",u),window.attachEvent("onresize",c)),t.fn.sticky=function(n){return d[n]?d[n].apply(this,e.call(arguments,1)):"object"!==(void 0===n?"undefined":a(n))&&n?void t.error("Method "+n+" does not exist on jQuery.sticky"):d.init.apply(this,arguments)},t.fn.unstick=function(n){return d[n]?d[n].apply(this,e.call(arguments,1)):"object"!==(void 0===n?"undefined":a(n))&&n?void t.error("Method "+n+" does not exist on jQuery.sticky"):d.unstick.apply(this,arguments)},t(function(){setTimeout(u,0)})})},qDl4:function(t,e,n){"use strict";Object.defineProperty(e,"__esModule",{value:!0});var o=n("JRgq"),r=n.n(o);for(var i in o)["default","default"].indexOf(i)<0&&function(t){n.d(e,t,function(){return o[t]})}(i);var a=n("JCFA"),s=n("VU/8"),l=s(r.a,a.a,!1,null,null,null);e.default=l.exports},qb8i:function(t,e,n){"use strict";Object.defineProperty(e,"__esModule",{value:!0}),n("F8S1");var o=n("0iPh"),r=function(t){return t&&t.__esModule?t:{default:t}}(o);e.default={props:{title:{default:"上传"},url:{type:String,default:""},multiple:{type:Boolean,default:!1},drag:{type:Boolean,default:!0},showFileList:{type:Boolean,default:!0},accept:{default:".xlsx"},tip:{default:"仅限上传.xlsx"},limit:{type:Number,default:0},autoUpload:{type:Boolean,default:!0}},data:function(){return{bShow:!1,bProgress:!1}},mounted:function(){var t=this;(0,r.default)(this.$el).find(".modal-content").draggable({handle:".modal-header",cancel:".modal-title span",addClasses:!1,containment:"document",delay:100,opacity:.5}),(0,r.default)(this.$el).on("shown.bs.modal",function(){t.$emit("show"),t.bShow=!0}).on("hidden.bs.modal",function(){t.$emit("hide"),t.bShow=!1,t.$refs.uploader.clearFiles()}).on("hide.bs.modal",function(){if(t.bProgress)return t.$message({type:"error",message:"正在上传, 请稍后..."}),!1})},methods:{show:function(){(0,r.default)(this.$el).modal("show")},hide:function(){(0,r.default)(this.$el).modal("hide")},beforeUpload:function(t){return!(this.limit&&t.size>this.limit)||(this.$message({type:"error",message:"文件["+t.name+"]大小超过限制["+this.limit+"], 不能上传!"}),!1)},onSuccess:function(t,e,n){var o=!0,r=!1,i=void 0;try{for(var a,s=n[Symbol.iterator]();!(o=(a=s.next()).done);o=!0){var l=a.value;if(100!=l.percentage&&0!==l.percentage)return void(this.bProgress=!0)}}catch(t){r=!0,i=t}finally{try{!o&&s.return&&s.return()}finally{if(r)throw i}}this.bProgress=!1,this.$emit("uploaded",e)},onProgress:function(t,e,n){this.bProgress=!0},onError:function(t,e,n){t&&this.$message({type:"error",message:t+""});var o=!0,r=!1,i=void 0;try{for(var a,s=n[Symbol.iterator]();!(o=(a=s.next()).done);o=!0){var l=a.value;if(100!=l.percentage&&0!==l.percentage)return void(this.bProgress=!0)}}catch(t){r=!0,i=t}finally{try{!o&&s.return&&s.return()}finally{if(r)throw i}}this.bProgress=!1},onRemove:function(t,e){var n=!0,o=!1,r=void 0;try{for(var i,a=e[Symbol.iterator]();!(n=(i=a.next()).done);n=!0){var s=i.value;if(100!=s.percentage&&0!==s.percentage)return void(this.bProgress=!0)}}catch(t){o=!0,r=t}finally{try{!n&&a.return&&a.return()}finally{if(o)throw r}}this.bProgress=!1}}}},ql7U:function(t,e,n){var o=n("d155");"string"==typeof o&&(o=[[t.i,o,""]]),o.locals&&(t.exports=o.locals);n("rjj0")("d4a0308e",o,!0,{})},rF1v:function(t,e,n){e=t.exports=n("FZ+f")(!1),e.push([t.i,".upload-dlg .modal-dialog .modal-body{overflow:hidden}.upload-dlg .modal-dialog .modal-body .el-upload{display:block}.upload-dlg .modal-dialog .modal-body .el-upload .el-upload-dragger{margin:0 auto}",""])},sJmG:function(t,e,n){"use strict";Object.defineProperty(e,"__esModule",{value:!0});var o=n("2xaA"),r=n.n(o);for(var i in o)["default","default"].indexOf(i)<0&&function(t){n.d(e,t,function(){return o[t]})}(i);var a=n("6Exk"),s=n("VU/8"),l=s(r.a,a.a,!1,null,null,null);e.default=l.exports},tHoF:function(t,e,n){"use strict";var o=function(){var t=this,e=t.$createElement,n=t._self._c||e;return n("FormDlg",{ref:"dlg",attrs:{title:"编辑通道",disabled:t.errors.any()},on:{hide:t.onHide,show:t.onShow,submit:t.onSubmit}},[n("div",{class:{"form-group":!0,"has-error":t.errors.has("serial")}},[n("label",{staticClass:"col-sm-4 control-label",attrs:{for:"input-serial"}},[t._v("设备编号\n            "),n("span",{staticClass:"text-red"},[t._v("*")])]),t._v(" "),n("div",{staticClass:"col-sm-7"},[n("input",{staticClass:"form-control",attrs:{type:"text",readonly:"readonly",id:"input-serial",name:"serial","data-vv-as":"设备编号"},domProps:{value:t.form.serial}})])]),t._v(" "),n("div",{class:{"form-group":!0,"has-error":t.errors.has("code")}},[n("label",{staticClass:"col-sm-4 control-label",attrs:{for:"input-code"}},[t._v("通道编号\n            "),n("span",{staticClass:"text-red"},[t._v("*")])]),t._v(" "),n("div",{staticClass:"col-sm-7"},[n("input",{staticClass:"form-control",attrs:{type:"text",readonly:"readonly",id:"input-code",name:"code","data-vv-as":"通道编号"},domProps:{value:t.form.code}})])]),t._v(" "),"标准版"!==t.serverInfo.VersionType?n("div",{class:{"form-group":!0,"has-error":t.errors.has("custom_id")}},[n("label",{staticClass:"col-sm-4 control-label",attrs:{for:"input-custom-id"}},[t._v("自定义通道编号\n        ")]),t._v(" "),n("div",{staticClass:"col-sm-7"},[n("input",{directives:[{name:"model",rawName:"v-model.trim",value:t.form.customID,expression:"form.customID",modifiers:{trim:!0}}],staticClass:"form-control",attrs:{type:"text",id:"input-custom-id",name:"custom_id",placeholder:"可选, 用于级联","data-vv-as":"自定义通道编号",oninput:"value=value.replace(/[^\\d]/g,'')"},domProps:{value:t.form.customID},on:{keydown:function(e){if(!("button"in e)&&t._k(e.keyCode,"enter",13,e.key))return null;e.preventDefault(),t.$el.querySelector("#input-name").focus()},input:function(e){e.target.composing||t.$set(t.form,"customID",e.target.value.trim())},blur:function(e){t.$forceUpdate()}}})])]):t._e(),t._v(" "),n("div",{class:{"form-group":!0,"has-error":t.errors.has("name")}},[n("label",{staticClass:"col-sm-4 control-label",attrs:{for:"input-name"}},[t._v("自定义名称\n        ")]),t._v(" "),n("div",{staticClass:"col-sm-7"},[n("div",{staticClass:"input-group",staticStyle:{width:"100%"}},[n("input",{directives:[{name:"model",rawName:"v-model.trim",value:t.form.customName,expression:"form.customName",modifiers:{trim:!0}}],staticClass:"form-control",attrs:{type:"text",id:"input-name",name:"name",placeholder:t.form.name||"-","data-vv-as":"自定义名称"},domProps:{value:t.form.customName},on:{keydown:function(e){if(!("button"in e)&&t._k(e.keyCode,"enter",13,e.key))return null;e.preventDefault(),t.$el.querySelector("#input-description").focus()},input:function(e){e.target.composing||t.$set(t.form,"customName",e.target.value.trim())},blur:function(e){t.$forceUpdate()}}}),t._v(" "),n("span",{directives:[{name:"show",rawName:"v-show",value:t.form.customName||t.form.name,expression:"form.customName || form.name"}],staticClass:"input-group-btn"},[n("button",{directives:[{name:"clipboard",rawName:"v-clipboard",value:t.form.customName||t.form.name,expression:"form.customName || form.name"}],staticClass:"btn btn-default",staticStyle:{"border-top-right-radius":"0","border-bottom-right-radius":"0"},attrs:{type:"button",title:t.form.customName?"拷贝自定义名称":"拷贝原名称"},on:{success:function(e){t.$message({type:"success",message:"成功拷贝到粘贴板"})}}},[n("i",{staticClass:"fa fa-copy"})])])])])]),t._v(" "),n("div",{class:{"form-group":!0,"has-error":t.errors.has("description")}},[n("label",{staticClass:"col-sm-4 control-label",attrs:{for:"input-description"}},[t._v("备注\n        ")]),t._v(" "),n("div",{staticClass:"col-sm-7"},[n("el-input",{attrs:{id:"input-description",type:"textarea",autosize:{minRows:3,maxRows:10},rows:3,placeholder:"可选"},model:{value:t.form.description,callback:function(e){t.$set(t.form,"description","string"==typeof e?e.trim():e)},expression:"form.description"}})],1)])])},r=[],i={render:o,staticRenderFns:r};e.a=i},tS9P:function(t,e,n){e=t.exports=n("FZ+f")(!1),e.push([t.i,".time-rule[data-v-4b1fba7c]{overflow:hidden;position:relative;height:50px;margin:0 auto;width:100%;font-size:12px;max-width:1440px;background-color:#ccc}.time-day[data-v-4b1fba7c]{position:absolute;left:0;top:0;height:100%;width:1440px;cursor:pointer;-ms-user-select:none;-webkit-user-select:none;-moz-user-select:none;user-select:none}.time-minute[data-v-4b1fba7c]{float:left;height:8px;margin:0;cursor:default}.time-minute.active[data-v-4b1fba7c]{background-color:#404d59;cursor:pointer}.time-text-first[data-v-4b1fba7c],.time-text[data-v-4b1fba7c]{float:left;width:60px;border-left:1px solid #999;border-top:1px solid #999;-ms-user-select:none;-webkit-user-select:none;-moz-user-select:none;user-select:none;text-align:center;height:25px;line-height:25px}.time-text-first[data-v-4b1fba7c]{border-left:0}.time-cursor[data-v-4b1fba7c]{position:absolute;left:0;top:0;height:30px;width:2px;background-color:red;text-align:center}.time-cursor-text[data-v-4b1fba7c]{position:absolute;padding:0 5px;width:60px;left:-30px;top:30px;border:1px solid red;height:15px;line-height:15px;cursor:move;background-color:#fff;-ms-user-select:none;-webkit-user-select:none;-moz-user-select:none;user-select:none}",""])},toVl:function(t,e,n){"use strict";(function(t){function o(t){return t&&t.__esModule?t:{default:t}}function r(t){return function(){var e=t.apply(this,arguments);return new Promise(function(t,n){function o(r,i){try{var a=e[r](i),s=a.value}catch(t){return void n(t)}if(!a.done)return Promise.resolve(s).then(function(t){o("next",t)},function(t){o("throw",t)});t(s)}return o("next")})}}Object.defineProperty(e,"__esModule",{value:!0});var i=Object.assign||function(t){for(var e=1;e<arguments.length;e++){var n=arguments[e];for(var o in n)Object.prototype.hasOwnProperty.call(n,o)&&(t[o]=n[o])}return t},a=n("M4fF"),s=(o(a),n("PJh5")),l=o(s),u=n("WEd6"),c=o(u),d=n("+ABx"),f=o(d),v=n("S4Bz"),p=o(v),h=n("ENv+"),m=o(h),g=n("eAMf"),b=o(g),y=n("NYxO");e.default={props:{devid:{type:String,default:""},channel:{type:String,default:""},mode:{type:String,default:"timebox"},day:{type:String,default:function(){return(0,l.default)().format("YYYYMMDD")}}},data:function(){return{timerange:[(0,l.default)(this.day,"YYYYMMDD").startOf("hour").toDate(),(0,l.default)(this.day,"YYYYMMDD").startOf("hour").toDate()],videoLoading:!1,loading:!1,center:"",indistinct:"",records:[],currentTime:null,videos:[],video:null,protocol:"",videoUrl:"",streamID:"",osd:"",touchTimer:0,switchTimer:0,speed:1,mediaInfo:null,bOutHevcTip:!1,bDownloading:!1,bShared:!1,bAudioEnable:!1}},computed:i({},(0,y.mapState)(["userInfo","serverInfo"]),{shareURL:function(){return this.bShared||!1===this.serverInfo.APIAuth||this.serverInfo.DemoUser?"/playback.html?type=device&serial="+this.devid+"&code="+this.channel+"&day="+this.day:""}}),components:{DatePicker:c.default,LivePlayer:b.default,TimeRule:f.default,TimeRangeDlg:p.default,PlaybackDownloadDlg:m.default},methods:{ready:function(){this.$watch("day",function(t,e){this.timerange=[(0,l.default)(this.day,"YYYYMMDD").startOf("hour").toDate(),(0,l.default)(this.day,"YYYYMMDD").startOf("hour").toDate()]}),this.$watch("center",function(t,e){this.timerange=[(0,l.default)(this.day,"YYYYMMDD").startOf("hour").toDate(),(0,l.default)(this.day,"YYYYMMDD").startOf("hour").toDate()]}),this.$watch("indistinct",function(t,e){this.timerange=[(0,l.default)(this.day,"YYYYMMDD").startOf("hour").toDate(),(0,l.default)(this.day,"YYYYMMDD").startOf("hour").toDate()]}),this.$watch("video",function(t,e){t&&t!=e?this.startPlayback():this.stopPlayback()});var e=(0,l.default)(),n=e.diff(e.clone().startOf("day"),"minutes");n-=10,n<0&&(n=0),this.$refs.timeRule.clickMinute(n),console.log(this.devid,this.channel,this.day),this.getRecords(!0),t(window).on("beforeunload",this.beforeUnload)},keyDown:function(t){27==t.keyCode&&this.$el.querySelector(".fa-chevron-left").click()},isMobile:function(){return videojs.browser.IS_IOS||videojs.browser.IS_ANDROID},showDatePicker:function(){t(this.$refs.datePicker.$el).focus()},updateDay:function(t){var e=this;this.$nextTick(function(){e.$router.replace({path:"/devices/playback/"+e.mode+"/"+e.devid+"/"+e.channel+"/"+t,query:Object.assign({},e.$route.query,{center:e.center,indistinct:e.indistinct})})})},nextTimeRange:function(){var t=(0,l.default)(this.day,"YYYYMMDD").endOf("day"),e=(0,l.default)().startOf("second");t.isAfter(e,"second")&&(t=e);var n=(0,l.default)(this.timerange[1]);if(n.isSameOrAfter(t,"second"))return!1;var o=(0,l.default)(this.timerange[1]).add(6,"hours");return o.isAfter(t)&&(o=t),!o.clone().startOf("minute").isSameOrBefore(n.clone().startOf("minute"),"second")&&(console.log(n.format("YY-MM-DD HH:mm:ss"),"~",o.format("YY-MM-DD HH:mm:ss"),"loading..."),this.timerange=[n.toDate(),o.toDate()],!0)},getRecords:function(e){var n=this;if(e&&(this.loading=!0,this.records=[]),!this.nextTimeRange())return this.videos=this.records,void this.$nextTick(function(){n.loading=!1});t.ajax({method:"GET",url:"/api/v1/playback/recordlist",global:!1,data:{timeout:this.serverInfo.RecordListTimeout||5,serial:this.devid,code:this.channel,center:this.center,indistinct:this.indistinct,starttime:(0,l.default)(this.timerange[0]).format("YYYY-MM-DDTHH:mm:ss"),endtime:(0,l.default)(this.timerange[1]).format("YYYY-MM-DDTHH:mm:ss")}}).then(function(t){n.bShared=!!t.Shared;var e=t.RecordList||[];n.records=n.records.concat(e.filter(function(t){return!!(t&&t.StartTime&&t.EndTime)&&(!n.records.some(function(e){return e.StartTime===t.StartTime&&e.EndTime===t.EndTime})||(console.log("repeat",t.StartTime,"~",t.EndTime),!1))}))}).always(function(){n.$nextTick(function(){n.getRecords(!1)})})},stopPlayback:function(){var e=this;return new Promise(function(n,o){if(e.switchTimer&&(clearTimeout(e.switchTimer),e.switchTimer=0),e.bOutHevcTip=!1,e.bAudioEnable=!1,e.videoUrl="",e.osd="",e.touchTimer&&(clearInterval(e.touchTimer),e.touchTimer=0),!e.streamID)return void n();t.ajax({method:"POST",url:"/api/v1/playback/stop",global:!1,data:{streamid:e.streamID}}).always(function(){n()}),e.streamID=""})},beforeUnload:function(t){this.stopPlayback(),t.returnValue=""},startPlayback:function(){var e=this;return r(regeneratorRuntime.mark(function n(){return regeneratorRuntime.wrap(function(n){for(;;)switch(n.prev=n.next){case 0:if(e.video){n.next=2;break}return n.abrupt("return");case 2:return e.videoLoading=!0,n.next=5,e.stopPlayback();case 5:t.post("/api/v1/playback/start",{serial:e.devid,code:e.channel,starttime:e.video.StartTime,endtime:e.video.EndTime}).then(function(t){var n="HLS",o=t.HLS;switch(e.flvSupported()&&(o=e.isIE()?t.WS_FLV:t.FLV,n=e.isIE()?"WS_FLV":"FLV"),String(e.serverInfo.PreferStreamFmt).toUpperCase()){case"WEBRTC":e.rtcSupported()&&(o=t.WEBRTC,n="WEBRTC");break;case"FLV":e.flvSupported()&&!e.isIE()&&(o=t.FLV,n="FLV");break;case"WS_FLV":case"WS-FLV":e.flvSupported()&&(o=t.WS_FLV,n="WS_FLV");break;case"HLS":o=t.HLS,n="HLS";break;case"RTMP":o=t.RTMP,n="RTMP"}e.streamID=t.StreamID,e.bAudioEnable=!!t.AudioEnable,e.osd=t.ChannelOSD||"",e.protocol=n,e.videoUrl=o,e.speed>0&&1!=e.speed&&e.scaleOnStart()}).always(function(){e.videoLoading=!1});case 6:case"end":return n.stop()}},n,e)}))()},downloadPlayback:function(e,n){var o=this;return r(regeneratorRuntime.mark(function r(){return regeneratorRuntime.wrap(function(r){for(;;)switch(r.prev=r.next){case 0:return o.bDownloading=!0,r.next=3,o.stopPlayback();case 3:t.post("/api/v1/playback/start",{serial:o.devid,code:o.channel,starttime:e,endtime:n,download:!0,download_speed:o.speed}).then(function(t){o.streamID=t.StreamID,o.$refs.playbackDownloadDlg.download(e+" - "+n,t.StreamID)}).always(function(){o.bDownloading=!1});case 4:case"end":return r.stop()}},r,o)}))()},toListView:function(){this.$router.replace({path:"/devices/playback/list/"+this.devid+"/"+this.channel+"/"+this.day,query:Object.assign({},this.$route.query,{center:this.center,indistinct:this.indistinct})})},onTimeChange:function(t){this.video=t},onMediaInfo:function(t){this.mediaInfo=t},onEnded:function(t){this.mediaInfo=null},onVideoError:function(t){if("MediaError"==t&&(this.mediaInfo&&String(this.mediaInfo.videoCodec).startsWith("hvc")||"HLS"==this.protocol)&&flvjs.getFeatureList()&&!flvjs.getFeatureList().nativeMP4H265Playback&&(this.bOutHevcTip=!0,console.log("提示: 正在播放 H265 直出流, 确保浏览器版本较新, 并且开启硬件加速")),this.video){var e=(0,l.default)(this.video.EndTime,"YYYY-MM-DDTHH:mm:ss");if(e.format("YYYYMMDD")==this.day){console.log("switch error",t);for(var n=1;n<1440;n++){var o=e.clone().add(n,"minutes");if(!o.isSame(e,"day")){this.video=null;break}var r=o.diff(o.clone().startOf("day"),"minutes");if(this.$refs.timeRule.minuteActiveClass(r)){this.$refs.timeRule.clickMinute(r);break}}}}},onVideoTimeUpdate:function(t){var e=this;if(!(t<1)&&this.video){var n=(0,l.default)(this.video.StartTime,"YYYY-MM-DDTHH:mm:ss"),o=n.clone().add(t,"seconds"),r=o.diff(o.clone().startOf("day"),"minutes");this.$refs.timeRule.clickMinute(r,!1);var i=(0,l.default)(this.video.EndTime,"YYYY-MM-DDTHH:mm:ss"),a=i.diff(o,"seconds");a<3&&!this.switchTimer&&i.format("YYYYMMDD")==this.day&&(console.log("switch timer",a),this.switchTimer=setTimeout(function(){for(var t=1;t<1440;t++){var n=i.clone().add(t,"minutes");if(!n.isSame(i,"day")){e.video=null;break}var o=n.diff(n.clone().startOf("day"),"minutes");if(e.$refs.timeRule.minuteActiveClass(o)){e.$refs.timeRule.clickMinute(o);break}}},3e3))}},onSpeedClick:function(e){var n=parseFloat(t(e.target).data("speed"));n>0&&this.speed!=n&&(this.streamID?this.scale(n):this.speed=n)},scale:function(){var e=this,n=arguments.length>0&&void 0!==arguments[0]?arguments[0]:1;this.streamID&&t.post("/api/v1/playback/control",{streamid:this.streamID,command:"scale",scale:n}).then(function(t){e.speed=n,e.$message({type:"success",message:n+" 倍速设置成功"})})},scaleOnStart:function(){this.streamID&&t.ajax({method:"POST",url:"/api/v1/playback/control",global:!1,data:{streamid:this.streamID,command:"scale",scale:this.speed}})},showTimeRangeDlg:function(){var t=(0,l.default)().second(0).subtract(30,"minutes"),e=(0,l.default)().second(0);t.isSame(e,"day")||(t=e.clone().startOf("day"));var n=this.$refs.timeRangeDlg;n.setDefault([t.toDate(),e.toDate()]),n.show()},onTimeRangeChange:function(t){if(t&&2==t.length){var e=""+this.day+t[0],n=""+this.day+t[1],o=(0,l.default)(e,"YYYYMMDDHHmmss"),r=(0,l.default)(n,"YYYYMMDDHHmmss");if(o.isBefore(r)){for(var i=!1,a=o.clone();a.isSameOrBefore(r);a=a.add(1,"minute")){var s=a.unix();s-=s%(60*(this.serverInfo.MinutesPerUnit||5));var u=l.default.unix(s).format("HH:mm");if(this.$refs.timeRule.activeMinutes[u]){i=!0;break}}return i?(this.$refs.timeRangeDlg.hide(),void this.downloadPlayback(o.format("YYYY-MM-DDTHH:mm:ss"),r.format("YYYY-MM-DDTHH:mm:ss"))):void this.$message({type:"error",message:o.format("HH:mm:ss")+" - "+r.format("HH:mm:ss")+" 没有录像"})}}this.$message({type:"error",message:"无效的时间段"})},onDownload:function(){this.streamID=""}},mounted:function(){t(document).on("click","#input-speed-menu a",this.onSpeedClick)},beforeDestroy:function(){t(window).off("beforeunload",this.beforeUnload),t(document).off("click","#input-speed-menu a",this.onSpeedClick),this.stopPlayback()},beforeRouteLeave:function(t,e,n){this.stopPlayback(),n()},beforeRouteEnter:function(t,e,n){n(function(e){e.center=t.query.center,e.indistinct=t.query.indistinct,e.ready()})},beforeRouteUpdate:function(t,e,n){var o=this;if(!t.params.day)return void n({path:"/devices/playback/"+this.mode+"/"+t.params.devid+"/"+t.params.channel+"/"+(0,l.default)().format("YYYYMMDD"),query:Object.assign({},this.$route.query,{center:this.center,indistinct:this.indistinct}),replace:!0});this.center=t.query.center,this.indistinct=t.query.indistinct,this.stopPlayback(),n(),this.$nextTick(function(){o.loading||e.path==t.path||(console.log(o.devid,o.channel,o.day),o.getRecords(!0))})}}}).call(e,n("0iPh"))},tviW:function(t,e,n){"use strict";function o(t){return t&&t.__esModule?t:{default:t}}function r(t){return function(){var e=t.apply(this,arguments);return new Promise(function(t,n){function o(r,i){try{var a=e[r](i),s=a.value}catch(t){return void n(t)}if(!a.done)return Promise.resolve(s).then(function(t){o("next",t)},function(t){o("throw",t)});t(s)}return o("next")})}}Object.defineProperty(e,"__esModule",{value:!0});var i=n("A9K1"),a=o(i),s=n("0iPh"),l=o(s);e.default={props:{useSeparateDevicePassword:{type:Boolean,default:!1}},data:function(){return{form:this.defForm(),smsList:[],smsGroupList:[],dropChannelTypes:[],innerChannelTypes:{134:"报警输入",135:"报警输出",136:"语音输入",137:"语音输出",200:"中心信令",215:"业务分组",216:"虚拟组织"},innerStreamModes:{"":"自动选择","stream:0":"主码流","stream:1":"子码流","streamnumber:0":"主码流(2022)","streamnumber:1":"子码流(2022)","streamprofile:0":"主码流","streamprofile:1":"子码流","streamMode:MAIN":"主码流","streamMode:SUB":"子码流"},debug:!1,showSubscribeInterval:!1,showPosition:!1}},components:{FormDlg:a.default},beforeDestroy:function(){(0,l.default)(this.$el).off("keydown",this.keyDown)},computed:{alertMsg:function(){var t=[];this.form.stream_mode&&t.push("码流索引, 需要下级支持");var e="";return t.length>0&&(e=t.join(", ")),e}},methods:{defForm:function(){return{serial:"",name:"",custom_name:"",media_transport:"UDP",media_transport_mode:"passive",stream_mode:"",sms_id:"",sms_group_id:"",recv_stream_ip:"",contact_ip:"",charset:"",catalog_interval:3600,subscribe_interval:0,catalog_subscribe:!1,alarm_subscribe:!1,position_subscribe:!1,ptz_subscribe:!1,record_center:!1,record_indistinct:!1,civil_code_first:!1,keep_original_tree:!1,password:"",drop_channel_type:"",longitude:0,latitude:0}},onHide:function(){this.debug=!1,this.showSubscribeInterval=!1,this.showPosition=!1,this.form=this.defForm(),this.dropChannelTypes=[],this.$emit("hide"),(0,l.default)(this.$el).off("keydown",this.keyDown)},onShow:function(){this.errors.clear(),this.$emit("show"),(0,l.default)(this.$el).on("keydown",this.keyDown)},onPasteLngLat:function(t){try{var e=t.clipboardData.getData("Text").trim().split(/\s*,\s*/);2==e.length&&(this.form.longitude=e[0],this.form.latitude=e[1],t.preventDefault())}catch(t){console.log(t)}},keyDown:function(t){if(t.altKey&&t.shiftKey)switch(t.key){case"D":t.preventDefault(),this.toggleDebug()}},toggleDebug:function(){this.debug=!this.debug},onMediaTransportChange:function(t){"UDP"==t&&(this.form.media_transport_mode="passive")},fetchSMSList:function(){return new Promise(function(t,e){l.default.get("/api/v1/sms/list").then(function(e){t(e.map(function(t){return{id:t.Serial||"",name:t.Serial||"",group:t.GroupID||""}}))}).fail(function(){t([])})})},onSubmit:function(){var t=this;return r(regeneratorRuntime.mark(function e(){var n,o,r;return regeneratorRuntime.wrap(function(e){for(;;)switch(e.prev=e.next){case 0:return e.next=2,t.$validator.validateAll();case 2:if(n=e.sent){e.next=8;break}return o=t.errors.items[0],t.$message({type:"error",message:o.msg}),(0,l.default)("[name="+o.field+"]").focus(),e.abrupt("return");case 8:r=Object.assign({},t.form),r.drop_channel_type=t.dropChannelTypes.join(","),delete r.name,r.longitude||(r.longitude=0),r.latitude||(r.latitude=0),l.default.post("/api/v1/device/setinfo",r).then(function(e){t.$refs.dlg.hide(),t.$emit("submit")});case 14:case"end":return e.stop()}},e,t)}))()},show:function(t){var e=this;return r(regeneratorRuntime.mark(function n(){var o,r,i,a,s,l,u,c,d,f;return regeneratorRuntime.wrap(function(n){for(;;)switch(n.prev=n.next){case 0:return e.errors.clear(),t&&Object.assign(e.form,t),e.form.drop_channel_type&&(e.dropChannelTypes=e.form.drop_channel_type.split(",")),e.form.subscribe_interval<0&&(e.showSubscribeInterval=!0),(e.form.longitude||e.form.latitude)&&(e.showPosition=!0),o=[],n.next=8,e.fetchSMSList();case 8:for(r=n.sent,i=!0,a=!1,s=void 0,n.prev=12,l=r[Symbol.iterator]();!(i=(u=l.next()).done);i=!0)c=u.value,c.group&&!o.some(function(t){return t.id==c.group})&&o.push({id:c.group,name:c.group});n.next=20;break;case 16:n.prev=16,n.t0=n.catch(12),a=!0,s=n.t0;case 20:n.prev=20,n.prev=21,!i&&l.return&&l.return();case 23:if(n.prev=23,!a){n.next=26;break}throw s;case 26:return n.finish(23);case 27:return n.finish(20);case 28:d=e.form.sms_id,d&&!r.some(function(t){return t.id==d})&&r.push({id:d,name:d+"(not found)"}),f=e.form.sms_group_id,f&&!o.some(function(t){return t.id==f})&&o.push({id:f,name:f+"(not found)"}),e.smsList=r,e.smsGroupList=o,e.form.subscribe_interval||(e.form.subscribe_interval=""),e.form.longitude||(e.form.longitude=""),e.form.latitude||(e.form.latitude=""),e.$nextTick(function(){e.$refs.dlg.show()});case 38:case"end":return n.stop()}},n,e,[[12,16,20,28],[21,,23,27]])}))()}}}},"u/lO":function(t,e,n){"use strict";var o=function(){var t=this,e=t.$createElement;return(t._self._c||e)("input",{attrs:{type:"text",placeholder:t.placeholder}})},r=[],i={render:o,staticRenderFns:r};e.a=i},wPWL:function(t,e,n){"use strict";var o=function(){var t=this,e=t.$createElement,n=t._self._c||e;return n("FormDlg",{ref:"dlg",attrs:{title:"编辑设备",disabled:t.errors.any(),size:"modal-lgg"},on:{hide:t.onHide,show:t.onShow,submit:t.onSubmit}},[n("div",{staticClass:"col-md-6"},[n("div",{class:{"form-group":!0,"has-error":t.errors.has("serial")}},[n("label",{staticClass:"col-sm-4 control-label",attrs:{for:"input-serial"}},[t._v("设备编号\n                "),n("span",{staticClass:"text-red"},[t._v("*")])]),t._v(" "),n("div",{staticClass:"col-sm-7"},[n("input",{directives:[{name:"model",rawName:"v-model.trim",value:t.form.serial,expression:"form.serial",modifiers:{trim:!0}},{name:"validate",rawName:"v-validate",value:"required",expression:"'required'"}],staticClass:"form-control",attrs:{type:"text",readonly:"readonly",id:"input-serial",name:"serial","data-vv-as":"设备编号"},domProps:{value:t.form.serial},on:{input:function(e){e.target.composing||t.$set(t.form,"serial",e.target.value.trim())},blur:function(e){t.$forceUpdate()}}})])]),t._v(" "),n("div",{class:{"form-group":!0,"has-error":t.errors.has("name")}},[n("label",{staticClass:"col-sm-4 control-label",attrs:{for:"input-name"}},[t._v("自定义名称\n            ")]),t._v(" "),n("div",{staticClass:"col-sm-7"},[n("div",{staticClass:"input-group",staticStyle:{width:"100%"}},[n("input",{directives:[{name:"model",rawName:"v-model.trim",value:t.form.custom_name,expression:"form.custom_name",modifiers:{trim:!0}}],staticClass:"form-control",attrs:{type:"text",id:"input-name",name:"name",placeholder:t.form.name||"-","data-vv-as":"自定义名称"},domProps:{value:t.form.custom_name},on:{keydown:function(e){if(!("button"in e)&&t._k(e.keyCode,"enter",13,e.key))return null;t.$el.querySelector("#input-password").focus()},input:function(e){e.target.composing||t.$set(t.form,"custom_name",e.target.value.trim())},blur:function(e){t.$forceUpdate()}}}),t._v(" "),n("span",{directives:[{name:"show",rawName:"v-show",value:t.form.custom_name||t.form.name,expression:"form.custom_name || form.name"}],staticClass:"input-group-btn"},[n("button",{directives:[{name:"clipboard",rawName:"v-clipboard",value:t.form.custom_name||t.form.name,expression:"form.custom_name || form.name"}],staticClass:"btn btn-default",staticStyle:{"border-top-right-radius":"0","border-bottom-right-radius":"0"},attrs:{type:"button",title:t.form.custom_name?"拷贝自定义名称":"拷贝原名称"},on:{success:function(e){t.$message({type:"success",message:"成功拷贝到粘贴板"})}}},[n("i",{staticClass:"fa fa-copy"})])])])])]),t._v(" "),n("div",{directives:[{name:"show",rawName:"v-show",value:t.useSeparateDevicePassword,expression:"useSeparateDevicePassword"}],class:{"form-group":!0,"has-error":t.errors.has("password")}},[n("label",{staticClass:"col-sm-4 control-label",attrs:{for:"input-password"}},[t._v("接入密码\n            ")]),t._v(" "),n("div",{staticClass:"col-sm-7"},[n("input",{directives:[{name:"model",rawName:"v-model.trim",value:t.form.password,expression:"form.password",modifiers:{trim:!0}}],staticClass:"form-control",attrs:{type:"text",id:"input-password",name:"password",placeholder:"默认使用 livecms.ini > sip > device_password","data-vv-as":"接入密码"},domProps:{value:t.form.password},on:{keydown:function(e){if(!("button"in e)&&t._k(e.keyCode,"enter",13,e.key))return null;t.$el.querySelector("#input-contact-ip").focus()},input:function(e){e.target.composing||t.$set(t.form,"password",e.target.value.trim())},blur:function(e){t.$forceUpdate()}}})])]),t._v(" "),n("div",{class:{"form-group":!0,"has-error":t.errors.has("contact_ip")}},[n("label",{staticClass:"col-sm-4 control-label",attrs:{for:"input-contact-ip"}},[t._v("信令联络 IP\n            ")]),t._v(" "),n("div",{staticClass:"col-sm-7"},[n("input",{directives:[{name:"model",rawName:"v-model.trim",value:t.form.contact_ip,expression:"form.contact_ip",modifiers:{trim:!0}}],staticClass:"form-control",attrs:{type:"text",id:"input-contact-ip",name:"contact_ip",placeholder:"默认使用 livecms.ini > sip > host","data-vv-as":"信令联络 IP"},domProps:{value:t.form.contact_ip},on:{keydown:function(e){if(!("button"in e)&&t._k(e.keyCode,"enter",13,e.key))return null;t.$el.querySelector("#input-recv-stream-ip").focus()},input:function(e){e.target.composing||t.$set(t.form,"contact_ip",e.target.value.trim())},blur:function(e){t.$forceUpdate()}}})])]),t._v(" "),n("div",{class:{"form-group":!0,"has-error":t.errors.has("sms_id")}},[n("label",{staticClass:"col-sm-4 control-label",attrs:{for:"input-sms-id"}},[t._v("SMS ID\n            ")]),t._v(" "),n("div",{staticClass:"col-sm-7"},[n("select",{directives:[{name:"model",rawName:"v-model.trim",value:t.form.sms_id,expression:"form.sms_id",modifiers:{trim:!0}},{name:"validate",rawName:"v-validate"}],staticClass:"form-control",attrs:{id:"input-sms-id",name:"sms_id","data-vv-as":"SMS"},on:{change:function(e){var n=Array.prototype.filter.call(e.target.options,function(t){return t.selected}).map(function(t){return"_value"in t?t._value:t.value});t.$set(t.form,"sms_id",e.target.multiple?n:n[0])}}},[n("option",{attrs:{value:""}},[t._v("自动选择负载最小")]),t._v(" "),t._l(t.smsList,function(e,o){return n("option",{key:o,domProps:{value:e.id}},[t._v(t._s(e.name))])})],2)])]),t._v(" "),n("div",{class:{"form-group":!0,"has-error":t.errors.has("sms_group_id")}},[n("label",{staticClass:"col-sm-4 control-label",attrs:{for:"input-sms-group-id"}},[t._v("SMS 分组\n            ")]),t._v(" "),n("div",{staticClass:"col-sm-7"},[n("select",{directives:[{name:"model",rawName:"v-model.trim",value:t.form.sms_group_id,expression:"form.sms_group_id",modifiers:{trim:!0}},{name:"validate",rawName:"v-validate"}],staticClass:"form-control",attrs:{id:"input-sms-group-id",name:"sms_group_id","data-vv-as":"SMSGroup"},on:{change:function(e){var n=Array.prototype.filter.call(e.target.options,function(t){return t.selected}).map(function(t){return"_value"in t?t._value:t.value});t.$set(t.form,"sms_group_id",e.target.multiple?n:n[0])}}},[n("option",{attrs:{value:""}},[t._v("自动选择负载最小")]),t._v(" "),t._l(t.smsGroupList,function(e,o){return n("option",{key:o,domProps:{value:e.id}},[t._v(t._s(e.name))])})],2)])]),t._v(" "),n("div",{class:{"form-group":!0,"has-error":t.errors.has("recv_stream_ip")}},[n("label",{staticClass:"col-sm-4 control-label",attrs:{for:"input-recv-stream-ip"}},[t._v("收流 IP\n            ")]),t._v(" "),n("div",{staticClass:"col-sm-7"},[n("input",{directives:[{name:"model",rawName:"v-model.trim",value:t.form.recv_stream_ip,expression:"form.recv_stream_ip",modifiers:{trim:!0}}],staticClass:"form-control",attrs:{type:"text",id:"input-recv-stream-ip",name:"recv_stream_ip",placeholder:"默认使用 livesms.ini > sip > host","data-vv-as":"收流 IP"},domProps:{value:t.form.recv_stream_ip},on:{keydown:function(e){if(!("button"in e)&&t._k(e.keyCode,"enter",13,e.key))return null;t.$el.querySelector("#input-catalog-interval").focus()},input:function(e){e.target.composing||t.$set(t.form,"recv_stream_ip",e.target.value.trim())},blur:function(e){t.$forceUpdate()}}})])]),t._v(" "),n("div",{class:{"form-group":!0,"has-error":t.errors.has("drop_channel_type")}},[n("label",{staticClass:"col-sm-4 control-label",attrs:{for:"input-drop-channel-type"}},[t._v("过滤通道类型")]),t._v(" "),n("div",{staticClass:"col-sm-7"},[n("el-select",{staticStyle:{width:"100%"},attrs:{id:"input-drop-channel-type",size:"medium",multiple:"",filterable:"","allow-create":"","default-first-option":"",placeholder:"选填, 需要丢弃的通道类型/编号"},model:{value:t.dropChannelTypes,callback:function(e){t.dropChannelTypes="string"==typeof e?e.trim():e},expression:"dropChannelTypes"}},t._l(t.innerChannelTypes,function(t,e){return n("el-option",{key:e,attrs:{label:e+" - "+t,value:e}})}))],1)]),t._v(" "),n("div",{directives:[{name:"show",rawName:"v-show",value:t.debug||t.showPosition,expression:"debug || showPosition"}],class:{"form-group":!0,"has-error":t.errors.has("longitude")}},[n("label",{staticClass:"col-sm-4 control-label",attrs:{for:"input-position-longitude"}},[t._v("经度\n            ")]),t._v(" "),n("div",{staticClass:"col-sm-7"},[n("input",{directives:[{name:"validate",rawName:"v-validate",value:"decimal",expression:"'decimal'"},{name:"model",rawName:"v-model.trim",value:t.form.longitude,expression:"form.longitude",modifiers:{trim:!0}}],staticClass:"form-control",attrs:{type:"text",id:"input-position-longitude",name:"longitude","data-vv-as":"经度"},domProps:{value:t.form.longitude},on:{paste:t.onPasteLngLat,input:function(e){e.target.composing||t.$set(t.form,"longitude",e.target.value.trim())},blur:function(e){t.$forceUpdate()}}})])]),t._v(" "),n("div",{directives:[{name:"show",rawName:"v-show",value:t.debug||t.showPosition,expression:"debug || showPosition"}],class:{"form-group":!0,"has-error":t.errors.has("latitude")}},[n("label",{staticClass:"col-sm-4 control-label",attrs:{for:"input-position-latitude"}},[t._v("纬度\n            ")]),t._v(" "),n("div",{staticClass:"col-sm-7"},[n("input",{directives:[{name:"validate",rawName:"v-validate",value:"decimal",expression:"'decimal'"},{name:"model",rawName:"v-model.trim",value:t.form.latitude,expression:"form.latitude",modifiers:{trim:!0}}],staticClass:"form-control",attrs:{type:"text",id:"input-position-latitude",name:"latitude","data-vv-as":"纬度"},domProps:{value:t.form.latitude},on:{paste:t.onPasteLngLat,input:function(e){e.target.composing||t.$set(t.form,"latitude",e.target.value.trim())},blur:function(e){t.$forceUpdate()}}})])])]),t._v(" "),n("div",{staticClass:"col-md-6"},[n("div",{class:{"form-group":!0,"has-error":t.errors.has("catalog_interval")}},[n("label",{staticClass:"col-sm-4 control-label",attrs:{for:"input-catalog-interval"}},[t._v("通道更新周期(秒)\n            ")]),t._v(" "),n("div",{staticClass:"col-sm-7"},[n("input",{directives:[{name:"model",rawName:"v-model.trim",value:t.form.catalog_interval,expression:"form.catalog_interval",modifiers:{trim:!0}},{name:"validate",rawName:"v-validate",value:"numeric",expression:"'numeric'"}],staticClass:"form-control",attrs:{type:"text",id:"input-catalog-interval",name:"catalog_interval",placeholder:"3600"},domProps:{value:t.form.catalog_interval},on:{keydown:function(e){if(!("button"in e)&&t._k(e.keyCode,"enter",13,e.key))return null;t.$el.querySelector("#input-subscribe-interval").focus()},input:function(e){e.target.composing||t.$set(t.form,"catalog_interval",e.target.value.trim())},blur:function(e){t.$forceUpdate()}}})])]),t._v(" "),n("div",{directives:[{name:"show",rawName:"v-show",value:t.debug||t.showSubscribeInterval,expression:"debug || showSubscribeInterval"}],class:{"form-group":!0,"has-error":t.errors.has("subscribe_interval")}},[n("label",{staticClass:"col-sm-4 control-label",attrs:{for:"input-subscribe-interval"}},[t._v("订阅周期(秒)\n            ")]),t._v(" "),n("div",{staticClass:"col-sm-7"},[n("input",{directives:[{name:"model",rawName:"v-model.trim",value:t.form.subscribe_interval,expression:"form.subscribe_interval",modifiers:{trim:!0}},{name:"validate",rawName:"v-validate",value:"integer",expression:"'integer'"}],staticClass:"form-control",attrs:{type:"text",id:"input-subscribe-interval",name:"subscribe_interval",placeholder:"默认使用全局订阅配置, 负数不订阅"},domProps:{value:t.form.subscribe_interval},on:{keydown:function(e){if(!("button"in e)&&t._k(e.keyCode,"enter",13,e.key))return null;t.onSubmit(e)},input:function(e){e.target.composing||t.$set(t.form,"subscribe_interval",e.target.value.trim())},blur:function(e){t.$forceUpdate()}}})])]),t._v(" "),n("div",{class:{"form-group":!0,"has-error":t.errors.has("charset")}},[n("label",{staticClass:"col-sm-4 control-label",attrs:{for:"input-charset"}},[t._v("字符集\n            ")]),t._v(" "),n("div",{staticClass:"col-sm-7"},[n("select",{directives:[{name:"model",rawName:"v-model.trim",value:t.form.charset,expression:"form.charset",modifiers:{trim:!0}},{name:"validate",rawName:"v-validate"}],staticClass:"form-control",attrs:{id:"input-charset",name:"charset","data-vv-as":"字符集"},on:{change:function(e){var n=Array.prototype.filter.call(e.target.options,function(t){return t.selected}).map(function(t){return"_value"in t?t._value:t.value});t.$set(t.form,"charset",e.target.multiple?n:n[0])}}},[n("option",{attrs:{value:""}},[t._v("自动识别")]),t._v(" "),n("option",{attrs:{value:"GB2312"}},[t._v("GB2312")]),t._v(" "),n("option",{attrs:{value:"GB18030"}},[t._v("GB18030")]),t._v(" "),n("option",{attrs:{value:"UTF-8"}},[t._v("UTF-8")])])])]),t._v(" "),n("div",{class:{"form-group":!0,"has-error":t.errors.has("stream_mode")}},[n("label",{staticClass:"col-sm-4 control-label",attrs:{for:"input-stream-mode"}},[t._v("码流索引\n            ")]),t._v(" "),n("div",{staticClass:"col-sm-7"},[n("el-select",{staticStyle:{width:"100%"},attrs:{id:"input-stream-mode",size:"medium",clearable:"",filterable:"","allow-create":"","default-first-option":"",placeholder:"自动选择"},model:{value:t.form.stream_mode,callback:function(e){t.$set(t.form,"stream_mode","string"==typeof e?e.trim():e)},expression:"form.stream_mode"}},t._l(t.innerStreamModes,function(t,e){return n("el-option",{key:e,attrs:{label:e?e+" - "+t:""+t,value:e}})}))],1)]),t._v(" "),n("div",{class:{"form-group":!0,"has-error":t.errors.has("media_transport")}},[n("label",{staticClass:"col-sm-4 control-label",attrs:{for:"input-media-transport"}},[t._v("流传输模式\n            ")]),t._v(" "),n("div",{staticClass:"col-sm-7"},[n("el-radio-group",{attrs:{size:"mini"},on:{change:t.onMediaTransportChange},model:{value:t.form.media_transport,callback:function(e){t.$set(t.form,"media_transport","string"==typeof e?e.trim():e)},expression:"form.media_transport"}},[n("el-radio-button",{attrs:{label:"TCP"}}),t._v(" "),n("el-radio-button",{attrs:{label:"UDP"}})],1),t._v("\n                  \n                "),n("el-radio-group",{directives:[{name:"show",rawName:"v-show",value:"TCP"==t.form.media_transport,expression:"form.media_transport == 'TCP'"}],attrs:{size:"mini"},model:{value:t.form.media_transport_mode,callback:function(e){t.$set(t.form,"media_transport_mode","string"==typeof e?e.trim():e)},expression:"form.media_transport_mode"}},[n("el-radio-button",{attrs:{label:"passive"}},[t._v("被动")]),t._v(" "),n("el-radio-button",{attrs:{label:"active"}},[t._v("主动")])],1)],1)]),t._v(" "),n("div",{class:{"form-group":!0,"has-error":t.errors.has("subscribe_items")}},[n("label",{staticClass:"col-sm-4 control-label",attrs:{for:"input-subscribe-items"}},[t._v("订阅项目\n            ")]),t._v(" "),n("div",{staticClass:"col-sm-7 checkbox"},[n("el-checkbox",{staticStyle:{"margin-left":"-19px","margin-top":"-5px"},attrs:{size:"small",name:"catalog_subscribe",disabled:t.form.subscribe_interval<0},model:{value:t.form.catalog_subscribe,callback:function(e){t.$set(t.form,"catalog_subscribe","string"==typeof e?e.trim():e)},expression:"form.catalog_subscribe"}},[t._v("\n                    目录  \n                ")]),t._v(" "),n("el-checkbox",{staticStyle:{"margin-left":"-19px","margin-top":"-5px"},attrs:{size:"small",name:"alarm_subscribe",disabled:t.form.subscribe_interval<0},model:{value:t.form.alarm_subscribe,callback:function(e){t.$set(t.form,"alarm_subscribe","string"==typeof e?e.trim():e)},expression:"form.alarm_subscribe"}},[t._v("\n                    报警  \n                ")]),t._v(" "),n("el-checkbox",{staticStyle:{"margin-left":"-19px","margin-top":"-5px"},attrs:{size:"small",name:"position_subscribe",disabled:t.form.subscribe_interval<0},model:{value:t.form.position_subscribe,callback:function(e){t.$set(t.form,"position_subscribe","string"==typeof e?e.trim():e)},expression:"form.position_subscribe"}},[t._v("\n                    位置  \n                ")]),t._v(" "),n("el-checkbox",{staticStyle:{"margin-left":"-19px","margin-top":"-5px"},attrs:{size:"small",name:"ptz_subscribe",disabled:t.form.subscribe_interval<0},model:{value:t.form.ptz_subscribe,callback:function(e){t.$set(t.form,"ptz_subscribe","string"==typeof e?e.trim():e)},expression:"form.ptz_subscribe"}},[t._v("\n                    PTZ(2022)\n                ")])],1)]),t._v(" "),n("div",{class:{"form-group":!0,"has-error":t.errors.has("record")}},[n("label",{staticClass:"col-sm-4 control-label",attrs:{for:"input-record"}},[t._v("设备录像\n            ")]),t._v(" "),n("div",{staticClass:"col-sm-7 checkbox"},[n("el-checkbox",{staticStyle:{"margin-left":"-19px","margin-top":"-5px"},attrs:{size:"small",name:"record_center"},model:{value:t.form.record_center,callback:function(e){t.$set(t.form,"record_center","string"==typeof e?e.trim():e)},expression:"form.record_center"}},[t._v("\n                    中心检索  \n                ")]),t._v(" "),n("el-checkbox",{staticStyle:{"margin-left":"-19px","margin-top":"-5px"},attrs:{size:"small",name:"record_indistinct"},model:{value:t.form.record_indistinct,callback:function(e){t.$set(t.form,"record_indistinct","string"==typeof e?e.trim():e)},expression:"form.record_indistinct"}},[t._v("\n                    模糊查询\n                ")])],1)]),t._v(" "),n("div",{class:{"form-group":!0,"has-error":t.errors.has("catalog")}},[n("label",{staticClass:"col-sm-4 control-label",attrs:{for:"input-catalog"}},[t._v("目录结构\n            ")]),t._v(" "),n("div",{staticClass:"col-sm-7 checkbox"},[n("el-checkbox",{staticStyle:{"margin-left":"-19px","margin-top":"-5px"},attrs:{size:"small",name:"civil_code_first"},model:{value:t.form.civil_code_first,callback:function(e){t.$set(t.form,"civil_code_first","string"==typeof e?e.trim():e)},expression:"form.civil_code_first"}},[t._v("\n                    行政区域优先  \n                ")]),t._v(" "),n("el-checkbox",{directives:[{name:"show",rawName:"v-show",value:t.debug,expression:"debug"}],staticStyle:{"margin-left":"-19px","margin-top":"-5px"},attrs:{size:"small",name:"keep_original_tree"},model:{value:t.form.keep_original_tree,callback:function(e){t.$set(t.form,"keep_original_tree","string"==typeof e?e.trim():e)},expression:"form.keep_original_tree"}},[t._v("\n                    保持原样\n                ")])],1)])]),t._v(" "),n("div",{staticClass:"clearfix"}),t._v(" "),n("template",{attrs:{slot:"footer"},slot:"footer"},[t.alertMsg?n("div",{staticClass:"inline text-orange"},[n("small",[n("strong",[n("i",{staticClass:"fa fa-info-circle"}),t._v(" 提示 :   ")]),t._v("\n                "+t._s(t.alertMsg)+"\n                  \n            ")])]):t._e()])],2)},r=[],i={render:o,staticRenderFns:r};e.a=i},wZh5:function(t,e,n){"use strict";function o(t){n("KBJn")}Object.defineProperty(e,"__esModule",{value:!0});var r=n("xi4u"),i=n.n(r);for(var a in r)["default","default"].indexOf(a)<0&&function(t){n.d(e,t,function(){return r[t]})}(a);var s=n("5w0Z"),l=n("VU/8"),u=o,c=l(i.a,s.a,!1,u,"data-v-6aa38924",null);e.default=c.exports},x5s2:function(t,e,n){"use strict";var o=function(){var t=this,e=t.$createElement,n=t._self._c||e;return n("li",{staticClass:"el-timeline-item"},[n("div",{staticClass:"el-timeline-item__tail"}),t._v(" "),t.$slots.dot?t._e():n("div",{staticClass:"el-timeline-item__node",class:["el-timeline-item__node--"+(t.size||""),"el-timeline-item__node--"+(t.type||"")],style:{backgroundColor:t.color}},[t.icon?n("i",{staticClass:"el-timeline-item__icon",class:t.icon}):t._e()]),t._v(" "),t.$slots.dot?n("div",{staticClass:"el-timeline-item__dot"},[t._t("dot")],2):t._e(),t._v(" "),n("div",{staticClass:"el-timeline-item__wrapper"},[t.hideTimestamp||"top"!==t.placement?t._e():n("div",{staticClass:"el-timeline-item__timestamp is-top"},[t._v("\n\t\t\t"+t._s(t.timestamp)+"\n\t\t")]),t._v(" "),n("div",{staticClass:"el-timeline-item__content"},[t._t("default")],2),t._v(" "),t.hideTimestamp||"bottom"!==t.placement?t._e():n("div",{staticClass:"el-timeline-item__timestamp is-bottom"},[t._v("\n\t\t\t"+t._s(t.timestamp)+"\n\t\t")])])])},r=[],i={render:o,staticRenderFns:r};e.a=i},xi4u:function(t,e,n){"use strict";function o(t){return t&&t.__esModule?t:{default:t}}Object.defineProperty(e,"__esModule",{value:!0}),n("F8S1");var r=n("0iPh"),i=o(r),a=n("M4fF"),s=o(a);e.default={props:{title:{default:""},size:{type:String,default:"modal-lgg"},fade:{type:Boolean,default:!1},serverInfo:{type:Object,default:function(){}},userInfo:{type:Object,default:function(){return null}}},data:function(){return{q:"",channel_type:"",online:"",total:0,relateCnt:0,pageSize:10,currentPage:1,sort:"",order:"",related:!1,reverse:!1,loading:!1,channels:[],selection:[],bak:{},pcode:""}},watch:{q:function(t,e){this.doDelaySearch()},channel_type:function(t,e){this.doSearch()},online:function(t,e){this.doSearch()},related:function(t,e){this.doSearch()},reverse:function(t,e){this.doSearch()},currentPage:function(t,e){this.doSearch(t)},pageSize:function(t,e){this.doSearch()}},mounted:function(){var t=this;(0,i.default)(this.$el).find(".modal-content").draggable({handle:".modal-header",cancel:".modal-title span",addClasses:!1,containment:"document",delay:100,opacity:.5}),(0,i.default)(this.$el).on("shown.bs.modal",function(){t.$emit("show")}).on("hidden.bs.modal",function(){t.errors.clear(),t.reset(),t.$emit("hide")})},directives:{focus:{inserted:function(t){t.focus(),t.select()}}},methods:{sortChange:function(t){this.sort=t.prop,this.order="ascending"==t.order?"asc":"desc",this.getChannels()},select:function(t,e){var n=this;if(t.indexOf(e)>=0)i.default.post("/api/v1/channel/setcustomparent",{customs:[e.DeviceID+":"+e.ID+":"+this.pcode]}).then(function(){n.bak[e.DeviceID+":"+e.ID]=e.CustomParentID||""}).always(function(){n.getChannels()});else{var o=this.bak[e.DeviceID+":"+e.ID]||"";i.default.post("/api/v1/channel/setcustomparent",{customs:[e.DeviceID+":"+e.ID+":"+o]}).always(function(){n.getChannels()})}},selectAll:function(t){var e=this,n=[],o={};if(t.length){var r=!0,a=!1,s=void 0;try{for(var l,u=t[Symbol.iterator]();!(r=(l=u.next()).done);r=!0){var c=l.value;this.selection.indexOf(c)<0&&(n.push(c.DeviceID+":"+c.ID+":"+this.pcode),o[c.DeviceID+":"+c.ID]=c.CustomParentID||"")}}catch(t){a=!0,s=t}finally{try{!r&&u.return&&u.return()}finally{if(a)throw s}}i.default.post("/api/v1/channel/setcustomparent",{customs:n}).then(function(){e.bak=Object.assign(e.bak,o)}).always(function(){e.getChannels()})}else{var d=!0,f=!1,v=void 0;try{for(var p,h=this.selection[Symbol.iterator]();!(d=(p=h.next()).done);d=!0){var c=p.value,m=this.bak[c.DeviceID+":"+c.ID]||"";n.push(c.DeviceID+":"+c.ID+":"+m)}}catch(t){f=!0,v=t}finally{try{!d&&h.return&&h.return()}finally{if(f)throw v}}i.default.post("/api/v1/channel/setcustomparent",{customs:n}).always(function(){e.getChannels()})}},doSearch:function(){var t=arguments.length>0&&void 0!==arguments[0]?arguments[0]:1;this.currentPage=t,this.getChannels()},doDelaySearch:s.default.debounce(function(){this.doSearch()},800),formatName:function(t,e,n){var o=t.DeviceCustomName||t.DeviceName||"",r=t.CustomName||t.Name||"";return o&&o!=r&&(r=r?r+"@"+o:o),r||"-"},formatDeviceName:function(t,e,n){return t.DeviceCustomName||t.DeviceName||"-"},formatChannelName:function(t,e,n){return t.CustomName||t.Name||"-"},formatManufacturer:function(t,e,n){return n||"-"},selectable:function(t,e){return!0},getChannels:function(){var t=this;this.pcode&&(this.loading=!0,i.default.get("/api/v1/channel/customlist",{pcode:this.pcode,q:this.q,start:(this.currentPage-1)*this.pageSize,limit:this.pageSize,channel_type:this.channel_type,online:"device"==this.channel_type?this.online:"",related:this.related,reverse:this.reverse,sort:this.sort,order:this.order}).then(function(e){t.$refs.channelTable.clearSelection(),t.total=e.ChannelCount,t.relateCnt=e.ChannelRelateCount,t.channels=e.ChannelList||[],t.selection=[],t.$nextTick(function(){t.channels.forEach(function(e){var n=e.CustomParentID==t.pcode;t.$refs.channelTable.toggleRowSelection(e,n),n&&t.selection.push(e)})})}).always(function(){t.$nextTick(function(){t.loading=!1})}))},isDir:function(t){if(t){if(t.SubCount>0)return!0;if(this.serverInfo.StrictChannelParental&&1==t.Parental)return!0;if(this.serverInfo.StrictChannelCode&&t.ID.length<=10)return!0;if(1==t.Parental&&"LiveQing"==t.Manufacturer)return!0;if(20==t.ID.length&&("216"==t.ID.substring(10,13)||"215"==t.ID.substring(10,13)))return!0}return!1},reset:function(){this.pcode="",this.$refs.channelTable.clearSelection(),this.channels=[],this.selection=[],this.bak={},this.q="",this.channel_type="",this.online="",this.related=!1,this.reverse=!1,this.total=0,this.relateCnt=0,this.currentPage=1,this.pageSize=10},setChannelID:function(t,e,n){var o=t.Editing;if(this.$set(t,"Editing",e),o&&!e){var r=n.target.value.trim();r==t.ID&&(r=""),t.CustomID!=r&&i.default.post("/api/v1/device/setchannelid",{serial:t.DeviceID,code:t.ID,id:r}).then(function(e){t.CustomID=r})}},show:function(t){this.pcode=t,(0,i.default)(this.$el).modal("show"),this.getChannels()},hide:function(){(0,i.default)(this.$el).modal("hide")},toggleReverse:function(){this.reverse=!this.reverse}}}},znKA:function(t,e,n){"use strict";var o=function(){var t=this,e=t.$createElement,n=t._self._c||e;return n("div",{ref:"dlg",staticClass:"modal",attrs:{"data-backdrop":"static","data-disable":"false","data-keyboard":"true",tabindex:"-1"}},[n("div",{class:["modal-dialog",t.size]},[n("div",{staticClass:"modal-content"},[n("div",{staticClass:"modal-header"},[t._m(0),t._v(" "),n("h4",{staticClass:"modal-title text-center text-primary"},[n("span",[t._v(t._s(t.title))])])]),t._v(" "),n("div",{staticClass:"modal-body"},[n("div",{staticClass:"text-center"},[t.visiable?n("el-time-picker",{attrs:{"is-range":"","arrow-control":t.arrow,"value-format":t.format,"default-value":t.vdefault,"start-placeholder":"开始时间","end-placeholder":"结束时间"},model:{value:t.value,callback:function(e){t.value=e},expression:"value"}}):t._e()],1)]),t._v(" "),n("div",{staticClass:"modal-footer"},[t._t("footer"),t._v(" "),n("button",{staticClass:"btn btn-default",attrs:{type:"button","data-dismiss":"modal"}},[t._v("取消")]),t._v(" "),n("button",{staticClass:"btn btn-primary",attrs:{type:"button",disabled:!t.value},on:{click:function(e){e.preventDefault(),t.doSubmit(e)}}},[t._v("确定")])],2)])])])},r=[function(){var t=this,e=t.$createElement,n=t._self._c||e;return n("button",{staticClass:"close",attrs:{type:"button","data-dismiss":"modal","aria-label":"Close"}},[n("span",{attrs:{"aria-hidden":"true"}},[t._v("×")])])}],i={render:o,staticRenderFns:r};e.a=i}});
\ No newline at end of file
@@ -0,0 +1,43 @@
+// 通过sse订阅实时事件, 收到事件后合并刷新页面数据; 浏览器不支持sse时退回定时轮询
+(function (window) {
+    window.subscribeEvents = function (topics, refresh, interval) {
+        if (!window.EventSource) {
+            var timer = setInterval(refresh, interval || 3000);
+            return {
+                close: function () {
+                    clearInterval(timer);
+                }
+            };
+        }
+
+        var pending = 0;
+        var onEvent = function () {
+            if (!pending) {
+                pending = setTimeout(function () {
+                    pending = 0;
+                    refresh();
+                }, 500);
+            }
+        };
+
+        var opened = false;
+        var source = new EventSource("/api/v1/event/sse?topics=" + encodeURIComponent(topics.join(",")));
+        source.onopen = function () {
+            // 断线期间的事件丢失, 重连后刷新一次
+            opened && onEvent();
+            opened = true;
+        };
+
+        topics.forEach(function (topic) {
+            source.addEventListener(topic, onEvent);
+        });
+
+        return {
+            close: function () {
+                source.close();
+                pending && clearTimeout(pending);
+                pending = 0;
+            }
+        };
+    };
+})(window);
@@ -0,0 +1,267 @@
+package jt808
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"gb-cms/log"
+	"go.uber.org/zap"
+	"net"
+	"testing"
+	"time"
+)
+
+const (
+	simPhone    = "013800000001"
+	simAuthCode = "AUTH-0001"
+)
+
+func init() {
+	log.Sugar = zap.NewNop().Sugar()
+}
+
+func TestEscape(t *testing.T) {
+	data := []byte{0x30, 0x7e, 0x08, 0x7d, 0x55}
+	escaped := Escape(data)
+	if !bytes.Equal(escaped, []byte{0x30, 0x7d, 0x02, 0x08, 0x7d, 0x01, 0x55}) {
+		t.Fatalf("escape: %x", escaped)
+	}
+
+	unescaped, err := Unescape(escaped)
+	if err != nil || !bytes.Equal(unescaped, data) {
+		t.Fatalf("unescape: %x err: %v", unescaped, err)
+	}
+
+	if _, err = Unescape([]byte{0x7d, 0x03}); err == nil {
+		t.Fatal("invalid escape should fail")
+	}
+}
+
+func TestEncodeDecode(t *testing.T) {
+	body := []byte{0x7e, 0x01, 0x7d}
+	for _, is2019 := range []bool{false, true} {
+		packet, err := Encode(&Header{MsgID: MsgIDLocation, Is2019: is2019, Version: 1, Phone: simPhone, Serial: 0x7e7d}, body)
+		if err != nil {
+			t.Fatal(err)
+		} else if packet[0] != flag || packet[len(packet)-1] != flag || bytes.IndexByte(packet[1:len(packet)-1], flag) >= 0 {
+			t.Fatalf("flag not escaped: %x", packet)
+		}
+
+		decoded, err := Decode(packet[1 : len(packet)-1])
+		if err != nil {
+			t.Fatal(err)
+		} else if decoded.MsgID != MsgIDLocation || decoded.Serial != 0x7e7d || decoded.Is2019 != is2019 || !bytes.Equal(decoded.Body, body) {
+			t.Fatalf("decoded: %+v", decoded)
+		} else if NormalizePhone(decoded.Phone) != NormalizePhone(simPhone) {
+			t.Fatalf("phone: %s", decoded.Phone)
+		}
+
+		// 篡改消息体, 校验码错误
+		packet[len(packet)-3] ^= 0xff
+		if _, err = Decode(packet[1 : len(packet)-1]); err == nil {
+			t.Fatal("checksum mismatch should fail")
+		}
+	}
+}
+
+func TestDecodeLocation(t *testing.T) {
+	body := make([]byte, 0, locationBodyLength)
+	body = binary.BigEndian.AppendUint32(body, 0)
+	body = binary.BigEndian.AppendUint32(body, 1<<3) // 西经
+	body = binary.BigEndian.AppendUint32(body, 22543096)
+	body = binary.BigEndian.AppendUint32(body, 114057865)
+	body = binary.BigEndian.AppendUint16(body, 35)
+	body = binary.BigEndian.AppendUint16(body, 605)
+	body = binary.BigEndian.AppendUint16(body, 90)
+	body = append(body, EncodeBCD("240618152056", 6)...)
+
+	location, err := DecodeLocation(body)
+	if err != nil {
+		t.Fatal(err)
+	} else if location.Latitude != 22.543096 || location.Longitude != -114.057865 || location.Speed != 60.5 || location.Direction != 90 || location.Altitude != 35 {
+		t.Fatalf("location: %+v", location)
+	} else if location.Time.Unix() != time.Date(2024, 6, 18, 7, 20, 56, 0, time.UTC).Unix() {
+		t.Fatalf("time: %s", location.Time)
+	}
+}
+
+// 记录业务回调
+type stubHandler struct {
+	locations chan *Location
+	closed    chan string
+}
+
+func (h *stubHandler) OnRegister(_ *Session, register *Register) (byte, string) {
+	if register.Model != "SIM-2019" {
+		return RegisterTerminalUnknown, ""
+	}
+
+	return RegisterSuccess, simAuthCode
+}
+
+func (h *stubHandler) OnAuthenticate(_ *Session, auth *Authentication) bool {
+	return auth.Code == simAuthCode
+}
+
+func (h *stubHandler) OnLocation(_ *Session, location *Location) {
+	h.locations <- location
+}
+
+func (h *stubHandler) OnClose(session *Session) {
+	h.closed <- session.Phone
+}
+
+// 模拟2019版终端
+type terminal struct {
+	t      *testing.T
+	conn   net.Conn
+	reader *bufio.Reader
+	serial uint16
+}
+
+func (c *terminal) send(msgId uint16, body []byte) {
+	c.serial++
+	packet, err := Encode(&Header{MsgID: msgId, Is2019: true, Version: 1, Phone: simPhone, Serial: c.serial}, body)
+	if err != nil {
+		c.t.Fatal(err)
+	} else if _, err = c.conn.Write(packet); err != nil {
+		c.t.Fatal(err)
+	}
+}
+
+func (c *terminal) read() *Packet {
+	_ = c.conn.SetReadDeadline(time.Now().Add(3 * time.Second))
+	for {
+		frame, err := c.reader.ReadBytes(flag)
+		if err != nil {
+			c.t.Fatal(err)
+		} else if len(frame) < 2 {
+			continue
+		}
+
+		packet, err := Decode(frame[:len(frame)-1])
+		if err != nil {
+			c.t.Fatal(err)
+		}
+		return packet
+	}
+}
+
+func (c *terminal) expectResponse(msgId uint16, result byte) {
+	packet := c.read()
+	if packet.MsgID != MsgIDPlatformResponse {
+		c.t.Fatalf("expect platform response, got 0x%04x", packet.MsgID)
+	}
+
+	response, err := DecodeGeneralResponse(packet.Body)
+	if err != nil {
+		c.t.Fatal(err)
+	} else if response.MsgID != msgId || response.Serial != c.serial || response.Result != result {
+		c.t.Fatalf("response: %+v", response)
+	}
+}
+
+func fixed(s string, n int) []byte {
+	data := make([]byte, n)
+	copy(data, s)
+	return data
+}
+
+func TestServer(t *testing.T) {
+	handler := &stubHandler{locations: make(chan *Location, 1), closed: make(chan string, 1)}
+	server := NewServer(handler, 5*time.Second)
+	if err := server.Start("127.0.0.1:0"); err != nil {
+		t.Fatal(err)
+	}
+
+	defer server.Close()
+	conn, err := net.Dial("tcp", server.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c := &terminal{t: t, conn: conn, reader: bufio.NewReader(conn)}
+
+	// 鉴权前上报位置被拒绝
+	c.send(MsgIDHeartbeat, nil)
+	c.expectResponse(MsgIDHeartbeat, ResultFailure)
+
+	// 注册
+	register := []byte{0x00, 0x2c, 0x01, 0x2c}
+	register = append(register, fixed("LKMIO", 11)...)
+	register = append(register, fixed("SIM-2019", 30)...)
+	register = append(register, fixed("T0001", 30)...)
+	register = append(register, 0x01, 0xd4, 0xc1, 'B', '1', '2', '3', '4', '5') // 粤B12345
+	c.send(MsgIDRegister, register)
+
+	packet := c.read()
+	if packet.MsgID != MsgIDRegisterResponse || binary.BigEndian.Uint16(packet.Body) != c.serial || packet.Body[2] != RegisterSuccess {
+		t.Fatalf("register response: %x", packet.Body)
+	} else if code := string(packet.Body[3:]); code != simAuthCode {
+		t.Fatalf("auth code: %s", code)
+	}
+
+	// 鉴权
+	auth := append([]byte{byte(len(simAuthCode))}, simAuthCode...)
+	auth = append(auth, fixed("860000000000001", 15)...)
+	auth = append(auth, fixed("V1.0.0", 20)...)
+	c.send(MsgIDAuthentication, auth)
+	c.expectResponse(MsgIDAuthentication, ResultSuccess)
+
+	c.send(MsgIDHeartbeat, nil)
+	c.expectResponse(MsgIDHeartbeat, ResultSuccess)
+
+	// 位置汇报
+	location := make([]byte, 22, locationBodyLength)
+	binary.BigEndian.PutUint32(location[8:], 22543096)
+	binary.BigEndian.PutUint32(location[12:], 114057865)
+	location = append(location, EncodeBCD("240618152056", 6)...)
+	c.send(MsgIDLocation, location)
+	c.expectResponse(MsgIDLocation, ResultSuccess)
+	if l := <-handler.locations; l.Latitude != 22.543096 {
+		t.Fatalf("location: %+v", l)
+	}
+
+	// 下发实时音视频请求, 终端通用应答
+	session := server.Find("13800000001")
+	if session == nil {
+		t.Fatal("session not found")
+	}
+
+	results := make(chan byte, 1)
+	go func() {
+		request := &LiveRequest{IP: "192.168.1.100", TCPPort: 1078, Channel: 1}
+		result, err := session.Request(MsgIDLiveRequest, request.Encode(), 3*time.Second)
+		if err != nil {
+			t.Error(err)
+		}
+		results <- result
+	}()
+
+	packet = c.read()
+	if packet.MsgID != MsgIDLiveRequest || int(packet.Body[0]) != len("192.168.1.100") || string(packet.Body[1:14]) != "192.168.1.100" {
+		t.Fatalf("live request: %x", packet.Body)
+	} else if binary.BigEndian.Uint16(packet.Body[14:]) != 1078 || packet.Body[18] != 1 {
+		t.Fatalf("live request: %x", packet.Body)
+	}
+
+	c.send(MsgIDTerminalResponse, (&GeneralResponse{Serial: packet.Serial, MsgID: packet.MsgID, Result: ResultSuccess}).Encode())
+	if result := <-results; result != ResultSuccess {
+		t.Fatalf("result: %d", result)
+	}
+
+	// 断开连接, 通知终端离线
+	_ = conn.Close()
+	select {
+	case phone := <-handler.closed:
+		if phone != "13800000001" {
+			t.Fatalf("closed phone: %s", phone)
+		}
+	case <-time.After(3 * time.Second):
+		t.Fatal("OnClose not called")
+	}
+
+	if server.Find(simPhone) != nil {
+		t.Fatal("session not removed")
+	}
+}
@@ -0,0 +1,298 @@
+package jt808
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"golang.org/x/text/encoding/simplifiedchinese"
+	"time"
+)
+
+// 注册结果
+const (
+	RegisterSuccess         = 0
+	RegisterVehicleExists   = 1 // 车辆已被注册
+	RegisterVehicleNotFound = 2 // 数据库中无该车辆
+	RegisterTerminalExists  = 3 // 终端已被注册
+	RegisterTerminalUnknown = 4 // 数据库中无该终端
+)
+
+// JT/T 1078 表12 实时音视频的数据类型
+const (
+	DataTypeAudioVideo = 0
+	DataTypeVideo      = 1
+	DataTypeTalk       = 2
+	DataTypeListen     = 3
+	DataTypeBroadcast  = 4
+)
+
+// JT/T 1078 表14 实时音视频传输控制指令
+const (
+	LiveControlClose     = 0
+	LiveControlSwitch    = 1 // 切换码流
+	LiveControlPause     = 2
+	LiveControlResume    = 3
+	LiveControlCloseTalk = 4
+)
+
+// 关闭的音视频类型
+const (
+	LiveCloseAll   = 0
+	LiveCloseAudio = 1
+	LiveCloseVideo = 2
+)
+
+// 实时音视频的码流类型
+const (
+	StreamTypeMain = 0
+	StreamTypeSub  = 1
+)
+
+const (
+	bcdTimeLength          = 6
+	bcdTimeFormat          = "060102150405"
+	locationBodyLength     = 28
+	register2013BodyLength = 37
+	register2019BodyLength = 76
+)
+
+// 协议中的时间为GMT+8
+var timeZone = time.FixedZone("GMT+8", 8*60*60)
+
+// 定长字符串去掉末尾的补位
+func trimFixed(data []byte) string {
+	return string(bytes.TrimRight(data, "\x00 "))
+}
+
+// 车牌等字符串使用GBK编码
+func decodeGBK(data []byte) string {
+	decoded, err := simplifiedchinese.GBK.NewDecoder().Bytes(bytes.TrimRight(data, "\x00"))
+	if err != nil {
+		return string(data)
+	}
+
+	return string(decoded)
+}
+
+func encodeBCDTime(t time.Time) []byte {
+	if t.IsZero() {
+		return make([]byte, bcdTimeLength)
+	}
+
+	return EncodeBCD(t.In(timeZone).Format(bcdTimeFormat), bcdTimeLength)
+}
+
+func decodeBCDTime(bcd []byte) (time.Time, error) {
+	return time.ParseInLocation(bcdTimeFormat, DecodeBCD(bcd), timeZone)
+}
+
+// 编码服务器IP和端口, IP长度为BYTE
+func appendServerAddr(data []byte, ip string, tcpPort, udpPort uint16) []byte {
+	data = append(data, byte(len(ip)))
+	data = append(data, ip...)
+	data = binary.BigEndian.AppendUint16(data, tcpPort)
+	return binary.BigEndian.AppendUint16(data, udpPort)
+}
+
+// GeneralResponse 终端/平台通用应答
+type GeneralResponse struct {
+	Serial uint16 // 应答流水号
+	MsgID  uint16 // 应答ID
+	Result byte
+}
+
+func (r *GeneralResponse) Encode() []byte {
+	data := binary.BigEndian.AppendUint16(nil, r.Serial)
+	data = binary.BigEndian.AppendUint16(data, r.MsgID)
+	return append(data, r.Result)
+}
+
+func DecodeGeneralResponse(body []byte) (*GeneralResponse, error) {
+	if len(body) < 5 {
+		return nil, fmt.Errorf("general response too short %d", len(body))
+	}
+
+	return &GeneralResponse{
+		Serial: binary.BigEndian.Uint16(body),
+		MsgID:  binary.BigEndian.Uint16(body[2:]),
+		Result: body[4],
+	}, nil
+}
+
+// Register 终端注册
+type Register struct {
+	Province     uint16
+	City         uint16
+	Manufacturer string
+	Model        string
+	TerminalID   string
+	PlateColor   byte
+	Plate        string
+}
+
+func DecodeRegister(body []byte, is2019 bool) (*Register, error) {
+	// 2013版制造商ID 5字节, 型号20字节, 终端ID 7字节. 2019版分别为11/30/30字节
+	makerLength, modelLength, idLength, minLength := 5, 20, 7, register2013BodyLength
+	if is2019 {
+		makerLength, modelLength, idLength, minLength = 11, 30, 30, register2019BodyLength
+	}
+
+	if len(body) < minLength {
+		return nil, fmt.Errorf("register body too short %d", len(body))
+	}
+
+	register := &Register{
+		Province: binary.BigEndian.Uint16(body),
+		City:     binary.BigEndian.Uint16(body[2:]),
+	}
+
+	offset := 4
+	register.Manufacturer = trimFixed(body[offset : offset+makerLength])
+	offset += makerLength
+	register.Model = trimFixed(body[offset : offset+modelLength])
+	offset += modelLength
+	register.TerminalID = trimFixed(body[offset : offset+idLength])
+	offset += idLength
+	register.PlateColor = body[offset]
+	register.Plate = decodeGBK(body[offset+1:])
+	return register, nil
+}
+
+// EncodeRegisterResponse 注册应答, 成功时携带鉴权码
+func EncodeRegisterResponse(serial uint16, result byte, authCode string) []byte {
+	data := binary.BigEndian.AppendUint16(nil, serial)
+	data = append(data, result)
+	if RegisterSuccess == result {
+		data = append(data, authCode...)
+	}
+
+	return data
+}
+
+// Authentication 终端鉴权
+type Authentication struct {
+	Code     string
+	IMEI     string
+	Firmware string
+}
+
+func DecodeAuthentication(body []byte, is2019 bool) (*Authentication, error) {
+	// 2013版消息体只有鉴权码
+	if !is2019 {
+		return &Authentication{Code: string(body)}, nil
+	} else if len(body) < 1 || len(body) < 1+int(body[0])+35 {
+		return nil, fmt.Errorf("authentication body too short %d", len(body))
+	}
+
+	length := int(body[0])
+	return &Authentication{
+		Code:     string(body[1 : 1+length]),
+		IMEI:     trimFixed(body[1+length : 16+length]),
+		Firmware: trimFixed(body[16+length : 36+length]),
+	}, nil
+}
+
+// Location 位置信息汇报的基本信息, 不解析附加信息
+type Location struct {
+	Alarm     uint32
+	Status    uint32
+	Latitude  float64 // 度, 南纬为负数
+	Longitude float64 // 度, 西经为负数
+	Altitude  uint16  // 米
+	Speed     float64 // km/h
+	Direction uint16  // 0-359, 正北为0, 顺时针
+	Time      time.Time
+}
+
+func DecodeLocation(body []byte) (*Location, error) {
+	if len(body) < locationBodyLength {
+		return nil, fmt.Errorf("location body too short %d", len(body))
+	}
+
+	location := &Location{
+		Alarm:     binary.BigEndian.Uint32(body),
+		Status:    binary.BigEndian.Uint32(body[4:]),
+		Latitude:  float64(binary.BigEndian.Uint32(body[8:])) / 1e6,
+		Longitude: float64(binary.BigEndian.Uint32(body[12:])) / 1e6,
+		Altitude:  binary.BigEndian.Uint16(body[16:]),
+		Speed:     float64(binary.BigEndian.Uint16(body[18:])) / 10,
+		Direction: binary.BigEndian.Uint16(body[20:]),
+	}
+
+	// 状态位 bit2 0-北纬 1-南纬, bit3 0-东经 1-西经
+	if location.Status&(1<<2) != 0 {
+		location.Latitude = -location.Latitude
+	}
+
+	if location.Status&(1<<3) != 0 {
+		location.Longitude = -location.Longitude
+	}
+
+	var err error
+	if location.Time, err = decodeBCDTime(body[22:28]); err != nil {
+		return nil, fmt.Errorf("invalid location time %s", DecodeBCD(body[22:28]))
+	}
+
+	return location, nil
+}
+
+// LiveRequest 0x9101实时音视频传输请求
+type LiveRequest struct {
+	IP         string
+	TCPPort    uint16
+	UDPPort    uint16
+	Channel    byte
+	DataType   byte
+	StreamType byte
+}
+
+func (r *LiveRequest) Encode() []byte {
+	data := appendServerAddr(nil, r.IP, r.TCPPort, r.UDPPort)
+	return append(data, r.Channel, r.DataType, r.StreamType)
+}
+
+// LiveControl 0x9102实时音视频传输控制
+type LiveControl struct {
+	Channel    byte
+	Command    byte
+	CloseType  byte
+	StreamType byte
+}
+
+func (c *LiveControl) Encode() []byte {
+	return []byte{c.Channel, c.Command, c.CloseType, c.StreamType}
+}
+
+// PlaybackRequest 0x9201远程录像回放请求
+type PlaybackRequest struct {
+	IP          string
+	TCPPort     uint16
+	UDPPort     uint16
+	Channel     byte
+	MediaType   byte
+	StreamType  byte
+	StorageType byte
+	Mode        byte
+	Multiple    byte
+	StartTime   time.Time
+	EndTime     time.Time // 为零值时表示一直回放
+}
+
+func (r *PlaybackRequest) Encode() []byte {
+	data := appendServerAddr(nil, r.IP, r.TCPPort, r.UDPPort)
+	data = append(data, r.Channel, r.MediaType, r.StreamType, r.StorageType, r.Mode, r.Multiple)
+	data = append(data, encodeBCDTime(r.StartTime)...)
+	return append(data, encodeBCDTime(r.EndTime)...)
+}
+
+// PlaybackControl 0x9202远程录像回放控制
+type PlaybackControl struct {
+	Channel  byte
+	Control  byte
+	Multiple byte
+	Position time.Time // 拖动回放的位置
+}
+
+func (c *PlaybackControl) Encode() []byte {
+	return append([]byte{c.Channel, c.Control, c.Multiple}, encodeBCDTime(c.Position)...)
+}
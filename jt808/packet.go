@@ -0,0 +1,210 @@
+// Package jt808 JT/T 808终端通信协议, 兼容2013和2019版本, 以及JT/T 1078的音视频指令
+package jt808
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strings"
+)
+
+// 消息ID
+const (
+	MsgIDTerminalResponse = 0x0001 // 终端通用应答
+	MsgIDHeartbeat        = 0x0002
+	MsgIDLogout           = 0x0003
+	MsgIDRegister         = 0x0100
+	MsgIDAuthentication   = 0x0102
+	MsgIDLocation         = 0x0200
+	MsgIDPlatformResponse = 0x8001 // 平台通用应答
+	MsgIDRegisterResponse = 0x8100
+	MsgIDLiveRequest      = 0x9101 // 实时音视频传输请求
+	MsgIDLiveControl      = 0x9102 // 实时音视频传输控制
+	MsgIDPlaybackRequest  = 0x9201 // 远程录像回放请求
+	MsgIDPlaybackControl  = 0x9202 // 远程录像回放控制
+)
+
+// 通用应答结果
+const (
+	ResultSuccess     = 0
+	ResultFailure     = 1
+	ResultMessageErr  = 2
+	ResultUnsupported = 3
+)
+
+const (
+	flag        = 0x7e
+	escape      = 0x7d
+	maxBodySize = 0x3ff
+
+	attrSubpackage = 1 << 13
+	attrVersion    = 1 << 14
+
+	phoneLength2013 = 6
+	phoneLength2019 = 10
+)
+
+// Header 消息头
+type Header struct {
+	MsgID        uint16
+	Version      byte   // 2019版本的协议版本号
+	Is2019       bool   // 消息体属性的版本标识
+	Phone        string // 终端手机号, BCD编码的原始数字
+	Serial       uint16
+	Subpackage   bool
+	PackageTotal uint16
+	PackageIndex uint16
+}
+
+type Packet struct {
+	Header
+	Body []byte
+}
+
+// NormalizePhone 去掉手机号的前导0, 2013版为12位, 2019版为20位
+func NormalizePhone(phone string) string {
+	return strings.TrimLeft(phone, "0")
+}
+
+// Escape 0x7e转义为0x7d 0x02, 0x7d转义为0x7d 0x01
+func Escape(data []byte) []byte {
+	escaped := make([]byte, 0, len(data)+8)
+	for _, b := range data {
+		if b == flag || b == escape {
+			escaped = append(escaped, escape, b-escape+1)
+		} else {
+			escaped = append(escaped, b)
+		}
+	}
+
+	return escaped
+}
+
+func Unescape(data []byte) ([]byte, error) {
+	unescaped := make([]byte, 0, len(data))
+	for i := 0; i < len(data); i++ {
+		if data[i] != escape {
+			unescaped = append(unescaped, data[i])
+			continue
+		} else if i+1 >= len(data) || (data[i+1] != 0x01 && data[i+1] != 0x02) {
+			return nil, fmt.Errorf("invalid escape at %d", i)
+		}
+
+		i++
+		unescaped = append(unescaped, data[i]+escape-1)
+	}
+
+	return unescaped, nil
+}
+
+func checksum(data []byte) byte {
+	var sum byte
+	for _, b := range data {
+		sum ^= b
+	}
+
+	return sum
+}
+
+// EncodeBCD 数字字符串编码为n字节的BCD码, 长度不足左侧补0
+func EncodeBCD(s string, n int) []byte {
+	if len(s) < n*2 {
+		s = strings.Repeat("0", n*2-len(s)) + s
+	}
+
+	s = s[len(s)-n*2:]
+	bcd := make([]byte, n)
+	for i := 0; i < n; i++ {
+		bcd[i] = (s[i*2]-'0')<<4 | (s[i*2+1] - '0')
+	}
+
+	return bcd
+}
+
+func DecodeBCD(bcd []byte) string {
+	var builder strings.Builder
+	for _, b := range bcd {
+		builder.WriteByte('0' + b>>4)
+		builder.WriteByte('0' + b&0x0f)
+	}
+
+	return builder.String()
+}
+
+// Decode 解析去掉首尾标识位的消息, 校验码错误返回error
+func Decode(frame []byte) (*Packet, error) {
+	data, err := Unescape(frame)
+	if err != nil {
+		return nil, err
+	} else if len(data) < 13 {
+		return nil, fmt.Errorf("packet too short %d", len(data))
+	} else if sum := checksum(data[:len(data)-1]); sum != data[len(data)-1] {
+		return nil, fmt.Errorf("checksum mismatch %x != %x", sum, data[len(data)-1])
+	}
+
+	data = data[:len(data)-1]
+	packet := &Packet{}
+	packet.MsgID = binary.BigEndian.Uint16(data)
+	attr := binary.BigEndian.Uint16(data[2:])
+	packet.Is2019 = attr&attrVersion != 0
+	packet.Subpackage = attr&attrSubpackage != 0
+
+	offset := 4
+	phoneLength := phoneLength2013
+	if packet.Is2019 {
+		packet.Version = data[offset]
+		phoneLength = phoneLength2019
+		offset++
+	}
+
+	headerLength := offset + phoneLength + 2
+	if packet.Subpackage {
+		headerLength += 4
+	}
+
+	bodyLength := int(attr & maxBodySize)
+	if len(data) != headerLength+bodyLength {
+		return nil, fmt.Errorf("body length mismatch %d != %d", len(data)-headerLength, bodyLength)
+	}
+
+	packet.Phone = DecodeBCD(data[offset : offset+phoneLength])
+	offset += phoneLength
+	packet.Serial = binary.BigEndian.Uint16(data[offset:])
+	offset += 2
+	if packet.Subpackage {
+		packet.PackageTotal = binary.BigEndian.Uint16(data[offset:])
+		packet.PackageIndex = binary.BigEndian.Uint16(data[offset+2:])
+		offset += 4
+	}
+
+	packet.Body = data[offset:]
+	return packet, nil
+}
+
+// Encode 编码消息, 返回包含首尾标识位的完整消息. 不支持分包
+func Encode(header *Header, body []byte) ([]byte, error) {
+	if len(body) > maxBodySize {
+		return nil, fmt.Errorf("body too large %d", len(body))
+	}
+
+	attr := uint16(len(body))
+	phoneLength := phoneLength2013
+	data := make([]byte, 4, 17+len(body)+1)
+	if header.Is2019 {
+		attr |= attrVersion
+		phoneLength = phoneLength2019
+		data = append(data, header.Version)
+	}
+
+	binary.BigEndian.PutUint16(data, header.MsgID)
+	binary.BigEndian.PutUint16(data[2:], attr)
+	data = append(data, EncodeBCD(header.Phone, phoneLength)...)
+	data = binary.BigEndian.AppendUint16(data, header.Serial)
+	data = append(data, body...)
+	data = append(data, checksum(data))
+
+	escaped := Escape(data)
+	packet := make([]byte, 0, len(escaped)+2)
+	packet = append(packet, flag)
+	packet = append(packet, escaped...)
+	return append(packet, flag), nil
+}
@@ -0,0 +1,337 @@
+package jt808
+
+import (
+	"bufio"
+	"fmt"
+	"gb-cms/log"
+	"net"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	// 超过该长度仍未收到结束标识, 断开连接
+	maxFrameSize = 4096
+)
+
+// Handler 终端消息的业务处理
+type Handler interface {
+	// OnRegister 返回注册结果和鉴权码
+	OnRegister(session *Session, register *Register) (byte, string)
+
+	// OnAuthenticate 鉴权成功后终端上线
+	OnAuthenticate(session *Session, auth *Authentication) bool
+
+	OnLocation(session *Session, location *Location)
+
+	// OnClose 鉴权成功的终端断开连接
+	OnClose(session *Session)
+}
+
+type Server struct {
+	handler   Handler
+	keepalive time.Duration
+	listener  net.Listener
+	lock      sync.RWMutex
+	sessions  map[string]*Session // 去掉前导0的手机号->终端会话
+}
+
+// Session 终端的TCP连接
+type Session struct {
+	Phone  string // 去掉前导0的手机号
+	conn   net.Conn
+	server *Server
+
+	phone         string // 终端上报的原始手机号
+	is2019        bool
+	version       byte
+	serial        atomic.Uint32
+	authenticated bool
+	writeLock     sync.Mutex
+
+	pendingLock sync.Mutex
+	pending     map[uint16]chan byte // 下发消息的流水号->等待终端通用应答
+	closeOnce   sync.Once
+}
+
+func NewServer(handler Handler, keepalive time.Duration) *Server {
+	return &Server{
+		handler:   handler,
+		keepalive: keepalive,
+		sessions:  make(map[string]*Session, 64),
+	}
+}
+
+func (s *Server) Start(addr string) error {
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+
+	s.listener = listener
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				log.Sugar.Errorf("部标信令服务器停止接受连接 err: %s", err.Error())
+				return
+			}
+
+			session := &Session{conn: conn, server: s, pending: make(map[uint16]chan byte, 4)}
+			go session.run()
+		}
+	}()
+
+	return nil
+}
+
+func (s *Server) Addr() net.Addr {
+	return s.listener.Addr()
+}
+
+func (s *Server) Close() {
+	_ = s.listener.Close()
+
+	s.lock.Lock()
+	sessions := s.sessions
+	s.sessions = make(map[string]*Session)
+	s.lock.Unlock()
+
+	for _, session := range sessions {
+		session.Close()
+	}
+}
+
+// Find 查找鉴权成功的终端
+func (s *Server) Find(phone string) *Session {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.sessions[NormalizePhone(phone)]
+}
+
+// 终端重连时, 关闭旧的连接
+func (s *Server) add(session *Session) {
+	s.lock.Lock()
+	old := s.sessions[session.Phone]
+	s.sessions[session.Phone] = session
+	s.lock.Unlock()
+
+	if old != nil && old != session {
+		log.Sugar.Warnf("终端重复连接, 关闭旧连接 phone: %s old: %s new: %s", session.Phone, old.conn.RemoteAddr(), session.conn.RemoteAddr())
+		old.Close()
+	}
+}
+
+func (s *Server) remove(session *Session) bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.sessions[session.Phone] != session {
+		return false
+	}
+
+	delete(s.sessions, session.Phone)
+	return true
+}
+
+func (s *Session) RemoteAddr() net.Addr {
+	return s.conn.RemoteAddr()
+}
+
+// Send 下发消息, 返回消息流水号
+func (s *Session) Send(msgId uint16, body []byte) (uint16, error) {
+	serial := uint16(s.serial.Add(1))
+	packet, err := Encode(&Header{MsgID: msgId, Is2019: s.is2019, Version: s.version, Phone: s.phone, Serial: serial}, body)
+	if err != nil {
+		return 0, err
+	}
+
+	s.writeLock.Lock()
+	defer s.writeLock.Unlock()
+	_, err = s.conn.Write(packet)
+	return serial, err
+}
+
+// Request 下发消息并等待终端通用应答, 返回应答结果
+func (s *Session) Request(msgId uint16, body []byte, timeout time.Duration) (byte, error) {
+	// 先登记再发送, 避免应答先于登记到达
+	serial := uint16(s.serial.Add(1))
+	ch := make(chan byte, 1)
+	s.pendingLock.Lock()
+	s.pending[serial] = ch
+	s.pendingLock.Unlock()
+
+	defer func() {
+		s.pendingLock.Lock()
+		delete(s.pending, serial)
+		s.pendingLock.Unlock()
+	}()
+
+	packet, err := Encode(&Header{MsgID: msgId, Is2019: s.is2019, Version: s.version, Phone: s.phone, Serial: serial}, body)
+	if err != nil {
+		return 0, err
+	}
+
+	s.writeLock.Lock()
+	_, err = s.conn.Write(packet)
+	s.writeLock.Unlock()
+	if err != nil {
+		return 0, err
+	}
+
+	select {
+	case result := <-ch:
+		return result, nil
+	case <-time.After(timeout):
+		return 0, fmt.Errorf("等待终端应答超时 msg: 0x%04x serial: %d", msgId, serial)
+	}
+}
+
+func (s *Session) Close() {
+	s.closeOnce.Do(func() {
+		_ = s.conn.Close()
+	})
+}
+
+func (s *Session) respond(packet *Packet, result byte) {
+	response := GeneralResponse{Serial: packet.Serial, MsgID: packet.MsgID, Result: result}
+	if _, err := s.Send(MsgIDPlatformResponse, response.Encode()); err != nil {
+		log.Sugar.Errorf("应答终端失败 err: %s phone: %s", err.Error(), s.phone)
+	}
+}
+
+func (s *Session) run() {
+	defer func() {
+		s.Close()
+		if s.authenticated && s.server.remove(s) {
+			s.server.handler.OnClose(s)
+		}
+	}()
+
+	reader := bufio.NewReaderSize(s.conn, 1024)
+	var frame []byte
+	for {
+		_ = s.conn.SetReadDeadline(time.Now().Add(s.server.keepalive))
+		b, err := reader.ReadByte()
+		if err != nil {
+			if s.authenticated {
+				log.Sugar.Infof("终端断开连接 err: %s phone: %s addr: %s", err.Error(), s.Phone, s.conn.RemoteAddr())
+			}
+			return
+		} else if b != flag {
+			if frame = append(frame, b); len(frame) > maxFrameSize {
+				log.Sugar.Errorf("终端消息过长, 断开连接 addr: %s", s.conn.RemoteAddr())
+				return
+			}
+			continue
+		} else if len(frame) == 0 {
+			// 起始标识
+			continue
+		}
+
+		packet, err := Decode(frame)
+		frame = frame[:0]
+		if err != nil {
+			log.Sugar.Errorf("解析终端消息失败 err: %s addr: %s", err.Error(), s.conn.RemoteAddr())
+			continue
+		}
+
+		s.onPacket(packet)
+	}
+}
+
+func (s *Session) onPacket(packet *Packet) {
+	if packet.Subpackage {
+		log.Sugar.Warnf("不支持分包消息 msg: 0x%04x phone: %s", packet.MsgID, packet.Phone)
+		s.respond(packet, ResultUnsupported)
+		return
+	}
+
+	if s.authenticated && packet.Phone != s.phone {
+		log.Sugar.Errorf("终端手机号与鉴权时不一致 phone: %s authenticated: %s", packet.Phone, s.phone)
+		s.respond(packet, ResultFailure)
+		return
+	} else if !s.authenticated {
+		// 应答使用终端上报的手机号和协议版本
+		s.phone, s.Phone, s.is2019, s.version = packet.Phone, NormalizePhone(packet.Phone), packet.Is2019, packet.Version
+
+		// 鉴权前只允许注册和鉴权
+		if MsgIDRegister != packet.MsgID && MsgIDAuthentication != packet.MsgID {
+			s.respond(packet, ResultFailure)
+			return
+		}
+	}
+
+	switch packet.MsgID {
+	case MsgIDRegister:
+		register, err := DecodeRegister(packet.Body, packet.Is2019)
+		if err != nil {
+			log.Sugar.Errorf("解析终端注册失败 err: %s phone: %s", err.Error(), packet.Phone)
+			s.respond(packet, ResultMessageErr)
+			return
+		}
+
+		result, authCode := s.server.handler.OnRegister(s, register)
+		if _, err = s.Send(MsgIDRegisterResponse, EncodeRegisterResponse(packet.Serial, result, authCode)); err != nil {
+			log.Sugar.Errorf("应答终端注册失败 err: %s phone: %s", err.Error(), packet.Phone)
+		}
+		break
+	case MsgIDAuthentication:
+		auth, err := DecodeAuthentication(packet.Body, packet.Is2019)
+		if err != nil {
+			log.Sugar.Errorf("解析终端鉴权失败 err: %s phone: %s", err.Error(), packet.Phone)
+			s.respond(packet, ResultMessageErr)
+			return
+		} else if s.authenticated {
+			s.respond(packet, ResultSuccess)
+			return
+		} else if !s.server.handler.OnAuthenticate(s, auth) {
+			s.respond(packet, ResultFailure)
+			return
+		}
+
+		s.authenticated = true
+		s.server.add(s)
+		s.respond(packet, ResultSuccess)
+		break
+	case MsgIDLogout:
+		s.respond(packet, ResultSuccess)
+		s.Close()
+		break
+	case MsgIDHeartbeat:
+		s.respond(packet, ResultSuccess)
+		break
+	case MsgIDLocation:
+		location, err := DecodeLocation(packet.Body)
+		if err != nil {
+			log.Sugar.Errorf("解析位置信息失败 err: %s phone: %s", err.Error(), packet.Phone)
+			s.respond(packet, ResultMessageErr)
+			return
+		}
+
+		s.server.handler.OnLocation(s, location)
+		s.respond(packet, ResultSuccess)
+		break
+	case MsgIDTerminalResponse:
+		response, err := DecodeGeneralResponse(packet.Body)
+		if err != nil {
+			log.Sugar.Errorf("解析终端通用应答失败 err: %s phone: %s", err.Error(), packet.Phone)
+			return
+		}
+
+		s.pendingLock.Lock()
+		ch := s.pending[response.Serial]
+		s.pendingLock.Unlock()
+		if ch != nil {
+			// 重复的应答丢弃
+			select {
+			case ch <- response.Result:
+			default:
+			}
+		}
+		break
+	default:
+		s.respond(packet, ResultUnsupported)
+		break
+	}
+}
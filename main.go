@@ -49,6 +49,44 @@ func main() {
 	if config.Hooks.OnInvite != "" {
 		hook.RegisterEventUrl(hook.EventTypeDeviceOnInvite, config.Hooks.OnInvite)
 	}
+	if config.Hooks.OnPlayback != "" {
+		hook.RegisterEventUrl(hook.EventTypeDeviceOnPlayback, config.Hooks.OnPlayback)
+	}
+	if config.Hooks.OnPlaybackControl != "" {
+		hook.RegisterEventUrl(hook.EventTypeDeviceOnPlaybackControl, config.Hooks.OnPlaybackControl)
+	}
+	if config.Hooks.OnQueryRecord != "" {
+		hook.RegisterEventUrl(hook.EventTypeDeviceOnQueryRecord, config.Hooks.OnQueryRecord)
+	}
+
+	// 异步推送的事件
+	for event, url := range map[int]string{
+		hook.EventTypeDeviceOnline:           config.Hooks.Online,
+		hook.EventTypeDeviceOffline:          config.Hooks.Offline,
+		hook.EventTypeDevicePosition:         config.Hooks.Position,
+		hook.EventTypeDeviceKeepaliveTimeout: config.Hooks.KeepaliveTimeout,
+		hook.EventTypeDeviceCatalog:          config.Hooks.Catalog,
+		hook.EventTypeDeviceAlarm:            config.Hooks.Alarm,
+		hook.EventTypeStreamStart:            config.Hooks.StreamStart,
+		hook.EventTypeStreamStop:             config.Hooks.StreamStop,
+		hook.EventTypeCascadeOnline:          config.Hooks.CascadeOnline,
+		hook.EventTypeCascadeOffline:         config.Hooks.CascadeOffline,
+		hook.EventTypeChannelStatus:          config.Hooks.ChannelStatus,
+	} {
+		if url != "" {
+			hook.RegisterEventUrl(event, url)
+		}
+	}
+
+	hook.Timeout = time.Duration(config.Hooks.Timeout) * time.Second
+	hook.StartDispatcher(hook.DispatcherOptions{
+		Secret:        config.Hooks.Secret,
+		Retries:       config.Hooks.Retries,
+		RetryInterval: time.Duration(config.Hooks.RetryInterval) * time.Second,
+		QueueSize:     config.Hooks.QueueSize,
+		Workers:       4,
+		DeadLetter:    config.Hooks.DeadLetter,
+	})
 
 	// 读取或生成密码MD5值
 	hash := md5.Sum([]byte("admin"))
@@ -91,6 +129,18 @@ func main() {
 
 	stack.Start()
 
+	// 启动内置部标信令服务器
+	if config.JT808.Port > 0 {
+		if err = stack.StartJT808Server(); err != nil {
+			panic(err)
+		}
+	}
+
+	// 发布事件到mqtt
+	if config.MQTT.Broker != "" {
+		stack.StartMQTT()
+	}
+
 	go api.StartStats()
 
 	// 启动http服务
@@ -0,0 +1,148 @@
+package onvif
+
+import (
+	"crypto/rand"
+	"encoding/xml"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	// MulticastAddr WS-Discovery组播地址
+	MulticastAddr = "239.255.255.250:3702"
+
+	ProbeFormat = "<?xml version=\"1.0\" encoding=\"UTF-8\"?>" +
+		"<e:Envelope xmlns:e=\"http://www.w3.org/2003/05/soap-envelope\" xmlns:w=\"http://schemas.xmlsoap.org/ws/2004/08/addressing\" xmlns:d=\"http://schemas.xmlsoap.org/ws/2005/04/discovery\" xmlns:dn=\"http://www.onvif.org/ver10/network/wsdl\">" +
+		"<e:Header>" +
+		"<w:MessageID>uuid:%s</w:MessageID>" +
+		"<w:To e:mustUnderstand=\"true\">urn:schemas-xmlsoap-org:ws:2005:04:discovery</w:To>" +
+		"<w:Action e:mustUnderstand=\"true\">http://schemas.xmlsoap.org/ws/2005/04/discovery/Probe</w:Action>" +
+		"</e:Header>" +
+		"<e:Body><d:Probe><d:Types>dn:NetworkVideoTransmitter</d:Types></d:Probe></e:Body>" +
+		"</e:Envelope>"
+)
+
+// Discovered 探测到的设备
+type Discovered struct {
+	Endpoint string   `json:"endpoint"` // 设备唯一标识, 例如urn:uuid:...
+	XAddrs   []string `json:"xaddrs"`   // 设备服务地址
+	Name     string   `json:"name"`     // 从scope中解析的名称和型号
+	Hardware string   `json:"hardware"`
+	Scopes   []string `json:"scopes"`
+}
+
+// XAddr 优先返回IPv4的设备服务地址
+func (d *Discovered) XAddr() string {
+	for _, addr := range d.XAddrs {
+		if u, err := url.Parse(addr); err == nil {
+			if ip := net.ParseIP(u.Hostname()); ip != nil && ip.To4() != nil {
+				return addr
+			}
+		}
+	}
+
+	if len(d.XAddrs) > 0 {
+		return d.XAddrs[0]
+	}
+
+	return ""
+}
+
+type probeMatches struct {
+	Matches []struct {
+		Endpoint string `xml:"EndpointReference>Address"`
+		Scopes   string `xml:"Scopes"`
+		XAddrs   string `xml:"XAddrs"`
+	} `xml:"Body>ProbeMatches>ProbeMatch"`
+}
+
+// Probe 发送Probe消息, 在超时时间内收集应答. addr为空时发送到组播地址
+func Probe(addr string, timeout time.Duration) ([]*Discovered, error) {
+	if addr == "" {
+		addr = MulticastAddr
+	}
+
+	dst, err := net.ResolveUDPAddr("udp4", addr)
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := net.ListenUDP("udp4", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defer conn.Close()
+	if _, err = conn.WriteToUDP([]byte(fmt.Sprintf(ProbeFormat, newUUID())), dst); err != nil {
+		return nil, err
+	}
+
+	_ = conn.SetReadDeadline(time.Now().Add(timeout))
+
+	var devices []*Discovered
+	exists := make(map[string]bool)
+	buffer := make([]byte, 64*1024)
+	for {
+		n, _, err := conn.ReadFromUDP(buffer)
+		if err != nil {
+			// 超时结束探测
+			break
+		}
+
+		var matches probeMatches
+		if xml.Unmarshal(buffer[:n], &matches) != nil {
+			continue
+		}
+
+		for _, match := range matches.Matches {
+			if match.XAddrs == "" || exists[match.Endpoint] {
+				continue
+			}
+
+			exists[match.Endpoint] = true
+			device := &Discovered{
+				Endpoint: match.Endpoint,
+				XAddrs:   strings.Fields(match.XAddrs),
+				Scopes:   strings.Fields(match.Scopes),
+			}
+
+			for _, scope := range device.Scopes {
+				if value, ok := scopeValue(scope, "name/"); ok {
+					device.Name = value
+				} else if value, ok = scopeValue(scope, "hardware/"); ok {
+					device.Hardware = value
+				}
+			}
+
+			devices = append(devices, device)
+		}
+	}
+
+	return devices, nil
+}
+
+// 解析onvif://www.onvif.org/name/xxx格式的scope
+func scopeValue(scope, key string) (string, bool) {
+	index := strings.Index(scope, "onvif://www.onvif.org/"+key)
+	if index < 0 {
+		return "", false
+	}
+
+	value := scope[index+len("onvif://www.onvif.org/"+key):]
+	if unescaped, err := url.PathUnescape(value); err == nil {
+		value = unescaped
+	}
+
+	return value, true
+}
+
+func newUUID() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+}
@@ -0,0 +1,245 @@
+// Package onvif 实现接入ONVIF摄像机所需的最小功能集: WS-Discovery设备发现、
+// 设备信息和能力查询、媒体配置和取流地址查询, 以及PTZ连续移动/停止.
+package onvif
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	NamespaceDevice = "http://www.onvif.org/ver10/device/wsdl"
+	NamespaceMedia  = "http://www.onvif.org/ver10/media/wsdl"
+	NamespacePTZ    = "http://www.onvif.org/ver20/ptz/wsdl"
+	NamespaceSchema = "http://www.onvif.org/ver10/schema"
+
+	EnvelopeFormat = "<?xml version=\"1.0\" encoding=\"UTF-8\"?>" +
+		"<s:Envelope xmlns:s=\"http://www.w3.org/2003/05/soap-envelope\">" +
+		"<s:Header>%s</s:Header>" +
+		"<s:Body>%s</s:Body>" +
+		"</s:Envelope>"
+
+	// SecurityFormat WS-Security UsernameToken, 密码使用摘要模式
+	SecurityFormat = "<wsse:Security s:mustUnderstand=\"1\" xmlns:wsse=\"http://docs.oasis-open.org/wss/2004/01/oasis-200401-wss-wssecurity-secext-1.0.xsd\" xmlns:wsu=\"http://docs.oasis-open.org/wss/2004/01/oasis-200401-wss-wssecurity-utility-1.0.xsd\">" +
+		"<wsse:UsernameToken>" +
+		"<wsse:Username>%s</wsse:Username>" +
+		"<wsse:Password Type=\"http://docs.oasis-open.org/wss/2004/01/oasis-200401-wss-username-token-profile-1.0#PasswordDigest\">%s</wsse:Password>" +
+		"<wsse:Nonce EncodingType=\"http://docs.oasis-open.org/wss/2004/01/oasis-200401-wss-soap-message-security-1.0#Base64Binary\">%s</wsse:Nonce>" +
+		"<wsu:Created>%s</wsu:Created>" +
+		"</wsse:UsernameToken>" +
+		"</wsse:Security>"
+)
+
+type Fault struct {
+	Code   string `xml:"Code>Value"`
+	Reason string `xml:"Reason>Text"`
+}
+
+func (f *Fault) Error() string {
+	return fmt.Sprintf("onvif fault: %s %s", f.Code, f.Reason)
+}
+
+type envelope struct {
+	Body struct {
+		Fault *Fault `xml:"Fault"`
+		Inner []byte `xml:",innerxml"`
+	} `xml:"Body"`
+}
+
+type DeviceInformation struct {
+	Manufacturer    string `xml:"Manufacturer" json:"manufacturer"`
+	Model           string `xml:"Model" json:"model"`
+	FirmwareVersion string `xml:"FirmwareVersion" json:"firmware_version"`
+	SerialNumber    string `xml:"SerialNumber" json:"serial_number"`
+	HardwareID      string `xml:"HardwareId" json:"hardware_id"`
+}
+
+// Capabilities 各服务的地址, 不支持的服务为空
+type Capabilities struct {
+	Media string `xml:"Capabilities>Media>XAddr"`
+	PTZ   string `xml:"Capabilities>PTZ>XAddr"`
+}
+
+type Profile struct {
+	Token    string    `xml:"token,attr" json:"token"`
+	Name     string    `xml:"Name" json:"name"`
+	Encoding string    `xml:"VideoEncoderConfiguration>Encoding" json:"encoding"`
+	Width    int       `xml:"VideoEncoderConfiguration>Resolution>Width" json:"width"`
+	Height   int       `xml:"VideoEncoderConfiguration>Resolution>Height" json:"height"`
+	PTZ      *struct{} `xml:"PTZConfiguration" json:"-"`
+}
+
+// SupportPTZ 是否配置了云台
+func (p *Profile) SupportPTZ() bool {
+	return p.PTZ != nil
+}
+
+// Client ONVIF设备服务客户端, XAddr为设备服务地址, 例如http://192.168.1.64/onvif/device_service
+type Client struct {
+	XAddr    string
+	Username string
+	Password string
+
+	HttpClient *http.Client
+	// 设备时间与本地时间的差值, 摘要认证使用设备时间, 避免时间不同步导致认证失败
+	TimeOffset time.Duration
+}
+
+func NewClient(xaddr, username, password string) *Client {
+	return &Client{
+		XAddr:      xaddr,
+		Username:   username,
+		Password:   password,
+		HttpClient: &http.Client{Timeout: 5 * time.Second},
+	}
+}
+
+// 生成UsernameToken, digest = base64(sha1(nonce + created + password))
+func (c *Client) security() string {
+	if c.Username == "" {
+		return ""
+	}
+
+	nonce := make([]byte, 16)
+	_, _ = rand.Read(nonce)
+	created := time.Now().Add(c.TimeOffset).UTC().Format("2006-01-02T15:04:05.000Z")
+
+	hash := sha1.New()
+	hash.Write(nonce)
+	hash.Write([]byte(created))
+	hash.Write([]byte(c.Password))
+	digest := base64.StdEncoding.EncodeToString(hash.Sum(nil))
+
+	return fmt.Sprintf(SecurityFormat, escape(c.Username), digest, base64.StdEncoding.EncodeToString(nonce), created)
+}
+
+// Call 向服务地址发送请求, 将Body中的应答解析到response
+func (c *Client) Call(xaddr, body string, response interface{}) error {
+	data := fmt.Sprintf(EnvelopeFormat, c.security(), body)
+	resp, err := c.HttpClient.Post(xaddr, "application/soap+xml; charset=utf-8", strings.NewReader(data))
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close()
+	content, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	var env envelope
+	if err = xml.Unmarshal(content, &env); err != nil {
+		return fmt.Errorf("解析应答失败 status: %d err: %s", resp.StatusCode, err.Error())
+	} else if env.Body.Fault != nil {
+		return env.Body.Fault
+	} else if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("应答错误 status: %d", resp.StatusCode)
+	} else if response == nil {
+		return nil
+	}
+
+	return xml.Unmarshal(bytes.TrimSpace(env.Body.Inner), response)
+}
+
+// SyncTime 查询设备时间, 计算与本地时间的差值. 该请求不需要认证
+func (c *Client) SyncTime() error {
+	var response struct {
+		Year   int `xml:"SystemDateAndTime>UTCDateTime>Date>Year"`
+		Month  int `xml:"SystemDateAndTime>UTCDateTime>Date>Month"`
+		Day    int `xml:"SystemDateAndTime>UTCDateTime>Date>Day"`
+		Hour   int `xml:"SystemDateAndTime>UTCDateTime>Time>Hour"`
+		Minute int `xml:"SystemDateAndTime>UTCDateTime>Time>Minute"`
+		Second int `xml:"SystemDateAndTime>UTCDateTime>Time>Second"`
+	}
+
+	anonymous := *c
+	anonymous.Username = ""
+	if err := anonymous.Call(c.XAddr, fmt.Sprintf("<GetSystemDateAndTime xmlns=\"%s\"/>", NamespaceDevice), &response); err != nil {
+		return err
+	} else if response.Year > 0 {
+		utc := time.Date(response.Year, time.Month(response.Month), response.Day, response.Hour, response.Minute, response.Second, 0, time.UTC)
+		c.TimeOffset = utc.Sub(time.Now())
+	}
+
+	return nil
+}
+
+func (c *Client) GetDeviceInformation() (*DeviceInformation, error) {
+	var info DeviceInformation
+	if err := c.Call(c.XAddr, fmt.Sprintf("<GetDeviceInformation xmlns=\"%s\"/>", NamespaceDevice), &info); err != nil {
+		return nil, err
+	}
+
+	return &info, nil
+}
+
+func (c *Client) GetCapabilities() (*Capabilities, error) {
+	var capabilities Capabilities
+	body := fmt.Sprintf("<GetCapabilities xmlns=\"%s\"><Category>All</Category></GetCapabilities>", NamespaceDevice)
+	if err := c.Call(c.XAddr, body, &capabilities); err != nil {
+		return nil, err
+	}
+
+	return &capabilities, nil
+}
+
+// GetProfiles 查询媒体配置, mediaXAddr为GetCapabilities返回的媒体服务地址
+func (c *Client) GetProfiles(mediaXAddr string) ([]*Profile, error) {
+	var response struct {
+		Profiles []*Profile `xml:"Profiles"`
+	}
+
+	if err := c.Call(mediaXAddr, fmt.Sprintf("<GetProfiles xmlns=\"%s\"/>", NamespaceMedia), &response); err != nil {
+		return nil, err
+	}
+
+	return response.Profiles, nil
+}
+
+// GetStreamUri 查询媒体配置的RTSP单播取流地址
+func (c *Client) GetStreamUri(mediaXAddr, profileToken string) (string, error) {
+	var response struct {
+		Uri string `xml:"MediaUri>Uri"`
+	}
+
+	body := fmt.Sprintf("<GetStreamUri xmlns=\"%s\">"+
+		"<StreamSetup><Stream xmlns=\"%s\">RTP-Unicast</Stream><Transport xmlns=\"%s\"><Protocol>RTSP</Protocol></Transport></StreamSetup>"+
+		"<ProfileToken>%s</ProfileToken>"+
+		"</GetStreamUri>", NamespaceMedia, NamespaceSchema, NamespaceSchema, escape(profileToken))
+	if err := c.Call(mediaXAddr, body, &response); err != nil {
+		return "", err
+	} else if response.Uri == "" {
+		return "", fmt.Errorf("取流地址为空 profile: %s", profileToken)
+	}
+
+	return response.Uri, nil
+}
+
+// ContinuousMove 云台连续移动, 速度取值范围[-1, 1], 直到调用Stop
+func (c *Client) ContinuousMove(ptzXAddr, profileToken string, x, y, zoom float64) error {
+	body := fmt.Sprintf("<ContinuousMove xmlns=\"%s\">"+
+		"<ProfileToken>%s</ProfileToken>"+
+		"<Velocity><PanTilt xmlns=\"%s\" x=\"%.2f\" y=\"%.2f\"/><Zoom xmlns=\"%s\" x=\"%.2f\"/></Velocity>"+
+		"</ContinuousMove>", NamespacePTZ, escape(profileToken), NamespaceSchema, x, y, NamespaceSchema, zoom)
+	return c.Call(ptzXAddr, body, nil)
+}
+
+// Stop 停止云台移动和变倍
+func (c *Client) Stop(ptzXAddr, profileToken string) error {
+	body := fmt.Sprintf("<Stop xmlns=\"%s\"><ProfileToken>%s</ProfileToken><PanTilt>true</PanTilt><Zoom>true</Zoom></Stop>", NamespacePTZ, escape(profileToken))
+	return c.Call(ptzXAddr, body, nil)
+}
+
+func escape(s string) string {
+	var buffer bytes.Buffer
+	_ = xml.EscapeText(&buffer, []byte(s))
+	return buffer.String()
+}
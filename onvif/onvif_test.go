@@ -0,0 +1,210 @@
+package onvif
+
+import (
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+const (
+	stubUsername = "admin"
+	stubPassword = "12345"
+
+	stubEnvelope = "<?xml version=\"1.0\" encoding=\"UTF-8\"?>" +
+		"<SOAP-ENV:Envelope xmlns:SOAP-ENV=\"http://www.w3.org/2003/05/soap-envelope\" xmlns:tds=\"http://www.onvif.org/ver10/device/wsdl\" xmlns:trt=\"http://www.onvif.org/ver10/media/wsdl\" xmlns:tt=\"http://www.onvif.org/ver10/schema\" xmlns:tptz=\"http://www.onvif.org/ver20/ptz/wsdl\">" +
+		"<SOAP-ENV:Body>\r\n%s\r\n</SOAP-ENV:Body></SOAP-ENV:Envelope>"
+
+	stubFault = "<SOAP-ENV:Fault><SOAP-ENV:Code><SOAP-ENV:Value>SOAP-ENV:Sender</SOAP-ENV:Value></SOAP-ENV:Code>" +
+		"<SOAP-ENV:Reason><SOAP-ENV:Text xml:lang=\"en\">Sender not Authorized</SOAP-ENV:Text></SOAP-ENV:Reason></SOAP-ENV:Fault>"
+)
+
+// 模拟ONVIF设备, 校验摘要认证, 按请求的方法返回应答
+type stub struct {
+	server *httptest.Server
+	moves  []string
+}
+
+func newStub() *stub {
+	s := &stub{}
+	s.server = httptest.NewServer(http.HandlerFunc(s.handle))
+	return s
+}
+
+func (s *stub) authorized(body string) bool {
+	var request struct {
+		Username string `xml:"Header>Security>UsernameToken>Username"`
+		Password string `xml:"Header>Security>UsernameToken>Password"`
+		Nonce    string `xml:"Header>Security>UsernameToken>Nonce"`
+		Created  string `xml:"Header>Security>UsernameToken>Created"`
+	}
+
+	if xml.Unmarshal([]byte(body), &request) != nil || request.Username != stubUsername {
+		return false
+	}
+
+	nonce, _ := base64.StdEncoding.DecodeString(request.Nonce)
+	hash := sha1.New()
+	hash.Write(nonce)
+	hash.Write([]byte(request.Created))
+	hash.Write([]byte(stubPassword))
+	return request.Password == base64.StdEncoding.EncodeToString(hash.Sum(nil))
+}
+
+func (s *stub) handle(w http.ResponseWriter, r *http.Request) {
+	data, _ := io.ReadAll(r.Body)
+	body := string(data)
+
+	var response string
+	if strings.Contains(body, "<GetSystemDateAndTime") {
+		response = "<tds:GetSystemDateAndTimeResponse><tds:SystemDateAndTime><tt:UTCDateTime>" +
+			"<tt:Time><tt:Hour>1</tt:Hour><tt:Minute>2</tt:Minute><tt:Second>3</tt:Second></tt:Time>" +
+			"<tt:Date><tt:Year>2030</tt:Year><tt:Month>1</tt:Month><tt:Day>1</tt:Day></tt:Date>" +
+			"</tt:UTCDateTime></tds:SystemDateAndTime></tds:GetSystemDateAndTimeResponse>"
+	} else if !s.authorized(body) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = fmt.Fprintf(w, stubEnvelope, stubFault)
+		return
+	} else if strings.Contains(body, "<GetDeviceInformation") {
+		response = "<tds:GetDeviceInformationResponse><tds:Manufacturer>Stub</tds:Manufacturer><tds:Model>IPC-1</tds:Model>" +
+			"<tds:FirmwareVersion>V1.0</tds:FirmwareVersion><tds:SerialNumber>SN001</tds:SerialNumber><tds:HardwareId>HW</tds:HardwareId>" +
+			"</tds:GetDeviceInformationResponse>"
+	} else if strings.Contains(body, "<GetCapabilities") {
+		response = fmt.Sprintf("<tds:GetCapabilitiesResponse><tds:Capabilities>"+
+			"<tt:Device><tt:XAddr>%[1]s/onvif/device_service</tt:XAddr></tt:Device>"+
+			"<tt:Media><tt:XAddr>%[1]s/onvif/media</tt:XAddr></tt:Media>"+
+			"<tt:PTZ><tt:XAddr>%[1]s/onvif/ptz</tt:XAddr></tt:PTZ>"+
+			"</tds:Capabilities></tds:GetCapabilitiesResponse>", s.server.URL)
+	} else if strings.Contains(body, "<GetProfiles") {
+		response = "<trt:GetProfilesResponse>" +
+			"<trt:Profiles token=\"main\" fixed=\"true\"><tt:Name>mainStream</tt:Name>" +
+			"<tt:VideoEncoderConfiguration token=\"v0\"><tt:Encoding>H264</tt:Encoding><tt:Resolution><tt:Width>1920</tt:Width><tt:Height>1080</tt:Height></tt:Resolution></tt:VideoEncoderConfiguration>" +
+			"<tt:PTZConfiguration token=\"ptz0\"><tt:Name>ptz</tt:Name></tt:PTZConfiguration>" +
+			"</trt:Profiles>" +
+			"<trt:Profiles token=\"sub\" fixed=\"true\"><tt:Name>subStream</tt:Name>" +
+			"<tt:VideoEncoderConfiguration token=\"v1\"><tt:Encoding>H264</tt:Encoding><tt:Resolution><tt:Width>640</tt:Width><tt:Height>360</tt:Height></tt:Resolution></tt:VideoEncoderConfiguration>" +
+			"</trt:Profiles>" +
+			"</trt:GetProfilesResponse>"
+	} else if strings.Contains(body, "<GetStreamUri") {
+		token := body[strings.Index(body, "<ProfileToken>")+len("<ProfileToken>") : strings.Index(body, "</ProfileToken>")]
+		response = fmt.Sprintf("<trt:GetStreamUriResponse><trt:MediaUri><tt:Uri>rtsp://127.0.0.1:554/%s</tt:Uri>"+
+			"<tt:InvalidAfterConnect>false</tt:InvalidAfterConnect></trt:MediaUri></trt:GetStreamUriResponse>", token)
+	} else if strings.Contains(body, "<ContinuousMove") {
+		s.moves = append(s.moves, body[strings.Index(body, "<Velocity>"):strings.Index(body, "</Velocity>")])
+		response = "<tptz:ContinuousMoveResponse/>"
+	} else if strings.Contains(body, "<Stop") {
+		s.moves = append(s.moves, "stop")
+		response = "<tptz:StopResponse/>"
+	}
+
+	w.Header().Set("Content-Type", "application/soap+xml; charset=utf-8")
+	_, _ = fmt.Fprintf(w, stubEnvelope, response)
+}
+
+func TestClient(t *testing.T) {
+	s := newStub()
+	defer s.server.Close()
+
+	client := NewClient(s.server.URL+"/onvif/device_service", stubUsername, stubPassword)
+	if err := client.SyncTime(); err != nil {
+		t.Fatal(err)
+	} else if client.TimeOffset < time.Hour {
+		t.Fatalf("time offset %s", client.TimeOffset)
+	}
+
+	info, err := client.GetDeviceInformation()
+	if err != nil {
+		t.Fatal(err)
+	} else if info.Manufacturer != "Stub" || info.Model != "IPC-1" || info.FirmwareVersion != "V1.0" || info.SerialNumber != "SN001" {
+		t.Fatalf("device information %+v", *info)
+	}
+
+	capabilities, err := client.GetCapabilities()
+	if err != nil {
+		t.Fatal(err)
+	} else if capabilities.Media != s.server.URL+"/onvif/media" || capabilities.PTZ != s.server.URL+"/onvif/ptz" {
+		t.Fatalf("capabilities %+v", *capabilities)
+	}
+
+	profiles, err := client.GetProfiles(capabilities.Media)
+	if err != nil {
+		t.Fatal(err)
+	} else if len(profiles) != 2 {
+		t.Fatalf("profiles %d", len(profiles))
+	} else if p := profiles[0]; p.Token != "main" || p.Name != "mainStream" || p.Encoding != "H264" || p.Width != 1920 || p.Height != 1080 || !p.SupportPTZ() {
+		t.Fatalf("profile %+v", *p)
+	} else if profiles[1].SupportPTZ() {
+		t.Fatal("sub profile has no ptz")
+	}
+
+	uri, err := client.GetStreamUri(capabilities.Media, "sub")
+	if err != nil {
+		t.Fatal(err)
+	} else if uri != "rtsp://127.0.0.1:554/sub" {
+		t.Fatalf("stream uri %s", uri)
+	}
+
+	if err = client.ContinuousMove(capabilities.PTZ, "main", -0.5, 0, 0); err != nil {
+		t.Fatal(err)
+	} else if err = client.Stop(capabilities.PTZ, "main"); err != nil {
+		t.Fatal(err)
+	} else if len(s.moves) != 2 || !strings.Contains(s.moves[0], "x=\"-0.50\" y=\"0.00\"") || s.moves[1] != "stop" {
+		t.Fatalf("moves %v", s.moves)
+	}
+
+	// 密码错误返回Fault
+	client.Password = "wrong"
+	if _, err = client.GetDeviceInformation(); err == nil || !strings.Contains(err.Error(), "Sender not Authorized") {
+		t.Fatalf("expected fault, got %v", err)
+	}
+}
+
+func TestProbe(t *testing.T) {
+	conn, err := net.ListenUDP("udp4", &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1)})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer conn.Close()
+	go func() {
+		buffer := make([]byte, 4096)
+		n, addr, err := conn.ReadFromUDP(buffer)
+		if err != nil || !strings.Contains(string(buffer[:n]), "NetworkVideoTransmitter") {
+			return
+		}
+
+		match := "<?xml version=\"1.0\" encoding=\"UTF-8\"?>" +
+			"<SOAP-ENV:Envelope xmlns:SOAP-ENV=\"http://www.w3.org/2003/05/soap-envelope\" xmlns:wsa=\"http://schemas.xmlsoap.org/ws/2004/08/addressing\" xmlns:d=\"http://schemas.xmlsoap.org/ws/2005/04/discovery\">" +
+			"<SOAP-ENV:Body><d:ProbeMatches><d:ProbeMatch>" +
+			"<wsa:EndpointReference><wsa:Address>urn:uuid:stub-0001</wsa:Address></wsa:EndpointReference>" +
+			"<d:Types>dn:NetworkVideoTransmitter</d:Types>" +
+			"<d:Scopes>onvif://www.onvif.org/type/video_encoder onvif://www.onvif.org/name/Stub%20Camera onvif://www.onvif.org/hardware/IPC-1</d:Scopes>" +
+			"<d:XAddrs>http://[fe80::1]/onvif/device_service http://192.168.1.64/onvif/device_service</d:XAddrs>" +
+			"</d:ProbeMatch></d:ProbeMatches></SOAP-ENV:Body></SOAP-ENV:Envelope>"
+
+		// 重复应答只保留一个
+		_, _ = conn.WriteToUDP([]byte(match), addr)
+		_, _ = conn.WriteToUDP([]byte(match), addr)
+	}()
+
+	devices, err := Probe(conn.LocalAddr().String(), 500*time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	} else if len(devices) != 1 {
+		t.Fatalf("devices %d", len(devices))
+	}
+
+	device := devices[0]
+	if device.Endpoint != "urn:uuid:stub-0001" || device.Name != "Stub Camera" || device.Hardware != "IPC-1" {
+		t.Fatalf("device %+v", *device)
+	} else if device.XAddr() != "http://192.168.1.64/onvif/device_service" {
+		t.Fatalf("xaddr %s", device.XAddr())
+	}
+}
@@ -0,0 +1,160 @@
+package sdp
+
+import (
+	"bytes"
+	"strconv"
+	"strings"
+)
+
+// GB28181 s= 会话类型
+const (
+	SessionPlay     = "Play"
+	SessionPlayback = "Playback"
+	SessionDownload = "Download"
+	SessionTalk     = "Talk"
+)
+
+// GB28181 f= 视频编码格式
+const (
+	VideoCodecMPEG4 = 1
+	VideoCodecH264  = 2
+	VideoCodecSVAC  = 3
+	VideoCodec3GP   = 4
+	VideoCodecH265  = 5
+)
+
+// GB28181 f= 分辨率编号对应的宽高
+var resolutions = map[string][2]int{
+	"1": {176, 144},   // QCIF
+	"2": {352, 288},   // CIF
+	"3": {704, 576},   // 4CIF
+	"4": {720, 576},   // D1
+	"5": {1280, 720},  // 720P
+	"6": {1920, 1080}, // 1080P/I
+}
+
+// MediaDescription GB28181 f=媒体描述, 格式: f=v/编码格式/分辨率/帧率/码率类型/码率大小a/编码格式/码率大小/采样率
+// 未填写的字段为零值.
+type MediaDescription struct {
+	VideoCodec   int    // 1-MPEG-4 2-H.264 3-SVAC 4-3GP 5-H.265
+	Resolution   string // 1-QCIF 2-CIF 3-4CIF 4-D1 5-720P 6-1080P/I, 部分设备直接填写宽x高
+	FrameRate    int    // 帧率 0~99
+	BitrateType  int    // 1-固定码率 2-可变码率
+	VideoBitrate int    // 码率 kbps
+	AudioCodec   int    // 1-G.711 2-G.723.1 3-G.729 4-G.722.1
+	AudioBitrate int    // 音频码率编号
+	SampleRate   int    // 1-8kHz 2-14kHz 3-16kHz 4-32kHz
+}
+
+// Size 返回分辨率的宽高, 无法识别返回0
+func (m *MediaDescription) Size() (int, int) {
+	if size, ok := resolutions[m.Resolution]; ok {
+		return size[0], size[1]
+	}
+
+	split := strings.Split(strings.ToLower(m.Resolution), "x")
+	if len(split) != 2 {
+		return 0, 0
+	}
+
+	width, _ := strconv.Atoi(split[0])
+	height, _ := strconv.Atoi(split[1])
+	return width, height
+}
+
+func (m *MediaDescription) String() string {
+	itoa := func(v int) string {
+		if v == 0 {
+			return ""
+		}
+		return strconv.Itoa(v)
+	}
+
+	return "v/" + strings.Join([]string{itoa(m.VideoCodec), m.Resolution, itoa(m.FrameRate), itoa(m.BitrateType), itoa(m.VideoBitrate)}, "/") +
+		"a/" + strings.Join([]string{itoa(m.AudioCodec), itoa(m.AudioBitrate), itoa(m.SampleRate)}, "/")
+}
+
+func (m *MediaDescription) Append(b *bytes.Buffer) {
+	b.WriteString("f=")
+	b.WriteString(m.String())
+	b.WriteString("\r\n")
+}
+
+// ParseMediaDescription 解析f=行的值, 例如"v/2/6/25/1/4096a/1/8/1"
+func ParseMediaDescription(s string) *MediaDescription {
+	m := &MediaDescription{}
+	var video, audio string
+	if n := strings.Index(s, "a/"); n >= 0 {
+		video, audio = s[:n], s[n+2:]
+	} else {
+		video = s
+	}
+
+	atoi := func(fields []string, i int) int {
+		if i >= len(fields) {
+			return 0
+		}
+		v, _ := strconv.Atoi(fields[i])
+		return v
+	}
+
+	if strings.HasPrefix(video, "v/") {
+		fields := strings.Split(video[2:], "/")
+		m.VideoCodec = atoi(fields, 0)
+		if len(fields) > 1 {
+			m.Resolution = fields[1]
+		}
+		m.FrameRate = atoi(fields, 2)
+		m.BitrateType = atoi(fields, 3)
+		m.VideoBitrate = atoi(fields, 4)
+	}
+
+	if audio != "" {
+		fields := strings.Split(audio, "/")
+		m.AudioCodec = atoi(fields, 0)
+		m.AudioBitrate = atoi(fields, 1)
+		m.SampleRate = atoi(fields, 2)
+	}
+
+	return m
+}
+
+// SessionType 返回规范的会话类型, 兼容大小写不一致的设备. 无法识别的原样返回
+func (sdp *SDP) SessionType() string {
+	for _, session := range []string{SessionPlay, SessionPlayback, SessionDownload, SessionTalk} {
+		if strings.EqualFold(session, sdp.Session) {
+			return session
+		}
+	}
+
+	return sdp.Session
+}
+
+// 输出GB28181扩展的a=属性
+func (sdp *SDP) appendGBAttrs(b *bytes.Buffer) {
+	if sdp.Setup != "" {
+		b.WriteString("a=setup:")
+		b.WriteString(sdp.Setup)
+		b.WriteString("\r\n")
+	}
+	if sdp.Connection != "" {
+		b.WriteString("a=connection:")
+		b.WriteString(sdp.Connection)
+		b.WriteString("\r\n")
+	}
+	if sdp.DownloadSpeed > 0 {
+		b.WriteString("a=downloadspeed:")
+		b.WriteString(strconv.Itoa(sdp.DownloadSpeed))
+		b.WriteString("\r\n")
+	}
+	if sdp.FileSize > 0 {
+		b.WriteString("a=filesize:")
+		b.WriteString(strconv.FormatInt(sdp.FileSize, 10))
+		b.WriteString("\r\n")
+	}
+	if sdp.StreamNumber != "" {
+		b.WriteString("a=streamnumber:")
+		b.WriteString(sdp.StreamNumber)
+		b.WriteString("\r\n")
+	}
+}
@@ -93,6 +93,15 @@ type SDP struct {
 	RecvOnly bool        // True if 'a=recvonly' was specified in SDP
 	Attrs    [][2]string // a= lines we don't recognize
 	Other    [][2]string // Other description
+
+	// GB28181扩展
+	SSRC          string            // y= SSRC
+	MediaDesc     *MediaDescription // f= 媒体描述
+	Setup         string            // a=setup TCP连接方式, active/passive
+	Connection    string            // a=connection new/existing
+	DownloadSpeed int               // a=downloadspeed 下载倍速
+	FileSize      int64             // a=filesize 下载文件大小
+	StreamNumber  string            // a=streamnumber 码流编号, 为空表示未指定
 }
 
 // Easy way to create a basic, everyday SDP for VoIP.
@@ -194,6 +203,24 @@ func Parse(s string) (sdp *SDP, err error) {
 				} else {
 					log.Println("Invalid SDP Ptime value", ptimeS)
 				}
+			case strings.HasPrefix(line, "setup:"):
+				sdp.Setup = line[6:]
+			case strings.HasPrefix(line, "connection:"):
+				sdp.Connection = line[11:]
+			case strings.HasPrefix(line, "downloadspeed:"):
+				if speed, err := strconv.Atoi(line[14:]); err == nil && speed > 0 {
+					sdp.DownloadSpeed = speed
+				} else {
+					log.Println("Invalid SDP downloadspeed value", line[14:])
+				}
+			case strings.HasPrefix(line, "filesize:"):
+				if size, err := strconv.ParseInt(line[9:], 10, 64); err == nil && size > 0 {
+					sdp.FileSize = size
+				} else {
+					log.Println("Invalid SDP filesize value", line[9:])
+				}
+			case strings.HasPrefix(line, "streamnumber:"):
+				sdp.StreamNumber = line[13:]
 			case line == "sendrecv":
 			case line == "sendonly":
 				sdp.SendOnly = true
@@ -214,6 +241,10 @@ func Parse(s string) (sdp *SDP, err error) {
 					sdp.Attrs[l] = [2]string{line, ""}
 				}
 			}
+		case line[0] == 'y': // GB28181 ssrc
+			sdp.SSRC = line[2:]
+		case line[0] == 'f': // GB28181 媒体描述
+			sdp.MediaDesc = ParseMediaDescription(line[2:])
 		default:
 
 			// Other unknown fields will be saved here
@@ -356,6 +387,15 @@ func (sdp *SDP) Append(b *bytes.Buffer) {
 	} else {
 		b.WriteString("a=sendrecv\r\n")
 	}
+	sdp.appendGBAttrs(b)
+	if sdp.SSRC != "" {
+		b.WriteString("y=")
+		b.WriteString(sdp.SSRC)
+		b.WriteString("\r\n")
+	}
+	if sdp.MediaDesc != nil {
+		sdp.MediaDesc.Append(b)
+	}
 
 	// save unknown field
 	if sdp.Other != nil {
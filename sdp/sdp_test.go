@@ -473,3 +473,191 @@ func TestFormatSDP(t *testing.T) {
 		}
 	}
 }
+
+type gbSdpTest struct {
+	name string // arbitrary name for test
+	s    string // raw sdp input to parse
+	s2   string // non-blank if sdp looks different when we format it
+	sdp  *sdp.SDP
+	w, h int // resolution from f=
+}
+
+var gbSdpTests = []gbSdpTest{
+	{
+		name: "GB28181 Play TCP passive",
+		s: "v=0\r\n" +
+			"o=34020000001320000001 0 0 IN IP4 192.168.1.64\r\n" +
+			"s=Play\r\n" +
+			"c=IN IP4 192.168.1.64\r\n" +
+			"t=0 0\r\n" +
+			"m=video 15060 TCP/RTP/AVP 96 98\r\n" +
+			"a=rtpmap:96 PS/90000\r\n" +
+			"a=rtpmap:98 H264/90000\r\n" +
+			"a=recvonly\r\n" +
+			"a=setup:passive\r\n" +
+			"a=connection:new\r\n" +
+			"a=streamnumber:1\r\n" +
+			"y=0100000001\r\n" +
+			"f=v/2/6/25/1/4096a/1/8/1\r\n",
+		sdp: &sdp.SDP{
+			Session:      "Play",
+			Addr:         "192.168.1.64",
+			Time:         "0 0",
+			RecvOnly:     true,
+			SSRC:         "0100000001",
+			Setup:        "passive",
+			Connection:   "new",
+			StreamNumber: "1",
+			MediaDesc: &sdp.MediaDescription{
+				VideoCodec:   sdp.VideoCodecH264,
+				Resolution:   "6",
+				FrameRate:    25,
+				BitrateType:  1,
+				VideoBitrate: 4096,
+				AudioCodec:   1,
+				AudioBitrate: 8,
+				SampleRate:   1,
+			},
+		},
+		w: 1920,
+		h: 1080,
+	},
+
+	{
+		name: "GB28181 Download",
+		s: "v=0\r\n" +
+			"o=34020000001320000001 0 0 IN IP4 192.168.1.64\r\n" +
+			"s=download\r\n" +
+			"c=IN IP4 192.168.1.64\r\n" +
+			"t=1700000000 1700003600\r\n" +
+			"m=video 15062 RTP/AVP 96\r\n" +
+			"a=rtpmap:96 PS/90000\r\n" +
+			"a=sendonly\r\n" +
+			"a=downloadspeed:4\r\n" +
+			"a=filesize:1048576\r\n" +
+			"y=1100000002\r\n" +
+			"f=v/////a/1/8/1\r\n",
+		sdp: &sdp.SDP{
+			Session:       "download",
+			Addr:          "192.168.1.64",
+			Time:          "1700000000 1700003600",
+			SendOnly:      true,
+			SSRC:          "1100000002",
+			DownloadSpeed: 4,
+			FileSize:      1048576,
+			MediaDesc: &sdp.MediaDescription{
+				AudioCodec:   1,
+				AudioBitrate: 8,
+				SampleRate:   1,
+			},
+		},
+	},
+
+	{
+		name: "GB28181 resolution width x height",
+		s: "v=0\r\n" +
+			"o=34020000001320000001 0 0 IN IP4 192.168.1.64\r\n" +
+			"s=Playback\r\n" +
+			"c=IN IP4 192.168.1.64\r\n" +
+			"t=1700000000 1700003600\r\n" +
+			"m=video 15064 RTP/AVP 100\r\n" +
+			"a=rtpmap:100 H265/90000\r\n" +
+			"y=1100000003\r\n" +
+			"f=v/5/2560x1440/30/2/8192a///\r\n",
+		s2: "v=0\r\n" +
+			"o=34020000001320000001 0 0 IN IP4 192.168.1.64\r\n" +
+			"s=Playback\r\n" +
+			"c=IN IP4 192.168.1.64\r\n" +
+			"t=1700000000 1700003600\r\n" +
+			"m=video 15064 RTP/AVP 100\r\n" +
+			"a=rtpmap:100 H265/90000\r\n" +
+			"a=sendrecv\r\n" +
+			"y=1100000003\r\n" +
+			"f=v/5/2560x1440/30/2/8192a///\r\n",
+		sdp: &sdp.SDP{
+			Session: "Playback",
+			Addr:    "192.168.1.64",
+			Time:    "1700000000 1700003600",
+			SSRC:    "1100000003",
+			MediaDesc: &sdp.MediaDescription{
+				VideoCodec:   sdp.VideoCodecH265,
+				Resolution:   "2560x1440",
+				FrameRate:    30,
+				BitrateType:  2,
+				VideoBitrate: 8192,
+			},
+		},
+		w: 2560,
+		h: 1440,
+	},
+}
+
+func TestParseGB28181(t *testing.T) {
+	for _, test := range gbSdpTests {
+		s, err := sdp.Parse(test.s)
+		if err != nil {
+			t.Error(test.name, err)
+			continue
+		}
+
+		if test.sdp.Session != s.Session {
+			t.Error(test.name, "Session", test.sdp.Session, "!=", s.Session)
+		}
+		if test.sdp.Time != s.Time {
+			t.Error(test.name, "Time", test.sdp.Time, "!=", s.Time)
+		}
+		if test.sdp.SSRC != s.SSRC {
+			t.Error(test.name, "SSRC", test.sdp.SSRC, "!=", s.SSRC)
+		}
+		if test.sdp.Setup != s.Setup {
+			t.Error(test.name, "Setup", test.sdp.Setup, "!=", s.Setup)
+		}
+		if test.sdp.Connection != s.Connection {
+			t.Error(test.name, "Connection", test.sdp.Connection, "!=", s.Connection)
+		}
+		if test.sdp.DownloadSpeed != s.DownloadSpeed {
+			t.Error(test.name, "DownloadSpeed", test.sdp.DownloadSpeed, "!=", s.DownloadSpeed)
+		}
+		if test.sdp.FileSize != s.FileSize {
+			t.Error(test.name, "FileSize", test.sdp.FileSize, "!=", s.FileSize)
+		}
+		if test.sdp.StreamNumber != s.StreamNumber {
+			t.Error(test.name, "StreamNumber", test.sdp.StreamNumber, "!=", s.StreamNumber)
+		}
+		if len(s.Attrs) != 0 {
+			t.Error(test.name, "GB28181 attrs left in Attrs", s.Attrs)
+		}
+		if len(s.Other) != 0 {
+			t.Error(test.name, "GB28181 fields left in Other", s.Other)
+		}
+
+		if s.MediaDesc == nil {
+			t.Error(test.name, "MediaDesc not found")
+		} else if *test.sdp.MediaDesc != *s.MediaDesc {
+			t.Error(test.name, "MediaDesc", *test.sdp.MediaDesc, "!=", *s.MediaDesc)
+		} else if w, h := s.MediaDesc.Size(); w != test.w || h != test.h {
+			t.Error(test.name, "Size", test.w, test.h, "!=", w, h)
+		}
+
+		// 格式化后与原始sdp一致
+		expected := test.s
+		if test.s2 != "" {
+			expected = test.s2
+		}
+		if formatted := s.String(); formatted != expected {
+			t.Error("\n" + test.name + "\n\n" + expected + "\nIS NOT\n\n" + formatted)
+		}
+	}
+}
+
+func TestSessionType(t *testing.T) {
+	for _, session := range []string{"Play", "play", "PLAY"} {
+		if s := (&sdp.SDP{Session: session}).SessionType(); s != sdp.SessionPlay {
+			t.Error("SessionType", session, "!=", s)
+		}
+	}
+
+	if s := (&sdp.SDP{Session: "download"}).SessionType(); s != sdp.SessionDownload {
+		t.Error("SessionType download !=", s)
+	}
+}
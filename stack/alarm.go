@@ -29,6 +29,16 @@ const (
 		"<DeviceID>%s</DeviceID>\r\n" +
 		"<Result>%s</Result>\r\n" +
 		"</Response>"
+
+	// AlarmResetFormat A.2.3 报警复位, Info可选, 携带时只复位指定的报警方式和类型
+	AlarmResetFormat = "<?xml version=\"1.0\"?>\r\n" +
+		"<Control>\r\n" +
+		"<CmdType>DeviceControl</CmdType>\r\n" +
+		"<SN>%d</SN>\r\n" +
+		"<DeviceID>%s</DeviceID>\r\n" +
+		"<AlarmCmd>ResetAlarm</AlarmCmd>\r\n" +
+		"%s" +
+		"</Control>\r\n"
 )
 
 type AlarmNotify struct {
@@ -107,3 +117,19 @@ func (d *Device) SendAlarmNotificationResponseCmd(sn int, id string) {
 
 	common.SipStack.SendRequest(request)
 }
+
+// ResetAlarm 报警复位, 例如一键报警按钮处理完成后需要复位
+func (d *Device) ResetAlarm(channelId string, alarmMethod int, alarmType *int) {
+	var info string
+	if alarmMethod > 0 {
+		info = fmt.Sprintf("<Info>\r\n<AlarmMethod>%d</AlarmMethod>\r\n", alarmMethod)
+		if alarmType != nil {
+			info += fmt.Sprintf("<AlarmType>%d</AlarmType>\r\n", *alarmType)
+		}
+		info += "</Info>\r\n"
+	}
+
+	body := fmt.Sprintf(AlarmResetFormat, GetSN(), channelId, info)
+	request := d.BuildMessageRequest(channelId, body)
+	common.SipStack.SendRequest(request)
+}
@@ -0,0 +1,289 @@
+package stack
+
+import (
+	"context"
+	"fmt"
+	"gb-cms/common"
+	"gb-cms/dao"
+	"gb-cms/hook"
+	"gb-cms/log"
+	"net"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	// 报警联动每次抓拍的张数
+	AlarmSnapNum = 3
+	// 报警抓拍图片的保存目录
+	AlarmSnapDir = "./data/snapshot"
+
+	alarmBroadcastTimeout         = 10 * time.Second
+	alarmBroadcastDefaultDuration = 30 * time.Second
+)
+
+var (
+	AlarmPlanManager = &alarmPlanManager{
+		recording:    make(map[common.StreamID]*time.Timer, 16),
+		snapSessions: make(map[string]time.Time, 16),
+	}
+)
+
+// alarmPlanManager 收到报警后, 匹配通道关联的报警预案, 执行联动动作
+type alarmPlanManager struct {
+	lock         sync.Mutex
+	recording    map[common.StreamID]*time.Timer // 报警联动开启录制的流, 到时关闭录制
+	snapSessions map[string]time.Time            // 等待设备上传抓拍图片的会话和过期时间
+}
+
+// OnAlarm 匹配并执行报警预案
+func (a *alarmPlanManager) OnAlarm(alarm *dao.AlarmModel) {
+	plans, err := dao.AlarmPlan.QueryEnabledPlans()
+	if err != nil {
+		log.Sugar.Errorf("查询报警预案失败 err: %s", err.Error())
+		return
+	}
+
+	now := time.Now()
+	for _, plan := range plans {
+		if !plan.Match(alarm, now) {
+			continue
+		} else if ok, _ := dao.AlarmPlan.QueryPlanContainsChannel(int(plan.ID), alarm.DeviceID, alarm.ChannelID); !ok {
+			continue
+		}
+
+		log.Sugar.Infof("执行报警预案 plan: %s device: %s channel: %s", plan.Name, alarm.DeviceID, alarm.ChannelID)
+		a.execute(plan, alarm)
+	}
+}
+
+func (a *alarmPlanManager) execute(plan *dao.AlarmPlanModel, alarm *dao.AlarmModel) {
+	var actions = map[string]func() error{}
+	if plan.RecordDuration > 0 {
+		actions["record"] = func() error {
+			return a.record(alarm.DeviceID, alarm.ChannelID, time.Duration(plan.RecordDuration)*time.Second)
+		}
+	}
+
+	if plan.PresetIndex > 0 && plan.PresetDeviceID != "" {
+		actions["preset"] = func() error {
+			return callPreset(plan.PresetDeviceID, plan.PresetChannelID, plan.PresetIndex)
+		}
+	}
+
+	if plan.SnapInterval > 0 {
+		actions["snap"] = func() error {
+			return a.snap(alarm.DeviceID, alarm.ChannelID, plan.SnapInterval)
+		}
+	}
+
+	if plan.WebhookURL != "" {
+		actions["webhook"] = func() error {
+			hook.NotifyURL(plan.WebhookURL, hook.EventName(hook.EventTypeDeviceAlarm), map[string]interface{}{
+				"plan_id":   plan.ID,
+				"plan_name": plan.Name,
+				"alarm":     alarm,
+			})
+			return nil
+		}
+	}
+
+	if plan.BroadcastURL != "" {
+		actions["broadcast"] = func() error {
+			duration := time.Duration(plan.BroadcastDuration) * time.Second
+			if duration <= 0 {
+				duration = alarmBroadcastDefaultDuration
+			}
+
+			return broadcastClip(alarm.DeviceID, alarm.ChannelID, plan.BroadcastURL, duration)
+		}
+	}
+
+	// 各个动作互不影响, 并行执行
+	for action, f := range actions {
+		go func(action string, f func() error) {
+			result := "OK"
+			if err := f(); err != nil {
+				log.Sugar.Errorf("执行报警联动失败 err: %s plan: %s action: %s", err.Error(), plan.Name, action)
+				result = err.Error()
+			}
+
+			_ = dao.AlarmPlan.SaveLog(&dao.AlarmPlanLogModel{
+				PlanID:    plan.ID,
+				PlanName:  plan.Name,
+				DeviceID:  alarm.DeviceID,
+				ChannelID: alarm.ChannelID,
+				Action:    action,
+				Result:    result,
+			})
+		}(action, f)
+	}
+}
+
+func onlineDevice(deviceId string) (*Device, error) {
+	model, _ := dao.Device.QueryDevice(deviceId)
+	if model == nil || !model.Online() {
+		return nil, fmt.Errorf("设备离线 id: %s", deviceId)
+	}
+
+	return &Device{model}, nil
+}
+
+// 拉流录制, 录制期间再次报警, 从最后一次报警开始计时
+func (a *alarmPlanManager) record(deviceId, channelId string, duration time.Duration) error {
+	streamId := common.GenerateStreamID(common.InviteTypePlay, deviceId, channelId, "", "")
+
+	a.lock.Lock()
+	if timer, ok := a.recording[streamId]; ok {
+		timer.Reset(duration)
+		a.lock.Unlock()
+		return nil
+	}
+
+	a.recording[streamId] = time.AfterFunc(duration, func() {
+		a.lock.Lock()
+		delete(a.recording, streamId)
+		a.lock.Unlock()
+
+		// 录像计划时间内, 由录像计划关闭录制
+		if RecordPlanManager.IsRecording(streamId) {
+			log.Sugar.Infof("报警联动录制结束, 录像计划录制中, 不关闭录制 stream: %s", streamId)
+			return
+		}
+
+		log.Sugar.Infof("关闭报警联动录制 stream: %s", streamId)
+		if err := MSStopRecord(string(streamId)); err != nil {
+			log.Sugar.Errorf("关闭报警联动录制失败 err: %s stream: %s", err.Error(), streamId)
+		}
+	})
+	a.lock.Unlock()
+
+	var err error
+	defer func() {
+		if err == nil {
+			return
+		}
+
+		a.lock.Lock()
+		if timer, ok := a.recording[streamId]; ok {
+			timer.Stop()
+			delete(a.recording, streamId)
+		}
+		a.lock.Unlock()
+	}()
+
+	device, err := onlineDevice(deviceId)
+	if err != nil {
+		return err
+	} else if _, err = device.StartStream(common.InviteTypePlay, streamId, channelId, "", "", device.GetSetup().String(), 0, true); err != nil {
+		return err
+	}
+
+	// 录像计划已开启录制
+	if RecordPlanManager.IsRecording(streamId) {
+		log.Sugar.Infof("录像计划录制中, 报警联动不重复开启录制 stream: %s duration: %s", streamId, duration)
+		return nil
+	}
+
+	log.Sugar.Infof("开启报警联动录制 stream: %s duration: %s", streamId, duration)
+	err = MSStartRecord(string(streamId))
+	return err
+}
+
+// IsRecording 流是否由报警联动开启录制, 未到关闭时间
+func (a *alarmPlanManager) IsRecording(streamId common.StreamID) bool {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	_, ok := a.recording[streamId]
+	return ok
+}
+
+func callPreset(deviceId, channelId string, index int) error {
+	if index > 255 {
+		return fmt.Errorf("预置位编号错误 %d", index)
+	}
+
+	device, err := onlineDevice(deviceId)
+	if err != nil {
+		return err
+	} else if channelId == "" {
+		channelId = deviceId
+	}
+
+	device.CallPreset(channelId, byte(index))
+	return nil
+}
+
+// 通知设备抓拍, 设备上传到/api/v1/alarm/snap/upload/{session}
+func (a *alarmPlanManager) snap(deviceId, channelId string, interval int) error {
+	device, err := onlineDevice(deviceId)
+	if err != nil {
+		return err
+	}
+
+	sessionId := fmt.Sprintf("%s_%s_%d", deviceId, channelId, time.Now().UnixMilli())
+	uploadUrl := fmt.Sprintf("http://%s/api/v1/alarm/snap/upload/%s", net.JoinHostPort(common.Config.PublicIP, strconv.Itoa(common.Config.HttpPort)), sessionId)
+
+	a.lock.Lock()
+	now := time.Now()
+	for session, expires := range a.snapSessions {
+		if now.After(expires) {
+			delete(a.snapSessions, session)
+		}
+	}
+
+	// 多等待一分钟上传
+	a.snapSessions[sessionId] = now.Add(time.Duration(AlarmSnapNum*interval)*time.Second + time.Minute)
+	a.lock.Unlock()
+
+	device.SnapShot(channelId, AlarmSnapNum, interval, uploadUrl, sessionId)
+	return nil
+}
+
+// SnapPath 返回抓拍会话的图片保存路径, 会话不存在或已过期返回false
+func (a *alarmPlanManager) SnapPath(sessionId string) (string, bool) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	expires, ok := a.snapSessions[sessionId]
+	if !ok || time.Now().After(expires) {
+		return "", false
+	}
+
+	return filepath.Join(AlarmSnapDir, fmt.Sprintf("%s_%d.jpg", sessionId, time.Now().UnixMilli())), true
+}
+
+// 流媒体服务拉取音频作为广播源, 广播指定时长后挂断
+func broadcastClip(deviceId, channelId, clipUrl string, duration time.Duration) error {
+	device, err := onlineDevice(deviceId)
+	if err != nil {
+		return err
+	}
+
+	sourceId := fmt.Sprintf("alarm_broadcast/%s/%s", deviceId, channelId)
+	if _, err = MSCreatePullSource(sourceId, clipUrl, "tcp"); err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), alarmBroadcastTimeout)
+	defer cancel()
+
+	sink, err := device.StartBroadcast(common.StreamID(sourceId), deviceId, channelId, ctx)
+	if err != nil {
+		_ = MSCloseSource(sourceId)
+		return err
+	}
+
+	time.AfterFunc(duration, func() {
+		log.Sugar.Infof("挂断报警联动广播 device: %s channel: %s", deviceId, channelId)
+		if model, _ := dao.Sink.DeleteSinkBySinkStreamID(sink.SinkStreamID); model != nil {
+			(&Sink{model}).Close(true, true)
+		}
+
+		_ = MSCloseSource(sourceId)
+	})
+
+	return nil
+}
@@ -22,6 +22,13 @@ const (
 		"</Notify>\r\n"
 )
 
+// BroadcastNotify 上级下发的语音广播通知
+type BroadcastNotify struct {
+	BaseMessage
+	SourceID string `xml:"SourceID"`
+	TargetID string `xml:"TargetID"`
+}
+
 func (d *Device) StartBroadcast(streamId common.StreamID, deviceId, channelId string, timeoutCtx context.Context) (*dao.SinkModel, error) {
 	// 生成sinkstreamid, 该通道的唯一广播id
 	sinkStreamId := common.GenerateStreamID(common.InviteTypeBroadcast, deviceId, channelId, "", "")
@@ -134,7 +141,9 @@ func (d *Device) OnInvite(request sip.Request, user string) sip.Response {
 	}
 
 	// 添加sink到流媒体服务器
-	response, err := AddForwardSink(TransStreamGBTalk, request, user, &Sink{sink}, sink.StreamID, offer, common.InviteTypeBroadcast, "8 PCMA/8000")
+	// 协商音频编码
+	payload := NegotiateAudioPayload(offer)
+	response, err := AddForwardSink(TransStreamGBTalk, request, user, &Sink{sink}, sink.StreamID, offer, common.InviteTypeBroadcast, payload)
 	if err != nil {
 		log.Sugar.Errorf("广播失败, 流媒体创建answer发生err: %s  sink: %s ", err.Error(), sink.SinkID)
 		streamWaiting.Put(http.StatusInternalServerError)
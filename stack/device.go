@@ -44,7 +44,7 @@ var (
 
 	SDPMessageType sip.ContentType = "application/sdp"
 
-	RTSPMessageType sip.ContentType = "application/RTSP"
+	RTSPMessageType sip.ContentType = "Application/MANSRTSP"
 )
 
 type GBDevice interface {
@@ -419,9 +419,24 @@ func (d *Device) NewRequestBuilder(method sip.RequestMethod, fromUser, realm, to
 	return builder
 }
 
-func (d *Device) BuildInviteRequest(sessionName, channelId, ip string, port uint16, startTime, stopTime, setup string, speed int, ssrc string) (sip.Request, error) {
+// StreamModeAttrs 返回选择码流的SDP属性, 未指定码流返回空. 同时携带2022标准的streamnumber和常见的厂家私有属性
+func (d *Device) StreamModeAttrs(streamNumber int) []string {
+	mode := d.StreamMode.WithNumber(streamNumber)
+	if mode == "" {
+		return nil
+	}
+
+	attrs := []string{string(mode)}
+	if "streamnumber" == mode.Name() {
+		attrs = append(attrs, string(common.StreamMode("streamprofile").WithNumber(streamNumber)))
+	}
+
+	return attrs
+}
+
+func (d *Device) BuildInviteRequest(sessionName, channelId, ip string, port uint16, startTime, stopTime, setup string, speed int, ssrc string, streamNumber int) (sip.Request, error) {
 	builder := d.NewRequestBuilder(sip.INVITE, common.Config.SipID, common.Config.SipContactAddr, channelId)
-	sdp := BuildSDP("video", common.Config.SipID, sessionName, ip, port, startTime, stopTime, setup, speed, ssrc, "96 PS/90000")
+	sdp := BuildSDP("video", common.Config.SipID, sessionName, ip, port, startTime, stopTime, setup, speed, ssrc, d.StreamModeAttrs(streamNumber), common.Config.VideoPayloads...)
 	builder.SetContentType(&SDPMessageType)
 	builder.SetContact(GlobalContactAddress)
 	builder.SetBody(sdp)
@@ -436,16 +451,16 @@ func (d *Device) BuildInviteRequest(sessionName, channelId, ip string, port uint
 	return request, err
 }
 
-func (d *Device) BuildLiveRequest(channelId, ip string, port uint16, setup string, ssrc string) (sip.Request, error) {
-	return d.BuildInviteRequest("Play", channelId, ip, port, "0", "0", setup, 0, ssrc)
+func (d *Device) BuildLiveRequest(channelId, ip string, port uint16, setup string, ssrc string, streamNumber int) (sip.Request, error) {
+	return d.BuildInviteRequest("Play", channelId, ip, port, "0", "0", setup, 0, ssrc, streamNumber)
 }
 
-func (d *Device) BuildPlaybackRequest(channelId, ip string, port uint16, startTime, stopTime, setup string, ssrc string) (sip.Request, error) {
-	return d.BuildInviteRequest("Playback", channelId, ip, port, startTime, stopTime, setup, 0, ssrc)
+func (d *Device) BuildPlaybackRequest(channelId, ip string, port uint16, startTime, stopTime, setup string, ssrc string, streamNumber int) (sip.Request, error) {
+	return d.BuildInviteRequest("Playback", channelId, ip, port, startTime, stopTime, setup, 0, ssrc, streamNumber)
 }
 
-func (d *Device) BuildDownloadRequest(channelId, ip string, port uint16, startTime, stopTime, setup string, speed int, ssrc string) (sip.Request, error) {
-	return d.BuildInviteRequest("Download", channelId, ip, port, startTime, stopTime, setup, speed, ssrc)
+func (d *Device) BuildDownloadRequest(channelId, ip string, port uint16, startTime, stopTime, setup string, speed int, ssrc string, streamNumber int) (sip.Request, error) {
+	return d.BuildInviteRequest("Download", channelId, ip, port, startTime, stopTime, setup, speed, ssrc, streamNumber)
 }
 
 func (d *Device) Close() {
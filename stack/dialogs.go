@@ -2,12 +2,14 @@ package stack
 
 import (
 	"context"
+	"gb-cms/dao"
 	"sync"
 	"time"
 )
 
 var (
-	EarlyDialogs = NewDialogManager[*StreamWaiting]()
+	EarlyDialogs   = NewDialogManager[*StreamWaiting]()
+	PendingInvites = NewDialogManager[*PendingInvite]()
 )
 
 type StreamWaiting struct {
@@ -17,7 +19,11 @@ type StreamWaiting struct {
 }
 
 func (s *StreamWaiting) Receive(seconds int) int {
-	s.onPublishCb = make(chan int, 0)
+	// 可能在等待前已创建带缓冲的管道, 避免推流通知先于等待到达
+	if s.onPublishCb == nil {
+		s.onPublishCb = make(chan int, 0)
+	}
+
 	timeout, cancelFunc := context.WithTimeout(context.Background(), time.Duration(seconds)*time.Second)
 	s.cancelFunc = cancelFunc
 	select {
@@ -32,6 +38,30 @@ func (s *StreamWaiting) Put(code int) {
 	s.onPublishCb <- code
 }
 
+// PendingInvite 正在进行中的invite, 同一路流的后续请求等待其结果, 而不是拿到未完成的占位记录
+type PendingInvite struct {
+	done   chan struct{}
+	stream *dao.StreamModel
+	err    error
+}
+
+func NewPendingInvite() *PendingInvite {
+	return &PendingInvite{done: make(chan struct{})}
+}
+
+// Wait 等待invite完成, 返回与首个请求相同的结果
+func (p *PendingInvite) Wait() (*dao.StreamModel, error) {
+	<-p.done
+	return p.stream, p.err
+}
+
+// Done 设置invite结果, 唤醒所有等待者
+func (p *PendingInvite) Done(stream *dao.StreamModel, err error) {
+	p.stream = stream
+	p.err = err
+	close(p.done)
+}
+
 type DialogManager[T any] struct {
 	lock    sync.RWMutex
 	dialogs map[string]T
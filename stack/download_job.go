@@ -0,0 +1,300 @@
+package stack
+
+import (
+	"fmt"
+	"gb-cms/common"
+	"gb-cms/dao"
+	"gb-cms/log"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	// 设备发送完毕或流断开后, 等待流媒体服务生成文件的超时时间
+	downloadMergeTimeout = time.Minute
+	// 重试间隔, 按重试次数递增
+	downloadRetryInterval = 30 * time.Second
+)
+
+var (
+	DownloadJobManager = &downloadJobManager{
+		running:   make(map[uint]*downloadTask, 8),
+		discarded: make(map[common.StreamID]time.Time, 8),
+	}
+)
+
+type downloadTask struct {
+	streamId common.StreamID
+	endAt    time.Time // 流结束的时间, 零值表示流未结束
+}
+
+// downloadJobManager 调度下载任务, 限制同时下载数, 跟踪进度, 失败后重试.
+// 任务状态只通过UpdateActiveJob更新修改的字段, 并在持有锁时更新, 避免互相覆盖
+type downloadJobManager struct {
+	lock      sync.Mutex
+	running   map[uint]*downloadTask
+	discarded map[common.StreamID]time.Time // 取消或失败后停止录制的流, 流媒体服务随后通知的文件不完整, 丢弃
+}
+
+// Start 重启后, 未完成的任务从头重新下载. 国标下载只能按时间段请求, 无法从中断的位置继续
+func (m *downloadJobManager) Start() {
+	jobs, err := dao.DownloadJob.QueryJobsByStatus(dao.DownloadStatusDownloading, dao.DownloadStatusMerging)
+	if err != nil {
+		log.Sugar.Errorf("查询未完成的下载任务失败 err: %s", err.Error())
+	}
+
+	for _, job := range jobs {
+		log.Sugar.Infof("重新开始下载任务 id: %d stream: %s", job.ID, job.StreamID)
+		_, _ = dao.DownloadJob.UpdateActiveJob(job.ID, map[string]interface{}{
+			"status":     dao.DownloadStatusWaiting,
+			"downloaded": 0,
+			"progress":   0,
+		})
+	}
+
+	go AddScheduledTask(5*time.Second, true, m.Schedule)
+}
+
+// Schedule 更新下载中任务的进度, 开始等待中的任务
+func (m *downloadJobManager) Schedule() {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	now := time.Now()
+	for streamId, discardAt := range m.discarded {
+		if now.Sub(discardAt) > downloadMergeTimeout {
+			delete(m.discarded, streamId)
+		}
+	}
+
+	for id, task := range m.running {
+		job, err := dao.DownloadJob.QueryJob(int(id))
+		if err != nil || !job.Active() {
+			delete(m.running, id)
+			continue
+		}
+
+		stream, _ := dao.Stream.QueryStream(task.streamId)
+		if stream != nil {
+			m.updateProgress(job)
+			continue
+		} else if job.Status == dao.DownloadStatusWaiting {
+			// 拉流中
+			continue
+		}
+
+		// 流已结束, 等待流媒体服务通知录制完成
+		if task.endAt.IsZero() {
+			task.endAt = now
+			_, _ = dao.DownloadJob.UpdateActiveJob(job.ID, map[string]interface{}{"status": dao.DownloadStatusMerging})
+		} else if now.Sub(task.endAt) > downloadMergeTimeout {
+			delete(m.running, id)
+			go m.fail(job, fmt.Errorf("等待生成文件超时"), true)
+		}
+	}
+
+	concurrency := max(common.Config.DownloadConcurrency, 1)
+	if len(m.running) >= concurrency {
+		return
+	}
+
+	jobs, err := dao.DownloadJob.QueryJobsByStatus(dao.DownloadStatusWaiting)
+	if err != nil {
+		log.Sugar.Errorf("查询等待中的下载任务失败 err: %s", err.Error())
+		return
+	}
+
+	for _, job := range jobs {
+		if len(m.running) >= concurrency {
+			break
+		} else if _, ok := m.running[job.ID]; ok || job.NextRetryAt.After(now) {
+			continue
+		}
+
+		m.running[job.ID] = &downloadTask{streamId: common.StreamID(job.StreamID)}
+		go m.start(job)
+	}
+}
+
+// 发起下载, 收流后开启MP4录制
+func (m *downloadJobManager) start(job *dao.DownloadJobModel) {
+	var err error
+	var recording bool
+	defer func() {
+		if err != nil {
+			m.lock.Lock()
+			delete(m.running, job.ID)
+			m.lock.Unlock()
+			m.fail(job, err, recording)
+		}
+	}()
+
+	device, _ := dao.Device.QueryDevice(job.DeviceID)
+	if device == nil || !device.Online() {
+		err = fmt.Errorf("设备离线")
+		return
+	}
+
+	startTime, err := time.ParseInLocation(RecordTimeFormat, job.StartTime, time.Local)
+	if err != nil {
+		return
+	}
+
+	endTime, err := time.ParseInLocation(RecordTimeFormat, job.EndTime, time.Local)
+	if err != nil {
+		return
+	}
+
+	log.Sugar.Infof("开始下载任务 id: %d stream: %s", job.ID, job.StreamID)
+	d := &Device{device}
+	streamId := common.StreamID(job.StreamID)
+	// 异步等待收流, 在收到流之前开启录制, 避免丢失开始的数据
+	stream, err := d.StartStream(common.InviteTypeDownload, streamId, job.ChannelID, strconv.FormatInt(startTime.Unix(), 10), strconv.FormatInt(endTime.Unix(), 10), device.GetSetup().String(), job.Speed, true)
+	if err != nil {
+		return
+	}
+
+	// 重试时, 上次丢弃的文件已通知完毕
+	m.lock.Lock()
+	delete(m.discarded, streamId)
+	m.lock.Unlock()
+
+	if err = MSStartRecordWithFormat(job.StreamID, "mp4"); err != nil {
+		return
+	}
+
+	recording = true
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	// 拉流期间任务被取消
+	if _, ok := m.running[job.ID]; !ok {
+		go m.stop(job.StreamID, true)
+		return
+	}
+
+	_, err = dao.DownloadJob.UpdateActiveJob(job.ID, map[string]interface{}{
+		"status":    dao.DownloadStatusDownloading,
+		"file_size": stream.FileSize,
+		"error":     "",
+	})
+}
+
+// 更新下载进度, 调用时需持有锁
+func (m *downloadJobManager) updateProgress(job *dao.DownloadJobModel) {
+	bytes, err := MSQueryStreamInBytes(job.StreamID)
+	if err != nil || bytes <= job.Downloaded {
+		return
+	}
+
+	columns := map[string]interface{}{"downloaded": bytes}
+	if job.FileSize > 0 {
+		// 完成前最多显示99%
+		columns["progress"] = min(float64(bytes)*100/float64(job.FileSize), 99)
+	}
+
+	_, _ = dao.DownloadJob.UpdateActiveJob(job.ID, columns)
+}
+
+// 停止录制并关闭流, recording表示是否已开启录制
+func (m *downloadJobManager) stop(streamId string, recording bool) {
+	if recording {
+		m.lock.Lock()
+		m.discarded[common.StreamID(streamId)] = time.Now()
+		m.lock.Unlock()
+
+		_ = MSStopRecord(streamId)
+	}
+
+	CloseStream(common.StreamID(streamId), true)
+}
+
+// 下载失败, 未超过重试次数则稍后重试. 任务已被取消则不修改状态
+func (m *downloadJobManager) fail(job *dao.DownloadJobModel, err error, recording bool) {
+	log.Sugar.Errorf("下载任务失败 err: %s id: %d stream: %s retries: %d", err.Error(), job.ID, job.StreamID, job.Retries)
+	m.stop(job.StreamID, recording)
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	latest, _ := dao.DownloadJob.QueryJob(int(job.ID))
+	if latest == nil || !latest.Active() {
+		return
+	}
+
+	columns := map[string]interface{}{
+		"error":      err.Error(),
+		"downloaded": 0,
+		"progress":   0,
+	}
+
+	if latest.Retries < common.Config.DownloadRetries {
+		columns["retries"] = latest.Retries + 1
+		columns["status"] = dao.DownloadStatusWaiting
+		columns["next_retry_at"] = time.Now().Add(time.Duration(latest.Retries+1) * downloadRetryInterval)
+	} else {
+		columns["status"] = dao.DownloadStatusFailed
+	}
+
+	_, _ = dao.DownloadJob.UpdateActiveJob(job.ID, columns)
+}
+
+// OnMediaStatus 设备通知回放/下载发送完毕
+func (m *downloadJobManager) OnMediaStatus(streamId common.StreamID) {
+	job, _ := dao.DownloadJob.QueryJobByStreamID(string(streamId))
+	if job == nil {
+		return
+	}
+
+	log.Sugar.Infof("下载任务设备发送完毕 id: %d stream: %s", job.ID, streamId)
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	_, _ = dao.DownloadJob.UpdateActiveJob(job.ID, map[string]interface{}{"status": dao.DownloadStatusMerging})
+}
+
+// OnRecord 流媒体服务录制完成, 返回是否是下载任务的文件
+func (m *downloadJobManager) OnRecord(streamId common.StreamID, path string, size int64) bool {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if _, ok := m.discarded[streamId]; ok {
+		delete(m.discarded, streamId)
+		log.Sugar.Infof("丢弃已取消或失败的下载任务文件 stream: %s path: %s", streamId, path)
+		return true
+	}
+
+	job, _ := dao.DownloadJob.QueryJobByStreamID(string(streamId))
+	if job == nil {
+		return false
+	}
+
+	delete(m.running, job.ID)
+	log.Sugar.Infof("下载任务完成 id: %d stream: %s path: %s", job.ID, streamId, path)
+	_, _ = dao.DownloadJob.UpdateActiveJob(job.ID, map[string]interface{}{
+		"status":       dao.DownloadStatusCompleted,
+		"path":         path,
+		"size":         size,
+		"progress":     100,
+		"completed_at": time.Now(),
+	})
+	return true
+}
+
+// Cancel 取消下载任务
+func (m *downloadJobManager) Cancel(job *dao.DownloadJobModel) error {
+	m.lock.Lock()
+	delete(m.running, job.ID)
+	ok, err := dao.DownloadJob.UpdateActiveJob(job.ID, map[string]interface{}{"status": dao.DownloadStatusCanceled})
+	m.lock.Unlock()
+
+	if err != nil {
+		return err
+	} else if !ok {
+		return fmt.Errorf("下载任务已结束")
+	}
+
+	// 等待中的任务还未开启录制, 正在拉流的由start停止
+	m.stop(job.StreamID, job.Status != dao.DownloadStatusWaiting)
+	return nil
+}
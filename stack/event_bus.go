@@ -0,0 +1,88 @@
+package stack
+
+import (
+	"gb-cms/hook"
+	"gb-cms/log"
+	"sync"
+	"time"
+)
+
+const (
+	// 订阅者的事件缓冲, 缓冲满后丢弃新的事件, 防止慢客户端阻塞发布
+	eventSubscriberBuffer = 64
+)
+
+var (
+	EventBus = &eventBus{subscribers: make(map[*EventSubscriber]bool, 16)}
+)
+
+// EventMessage 事件总线上的事件, 主题同webhook的事件名称
+type EventMessage struct {
+	Topic     string      `json:"topic"`
+	Timestamp int64       `json:"timestamp"` // unix毫秒
+	Data      interface{} `json:"data"`
+}
+
+type EventSubscriber struct {
+	C      chan *EventMessage
+	topics map[string]bool // 为空订阅所有主题
+}
+
+func (s *EventSubscriber) match(topic string) bool {
+	return len(s.topics) == 0 || s.topics[topic]
+}
+
+type eventBus struct {
+	lock        sync.RWMutex
+	subscribers map[*EventSubscriber]bool
+}
+
+// Subscribe 订阅指定主题的事件, topics为空订阅所有
+func (b *eventBus) Subscribe(topics []string) *EventSubscriber {
+	subscriber := &EventSubscriber{
+		C:      make(chan *EventMessage, eventSubscriberBuffer),
+		topics: make(map[string]bool, len(topics)),
+	}
+
+	for _, topic := range topics {
+		if topic != "" {
+			subscriber.topics[topic] = true
+		}
+	}
+
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	b.subscribers[subscriber] = true
+	return subscriber
+}
+
+func (b *eventBus) Unsubscribe(subscriber *EventSubscriber) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	delete(b.subscribers, subscriber)
+}
+
+func (b *eventBus) Publish(topic string, data interface{}) {
+	message := &EventMessage{Topic: topic, Timestamp: time.Now().UnixMilli(), Data: data}
+
+	b.lock.RLock()
+	defer b.lock.RUnlock()
+	for subscriber := range b.subscribers {
+		if !subscriber.match(topic) {
+			continue
+		}
+
+		select {
+		case subscriber.C <- message:
+			break
+		default:
+			log.Sugar.Warnf("事件订阅者处理过慢, 丢弃事件 topic: %s", topic)
+		}
+	}
+}
+
+// PublishEvent 发布事件到事件总线, 配置了webhook地址的同时异步推送
+func PublishEvent(eventType int, data interface{}) {
+	hook.Notify(eventType, data)
+	EventBus.Publish(hook.EventName(eventType), data)
+}
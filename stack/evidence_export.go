@@ -0,0 +1,300 @@
+package stack
+
+import (
+	"archive/zip"
+	"bytes"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"gb-cms/common"
+	"gb-cms/dao"
+	"gb-cms/log"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+var (
+	EvidenceExportManager = &evidenceExportManager{}
+)
+
+// EvidenceManifest 证据包清单, 以manifest.json和manifest.csv写入证据包
+type EvidenceManifest struct {
+	ID        uint           `json:"id"`
+	Name      string         `json:"name"`
+	StartTime string         `json:"start_time"`
+	EndTime   string         `json:"end_time"`
+	Exporter  string         `json:"exporter"`
+	CreatedAt string         `json:"created_at"`
+	Files     []EvidenceFile `json:"files"`
+}
+
+type EvidenceFile struct {
+	File        string `json:"file"` // 证据包内的文件名
+	DeviceID    string `json:"device_id"`
+	ChannelID   string `json:"channel_id"`
+	ChannelName string `json:"channel_name"`
+	StartTime   string `json:"start_time"`
+	EndTime     string `json:"end_time"`
+	Size        int64  `json:"size"`
+	SHA256      string `json:"sha256"`
+}
+
+// evidenceExportManager 等待导出任务的各通道下载完成, 打包成包含清单和哈希值的zip
+type evidenceExportManager struct {
+	lock sync.Mutex
+}
+
+// Start 重启后, 未打包完成的任务重新打包
+func (m *evidenceExportManager) Start() {
+	exports, err := dao.Export.QueryExportsByStatus(dao.ExportStatusPacking)
+	if err != nil {
+		log.Sugar.Errorf("查询未完成的证据包导出任务失败 err: %s", err.Error())
+	}
+
+	for _, export := range exports {
+		export.Status = dao.ExportStatusDownloading
+		_ = dao.Export.Save(export)
+	}
+
+	go AddScheduledTask(5*time.Second, true, m.Schedule)
+}
+
+// Schedule 检查导出任务的下载状态, 全部下载完成后开始打包
+func (m *evidenceExportManager) Schedule() {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	exports, err := dao.Export.QueryExportsByStatus(dao.ExportStatusDownloading)
+	if err != nil {
+		log.Sugar.Errorf("查询证据包导出任务失败 err: %s", err.Error())
+		return
+	}
+
+	for _, export := range exports {
+		jobs, err := dao.DownloadJob.QueryJobsByExportID(export.ID)
+		if err != nil {
+			continue
+		} else if len(jobs) == 0 {
+			m.fail(export, fmt.Errorf("没有下载任务"))
+			continue
+		}
+
+		completed := true
+		var failed *dao.DownloadJobModel
+		for _, job := range jobs {
+			if job.Status == dao.DownloadStatusFailed || job.Status == dao.DownloadStatusCanceled {
+				failed = job
+				break
+			} else if job.Status != dao.DownloadStatusCompleted {
+				completed = false
+			}
+		}
+
+		if failed != nil {
+			m.fail(export, fmt.Errorf("通道下载失败 device: %s channel: %s err: %s", failed.DeviceID, failed.ChannelID, failed.Error))
+			continue
+		} else if !completed {
+			continue
+		}
+
+		export.Status = dao.ExportStatusPacking
+		if err = dao.Export.Save(export); err != nil {
+			continue
+		}
+
+		go func(export *dao.EvidenceExportModel, jobs []*dao.DownloadJobModel) {
+			if err := m.pack(export, jobs); err != nil {
+				m.fail(export, err)
+			}
+		}(export, jobs)
+	}
+}
+
+func (m *evidenceExportManager) fail(export *dao.EvidenceExportModel, err error) {
+	log.Sugar.Errorf("导出证据包失败 err: %s id: %d", err.Error(), export.ID)
+	export.Status = dao.ExportStatusFailed
+	export.Error = err.Error()
+	_ = dao.Export.Save(export)
+}
+
+// 将各通道的MP4文件、清单和哈希值写入zip
+func (m *evidenceExportManager) pack(export *dao.EvidenceExportModel, jobs []*dao.DownloadJobModel) error {
+	if err := os.MkdirAll(common.Config.ExportPath, 0755); err != nil {
+		return err
+	}
+
+	path := filepath.Join(common.Config.ExportPath, fmt.Sprintf("evidence_%d_%s.zip", export.ID, time.Now().Format("20060102150405")))
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+
+	// 同时计算证据包的哈希值
+	hash := sha256.New()
+	writer := zip.NewWriter(io.MultiWriter(file, hash))
+	manifest, err := m.write(writer, export, jobs)
+	if err == nil {
+		err = writer.Close()
+	}
+
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+
+	if err != nil {
+		_ = os.Remove(path)
+		return err
+	}
+
+	var size int64
+	if info, err := os.Stat(path); err == nil {
+		size = info.Size()
+	}
+
+	export.Status = dao.ExportStatusCompleted
+	export.Path = path
+	export.Size = size
+	export.SHA256 = hex.EncodeToString(hash.Sum(nil))
+	export.Error = ""
+	export.CompletedAt = time.Now()
+	log.Sugar.Infof("导出证据包完成 id: %d files: %d path: %s sha256: %s", export.ID, len(manifest.Files), path, export.SHA256)
+	return dao.Export.Save(export)
+}
+
+func (m *evidenceExportManager) write(writer *zip.Writer, export *dao.EvidenceExportModel, jobs []*dao.DownloadJobModel) (*EvidenceManifest, error) {
+	manifest := &EvidenceManifest{
+		ID:        export.ID,
+		Name:      export.Name,
+		StartTime: export.StartTime,
+		EndTime:   export.EndTime,
+		Exporter:  export.Username,
+		CreatedAt: export.CreatedAt.Format("2006-01-02 15:04:05"),
+	}
+
+	// sha256sum格式的校验文件, 可使用"sha256sum -c SHA256SUMS"校验
+	sums := &bytes.Buffer{}
+	for _, job := range jobs {
+		name := fmt.Sprintf("%s_%s_%s.mp4", job.DeviceID, job.ChannelID, strings.NewReplacer("-", "", ":", "").Replace(job.StartTime))
+		size, sum, err := zipFile(writer, name, job.Path)
+		if err != nil {
+			return nil, fmt.Errorf("写入录像文件失败 err: %s path: %s", err.Error(), job.Path)
+		}
+
+		manifest.Files = append(manifest.Files, EvidenceFile{
+			File:        name,
+			DeviceID:    job.DeviceID,
+			ChannelID:   job.ChannelID,
+			ChannelName: job.Name,
+			StartTime:   job.StartTime,
+			EndTime:     job.EndTime,
+			Size:        size,
+			SHA256:      sum,
+		})
+
+		_, _ = fmt.Fprintf(sums, "%s  %s\n", sum, name)
+	}
+
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	sum, err := zipBytes(writer, "manifest.json", data)
+	if err != nil {
+		return nil, err
+	}
+
+	_, _ = fmt.Fprintf(sums, "%s  %s\n", sum, "manifest.json")
+
+	buffer := &bytes.Buffer{}
+	csvWriter := csv.NewWriter(buffer)
+	_ = csvWriter.Write([]string{"file", "device_id", "channel_id", "channel_name", "start_time", "end_time", "size", "sha256", "exporter"})
+	for _, f := range manifest.Files {
+		_ = csvWriter.Write([]string{f.File, f.DeviceID, f.ChannelID, f.ChannelName, f.StartTime, f.EndTime, strconv.FormatInt(f.Size, 10), f.SHA256, manifest.Exporter})
+	}
+
+	if csvWriter.Flush(); csvWriter.Error() != nil {
+		return nil, csvWriter.Error()
+	}
+
+	sum, err = zipBytes(writer, "manifest.csv", buffer.Bytes())
+	if err != nil {
+		return nil, err
+	}
+
+	_, _ = fmt.Fprintf(sums, "%s  %s\n", sum, "manifest.csv")
+
+	if _, err = zipBytes(writer, "SHA256SUMS", sums.Bytes()); err != nil {
+		return nil, err
+	}
+
+	return manifest, nil
+}
+
+// 写入文件到zip, MP4已经是压缩格式, 不再压缩. 返回文件大小和SHA-256
+func zipFile(writer *zip.Writer, name, path string) (int64, string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return 0, "", err
+	}
+
+	defer file.Close()
+	w, err := writer.CreateHeader(&zip.FileHeader{Name: name, Method: zip.Store, Modified: time.Now()})
+	if err != nil {
+		return 0, "", err
+	}
+
+	hash := sha256.New()
+	size, err := io.Copy(io.MultiWriter(w, hash), file)
+	if err != nil {
+		return 0, "", err
+	}
+
+	return size, hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+func zipBytes(writer *zip.Writer, name string, data []byte) (string, error) {
+	w, err := writer.CreateHeader(&zip.FileHeader{Name: name, Method: zip.Deflate, Modified: time.Now()})
+	if err != nil {
+		return "", err
+	}
+
+	if _, err = w.Write(data); err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// Remove 删除导出任务, 取消未完成的下载, 删除证据包文件
+func (m *evidenceExportManager) Remove(export *dao.EvidenceExportModel) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if export.Status == dao.ExportStatusPacking {
+		return fmt.Errorf("证据包打包中")
+	}
+
+	jobs, _ := dao.DownloadJob.QueryJobsByExportID(export.ID)
+	for _, job := range jobs {
+		if job.Active() {
+			_ = DownloadJobManager.Cancel(job)
+		}
+	}
+
+	if export.Path != "" {
+		if err := os.Remove(export.Path); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+
+	return dao.Export.DeleteExport(int(export.ID))
+}
@@ -0,0 +1,207 @@
+package stack
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"gb-cms/common"
+	"gb-cms/dao"
+	"gb-cms/hook"
+	"gb-cms/jt808"
+	"gb-cms/log"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// 等待终端应答音视频指令的超时时间
+	jtCommandTimeout = 5 * time.Second
+)
+
+var (
+	// JT808Server 内置部标信令服务器, 为空时通过钩子通知外部1078信令服务器
+	JT808Server *jt808.Server
+)
+
+type jtTerminalHandler struct {
+}
+
+// 终端上报的手机号去掉了前导0, 兼容添加设备时填写的12位或20位sim卡号
+func queryJTDeviceByPhone(phone string) (*dao.JTDeviceModel, error) {
+	var err error
+	for _, length := range []int{0, 12, 20} {
+		simNumber := phone
+		if len(phone) < length {
+			simNumber = strings.Repeat("0", length-len(phone)) + phone
+		}
+
+		var device *dao.JTDeviceModel
+		if device, err = dao.JTDevice.QueryDeviceBySimNumber(simNumber); err == nil {
+			return device, nil
+		}
+	}
+
+	return nil, err
+}
+
+func generateAuthCode() string {
+	code := make([]byte, 8)
+	_, _ = rand.Read(code)
+	return hex.EncodeToString(code)
+}
+
+func (h *jtTerminalHandler) OnRegister(session *jt808.Session, register *jt808.Register) (byte, string) {
+	device, err := queryJTDeviceByPhone(session.Phone)
+	if err != nil {
+		log.Sugar.Warnf("终端注册失败, sim卡号未添加 phone: %s addr: %s", session.Phone, session.RemoteAddr())
+		return jt808.RegisterTerminalUnknown, ""
+	}
+
+	authCode := generateAuthCode()
+	if err = dao.JTDevice.UpdateTerminalRegister(device.Username, register.Manufacturer, register.Model, authCode); err != nil {
+		log.Sugar.Errorf("保存终端鉴权码失败 err: %s device: %s", err.Error(), device.Username)
+		return jt808.RegisterTerminalUnknown, ""
+	}
+
+	// 向上级应答的设备信息使用终端上报的制造商和型号
+	if client := JTDeviceManager.Find(device.Username); client != nil {
+		client.SetDeviceInfo(device.Name, register.Manufacturer, register.Model, device.Firmware)
+	}
+
+	log.Sugar.Infof("终端注册成功 sim number: %s device: %s plate: %s manufacturer: %s model: %s", device.SimNumber, device.Username, register.Plate, register.Manufacturer, register.Model)
+	return jt808.RegisterSuccess, authCode
+}
+
+func (h *jtTerminalHandler) OnAuthenticate(session *jt808.Session, auth *jt808.Authentication) bool {
+	device, err := queryJTDeviceByPhone(session.Phone)
+	if err != nil {
+		log.Sugar.Warnf("终端鉴权失败, sim卡号未添加 phone: %s addr: %s", session.Phone, session.RemoteAddr())
+		return false
+	} else if device.AuthCode == "" || device.AuthCode != auth.Code {
+		log.Sugar.Warnf("终端鉴权失败, 鉴权码错误 sim number: %s device: %s addr: %s", device.SimNumber, device.Username, session.RemoteAddr())
+		return false
+	}
+
+	if auth.Firmware != "" && auth.Firmware != device.Firmware {
+		_ = dao.JTDevice.UpdateTerminalFirmware(device.Username, auth.Firmware)
+	}
+
+	if err = dao.JTDevice.UpdateTerminalStatus(device.Username, common.ON); err != nil {
+		log.Sugar.Errorf("更新终端状态失败 err: %s device: %s", err.Error(), device.Username)
+	}
+
+	log.Sugar.Infof("终端上线 sim number: %s device: %s addr: %s", device.SimNumber, device.Username, session.RemoteAddr())
+	return true
+}
+
+func (h *jtTerminalHandler) OnLocation(session *jt808.Session, location *jt808.Location) {
+	log.Sugar.Debugf("终端位置汇报 phone: %s longitude: %f latitude: %f speed: %.1f direction: %d time: %s", session.Phone, location.Longitude, location.Latitude, location.Speed, location.Direction, location.Time)
+
+	device, err := queryJTDeviceByPhone(session.Phone)
+	if err != nil {
+		return
+	}
+
+	_ = OnJTPosition(device, &JTPosition{
+		SimNumber: device.SimNumber,
+		Longitude: location.Longitude,
+		Latitude:  location.Latitude,
+		Altitude:  int(location.Altitude),
+		Speed:     location.Speed,
+		Direction: int(location.Direction),
+		Time:      location.Time.Unix(),
+	})
+}
+
+func (h *jtTerminalHandler) OnClose(session *jt808.Session) {
+	device, err := queryJTDeviceByPhone(session.Phone)
+	if err != nil {
+		return
+	}
+
+	log.Sugar.Infof("终端离线 sim number: %s device: %s addr: %s", device.SimNumber, device.Username, session.RemoteAddr())
+	if err = dao.JTDevice.UpdateTerminalStatus(device.Username, common.OFF); err != nil {
+		log.Sugar.Errorf("更新终端状态失败 err: %s device: %s", err.Error(), device.Username)
+	}
+}
+
+// StartJT808Server 启动内置部标信令服务器, 所有终端置为离线等待重新鉴权
+func StartJT808Server() error {
+	if err := dao.JTDevice.ResetTerminalStatus(); err != nil {
+		log.Sugar.Errorf("重置终端状态失败 err: %s", err.Error())
+	}
+
+	server := jt808.NewServer(&jtTerminalHandler{}, time.Duration(common.Config.JT808.AliveExpires)*time.Second)
+	addr := net.JoinHostPort(common.Config.ListenIP, strconv.Itoa(common.Config.JT808.Port))
+	if err := server.Start(addr); err != nil {
+		return err
+	}
+
+	JT808Server = server
+	log.Sugar.Infof("启动部标信令服务器 addr: %s", addr)
+	return nil
+}
+
+// 下发音视频指令, 等待终端通用应答
+func sendJTCommand(simNumber string, msgId uint16, body []byte) error {
+	session := JT808Server.Find(simNumber)
+	if session == nil {
+		return fmt.Errorf("终端不在线 %s", simNumber)
+	}
+
+	result, err := session.Request(msgId, body, jtCommandTimeout)
+	if err != nil {
+		return err
+	} else if jt808.ResultSuccess != result {
+		return fmt.Errorf("终端应答失败 result: %d", result)
+	}
+
+	return nil
+}
+
+func jtChannelNumber(streamId common.StreamID) byte {
+	number, _ := strconv.Atoi(streamId.ChannelID())
+	return byte(number)
+}
+
+// StartJTLive 通知终端推送实时音视频, 未启用内置部标信令服务器时通过on_invite钩子通知1078信令服务器
+func StartJTLive(streamId common.StreamID) error {
+	log.Sugar.Infof("通知终端推流 stream: %s", streamId)
+	return requestJTMedia(streamId, jt808.DataTypeAudioVideo)
+}
+
+// 下发0x9101实时音视频传输请求, 对讲和广播也使用该指令
+func requestJTMedia(streamId common.StreamID, dataType byte) error {
+	if JT808Server == nil {
+		return postJTEvent(hook.PostOnInviteEvent(streamId.DeviceID(), streamId.ChannelID(), int(dataType)))
+	}
+
+	request := jt808.LiveRequest{
+		IP:         common.Config.JT808.MediaIP,
+		TCPPort:    uint16(common.Config.JT808.MediaTCPPort),
+		UDPPort:    uint16(common.Config.JT808.MediaUDPPort),
+		Channel:    jtChannelNumber(streamId),
+		DataType:   dataType,
+		StreamType: jt808.StreamTypeMain,
+	}
+
+	return sendJTCommand(streamId.DeviceID(), jt808.MsgIDLiveRequest, request.Encode())
+}
+
+// StopJTLive 通知终端停止推送实时音视频. 外部1078信令服务器自行管理推流
+func StopJTLive(streamId common.StreamID) error {
+	if JT808Server == nil {
+		return nil
+	}
+
+	control := jt808.LiveControl{
+		Channel:   jtChannelNumber(streamId),
+		Command:   jt808.LiveControlClose,
+		CloseType: jt808.LiveCloseAll,
+	}
+
+	log.Sugar.Infof("通知终端停止推流 stream: %s", streamId)
+	return sendJTCommand(streamId.DeviceID(), jt808.MsgIDLiveControl, control.Encode())
+}
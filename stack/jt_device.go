@@ -36,10 +36,16 @@ func (g *JTDevice) OnInvite(request sip.Request, user string) sip.Response {
 		return CreateResponseWithStatusCode(request, http.StatusBadRequest)
 	}
 
+	// 上级发起语音对讲
+	if "talk" == strings.ToLower(gbsdp.SDP.Session) {
+		return g.OnTalkInvite(request, user, channel, gbsdp)
+	}
+
+	// 回放/下载通过on_playback钩子通知1078信令服务器下发0x9201
 	var inviteType common.InviteType
 	inviteType.SessionName2Type(strings.ToLower(gbsdp.SDP.Session))
-	if common.InviteTypePlay != inviteType {
-		log.Sugar.Warnf("处理上级Invite失败, 1078暂不支持非实时预览流 inviteType: %s channel: %s device: %s", inviteType, user, g.Username)
+	if common.InviteTypePlay != inviteType && common.InviteTypePlayback != inviteType && common.InviteTypeDownload != inviteType {
+		log.Sugar.Warnf("处理上级Invite失败, 1078不支持的流类型 inviteType: %s channel: %s device: %s", inviteType, user, g.Username)
 		return CreateResponseWithStatusCode(request, http.StatusNotImplemented)
 	}
 
@@ -59,6 +65,24 @@ func (g *JTDevice) OnInvite(request sip.Request, user string) sip.Response {
 	return response
 }
 
+// 多个部标设备可能注册到同一个上级, 被订阅的会话使用设备ID保存
+
+func (g *JTDevice) OnSubscribeCatalog(request sip.Request, expires int) (sip.Response, error) {
+	return CreateOrDeleteSubscribeDialog(g.username, request, expires, dao.SipDialogTypeSubscribeCatalog)
+}
+
+func (g *JTDevice) OnSubscribeAlarm(request sip.Request, expires int) (sip.Response, error) {
+	return CreateOrDeleteSubscribeDialog(g.username, request, expires, dao.SipDialogTypeSubscribeAlarm)
+}
+
+func (g *JTDevice) OnSubscribePosition(request sip.Request, expires int) (sip.Response, error) {
+	return CreateOrDeleteSubscribeDialog(g.username, request, expires, dao.SipDialogTypeSubscribePosition)
+}
+
+func (g *JTDevice) CreateRequestByDialogType(t int, method sip.RequestMethod) (sip.Request, error) {
+	return g.createRequestByDialog(g.username, t, method)
+}
+
 func (g *JTDevice) Start() {
 	log.Sugar.Infof("启动部标设备, deivce: %s transport: %s addr: %s", g.Username, g.sipUA.Transport, g.sipUA.ServerAddr)
 	g.sipUA.Start()
@@ -82,6 +106,9 @@ func (g *JTDevice) OfflineCB() {
 
 	// 释放所有推流
 	g.CloseStreams(true, true)
+
+	// 删除被订阅的会话
+	_ = dao.Dialog.DeleteDialogs(g.username)
 }
 
 func NewJTDevice(model *dao.JTDeviceModel, ua common.SipServer) (*JTDevice, error) {
@@ -0,0 +1,192 @@
+package stack
+
+import (
+	"fmt"
+	"gb-cms/common"
+	"gb-cms/dao"
+	"gb-cms/hook"
+	"gb-cms/jt808"
+	"gb-cms/log"
+	"github.com/ghettovoice/gosip/sip"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// JT/T 1078 表31 回放方式
+const (
+	JTPlaybackModeNormal      = 0
+	JTPlaybackModeFastForward = 1
+)
+
+// JT/T 1078 表33 回放控制
+const (
+	JTPlaybackControlPlay           = 0 // 开始/继续回放
+	JTPlaybackControlPause          = 1
+	JTPlaybackControlStop           = 2
+	JTPlaybackControlFastForward    = 3
+	JTPlaybackControlKeyFrameRewind = 4
+	JTPlaybackControlSeek           = 5 // 拖动回放
+	JTPlaybackControlKeyFramePlay   = 6
+)
+
+// JTPlaybackMultiple 将倍速转换为1078的快进/快退倍数, 0-无效 1-1倍 2-2倍 3-4倍 4-8倍 5-16倍
+func JTPlaybackMultiple(scale float64) int {
+	if scale < 0 {
+		scale = -scale
+	}
+
+	switch {
+	case scale >= 16:
+		return 5
+	case scale >= 8:
+		return 4
+	case scale >= 4:
+		return 3
+	case scale >= 2:
+		return 2
+	case scale > 0:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func postJTEvent(response *http.Response, err error) error {
+	if err != nil {
+		return err
+	}
+
+	_ = response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return fmt.Errorf("响应状态码: %d", response.StatusCode)
+	}
+
+	return nil
+}
+
+// StartJTPlayback 通知1078信令服务器下发0x9201录像回放请求. 下载使用快进方式回放, speed为下载倍速
+func StartJTPlayback(streamId common.StreamID, speed int) error {
+	start, end := streamId.TimeRange()
+	mode, multiple := JTPlaybackModeNormal, 0
+	if common.InviteTypeDownload == streamId.InviteType() && speed > 1 {
+		mode, multiple = JTPlaybackModeFastForward, JTPlaybackMultiple(float64(speed))
+	}
+
+	log.Sugar.Infof("通知1078信令服务器回放录像 stream: %s mode: %d multiple: %d", streamId, mode, multiple)
+	if JT808Server == nil {
+		return postJTEvent(hook.PostOnPlaybackEvent(streamId.DeviceID(), streamId.ChannelID(), string(streamId), start, end, mode, multiple))
+	}
+
+	request := jt808.PlaybackRequest{
+		IP:        common.Config.JT808.MediaIP,
+		TCPPort:   uint16(common.Config.JT808.MediaTCPPort),
+		UDPPort:   uint16(common.Config.JT808.MediaUDPPort),
+		Channel:   jtChannelNumber(streamId),
+		Mode:      byte(mode),
+		Multiple:  byte(multiple),
+		StartTime: time.Unix(start, 0),
+		EndTime:   time.Unix(end, 0),
+	}
+
+	return sendJTCommand(streamId.DeviceID(), jt808.MsgIDPlaybackRequest, request.Encode())
+}
+
+// ControlJTPlayback 通知1078信令服务器下发0x9202录像回放控制, position为拖动到的时间, 单位秒
+func ControlJTPlayback(streamId common.StreamID, control, multiple int, position int64) error {
+	log.Sugar.Infof("通知1078信令服务器回放控制 stream: %s control: %d multiple: %d position: %d", streamId, control, multiple, position)
+	if JT808Server == nil {
+		return postJTEvent(hook.PostOnPlaybackControlEvent(streamId.DeviceID(), streamId.ChannelID(), string(streamId), control, multiple, position))
+	}
+
+	request := jt808.PlaybackControl{
+		Channel:  jtChannelNumber(streamId),
+		Control:  byte(control),
+		Multiple: byte(multiple),
+	}
+
+	if position > 0 {
+		request.Position = time.Unix(position, 0)
+	}
+
+	return sendJTCommand(streamId.DeviceID(), jt808.MsgIDPlaybackControl, request.Encode())
+}
+
+// ParseMANSRTSP 解析MANSRTSP回放控制消息, 返回方法和头域
+func ParseMANSRTSP(body string) (string, map[string]string) {
+	lines := strings.Split(strings.ReplaceAll(body, "\r\n", "\n"), "\n")
+	method := strings.ToUpper(strings.Fields(lines[0] + " ")[0])
+	headers := make(map[string]string, len(lines))
+	for _, line := range lines[1:] {
+		if index := strings.Index(line, ":"); index > 0 {
+			headers[strings.ToLower(strings.TrimSpace(line[:index]))] = strings.TrimSpace(line[index+1:])
+		}
+	}
+
+	return method, headers
+}
+
+// 将上级的MANSRTSP消息转换为1078回放控制
+func mansRTSP2JTControl(streamId common.StreamID, body string) (int, int, int64, error) {
+	method, headers := ParseMANSRTSP(body)
+	switch method {
+	case "PAUSE":
+		return JTPlaybackControlPause, 0, 0, nil
+	case "TEARDOWN":
+		return JTPlaybackControlStop, 0, 0, nil
+	case "PLAY":
+		break
+	default:
+		return 0, 0, 0, fmt.Errorf("不支持的回放控制 %s", method)
+	}
+
+	// 拖动, Range: npt=100- 或 npt=100-200, 只使用开始位置
+	if value := headers["range"]; value != "" && !strings.HasPrefix(value, "npt=now") {
+		begin, _, _ := strings.Cut(strings.TrimPrefix(value, "npt="), "-")
+		offset, err := strconv.ParseFloat(strings.TrimSpace(begin), 64)
+		if err != nil {
+			return 0, 0, 0, fmt.Errorf("range格式错误 %s", value)
+		}
+
+		start, _ := streamId.TimeRange()
+		return JTPlaybackControlSeek, 0, start + int64(offset), nil
+	}
+
+	// 倍速, 负数为快退
+	if value := headers["scale"]; value != "" {
+		scale, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return 0, 0, 0, fmt.Errorf("scale格式错误 %s", value)
+		} else if scale < 0 {
+			return JTPlaybackControlKeyFrameRewind, JTPlaybackMultiple(scale), 0, nil
+		} else if scale > 1 {
+			return JTPlaybackControlFastForward, JTPlaybackMultiple(scale), 0, nil
+		}
+	}
+
+	return JTPlaybackControlPlay, 0, 0, nil
+}
+
+// OnJTPlaybackInfo 上级的回放控制, 转换为0x9202通知1078信令服务器
+func OnJTPlaybackInfo(request sip.Request) sip.Response {
+	id, _ := request.CallID()
+	sink, _ := dao.Sink.QuerySinkByCallID(id.Value())
+	if sink == nil {
+		return CreateResponseWithStatusCode(request, http.StatusNotFound)
+	} else if common.InviteTypePlay == sink.StreamID.InviteType() {
+		log.Sugar.Warnf("处理1078回放控制失败, 实时流不支持回放控制 stream: %s", sink.StreamID)
+		return CreateResponseWithStatusCode(request, http.StatusBadRequest)
+	}
+
+	control, multiple, position, err := mansRTSP2JTControl(sink.StreamID, request.Body())
+	if err != nil {
+		log.Sugar.Errorf("处理1078回放控制失败 err: %s stream: %s body: %s", err.Error(), sink.StreamID, request.Body())
+		return CreateResponseWithStatusCode(request, http.StatusBadRequest)
+	} else if err = ControlJTPlayback(sink.StreamID, control, multiple, position); err != nil {
+		log.Sugar.Errorf("通知1078信令服务器回放控制失败 err: %s stream: %s", err.Error(), sink.StreamID)
+		return CreateResponseWithStatusCode(request, http.StatusInternalServerError)
+	}
+
+	return CreateResponseWithStatusCode(request, http.StatusOK)
+}
@@ -0,0 +1,41 @@
+package stack
+
+import (
+	"gb-cms/common"
+	"testing"
+)
+
+func TestMansRTSP2JTControl(t *testing.T) {
+	streamId := common.StreamID("34020000001320000001/34020000001310000001.playback.1700000000.1700003600")
+
+	tests := []struct {
+		name     string
+		body     string
+		control  int
+		multiple int
+		position int64
+		wantErr  bool
+	}{
+		{"pause", "PAUSE RTSP/1.0\r\nCSeq: 2\r\nPauseTime: now\r\n", JTPlaybackControlPause, 0, 0, false},
+		{"teardown", "TEARDOWN RTSP/1.0\r\nCSeq: 3\r\n", JTPlaybackControlStop, 0, 0, false},
+		{"resume", "PLAY RTSP/1.0\r\nCSeq: 4\r\nRange: npt=now-\r\n", JTPlaybackControlPlay, 0, 0, false},
+		{"seek", "PLAY RTSP/1.0\r\nCSeq: 5\r\nRange: npt=100-\r\n", JTPlaybackControlSeek, 0, 1700000100, false},
+		{"seek with end", "PLAY RTSP/1.0\r\nCSeq: 6\r\nRange: npt=100-200\r\n", JTPlaybackControlSeek, 0, 1700000100, false},
+		{"seek decimal", "PLAY RTSP/1.0\r\nCSeq: 7\r\nRange: npt=12.5-3600.0\r\n", JTPlaybackControlSeek, 0, 1700000012, false},
+		{"fast forward", "PLAY RTSP/1.0\r\nCSeq: 8\r\nScale: 4.00\r\n", JTPlaybackControlFastForward, 3, 0, false},
+		{"rewind", "PLAY RTSP/1.0\r\nCSeq: 9\r\nScale: -2.00\r\n", JTPlaybackControlKeyFrameRewind, 2, 0, false},
+		{"invalid range", "PLAY RTSP/1.0\r\nCSeq: 10\r\nRange: npt=abc-\r\n", 0, 0, 0, true},
+		{"unsupported method", "OPTIONS RTSP/1.0\r\nCSeq: 11\r\n", 0, 0, 0, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			control, multiple, position, err := mansRTSP2JTControl(streamId, test.body)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("mansRTSP2JTControl() err = %v, wantErr %v", err, test.wantErr)
+			} else if control != test.control || multiple != test.multiple || position != test.position {
+				t.Errorf("mansRTSP2JTControl() = %d %d %d, want %d %d %d", control, multiple, position, test.control, test.multiple, test.position)
+			}
+		})
+	}
+}
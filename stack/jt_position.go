@@ -0,0 +1,97 @@
+package stack
+
+import (
+	"encoding/xml"
+	"fmt"
+	"gb-cms/dao"
+	"gb-cms/log"
+	"github.com/ghettovoice/gosip/sip"
+	"strconv"
+	"time"
+)
+
+// JTPosition 部标终端的位置汇报, 来自内置部标信令服务器或1078信令服务器
+type JTPosition struct {
+	SimNumber string  `json:"sim_number"`
+	Longitude float64 `json:"longitude"`
+	Latitude  float64 `json:"latitude"`
+	Altitude  int     `json:"altitude"`  // 海拔, 单位米
+	Speed     float64 `json:"speed"`     // 速度, 单位km/h
+	Direction int     `json:"direction"` // 方向, 正北为0, 顺时针
+	Time      int64   `json:"time"`      // 定位时间, 单位秒. 为0使用收到的时间
+}
+
+// 位置通知的根元素为Notify
+type mobilePositionNotifyMessage struct {
+	XMLName xml.Name `xml:"Notify"`
+	*MobilePositionNotify
+}
+
+func (p *JTPosition) toMobilePosition(deviceId string) *MobilePositionNotify {
+	t := time.Now()
+	if p.Time > 0 {
+		t = time.Unix(p.Time, 0)
+	}
+
+	speed := strconv.FormatFloat(p.Speed, 'f', 1, 64)
+	direction := strconv.Itoa(p.Direction)
+	altitude := strconv.Itoa(p.Altitude)
+	return &MobilePositionNotify{
+		DeviceID:  deviceId,
+		CmdType:   CmdMobilePosition,
+		SN:        GetSN(),
+		Time:      t.Format(RecordTimeFormat),
+		Longitude: p.Longitude,
+		Latitude:  p.Latitude,
+		Speed:     &speed,
+		Direction: &direction,
+		Altitude:  &altitude,
+	}
+}
+
+// NotifyPosition 通过上级的位置订阅会话发送位置通知, 上级未订阅不发送
+func (g *JTDevice) NotifyPosition(position *MobilePositionNotify) error {
+	request, err := g.CreateRequestByDialogType(dao.SipDialogTypeSubscribePosition, sip.NOTIFY)
+	if err != nil {
+		// 上级未订阅位置
+		return nil
+	}
+
+	indent, err := xml.MarshalIndent(&mobilePositionNotifyMessage{MobilePositionNotify: position}, " ", "")
+	if err != nil {
+		return err
+	}
+
+	request.SetBody(AddXMLHeader(string(indent)), true)
+	g.SendRequest(request)
+	return nil
+}
+
+// OnJTPosition 保存部标终端的位置, 转发给订阅了位置的上级
+func OnJTPosition(device *dao.JTDeviceModel, position *JTPosition) error {
+	notify := position.toMobilePosition(device.Username)
+	(&EventHandler{}).SavePosition(&dao.PositionModel{
+		DeviceID:  device.Username,
+		Longitude: notify.Longitude,
+		Latitude:  notify.Latitude,
+		Speed:     notify.Speed,
+		Direction: notify.Direction,
+		Altitude:  notify.Altitude,
+		Time:      notify.Time,
+		Source:    dao.PositionSourceJT,
+	})
+
+	client := JTDeviceManager.Find(device.Username)
+	if client == nil {
+		return fmt.Errorf("部标设备不存在 %s", device.Username)
+	} else if !client.Online() {
+		return nil
+	}
+
+	if err := client.(*JTDevice).NotifyPosition(notify); err != nil {
+		log.Sugar.Errorf("向上级发送位置通知失败 err: %s device: %s", err.Error(), device.Username)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,149 @@
+package stack
+
+import (
+	"encoding/json"
+	"fmt"
+	"gb-cms/common"
+	"gb-cms/dao"
+	"gb-cms/hook"
+	"gb-cms/log"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	// 每条应答消息携带的录像数量, 避免UDP消息过大
+	jtRecordBatchSize = 10
+)
+
+// QueryRecordInfo 上级查询录像
+type QueryRecordInfo struct {
+	BaseMessage
+	StartTime string `xml:"StartTime"`
+	EndTime   string `xml:"EndTime"`
+	Type      string `xml:"Type"`
+}
+
+// JTRecordResource 0x1205音视频资源列表中的资源
+type JTRecordResource struct {
+	ChannelNumber int    `json:"channel_number"`
+	StartTime     int64  `json:"start_time"` // 单位秒
+	EndTime       int64  `json:"end_time"`
+	AlarmFlag     uint64 `json:"alarm_flag"`   // 0-非报警录像
+	MediaType     int    `json:"media_type"`   // 0-音视频 1-音频 2-视频
+	StreamType    int    `json:"stream_type"`  // 1-主码流 2-子码流
+	StorageType   int    `json:"storage_type"` // 1-主存储器 2-灾备存储器
+	FileSize      uint64 `json:"file_size"`
+}
+
+// QueryJTRecords 通过on_query_record钩子通知1078信令服务器下发0x9205, 钩子在响应体中返回设备应答的资源列表
+func QueryJTRecords(simNumber string, channelNumber int, startTime, endTime int64) ([]*JTRecordResource, error) {
+	if hook.EventUrls[hook.EventTypeDeviceOnQueryRecord] == "" {
+		return nil, fmt.Errorf("未配置on_query_record钩子")
+	}
+
+	timeout := time.Duration(common.Config.Hooks.QueryRecordTimeout) * time.Second
+	response, err := hook.PostOnQueryRecordEvent(simNumber, strconv.Itoa(channelNumber), startTime, endTime, timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("响应状态码: %d", response.StatusCode)
+	}
+
+	var resources []*JTRecordResource
+	if err = json.NewDecoder(response.Body).Decode(&resources); err != nil {
+		return nil, fmt.Errorf("解析资源列表失败 %s", err.Error())
+	}
+
+	return resources, nil
+}
+
+// 资源列表转换为国标录像列表, 只保留上级查询的录像类型, 过滤纯音频资源
+func jtResources2Records(resources []*JTRecordResource, channel *dao.ChannelModel, recordType string) []RecordInfo {
+	var records []RecordInfo
+	for _, resource := range resources {
+		alarm := resource.AlarmFlag != 0
+		if resource.MediaType == 1 || ("alarm" == recordType && !alarm) || ("time" == recordType && alarm) {
+			continue
+		}
+
+		record := RecordInfo{
+			DeviceID:  channel.DeviceID,
+			Name:      channel.Name,
+			StartTime: time.Unix(resource.StartTime, 0).Format(RecordTimeFormat),
+			EndTime:   time.Unix(resource.EndTime, 0).Format(RecordTimeFormat),
+			Secrecy:   "0",
+			Type:      "time",
+			FileSize:  resource.FileSize,
+		}
+
+		if alarm {
+			record.Type = "alarm"
+		}
+
+		// 国标码流编号从0开始
+		if resource.StreamType > 1 {
+			record.StreamNumber = resource.StreamType - 1
+		}
+
+		records = append(records, record)
+	}
+
+	return records
+}
+
+// OnQueryRecord 上级查询录像, 查询失败或超时应答空的录像列表
+func (g *JTDevice) OnQueryRecord(query *QueryRecordInfo) {
+	response := QueryRecordInfoResponse{
+		CmdType:  CmdRecordInfo,
+		SN:       query.SN,
+		DeviceID: query.DeviceID,
+	}
+
+	channels, _ := dao.Channel.QueryChannelsByChannelID(query.DeviceID)
+	if len(channels) < 1 || channels[0].RootID != g.username {
+		log.Sugar.Errorf("处理1078的录像查询失败. 通道不存在 channel: %s device: %s", query.DeviceID, g.Username)
+		g.SendMessage(&response)
+		return
+	}
+
+	start, err := time.ParseInLocation(RecordTimeFormat, query.StartTime, time.Local)
+	if err != nil {
+		log.Sugar.Errorf("处理1078的录像查询失败. 开始时间格式错误 time: %s channel: %s", query.StartTime, query.DeviceID)
+		g.SendMessage(&response)
+		return
+	}
+
+	end, err := time.ParseInLocation(RecordTimeFormat, query.EndTime, time.Local)
+	if err != nil {
+		log.Sugar.Errorf("处理1078的录像查询失败. 结束时间格式错误 time: %s channel: %s", query.EndTime, query.DeviceID)
+		g.SendMessage(&response)
+		return
+	}
+
+	log.Sugar.Infof("查询1078录像 sim number: %s channel: %s start: %s end: %s type: %s", g.simNumber, query.DeviceID, query.StartTime, query.EndTime, query.Type)
+	resources, err := QueryJTRecords(g.simNumber, channels[0].ChannelNumber, start.Unix(), end.Unix())
+	if err != nil {
+		log.Sugar.Errorf("通知1078信令服务器查询录像失败 err: %s sim number: %s channel: %s", err.Error(), g.simNumber, query.DeviceID)
+		g.SendMessage(&response)
+		return
+	}
+
+	records := jtResources2Records(resources, channels[0], query.Type)
+	response.SumNum = len(records)
+	if response.SumNum < 1 {
+		g.SendMessage(&response)
+		return
+	}
+
+	for i := 0; i < len(records); i += jtRecordBatchSize {
+		items := records[i:min(i+jtRecordBatchSize, len(records))]
+		response.DeviceList.Num = len(items)
+		response.DeviceList.Devices = items
+		g.SendMessage(&response)
+	}
+}
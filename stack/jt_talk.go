@@ -0,0 +1,204 @@
+package stack
+
+import (
+	"context"
+	"fmt"
+	"gb-cms/common"
+	"gb-cms/dao"
+	"gb-cms/jt808"
+	"gb-cms/log"
+	"gb-cms/sdp"
+	"github.com/ghettovoice/gosip"
+	"github.com/ghettovoice/gosip/sip"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	// 等待上级应答广播invite的超时时间
+	jtBroadcastInviteTimeout = 10 * time.Second
+)
+
+// StartJTTalk 通知终端开始对讲/广播, dataType为jt808.DataTypeTalk或jt808.DataTypeBroadcast
+func StartJTTalk(streamId common.StreamID, dataType byte) error {
+	log.Sugar.Infof("通知终端开始对讲 stream: %s data type: %d", streamId, dataType)
+	return requestJTMedia(streamId, dataType)
+}
+
+// StopJTTalk 通知终端关闭对讲/广播. 外部1078信令服务器自行管理
+func StopJTTalk(streamId common.StreamID) error {
+	if JT808Server == nil {
+		return nil
+	}
+
+	control := jt808.LiveControl{
+		Channel:   jtChannelNumber(streamId),
+		Command:   jt808.LiveControlCloseTalk,
+		CloseType: jt808.LiveCloseAll,
+	}
+
+	log.Sugar.Infof("通知终端关闭对讲 stream: %s", streamId)
+	return sendJTCommand(streamId.DeviceID(), jt808.MsgIDLiveControl, control.Encode())
+}
+
+// OnTalkInvite 上级发起语音对讲, 流媒体服务将终端的对讲音频转发给上级, 上级的音频转发给终端
+func (g *JTDevice) OnTalkInvite(request sip.Request, user string, channel *dao.ChannelModel, offer *GBSDP) sip.Response {
+	if offer.Media == nil || "audio" != offer.MediaType {
+		log.Sugar.Errorf("处理上级对讲失败, offer中缺少audio字段. channel: %s device: %s sdp: %s", user, g.username, request.Body())
+		return CreateResponseWithStatusCode(request, http.StatusBadRequest)
+	}
+
+	streamId := common.GenerateStreamID(common.InviteTypeTalk, g.simNumber, strconv.Itoa(channel.ChannelNumber), "", "")
+	sink := &dao.SinkModel{
+		SinkStreamID: streamId,
+		StreamID:     streamId,
+		ServerAddr:   g.ServerAddr,
+		Protocol:     TransStreamGBTalk,
+		CreateTime:   time.Now().Unix(),
+	}
+
+	// 保存sink, 保存失败认为该通道正在对讲
+	if err := dao.Sink.CreateSink(sink); err != nil {
+		log.Sugar.Errorf("处理上级对讲失败, 通道正在对讲 channel: %s device: %s", user, g.username)
+		return CreateResponseWithStatusCode(request, 486)
+	}
+
+	// 协商音频编码, 终端的G711/ADPCM由流媒体服务转码
+	payload := NegotiateAudioPayload(offer)
+	response, err := AddForwardSink(TransStreamGBTalk, request, user, &Sink{sink}, streamId, offer, common.InviteTypeTalk, payload)
+	if err != nil {
+		log.Sugar.Errorf("处理上级对讲失败, 流媒体创建answer发生err: %s channel: %s device: %s", err.Error(), user, g.username)
+		_, _ = dao.Sink.DeleteSinkBySinkStreamID(streamId)
+		return CreateResponseWithStatusCode(request, http.StatusInternalServerError)
+	}
+
+	go func() {
+		if err := StartJTTalk(streamId, jt808.DataTypeTalk); err != nil {
+			log.Sugar.Errorf("通知终端对讲失败 err: %s stream: %s", err.Error(), streamId)
+			if model, _ := dao.Sink.DeleteSinkBySinkStreamID(streamId); model != nil {
+				(&Sink{model}).Close(true, true)
+			}
+		}
+	}()
+
+	return response
+}
+
+// OnBroadcast 上级发起语音广播, 应答后向上级发起invite接收广播音频, 流媒体服务转发给终端
+func (g *JTDevice) OnBroadcast(notify *BroadcastNotify, channel *dao.ChannelModel) {
+	log.Sugar.Infof("收到上级的语音广播 source: %s target: %s device: %s", notify.SourceID, notify.TargetID, g.username)
+
+	streamId := common.GenerateStreamID(common.InviteTypeBroadcast, g.simNumber, strconv.Itoa(channel.ChannelNumber), "", "")
+	stream := &dao.StreamModel{
+		DeviceID:   streamId.DeviceID(),
+		ChannelID:  streamId.ChannelID(),
+		StreamID:   streamId,
+		Protocol:   SourceType28181,
+		StreamType: string(common.InviteTypeBroadcast),
+		Name:       channel.Name,
+		SetupType:  common.SetupTypeUDP,
+	}
+
+	result := "OK"
+	if _, ok := dao.Stream.SaveStream(stream); !ok {
+		log.Sugar.Errorf("处理上级广播失败, 通道正在广播 stream: %s", streamId)
+		result = "ERROR"
+	}
+
+	g.SendMessage(&BaseResponse{
+		BaseMessage: BaseMessage{CmdType: CmdBroadcast, SN: notify.SN, DeviceID: notify.TargetID},
+		Result:      result,
+	})
+
+	if "OK" != result {
+		return
+	}
+
+	err := g.inviteBroadcast(notify, stream)
+	if err == nil {
+		_ = dao.Stream.UpdateStream(stream)
+		err = StartJTTalk(streamId, jt808.DataTypeBroadcast)
+	}
+
+	if err != nil {
+		log.Sugar.Errorf("处理上级广播失败 err: %s stream: %s", err.Error(), streamId)
+		CloseStream(streamId, true)
+	}
+}
+
+// 向上级发起invite, 流媒体服务接收上级的广播音频
+func (g *JTDevice) inviteBroadcast(notify *BroadcastNotify, stream *dao.StreamModel) error {
+	ip, port, urls, ssrc, err := MSCreateGBSource(string(stream.StreamID), stream.SetupType.String(), "", string(common.InviteTypeBroadcast), 0)
+	if err != nil {
+		return err
+	}
+
+	stream.Urls = urls
+	offer := BuildSDP("audio", notify.TargetID, "Play", ip, port, "0", "0", stream.SetupType.String(), 0, ssrc, nil, common.Config.AudioPayloads...)
+	request, err := BuildRequest(sip.INVITE, notify.TargetID, g.sipUA.ListenAddr, notify.SourceID, g.ServerAddr, g.sipUA.Transport, &SDPMessageType, offer)
+	if err != nil {
+		return err
+	}
+
+	request.AppendHeader(GlobalContactAddress.AsContactHeader())
+	request.AppendHeader(Subject(notify.SourceID + ":0," + notify.TargetID + ":" + ssrc))
+
+	var dialog sip.Request
+	var body string
+	ctx, cancel := context.WithTimeout(context.Background(), jtBroadcastInviteTimeout)
+	defer cancel()
+
+	common.SipStack.SendRequestWithContext(ctx, request, gosip.WithResponseHandler(func(res sip.Response, _ sip.Request) {
+		if res.StatusCode() < 200 {
+			return
+		} else if res.StatusCode() > 299 {
+			err = fmt.Errorf("answer has a bad status code: %d", res.StatusCode())
+			cancel()
+			return
+		}
+
+		body = res.Body()
+		ackRequest := sip.NewAckRequest("", request, res, "", nil)
+		ackRequest.AppendHeader(GlobalContactAddress.AsContactHeader())
+
+		// 应答的contact可能不对, 发送给上级地址
+		host, p, _ := net.SplitHostPort(g.ServerAddr)
+		remotePort, _ := strconv.Atoi(p)
+		sipPort := sip.Port(remotePort)
+		recipient := ackRequest.Recipient()
+		recipient.SetHost(host)
+		recipient.SetPort(&sipPort)
+
+		if err = common.SipStack.Send(ackRequest); err != nil {
+			cancel()
+		} else {
+			dialog = CreateDialogRequestFromAnswer(res, false, g.ServerAddr)
+		}
+	}))
+
+	if err != nil {
+		return err
+	} else if dialog == nil {
+		return fmt.Errorf("invite request timeout")
+	}
+
+	stream.SetDialog(dialog)
+
+	// 告知流媒体服务上级选择的音频编码
+	answer, err := sdp.Parse(body)
+	if err != nil {
+		return err
+	}
+
+	var payloads []string
+	if answer.Audio != nil {
+		for _, codec := range answer.Audio.Codecs {
+			payloads = append(payloads, fmt.Sprintf("%d %s/%d", codec.PT, codec.Name, codec.Rate))
+		}
+	}
+
+	_, stream.AudioCodec = AnswerCodecs(answer)
+	return MSConnectGBSource(string(stream.StreamID), "", 0, payloads)
+}
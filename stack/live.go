@@ -14,12 +14,36 @@ import (
 	"time"
 )
 
-func (d *Device) StartStream(inviteType common.InviteType, streamId common.StreamID, channelId, startTime, stopTime, setup string, speed int, sync bool) (*dao.StreamModel, error) {
+func (d *Device) StartStream(inviteType common.InviteType, streamId common.StreamID, channelId, startTime, stopTime, setup string, speed int, sync bool) (stream *dao.StreamModel, err error) {
+	// 同一路流已有invite在进行中, 等待其结果
+	pending := NewPendingInvite()
+	if old, ok := PendingInvites.Add(string(streamId), pending); !ok {
+		log.Sugar.Infof("等待进行中的invite streamId: %s", streamId)
+		return old.Wait()
+	}
+
+	// 发生panic也要唤醒等待者, 否则后续同一路流的invite永远等待
+	defer func() {
+		if r := recover(); r != nil {
+			log.Sugar.Errorf("invite发生panic err: %v streamId: %s", r, streamId)
+			stream, err = nil, fmt.Errorf("invite失败 %v", r)
+		}
+
+		PendingInvites.Remove(string(streamId))
+		pending.Done(stream, err)
+	}()
+
+	return d.startStream(inviteType, streamId, channelId, startTime, stopTime, setup, speed, sync)
+}
+
+func (d *Device) startStream(inviteType common.InviteType, streamId common.StreamID, channelId, startTime, stopTime, setup string, speed int, sync bool) (*dao.StreamModel, error) {
 	channel, err := dao.Channel.QueryChannel(d.DeviceID, channelId)
 	if err != nil {
 		return nil, err
 	} else if channel == nil {
 		return nil, fmt.Errorf("channel not found")
+	} else if IsVirtualDevice(d.DeviceModel) {
+		return d.startProxyStream(inviteType, streamId, channel)
 	}
 
 	stream := &dao.StreamModel{
@@ -31,7 +55,7 @@ func (d *Device) StartStream(inviteType common.InviteType, streamId common.Strea
 		Name:       channel.Name,
 	}
 
-	// 先添加占位置, 防止重复请求
+	// 先添加占位置, 防止重复请求. 进行中的invite已由PendingInvites拦截, 此处存在的都是已建立的流
 	oldStream, b := dao.Stream.SaveStream(stream)
 	if !b {
 		if oldStream == nil {
@@ -40,43 +64,97 @@ func (d *Device) StartStream(inviteType common.InviteType, streamId common.Strea
 		return oldStream, nil
 	}
 
-	dialog, urls, err := d.Invite(inviteType, streamId, channelId, startTime, stopTime, setup, speed)
-	if err != nil {
+	// 依次尝试的取流方式, 收流超时后挂断, 使用下一种方式重新invite
+	setups := d.fallbackSetups(setup)
+	_, _, firstPacketTimeout := d.GetInviteTimeout()
+
+	invite := func(setup string) error {
+		dialog, urls, answer, err := d.Invite(inviteType, streamId, channelId, startTime, stopTime, setup, speed)
+		if err != nil {
+			return err
+		}
+
+		stream.VideoCodec, stream.AudioCodec = AnswerCodecs(answer)
+		stream.FileSize = answer.FileSize
+		stream.SetDialog(dialog)
+		stream.SetupType = common.String2SetupType(setup)
+		stream.Urls = urls
+		if common.InviteTypePlay != inviteType {
+			// 回放/下载从开始时间按请求的倍速播放
+			start, _ := strconv.ParseInt(startTime, 10, 64)
+			end, _ := strconv.ParseInt(stopTime, 10, 64)
+			stream.InitPlayback(start, end, max(float64(speed), 1))
+		}
+		return nil
+	}
+
+	if err = invite(setups[0]); err != nil {
 		_, _ = dao.Stream.DeleteStream(streamId)
 		return nil, err
 	}
 
-	stream.SetDialog(dialog)
-
 	// 等待流媒体服务发送推流通知
 	wait := func() bool {
-		waiting := StreamWaiting{}
-		log.Sugar.Infof("等待收流通知 streamId: %s", streamId)
-		_, _ = EarlyDialogs.Add(string(streamId), &waiting)
-		defer EarlyDialogs.Remove(string(streamId))
-
-		ok := http.StatusOK == waiting.Receive(10)
-		if !ok {
-			log.Sugar.Infof("收流超时 发送bye请求...")
-			CloseStream(streamId, true)
+		for i := 0; ; i++ {
+			waiting := StreamWaiting{}
+			log.Sugar.Infof("等待收流通知 streamId: %s setup: %s", streamId, setups[i])
+			_, _ = EarlyDialogs.Add(string(streamId), &waiting)
+			ok := http.StatusOK == waiting.Receive(firstPacketTimeout)
+			EarlyDialogs.Remove(string(streamId))
+			if ok {
+				// 记住可以收到流的取流方式
+				if i > 0 && d.Setup != stream.SetupType {
+					log.Sugar.Infof("更新设备取流方式 device: %s setup: %s", d.DeviceID, setups[i])
+					_ = dao.Device.UpdateMediaTransport(d.DeviceID, stream.SetupType)
+				}
+				return true
+			} else if i+1 >= len(setups) {
+				break
+			}
+
+			log.Sugar.Infof("收流超时, 更换取流方式重试 streamId: %s setup: %s", streamId, setups[i+1])
+			(&Stream{stream}).Bye()
+			_ = MSCloseSource(string(streamId))
+			if err := invite(setups[i+1]); err != nil {
+				log.Sugar.Errorf("更换取流方式重试失败 err: %s streamId: %s", err.Error(), streamId)
+				break
+			}
+
+			_ = dao.Stream.UpdateStream(stream)
 		}
-		return ok
+
+		log.Sugar.Infof("收流超时 发送bye请求...")
+		CloseStream(streamId, true)
+		return false
 	}
 
 	if sync {
+		_ = dao.Stream.UpdateStream(stream)
 		go wait()
-	} else if !sync && !wait() {
+		return stream, nil
+	} else if !wait() {
 		return nil, fmt.Errorf("receiving stream timed out")
 	}
 
-	stream.Urls = urls
-
 	// 保存到数据库
 	_ = dao.Stream.UpdateStream(stream)
 	return stream, nil
 }
 
-func (d *Device) Invite(inviteType common.InviteType, streamId common.StreamID, channelId, startTime, stopTime, setup string, speed int) (sip.Request, []string, error) {
+// 返回依次尝试的取流方式, 从指定的取流方式开始, 再按照全局配置的顺序尝试其余的取流方式
+func (d *Device) fallbackSetups(setup string) []string {
+	setups := []string{setup}
+	for _, fallback := range common.String2SetupTypes(common.Config.MediaTransportFallback) {
+		if fallback.String() != setup {
+			setups = append(setups, fallback.String())
+		}
+	}
+
+	return setups
+}
+
+// Invite 发起invite请求, 返回会话、拉流地址和设备的应答
+func (d *Device) Invite(inviteType common.InviteType, streamId common.StreamID, channelId, startTime, stopTime, setup string, speed int) (sip.Request, []string, *sdp.SDP, error) {
 	var err error
 	var ssrc string
 
@@ -91,31 +169,43 @@ func (d *Device) Invite(inviteType common.InviteType, streamId common.StreamID,
 	ip, port, urls, ssrc, msErr := MSCreateGBSource(string(streamId), setup, "", string(inviteType), float64(speed))
 	if msErr != nil {
 		log.Sugar.Errorf("创建GBSource失败 err: %s", msErr.Error())
-		return nil, nil, msErr
+		return nil, nil, nil, msErr
 	}
 
-	// 创建invite请求
+	// 创建invite请求, 码流由流ID决定
 	var inviteRequest sip.Request
+	streamNumber := streamId.StreamNumber()
+	if streamNumber == common.StreamNumberDefault && d.StreamMode != "" {
+		streamNumber = common.StreamNumberMain
+	}
+
 	if common.InviteTypePlayback == inviteType {
-		inviteRequest, err = d.BuildPlaybackRequest(channelId, ip, port, startTime, stopTime, setup, ssrc)
+		inviteRequest, err = d.BuildPlaybackRequest(channelId, ip, port, startTime, stopTime, setup, ssrc, streamNumber)
 	} else if common.InviteTypeDownload == inviteType {
-		inviteRequest, err = d.BuildDownloadRequest(channelId, ip, port, startTime, stopTime, setup, speed, ssrc)
+		inviteRequest, err = d.BuildDownloadRequest(channelId, ip, port, startTime, stopTime, setup, speed, ssrc, streamNumber)
 	} else {
-		inviteRequest, err = d.BuildLiveRequest(channelId, ip, port, setup, ssrc)
+		inviteRequest, err = d.BuildLiveRequest(channelId, ip, port, setup, ssrc, streamNumber)
 	}
 
 	if err != nil {
 		log.Sugar.Errorf("创建invite失败 err: %s", err.Error())
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 
 	var dialogRequest sip.Request
 	var body string
-	reqCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	inviteTimeout, ackTimeout, _ := d.GetInviteTimeout()
+	reqCtx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// 在invite超时时间内未收到任何应答则取消, 收到临时应答后, 重新计时等待200应答
+	timer := time.AfterFunc(time.Duration(inviteTimeout)*time.Second, cancel)
+	defer timer.Stop()
+
 	// invite信令交互
 	common.SipStack.SendRequestWithContext(reqCtx, inviteRequest, gosip.WithResponseHandler(func(res sip.Response, request sip.Request) {
 		if res.StatusCode() < 200 {
-
+			timer.Reset(time.Duration(ackTimeout) * time.Second)
 		} else if res.StatusCode() == 200 {
 			body = res.Body()
 			ackRequest := sip.NewAckRequest("", inviteRequest, res, "", nil)
@@ -144,49 +234,66 @@ func (d *Device) Invite(inviteType common.InviteType, streamId common.StreamID,
 	}))
 
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	} else if dialogRequest == nil {
 		// invite 没有收到任何应答
-		return nil, nil, fmt.Errorf("invite request timeout")
-	} else if "active" == setup || common.InviteTypeDownload == inviteType {
+		return nil, nil, nil, fmt.Errorf("invite request timeout")
+	}
+
+	// 解析应答, 获取设备选择的编码
+	answer, parseErr := sdp.Parse(body)
+	if parseErr != nil {
+		log.Sugar.Warnf("解析应答sdp失败 err: %s sdp: %s", parseErr.Error(), body)
+	}
+
+	var payloads []string
+	if answer != nil && answer.Video != nil {
+		for _, codec := range answer.Video.Codecs {
+			payloads = append(payloads, fmt.Sprintf("%d %s/%d", codec.PT, codec.Name, codec.Rate))
+		}
+	}
+
+	// 非PS流, 需要告知流媒体服务负载类型
+	es := len(payloads) > 0 && common.DefaultVideoPayload.Name() != common.Payload(payloads[0]).Name()
+	if "active" == setup || common.InviteTypeDownload == inviteType || es {
 		// 如果是TCP主动拉流, 还需要将拉流地址告知给流媒体服务
-		var answer *sdp.SDP
-		answer, err = sdp.Parse(body)
-		if err != nil {
-			return nil, nil, err
+		if err = parseErr; err != nil {
+			return nil, nil, nil, err
 		}
 
 		// 解析下载的文件大小
 		var fileSize int
 		if common.InviteTypeDownload == inviteType {
-			for _, attr := range answer.Attrs {
-				if "filesize" != attr[0] {
-					continue
-				}
+			fileSize = int(answer.FileSize)
+		}
 
-				fileSize, _ = strconv.Atoi(attr[1])
-			}
+		var addr string
+		if "active" == setup || common.InviteTypeDownload == inviteType {
+			addr = fmt.Sprintf("%s:%d", answer.Addr, answer.Video.Port)
 		}
 
-		addr := fmt.Sprintf("%s:%d", answer.Addr, answer.Video.Port)
-		if err = MSConnectGBSource(string(streamId), addr, fileSize); err != nil {
+		if err = MSConnectGBSource(string(streamId), addr, fileSize, payloads); err != nil {
 			log.Sugar.Errorf("设置GB28181连接地址失败 err: %s addr: %s", err.Error(), addr)
-			return nil, nil, err
+			return nil, nil, nil, err
 		}
 	}
 
-	return dialogRequest, urls, nil
+	if answer == nil {
+		answer = &sdp.SDP{}
+	}
+
+	return dialogRequest, urls, answer, nil
 }
 
-func (d *Device) Play(streamId common.StreamID, channelId, setup string) (sip.Request, []string, error) {
+func (d *Device) Play(streamId common.StreamID, channelId, setup string) (sip.Request, []string, *sdp.SDP, error) {
 	return d.Invite(common.InviteTypePlay, streamId, channelId, "", "", setup, 0)
 }
 
-func (d *Device) Playback(streamId common.StreamID, channelId, startTime, stopTime, setup string) (sip.Request, []string, error) {
+func (d *Device) Playback(streamId common.StreamID, channelId, startTime, stopTime, setup string) (sip.Request, []string, *sdp.SDP, error) {
 	return d.Invite(common.InviteTypePlayback, streamId, channelId, startTime, stopTime, setup, 0)
 
 }
 
-func (d *Device) Download(streamId common.StreamID, channelId, startTime, stopTime, setup string, speed int) (sip.Request, []string, error) {
+func (d *Device) Download(streamId common.StreamID, channelId, startTime, stopTime, setup string, speed int) (sip.Request, []string, *sdp.SDP, error) {
 	return d.Invite(common.InviteTypePlayback, streamId, channelId, startTime, stopTime, setup, speed)
 }
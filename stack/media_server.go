@@ -28,6 +28,7 @@ const (
 	SourceType28181
 	SourceType1078
 	SourceTypeGBTalk
+	SourceTypePull // 拉流代理
 )
 
 type SourceDetails struct {
@@ -49,15 +50,16 @@ type SinkDetails struct {
 }
 
 type SDP struct {
-	SessionName string  `json:"session_name,omitempty"` // play/download/playback/talk/broadcast
-	Addr        string  `json:"addr,omitempty"`         // 连接地址
-	SSRC        string  `json:"ssrc,omitempty"`
-	Setup       string  `json:"setup,omitempty"`     // active/passive
-	Transport   string  `json:"transport,omitempty"` // tcp/udp
-	Speed       float64 `json:"speed"`
-	StartTime   int     `json:"start_time,omitempty"`
-	EndTime     int     `json:"end_time,omitempty"`
-	FileSize    int     `json:"file_size,omitempty"`
+	SessionName string   `json:"session_name,omitempty"` // play/download/playback/talk/broadcast
+	Addr        string   `json:"addr,omitempty"`         // 连接地址
+	SSRC        string   `json:"ssrc,omitempty"`
+	Setup       string   `json:"setup,omitempty"`     // active/passive
+	Transport   string   `json:"transport,omitempty"` // tcp/udp
+	Speed       float64  `json:"speed"`
+	StartTime   int      `json:"start_time,omitempty"`
+	EndTime     int      `json:"end_time,omitempty"`
+	FileSize    int      `json:"file_size,omitempty"`
+	Payloads    []string `json:"payloads,omitempty"` // 协商的负载类型, 例如"100 H265/90000"
 }
 
 type SourceSDP struct {
@@ -139,12 +141,43 @@ func MSCreateGBSource(id, setup string, ssrc string, sessionName string, speed f
 	return host, uint16(port), data.Data.Urls, data.Data.SSRC, err
 }
 
-func MSConnectGBSource(id, addr string, fileSize int) error {
+// MSCreatePullSource 通知流媒体服务拉取RTSP/RTMP/HTTP-FLV地址作为source, 返回拉流地址. 拉流成功后通过推流事件通知
+func MSCreatePullSource(id, pullUrl, transport string) ([]string, error) {
+	v := &struct {
+		Source    string `json:"source"`
+		Url       string `json:"url"`
+		Transport string `json:"transport,omitempty"` // RTSP拉流的传输方式, tcp/udp
+	}{
+		Source:    id,
+		Url:       pullUrl,
+		Transport: transport,
+	}
+
+	response, err := Send("api/v1/pull/source/create", v)
+	if err != nil {
+		return nil, err
+	}
+
+	data := &common.Response[struct {
+		Urls []string `json:"urls"`
+	}]{}
+
+	if err = common.DecodeJSONBody(response.Body, data); err != nil {
+		return nil, err
+	} else if http.StatusOK != data.Code {
+		return nil, fmt.Errorf(data.Msg)
+	}
+
+	return data.Data.Urls, nil
+}
+
+func MSConnectGBSource(id, addr string, fileSize int, payloads []string) error {
 	v := &SourceSDP{
 		Source: id,
 		SDP: SDP{
 			Addr:     addr,
 			FileSize: fileSize,
+			Payloads: payloads,
 		},
 	}
 
@@ -286,3 +319,64 @@ func MSSpeedSet(id string, speed float64) error {
 	_, err := Send("api/v1/gb28181/speed/set", v)
 	return err
 }
+
+// MSStartRecord 开启流媒体服务录制
+func MSStartRecord(id string) error {
+	return msRecord("api/v1/record/start", id, nil)
+}
+
+// MSStartRecordWithFormat 开启流媒体服务录制, 指定录制的文件格式, 例如mp4
+func MSStartRecordWithFormat(id, format string) error {
+	values := url.Values{}
+	values.Set("format", format)
+	return msRecord("api/v1/record/start", id, values)
+}
+
+// MSStopRecord 关闭流媒体服务录制
+func MSStopRecord(id string) error {
+	return msRecord("api/v1/record/stop", id, nil)
+}
+
+func msRecord(path, id string, values url.Values) error {
+	if values == nil {
+		values = url.Values{}
+	}
+
+	values.Set("streamid", id)
+	response, err := SendWithUrlParams(path, nil, values)
+	if err != nil {
+		return err
+	}
+
+	_ = response.Body.Close()
+	if http.StatusOK != response.StatusCode {
+		return fmt.Errorf("%s %s", path, response.Status)
+	}
+
+	return nil
+}
+
+// MSQueryStreamInBytes 查询流媒体服务已接收的字节数
+func MSQueryStreamInBytes(id string) (int64, error) {
+	values := url.Values{}
+	values.Set("streamid", id)
+	response, err := MSQueryStreamInfo(nil, values.Encode())
+	if err != nil {
+		return 0, err
+	}
+
+	defer response.Body.Close()
+	if http.StatusOK != response.StatusCode {
+		return 0, fmt.Errorf("query stream info %s", response.Status)
+	}
+
+	info := struct {
+		InBytes int64 `json:"InBytes"`
+	}{}
+
+	if err = json.NewDecoder(response.Body).Decode(&info); err != nil {
+		return 0, err
+	}
+
+	return info.InBytes, nil
+}
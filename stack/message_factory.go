@@ -12,7 +12,8 @@ const (
 	XmlHeaderGBK = `<?xml version="1.0"?>` + "\r\n"
 )
 
-func BuildSDP(media, userName, sessionName, ip string, port uint16, startTime, stopTime, setup string, speed int, ssrc string, attrs ...string) string {
+// BuildSDP 创建国标SDP, mediaAttrs为额外的媒体属性, 例如streamnumber:1, attrs为rtpmap
+func BuildSDP(media, userName, sessionName, ip string, port uint16, startTime, stopTime, setup string, speed int, ssrc string, mediaAttrs []string, attrs ...string) string {
 	format := "v=0\r\n" +
 		"o=%s 0 0 IN IP4 %s\r\n" +
 		"s=%s\r\n" +
@@ -51,6 +52,10 @@ func BuildSDP(media, userName, sessionName, ip string, port uint16, startTime, s
 		sdp += fmt.Sprintf("a=downloadspeed:%d\r\n", speed)
 	}
 
+	for _, attr := range mediaAttrs {
+		sdp += fmt.Sprintf("a=%s\r\n", attr)
+	}
+
 	sdp += fmt.Sprintf("y=%s\r\n", ssrc)
 	return sdp
 }
@@ -0,0 +1,202 @@
+package stack
+
+import (
+	"encoding/json"
+	"fmt"
+	"gb-cms/common"
+	"gb-cms/dao"
+	"gb-cms/hook"
+	"gb-cms/log"
+	mqtt "github.com/eclipse/paho.mqtt.golang"
+	"time"
+)
+
+const (
+	// 等待设备应答目录查询的超时时间, 单位秒
+	mqttCatalogTimeout = 15
+)
+
+var (
+	mqttClient mqtt.Client
+)
+
+// MQTTRequest 控制指令, 通过{topic_prefix}/request下发
+type MQTTRequest struct {
+	ID        string `json:"id"`      // 请求ID, 原样返回
+	Command   string `json:"command"` // ptz/start_stream/catalog
+	DeviceID  string `json:"device_id"`
+	ChannelID string `json:"channel_id"`
+	PTZ       string `json:"ptz"`   // 云台命令 left/right/up/down/zoomin/zoomout/stop
+	Setup     string `json:"setup"` // 拉流的传输方式, 为空使用设备的配置
+}
+
+// MQTTResponse 指令应答, 发布到{topic_prefix}/response
+type MQTTResponse struct {
+	ID      string      `json:"id"`
+	Command string      `json:"command"`
+	Code    int         `json:"code"` // 0-成功
+	Msg     string      `json:"msg"`
+	Data    interface{} `json:"data,omitempty"`
+}
+
+func mqttTopic(suffix string) string {
+	return common.Config.MQTT.TopicPrefix + "/" + suffix
+}
+
+// 返回事件发布的主题, 以及是否保留消息
+func mqttEventTopic(event *EventMessage) (string, bool) {
+	data, _ := event.Data.(map[string]interface{})
+	deviceId, _ := data["device_id"].(string)
+
+	switch event.Topic {
+	case hook.EventName(hook.EventTypeDeviceOnline), hook.EventName(hook.EventTypeDeviceOffline):
+		return mqttTopic(fmt.Sprintf("device/%s/status", deviceId)), true
+	case hook.EventName(hook.EventTypeDevicePosition):
+		return mqttTopic(fmt.Sprintf("device/%s/position", deviceId)), true
+	case hook.EventName(hook.EventTypeChannelStatus):
+		return mqttTopic(fmt.Sprintf("channel/%s/%s/status", deviceId, data["channel_id"])), true
+	case hook.EventName(hook.EventTypeStreamStart), hook.EventName(hook.EventTypeStreamStop):
+		// 流ID包含设备ID和通道ID, 例如device/channel. 关闭的流不保留, 发布后清除保留的消息
+		return mqttTopic(fmt.Sprintf("stream/%s/status", data["stream"])), hook.EventName(hook.EventTypeStreamStart) == event.Topic
+	case hook.EventName(hook.EventTypeCascadeOnline), hook.EventName(hook.EventTypeCascadeOffline):
+		return mqttTopic(fmt.Sprintf("cascade/%s/status", data["username"])), true
+	default:
+		// 报警/目录变化/心跳超时
+		return mqttTopic(fmt.Sprintf("device/%s/%s", deviceId, event.Topic)), false
+	}
+}
+
+func mqttPublish(topic string, retained bool, payload interface{}) {
+	bytes, err := json.Marshal(payload)
+	if err != nil {
+		log.Sugar.Errorf("序列化mqtt消息失败 err: %s topic: %s", err.Error(), topic)
+		return
+	}
+
+	// 不等待broker应答, 断线期间由客户端缓存, 重连后发送
+	mqttClient.Publish(topic, common.Config.MQTT.QoS, retained && common.Config.MQTT.Retain, bytes)
+}
+
+// 发布空的保留消息, 清除broker保留的消息
+func mqttClearRetained(topic string) {
+	if common.Config.MQTT.Retain {
+		mqttClient.Publish(topic, common.Config.MQTT.QoS, true, []byte{})
+	}
+}
+
+// 转发事件总线上的所有事件
+func publishMQTTEvents() {
+	subscriber := EventBus.Subscribe(nil)
+	for event := range subscriber.C {
+		topic, retained := mqttEventTopic(event)
+		mqttPublish(topic, retained, event)
+
+		// 流已关闭, 新的订阅者不再收到该流的状态
+		if hook.EventName(hook.EventTypeStreamStop) == event.Topic {
+			mqttClearRetained(topic)
+		}
+	}
+}
+
+func onMQTTRequest(_ mqtt.Client, message mqtt.Message) {
+	request := &MQTTRequest{}
+	if err := json.Unmarshal(message.Payload(), request); err != nil {
+		log.Sugar.Errorf("解析mqtt指令失败 err: %s payload: %s", err.Error(), message.Payload())
+		return
+	}
+
+	log.Sugar.Infof("收到mqtt指令 %v", *request)
+
+	// 拉流和查询目录需要等待设备应答, 不阻塞客户端的消息处理
+	go func() {
+		response := &MQTTResponse{ID: request.ID, Command: request.Command, Msg: "OK"}
+		data, err := ExecuteMQTTRequest(request)
+		if err != nil {
+			log.Sugar.Errorf("执行mqtt指令失败 err: %s command: %s device: %s", err.Error(), request.Command, request.DeviceID)
+			response.Code = -1
+			response.Msg = err.Error()
+		} else {
+			response.Data = data
+		}
+
+		mqttPublish(mqttTopic("response"), false, response)
+	}()
+}
+
+// ExecuteMQTTRequest 执行控制指令
+func ExecuteMQTTRequest(request *MQTTRequest) (interface{}, error) {
+	model, _ := dao.Device.QueryDevice(request.DeviceID)
+	if model == nil {
+		return nil, fmt.Errorf("设备不存在")
+	} else if !model.Online() {
+		return nil, fmt.Errorf("设备离线")
+	}
+
+	device := &Device{DeviceModel: model}
+	switch request.Command {
+	case "ptz":
+		if IsOnvifDevice(model) {
+			return nil, OnvifControlPTZ(request.DeviceID, request.ChannelID, request.PTZ)
+		}
+
+		device.ControlPTZ(request.PTZ, request.ChannelID)
+		return nil, nil
+	case "start_stream":
+		setup := request.Setup
+		if setup == "" {
+			setup = model.GetSetup().String()
+		}
+
+		streamId := common.GenerateStreamIDWithNumber(common.InviteTypePlay, model.GetID(), request.ChannelID, "", "", model.StreamMode.Number())
+		stream, err := device.StartStream(common.InviteTypePlay, streamId, request.ChannelID, "", "", setup, 0, true)
+		if err != nil {
+			return nil, err
+		}
+
+		return map[string]interface{}{"stream": streamId, "urls": stream.Urls}, nil
+	case "catalog":
+		channels, err := device.QueryCatalog(mqttCatalogTimeout)
+		if err != nil {
+			return nil, err
+		}
+
+		return map[string]interface{}{"channel_count": len(channels)}, nil
+	default:
+		return nil, fmt.Errorf("不支持的指令 %s", request.Command)
+	}
+}
+
+// StartMQTT 连接broker, 发布事件并订阅控制指令. 断线自动重连
+func StartMQTT() {
+	config := common.Config.MQTT
+	statusTopic := mqttTopic("status")
+
+	options := mqtt.NewClientOptions().
+		AddBroker(config.Broker).
+		SetClientID(config.ClientID).
+		SetUsername(config.Username).
+		SetPassword(config.Password).
+		SetAutoReconnect(true).
+		SetConnectRetry(true).
+		SetConnectRetryInterval(5*time.Second).
+		SetWill(statusTopic, "offline", config.QoS, true)
+
+	// 重连后重新订阅
+	options.SetOnConnectHandler(func(client mqtt.Client) {
+		log.Sugar.Infof("连接mqtt broker成功 addr: %s", config.Broker)
+
+		client.Publish(statusTopic, config.QoS, true, "online")
+		if token := client.Subscribe(mqttTopic("request"), config.QoS, onMQTTRequest); token.Wait() && token.Error() != nil {
+			log.Sugar.Errorf("订阅mqtt指令失败 err: %s", token.Error().Error())
+		}
+	})
+
+	options.SetConnectionLostHandler(func(_ mqtt.Client, err error) {
+		log.Sugar.Errorf("mqtt连接断开 err: %s addr: %s", err.Error(), config.Broker)
+	})
+
+	mqttClient = mqtt.NewClient(options)
+	mqttClient.Connect()
+
+	go publishMQTTEvents()
+}
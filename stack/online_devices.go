@@ -3,6 +3,7 @@ package stack
 import (
 	"gb-cms/common"
 	"gb-cms/dao"
+	"gb-cms/hook"
 	"gb-cms/log"
 	"sync"
 	"time"
@@ -92,6 +93,7 @@ func NewOnlineDeviceManager() *onlineDeviceManager {
 // OnExpires Redis设备ID到期回调
 func OnExpires(db int, id string) {
 	log.Sugar.Infof("设备心跳过期 device: %s", id)
+	PublishEvent(hook.EventTypeDeviceKeepaliveTimeout, map[string]interface{}{"device_id": id})
 	CloseDevice(id, "设备超时离线 OFF")
 }
 
@@ -115,4 +117,5 @@ func CloseDevice(id string, reason string) {
 	}
 
 	(&Device{DeviceModel: device}).Close()
+	PublishEvent(hook.EventTypeDeviceOffline, map[string]interface{}{"device_id": id, "reason": reason})
 }
@@ -0,0 +1,303 @@
+package stack
+
+import (
+	"fmt"
+	"gb-cms/common"
+	"gb-cms/dao"
+	"gb-cms/log"
+	"gb-cms/onvif"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	// OnvifUserAgent ONVIF虚拟设备的UserAgent, 用于区分国标设备
+	OnvifUserAgent = "ONVIF"
+	// ONVIF虚拟设备的类型编码(118-NVR), 序号以8开头
+	onvifDeviceTypeCode = "1188"
+	// 云台连续移动的速度, 与国标控制使用的速度一致
+	onvifPTZSpeed = 0.5
+)
+
+var (
+	// 离线设备的请求需要等待超时, 避免上一轮检测未结束时重复检测
+	onvifCheckLock sync.Mutex
+)
+
+// GenerateOnvifDeviceID 根据ONVIF设备ID生成20位虚拟设备ID. 序号只有6位, 重复时由dao顺延
+func GenerateOnvifDeviceID(id uint) string {
+	return fmt.Sprintf("%s%s%06d", sipIDPrefix(), onvifDeviceTypeCode, id%1000000)
+}
+
+func IsOnvifDevice(device *dao.DeviceModel) bool {
+	return device.UserAgent == OnvifUserAgent
+}
+
+// 数据库中的密码是加密的, 解密失败按空密码处理
+func onvifPassword(device *dao.OnvifDeviceModel) string {
+	password, err := common.DecryptSecret(device.Password)
+	if err != nil {
+		log.Sugar.Errorf("解密ONVIF设备密码失败 err: %s device: %s", err.Error(), device.DeviceID)
+	}
+
+	return password
+}
+
+func newOnvifClient(device *dao.OnvifDeviceModel) *onvif.Client {
+	return onvif.NewClient(device.XAddr, device.Username, onvifPassword(device))
+}
+
+// 取流地址携带认证信息, 仅在通知流媒体服务拉流时添加, 不保存到数据库
+func withCredentials(uri, username, password string) string {
+	u, err := url.Parse(uri)
+	if err != nil || username == "" || u.User != nil {
+		return uri
+	}
+
+	u.User = url.UserPassword(username, password)
+	return u.String()
+}
+
+// AddOnvifDevice 查询设备信息和媒体配置, 作为虚拟国标设备接入, 每个媒体配置生成一个通道
+func AddOnvifDevice(xaddr, endpoint, username, password, name string, alwaysOn bool) (*dao.OnvifDeviceModel, error) {
+	client := onvif.NewClient(xaddr, username, password)
+	if err := client.SyncTime(); err != nil {
+		log.Sugar.Errorf("查询ONVIF设备时间失败 err: %s xaddr: %s", err.Error(), xaddr)
+		return nil, fmt.Errorf("设备无法访问")
+	}
+
+	info, err := client.GetDeviceInformation()
+	if err != nil {
+		return nil, fmt.Errorf("查询设备信息失败 %s", err.Error())
+	}
+
+	capabilities, err := client.GetCapabilities()
+	if err != nil {
+		return nil, fmt.Errorf("查询设备能力失败 %s", err.Error())
+	} else if capabilities.Media == "" {
+		return nil, fmt.Errorf("设备不支持媒体服务")
+	}
+
+	profiles, err := client.GetProfiles(capabilities.Media)
+	if err != nil {
+		return nil, fmt.Errorf("查询媒体配置失败 %s", err.Error())
+	} else if len(profiles) == 0 {
+		return nil, fmt.Errorf("设备没有媒体配置")
+	}
+
+	if name == "" {
+		name = info.Manufacturer + " " + info.Model
+	}
+
+	var proxies []*dao.StreamProxyModel
+	for _, profile := range profiles {
+		uri, err := client.GetStreamUri(capabilities.Media, profile.Token)
+		if err != nil {
+			return nil, fmt.Errorf("查询取流地址失败 profile: %s err: %s", profile.Token, err.Error())
+		}
+
+		proxies = append(proxies, &dao.StreamProxyModel{
+			Name:         name + "-" + profile.Name,
+			Url:          uri,
+			Transport:    "tcp",
+			AlwaysOn:     alwaysOn,
+			Enable:       true,
+			ProfileToken: profile.Token,
+			PTZ:          capabilities.PTZ != "" && profile.SupportPTZ(),
+		})
+	}
+
+	now := time.Now()
+	device := &dao.DeviceModel{
+		Name:          name,
+		Status:        common.ON,
+		Manufacturer:  info.Manufacturer,
+		Model:         info.Model,
+		Firmware:      info.FirmwareVersion,
+		UserAgent:     OnvifUserAgent,
+		RegisterTime:  now,
+		LastHeartbeat: now,
+	}
+
+	if u, err := url.Parse(xaddr); err == nil {
+		device.RemoteIP = u.Hostname()
+		device.RemotePort, _ = strconv.Atoi(u.Port())
+		if device.RemotePort == 0 {
+			device.RemotePort = 80
+		}
+	}
+
+	encrypted, err := common.EncryptSecret(password)
+	if err != nil {
+		log.Sugar.Errorf("加密ONVIF设备密码失败 err: %s xaddr: %s", err.Error(), xaddr)
+		return nil, fmt.Errorf("保存设备密码失败")
+	}
+
+	model := &dao.OnvifDeviceModel{
+		Name:       name,
+		Endpoint:   endpoint,
+		XAddr:      xaddr,
+		Username:   username,
+		Password:   encrypted,
+		MediaXAddr: capabilities.Media,
+		PTZXAddr:   capabilities.PTZ,
+	}
+
+	if err = dao.Onvif.Create(model, device, proxies, GenerateOnvifDeviceID, GenerateProxyChannelID); err != nil {
+		return nil, err
+	}
+
+	log.Sugar.Infof("ONVIF设备接入成功 device: %s xaddr: %s channels: %d", model.DeviceID, xaddr, len(proxies))
+	saveOnvifStatusLog(model.DeviceID, common.ON, "ONVIF设备接入上线 ON")
+	return model, nil
+}
+
+// 拉流地址添加ONVIF设备的认证信息
+func onvifPullUrl(proxy *dao.StreamProxyModel) string {
+	device, err := dao.Onvif.QueryOnvifDeviceByDeviceID(proxy.DeviceID)
+	if err != nil {
+		return proxy.Url
+	}
+
+	return withCredentials(proxy.Url, device.Username, onvifPassword(device))
+}
+
+// 隐藏地址中的密码, 用于日志和流信息
+func redactUrl(uri string) string {
+	if u, err := url.Parse(uri); err == nil {
+		return u.Redacted()
+	}
+
+	return uri
+}
+
+// RemoveOnvifDevice 关闭所有通道的流, 删除设备和通道
+func RemoveOnvifDevice(device *dao.OnvifDeviceModel) error {
+	proxies, _ := dao.StreamProxy.QueryProxiesByDeviceID(device.DeviceID)
+	if err := dao.Onvif.Delete(device); err != nil {
+		return err
+	}
+
+	for _, proxy := range proxies {
+		StopProxyStream(proxy)
+	}
+
+	return nil
+}
+
+// OnvifControlPTZ 将国标云台命令转换为ONVIF连续移动/停止
+func OnvifControlPTZ(deviceId, channelId, command string) error {
+	proxy, err := dao.StreamProxy.QueryProxyByChannelID(channelId)
+	if err != nil || proxy.DeviceID != deviceId {
+		return fmt.Errorf("通道不存在")
+	} else if !proxy.PTZ {
+		return fmt.Errorf("通道不支持云台")
+	}
+
+	device, err := dao.Onvif.QueryOnvifDeviceByDeviceID(deviceId)
+	if err != nil {
+		return fmt.Errorf("设备不存在")
+	}
+
+	var x, y, zoom float64
+	switch command {
+	case "right":
+		x = onvifPTZSpeed
+		break
+	case "left":
+		x = -onvifPTZSpeed
+		break
+	case "down":
+		y = -onvifPTZSpeed
+		break
+	case "up":
+		y = onvifPTZSpeed
+		break
+	case "zoomin":
+		zoom = onvifPTZSpeed
+		break
+	case "zoomout":
+		zoom = -onvifPTZSpeed
+		break
+	case "stop":
+		return newOnvifClient(device).Stop(device.PTZXAddr, proxy.ProfileToken)
+	default:
+		return fmt.Errorf("不支持的云台命令 %s", command)
+	}
+
+	return newOnvifClient(device).ContinuousMove(device.PTZXAddr, proxy.ProfileToken, x, y, zoom)
+}
+
+func saveOnvifStatusLog(deviceId string, status common.OnlineStatus, description string) {
+	err := dao.StatusLog.Save(&dao.StatusLogModel{
+		Serial:      deviceId,
+		Code:        "*",
+		Status:      status.String(),
+		Description: description,
+	})
+
+	if err != nil {
+		log.Sugar.Errorf("保存设备状态日志失败 device: %s err: %s", deviceId, err.Error())
+	}
+}
+
+// 更新ONVIF设备下所有通道的在线状态, 停用的通道始终离线
+func updateOnvifChannelsStatus(deviceId string, online bool) {
+	proxies, _ := dao.StreamProxy.QueryProxiesByDeviceID(deviceId)
+	for _, proxy := range proxies {
+		status := common.OFF
+		if online && proxy.Enable {
+			status = common.ON
+		}
+
+		_ = dao.Channel.UpdateChannelStatus(deviceId, proxy.ChannelID, status.String())
+	}
+}
+
+// CheckOnvifDevices 定时查询ONVIF设备时间, 更新设备在线状态. ONVIF设备没有心跳
+func CheckOnvifDevices() {
+	if !onvifCheckLock.TryLock() {
+		return
+	}
+
+	defer onvifCheckLock.Unlock()
+	devices, err := dao.Onvif.LoadOnvifDevices()
+	if err != nil {
+		log.Sugar.Errorf("查询ONVIF设备失败 err: %s", err.Error())
+		return
+	}
+
+	for _, device := range devices {
+		model, _ := dao.Device.QueryDevice(device.DeviceID)
+		if model == nil {
+			continue
+		}
+
+		err = newOnvifClient(device).SyncTime()
+		if online := err == nil; online == model.Online() {
+			continue
+		} else if online {
+			log.Sugar.Infof("ONVIF设备恢复在线 device: %s xaddr: %s", device.DeviceID, device.XAddr)
+			_ = dao.Device.UpdateDeviceStatus(device.DeviceID, common.ON)
+			saveOnvifStatusLog(device.DeviceID, common.ON, "ONVIF设备恢复在线 ON")
+			updateOnvifChannelsStatus(device.DeviceID, true)
+		} else {
+			log.Sugar.Errorf("ONVIF设备离线 device: %s xaddr: %s err: %s", device.DeviceID, device.XAddr, err.Error())
+			updateOnvifChannelsStatus(device.DeviceID, false)
+			CloseDevice(device.DeviceID, "ONVIF设备无法访问 OFF")
+		}
+	}
+}
+
+// IsOnvifXAddrHost 判断服务地址是否指向同一主机, 用于匹配发现的设备是否已接入
+func IsOnvifXAddrHost(a, b string) bool {
+	ua, err := url.Parse(a)
+	if err != nil {
+		return false
+	}
+
+	ub, err := url.Parse(b)
+	return err == nil && ua.Hostname() == ub.Hostname()
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"gb-cms/common"
 	"gb-cms/dao"
+	"gb-cms/hook"
 	"gb-cms/log"
 	"github.com/ghettovoice/gosip/sip"
 	"github.com/lkmio/avformat/utils"
@@ -101,7 +102,12 @@ func (g *Platform) OnInvite(request sip.Request, user string) sip.Response {
 
 	var inviteType common.InviteType
 	inviteType.SessionName2Type(strings.ToLower(gbSdp.SDP.Session))
-	streamId := common.GenerateStreamID(inviteType, channel.RootID, channel.DeviceID, gbSdp.StartTime, gbSdp.StopTime)
+	// 上级未指定码流, 使用配置的级联码流
+	streamNumber := gbSdp.StreamNumber
+	if streamNumber == common.StreamNumberDefault {
+		streamNumber = common.Config.CascadeStreamNumber
+	}
+	streamId := common.GenerateStreamIDWithNumber(inviteType, channel.RootID, channel.DeviceID, gbSdp.StartTime, gbSdp.StopTime, streamNumber)
 
 	sink := &dao.SinkModel{
 		StreamID:   streamId,
@@ -148,6 +154,8 @@ func (g *Platform) OnlineCB() {
 	if err := dao.Platform.UpdateOnlineStatus(common.ON, g.ServerAddr); err != nil {
 		log.Sugar.Infof("更新级联设备状态失败 err: %s server addr: %s", err.Error(), g.ServerAddr)
 	}
+
+	PublishEvent(hook.EventTypeCascadeOnline, map[string]interface{}{"username": g.Username, "server_id": g.ServerID, "server_addr": g.ServerAddr})
 }
 
 func (g *Platform) OfflineCB() {
@@ -157,6 +165,7 @@ func (g *Platform) OfflineCB() {
 		log.Sugar.Infof("更新级联设备状态失败 err: %s server addr: %s", err.Error(), g.ServerAddr)
 	}
 
+	PublishEvent(hook.EventTypeCascadeOffline, map[string]interface{}{"username": g.Username, "server_id": g.ServerID, "server_addr": g.ServerAddr})
 	g.release()
 }
 
@@ -185,7 +194,12 @@ func (g *Platform) OnSubscribeAlarm(request sip.Request, expires int) (sip.Respo
 }
 
 func (g *Platform) CreateRequestByDialogType(t int, method sip.RequestMethod) (sip.Request, error) {
-	model, err := dao.Dialog.QueryDialogsByType(g.ServerAddr, t)
+	return g.createRequestByDialog(g.ServerAddr, t, method)
+}
+
+// 根据被订阅的会话创建请求, id为保存会话时使用的ID
+func (g *Platform) createRequestByDialog(id string, t int, method sip.RequestMethod) (sip.Request, error) {
+	model, err := dao.Dialog.QueryDialogsByType(id, t)
 	if err != nil {
 		return nil, err
 	} else if len(model) < 1 || model[0].Dialog == nil {
@@ -3,24 +3,193 @@ package stack
 import (
 	"fmt"
 	"gb-cms/common"
+	"gb-cms/dao"
 	"github.com/ghettovoice/gosip/sip"
+	"net/http"
+	"sync"
+	"time"
 )
 
+// MANSRTSP回放控制消息, 见GB/T 28181-2016 附录B
 const (
 	RTSPBodyFormat = "PLAY RTSP/1.0\r\n" +
 		"CSeq: %d\r\n" +
-		"Scale: %.1f\r\n"
+		"Scale: %.2f\r\n"
+
+	RTSPPauseBodyFormat = "PAUSE RTSP/1.0\r\n" +
+		"CSeq: %d\r\n" +
+		"PauseTime: now\r\n"
+
+	RTSPResumeBodyFormat = "PLAY RTSP/1.0\r\n" +
+		"CSeq: %d\r\n" +
+		"Range: npt=now-\r\n"
+)
+
+var (
+	// 按流串行执行回放控制, 保证CSeq递增和播放状态一致, 不同流之间互不阻塞
+	playbackLocks     = make(map[common.StreamID]*playbackLock)
+	playbackLocksLock sync.Mutex
 )
 
-func (d *Device) ScalePlayback(dialog sip.Request, speed float64) {
-	infoRequest := CreateRequestFromDialog(dialog, sip.INFO, d.RemoteIP, d.RemotePort)
-	sn := GetSN()
-	body := fmt.Sprintf(RTSPBodyFormat, sn, speed)
-	infoRequest.SetBody(body, true)
-	infoRequest.RemoveHeader("Content-Type")
-	infoRequest.AppendHeader(&RTSPMessageType)
-	infoRequest.RemoveHeader("Contact")
-	infoRequest.AppendHeader(GlobalContactAddress.AsContactHeader())
-
-	common.SipStack.SendRequest(infoRequest)
+type playbackLock struct {
+	sync.Mutex
+	refCount int // 持有和等待锁的数量, 为0时删除
+}
+
+// 锁定流的回放控制, 返回解锁函数
+func lockPlayback(streamId common.StreamID) func() {
+	playbackLocksLock.Lock()
+	lock, ok := playbackLocks[streamId]
+	if !ok {
+		lock = &playbackLock{}
+		playbackLocks[streamId] = lock
+	}
+
+	lock.refCount++
+	playbackLocksLock.Unlock()
+
+	lock.Lock()
+	return func() {
+		lock.Unlock()
+
+		playbackLocksLock.Lock()
+		if lock.refCount--; lock.refCount == 0 {
+			delete(playbackLocks, streamId)
+		}
+		playbackLocksLock.Unlock()
+	}
+}
+
+// 在流的会话上发送MANSRTSP消息, 等待设备应答. 成功后保存递增后的CSeq
+func sendPlaybackControl(stream *dao.StreamModel, format string, args ...interface{}) error {
+	if stream.Dialog == nil || stream.Dialog.Request == nil {
+		return fmt.Errorf("stream not found")
+	} else if common.InviteTypePlay == common.InviteType(stream.StreamType) {
+		return fmt.Errorf("实时流不支持回放控制")
+	}
+
+	device, err := dao.Device.QueryDevice(stream.DeviceID)
+	if err != nil {
+		return err
+	}
+
+	// 创建请求时会递增会话的CSeq
+	request := CreateRequestFromDialog(stream.Dialog, sip.INFO, device.RemoteIP, device.RemotePort)
+	stream.RTSPCSeq++
+	request.SetBody(fmt.Sprintf(format, append([]interface{}{stream.RTSPCSeq}, args...)...), true)
+	request.RemoveHeader("Content-Type")
+	request.AppendHeader(&RTSPMessageType)
+	request.RemoveHeader("Contact")
+	request.AppendHeader(GlobalContactAddress.AsContactHeader())
+
+	transaction := common.SipStack.SendRequest(request)
+	var response sip.Response
+	select {
+	case response = <-transaction.Responses():
+		break
+	case <-time.After(5 * time.Second):
+		break
+	}
+
+	// 无论设备是否应答, 都保存CSeq, 后续请求的CSeq不能重复
+	_ = dao.Stream.UpdateStream(stream)
+	if response == nil {
+		return fmt.Errorf("设备未应答")
+	} else if response.StatusCode() != http.StatusOK {
+		return fmt.Errorf("设备应答错误 %d %s", response.StatusCode(), response.Reason())
+	}
+
+	return nil
+}
+
+// 以当前位置为基准, 更新播放状态
+func updatePlayback(stream *dao.StreamModel, state string, position int64, scale float64) error {
+	stream.PlaybackState = state
+	stream.Position = position
+	stream.PositionAt = time.Now()
+	stream.Scale = scale
+	return dao.Stream.UpdateStream(stream)
+}
+
+func queryPlaybackStream(streamId common.StreamID) (*dao.StreamModel, error) {
+	stream, err := dao.Stream.QueryStream(streamId)
+	if err != nil {
+		return nil, fmt.Errorf("stream not found")
+	}
+
+	return stream, nil
+}
+
+// PausePlayback 暂停回放
+func PausePlayback(streamId common.StreamID) error {
+	defer lockPlayback(streamId)()
+
+	stream, err := queryPlaybackStream(streamId)
+	if err != nil {
+		return err
+	} else if stream.PlaybackState == dao.PlaybackStatePaused {
+		return nil
+	}
+
+	position := stream.CurrentPosition()
+	if err = sendPlaybackControl(stream, RTSPPauseBodyFormat); err != nil {
+		return err
+	}
+
+	return updatePlayback(stream, dao.PlaybackStatePaused, position, stream.Scale)
+}
+
+// ResumePlayback 从暂停的位置继续回放
+func ResumePlayback(streamId common.StreamID) error {
+	defer lockPlayback(streamId)()
+
+	stream, err := queryPlaybackStream(streamId)
+	if err != nil {
+		return err
+	} else if stream.PlaybackState == dao.PlaybackStatePlaying {
+		return nil
+	}
+
+	if err = sendPlaybackControl(stream, RTSPResumeBodyFormat); err != nil {
+		return err
+	}
+
+	return updatePlayback(stream, dao.PlaybackStatePlaying, stream.Position, stream.Scale)
+}
+
+// SeekPlayback 跳转到相对开始时间的位置继续回放, 单位秒
+func SeekPlayback(streamId common.StreamID, seconds int64) error {
+	defer lockPlayback(streamId)()
+
+	stream, err := queryPlaybackStream(streamId)
+	if err != nil {
+		return err
+	} else if duration := stream.PlaybackEnd - stream.PlaybackStart; seconds < 0 || (duration > 0 && seconds > duration) {
+		return fmt.Errorf("seek位置超出回放范围 %d", seconds)
+	}
+
+	if err = sendPlaybackControl(stream, SeekBodyFormat, seconds); err != nil {
+		return err
+	}
+
+	return updatePlayback(stream, dao.PlaybackStatePlaying, seconds, stream.Scale)
+}
+
+// ScalePlayback 设置回放倍速, 同步设置流媒体服务的倍速
+func ScalePlayback(streamId common.StreamID, scale float64) error {
+	defer lockPlayback(streamId)()
+
+	stream, err := queryPlaybackStream(streamId)
+	if err != nil {
+		return err
+	}
+
+	position := stream.CurrentPosition()
+	if err = sendPlaybackControl(stream, RTSPBodyFormat, scale); err != nil {
+		return err
+	} else if err = MSSpeedSet(string(streamId), scale); err != nil {
+		return err
+	}
+
+	return updatePlayback(stream, stream.PlaybackState, position, scale)
 }
@@ -0,0 +1,54 @@
+package stack
+
+import (
+	"gb-cms/common"
+	"testing"
+	"time"
+)
+
+func TestLockPlayback(t *testing.T) {
+	first := common.StreamID("34020000001320000001/34020000001310000001.playback.1")
+	second := common.StreamID("34020000001320000001/34020000001310000002.playback.1")
+
+	unlock := lockPlayback(first)
+
+	// 不同流的回放控制互不阻塞
+	done := make(chan struct{})
+	go func() {
+		lockPlayback(second)()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		break
+	case <-time.After(time.Second):
+		t.Fatal("other stream blocked")
+	}
+
+	// 同一个流等待上一个控制完成
+	locked := make(chan struct{})
+	go func() {
+		unlockAgain := lockPlayback(first)
+		close(locked)
+		unlockAgain()
+	}()
+
+	select {
+	case <-locked:
+		t.Fatal("same stream not serialized")
+	case <-time.After(50 * time.Millisecond):
+		break
+	}
+
+	unlock()
+	<-locked
+
+	// 释放后删除锁
+	time.Sleep(10 * time.Millisecond)
+	playbackLocksLock.Lock()
+	defer playbackLocksLock.Unlock()
+	if len(playbackLocks) != 0 {
+		t.Errorf("playback locks = %d, want 0", len(playbackLocks))
+	}
+}
@@ -13,6 +13,23 @@ const (
 		"<DeviceID>%s</DeviceID>\r\n" +
 		"<PTZCmd>%s</PTZCmd>\r\n" +
 		"</Control>\r\n"
+
+	// SnapShotConfigFormat 2022 A.2.3 图像抓拍, 设备抓拍后上传到UploadURL
+	SnapShotConfigFormat = "<?xml version=\"1.0\"?>\r\n" +
+		"<Control>\r\n" +
+		"<CmdType>DeviceConfig</CmdType>\r\n" +
+		"<SN>%d</SN>\r\n" +
+		"<DeviceID>%s</DeviceID>\r\n" +
+		"<SnapShotConfig>\r\n" +
+		"<SnapNum>%d</SnapNum>\r\n" +
+		"<Interval>%d</Interval>\r\n" +
+		"<UploadURL>%s</UploadURL>\r\n" +
+		"<SessionID>%s</SessionID>\r\n" +
+		"</SnapShotConfig>\r\n" +
+		"</Control>\r\n"
+
+	// 预置位指令, A.3.4
+	PTZCmdCallPreset = 0x82
 )
 
 // PTZCmd A.3.1 指令格式
@@ -72,3 +89,19 @@ func (d *Device) ControlPTZ(command string, channelId string) {
 	request := d.BuildMessageRequest(channelId, body)
 	common.SipStack.SendRequest(request)
 }
+
+// CallPreset 调用预置位
+func (d *Device) CallPreset(channelId string, index byte) {
+	// 字节5为00H, 字节6为预置位号
+	cmdHex := (&PTZCmd{}).Marshal(PTZCmdCallPreset, 0, index, 0)
+	body := fmt.Sprintf(DeviceControlFormat, GetSN(), channelId, cmdHex)
+	request := d.BuildMessageRequest(channelId, body)
+	common.SipStack.SendRequest(request)
+}
+
+// SnapShot 通知设备抓拍, 每隔interval秒抓拍一张, 共num张, 设备通过http上传到uploadUrl
+func (d *Device) SnapShot(channelId string, num, interval int, uploadUrl, sessionId string) {
+	body := fmt.Sprintf(SnapShotConfigFormat, GetSN(), channelId, num, interval, uploadUrl, sessionId)
+	request := d.BuildMessageRequest(channelId, body)
+	common.SipStack.SendRequest(request)
+}
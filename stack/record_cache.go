@@ -0,0 +1,137 @@
+package stack
+
+import (
+	"encoding/json"
+	"gb-cms/common"
+	"gb-cms/dao"
+	"gb-cms/log"
+	"time"
+)
+
+const (
+	RecordTimeFormat = "2006-01-02T15:04:05"
+)
+
+// 返回查询范围的缓存时长, 包含今天的录像仍在增加, 使用较短的缓存时长
+func recordCacheTTL(endTime string) time.Duration {
+	end, err := ParseRecordTime(endTime)
+	now := time.Now()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.Local)
+	if err != nil || end.After(today) {
+		return time.Duration(common.Config.RecordCacheTodayTTL) * time.Second
+	}
+
+	return time.Duration(common.Config.RecordCacheTTL) * time.Second
+}
+
+// 统一查询时间的格式, 缓存按字符串比较时间范围
+func formatRecordTime(str string) string {
+	if t, err := ParseRecordTime(str); err == nil {
+		return t.Format(RecordTimeFormat)
+	}
+
+	return str
+}
+
+// 从覆盖查询范围的缓存中读取录像, 只返回与查询范围重叠的录像
+func queryRecordCache(deviceId, channelId, startTime, endTime string) ([]RecordInfo, bool) {
+	caches, _ := dao.RecordCache.QueryCoveringCaches(deviceId, channelId, startTime, endTime)
+	for _, cache := range caches {
+		// 缓存范围包含今天时, 按较短的缓存时长判断
+		if time.Since(cache.UpdatedAt) >= recordCacheTTL(cache.EndTime) {
+			continue
+		}
+
+		var records []RecordInfo
+		if err := json.Unmarshal([]byte(cache.Records), &records); err != nil {
+			continue
+		} else if cache.StartTime == startTime && cache.EndTime == endTime {
+			return records, true
+		}
+
+		var result []RecordInfo
+		for _, record := range records {
+			if formatRecordTime(record.EndTime) >= startTime && formatRecordTime(record.StartTime) <= endTime {
+				result = append(result, record)
+			}
+		}
+
+		return result, true
+	}
+
+	return nil, false
+}
+
+// QueryRecordListWithCache 优先从缓存读取设备录像, refresh为true时忽略缓存重新查询. 只缓存完整的查询结果.
+// 查询范围被已缓存的范围覆盖时也使用缓存, 例如预取的整天录像
+func (d *Device) QueryRecordListWithCache(channelId, startTime, endTime string, refresh bool, idleTimeout, timeout time.Duration) ([]RecordInfo, bool, error) {
+	startTime, endTime = formatRecordTime(startTime), formatRecordTime(endTime)
+	ttl := recordCacheTTL(endTime)
+	if !refresh && ttl > 0 {
+		if records, ok := queryRecordCache(d.DeviceID, channelId, startTime, endTime); ok {
+			return records, true, nil
+		}
+	}
+
+	records, complete, err := d.QueryRecordList(channelId, startTime, endTime, idleTimeout, timeout)
+	if err != nil || !complete || ttl <= 0 {
+		return records, complete, err
+	}
+
+	bytes, err := json.Marshal(records)
+	if err == nil {
+		err = dao.RecordCache.SaveCache(&dao.RecordCacheModel{
+			DeviceID:  d.DeviceID,
+			ChannelID: channelId,
+			StartTime: startTime,
+			EndTime:   endTime,
+			Records:   string(bytes),
+		})
+	}
+
+	if err != nil {
+		log.Sugar.Errorf("保存录像查询缓存失败 err: %s device: %s channel: %s", err.Error(), d.DeviceID, channelId)
+	}
+
+	return records, complete, nil
+}
+
+// PrefetchRecords 预取选中通道前一天的录像, 在夜间执行, 避免白天查询慢速NVR
+func PrefetchRecords() {
+	// 清理过期的缓存
+	if ttl := max(common.Config.RecordCacheTTL, common.Config.RecordCacheTodayTTL); ttl > 0 {
+		if err := dao.RecordCache.DeleteExpired(time.Now().Add(-time.Duration(ttl) * time.Second)); err != nil {
+			log.Sugar.Errorf("删除过期的录像查询缓存失败 err: %s", err.Error())
+		}
+	}
+
+	prefetches, err := dao.RecordCache.QueryPrefetches()
+	if err != nil {
+		log.Sugar.Errorf("查询录像预取通道失败 err: %s", err.Error())
+		return
+	} else if common.Config.RecordCacheTTL < 1 {
+		return
+	}
+
+	// 与前端查询整天录像的范围一致, 00:00:00至23:59:59
+	now := time.Now()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.Local)
+	startTime := today.AddDate(0, 0, -1).Format(RecordTimeFormat)
+	endTime := today.Add(-time.Second).Format(RecordTimeFormat)
+
+	// 逐个查询, 避免同时向同一台NVR发送大量查询
+	for _, prefetch := range prefetches {
+		model, _ := dao.Device.QueryDevice(prefetch.DeviceID)
+		if model == nil || !model.Online() {
+			continue
+		}
+
+		device := &Device{model}
+		records, complete, err := device.QueryRecordListWithCache(prefetch.ChannelID, startTime, endTime, true, 10*time.Second, 2*time.Minute)
+		if err != nil {
+			log.Sugar.Errorf("预取录像失败 err: %s device: %s channel: %s", err.Error(), prefetch.DeviceID, prefetch.ChannelID)
+		} else {
+			log.Sugar.Infof("预取录像 device: %s channel: %s count: %d complete: %t", prefetch.DeviceID, prefetch.ChannelID, len(records), complete)
+		}
+	}
+}
@@ -0,0 +1,147 @@
+package stack
+
+import (
+	"gb-cms/common"
+	"gb-cms/dao"
+	"gb-cms/log"
+	"github.com/shirou/gopsutil/v3/disk"
+	"os"
+	"sync"
+	"time"
+)
+
+const (
+	// 每次从数据库取出的待删除录像数
+	recordCleanBatchSize = 100
+)
+
+var (
+	recordCleanLock sync.Mutex
+)
+
+// DeleteCloudRecords 删除录像文件和索引, 文件不存在视为删除成功. 返回删除的录像数
+func DeleteCloudRecords(records []*dao.CloudRecordModel) (int, error) {
+	var ids []uint
+	for _, record := range records {
+		if err := os.Remove(record.Path); err != nil && !os.IsNotExist(err) {
+			log.Sugar.Errorf("删除录像文件失败 err: %s path: %s", err.Error(), record.Path)
+			continue
+		}
+
+		ids = append(ids, record.ID)
+	}
+
+	return len(ids), dao.CloudRecord.DeleteRecords(ids)
+}
+
+// 分批删除查询到的录像, 直到没有可删除的录像
+func cleanRecords(query func() ([]*dao.CloudRecordModel, error)) int {
+	var total int
+	for {
+		records, err := query()
+		if err != nil {
+			log.Sugar.Errorf("查询待清理的录像失败 err: %s", err.Error())
+			break
+		} else if len(records) == 0 {
+			break
+		}
+
+		count, err := DeleteCloudRecords(records)
+		if err != nil {
+			log.Sugar.Errorf("删除录像索引失败 err: %s", err.Error())
+			break
+		}
+
+		total += count
+		// 文件删除失败的索引会被再次查询到, 一条都没删除成功时退出, 防止死循环
+		if count == 0 || len(records) < recordCleanBatchSize {
+			break
+		}
+	}
+
+	return total
+}
+
+// CleanExpiredRecords 按保留天数清理录像, 通道单独设置的天数优先于全局配置. 锁定的录像不清理
+func CleanExpiredRecords() {
+	recordCleanLock.Lock()
+	defer recordCleanLock.Unlock()
+
+	now := time.Now()
+	retentions, err := dao.CloudRecord.QueryRetentions()
+	if err != nil {
+		log.Sugar.Errorf("查询通道录像保留策略失败 err: %s", err.Error())
+		return
+	}
+
+	for _, retention := range retentions {
+		expired := now.AddDate(0, 0, -retention.ReserveDays)
+		count := cleanRecords(func() ([]*dao.CloudRecordModel, error) {
+			return dao.CloudRecord.QueryExpiredRecords(retention.DeviceID, retention.ChannelID, expired, recordCleanBatchSize)
+		})
+
+		if count > 0 {
+			log.Sugar.Infof("清理过期录像 device: %s channel: %s days: %d count: %d", retention.DeviceID, retention.ChannelID, retention.ReserveDays, count)
+		}
+	}
+
+	if common.Config.RecordReserveDays < 1 {
+		return
+	}
+
+	expired := now.AddDate(0, 0, -common.Config.RecordReserveDays)
+	count := cleanRecords(func() ([]*dao.CloudRecordModel, error) {
+		return dao.CloudRecord.QueryGlobalExpiredRecords(expired, recordCleanBatchSize)
+	})
+
+	if count > 0 {
+		log.Sugar.Infof("清理过期录像 days: %d count: %d", common.Config.RecordReserveDays, count)
+	}
+}
+
+// CleanRecordsByDiskUsage 存储路径所在磁盘使用率超过阈值时, 从最早的录像开始清理, 直到低于阈值. 锁定的录像不清理
+func CleanRecordsByDiskUsage() {
+	recordCleanLock.Lock()
+	defer recordCleanLock.Unlock()
+
+	for _, path := range common.Config.RecordPaths {
+		threshold := common.Config.GetRecordDiskThreshold(path)
+		if threshold < 1 {
+			continue
+		}
+
+		var count int
+		for {
+			usage, err := disk.Usage(path)
+			if err != nil {
+				log.Sugar.Errorf("获取录像存储路径磁盘信息失败 err: %s path: %s", err.Error(), path)
+				break
+			} else if usage.UsedPercent < float64(threshold) {
+				break
+			}
+
+			records, err := dao.CloudRecord.QueryOldestRecords(path, recordCleanBatchSize)
+			if err != nil {
+				log.Sugar.Errorf("查询待清理的录像失败 err: %s", err.Error())
+				break
+			} else if len(records) == 0 {
+				log.Sugar.Warnf("磁盘使用率超过阈值, 但没有可清理的录像 path: %s used: %.2f%%", path, usage.UsedPercent)
+				break
+			}
+
+			n, err := DeleteCloudRecords(records)
+			if err != nil {
+				log.Sugar.Errorf("删除录像索引失败 err: %s", err.Error())
+				break
+			} else if n == 0 {
+				break
+			}
+
+			count += n
+		}
+
+		if count > 0 {
+			log.Sugar.Infof("磁盘使用率超过阈值, 清理最早的录像 path: %s count: %d", path, count)
+		}
+	}
+}
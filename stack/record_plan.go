@@ -0,0 +1,209 @@
+package stack
+
+import (
+	"fmt"
+	"gb-cms/common"
+	"gb-cms/dao"
+	"gb-cms/log"
+	"sync"
+	"time"
+)
+
+var (
+	RecordPlanManager = &recordPlanManager{
+		recording: make(map[common.StreamID]bool, 16),
+		owned:     make(map[common.StreamID]bool, 16),
+		manual:    make(map[common.StreamID]bool, 16),
+	}
+)
+
+// recordPlanManager 根据录像计划, 在计划时间内拉流并开启流媒体服务录制, 计划时间外关闭录制
+type recordPlanManager struct {
+	lock      sync.Mutex
+	recording map[common.StreamID]bool // 计划时间内的流, true-已开启录制 false-拉流中
+	owned     map[common.StreamID]bool // 由录像计划开启录制的流, 计划结束时关闭录制和流
+	manual    map[common.StreamID]bool // 手动开启录制的流, 录像计划不开启也不关闭录制
+	started   bool                     // 启动后是否已执行过一次, 首次执行需要关闭重启前遗留的录制
+}
+
+// Schedule 每分钟执行一次, 对比计划时间内的通道和正在录制的流
+func (r *recordPlanManager) Schedule() {
+	now := time.Now()
+	plans, err := dao.RecordPlan.QueryEnabledPlans()
+	if err != nil {
+		log.Sugar.Errorf("查询录像计划失败 err: %s", err.Error())
+		return
+	}
+
+	// 计划时间内需要录制的通道
+	channels := make(map[common.StreamID]*dao.ChannelModel, 16)
+	// 关联了录像计划, 但不在计划时间内的通道
+	idles := make(map[common.StreamID]*dao.ChannelModel, 16)
+	for _, plan := range plans {
+		models, err := dao.RecordPlan.QueryPlanChannelModels(int(plan.ID))
+		if err != nil {
+			log.Sugar.Errorf("查询录像计划通道失败 err: %s plan: %d", err.Error(), plan.ID)
+			continue
+		}
+
+		recording := plan.Recording(now)
+		for _, channel := range models {
+			streamId := common.GenerateStreamID(common.InviteTypePlay, channel.RootID, channel.DeviceID, "", "")
+			if recording {
+				channels[streamId] = channel
+			} else {
+				idles[streamId] = channel
+			}
+		}
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	// 流已关闭, 手动录制随之结束
+	for streamId := range r.manual {
+		if stream, _ := dao.Stream.QueryStream(streamId); stream == nil {
+			delete(r.manual, streamId)
+		}
+	}
+
+	// 关闭计划时间外的录制
+	for streamId := range r.recording {
+		if _, ok := channels[streamId]; ok {
+			continue
+		}
+
+		r.stop(streamId)
+	}
+
+	// 重启后, 流媒体服务可能还在录制计划时间外的流
+	if !r.started {
+		r.started = true
+		for streamId := range idles {
+			if _, ok := channels[streamId]; ok {
+				continue
+			} else if stream, _ := dao.Stream.QueryStream(streamId); stream != nil {
+				log.Sugar.Infof("关闭重启前遗留的计划录制 stream: %s", streamId)
+				_ = MSStopRecord(string(streamId))
+			}
+		}
+	}
+
+	// 开启计划时间内的录制
+	for streamId, channel := range channels {
+		started, ok := r.recording[streamId]
+		if ok && !started {
+			// 拉流中
+			continue
+		} else if ok {
+			// 流已经断开, 重新拉流录制
+			if stream, _ := dao.Stream.QueryStream(streamId); stream != nil {
+				continue
+			}
+		}
+
+		r.recording[streamId] = false
+		go r.start(streamId, channel)
+	}
+}
+
+// 拉流并开启录制
+func (r *recordPlanManager) start(streamId common.StreamID, channel *dao.ChannelModel) {
+	var err error
+	defer func() {
+		r.lock.Lock()
+		defer r.lock.Unlock()
+
+		if err != nil {
+			delete(r.recording, streamId)
+			delete(r.owned, streamId)
+		} else if _, ok := r.recording[streamId]; ok {
+			r.recording[streamId] = true
+		}
+	}()
+
+	device, _ := dao.Device.QueryDevice(channel.RootID)
+	if device == nil || !device.Online() {
+		err = fmt.Errorf("设备离线 id: %s", channel.RootID)
+		return
+	}
+
+	d := &Device{device}
+	if _, err = d.StartStream(common.InviteTypePlay, streamId, channel.DeviceID, "", "", device.GetSetup().String(), 0, false); err != nil {
+		log.Sugar.Errorf("计划录制拉流失败 err: %s stream: %s", err.Error(), streamId)
+		return
+	}
+
+	// 拉流期间已到计划结束时间
+	r.lock.Lock()
+	_, ok := r.recording[streamId]
+	manual := r.manual[streamId]
+	if ok && !manual {
+		r.owned[streamId] = true
+	}
+	r.lock.Unlock()
+
+	if !ok {
+		return
+	} else if manual {
+		log.Sugar.Infof("已手动开启录制, 录像计划不重复开启 stream: %s", streamId)
+		return
+	}
+
+	log.Sugar.Infof("开启计划录制 stream: %s", streamId)
+	if err = MSStartRecord(string(streamId)); err != nil {
+		log.Sugar.Errorf("开启计划录制失败 err: %s stream: %s", err.Error(), streamId)
+	}
+}
+
+// IsRecording 流是否由录像计划拉流录制中
+func (r *recordPlanManager) IsRecording(streamId common.StreamID) bool {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	_, ok := r.recording[streamId]
+	return ok
+}
+
+// OnManualRecord 通过接口手动开启或关闭录制. 手动开启的录制由手动关闭, 录像计划结束时不关闭
+func (r *recordPlanManager) OnManualRecord(streamId common.StreamID, recording bool) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	delete(r.owned, streamId)
+	if recording {
+		r.manual[streamId] = true
+	} else {
+		delete(r.manual, streamId)
+	}
+}
+
+// 关闭录像计划开启的录制, 没有播放端时关闭拉流
+func (r *recordPlanManager) stop(streamId common.StreamID) {
+	owned := r.owned[streamId]
+	delete(r.recording, streamId)
+	delete(r.owned, streamId)
+	if !owned {
+		return
+	}
+
+	log.Sugar.Infof("关闭计划录制 stream: %s", streamId)
+
+	go func() {
+		// 报警联动录制未结束, 由报警联动到时关闭
+		if AlarmPlanManager.IsRecording(streamId) {
+			log.Sugar.Infof("报警联动录制中, 不关闭录制 stream: %s", streamId)
+			return
+		} else if err := MSStopRecord(string(streamId)); err != nil {
+			log.Sugar.Errorf("关闭计划录制失败 err: %s stream: %s", err.Error(), streamId)
+		}
+
+		if IsAlwaysOnProxyStream(streamId) {
+			return
+		} else if sinks, err := MSQuerySinkList(string(streamId)); err != nil {
+			log.Sugar.Errorf("查询计划录制的播放端失败 err: %s stream: %s", err.Error(), streamId)
+		} else if len(sinks) == 0 {
+			log.Sugar.Infof("计划录制结束, 没有播放端, 关闭流 stream: %s", streamId)
+			CloseStream(streamId, true)
+		}
+	}()
+}
@@ -0,0 +1,207 @@
+package stack
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+)
+
+// 录像来源
+const (
+	RecordSourceDevice = "device" // 设备本地存储
+	RecordSourceNVR    = "nvr"    // NVR/DVR存储
+	RecordSourceCenter = "center" // 录像中心(媒体存储服务器)
+	RecordSourceCloud  = "cloud"  // 云端录像
+)
+
+// RecordSpan 时间轴上的一段录像, Sources为覆盖该时间段的录像来源
+type RecordSpan struct {
+	Start   int64 // unix秒
+	End     int64
+	Sources []string
+}
+
+// RecordSource 根据录像的RecorderID类型编码判断录像来源
+func RecordSource(info *RecordInfo) string {
+	switch GetTypeCode(info.RecorderID) {
+	case "111", "118":
+		return RecordSourceNVR
+	case "210":
+		return RecordSourceCenter
+	default:
+		return RecordSourceDevice
+	}
+}
+
+// ParseRecordTime 解析设备录像时间, 按本地时区解析
+func ParseRecordTime(str string) (time.Time, error) {
+	return time.ParseInLocation(RecordTimeFormat, str, time.Local)
+}
+
+// QueryRecordList 查询设备录像, 等待所有分包响应完毕. 按SumNum判断是否完整, 去除重复的条目.
+// 每次收到响应重新计时, 在idleTimeout内没有收到新的响应, 或总时长超过timeout, 返回已收到的录像
+func (d *Device) QueryRecordList(channelId, startTime, endTime string, idleTimeout, timeout time.Duration) ([]RecordInfo, bool, error) {
+	var lock sync.Mutex
+	var records []RecordInfo
+	var sumNum int
+	var complete bool
+	items := make(map[string]bool, 32)
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	timer := time.AfterFunc(idleTimeout, cancel)
+	defer timer.Stop()
+
+	sn := GetSN()
+	SNManager.AddEvent(sn, func(data interface{}) {
+		response := data.(*QueryRecordInfoResponse)
+		lock.Lock()
+		defer lock.Unlock()
+
+		timer.Reset(idleTimeout)
+		sumNum = response.SumNum
+		for _, record := range response.DeviceList.Devices {
+			// 部分设备会重发分包
+			key := fmt.Sprintf("%s/%s/%s/%s", record.StartTime, record.EndTime, record.FilePath, record.Type)
+			if items[key] {
+				continue
+			}
+
+			items[key] = true
+			records = append(records, record)
+		}
+
+		// 所有记录响应完毕
+		if len(records) >= sumNum {
+			complete = true
+			cancel()
+		}
+	})
+
+	defer SNManager.RemoveEvent(sn)
+	if err := d.QueryRecord(channelId, startTime, endTime, sn, "all"); err != nil {
+		return nil, false, err
+	}
+
+	<-ctx.Done()
+
+	lock.Lock()
+	defer lock.Unlock()
+	if !complete && len(records) == 0 && sumNum == 0 {
+		return nil, false, fmt.Errorf("query record timeout")
+	}
+
+	return records, complete, nil
+}
+
+// MergeRecordSpans 合并录像时间段, 重叠的部分合并来源, 间隔不超过gap秒的相邻时间段视为连续.
+// 返回按时间排序、互不重叠的时间段, 相邻且来源相同的时间段会合并为一段
+func MergeRecordSpans(spans []RecordSpan, gap int64) []RecordSpan {
+	type boundary struct {
+		time   int64
+		source string
+		start  bool
+	}
+
+	var boundaries []boundary
+	for _, span := range spans {
+		if span.End <= span.Start {
+			continue
+		}
+
+		for _, source := range span.Sources {
+			boundaries = append(boundaries, boundary{span.Start, source, true}, boundary{span.End, source, false})
+		}
+	}
+
+	sort.Slice(boundaries, func(i, j int) bool {
+		return boundaries[i].time < boundaries[j].time
+	})
+
+	var result []RecordSpan
+	active := make(map[string]int, 4)
+	for i := 0; i < len(boundaries); {
+		t := boundaries[i].time
+		for ; i < len(boundaries) && boundaries[i].time == t; i++ {
+			if boundaries[i].start {
+				active[boundaries[i].source]++
+			} else {
+				active[boundaries[i].source]--
+			}
+		}
+
+		var sources []string
+		for _, source := range []string{RecordSourceDevice, RecordSourceNVR, RecordSourceCenter, RecordSourceCloud} {
+			if active[source] > 0 {
+				sources = append(sources, source)
+			}
+		}
+
+		// 结束上一段
+		if n := len(result); n > 0 && result[n-1].End == 0 {
+			if equalSources(result[n-1].Sources, sources) {
+				continue
+			}
+
+			result[n-1].End = t
+		}
+
+		if len(sources) > 0 {
+			result = append(result, RecordSpan{Start: t, Sources: sources})
+		}
+	}
+
+	// 连接间隔不超过gap的相邻时间段
+	var merged []RecordSpan
+	for _, span := range result {
+		n := len(merged)
+		if n == 0 || span.Start-merged[n-1].End > gap {
+			merged = append(merged, span)
+		} else if equalSources(merged[n-1].Sources, span.Sources) {
+			merged[n-1].End = span.End
+		} else {
+			merged[n-1].End = span.Start
+			merged = append(merged, span)
+		}
+	}
+
+	return merged
+}
+
+func equalSources(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// RecordGaps 返回[start, end)范围内没有录像的时间段, spans需按时间排序且互不重叠
+func RecordGaps(spans []RecordSpan, start, end int64) [][2]int64 {
+	var gaps [][2]int64
+	cursor := start
+	for _, span := range spans {
+		if span.Start > cursor && cursor < end {
+			gaps = append(gaps, [2]int64{cursor, min(span.Start, end)})
+		}
+
+		if span.End > cursor {
+			cursor = span.End
+		}
+	}
+
+	if cursor < end {
+		gaps = append(gaps, [2]int64{cursor, end})
+	}
+
+	return gaps
+}
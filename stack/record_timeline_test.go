@@ -0,0 +1,153 @@
+package stack
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMergeRecordSpans(t *testing.T) {
+	device, cloud := []string{RecordSourceDevice}, []string{RecordSourceCloud}
+	both := []string{RecordSourceDevice, RecordSourceCloud}
+
+	tests := []struct {
+		name  string
+		spans []RecordSpan
+		gap   int64
+		want  []RecordSpan
+	}{
+		{name: "empty"},
+		{
+			name:  "single",
+			spans: []RecordSpan{{0, 10, device}},
+			want:  []RecordSpan{{0, 10, device}},
+		},
+		{
+			name:  "invalid span dropped",
+			spans: []RecordSpan{{10, 10, device}, {20, 15, device}, {30, 40, device}},
+			want:  []RecordSpan{{30, 40, device}},
+		},
+		{
+			name:  "overlap same source",
+			spans: []RecordSpan{{0, 15, device}, {10, 20, device}},
+			want:  []RecordSpan{{0, 20, device}},
+		},
+		{
+			name:  "adjacent same source",
+			spans: []RecordSpan{{0, 10, device}, {10, 20, device}},
+			want:  []RecordSpan{{0, 20, device}},
+		},
+		{
+			name:  "unsorted input",
+			spans: []RecordSpan{{10, 20, device}, {0, 10, device}},
+			want:  []RecordSpan{{0, 20, device}},
+		},
+		{
+			name:  "overlap different sources",
+			spans: []RecordSpan{{0, 20, device}, {10, 30, cloud}},
+			want:  []RecordSpan{{0, 10, device}, {10, 20, both}, {20, 30, cloud}},
+		},
+		{
+			name:  "nested",
+			spans: []RecordSpan{{0, 30, device}, {10, 20, cloud}},
+			want:  []RecordSpan{{0, 10, device}, {10, 20, both}, {20, 30, device}},
+		},
+		{
+			name:  "identical different sources",
+			spans: []RecordSpan{{0, 10, cloud}, {0, 10, device}},
+			want:  []RecordSpan{{0, 10, both}},
+		},
+		{
+			name:  "adjacent different sources",
+			spans: []RecordSpan{{0, 10, device}, {10, 20, cloud}},
+			want:  []RecordSpan{{0, 10, device}, {10, 20, cloud}},
+		},
+		{
+			name:  "gap within threshold same source",
+			spans: []RecordSpan{{0, 10, device}, {12, 20, device}},
+			gap:   2,
+			want:  []RecordSpan{{0, 20, device}},
+		},
+		{
+			name:  "gap over threshold",
+			spans: []RecordSpan{{0, 10, device}, {12, 20, device}},
+			gap:   1,
+			want:  []RecordSpan{{0, 10, device}, {12, 20, device}},
+		},
+		{
+			// 不同来源之间的间隔归前一段
+			name:  "gap within threshold different sources",
+			spans: []RecordSpan{{0, 10, device}, {12, 20, cloud}},
+			gap:   5,
+			want:  []RecordSpan{{0, 12, device}, {12, 20, cloud}},
+		},
+		{
+			name:  "multiple sources in one span",
+			spans: []RecordSpan{{0, 10, []string{RecordSourceCloud, RecordSourceDevice}}},
+			want:  []RecordSpan{{0, 10, both}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := MergeRecordSpans(test.spans, test.gap); !reflect.DeepEqual(got, test.want) {
+				t.Errorf("MergeRecordSpans() = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestRecordGaps(t *testing.T) {
+	tests := []struct {
+		name       string
+		spans      []RecordSpan
+		start, end int64
+		want       [][2]int64
+	}{
+		{name: "no record", start: 0, end: 100, want: [][2]int64{{0, 100}}},
+		{name: "full coverage", spans: []RecordSpan{{0, 100, nil}}, start: 0, end: 100},
+		{name: "beyond range", spans: []RecordSpan{{-10, 110, nil}}, start: 0, end: 100},
+		{
+			name:  "gap in middle",
+			spans: []RecordSpan{{0, 40, nil}, {60, 100, nil}},
+			start: 0, end: 100,
+			want: [][2]int64{{40, 60}},
+		},
+		{
+			name:  "gap at both ends",
+			spans: []RecordSpan{{20, 80, nil}},
+			start: 0, end: 100,
+			want: [][2]int64{{0, 20}, {80, 100}},
+		},
+		{
+			name:  "adjacent spans",
+			spans: []RecordSpan{{0, 50, nil}, {50, 100, nil}},
+			start: 0, end: 100,
+		},
+		{
+			name:  "span starts before range",
+			spans: []RecordSpan{{-10, 5, nil}},
+			start: 0, end: 20,
+			want: [][2]int64{{5, 20}},
+		},
+		{
+			name:  "span starts after range",
+			spans: []RecordSpan{{25, 30, nil}},
+			start: 0, end: 20,
+			want: [][2]int64{{0, 20}},
+		},
+		{
+			name:  "span ends before range",
+			spans: []RecordSpan{{-20, -10, nil}, {10, 20, nil}},
+			start: 0, end: 20,
+			want: [][2]int64{{0, 10}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := RecordGaps(test.spans, test.start, test.end); !reflect.DeepEqual(got, test.want) {
+				t.Errorf("RecordGaps() = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
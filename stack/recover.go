@@ -133,7 +133,7 @@ func updateDevicesStatus() {
 		now := time.Now()
 		var offlineDevices []string
 		for key, device := range devices {
-			if device.Status == common.OFF {
+			if device.Status == common.OFF || IsVirtualDevice(device) {
 				continue
 			} else if now.Sub(device.LastHeartbeat) < time.Duration(common.Config.AliveExpires)*time.Second {
 				OnlineDeviceManager.Add(key, device.LastHeartbeat)
@@ -218,14 +218,26 @@ func Start() {
 	// 启动1078设备
 	startJTDevices()
 
+	// 上线拉流代理的虚拟设备
+	StartProxyDevice()
+
+	// 重新下载未完成的下载任务
+	DownloadJobManager.Start()
+	// 恢复未完成的证据包导出任务
+	EvidenceExportManager.Start()
+
 	// 启动目录刷新任务
 	go AddScheduledTask(time.Minute, true, RefreshCatalogScheduleTask)
 	// 启动订阅刷新任务
 	go AddScheduledTask(time.Minute, true, RefreshSubscribeScheduleTask)
+	// 启动常驻拉流代理的保活任务
+	go AddScheduledTask(30*time.Second, true, KeepAlwaysOnProxies)
+	// 启动ONVIF设备在线检测任务
+	go AddScheduledTask(time.Minute, true, CheckOnvifDevices)
 
 	// 启动定时任务, 每天凌晨3点执行
+	// Start返回后定时任务仍需运行, 不能在此关闭调度器
 	s, _ := gocron.NewScheduler()
-	defer func() { _ = s.Shutdown() }()
 
 	// 删除过期的位置、报警记录
 	_, _ = s.NewJob(
@@ -262,10 +274,45 @@ func Start() {
 				if err != nil {
 					log.Sugar.Errorf("删除过期的位置记录失败 err: %s", err.Error())
 				}
+
+				// 删除过期的云端录像
+				CleanExpiredRecords()
+				CleanRecordsByDiskUsage()
 			},
 		),
 	)
 
+	// 录像计划, 每分钟检查一次
+	_, _ = s.NewJob(
+		gocron.CronJob(
+			"* * * * *",
+			false,
+		),
+		gocron.NewTask(RecordPlanManager.Schedule),
+		gocron.WithSingletonMode(gocron.LimitModeReschedule),
+		gocron.WithStartAt(gocron.WithStartImmediately()),
+	)
+
+	// 预取前一天的设备录像, 每天凌晨2点执行
+	_, _ = s.NewJob(
+		gocron.CronJob(
+			"0 2 * * *",
+			false,
+		),
+		gocron.NewTask(PrefetchRecords),
+		gocron.WithSingletonMode(gocron.LimitModeReschedule),
+	)
+
+	// 录像存储磁盘使用率, 每10分钟检查一次
+	_, _ = s.NewJob(
+		gocron.CronJob(
+			"*/10 * * * *",
+			false,
+		),
+		gocron.NewTask(CleanRecordsByDiskUsage),
+		gocron.WithSingletonMode(gocron.LimitModeReschedule),
+	)
+
 	s.Start()
 
 	// 加载ip2region数据库
@@ -10,6 +10,11 @@ func RefreshCatalogScheduleTask() {
 	devices, _ := dao.Device.QueryRefreshCatalogExpiredDevices(now)
 	// 发起查询目录请求
 	for _, device := range devices {
+		// 拉流代理和ONVIF的虚拟设备没有目录
+		if IsVirtualDevice(device) {
+			continue
+		}
+
 		d := &Device{device}
 		go func() {
 			_, _ = d.QueryCatalog(30)
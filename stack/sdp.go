@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"gb-cms/common"
 	"gb-cms/sdp"
-	"strconv"
 	"strings"
 )
 
@@ -18,6 +17,7 @@ type GBSDP struct {
 	StartTime, StopTime     string
 	ConnectionAddr          string
 	IsTcpTransport          bool
+	StreamNumber            int // 上级指定的码流, 未指定为common.StreamNumberDefault
 }
 
 func ParseGBSDP(body string) (*GBSDP, error) {
@@ -26,25 +26,17 @@ func ParseGBSDP(body string) (*GBSDP, error) {
 		return nil, err
 	}
 
-	gbSdp := &GBSDP{SDP: offer}
-	// 解析设置下载速度
-	var setup string
-	for _, attr := range offer.Attrs {
-		if "downloadspeed" == attr[0] {
-			speed, err := strconv.Atoi(attr[1])
-			if err != nil {
-				return nil, err
-			}
-			gbSdp.Speed = speed
-		} else if "setup" == attr[0] {
-			setup = attr[1]
-		}
-	}
+	gbSdp := &GBSDP{SDP: offer, SSRC: offer.SSRC, Speed: offer.DownloadSpeed, StreamNumber: common.StreamNumberDefault}
+	setup := offer.Setup
 
-	// 解析ssrc
-	for _, attr := range offer.Other {
-		if "y" == attr[0] {
-			gbSdp.SSRC = attr[1]
+	// 解析码流编号, 兼容厂家私有属性
+	if offer.StreamNumber != "" {
+		gbSdp.StreamNumber = common.String2StreamNumber(offer.StreamNumber)
+	} else {
+		for _, attr := range offer.Attrs {
+			if "streamprofile" == attr[0] || "stream" == attr[0] || "streamMode" == attr[0] {
+				gbSdp.StreamNumber = common.StreamMode(attr[0] + ":" + attr[1]).Number()
+			}
 		}
 	}
 
@@ -76,3 +68,34 @@ func ParseGBSDP(body string) (*GBSDP, error) {
 	gbSdp.ConnectionAddr = fmt.Sprintf("%s:%d", gbSdp.SDP.Addr, gbSdp.Media.Port)
 	return gbSdp, nil
 }
+
+// AnswerCodecs 返回应答中设备选择的视频和音频编码, 多个负载类型时取第一个
+func AnswerCodecs(answer *sdp.SDP) (string, string) {
+	var video, audio string
+	if answer.Video != nil && len(answer.Video.Codecs) > 0 {
+		video = strings.ToUpper(answer.Video.Codecs[0].Name)
+	}
+
+	if answer.Audio != nil && len(answer.Audio.Codecs) > 0 {
+		audio = strings.ToUpper(answer.Audio.Codecs[0].Name)
+	}
+
+	return video, audio
+}
+
+// NegotiateAudioPayload 按照配置的优先级, 选择offer中支持的音频负载类型. offer中没有支持的, 使用优先级最高的
+func NegotiateAudioPayload(offer *GBSDP) string {
+	payloads := common.Config.AudioPayloads
+	if offer.Media != nil {
+		for _, payload := range payloads {
+			for _, codec := range offer.Media.Codecs {
+				if strings.EqualFold(common.Payload(payload).Name(), codec.Name) {
+					// 使用offer中的负载类型编号
+					return fmt.Sprintf("%d %s/%d", codec.PT, common.Payload(payload).Name(), codec.Rate)
+				}
+			}
+		}
+	}
+
+	return payloads[0]
+}
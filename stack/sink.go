@@ -9,6 +9,8 @@ import (
 	"github.com/ghettovoice/gosip/sip/parser"
 	"net/http"
 	"net/url"
+	"strconv"
+	"strings"
 )
 
 // Sink 级联/对讲/网关转发流Sink
@@ -27,12 +29,39 @@ func (s *Sink) Close(bye, ms bool) {
 		go MSCloseSink(string(s.StreamID), s.SinkID)
 	}
 
+	// 通知1078信令服务器停止回放
+	if s.Protocol == TransStreamGBGateway && common.InviteTypePlay != s.StreamID.InviteType() {
+		go func(streamId common.StreamID) {
+			if err := ControlJTPlayback(streamId, JTPlaybackControlStop, 0, 0); err != nil {
+				log.Sugar.Errorf("通知1078信令服务器停止回放失败 err: %s stream: %s", err.Error(), streamId)
+			}
+		}(s.StreamID)
+	} else if s.Protocol == TransStreamGBGateway {
+		// 实时流可能同时转发给多个上级, 最后一个上级挂断后通知终端停止推流
+		go func(streamId common.StreamID) {
+			if sinks, _ := dao.Sink.QuerySinks(streamId); len(sinks) > 0 {
+				return
+			} else if err := StopJTLive(streamId); err != nil {
+				log.Sugar.Errorf("通知终端停止推流失败 err: %s stream: %s", err.Error(), streamId)
+			}
+		}(s.StreamID)
+	}
+
 	// 目前只有一对一对讲, 断开就删除整个websocket对讲流
 	if s.Protocol == TransStreamGBTalk {
 		_, _ = dao.Sink.DeleteSinkBySinkStreamID(s.SinkStreamID)
 		_, _ = dao.Stream.DeleteStream(s.StreamID)
 		// 删除流媒体source
 		_ = MSCloseSource(string(s.StreamID))
+
+		// 上级与部标设备的对讲, 通知终端关闭对讲
+		if common.InviteTypeTalk == s.StreamID.InviteType() {
+			go func(streamId common.StreamID) {
+				if err := StopJTTalk(streamId); err != nil {
+					log.Sugar.Errorf("通知终端关闭对讲失败 err: %s stream: %s", err.Error(), streamId)
+				}
+			}(s.StreamID)
+		}
 	}
 }
 
@@ -99,10 +128,16 @@ func AddForwardSink(forwardType int, request sip.Request, user string, sink *Sin
 	urlParams := make(url.Values)
 	if TransStreamGBTalk == forwardType {
 		urlParams.Add("forward_type", "broadcast")
+		// 告知流媒体服务协商的音频编码
+		urlParams.Add("payloads", strings.Join(attrs, ","))
 	} else if TransStreamGBCascaded == forwardType {
 		urlParams.Add("forward_type", "cascaded")
 	} else if TransStreamGBGateway == forwardType {
 		urlParams.Add("forward_type", "gateway_1078")
+		// 下载倍速, 通知1078信令服务器快进回放
+		if common.InviteTypeDownload == inviteType && gbSdp.Speed > 0 {
+			urlParams.Add("speed", strconv.Itoa(gbSdp.Speed))
+		}
 	}
 
 	ip, port, sinkID, ssrc, err := MSAddForwardSink(forwardType, string(streamId), gbSdp.ConnectionAddr, gbSdp.OfferSetup.String(), gbSdp.AnswerSetup.String(), gbSdp.SSRC, string(inviteType), urlParams)
@@ -117,7 +152,7 @@ func AddForwardSink(forwardType int, request sip.Request, user string, sink *Sin
 
 	sink.SinkID = sinkID
 	// 创建answer
-	answer := BuildSDP(gbSdp.MediaType, user, gbSdp.SDP.Session, ip, port, gbSdp.StartTime, gbSdp.StopTime, gbSdp.AnswerSetup.String(), gbSdp.Speed, ssrc, attrs...)
+	answer := BuildSDP(gbSdp.MediaType, user, gbSdp.SDP.Session, ip, port, gbSdp.StartTime, gbSdp.StopTime, gbSdp.AnswerSetup.String(), gbSdp.Speed, ssrc, nil, attrs...)
 	response := CreateResponseWithStatusCode(request, http.StatusOK)
 
 	// answer添加contact头域
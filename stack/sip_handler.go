@@ -4,6 +4,7 @@ import (
 	"encoding/xml"
 	"gb-cms/common"
 	"gb-cms/dao"
+	"gb-cms/hook"
 	"gb-cms/log"
 	"github.com/ghettovoice/gosip/sip"
 	"github.com/lkmio/avformat/utils"
@@ -86,6 +87,13 @@ func (e *EventHandler) OnRegister(id, transport, addr, userAgent string) (int, G
 			log.Sugar.Errorf("保存设备状态日志失败 device: %s err: %s", id, err.Error())
 		}
 
+		PublishEvent(hook.EventTypeDeviceOnline, map[string]interface{}{
+			"device_id":   id,
+			"transport":   transport,
+			"remote_addr": addr,
+			"user_agent":  userAgent,
+		})
+
 		// 通道不为空, 通知上级
 		if count > 0 {
 			go device.PushCatalog()
@@ -147,6 +155,18 @@ func (e *EventHandler) OnDeviceInfo(device string, response *DeviceInfoResponse)
 }
 
 func (e *EventHandler) SavePosition(position *dao.PositionModel) {
+	PublishEvent(hook.EventTypeDevicePosition, map[string]interface{}{
+		"device_id":  position.DeviceID,
+		"channel_id": position.ChannelID,
+		"longitude":  position.Longitude,
+		"latitude":   position.Latitude,
+		"speed":      position.Speed,
+		"direction":  position.Direction,
+		"altitude":   position.Altitude,
+		"time":       position.Time,
+		"source":     position.Source,
+	})
+
 	// 更新设备最新的位置
 	if position.DeviceID == position.ChannelID || position.ChannelID == "" {
 		conditions := make(map[string]interface{}, 0)
@@ -238,6 +258,16 @@ func ForwardCatalogNotifyMessage(catalog *CatalogResponse) {
 }
 
 func (e *EventHandler) OnNotifyCatalogMessage(catalog *CatalogResponse) {
+	var changes []map[string]string
+	defer func() {
+		if len(changes) > 0 {
+			PublishEvent(hook.EventTypeDeviceCatalog, map[string]interface{}{
+				"device_id": catalog.DeviceID,
+				"channels":  changes,
+			})
+		}
+	}()
+
 	for _, channel := range catalog.DeviceList.Devices {
 		if channel.Event == "" {
 			log.Sugar.Warnf("目录事件为空 设备ID: %s", channel.DeviceID)
@@ -245,12 +275,15 @@ func (e *EventHandler) OnNotifyCatalogMessage(catalog *CatalogResponse) {
 		}
 
 		channel.RootID = catalog.DeviceID
+		changes = append(changes, map[string]string{"channel_id": channel.DeviceID, "name": channel.Name, "event": channel.Event})
 		switch channel.Event {
 		case "ON":
 			_ = dao.Channel.UpdateChannelStatus(catalog.DeviceID, channel.DeviceID, string(common.ON))
+			PublishEvent(hook.EventTypeChannelStatus, map[string]interface{}{"device_id": catalog.DeviceID, "channel_id": channel.DeviceID, "status": common.ON})
 			break
 		case "OFF":
 			_ = dao.Channel.UpdateChannelStatus(catalog.DeviceID, channel.DeviceID, string(common.OFF))
+			PublishEvent(hook.EventTypeChannelStatus, map[string]interface{}{"device_id": catalog.DeviceID, "channel_id": channel.DeviceID, "status": common.OFF})
 			break
 		case "VLOST":
 			break
@@ -286,10 +319,23 @@ func (e *EventHandler) OnNotifyAlarmMessage(deviceId string, alarm *AlarmNotify)
 		})
 	}
 
-	if common.Config.AlarmReserveDays < 1 {
-		return
+	var alarmType *int
+	if alarm.Info != nil {
+		alarmType = alarm.Info.AlarmType
 	}
 
+	PublishEvent(hook.EventTypeDeviceAlarm, map[string]interface{}{
+		"device_id":      deviceId,
+		"channel_id":     alarm.DeviceID,
+		"alarm_priority": alarm.AlarmPriority,
+		"alarm_method":   alarm.AlarmMethod,
+		"alarm_type":     alarmType,
+		"time":           alarm.AlarmTime,
+		"description":    alarm.AlarmDescription,
+		"longitude":      alarm.Longitude,
+		"latitude":       alarm.Latitude,
+	})
+
 	model := dao.AlarmModel{
 		DeviceID:      deviceId,
 		ChannelID:     alarm.DeviceID,
@@ -308,6 +354,14 @@ func (e *EventHandler) OnNotifyAlarmMessage(deviceId string, alarm *AlarmNotify)
 		}
 	}
 
+	// 报警联动, 保存时会修改model, 使用副本
+	linkage := model
+	go AlarmPlanManager.OnAlarm(&linkage)
+
+	if common.Config.AlarmReserveDays < 1 {
+		return
+	}
+
 	if err := dao.Alarm.Save(&model); err != nil {
 		log.Sugar.Errorf("保存报警信息到数据库失败 device: %s err: %s", alarm.DeviceID, err.Error())
 	}
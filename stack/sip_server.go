@@ -183,7 +183,8 @@ func (s *SipServer) OnBye(wrapper *SipRequestSource) {
 	id, _ := wrapper.req.CallID()
 	var deviceId string
 
-	if stream, _ := dao.Stream.DeleteStreamByCallID(id.Value()); stream != nil {
+	stream, _ := dao.Stream.DeleteStreamByCallID(id.Value())
+	if stream != nil {
 		// 下级设备挂断, 关闭流
 		deviceId = stream.StreamID.DeviceID()
 		(&Stream{stream}).Close(false, true)
@@ -201,11 +202,27 @@ func (s *SipServer) OnBye(wrapper *SipRequestSource) {
 		if jtDevice := JTDeviceManager.Find(deviceId); jtDevice != nil {
 			jtDevice.OnBye(wrapper.req)
 		}
+
+		// 上级挂断语音广播, 通知终端关闭
+		if stream != nil && common.InviteTypeBroadcast == stream.StreamID.InviteType() {
+			go func(streamId common.StreamID) {
+				if err := StopJTTalk(streamId); err != nil {
+					log2.Sugar.Errorf("通知终端关闭广播失败 err: %s stream: %s", err.Error(), streamId)
+				}
+			}(stream.StreamID)
+		}
 	} else if device, _ := dao.Device.QueryDevice(deviceId); device != nil {
 		(&Device{device}).OnBye(wrapper.req)
 	}
 }
 
+func (s *SipServer) OnInfo(wrapper *SipRequestSource) {
+	// 部标设备的上级回放控制
+	if wrapper.fromJt {
+		SendResponse(wrapper.tx, OnJTPlaybackInfo(wrapper.req))
+	}
+}
+
 func (s *SipServer) OnNotify(wrapper *SipRequestSource) {
 	response := sip.NewResponseFromRequest("", wrapper.req, 200, "OK", "")
 	SendResponse(wrapper.tx, response)
@@ -256,7 +273,19 @@ func (s *SipServer) OnSubscribe(wrapper *SipRequestSource) {
 
 	var client GBClient
 	if wrapper.fromJt {
-		return
+		// 部标设备, 根据设备ID或通道ID查找
+		user := wrapper.req.Recipient().User().String()
+		if client = JTDeviceManager.Find(user); client == nil {
+			if channels, _ := dao.Channel.QueryChannelsByChannelID(user); len(channels) > 0 {
+				client = JTDeviceManager.Find(channels[0].RootID)
+			}
+		}
+
+		if client == nil {
+			log2.Sugar.Errorf("处理订阅请求失败, 找不到部标设备. request: %s", wrapper.req.String())
+			code = http.StatusNotFound
+			return
+		}
 	} else {
 		if client = PlatformManager.Find(wrapper.req.Source()); client == nil {
 			log2.Sugar.Errorf("处理订阅请求失败, 找不到级联上级. request: %s", wrapper.req.String())
@@ -338,10 +367,22 @@ func (s *SipServer) OnMessage(wrapper *SipRequestSource) {
 	case XmlNameControl:
 		break
 	case XmlNameQuery:
+		// 查询录像只转发部标设备上级的请求, 级联上级的查询录像不支持
+		if CmdRecordInfo == cmd && !wrapper.fromJt {
+			ok = false
+			log2.Sugar.Errorf("处理上级请求消息失败, 不支持查询录像 addr: %s request: %s", wrapper.req.Source(), wrapper.req.String())
+			return
+		}
+
 		// 被上级查询
 		var device GBClient
 		if wrapper.fromCascade {
 			device = PlatformManager.Find(wrapper.req.Source())
+		} else if wrapper.fromJt && CmdRecordInfo == cmd {
+			// 查询录像的DeviceID为通道ID
+			if channels, _ := dao.Channel.QueryChannelsByChannelID(deviceId); len(channels) > 0 {
+				device = JTDeviceManager.Find(channels[0].RootID)
+			}
 		} else if wrapper.fromJt {
 			device = JTDeviceManager.Find(deviceId)
 		} else {
@@ -374,6 +415,9 @@ func (s *SipServer) OnMessage(wrapper *SipRequestSource) {
 			}
 
 			device.OnQueryCatalog(message.(*BaseMessage).SN, channels)
+		} else if jtDevice, b := device.(*JTDevice); b && CmdRecordInfo == cmd {
+			// 等待1078信令服务器应答, 异步查询
+			go jtDevice.OnQueryRecord(message.(*QueryRecordInfo))
 		}
 
 		break
@@ -385,7 +429,9 @@ func (s *SipServer) OnMessage(wrapper *SipRequestSource) {
 			// 回放/下载结束
 			ok = true
 			id, _ := wrapper.req.CallID()
-			CloseStreamByCallID(id.Value())
+			if stream := CloseStreamByCallID(id.Value()); stream != nil && common.InviteTypeDownload == common.InviteType(stream.StreamType) {
+				DownloadJobManager.OnMediaStatus(stream.StreamID)
+			}
 		} else if CmdAlarm == cmd {
 			ok = true
 			// 9.4 报警事件通知和分发
@@ -413,6 +459,15 @@ func (s *SipServer) OnMessage(wrapper *SipRequestSource) {
 				return
 			}
 			s.handler.OnNotifyCatalogMessage(&catalog)
+		} else if CmdBroadcast == cmd && wrapper.fromJt {
+			// 上级向部标设备发起语音广播, 目标ID为通道ID
+			notify := message.(*BroadcastNotify)
+			if channels, _ := dao.Channel.QueryChannelsByChannelID(notify.TargetID); len(channels) > 0 {
+				if client := JTDeviceManager.Find(channels[0].RootID); client != nil {
+					ok = true
+					go client.(*JTDevice).OnBroadcast(notify, channels[0])
+				}
+			}
 		}
 
 		break
@@ -502,9 +557,17 @@ func filterRequest(f func(wrapper *SipRequestSource)) gosip.RequestHandler {
 			fromJt = JTDeviceManager.ExistClientByServerAddr(req.Source())
 		}
 		switch req.Method() {
-		case sip.SUBSCRIBE, sip.INFO:
-			if platform == nil || fromJt {
-				// SUBSCRIBE/INFO只能本级域向下级发起
+		case sip.INFO:
+			if platform == nil && !fromJt {
+				// INFO只能上级发起, 部标设备的上级发起回放控制
+				SendResponseWithStatusCode(req, tx, http.StatusBadRequest)
+				log2.Sugar.Errorf("处理%s请求失败, %s消息只能上级发起. request: %s", req.Method(), req.Method(), req.String())
+				return
+			}
+			break
+		case sip.SUBSCRIBE:
+			if platform == nil && !fromJt {
+				// SUBSCRIBE只能上级发起, 部标设备的上级订阅移动位置
 				SendResponseWithStatusCode(req, tx, http.StatusBadRequest)
 				log2.Sugar.Errorf("处理%s请求失败, %s消息只能上级发起. request: %s", req.Method(), req.Method(), req.String())
 				return
@@ -546,6 +609,7 @@ func (s *SipServer) Start(id, listenIP, publicIP string, listenPort int) error {
 		fmt.Sprintf("%s.%s", XmlNameQuery, CmdCatalog):         reflect.TypeOf(BaseMessage{}),
 		fmt.Sprintf("%s.%s", XmlNameQuery, CmdDeviceInfo):      reflect.TypeOf(BaseMessage{}),
 		fmt.Sprintf("%s.%s", XmlNameQuery, CmdDeviceStatus):    reflect.TypeOf(BaseMessage{}),
+		fmt.Sprintf("%s.%s", XmlNameQuery, CmdRecordInfo):      reflect.TypeOf(QueryRecordInfo{}),
 		fmt.Sprintf("%s.%s", XmlNameResponse, CmdCatalog):      reflect.TypeOf(CatalogResponse{}),
 		fmt.Sprintf("%s.%s", XmlNameResponse, CmdDeviceInfo):   reflect.TypeOf(DeviceInfoResponse{}),
 		fmt.Sprintf("%s.%s", XmlNameResponse, CmdDeviceStatus): reflect.TypeOf(DeviceStatusResponse{}),
@@ -553,6 +617,7 @@ func (s *SipServer) Start(id, listenIP, publicIP string, listenPort int) error {
 		fmt.Sprintf("%s.%s", XmlNameNotify, CmdKeepalive):      reflect.TypeOf(BaseMessage{}),
 		fmt.Sprintf("%s.%s", XmlNameNotify, CmdMobilePosition): reflect.TypeOf(BaseMessage{}),
 		fmt.Sprintf("%s.%s", XmlNameResponse, CmdBroadcast):    reflect.TypeOf(BaseMessage{}),
+		fmt.Sprintf("%s.%s", XmlNameNotify, CmdBroadcast):      reflect.TypeOf(BroadcastNotify{}),
 		fmt.Sprintf("%s.%s", XmlNameNotify, CmdMediaStatus):    reflect.TypeOf(BaseMessage{}),
 	}
 
@@ -563,8 +628,7 @@ func (s *SipServer) Start(id, listenIP, publicIP string, listenPort int) error {
 	utils.Assert(ua.OnRequest(sip.NOTIFY, filterRequest(s.OnNotify)) == nil)
 	utils.Assert(ua.OnRequest(sip.MESSAGE, filterRequest(s.OnMessage)) == nil)
 
-	utils.Assert(ua.OnRequest(sip.INFO, filterRequest(func(wrapper *SipRequestSource) {
-	})) == nil)
+	utils.Assert(ua.OnRequest(sip.INFO, filterRequest(s.OnInfo)) == nil)
 	utils.Assert(ua.OnRequest(sip.CANCEL, filterRequest(func(wrapper *SipRequestSource) {
 	})) == nil)
 	utils.Assert(ua.OnRequest(sip.SUBSCRIBE, filterRequest(s.OnSubscribe)) == nil)
@@ -0,0 +1,91 @@
+package stack
+
+import (
+	"gb-cms/common"
+	"gb-cms/log"
+	"github.com/ghettovoice/gosip/sip"
+	"github.com/ghettovoice/gosip/sip/parser"
+	"go.uber.org/zap"
+	"net/http"
+	"testing"
+)
+
+const testSubscribe = "SUBSCRIBE sip:34020000001320000001@192.168.1.2:15060 SIP/2.0\r\n" +
+	"Via: SIP/2.0/UDP 192.168.1.100:5060;branch=z9hG4bK1234567\r\n" +
+	"From: <sip:34020000002000000001@3402000000>;tag=1234\r\n" +
+	"To: <sip:34020000001320000001@3402000000>\r\n" +
+	"Call-ID: 1234567890@192.168.1.100\r\n" +
+	"CSeq: 1 SUBSCRIBE\r\n" +
+	"Event: presence\r\n" +
+	"Expires: 3600\r\n" +
+	"Content-Length: 0\r\n\r\n"
+
+// 部标设备的上级地址
+type testJTClient struct {
+	GBClient
+	domain string
+}
+
+func (c *testJTClient) GetDomain() string {
+	return c.domain
+}
+
+type testServerTransaction struct {
+	sip.ServerTransaction
+	code sip.StatusCode
+}
+
+func (tx *testServerTransaction) Respond(res sip.Response) error {
+	tx.code = res.StatusCode()
+	return nil
+}
+
+func newTestSubscribe(t *testing.T, source string) sip.Request {
+	message, err := parser.NewPacketParser(common.Logger).ParseMessage([]byte(testSubscribe))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	req := message.(sip.Request)
+	req.SetSource(source)
+	return req
+}
+
+func TestFilterRequestSubscribe(t *testing.T) {
+	if log.Sugar == nil {
+		log.Sugar = zap.NewNop().Sugar()
+	}
+
+	jtAddr := "192.168.1.100:5060"
+	JTDeviceManager.Add("test-jt-subscribe", &testJTClient{domain: jtAddr})
+	defer JTDeviceManager.Remove("test-jt-subscribe")
+
+	tests := []struct {
+		name     string
+		source   string
+		handled  bool
+		fromJt   bool
+		wantCode sip.StatusCode
+	}{
+		{name: "from jt upper platform", source: jtAddr, handled: true, fromJt: true},
+		{name: "from unknown source", source: "192.168.1.101:5060", wantCode: http.StatusBadRequest},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var wrapper *SipRequestSource
+			tx := &testServerTransaction{}
+			filterRequest(func(w *SipRequestSource) {
+				wrapper = w
+			})(newTestSubscribe(t, test.source), tx)
+
+			if handled := wrapper != nil; handled != test.handled {
+				t.Fatalf("handled = %v, want %v", handled, test.handled)
+			} else if handled && (wrapper.fromJt != test.fromJt || wrapper.fromCascade) {
+				t.Errorf("fromJt = %v fromCascade = %v, want fromJt %v", wrapper.fromJt, wrapper.fromCascade, test.fromJt)
+			} else if tx.code != test.wantCode {
+				t.Errorf("response code = %d, want %d", tx.code, test.wantCode)
+			}
+		})
+	}
+}
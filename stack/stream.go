@@ -118,11 +118,14 @@ func CloseStream(streamId common.StreamID, ms bool) {
 	}
 }
 
-func CloseStreamByCallID(callId string) {
+// CloseStreamByCallID 关闭会话对应的流, 返回关闭的流
+func CloseStreamByCallID(callId string) *dao.StreamModel {
 	deleteStream, err := dao.Stream.DeleteStreamByCallID(callId)
 	if err == nil {
 		(&Stream{deleteStream}).Close(true, true)
 	}
+
+	return deleteStream
 }
 
 // CloseStreamSinks 关闭某个流的所有sink
@@ -0,0 +1,176 @@
+package stack
+
+import (
+	"fmt"
+	"gb-cms/common"
+	"gb-cms/dao"
+	"gb-cms/log"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	// 虚拟设备的类型编码(118-NVR)和序号, 拼接在本级ID的中心编码和行业编码之后
+	proxyDeviceSuffix = "1189999999"
+	// 代理通道的类型编码(131-摄像机), 序号以9开头, 避免与同域的真实设备冲突
+	proxyChannelTypeCode = "1319"
+)
+
+// 本级ID的中心编码和行业编码, 配置的ID不足10位时补0
+func sipIDPrefix() string {
+	id := common.Config.SipID
+	if len(id) < 10 {
+		return id + strings.Repeat("0", 10-len(id))
+	}
+
+	return id[:10]
+}
+
+// ProxyDeviceID 拉流代理所属的虚拟设备ID
+func ProxyDeviceID() string {
+	return sipIDPrefix() + proxyDeviceSuffix
+}
+
+func IsProxyDevice(deviceId string) bool {
+	return deviceId == ProxyDeviceID()
+}
+
+// IsVirtualDevice 拉流代理和ONVIF设备都是虚拟设备, 不走国标信令, 通道由流媒体服务拉流
+func IsVirtualDevice(device *dao.DeviceModel) bool {
+	return IsProxyDevice(device.DeviceID) || IsOnvifDevice(device)
+}
+
+// GenerateProxyChannelID 根据代理ID生成20位通道ID. 序号只有6位, 重复时由dao顺延
+func GenerateProxyChannelID(id uint) string {
+	return fmt.Sprintf("%s%s%06d", sipIDPrefix(), proxyChannelTypeCode, id%1000000)
+}
+
+// StartProxyDevice 创建或上线拉流代理的虚拟设备. 虚拟设备不发送心跳, 始终在线
+func StartProxyDevice() {
+	now := time.Now()
+	id := ProxyDeviceID()
+	if dao.Device.ExistDevice(id) {
+		_ = dao.Device.UpdateDeviceStatus(id, common.ON)
+		return
+	}
+
+	err := dao.Device.SaveDevice(&dao.DeviceModel{
+		DeviceID:      id,
+		Name:          "拉流代理",
+		Manufacturer:  "StreamProxy",
+		Status:        common.ON,
+		RegisterTime:  now,
+		LastHeartbeat: now,
+	})
+
+	if err != nil {
+		log.Sugar.Errorf("创建拉流代理虚拟设备失败 err: %s device: %s", err.Error(), id)
+	}
+}
+
+// 通知流媒体服务拉取代理地址, 等待推流通知
+func (d *Device) startProxyStream(inviteType common.InviteType, streamId common.StreamID, channel *dao.ChannelModel) (*dao.StreamModel, error) {
+	if common.InviteTypePlay != inviteType {
+		return nil, fmt.Errorf("拉流代理不支持回放和下载")
+	}
+
+	proxy, err := dao.StreamProxy.QueryProxyByChannelID(channel.DeviceID)
+	if err != nil {
+		return nil, fmt.Errorf("拉流代理不存在")
+	} else if !proxy.Enable {
+		return nil, fmt.Errorf("拉流代理未启用")
+	}
+
+	stream := &dao.StreamModel{
+		DeviceID:   streamId.DeviceID(),
+		ChannelID:  streamId.ChannelID(),
+		StreamID:   streamId,
+		Protocol:   SourceTypePull,
+		StreamType: string(inviteType),
+		Name:       channel.Name,
+		RemoteAddr: redactUrl(proxy.Url),
+	}
+
+	oldStream, b := dao.Stream.SaveStream(stream)
+	if !b {
+		if oldStream == nil {
+			return nil, fmt.Errorf("stream already exists")
+		}
+		return oldStream, nil
+	}
+
+	// 拉流可能很快成功, 在通知流媒体服务前开始等待推流通知
+	waiting := StreamWaiting{onPublishCb: make(chan int, 1)}
+	_, _ = EarlyDialogs.Add(string(streamId), &waiting)
+	defer EarlyDialogs.Remove(string(streamId))
+
+	log.Sugar.Infof("拉流代理开始拉流 stream: %s url: %s", streamId, redactUrl(proxy.Url))
+	urls, err := MSCreatePullSource(string(streamId), onvifPullUrl(proxy), proxy.Transport)
+	if err != nil {
+		log.Sugar.Errorf("拉流代理创建source失败 err: %s stream: %s", err.Error(), streamId)
+		_, _ = dao.Stream.DeleteStream(streamId)
+		return nil, err
+	}
+
+	_, _, firstPacketTimeout := d.GetInviteTimeout()
+	if http.StatusOK != waiting.Receive(firstPacketTimeout) {
+		log.Sugar.Errorf("拉流代理拉流超时 stream: %s url: %s", streamId, redactUrl(proxy.Url))
+		CloseStream(streamId, true)
+		return nil, fmt.Errorf("拉流超时")
+	}
+
+	stream.Urls = urls
+	_ = dao.Stream.UpdateStream(stream)
+	return stream, nil
+}
+
+// ProxyStreamID 代理通道的实时流ID
+func ProxyStreamID(proxy *dao.StreamProxyModel) common.StreamID {
+	return common.GenerateStreamID(common.InviteTypePlay, proxy.DeviceID, proxy.ChannelID, "", "")
+}
+
+// IsAlwaysOnProxyStream 是否是常驻拉流的代理流, 无人观看时也不关闭
+func IsAlwaysOnProxyStream(streamId common.StreamID) bool {
+	proxy, _ := dao.StreamProxy.QueryProxyByChannelID(streamId.ChannelID())
+	return proxy != nil && proxy.DeviceID == streamId.DeviceID() && proxy.Enable && proxy.AlwaysOn
+}
+
+// StopProxyStream 关闭代理的流和转发
+func StopProxyStream(proxy *dao.StreamProxyModel) {
+	CloseStream(ProxyStreamID(proxy), true)
+}
+
+// KeepAlwaysOnProxies 常驻拉流的代理断流后重新拉流
+func KeepAlwaysOnProxies() {
+	proxies, err := dao.StreamProxy.QueryAlwaysOnProxies()
+	if err != nil {
+		log.Sugar.Errorf("查询常驻拉流代理失败 err: %s", err.Error())
+		return
+	}
+
+	devices := make(map[string]*dao.DeviceModel)
+	for _, proxy := range proxies {
+		streamId := ProxyStreamID(proxy)
+		if stream, _ := dao.Stream.QueryStream(streamId); stream != nil {
+			continue
+		}
+
+		device, ok := devices[proxy.DeviceID]
+		if !ok {
+			device, _ = dao.Device.QueryDevice(proxy.DeviceID)
+			devices[proxy.DeviceID] = device
+		}
+
+		// 离线的ONVIF设备等待恢复在线
+		if device == nil || !device.Online() {
+			continue
+		}
+
+		go func(d *Device, proxy *dao.StreamProxyModel) {
+			if _, err := d.StartStream(common.InviteTypePlay, streamId, proxy.ChannelID, "", "", "", 0, false); err != nil {
+				log.Sugar.Errorf("常驻拉流代理拉流失败 err: %s channel: %s url: %s", err.Error(), proxy.ChannelID, redactUrl(proxy.Url))
+			}
+		}(&Device{device}, proxy)
+	}
+}
@@ -5,7 +5,6 @@ import (
 	"encoding/xml"
 	"fmt"
 	"gb-cms/common"
-	"github.com/ghettovoice/gosip/sip"
 )
 
 const (
@@ -19,11 +18,9 @@ const (
 		"<Type>%s</Type>\r\n" +
 		"</Query>\r\n"
 
-	SeekBodyFormat = "PLAY RTSP/1.0\r\n" + "CSeq: %d\r\n" + "Range: npt=%d-\r\n"
-)
-
-var (
-	RtspMessageType sip.ContentType = "application/RTSP"
+	SeekBodyFormat = "PLAY RTSP/1.0\r\n" +
+		"CSeq: %d\r\n" +
+		"Range: npt=%d-\r\n"
 )
 
 type QueryRecordInfoResponse struct {